@@ -4,8 +4,10 @@ package ent
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/iWorld-y/domain_radar/app/common/ent/actionguide"
@@ -17,6 +19,7 @@ type ActionGuideCreate struct {
 	config
 	mutation *ActionGuideMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetDeepAnalysisID sets the "deep_analysis_id" field.
@@ -134,6 +137,7 @@ func (_c *ActionGuideCreate) createSpec() (*ActionGuide, *sqlgraph.CreateSpec) {
 		_node = &ActionGuide{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(actionguide.Table, sqlgraph.NewFieldSpec(actionguide.FieldID, field.TypeInt))
 	)
+	_spec.OnConflict = _c.conflict
 	if id, ok := _c.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = id
@@ -162,11 +166,220 @@ func (_c *ActionGuideCreate) createSpec() (*ActionGuide, *sqlgraph.CreateSpec) {
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ActionGuide.Create().
+//		SetDeepAnalysisID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ActionGuideUpsert) {
+//			SetDeepAnalysisID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ActionGuideCreate) OnConflict(opts ...sql.ConflictOption) *ActionGuideUpsertOne {
+	_c.conflict = opts
+	return &ActionGuideUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ActionGuide.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ActionGuideCreate) OnConflictColumns(columns ...string) *ActionGuideUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ActionGuideUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ActionGuideUpsertOne is the builder for "upsert"-ing
+	//  one ActionGuide node.
+	ActionGuideUpsertOne struct {
+		create *ActionGuideCreate
+	}
+
+	// ActionGuideUpsert is the "OnConflict" setter.
+	ActionGuideUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetDeepAnalysisID sets the "deep_analysis_id" field.
+func (u *ActionGuideUpsert) SetDeepAnalysisID(v int) *ActionGuideUpsert {
+	u.Set(actionguide.FieldDeepAnalysisID, v)
+	return u
+}
+
+// UpdateDeepAnalysisID sets the "deep_analysis_id" field to the value that was provided on create.
+func (u *ActionGuideUpsert) UpdateDeepAnalysisID() *ActionGuideUpsert {
+	u.SetExcluded(actionguide.FieldDeepAnalysisID)
+	return u
+}
+
+// ClearDeepAnalysisID clears the value of the "deep_analysis_id" field.
+func (u *ActionGuideUpsert) ClearDeepAnalysisID() *ActionGuideUpsert {
+	u.SetNull(actionguide.FieldDeepAnalysisID)
+	return u
+}
+
+// SetGuideContent sets the "guide_content" field.
+func (u *ActionGuideUpsert) SetGuideContent(v string) *ActionGuideUpsert {
+	u.Set(actionguide.FieldGuideContent, v)
+	return u
+}
+
+// UpdateGuideContent sets the "guide_content" field to the value that was provided on create.
+func (u *ActionGuideUpsert) UpdateGuideContent() *ActionGuideUpsert {
+	u.SetExcluded(actionguide.FieldGuideContent)
+	return u
+}
+
+// ClearGuideContent clears the value of the "guide_content" field.
+func (u *ActionGuideUpsert) ClearGuideContent() *ActionGuideUpsert {
+	u.SetNull(actionguide.FieldGuideContent)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ActionGuide.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(actionguide.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ActionGuideUpsertOne) UpdateNewValues() *ActionGuideUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(actionguide.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ActionGuide.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ActionGuideUpsertOne) Ignore() *ActionGuideUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ActionGuideUpsertOne) DoNothing() *ActionGuideUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ActionGuideCreate.OnConflict
+// documentation for more info.
+func (u *ActionGuideUpsertOne) Update(set func(*ActionGuideUpsert)) *ActionGuideUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ActionGuideUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetDeepAnalysisID sets the "deep_analysis_id" field.
+func (u *ActionGuideUpsertOne) SetDeepAnalysisID(v int) *ActionGuideUpsertOne {
+	return u.Update(func(s *ActionGuideUpsert) {
+		s.SetDeepAnalysisID(v)
+	})
+}
+
+// UpdateDeepAnalysisID sets the "deep_analysis_id" field to the value that was provided on create.
+func (u *ActionGuideUpsertOne) UpdateDeepAnalysisID() *ActionGuideUpsertOne {
+	return u.Update(func(s *ActionGuideUpsert) {
+		s.UpdateDeepAnalysisID()
+	})
+}
+
+// ClearDeepAnalysisID clears the value of the "deep_analysis_id" field.
+func (u *ActionGuideUpsertOne) ClearDeepAnalysisID() *ActionGuideUpsertOne {
+	return u.Update(func(s *ActionGuideUpsert) {
+		s.ClearDeepAnalysisID()
+	})
+}
+
+// SetGuideContent sets the "guide_content" field.
+func (u *ActionGuideUpsertOne) SetGuideContent(v string) *ActionGuideUpsertOne {
+	return u.Update(func(s *ActionGuideUpsert) {
+		s.SetGuideContent(v)
+	})
+}
+
+// UpdateGuideContent sets the "guide_content" field to the value that was provided on create.
+func (u *ActionGuideUpsertOne) UpdateGuideContent() *ActionGuideUpsertOne {
+	return u.Update(func(s *ActionGuideUpsert) {
+		s.UpdateGuideContent()
+	})
+}
+
+// ClearGuideContent clears the value of the "guide_content" field.
+func (u *ActionGuideUpsertOne) ClearGuideContent() *ActionGuideUpsertOne {
+	return u.Update(func(s *ActionGuideUpsert) {
+		s.ClearGuideContent()
+	})
+}
+
+// Exec executes the query.
+func (u *ActionGuideUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ActionGuideCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ActionGuideUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ActionGuideUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ActionGuideUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // ActionGuideCreateBulk is the builder for creating many ActionGuide entities in bulk.
 type ActionGuideCreateBulk struct {
 	config
 	err      error
 	builders []*ActionGuideCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the ActionGuide entities in the database.
@@ -195,6 +408,7 @@ func (_c *ActionGuideCreateBulk) Save(ctx context.Context) ([]*ActionGuide, erro
 					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -248,3 +462,159 @@ func (_c *ActionGuideCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ActionGuide.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ActionGuideUpsert) {
+//			SetDeepAnalysisID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ActionGuideCreateBulk) OnConflict(opts ...sql.ConflictOption) *ActionGuideUpsertBulk {
+	_c.conflict = opts
+	return &ActionGuideUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ActionGuide.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ActionGuideCreateBulk) OnConflictColumns(columns ...string) *ActionGuideUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ActionGuideUpsertBulk{
+		create: _c,
+	}
+}
+
+// ActionGuideUpsertBulk is the builder for "upsert"-ing
+// a bulk of ActionGuide nodes.
+type ActionGuideUpsertBulk struct {
+	create *ActionGuideCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ActionGuide.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(actionguide.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ActionGuideUpsertBulk) UpdateNewValues() *ActionGuideUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(actionguide.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ActionGuide.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ActionGuideUpsertBulk) Ignore() *ActionGuideUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ActionGuideUpsertBulk) DoNothing() *ActionGuideUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ActionGuideCreateBulk.OnConflict
+// documentation for more info.
+func (u *ActionGuideUpsertBulk) Update(set func(*ActionGuideUpsert)) *ActionGuideUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ActionGuideUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetDeepAnalysisID sets the "deep_analysis_id" field.
+func (u *ActionGuideUpsertBulk) SetDeepAnalysisID(v int) *ActionGuideUpsertBulk {
+	return u.Update(func(s *ActionGuideUpsert) {
+		s.SetDeepAnalysisID(v)
+	})
+}
+
+// UpdateDeepAnalysisID sets the "deep_analysis_id" field to the value that was provided on create.
+func (u *ActionGuideUpsertBulk) UpdateDeepAnalysisID() *ActionGuideUpsertBulk {
+	return u.Update(func(s *ActionGuideUpsert) {
+		s.UpdateDeepAnalysisID()
+	})
+}
+
+// ClearDeepAnalysisID clears the value of the "deep_analysis_id" field.
+func (u *ActionGuideUpsertBulk) ClearDeepAnalysisID() *ActionGuideUpsertBulk {
+	return u.Update(func(s *ActionGuideUpsert) {
+		s.ClearDeepAnalysisID()
+	})
+}
+
+// SetGuideContent sets the "guide_content" field.
+func (u *ActionGuideUpsertBulk) SetGuideContent(v string) *ActionGuideUpsertBulk {
+	return u.Update(func(s *ActionGuideUpsert) {
+		s.SetGuideContent(v)
+	})
+}
+
+// UpdateGuideContent sets the "guide_content" field to the value that was provided on create.
+func (u *ActionGuideUpsertBulk) UpdateGuideContent() *ActionGuideUpsertBulk {
+	return u.Update(func(s *ActionGuideUpsert) {
+		s.UpdateGuideContent()
+	})
+}
+
+// ClearGuideContent clears the value of the "guide_content" field.
+func (u *ActionGuideUpsertBulk) ClearGuideContent() *ActionGuideUpsertBulk {
+	return u.Update(func(s *ActionGuideUpsert) {
+		s.ClearGuideContent()
+	})
+}
+
+// Exec executes the query.
+func (u *ActionGuideUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ActionGuideCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ActionGuideCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ActionGuideUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,179 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/iWorld-y/domain_radar/app/common/ent/analysislens"
+	"github.com/iWorld-y/domain_radar/app/common/ent/user"
+)
+
+// AnalysisLens is the model entity for the AnalysisLens schema.
+type AnalysisLens struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// Section title shown in the report, e.g. competitor moves
+	Name string `json:"name,omitempty"`
+	// What the deep analysis should write in this section
+	Instruction string `json:"instruction,omitempty"`
+	// Display order of the section, ascending
+	Position int `json:"position,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AnalysisLensQuery when eager-loading is set.
+	Edges        AnalysisLensEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// AnalysisLensEdges holds the relations/edges for other nodes in the graph.
+type AnalysisLensEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AnalysisLensEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AnalysisLens) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case analysislens.FieldID, analysislens.FieldUserID, analysislens.FieldPosition:
+			values[i] = new(sql.NullInt64)
+		case analysislens.FieldName, analysislens.FieldInstruction:
+			values[i] = new(sql.NullString)
+		case analysislens.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AnalysisLens fields.
+func (_m *AnalysisLens) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case analysislens.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case analysislens.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = int(value.Int64)
+			}
+		case analysislens.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case analysislens.FieldInstruction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field instruction", values[i])
+			} else if value.Valid {
+				_m.Instruction = value.String
+			}
+		case analysislens.FieldPosition:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field position", values[i])
+			} else if value.Valid {
+				_m.Position = int(value.Int64)
+			}
+		case analysislens.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AnalysisLens.
+// This includes values selected through modifiers, order, etc.
+func (_m *AnalysisLens) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the AnalysisLens entity.
+func (_m *AnalysisLens) QueryUser() *UserQuery {
+	return NewAnalysisLensClient(_m.config).QueryUser(_m)
+}
+
+// Update returns a builder for updating this AnalysisLens.
+// Note that you need to call AnalysisLens.Unwrap() before calling this method if this AnalysisLens
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *AnalysisLens) Update() *AnalysisLensUpdateOne {
+	return NewAnalysisLensClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the AnalysisLens entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *AnalysisLens) Unwrap() *AnalysisLens {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AnalysisLens is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *AnalysisLens) String() string {
+	var builder strings.Builder
+	builder.WriteString("AnalysisLens(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("instruction=")
+	builder.WriteString(_m.Instruction)
+	builder.WriteString(", ")
+	builder.WriteString("position=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Position))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AnalysisLensSlice is a parsable slice of AnalysisLens.
+type AnalysisLensSlice []*AnalysisLens
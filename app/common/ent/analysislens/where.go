@@ -0,0 +1,349 @@
+// Code generated by ent, DO NOT EDIT.
+
+package analysislens
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/iWorld-y/domain_radar/app/common/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.AnalysisLens {
+	return predicate.AnalysisLens(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.AnalysisLens {
+	return predicate.AnalysisLens(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.AnalysisLens {
+	return predicate.AnalysisLens(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.AnalysisLens {
+	return predicate.AnalysisLens(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.AnalysisLens {
+	return predicate.AnalysisLens(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.AnalysisLens {
+	return predicate.AnalysisLens(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.AnalysisLens {
+	return predicate.AnalysisLens(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.AnalysisLens {
+	return predicate.AnalysisLens(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.AnalysisLens {
+	return predicate.AnalysisLens(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.AnalysisLens {
+	return predicate.AnalysisLens(sql.FieldEQ(FieldUserID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.AnalysisLens {
+	return predicate.AnalysisLens(sql.FieldEQ(FieldName, v))
+}
+
+// Instruction applies equality check predicate on the "instruction" field. It's identical to InstructionEQ.
+func Instruction(v string) predicate.AnalysisLens {
+	return predicate.AnalysisLens(sql.FieldEQ(FieldInstruction, v))
+}
+
+// Position applies equality check predicate on the "position" field. It's identical to PositionEQ.
+func Position(v int) predicate.AnalysisLens {
+	return predicate.AnalysisLens(sql.FieldEQ(FieldPosition, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AnalysisLens {
+	return predicate.AnalysisLens(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.AnalysisLens {
+	return predicate.AnalysisLens(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.AnalysisLens {
+	return predicate.AnalysisLens(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.AnalysisLens {
+	return predicate.AnalysisLens(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.AnalysisLens {
+	return predicate.AnalysisLens(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.AnalysisLens {
+	return predicate.AnalysisLens(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.AnalysisLens {
+	return predicate.AnalysisLens(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.AnalysisLens {
+	return predicate.AnalysisLens(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.AnalysisLens {
+	return predicate.AnalysisLens(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.AnalysisLens {
+	return predicate.AnalysisLens(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.AnalysisLens {
+	return predicate.AnalysisLens(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.AnalysisLens {
+	return predicate.AnalysisLens(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.AnalysisLens {
+	return predicate.AnalysisLens(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.AnalysisLens {
+	return predicate.AnalysisLens(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.AnalysisLens {
+	return predicate.AnalysisLens(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.AnalysisLens {
+	return predicate.AnalysisLens(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.AnalysisLens {
+	return predicate.AnalysisLens(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.AnalysisLens {
+	return predicate.AnalysisLens(sql.FieldContainsFold(FieldName, v))
+}
+
+// InstructionEQ applies the EQ predicate on the "instruction" field.
+func InstructionEQ(v string) predicate.AnalysisLens {
+	return predicate.AnalysisLens(sql.FieldEQ(FieldInstruction, v))
+}
+
+// InstructionNEQ applies the NEQ predicate on the "instruction" field.
+func InstructionNEQ(v string) predicate.AnalysisLens {
+	return predicate.AnalysisLens(sql.FieldNEQ(FieldInstruction, v))
+}
+
+// InstructionIn applies the In predicate on the "instruction" field.
+func InstructionIn(vs ...string) predicate.AnalysisLens {
+	return predicate.AnalysisLens(sql.FieldIn(FieldInstruction, vs...))
+}
+
+// InstructionNotIn applies the NotIn predicate on the "instruction" field.
+func InstructionNotIn(vs ...string) predicate.AnalysisLens {
+	return predicate.AnalysisLens(sql.FieldNotIn(FieldInstruction, vs...))
+}
+
+// InstructionGT applies the GT predicate on the "instruction" field.
+func InstructionGT(v string) predicate.AnalysisLens {
+	return predicate.AnalysisLens(sql.FieldGT(FieldInstruction, v))
+}
+
+// InstructionGTE applies the GTE predicate on the "instruction" field.
+func InstructionGTE(v string) predicate.AnalysisLens {
+	return predicate.AnalysisLens(sql.FieldGTE(FieldInstruction, v))
+}
+
+// InstructionLT applies the LT predicate on the "instruction" field.
+func InstructionLT(v string) predicate.AnalysisLens {
+	return predicate.AnalysisLens(sql.FieldLT(FieldInstruction, v))
+}
+
+// InstructionLTE applies the LTE predicate on the "instruction" field.
+func InstructionLTE(v string) predicate.AnalysisLens {
+	return predicate.AnalysisLens(sql.FieldLTE(FieldInstruction, v))
+}
+
+// InstructionContains applies the Contains predicate on the "instruction" field.
+func InstructionContains(v string) predicate.AnalysisLens {
+	return predicate.AnalysisLens(sql.FieldContains(FieldInstruction, v))
+}
+
+// InstructionHasPrefix applies the HasPrefix predicate on the "instruction" field.
+func InstructionHasPrefix(v string) predicate.AnalysisLens {
+	return predicate.AnalysisLens(sql.FieldHasPrefix(FieldInstruction, v))
+}
+
+// InstructionHasSuffix applies the HasSuffix predicate on the "instruction" field.
+func InstructionHasSuffix(v string) predicate.AnalysisLens {
+	return predicate.AnalysisLens(sql.FieldHasSuffix(FieldInstruction, v))
+}
+
+// InstructionEqualFold applies the EqualFold predicate on the "instruction" field.
+func InstructionEqualFold(v string) predicate.AnalysisLens {
+	return predicate.AnalysisLens(sql.FieldEqualFold(FieldInstruction, v))
+}
+
+// InstructionContainsFold applies the ContainsFold predicate on the "instruction" field.
+func InstructionContainsFold(v string) predicate.AnalysisLens {
+	return predicate.AnalysisLens(sql.FieldContainsFold(FieldInstruction, v))
+}
+
+// PositionEQ applies the EQ predicate on the "position" field.
+func PositionEQ(v int) predicate.AnalysisLens {
+	return predicate.AnalysisLens(sql.FieldEQ(FieldPosition, v))
+}
+
+// PositionNEQ applies the NEQ predicate on the "position" field.
+func PositionNEQ(v int) predicate.AnalysisLens {
+	return predicate.AnalysisLens(sql.FieldNEQ(FieldPosition, v))
+}
+
+// PositionIn applies the In predicate on the "position" field.
+func PositionIn(vs ...int) predicate.AnalysisLens {
+	return predicate.AnalysisLens(sql.FieldIn(FieldPosition, vs...))
+}
+
+// PositionNotIn applies the NotIn predicate on the "position" field.
+func PositionNotIn(vs ...int) predicate.AnalysisLens {
+	return predicate.AnalysisLens(sql.FieldNotIn(FieldPosition, vs...))
+}
+
+// PositionGT applies the GT predicate on the "position" field.
+func PositionGT(v int) predicate.AnalysisLens {
+	return predicate.AnalysisLens(sql.FieldGT(FieldPosition, v))
+}
+
+// PositionGTE applies the GTE predicate on the "position" field.
+func PositionGTE(v int) predicate.AnalysisLens {
+	return predicate.AnalysisLens(sql.FieldGTE(FieldPosition, v))
+}
+
+// PositionLT applies the LT predicate on the "position" field.
+func PositionLT(v int) predicate.AnalysisLens {
+	return predicate.AnalysisLens(sql.FieldLT(FieldPosition, v))
+}
+
+// PositionLTE applies the LTE predicate on the "position" field.
+func PositionLTE(v int) predicate.AnalysisLens {
+	return predicate.AnalysisLens(sql.FieldLTE(FieldPosition, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AnalysisLens {
+	return predicate.AnalysisLens(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AnalysisLens {
+	return predicate.AnalysisLens(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AnalysisLens {
+	return predicate.AnalysisLens(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AnalysisLens {
+	return predicate.AnalysisLens(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AnalysisLens {
+	return predicate.AnalysisLens(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AnalysisLens {
+	return predicate.AnalysisLens(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AnalysisLens {
+	return predicate.AnalysisLens(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AnalysisLens {
+	return predicate.AnalysisLens(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.AnalysisLens {
+	return predicate.AnalysisLens(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.AnalysisLens {
+	return predicate.AnalysisLens(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AnalysisLens) predicate.AnalysisLens {
+	return predicate.AnalysisLens(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AnalysisLens) predicate.AnalysisLens {
+	return predicate.AnalysisLens(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AnalysisLens) predicate.AnalysisLens {
+	return predicate.AnalysisLens(sql.NotPredicates(p))
+}
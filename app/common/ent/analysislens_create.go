@@ -0,0 +1,769 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/iWorld-y/domain_radar/app/common/ent/analysislens"
+	"github.com/iWorld-y/domain_radar/app/common/ent/user"
+)
+
+// AnalysisLensCreate is the builder for creating a AnalysisLens entity.
+type AnalysisLensCreate struct {
+	config
+	mutation *AnalysisLensMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *AnalysisLensCreate) SetUserID(v int) *AnalysisLensCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *AnalysisLensCreate) SetName(v string) *AnalysisLensCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetInstruction sets the "instruction" field.
+func (_c *AnalysisLensCreate) SetInstruction(v string) *AnalysisLensCreate {
+	_c.mutation.SetInstruction(v)
+	return _c
+}
+
+// SetPosition sets the "position" field.
+func (_c *AnalysisLensCreate) SetPosition(v int) *AnalysisLensCreate {
+	_c.mutation.SetPosition(v)
+	return _c
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_c *AnalysisLensCreate) SetNillablePosition(v *int) *AnalysisLensCreate {
+	if v != nil {
+		_c.SetPosition(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AnalysisLensCreate) SetCreatedAt(v time.Time) *AnalysisLensCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *AnalysisLensCreate) SetNillableCreatedAt(v *time.Time) *AnalysisLensCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *AnalysisLensCreate) SetID(v int) *AnalysisLensCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *AnalysisLensCreate) SetUser(v *User) *AnalysisLensCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the AnalysisLensMutation object of the builder.
+func (_c *AnalysisLensCreate) Mutation() *AnalysisLensMutation {
+	return _c.mutation
+}
+
+// Save creates the AnalysisLens in the database.
+func (_c *AnalysisLensCreate) Save(ctx context.Context) (*AnalysisLens, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AnalysisLensCreate) SaveX(ctx context.Context) *AnalysisLens {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AnalysisLensCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AnalysisLensCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AnalysisLensCreate) defaults() {
+	if _, ok := _c.mutation.Position(); !ok {
+		v := analysislens.DefaultPosition
+		_c.mutation.SetPosition(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := analysislens.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AnalysisLensCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "AnalysisLens.user_id"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "AnalysisLens.name"`)}
+	}
+	if _, ok := _c.mutation.Instruction(); !ok {
+		return &ValidationError{Name: "instruction", err: errors.New(`ent: missing required field "AnalysisLens.instruction"`)}
+	}
+	if _, ok := _c.mutation.Position(); !ok {
+		return &ValidationError{Name: "position", err: errors.New(`ent: missing required field "AnalysisLens.position"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AnalysisLens.created_at"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "AnalysisLens.user"`)}
+	}
+	return nil
+}
+
+func (_c *AnalysisLensCreate) sqlSave(ctx context.Context) (*AnalysisLens, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AnalysisLensCreate) createSpec() (*AnalysisLens, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AnalysisLens{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(analysislens.Table, sqlgraph.NewFieldSpec(analysislens.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(analysislens.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Instruction(); ok {
+		_spec.SetField(analysislens.FieldInstruction, field.TypeString, value)
+		_node.Instruction = value
+	}
+	if value, ok := _c.mutation.Position(); ok {
+		_spec.SetField(analysislens.FieldPosition, field.TypeInt, value)
+		_node.Position = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(analysislens.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   analysislens.UserTable,
+			Columns: []string{analysislens.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AnalysisLens.Create().
+//		SetUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AnalysisLensUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AnalysisLensCreate) OnConflict(opts ...sql.ConflictOption) *AnalysisLensUpsertOne {
+	_c.conflict = opts
+	return &AnalysisLensUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AnalysisLens.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AnalysisLensCreate) OnConflictColumns(columns ...string) *AnalysisLensUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AnalysisLensUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// AnalysisLensUpsertOne is the builder for "upsert"-ing
+	//  one AnalysisLens node.
+	AnalysisLensUpsertOne struct {
+		create *AnalysisLensCreate
+	}
+
+	// AnalysisLensUpsert is the "OnConflict" setter.
+	AnalysisLensUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUserID sets the "user_id" field.
+func (u *AnalysisLensUpsert) SetUserID(v int) *AnalysisLensUpsert {
+	u.Set(analysislens.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *AnalysisLensUpsert) UpdateUserID() *AnalysisLensUpsert {
+	u.SetExcluded(analysislens.FieldUserID)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *AnalysisLensUpsert) SetName(v string) *AnalysisLensUpsert {
+	u.Set(analysislens.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *AnalysisLensUpsert) UpdateName() *AnalysisLensUpsert {
+	u.SetExcluded(analysislens.FieldName)
+	return u
+}
+
+// SetInstruction sets the "instruction" field.
+func (u *AnalysisLensUpsert) SetInstruction(v string) *AnalysisLensUpsert {
+	u.Set(analysislens.FieldInstruction, v)
+	return u
+}
+
+// UpdateInstruction sets the "instruction" field to the value that was provided on create.
+func (u *AnalysisLensUpsert) UpdateInstruction() *AnalysisLensUpsert {
+	u.SetExcluded(analysislens.FieldInstruction)
+	return u
+}
+
+// SetPosition sets the "position" field.
+func (u *AnalysisLensUpsert) SetPosition(v int) *AnalysisLensUpsert {
+	u.Set(analysislens.FieldPosition, v)
+	return u
+}
+
+// UpdatePosition sets the "position" field to the value that was provided on create.
+func (u *AnalysisLensUpsert) UpdatePosition() *AnalysisLensUpsert {
+	u.SetExcluded(analysislens.FieldPosition)
+	return u
+}
+
+// AddPosition adds v to the "position" field.
+func (u *AnalysisLensUpsert) AddPosition(v int) *AnalysisLensUpsert {
+	u.Add(analysislens.FieldPosition, v)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *AnalysisLensUpsert) SetCreatedAt(v time.Time) *AnalysisLensUpsert {
+	u.Set(analysislens.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *AnalysisLensUpsert) UpdateCreatedAt() *AnalysisLensUpsert {
+	u.SetExcluded(analysislens.FieldCreatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.AnalysisLens.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(analysislens.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AnalysisLensUpsertOne) UpdateNewValues() *AnalysisLensUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(analysislens.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AnalysisLens.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *AnalysisLensUpsertOne) Ignore() *AnalysisLensUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AnalysisLensUpsertOne) DoNothing() *AnalysisLensUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AnalysisLensCreate.OnConflict
+// documentation for more info.
+func (u *AnalysisLensUpsertOne) Update(set func(*AnalysisLensUpsert)) *AnalysisLensUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AnalysisLensUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *AnalysisLensUpsertOne) SetUserID(v int) *AnalysisLensUpsertOne {
+	return u.Update(func(s *AnalysisLensUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *AnalysisLensUpsertOne) UpdateUserID() *AnalysisLensUpsertOne {
+	return u.Update(func(s *AnalysisLensUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *AnalysisLensUpsertOne) SetName(v string) *AnalysisLensUpsertOne {
+	return u.Update(func(s *AnalysisLensUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *AnalysisLensUpsertOne) UpdateName() *AnalysisLensUpsertOne {
+	return u.Update(func(s *AnalysisLensUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetInstruction sets the "instruction" field.
+func (u *AnalysisLensUpsertOne) SetInstruction(v string) *AnalysisLensUpsertOne {
+	return u.Update(func(s *AnalysisLensUpsert) {
+		s.SetInstruction(v)
+	})
+}
+
+// UpdateInstruction sets the "instruction" field to the value that was provided on create.
+func (u *AnalysisLensUpsertOne) UpdateInstruction() *AnalysisLensUpsertOne {
+	return u.Update(func(s *AnalysisLensUpsert) {
+		s.UpdateInstruction()
+	})
+}
+
+// SetPosition sets the "position" field.
+func (u *AnalysisLensUpsertOne) SetPosition(v int) *AnalysisLensUpsertOne {
+	return u.Update(func(s *AnalysisLensUpsert) {
+		s.SetPosition(v)
+	})
+}
+
+// AddPosition adds v to the "position" field.
+func (u *AnalysisLensUpsertOne) AddPosition(v int) *AnalysisLensUpsertOne {
+	return u.Update(func(s *AnalysisLensUpsert) {
+		s.AddPosition(v)
+	})
+}
+
+// UpdatePosition sets the "position" field to the value that was provided on create.
+func (u *AnalysisLensUpsertOne) UpdatePosition() *AnalysisLensUpsertOne {
+	return u.Update(func(s *AnalysisLensUpsert) {
+		s.UpdatePosition()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *AnalysisLensUpsertOne) SetCreatedAt(v time.Time) *AnalysisLensUpsertOne {
+	return u.Update(func(s *AnalysisLensUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *AnalysisLensUpsertOne) UpdateCreatedAt() *AnalysisLensUpsertOne {
+	return u.Update(func(s *AnalysisLensUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *AnalysisLensUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AnalysisLensCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AnalysisLensUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *AnalysisLensUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *AnalysisLensUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// AnalysisLensCreateBulk is the builder for creating many AnalysisLens entities in bulk.
+type AnalysisLensCreateBulk struct {
+	config
+	err      error
+	builders []*AnalysisLensCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the AnalysisLens entities in the database.
+func (_c *AnalysisLensCreateBulk) Save(ctx context.Context) ([]*AnalysisLens, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AnalysisLens, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AnalysisLensMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AnalysisLensCreateBulk) SaveX(ctx context.Context) []*AnalysisLens {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AnalysisLensCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AnalysisLensCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AnalysisLens.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AnalysisLensUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AnalysisLensCreateBulk) OnConflict(opts ...sql.ConflictOption) *AnalysisLensUpsertBulk {
+	_c.conflict = opts
+	return &AnalysisLensUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AnalysisLens.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AnalysisLensCreateBulk) OnConflictColumns(columns ...string) *AnalysisLensUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AnalysisLensUpsertBulk{
+		create: _c,
+	}
+}
+
+// AnalysisLensUpsertBulk is the builder for "upsert"-ing
+// a bulk of AnalysisLens nodes.
+type AnalysisLensUpsertBulk struct {
+	create *AnalysisLensCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.AnalysisLens.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(analysislens.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AnalysisLensUpsertBulk) UpdateNewValues() *AnalysisLensUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(analysislens.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AnalysisLens.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *AnalysisLensUpsertBulk) Ignore() *AnalysisLensUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AnalysisLensUpsertBulk) DoNothing() *AnalysisLensUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AnalysisLensCreateBulk.OnConflict
+// documentation for more info.
+func (u *AnalysisLensUpsertBulk) Update(set func(*AnalysisLensUpsert)) *AnalysisLensUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AnalysisLensUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *AnalysisLensUpsertBulk) SetUserID(v int) *AnalysisLensUpsertBulk {
+	return u.Update(func(s *AnalysisLensUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *AnalysisLensUpsertBulk) UpdateUserID() *AnalysisLensUpsertBulk {
+	return u.Update(func(s *AnalysisLensUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *AnalysisLensUpsertBulk) SetName(v string) *AnalysisLensUpsertBulk {
+	return u.Update(func(s *AnalysisLensUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *AnalysisLensUpsertBulk) UpdateName() *AnalysisLensUpsertBulk {
+	return u.Update(func(s *AnalysisLensUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetInstruction sets the "instruction" field.
+func (u *AnalysisLensUpsertBulk) SetInstruction(v string) *AnalysisLensUpsertBulk {
+	return u.Update(func(s *AnalysisLensUpsert) {
+		s.SetInstruction(v)
+	})
+}
+
+// UpdateInstruction sets the "instruction" field to the value that was provided on create.
+func (u *AnalysisLensUpsertBulk) UpdateInstruction() *AnalysisLensUpsertBulk {
+	return u.Update(func(s *AnalysisLensUpsert) {
+		s.UpdateInstruction()
+	})
+}
+
+// SetPosition sets the "position" field.
+func (u *AnalysisLensUpsertBulk) SetPosition(v int) *AnalysisLensUpsertBulk {
+	return u.Update(func(s *AnalysisLensUpsert) {
+		s.SetPosition(v)
+	})
+}
+
+// AddPosition adds v to the "position" field.
+func (u *AnalysisLensUpsertBulk) AddPosition(v int) *AnalysisLensUpsertBulk {
+	return u.Update(func(s *AnalysisLensUpsert) {
+		s.AddPosition(v)
+	})
+}
+
+// UpdatePosition sets the "position" field to the value that was provided on create.
+func (u *AnalysisLensUpsertBulk) UpdatePosition() *AnalysisLensUpsertBulk {
+	return u.Update(func(s *AnalysisLensUpsert) {
+		s.UpdatePosition()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *AnalysisLensUpsertBulk) SetCreatedAt(v time.Time) *AnalysisLensUpsertBulk {
+	return u.Update(func(s *AnalysisLensUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *AnalysisLensUpsertBulk) UpdateCreatedAt() *AnalysisLensUpsertBulk {
+	return u.Update(func(s *AnalysisLensUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *AnalysisLensUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the AnalysisLensCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AnalysisLensCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AnalysisLensUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/iWorld-y/domain_radar/app/common/ent/analysislens"
+	"github.com/iWorld-y/domain_radar/app/common/ent/predicate"
+)
+
+// AnalysisLensDelete is the builder for deleting a AnalysisLens entity.
+type AnalysisLensDelete struct {
+	config
+	hooks    []Hook
+	mutation *AnalysisLensMutation
+}
+
+// Where appends a list predicates to the AnalysisLensDelete builder.
+func (_d *AnalysisLensDelete) Where(ps ...predicate.AnalysisLens) *AnalysisLensDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *AnalysisLensDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *AnalysisLensDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *AnalysisLensDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(analysislens.Table, sqlgraph.NewFieldSpec(analysislens.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// AnalysisLensDeleteOne is the builder for deleting a single AnalysisLens entity.
+type AnalysisLensDeleteOne struct {
+	_d *AnalysisLensDelete
+}
+
+// Where appends a list predicates to the AnalysisLensDelete builder.
+func (_d *AnalysisLensDeleteOne) Where(ps ...predicate.AnalysisLens) *AnalysisLensDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *AnalysisLensDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{analysislens.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *AnalysisLensDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
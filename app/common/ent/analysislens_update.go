@@ -0,0 +1,479 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/iWorld-y/domain_radar/app/common/ent/analysislens"
+	"github.com/iWorld-y/domain_radar/app/common/ent/predicate"
+	"github.com/iWorld-y/domain_radar/app/common/ent/user"
+)
+
+// AnalysisLensUpdate is the builder for updating AnalysisLens entities.
+type AnalysisLensUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *AnalysisLensMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the AnalysisLensUpdate builder.
+func (_u *AnalysisLensUpdate) Where(ps ...predicate.AnalysisLens) *AnalysisLensUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *AnalysisLensUpdate) SetUserID(v int) *AnalysisLensUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *AnalysisLensUpdate) SetNillableUserID(v *int) *AnalysisLensUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *AnalysisLensUpdate) SetName(v string) *AnalysisLensUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *AnalysisLensUpdate) SetNillableName(v *string) *AnalysisLensUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetInstruction sets the "instruction" field.
+func (_u *AnalysisLensUpdate) SetInstruction(v string) *AnalysisLensUpdate {
+	_u.mutation.SetInstruction(v)
+	return _u
+}
+
+// SetNillableInstruction sets the "instruction" field if the given value is not nil.
+func (_u *AnalysisLensUpdate) SetNillableInstruction(v *string) *AnalysisLensUpdate {
+	if v != nil {
+		_u.SetInstruction(*v)
+	}
+	return _u
+}
+
+// SetPosition sets the "position" field.
+func (_u *AnalysisLensUpdate) SetPosition(v int) *AnalysisLensUpdate {
+	_u.mutation.ResetPosition()
+	_u.mutation.SetPosition(v)
+	return _u
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_u *AnalysisLensUpdate) SetNillablePosition(v *int) *AnalysisLensUpdate {
+	if v != nil {
+		_u.SetPosition(*v)
+	}
+	return _u
+}
+
+// AddPosition adds value to the "position" field.
+func (_u *AnalysisLensUpdate) AddPosition(v int) *AnalysisLensUpdate {
+	_u.mutation.AddPosition(v)
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *AnalysisLensUpdate) SetCreatedAt(v time.Time) *AnalysisLensUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *AnalysisLensUpdate) SetNillableCreatedAt(v *time.Time) *AnalysisLensUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *AnalysisLensUpdate) SetUser(v *User) *AnalysisLensUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the AnalysisLensMutation object of the builder.
+func (_u *AnalysisLensUpdate) Mutation() *AnalysisLensMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *AnalysisLensUpdate) ClearUser() *AnalysisLensUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AnalysisLensUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AnalysisLensUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AnalysisLensUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AnalysisLensUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AnalysisLensUpdate) check() error {
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AnalysisLens.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AnalysisLensUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AnalysisLensUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *AnalysisLensUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(analysislens.Table, analysislens.Columns, sqlgraph.NewFieldSpec(analysislens.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(analysislens.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Instruction(); ok {
+		_spec.SetField(analysislens.FieldInstruction, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Position(); ok {
+		_spec.SetField(analysislens.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPosition(); ok {
+		_spec.AddField(analysislens.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(analysislens.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   analysislens.UserTable,
+			Columns: []string{analysislens.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   analysislens.UserTable,
+			Columns: []string{analysislens.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{analysislens.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AnalysisLensUpdateOne is the builder for updating a single AnalysisLens entity.
+type AnalysisLensUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *AnalysisLensMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *AnalysisLensUpdateOne) SetUserID(v int) *AnalysisLensUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *AnalysisLensUpdateOne) SetNillableUserID(v *int) *AnalysisLensUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *AnalysisLensUpdateOne) SetName(v string) *AnalysisLensUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *AnalysisLensUpdateOne) SetNillableName(v *string) *AnalysisLensUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetInstruction sets the "instruction" field.
+func (_u *AnalysisLensUpdateOne) SetInstruction(v string) *AnalysisLensUpdateOne {
+	_u.mutation.SetInstruction(v)
+	return _u
+}
+
+// SetNillableInstruction sets the "instruction" field if the given value is not nil.
+func (_u *AnalysisLensUpdateOne) SetNillableInstruction(v *string) *AnalysisLensUpdateOne {
+	if v != nil {
+		_u.SetInstruction(*v)
+	}
+	return _u
+}
+
+// SetPosition sets the "position" field.
+func (_u *AnalysisLensUpdateOne) SetPosition(v int) *AnalysisLensUpdateOne {
+	_u.mutation.ResetPosition()
+	_u.mutation.SetPosition(v)
+	return _u
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_u *AnalysisLensUpdateOne) SetNillablePosition(v *int) *AnalysisLensUpdateOne {
+	if v != nil {
+		_u.SetPosition(*v)
+	}
+	return _u
+}
+
+// AddPosition adds value to the "position" field.
+func (_u *AnalysisLensUpdateOne) AddPosition(v int) *AnalysisLensUpdateOne {
+	_u.mutation.AddPosition(v)
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *AnalysisLensUpdateOne) SetCreatedAt(v time.Time) *AnalysisLensUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *AnalysisLensUpdateOne) SetNillableCreatedAt(v *time.Time) *AnalysisLensUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *AnalysisLensUpdateOne) SetUser(v *User) *AnalysisLensUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the AnalysisLensMutation object of the builder.
+func (_u *AnalysisLensUpdateOne) Mutation() *AnalysisLensMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *AnalysisLensUpdateOne) ClearUser() *AnalysisLensUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Where appends a list predicates to the AnalysisLensUpdate builder.
+func (_u *AnalysisLensUpdateOne) Where(ps ...predicate.AnalysisLens) *AnalysisLensUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AnalysisLensUpdateOne) Select(field string, fields ...string) *AnalysisLensUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AnalysisLens entity.
+func (_u *AnalysisLensUpdateOne) Save(ctx context.Context) (*AnalysisLens, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AnalysisLensUpdateOne) SaveX(ctx context.Context) *AnalysisLens {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AnalysisLensUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AnalysisLensUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AnalysisLensUpdateOne) check() error {
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AnalysisLens.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AnalysisLensUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AnalysisLensUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *AnalysisLensUpdateOne) sqlSave(ctx context.Context) (_node *AnalysisLens, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(analysislens.Table, analysislens.Columns, sqlgraph.NewFieldSpec(analysislens.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AnalysisLens.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, analysislens.FieldID)
+		for _, f := range fields {
+			if !analysislens.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != analysislens.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(analysislens.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Instruction(); ok {
+		_spec.SetField(analysislens.FieldInstruction, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Position(); ok {
+		_spec.SetField(analysislens.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPosition(); ok {
+		_spec.AddField(analysislens.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(analysislens.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   analysislens.UserTable,
+			Columns: []string{analysislens.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   analysislens.UserTable,
+			Columns: []string{analysislens.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &AnalysisLens{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{analysislens.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,165 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/iWorld-y/domain_radar/app/common/ent/analysissection"
+	"github.com/iWorld-y/domain_radar/app/common/ent/deepanalysisresult"
+)
+
+// AnalysisSection is the model entity for the AnalysisSection schema.
+type AnalysisSection struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// DeepAnalysisID holds the value of the "deep_analysis_id" field.
+	DeepAnalysisID int `json:"deep_analysis_id,omitempty"`
+	// Name of the user-defined lens the section was written for
+	Name string `json:"name,omitempty"`
+	// Section body in Markdown
+	Content string `json:"content,omitempty"`
+	// Display order within the deep analysis, ascending
+	Position int `json:"position,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AnalysisSectionQuery when eager-loading is set.
+	Edges        AnalysisSectionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// AnalysisSectionEdges holds the relations/edges for other nodes in the graph.
+type AnalysisSectionEdges struct {
+	// DeepAnalysisResult holds the value of the deep_analysis_result edge.
+	DeepAnalysisResult *DeepAnalysisResult `json:"deep_analysis_result,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// DeepAnalysisResultOrErr returns the DeepAnalysisResult value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AnalysisSectionEdges) DeepAnalysisResultOrErr() (*DeepAnalysisResult, error) {
+	if e.DeepAnalysisResult != nil {
+		return e.DeepAnalysisResult, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: deepanalysisresult.Label}
+	}
+	return nil, &NotLoadedError{edge: "deep_analysis_result"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AnalysisSection) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case analysissection.FieldID, analysissection.FieldDeepAnalysisID, analysissection.FieldPosition:
+			values[i] = new(sql.NullInt64)
+		case analysissection.FieldName, analysissection.FieldContent:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AnalysisSection fields.
+func (_m *AnalysisSection) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case analysissection.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case analysissection.FieldDeepAnalysisID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field deep_analysis_id", values[i])
+			} else if value.Valid {
+				_m.DeepAnalysisID = int(value.Int64)
+			}
+		case analysissection.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case analysissection.FieldContent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content", values[i])
+			} else if value.Valid {
+				_m.Content = value.String
+			}
+		case analysissection.FieldPosition:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field position", values[i])
+			} else if value.Valid {
+				_m.Position = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AnalysisSection.
+// This includes values selected through modifiers, order, etc.
+func (_m *AnalysisSection) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryDeepAnalysisResult queries the "deep_analysis_result" edge of the AnalysisSection entity.
+func (_m *AnalysisSection) QueryDeepAnalysisResult() *DeepAnalysisResultQuery {
+	return NewAnalysisSectionClient(_m.config).QueryDeepAnalysisResult(_m)
+}
+
+// Update returns a builder for updating this AnalysisSection.
+// Note that you need to call AnalysisSection.Unwrap() before calling this method if this AnalysisSection
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *AnalysisSection) Update() *AnalysisSectionUpdateOne {
+	return NewAnalysisSectionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the AnalysisSection entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *AnalysisSection) Unwrap() *AnalysisSection {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AnalysisSection is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *AnalysisSection) String() string {
+	var builder strings.Builder
+	builder.WriteString("AnalysisSection(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("deep_analysis_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DeepAnalysisID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("content=")
+	builder.WriteString(_m.Content)
+	builder.WriteString(", ")
+	builder.WriteString("position=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Position))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AnalysisSections is a parsable slice of AnalysisSection.
+type AnalysisSections []*AnalysisSection
@@ -0,0 +1,100 @@
+// Code generated by ent, DO NOT EDIT.
+
+package analysissection
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the analysissection type in the database.
+	Label = "analysis_section"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldDeepAnalysisID holds the string denoting the deep_analysis_id field in the database.
+	FieldDeepAnalysisID = "deep_analysis_id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldContent holds the string denoting the content field in the database.
+	FieldContent = "content"
+	// FieldPosition holds the string denoting the position field in the database.
+	FieldPosition = "position"
+	// EdgeDeepAnalysisResult holds the string denoting the deep_analysis_result edge name in mutations.
+	EdgeDeepAnalysisResult = "deep_analysis_result"
+	// Table holds the table name of the analysissection in the database.
+	Table = "analysis_sections"
+	// DeepAnalysisResultTable is the table that holds the deep_analysis_result relation/edge.
+	DeepAnalysisResultTable = "analysis_sections"
+	// DeepAnalysisResultInverseTable is the table name for the DeepAnalysisResult entity.
+	// It exists in this package in order to avoid circular dependency with the "deepanalysisresult" package.
+	DeepAnalysisResultInverseTable = "deep_analysis_results"
+	// DeepAnalysisResultColumn is the table column denoting the deep_analysis_result relation/edge.
+	DeepAnalysisResultColumn = "deep_analysis_id"
+)
+
+// Columns holds all SQL columns for analysissection fields.
+var Columns = []string{
+	FieldID,
+	FieldDeepAnalysisID,
+	FieldName,
+	FieldContent,
+	FieldPosition,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultPosition holds the default value on creation for the "position" field.
+	DefaultPosition int
+)
+
+// OrderOption defines the ordering options for the AnalysisSection queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByDeepAnalysisID orders the results by the deep_analysis_id field.
+func ByDeepAnalysisID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeepAnalysisID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByContent orders the results by the content field.
+func ByContent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContent, opts...).ToFunc()
+}
+
+// ByPosition orders the results by the position field.
+func ByPosition(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPosition, opts...).ToFunc()
+}
+
+// ByDeepAnalysisResultField orders the results by deep_analysis_result field.
+func ByDeepAnalysisResultField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDeepAnalysisResultStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newDeepAnalysisResultStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DeepAnalysisResultInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, DeepAnalysisResultTable, DeepAnalysisResultColumn),
+	)
+}
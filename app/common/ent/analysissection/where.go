@@ -0,0 +1,322 @@
+// Code generated by ent, DO NOT EDIT.
+
+package analysissection
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/iWorld-y/domain_radar/app/common/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.AnalysisSection {
+	return predicate.AnalysisSection(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.AnalysisSection {
+	return predicate.AnalysisSection(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.AnalysisSection {
+	return predicate.AnalysisSection(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.AnalysisSection {
+	return predicate.AnalysisSection(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.AnalysisSection {
+	return predicate.AnalysisSection(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.AnalysisSection {
+	return predicate.AnalysisSection(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.AnalysisSection {
+	return predicate.AnalysisSection(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.AnalysisSection {
+	return predicate.AnalysisSection(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.AnalysisSection {
+	return predicate.AnalysisSection(sql.FieldLTE(FieldID, id))
+}
+
+// DeepAnalysisID applies equality check predicate on the "deep_analysis_id" field. It's identical to DeepAnalysisIDEQ.
+func DeepAnalysisID(v int) predicate.AnalysisSection {
+	return predicate.AnalysisSection(sql.FieldEQ(FieldDeepAnalysisID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.AnalysisSection {
+	return predicate.AnalysisSection(sql.FieldEQ(FieldName, v))
+}
+
+// Content applies equality check predicate on the "content" field. It's identical to ContentEQ.
+func Content(v string) predicate.AnalysisSection {
+	return predicate.AnalysisSection(sql.FieldEQ(FieldContent, v))
+}
+
+// Position applies equality check predicate on the "position" field. It's identical to PositionEQ.
+func Position(v int) predicate.AnalysisSection {
+	return predicate.AnalysisSection(sql.FieldEQ(FieldPosition, v))
+}
+
+// DeepAnalysisIDEQ applies the EQ predicate on the "deep_analysis_id" field.
+func DeepAnalysisIDEQ(v int) predicate.AnalysisSection {
+	return predicate.AnalysisSection(sql.FieldEQ(FieldDeepAnalysisID, v))
+}
+
+// DeepAnalysisIDNEQ applies the NEQ predicate on the "deep_analysis_id" field.
+func DeepAnalysisIDNEQ(v int) predicate.AnalysisSection {
+	return predicate.AnalysisSection(sql.FieldNEQ(FieldDeepAnalysisID, v))
+}
+
+// DeepAnalysisIDIn applies the In predicate on the "deep_analysis_id" field.
+func DeepAnalysisIDIn(vs ...int) predicate.AnalysisSection {
+	return predicate.AnalysisSection(sql.FieldIn(FieldDeepAnalysisID, vs...))
+}
+
+// DeepAnalysisIDNotIn applies the NotIn predicate on the "deep_analysis_id" field.
+func DeepAnalysisIDNotIn(vs ...int) predicate.AnalysisSection {
+	return predicate.AnalysisSection(sql.FieldNotIn(FieldDeepAnalysisID, vs...))
+}
+
+// DeepAnalysisIDIsNil applies the IsNil predicate on the "deep_analysis_id" field.
+func DeepAnalysisIDIsNil() predicate.AnalysisSection {
+	return predicate.AnalysisSection(sql.FieldIsNull(FieldDeepAnalysisID))
+}
+
+// DeepAnalysisIDNotNil applies the NotNil predicate on the "deep_analysis_id" field.
+func DeepAnalysisIDNotNil() predicate.AnalysisSection {
+	return predicate.AnalysisSection(sql.FieldNotNull(FieldDeepAnalysisID))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.AnalysisSection {
+	return predicate.AnalysisSection(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.AnalysisSection {
+	return predicate.AnalysisSection(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.AnalysisSection {
+	return predicate.AnalysisSection(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.AnalysisSection {
+	return predicate.AnalysisSection(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.AnalysisSection {
+	return predicate.AnalysisSection(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.AnalysisSection {
+	return predicate.AnalysisSection(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.AnalysisSection {
+	return predicate.AnalysisSection(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.AnalysisSection {
+	return predicate.AnalysisSection(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.AnalysisSection {
+	return predicate.AnalysisSection(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.AnalysisSection {
+	return predicate.AnalysisSection(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.AnalysisSection {
+	return predicate.AnalysisSection(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.AnalysisSection {
+	return predicate.AnalysisSection(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.AnalysisSection {
+	return predicate.AnalysisSection(sql.FieldContainsFold(FieldName, v))
+}
+
+// ContentEQ applies the EQ predicate on the "content" field.
+func ContentEQ(v string) predicate.AnalysisSection {
+	return predicate.AnalysisSection(sql.FieldEQ(FieldContent, v))
+}
+
+// ContentNEQ applies the NEQ predicate on the "content" field.
+func ContentNEQ(v string) predicate.AnalysisSection {
+	return predicate.AnalysisSection(sql.FieldNEQ(FieldContent, v))
+}
+
+// ContentIn applies the In predicate on the "content" field.
+func ContentIn(vs ...string) predicate.AnalysisSection {
+	return predicate.AnalysisSection(sql.FieldIn(FieldContent, vs...))
+}
+
+// ContentNotIn applies the NotIn predicate on the "content" field.
+func ContentNotIn(vs ...string) predicate.AnalysisSection {
+	return predicate.AnalysisSection(sql.FieldNotIn(FieldContent, vs...))
+}
+
+// ContentGT applies the GT predicate on the "content" field.
+func ContentGT(v string) predicate.AnalysisSection {
+	return predicate.AnalysisSection(sql.FieldGT(FieldContent, v))
+}
+
+// ContentGTE applies the GTE predicate on the "content" field.
+func ContentGTE(v string) predicate.AnalysisSection {
+	return predicate.AnalysisSection(sql.FieldGTE(FieldContent, v))
+}
+
+// ContentLT applies the LT predicate on the "content" field.
+func ContentLT(v string) predicate.AnalysisSection {
+	return predicate.AnalysisSection(sql.FieldLT(FieldContent, v))
+}
+
+// ContentLTE applies the LTE predicate on the "content" field.
+func ContentLTE(v string) predicate.AnalysisSection {
+	return predicate.AnalysisSection(sql.FieldLTE(FieldContent, v))
+}
+
+// ContentContains applies the Contains predicate on the "content" field.
+func ContentContains(v string) predicate.AnalysisSection {
+	return predicate.AnalysisSection(sql.FieldContains(FieldContent, v))
+}
+
+// ContentHasPrefix applies the HasPrefix predicate on the "content" field.
+func ContentHasPrefix(v string) predicate.AnalysisSection {
+	return predicate.AnalysisSection(sql.FieldHasPrefix(FieldContent, v))
+}
+
+// ContentHasSuffix applies the HasSuffix predicate on the "content" field.
+func ContentHasSuffix(v string) predicate.AnalysisSection {
+	return predicate.AnalysisSection(sql.FieldHasSuffix(FieldContent, v))
+}
+
+// ContentIsNil applies the IsNil predicate on the "content" field.
+func ContentIsNil() predicate.AnalysisSection {
+	return predicate.AnalysisSection(sql.FieldIsNull(FieldContent))
+}
+
+// ContentNotNil applies the NotNil predicate on the "content" field.
+func ContentNotNil() predicate.AnalysisSection {
+	return predicate.AnalysisSection(sql.FieldNotNull(FieldContent))
+}
+
+// ContentEqualFold applies the EqualFold predicate on the "content" field.
+func ContentEqualFold(v string) predicate.AnalysisSection {
+	return predicate.AnalysisSection(sql.FieldEqualFold(FieldContent, v))
+}
+
+// ContentContainsFold applies the ContainsFold predicate on the "content" field.
+func ContentContainsFold(v string) predicate.AnalysisSection {
+	return predicate.AnalysisSection(sql.FieldContainsFold(FieldContent, v))
+}
+
+// PositionEQ applies the EQ predicate on the "position" field.
+func PositionEQ(v int) predicate.AnalysisSection {
+	return predicate.AnalysisSection(sql.FieldEQ(FieldPosition, v))
+}
+
+// PositionNEQ applies the NEQ predicate on the "position" field.
+func PositionNEQ(v int) predicate.AnalysisSection {
+	return predicate.AnalysisSection(sql.FieldNEQ(FieldPosition, v))
+}
+
+// PositionIn applies the In predicate on the "position" field.
+func PositionIn(vs ...int) predicate.AnalysisSection {
+	return predicate.AnalysisSection(sql.FieldIn(FieldPosition, vs...))
+}
+
+// PositionNotIn applies the NotIn predicate on the "position" field.
+func PositionNotIn(vs ...int) predicate.AnalysisSection {
+	return predicate.AnalysisSection(sql.FieldNotIn(FieldPosition, vs...))
+}
+
+// PositionGT applies the GT predicate on the "position" field.
+func PositionGT(v int) predicate.AnalysisSection {
+	return predicate.AnalysisSection(sql.FieldGT(FieldPosition, v))
+}
+
+// PositionGTE applies the GTE predicate on the "position" field.
+func PositionGTE(v int) predicate.AnalysisSection {
+	return predicate.AnalysisSection(sql.FieldGTE(FieldPosition, v))
+}
+
+// PositionLT applies the LT predicate on the "position" field.
+func PositionLT(v int) predicate.AnalysisSection {
+	return predicate.AnalysisSection(sql.FieldLT(FieldPosition, v))
+}
+
+// PositionLTE applies the LTE predicate on the "position" field.
+func PositionLTE(v int) predicate.AnalysisSection {
+	return predicate.AnalysisSection(sql.FieldLTE(FieldPosition, v))
+}
+
+// HasDeepAnalysisResult applies the HasEdge predicate on the "deep_analysis_result" edge.
+func HasDeepAnalysisResult() predicate.AnalysisSection {
+	return predicate.AnalysisSection(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, DeepAnalysisResultTable, DeepAnalysisResultColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDeepAnalysisResultWith applies the HasEdge predicate on the "deep_analysis_result" edge with a given conditions (other predicates).
+func HasDeepAnalysisResultWith(preds ...predicate.DeepAnalysisResult) predicate.AnalysisSection {
+	return predicate.AnalysisSection(func(s *sql.Selector) {
+		step := newDeepAnalysisResultStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AnalysisSection) predicate.AnalysisSection {
+	return predicate.AnalysisSection(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AnalysisSection) predicate.AnalysisSection {
+	return predicate.AnalysisSection(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AnalysisSection) predicate.AnalysisSection {
+	return predicate.AnalysisSection(sql.NotPredicates(p))
+}
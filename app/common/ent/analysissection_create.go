@@ -0,0 +1,764 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/iWorld-y/domain_radar/app/common/ent/analysissection"
+	"github.com/iWorld-y/domain_radar/app/common/ent/deepanalysisresult"
+)
+
+// AnalysisSectionCreate is the builder for creating a AnalysisSection entity.
+type AnalysisSectionCreate struct {
+	config
+	mutation *AnalysisSectionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetDeepAnalysisID sets the "deep_analysis_id" field.
+func (_c *AnalysisSectionCreate) SetDeepAnalysisID(v int) *AnalysisSectionCreate {
+	_c.mutation.SetDeepAnalysisID(v)
+	return _c
+}
+
+// SetNillableDeepAnalysisID sets the "deep_analysis_id" field if the given value is not nil.
+func (_c *AnalysisSectionCreate) SetNillableDeepAnalysisID(v *int) *AnalysisSectionCreate {
+	if v != nil {
+		_c.SetDeepAnalysisID(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *AnalysisSectionCreate) SetName(v string) *AnalysisSectionCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetContent sets the "content" field.
+func (_c *AnalysisSectionCreate) SetContent(v string) *AnalysisSectionCreate {
+	_c.mutation.SetContent(v)
+	return _c
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (_c *AnalysisSectionCreate) SetNillableContent(v *string) *AnalysisSectionCreate {
+	if v != nil {
+		_c.SetContent(*v)
+	}
+	return _c
+}
+
+// SetPosition sets the "position" field.
+func (_c *AnalysisSectionCreate) SetPosition(v int) *AnalysisSectionCreate {
+	_c.mutation.SetPosition(v)
+	return _c
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_c *AnalysisSectionCreate) SetNillablePosition(v *int) *AnalysisSectionCreate {
+	if v != nil {
+		_c.SetPosition(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *AnalysisSectionCreate) SetID(v int) *AnalysisSectionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetDeepAnalysisResultID sets the "deep_analysis_result" edge to the DeepAnalysisResult entity by ID.
+func (_c *AnalysisSectionCreate) SetDeepAnalysisResultID(id int) *AnalysisSectionCreate {
+	_c.mutation.SetDeepAnalysisResultID(id)
+	return _c
+}
+
+// SetNillableDeepAnalysisResultID sets the "deep_analysis_result" edge to the DeepAnalysisResult entity by ID if the given value is not nil.
+func (_c *AnalysisSectionCreate) SetNillableDeepAnalysisResultID(id *int) *AnalysisSectionCreate {
+	if id != nil {
+		_c = _c.SetDeepAnalysisResultID(*id)
+	}
+	return _c
+}
+
+// SetDeepAnalysisResult sets the "deep_analysis_result" edge to the DeepAnalysisResult entity.
+func (_c *AnalysisSectionCreate) SetDeepAnalysisResult(v *DeepAnalysisResult) *AnalysisSectionCreate {
+	return _c.SetDeepAnalysisResultID(v.ID)
+}
+
+// Mutation returns the AnalysisSectionMutation object of the builder.
+func (_c *AnalysisSectionCreate) Mutation() *AnalysisSectionMutation {
+	return _c.mutation
+}
+
+// Save creates the AnalysisSection in the database.
+func (_c *AnalysisSectionCreate) Save(ctx context.Context) (*AnalysisSection, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AnalysisSectionCreate) SaveX(ctx context.Context) *AnalysisSection {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AnalysisSectionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AnalysisSectionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AnalysisSectionCreate) defaults() {
+	if _, ok := _c.mutation.Position(); !ok {
+		v := analysissection.DefaultPosition
+		_c.mutation.SetPosition(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AnalysisSectionCreate) check() error {
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "AnalysisSection.name"`)}
+	}
+	if _, ok := _c.mutation.Position(); !ok {
+		return &ValidationError{Name: "position", err: errors.New(`ent: missing required field "AnalysisSection.position"`)}
+	}
+	return nil
+}
+
+func (_c *AnalysisSectionCreate) sqlSave(ctx context.Context) (*AnalysisSection, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AnalysisSectionCreate) createSpec() (*AnalysisSection, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AnalysisSection{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(analysissection.Table, sqlgraph.NewFieldSpec(analysissection.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(analysissection.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Content(); ok {
+		_spec.SetField(analysissection.FieldContent, field.TypeString, value)
+		_node.Content = value
+	}
+	if value, ok := _c.mutation.Position(); ok {
+		_spec.SetField(analysissection.FieldPosition, field.TypeInt, value)
+		_node.Position = value
+	}
+	if nodes := _c.mutation.DeepAnalysisResultIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   analysissection.DeepAnalysisResultTable,
+			Columns: []string{analysissection.DeepAnalysisResultColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deepanalysisresult.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.DeepAnalysisID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AnalysisSection.Create().
+//		SetDeepAnalysisID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AnalysisSectionUpsert) {
+//			SetDeepAnalysisID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AnalysisSectionCreate) OnConflict(opts ...sql.ConflictOption) *AnalysisSectionUpsertOne {
+	_c.conflict = opts
+	return &AnalysisSectionUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AnalysisSection.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AnalysisSectionCreate) OnConflictColumns(columns ...string) *AnalysisSectionUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AnalysisSectionUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// AnalysisSectionUpsertOne is the builder for "upsert"-ing
+	//  one AnalysisSection node.
+	AnalysisSectionUpsertOne struct {
+		create *AnalysisSectionCreate
+	}
+
+	// AnalysisSectionUpsert is the "OnConflict" setter.
+	AnalysisSectionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetDeepAnalysisID sets the "deep_analysis_id" field.
+func (u *AnalysisSectionUpsert) SetDeepAnalysisID(v int) *AnalysisSectionUpsert {
+	u.Set(analysissection.FieldDeepAnalysisID, v)
+	return u
+}
+
+// UpdateDeepAnalysisID sets the "deep_analysis_id" field to the value that was provided on create.
+func (u *AnalysisSectionUpsert) UpdateDeepAnalysisID() *AnalysisSectionUpsert {
+	u.SetExcluded(analysissection.FieldDeepAnalysisID)
+	return u
+}
+
+// ClearDeepAnalysisID clears the value of the "deep_analysis_id" field.
+func (u *AnalysisSectionUpsert) ClearDeepAnalysisID() *AnalysisSectionUpsert {
+	u.SetNull(analysissection.FieldDeepAnalysisID)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *AnalysisSectionUpsert) SetName(v string) *AnalysisSectionUpsert {
+	u.Set(analysissection.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *AnalysisSectionUpsert) UpdateName() *AnalysisSectionUpsert {
+	u.SetExcluded(analysissection.FieldName)
+	return u
+}
+
+// SetContent sets the "content" field.
+func (u *AnalysisSectionUpsert) SetContent(v string) *AnalysisSectionUpsert {
+	u.Set(analysissection.FieldContent, v)
+	return u
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *AnalysisSectionUpsert) UpdateContent() *AnalysisSectionUpsert {
+	u.SetExcluded(analysissection.FieldContent)
+	return u
+}
+
+// ClearContent clears the value of the "content" field.
+func (u *AnalysisSectionUpsert) ClearContent() *AnalysisSectionUpsert {
+	u.SetNull(analysissection.FieldContent)
+	return u
+}
+
+// SetPosition sets the "position" field.
+func (u *AnalysisSectionUpsert) SetPosition(v int) *AnalysisSectionUpsert {
+	u.Set(analysissection.FieldPosition, v)
+	return u
+}
+
+// UpdatePosition sets the "position" field to the value that was provided on create.
+func (u *AnalysisSectionUpsert) UpdatePosition() *AnalysisSectionUpsert {
+	u.SetExcluded(analysissection.FieldPosition)
+	return u
+}
+
+// AddPosition adds v to the "position" field.
+func (u *AnalysisSectionUpsert) AddPosition(v int) *AnalysisSectionUpsert {
+	u.Add(analysissection.FieldPosition, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.AnalysisSection.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(analysissection.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AnalysisSectionUpsertOne) UpdateNewValues() *AnalysisSectionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(analysissection.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AnalysisSection.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *AnalysisSectionUpsertOne) Ignore() *AnalysisSectionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AnalysisSectionUpsertOne) DoNothing() *AnalysisSectionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AnalysisSectionCreate.OnConflict
+// documentation for more info.
+func (u *AnalysisSectionUpsertOne) Update(set func(*AnalysisSectionUpsert)) *AnalysisSectionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AnalysisSectionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetDeepAnalysisID sets the "deep_analysis_id" field.
+func (u *AnalysisSectionUpsertOne) SetDeepAnalysisID(v int) *AnalysisSectionUpsertOne {
+	return u.Update(func(s *AnalysisSectionUpsert) {
+		s.SetDeepAnalysisID(v)
+	})
+}
+
+// UpdateDeepAnalysisID sets the "deep_analysis_id" field to the value that was provided on create.
+func (u *AnalysisSectionUpsertOne) UpdateDeepAnalysisID() *AnalysisSectionUpsertOne {
+	return u.Update(func(s *AnalysisSectionUpsert) {
+		s.UpdateDeepAnalysisID()
+	})
+}
+
+// ClearDeepAnalysisID clears the value of the "deep_analysis_id" field.
+func (u *AnalysisSectionUpsertOne) ClearDeepAnalysisID() *AnalysisSectionUpsertOne {
+	return u.Update(func(s *AnalysisSectionUpsert) {
+		s.ClearDeepAnalysisID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *AnalysisSectionUpsertOne) SetName(v string) *AnalysisSectionUpsertOne {
+	return u.Update(func(s *AnalysisSectionUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *AnalysisSectionUpsertOne) UpdateName() *AnalysisSectionUpsertOne {
+	return u.Update(func(s *AnalysisSectionUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetContent sets the "content" field.
+func (u *AnalysisSectionUpsertOne) SetContent(v string) *AnalysisSectionUpsertOne {
+	return u.Update(func(s *AnalysisSectionUpsert) {
+		s.SetContent(v)
+	})
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *AnalysisSectionUpsertOne) UpdateContent() *AnalysisSectionUpsertOne {
+	return u.Update(func(s *AnalysisSectionUpsert) {
+		s.UpdateContent()
+	})
+}
+
+// ClearContent clears the value of the "content" field.
+func (u *AnalysisSectionUpsertOne) ClearContent() *AnalysisSectionUpsertOne {
+	return u.Update(func(s *AnalysisSectionUpsert) {
+		s.ClearContent()
+	})
+}
+
+// SetPosition sets the "position" field.
+func (u *AnalysisSectionUpsertOne) SetPosition(v int) *AnalysisSectionUpsertOne {
+	return u.Update(func(s *AnalysisSectionUpsert) {
+		s.SetPosition(v)
+	})
+}
+
+// AddPosition adds v to the "position" field.
+func (u *AnalysisSectionUpsertOne) AddPosition(v int) *AnalysisSectionUpsertOne {
+	return u.Update(func(s *AnalysisSectionUpsert) {
+		s.AddPosition(v)
+	})
+}
+
+// UpdatePosition sets the "position" field to the value that was provided on create.
+func (u *AnalysisSectionUpsertOne) UpdatePosition() *AnalysisSectionUpsertOne {
+	return u.Update(func(s *AnalysisSectionUpsert) {
+		s.UpdatePosition()
+	})
+}
+
+// Exec executes the query.
+func (u *AnalysisSectionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AnalysisSectionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AnalysisSectionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *AnalysisSectionUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *AnalysisSectionUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// AnalysisSectionCreateBulk is the builder for creating many AnalysisSection entities in bulk.
+type AnalysisSectionCreateBulk struct {
+	config
+	err      error
+	builders []*AnalysisSectionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the AnalysisSection entities in the database.
+func (_c *AnalysisSectionCreateBulk) Save(ctx context.Context) ([]*AnalysisSection, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AnalysisSection, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AnalysisSectionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AnalysisSectionCreateBulk) SaveX(ctx context.Context) []*AnalysisSection {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AnalysisSectionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AnalysisSectionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AnalysisSection.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AnalysisSectionUpsert) {
+//			SetDeepAnalysisID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AnalysisSectionCreateBulk) OnConflict(opts ...sql.ConflictOption) *AnalysisSectionUpsertBulk {
+	_c.conflict = opts
+	return &AnalysisSectionUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AnalysisSection.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AnalysisSectionCreateBulk) OnConflictColumns(columns ...string) *AnalysisSectionUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AnalysisSectionUpsertBulk{
+		create: _c,
+	}
+}
+
+// AnalysisSectionUpsertBulk is the builder for "upsert"-ing
+// a bulk of AnalysisSection nodes.
+type AnalysisSectionUpsertBulk struct {
+	create *AnalysisSectionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.AnalysisSection.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(analysissection.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AnalysisSectionUpsertBulk) UpdateNewValues() *AnalysisSectionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(analysissection.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AnalysisSection.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *AnalysisSectionUpsertBulk) Ignore() *AnalysisSectionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AnalysisSectionUpsertBulk) DoNothing() *AnalysisSectionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AnalysisSectionCreateBulk.OnConflict
+// documentation for more info.
+func (u *AnalysisSectionUpsertBulk) Update(set func(*AnalysisSectionUpsert)) *AnalysisSectionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AnalysisSectionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetDeepAnalysisID sets the "deep_analysis_id" field.
+func (u *AnalysisSectionUpsertBulk) SetDeepAnalysisID(v int) *AnalysisSectionUpsertBulk {
+	return u.Update(func(s *AnalysisSectionUpsert) {
+		s.SetDeepAnalysisID(v)
+	})
+}
+
+// UpdateDeepAnalysisID sets the "deep_analysis_id" field to the value that was provided on create.
+func (u *AnalysisSectionUpsertBulk) UpdateDeepAnalysisID() *AnalysisSectionUpsertBulk {
+	return u.Update(func(s *AnalysisSectionUpsert) {
+		s.UpdateDeepAnalysisID()
+	})
+}
+
+// ClearDeepAnalysisID clears the value of the "deep_analysis_id" field.
+func (u *AnalysisSectionUpsertBulk) ClearDeepAnalysisID() *AnalysisSectionUpsertBulk {
+	return u.Update(func(s *AnalysisSectionUpsert) {
+		s.ClearDeepAnalysisID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *AnalysisSectionUpsertBulk) SetName(v string) *AnalysisSectionUpsertBulk {
+	return u.Update(func(s *AnalysisSectionUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *AnalysisSectionUpsertBulk) UpdateName() *AnalysisSectionUpsertBulk {
+	return u.Update(func(s *AnalysisSectionUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetContent sets the "content" field.
+func (u *AnalysisSectionUpsertBulk) SetContent(v string) *AnalysisSectionUpsertBulk {
+	return u.Update(func(s *AnalysisSectionUpsert) {
+		s.SetContent(v)
+	})
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *AnalysisSectionUpsertBulk) UpdateContent() *AnalysisSectionUpsertBulk {
+	return u.Update(func(s *AnalysisSectionUpsert) {
+		s.UpdateContent()
+	})
+}
+
+// ClearContent clears the value of the "content" field.
+func (u *AnalysisSectionUpsertBulk) ClearContent() *AnalysisSectionUpsertBulk {
+	return u.Update(func(s *AnalysisSectionUpsert) {
+		s.ClearContent()
+	})
+}
+
+// SetPosition sets the "position" field.
+func (u *AnalysisSectionUpsertBulk) SetPosition(v int) *AnalysisSectionUpsertBulk {
+	return u.Update(func(s *AnalysisSectionUpsert) {
+		s.SetPosition(v)
+	})
+}
+
+// AddPosition adds v to the "position" field.
+func (u *AnalysisSectionUpsertBulk) AddPosition(v int) *AnalysisSectionUpsertBulk {
+	return u.Update(func(s *AnalysisSectionUpsert) {
+		s.AddPosition(v)
+	})
+}
+
+// UpdatePosition sets the "position" field to the value that was provided on create.
+func (u *AnalysisSectionUpsertBulk) UpdatePosition() *AnalysisSectionUpsertBulk {
+	return u.Update(func(s *AnalysisSectionUpsert) {
+		s.UpdatePosition()
+	})
+}
+
+// Exec executes the query.
+func (u *AnalysisSectionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the AnalysisSectionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AnalysisSectionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AnalysisSectionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
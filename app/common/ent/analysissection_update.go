@@ -0,0 +1,480 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/iWorld-y/domain_radar/app/common/ent/analysissection"
+	"github.com/iWorld-y/domain_radar/app/common/ent/deepanalysisresult"
+	"github.com/iWorld-y/domain_radar/app/common/ent/predicate"
+)
+
+// AnalysisSectionUpdate is the builder for updating AnalysisSection entities.
+type AnalysisSectionUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *AnalysisSectionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the AnalysisSectionUpdate builder.
+func (_u *AnalysisSectionUpdate) Where(ps ...predicate.AnalysisSection) *AnalysisSectionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetDeepAnalysisID sets the "deep_analysis_id" field.
+func (_u *AnalysisSectionUpdate) SetDeepAnalysisID(v int) *AnalysisSectionUpdate {
+	_u.mutation.SetDeepAnalysisID(v)
+	return _u
+}
+
+// SetNillableDeepAnalysisID sets the "deep_analysis_id" field if the given value is not nil.
+func (_u *AnalysisSectionUpdate) SetNillableDeepAnalysisID(v *int) *AnalysisSectionUpdate {
+	if v != nil {
+		_u.SetDeepAnalysisID(*v)
+	}
+	return _u
+}
+
+// ClearDeepAnalysisID clears the value of the "deep_analysis_id" field.
+func (_u *AnalysisSectionUpdate) ClearDeepAnalysisID() *AnalysisSectionUpdate {
+	_u.mutation.ClearDeepAnalysisID()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *AnalysisSectionUpdate) SetName(v string) *AnalysisSectionUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *AnalysisSectionUpdate) SetNillableName(v *string) *AnalysisSectionUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetContent sets the "content" field.
+func (_u *AnalysisSectionUpdate) SetContent(v string) *AnalysisSectionUpdate {
+	_u.mutation.SetContent(v)
+	return _u
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (_u *AnalysisSectionUpdate) SetNillableContent(v *string) *AnalysisSectionUpdate {
+	if v != nil {
+		_u.SetContent(*v)
+	}
+	return _u
+}
+
+// ClearContent clears the value of the "content" field.
+func (_u *AnalysisSectionUpdate) ClearContent() *AnalysisSectionUpdate {
+	_u.mutation.ClearContent()
+	return _u
+}
+
+// SetPosition sets the "position" field.
+func (_u *AnalysisSectionUpdate) SetPosition(v int) *AnalysisSectionUpdate {
+	_u.mutation.ResetPosition()
+	_u.mutation.SetPosition(v)
+	return _u
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_u *AnalysisSectionUpdate) SetNillablePosition(v *int) *AnalysisSectionUpdate {
+	if v != nil {
+		_u.SetPosition(*v)
+	}
+	return _u
+}
+
+// AddPosition adds value to the "position" field.
+func (_u *AnalysisSectionUpdate) AddPosition(v int) *AnalysisSectionUpdate {
+	_u.mutation.AddPosition(v)
+	return _u
+}
+
+// SetDeepAnalysisResultID sets the "deep_analysis_result" edge to the DeepAnalysisResult entity by ID.
+func (_u *AnalysisSectionUpdate) SetDeepAnalysisResultID(id int) *AnalysisSectionUpdate {
+	_u.mutation.SetDeepAnalysisResultID(id)
+	return _u
+}
+
+// SetNillableDeepAnalysisResultID sets the "deep_analysis_result" edge to the DeepAnalysisResult entity by ID if the given value is not nil.
+func (_u *AnalysisSectionUpdate) SetNillableDeepAnalysisResultID(id *int) *AnalysisSectionUpdate {
+	if id != nil {
+		_u = _u.SetDeepAnalysisResultID(*id)
+	}
+	return _u
+}
+
+// SetDeepAnalysisResult sets the "deep_analysis_result" edge to the DeepAnalysisResult entity.
+func (_u *AnalysisSectionUpdate) SetDeepAnalysisResult(v *DeepAnalysisResult) *AnalysisSectionUpdate {
+	return _u.SetDeepAnalysisResultID(v.ID)
+}
+
+// Mutation returns the AnalysisSectionMutation object of the builder.
+func (_u *AnalysisSectionUpdate) Mutation() *AnalysisSectionMutation {
+	return _u.mutation
+}
+
+// ClearDeepAnalysisResult clears the "deep_analysis_result" edge to the DeepAnalysisResult entity.
+func (_u *AnalysisSectionUpdate) ClearDeepAnalysisResult() *AnalysisSectionUpdate {
+	_u.mutation.ClearDeepAnalysisResult()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AnalysisSectionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AnalysisSectionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AnalysisSectionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AnalysisSectionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AnalysisSectionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AnalysisSectionUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *AnalysisSectionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(analysissection.Table, analysissection.Columns, sqlgraph.NewFieldSpec(analysissection.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(analysissection.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Content(); ok {
+		_spec.SetField(analysissection.FieldContent, field.TypeString, value)
+	}
+	if _u.mutation.ContentCleared() {
+		_spec.ClearField(analysissection.FieldContent, field.TypeString)
+	}
+	if value, ok := _u.mutation.Position(); ok {
+		_spec.SetField(analysissection.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPosition(); ok {
+		_spec.AddField(analysissection.FieldPosition, field.TypeInt, value)
+	}
+	if _u.mutation.DeepAnalysisResultCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   analysissection.DeepAnalysisResultTable,
+			Columns: []string{analysissection.DeepAnalysisResultColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deepanalysisresult.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DeepAnalysisResultIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   analysissection.DeepAnalysisResultTable,
+			Columns: []string{analysissection.DeepAnalysisResultColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deepanalysisresult.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{analysissection.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AnalysisSectionUpdateOne is the builder for updating a single AnalysisSection entity.
+type AnalysisSectionUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *AnalysisSectionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetDeepAnalysisID sets the "deep_analysis_id" field.
+func (_u *AnalysisSectionUpdateOne) SetDeepAnalysisID(v int) *AnalysisSectionUpdateOne {
+	_u.mutation.SetDeepAnalysisID(v)
+	return _u
+}
+
+// SetNillableDeepAnalysisID sets the "deep_analysis_id" field if the given value is not nil.
+func (_u *AnalysisSectionUpdateOne) SetNillableDeepAnalysisID(v *int) *AnalysisSectionUpdateOne {
+	if v != nil {
+		_u.SetDeepAnalysisID(*v)
+	}
+	return _u
+}
+
+// ClearDeepAnalysisID clears the value of the "deep_analysis_id" field.
+func (_u *AnalysisSectionUpdateOne) ClearDeepAnalysisID() *AnalysisSectionUpdateOne {
+	_u.mutation.ClearDeepAnalysisID()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *AnalysisSectionUpdateOne) SetName(v string) *AnalysisSectionUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *AnalysisSectionUpdateOne) SetNillableName(v *string) *AnalysisSectionUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetContent sets the "content" field.
+func (_u *AnalysisSectionUpdateOne) SetContent(v string) *AnalysisSectionUpdateOne {
+	_u.mutation.SetContent(v)
+	return _u
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (_u *AnalysisSectionUpdateOne) SetNillableContent(v *string) *AnalysisSectionUpdateOne {
+	if v != nil {
+		_u.SetContent(*v)
+	}
+	return _u
+}
+
+// ClearContent clears the value of the "content" field.
+func (_u *AnalysisSectionUpdateOne) ClearContent() *AnalysisSectionUpdateOne {
+	_u.mutation.ClearContent()
+	return _u
+}
+
+// SetPosition sets the "position" field.
+func (_u *AnalysisSectionUpdateOne) SetPosition(v int) *AnalysisSectionUpdateOne {
+	_u.mutation.ResetPosition()
+	_u.mutation.SetPosition(v)
+	return _u
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_u *AnalysisSectionUpdateOne) SetNillablePosition(v *int) *AnalysisSectionUpdateOne {
+	if v != nil {
+		_u.SetPosition(*v)
+	}
+	return _u
+}
+
+// AddPosition adds value to the "position" field.
+func (_u *AnalysisSectionUpdateOne) AddPosition(v int) *AnalysisSectionUpdateOne {
+	_u.mutation.AddPosition(v)
+	return _u
+}
+
+// SetDeepAnalysisResultID sets the "deep_analysis_result" edge to the DeepAnalysisResult entity by ID.
+func (_u *AnalysisSectionUpdateOne) SetDeepAnalysisResultID(id int) *AnalysisSectionUpdateOne {
+	_u.mutation.SetDeepAnalysisResultID(id)
+	return _u
+}
+
+// SetNillableDeepAnalysisResultID sets the "deep_analysis_result" edge to the DeepAnalysisResult entity by ID if the given value is not nil.
+func (_u *AnalysisSectionUpdateOne) SetNillableDeepAnalysisResultID(id *int) *AnalysisSectionUpdateOne {
+	if id != nil {
+		_u = _u.SetDeepAnalysisResultID(*id)
+	}
+	return _u
+}
+
+// SetDeepAnalysisResult sets the "deep_analysis_result" edge to the DeepAnalysisResult entity.
+func (_u *AnalysisSectionUpdateOne) SetDeepAnalysisResult(v *DeepAnalysisResult) *AnalysisSectionUpdateOne {
+	return _u.SetDeepAnalysisResultID(v.ID)
+}
+
+// Mutation returns the AnalysisSectionMutation object of the builder.
+func (_u *AnalysisSectionUpdateOne) Mutation() *AnalysisSectionMutation {
+	return _u.mutation
+}
+
+// ClearDeepAnalysisResult clears the "deep_analysis_result" edge to the DeepAnalysisResult entity.
+func (_u *AnalysisSectionUpdateOne) ClearDeepAnalysisResult() *AnalysisSectionUpdateOne {
+	_u.mutation.ClearDeepAnalysisResult()
+	return _u
+}
+
+// Where appends a list predicates to the AnalysisSectionUpdate builder.
+func (_u *AnalysisSectionUpdateOne) Where(ps ...predicate.AnalysisSection) *AnalysisSectionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AnalysisSectionUpdateOne) Select(field string, fields ...string) *AnalysisSectionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AnalysisSection entity.
+func (_u *AnalysisSectionUpdateOne) Save(ctx context.Context) (*AnalysisSection, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AnalysisSectionUpdateOne) SaveX(ctx context.Context) *AnalysisSection {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AnalysisSectionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AnalysisSectionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AnalysisSectionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AnalysisSectionUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *AnalysisSectionUpdateOne) sqlSave(ctx context.Context) (_node *AnalysisSection, err error) {
+	_spec := sqlgraph.NewUpdateSpec(analysissection.Table, analysissection.Columns, sqlgraph.NewFieldSpec(analysissection.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AnalysisSection.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, analysissection.FieldID)
+		for _, f := range fields {
+			if !analysissection.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != analysissection.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(analysissection.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Content(); ok {
+		_spec.SetField(analysissection.FieldContent, field.TypeString, value)
+	}
+	if _u.mutation.ContentCleared() {
+		_spec.ClearField(analysissection.FieldContent, field.TypeString)
+	}
+	if value, ok := _u.mutation.Position(); ok {
+		_spec.SetField(analysissection.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPosition(); ok {
+		_spec.AddField(analysissection.FieldPosition, field.TypeInt, value)
+	}
+	if _u.mutation.DeepAnalysisResultCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   analysissection.DeepAnalysisResultTable,
+			Columns: []string{analysissection.DeepAnalysisResultColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deepanalysisresult.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DeepAnalysisResultIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   analysissection.DeepAnalysisResultTable,
+			Columns: []string{analysissection.DeepAnalysisResultColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deepanalysisresult.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &AnalysisSection{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{analysissection.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
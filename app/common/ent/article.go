@@ -29,6 +29,8 @@ type Article struct {
 	PubDate string `json:"pub_date,omitempty"`
 	// Content holds the value of the "content" field.
 	Content string `json:"content,omitempty"`
+	// Citation index within the domain report, starting from 1
+	RefIndex int `json:"ref_index,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ArticleQuery when eager-loading is set.
 	Edges        ArticleEdges `json:"edges"`
@@ -39,9 +41,13 @@ type Article struct {
 type ArticleEdges struct {
 	// DomainReport holds the value of the domain_report edge.
 	DomainReport *DomainReport `json:"domain_report,omitempty"`
+	// KeyEvents holds the value of the key_events edge.
+	KeyEvents []*KeyEvent `json:"key_events,omitempty"`
+	// ArticleEntities holds the value of the article_entities edge.
+	ArticleEntities []*ArticleEntity `json:"article_entities,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [3]bool
 }
 
 // DomainReportOrErr returns the DomainReport value or an error if the edge
@@ -55,12 +61,30 @@ func (e ArticleEdges) DomainReportOrErr() (*DomainReport, error) {
 	return nil, &NotLoadedError{edge: "domain_report"}
 }
 
+// KeyEventsOrErr returns the KeyEvents value or an error if the edge
+// was not loaded in eager-loading.
+func (e ArticleEdges) KeyEventsOrErr() ([]*KeyEvent, error) {
+	if e.loadedTypes[1] {
+		return e.KeyEvents, nil
+	}
+	return nil, &NotLoadedError{edge: "key_events"}
+}
+
+// ArticleEntitiesOrErr returns the ArticleEntities value or an error if the edge
+// was not loaded in eager-loading.
+func (e ArticleEdges) ArticleEntitiesOrErr() ([]*ArticleEntity, error) {
+	if e.loadedTypes[2] {
+		return e.ArticleEntities, nil
+	}
+	return nil, &NotLoadedError{edge: "article_entities"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Article) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case article.FieldID, article.FieldDomainReportID:
+		case article.FieldID, article.FieldDomainReportID, article.FieldRefIndex:
 			values[i] = new(sql.NullInt64)
 		case article.FieldTitle, article.FieldLink, article.FieldSource, article.FieldPubDate, article.FieldContent:
 			values[i] = new(sql.NullString)
@@ -121,6 +145,12 @@ func (_m *Article) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Content = value.String
 			}
+		case article.FieldRefIndex:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field ref_index", values[i])
+			} else if value.Valid {
+				_m.RefIndex = int(value.Int64)
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -139,6 +169,16 @@ func (_m *Article) QueryDomainReport() *DomainReportQuery {
 	return NewArticleClient(_m.config).QueryDomainReport(_m)
 }
 
+// QueryKeyEvents queries the "key_events" edge of the Article entity.
+func (_m *Article) QueryKeyEvents() *KeyEventQuery {
+	return NewArticleClient(_m.config).QueryKeyEvents(_m)
+}
+
+// QueryArticleEntities queries the "article_entities" edge of the Article entity.
+func (_m *Article) QueryArticleEntities() *ArticleEntityQuery {
+	return NewArticleClient(_m.config).QueryArticleEntities(_m)
+}
+
 // Update returns a builder for updating this Article.
 // Note that you need to call Article.Unwrap() before calling this method if this Article
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -179,6 +219,9 @@ func (_m *Article) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("content=")
 	builder.WriteString(_m.Content)
+	builder.WriteString(", ")
+	builder.WriteString("ref_index=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RefIndex))
 	builder.WriteByte(')')
 	return builder.String()
 }
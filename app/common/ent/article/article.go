@@ -24,8 +24,14 @@ const (
 	FieldPubDate = "pub_date"
 	// FieldContent holds the string denoting the content field in the database.
 	FieldContent = "content"
+	// FieldRefIndex holds the string denoting the ref_index field in the database.
+	FieldRefIndex = "ref_index"
 	// EdgeDomainReport holds the string denoting the domain_report edge name in mutations.
 	EdgeDomainReport = "domain_report"
+	// EdgeKeyEvents holds the string denoting the key_events edge name in mutations.
+	EdgeKeyEvents = "key_events"
+	// EdgeArticleEntities holds the string denoting the article_entities edge name in mutations.
+	EdgeArticleEntities = "article_entities"
 	// Table holds the table name of the article in the database.
 	Table = "articles"
 	// DomainReportTable is the table that holds the domain_report relation/edge.
@@ -35,6 +41,18 @@ const (
 	DomainReportInverseTable = "domain_reports"
 	// DomainReportColumn is the table column denoting the domain_report relation/edge.
 	DomainReportColumn = "domain_report_id"
+	// KeyEventsTable is the table that holds the key_events relation/edge. The primary key declared below.
+	KeyEventsTable = "key_event_articles"
+	// KeyEventsInverseTable is the table name for the KeyEvent entity.
+	// It exists in this package in order to avoid circular dependency with the "keyevent" package.
+	KeyEventsInverseTable = "key_events"
+	// ArticleEntitiesTable is the table that holds the article_entities relation/edge.
+	ArticleEntitiesTable = "article_entities"
+	// ArticleEntitiesInverseTable is the table name for the ArticleEntity entity.
+	// It exists in this package in order to avoid circular dependency with the "articleentity" package.
+	ArticleEntitiesInverseTable = "article_entities"
+	// ArticleEntitiesColumn is the table column denoting the article_entities relation/edge.
+	ArticleEntitiesColumn = "article_id"
 )
 
 // Columns holds all SQL columns for article fields.
@@ -46,8 +64,15 @@ var Columns = []string{
 	FieldSource,
 	FieldPubDate,
 	FieldContent,
+	FieldRefIndex,
 }
 
+var (
+	// KeyEventsPrimaryKey and KeyEventsColumn2 are the table columns denoting the
+	// primary key for the key_events relation (M2M).
+	KeyEventsPrimaryKey = []string{"key_event_id", "article_id"}
+)
+
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -96,12 +121,45 @@ func ByContent(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldContent, opts...).ToFunc()
 }
 
+// ByRefIndex orders the results by the ref_index field.
+func ByRefIndex(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRefIndex, opts...).ToFunc()
+}
+
 // ByDomainReportField orders the results by domain_report field.
 func ByDomainReportField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
 		sqlgraph.OrderByNeighborTerms(s, newDomainReportStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByKeyEventsCount orders the results by key_events count.
+func ByKeyEventsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newKeyEventsStep(), opts...)
+	}
+}
+
+// ByKeyEvents orders the results by key_events terms.
+func ByKeyEvents(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newKeyEventsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByArticleEntitiesCount orders the results by article_entities count.
+func ByArticleEntitiesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newArticleEntitiesStep(), opts...)
+	}
+}
+
+// ByArticleEntities orders the results by article_entities terms.
+func ByArticleEntities(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newArticleEntitiesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newDomainReportStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -109,3 +167,17 @@ func newDomainReportStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, DomainReportTable, DomainReportColumn),
 	)
 }
+func newKeyEventsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(KeyEventsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, KeyEventsTable, KeyEventsPrimaryKey...),
+	)
+}
+func newArticleEntitiesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ArticleEntitiesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ArticleEntitiesTable, ArticleEntitiesColumn),
+	)
+}
@@ -83,6 +83,11 @@ func Content(v string) predicate.Article {
 	return predicate.Article(sql.FieldEQ(FieldContent, v))
 }
 
+// RefIndex applies equality check predicate on the "ref_index" field. It's identical to RefIndexEQ.
+func RefIndex(v int) predicate.Article {
+	return predicate.Article(sql.FieldEQ(FieldRefIndex, v))
+}
+
 // DomainReportIDEQ applies the EQ predicate on the "domain_report_id" field.
 func DomainReportIDEQ(v int) predicate.Article {
 	return predicate.Article(sql.FieldEQ(FieldDomainReportID, v))
@@ -488,6 +493,56 @@ func ContentContainsFold(v string) predicate.Article {
 	return predicate.Article(sql.FieldContainsFold(FieldContent, v))
 }
 
+// RefIndexEQ applies the EQ predicate on the "ref_index" field.
+func RefIndexEQ(v int) predicate.Article {
+	return predicate.Article(sql.FieldEQ(FieldRefIndex, v))
+}
+
+// RefIndexNEQ applies the NEQ predicate on the "ref_index" field.
+func RefIndexNEQ(v int) predicate.Article {
+	return predicate.Article(sql.FieldNEQ(FieldRefIndex, v))
+}
+
+// RefIndexIn applies the In predicate on the "ref_index" field.
+func RefIndexIn(vs ...int) predicate.Article {
+	return predicate.Article(sql.FieldIn(FieldRefIndex, vs...))
+}
+
+// RefIndexNotIn applies the NotIn predicate on the "ref_index" field.
+func RefIndexNotIn(vs ...int) predicate.Article {
+	return predicate.Article(sql.FieldNotIn(FieldRefIndex, vs...))
+}
+
+// RefIndexGT applies the GT predicate on the "ref_index" field.
+func RefIndexGT(v int) predicate.Article {
+	return predicate.Article(sql.FieldGT(FieldRefIndex, v))
+}
+
+// RefIndexGTE applies the GTE predicate on the "ref_index" field.
+func RefIndexGTE(v int) predicate.Article {
+	return predicate.Article(sql.FieldGTE(FieldRefIndex, v))
+}
+
+// RefIndexLT applies the LT predicate on the "ref_index" field.
+func RefIndexLT(v int) predicate.Article {
+	return predicate.Article(sql.FieldLT(FieldRefIndex, v))
+}
+
+// RefIndexLTE applies the LTE predicate on the "ref_index" field.
+func RefIndexLTE(v int) predicate.Article {
+	return predicate.Article(sql.FieldLTE(FieldRefIndex, v))
+}
+
+// RefIndexIsNil applies the IsNil predicate on the "ref_index" field.
+func RefIndexIsNil() predicate.Article {
+	return predicate.Article(sql.FieldIsNull(FieldRefIndex))
+}
+
+// RefIndexNotNil applies the NotNil predicate on the "ref_index" field.
+func RefIndexNotNil() predicate.Article {
+	return predicate.Article(sql.FieldNotNull(FieldRefIndex))
+}
+
 // HasDomainReport applies the HasEdge predicate on the "domain_report" edge.
 func HasDomainReport() predicate.Article {
 	return predicate.Article(func(s *sql.Selector) {
@@ -511,6 +566,52 @@ func HasDomainReportWith(preds ...predicate.DomainReport) predicate.Article {
 	})
 }
 
+// HasKeyEvents applies the HasEdge predicate on the "key_events" edge.
+func HasKeyEvents() predicate.Article {
+	return predicate.Article(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, KeyEventsTable, KeyEventsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasKeyEventsWith applies the HasEdge predicate on the "key_events" edge with a given conditions (other predicates).
+func HasKeyEventsWith(preds ...predicate.KeyEvent) predicate.Article {
+	return predicate.Article(func(s *sql.Selector) {
+		step := newKeyEventsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasArticleEntities applies the HasEdge predicate on the "article_entities" edge.
+func HasArticleEntities() predicate.Article {
+	return predicate.Article(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ArticleEntitiesTable, ArticleEntitiesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasArticleEntitiesWith applies the HasEdge predicate on the "article_entities" edge with a given conditions (other predicates).
+func HasArticleEntitiesWith(preds ...predicate.ArticleEntity) predicate.Article {
+	return predicate.Article(func(s *sql.Selector) {
+		step := newArticleEntitiesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Article) predicate.Article {
 	return predicate.Article(sql.AndPredicates(predicates...))
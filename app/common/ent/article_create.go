@@ -4,12 +4,16 @@ package ent
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/iWorld-y/domain_radar/app/common/ent/article"
+	"github.com/iWorld-y/domain_radar/app/common/ent/articleentity"
 	"github.com/iWorld-y/domain_radar/app/common/ent/domainreport"
+	"github.com/iWorld-y/domain_radar/app/common/ent/keyevent"
 )
 
 // ArticleCreate is the builder for creating a Article entity.
@@ -17,6 +21,7 @@ type ArticleCreate struct {
 	config
 	mutation *ArticleMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetDomainReportID sets the "domain_report_id" field.
@@ -103,6 +108,20 @@ func (_c *ArticleCreate) SetNillableContent(v *string) *ArticleCreate {
 	return _c
 }
 
+// SetRefIndex sets the "ref_index" field.
+func (_c *ArticleCreate) SetRefIndex(v int) *ArticleCreate {
+	_c.mutation.SetRefIndex(v)
+	return _c
+}
+
+// SetNillableRefIndex sets the "ref_index" field if the given value is not nil.
+func (_c *ArticleCreate) SetNillableRefIndex(v *int) *ArticleCreate {
+	if v != nil {
+		_c.SetRefIndex(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *ArticleCreate) SetID(v int) *ArticleCreate {
 	_c.mutation.SetID(v)
@@ -114,6 +133,36 @@ func (_c *ArticleCreate) SetDomainReport(v *DomainReport) *ArticleCreate {
 	return _c.SetDomainReportID(v.ID)
 }
 
+// AddKeyEventIDs adds the "key_events" edge to the KeyEvent entity by IDs.
+func (_c *ArticleCreate) AddKeyEventIDs(ids ...int) *ArticleCreate {
+	_c.mutation.AddKeyEventIDs(ids...)
+	return _c
+}
+
+// AddKeyEvents adds the "key_events" edges to the KeyEvent entity.
+func (_c *ArticleCreate) AddKeyEvents(v ...*KeyEvent) *ArticleCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddKeyEventIDs(ids...)
+}
+
+// AddArticleEntityIDs adds the "article_entities" edge to the ArticleEntity entity by IDs.
+func (_c *ArticleCreate) AddArticleEntityIDs(ids ...int) *ArticleCreate {
+	_c.mutation.AddArticleEntityIDs(ids...)
+	return _c
+}
+
+// AddArticleEntities adds the "article_entities" edges to the ArticleEntity entity.
+func (_c *ArticleCreate) AddArticleEntities(v ...*ArticleEntity) *ArticleCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddArticleEntityIDs(ids...)
+}
+
 // Mutation returns the ArticleMutation object of the builder.
 func (_c *ArticleCreate) Mutation() *ArticleMutation {
 	return _c.mutation
@@ -176,6 +225,7 @@ func (_c *ArticleCreate) createSpec() (*Article, *sqlgraph.CreateSpec) {
 		_node = &Article{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(article.Table, sqlgraph.NewFieldSpec(article.FieldID, field.TypeInt))
 	)
+	_spec.OnConflict = _c.conflict
 	if id, ok := _c.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = id
@@ -200,6 +250,10 @@ func (_c *ArticleCreate) createSpec() (*Article, *sqlgraph.CreateSpec) {
 		_spec.SetField(article.FieldContent, field.TypeString, value)
 		_node.Content = value
 	}
+	if value, ok := _c.mutation.RefIndex(); ok {
+		_spec.SetField(article.FieldRefIndex, field.TypeInt, value)
+		_node.RefIndex = value
+	}
 	if nodes := _c.mutation.DomainReportIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -217,14 +271,463 @@ func (_c *ArticleCreate) createSpec() (*Article, *sqlgraph.CreateSpec) {
 		_node.DomainReportID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.KeyEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   article.KeyEventsTable,
+			Columns: article.KeyEventsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(keyevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ArticleEntitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   article.ArticleEntitiesTable,
+			Columns: []string{article.ArticleEntitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(articleentity.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Article.Create().
+//		SetDomainReportID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ArticleUpsert) {
+//			SetDomainReportID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ArticleCreate) OnConflict(opts ...sql.ConflictOption) *ArticleUpsertOne {
+	_c.conflict = opts
+	return &ArticleUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Article.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ArticleCreate) OnConflictColumns(columns ...string) *ArticleUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ArticleUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ArticleUpsertOne is the builder for "upsert"-ing
+	//  one Article node.
+	ArticleUpsertOne struct {
+		create *ArticleCreate
+	}
+
+	// ArticleUpsert is the "OnConflict" setter.
+	ArticleUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetDomainReportID sets the "domain_report_id" field.
+func (u *ArticleUpsert) SetDomainReportID(v int) *ArticleUpsert {
+	u.Set(article.FieldDomainReportID, v)
+	return u
+}
+
+// UpdateDomainReportID sets the "domain_report_id" field to the value that was provided on create.
+func (u *ArticleUpsert) UpdateDomainReportID() *ArticleUpsert {
+	u.SetExcluded(article.FieldDomainReportID)
+	return u
+}
+
+// ClearDomainReportID clears the value of the "domain_report_id" field.
+func (u *ArticleUpsert) ClearDomainReportID() *ArticleUpsert {
+	u.SetNull(article.FieldDomainReportID)
+	return u
+}
+
+// SetTitle sets the "title" field.
+func (u *ArticleUpsert) SetTitle(v string) *ArticleUpsert {
+	u.Set(article.FieldTitle, v)
+	return u
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *ArticleUpsert) UpdateTitle() *ArticleUpsert {
+	u.SetExcluded(article.FieldTitle)
+	return u
+}
+
+// ClearTitle clears the value of the "title" field.
+func (u *ArticleUpsert) ClearTitle() *ArticleUpsert {
+	u.SetNull(article.FieldTitle)
+	return u
+}
+
+// SetLink sets the "link" field.
+func (u *ArticleUpsert) SetLink(v string) *ArticleUpsert {
+	u.Set(article.FieldLink, v)
+	return u
+}
+
+// UpdateLink sets the "link" field to the value that was provided on create.
+func (u *ArticleUpsert) UpdateLink() *ArticleUpsert {
+	u.SetExcluded(article.FieldLink)
+	return u
+}
+
+// ClearLink clears the value of the "link" field.
+func (u *ArticleUpsert) ClearLink() *ArticleUpsert {
+	u.SetNull(article.FieldLink)
+	return u
+}
+
+// SetSource sets the "source" field.
+func (u *ArticleUpsert) SetSource(v string) *ArticleUpsert {
+	u.Set(article.FieldSource, v)
+	return u
+}
+
+// UpdateSource sets the "source" field to the value that was provided on create.
+func (u *ArticleUpsert) UpdateSource() *ArticleUpsert {
+	u.SetExcluded(article.FieldSource)
+	return u
+}
+
+// ClearSource clears the value of the "source" field.
+func (u *ArticleUpsert) ClearSource() *ArticleUpsert {
+	u.SetNull(article.FieldSource)
+	return u
+}
+
+// SetPubDate sets the "pub_date" field.
+func (u *ArticleUpsert) SetPubDate(v string) *ArticleUpsert {
+	u.Set(article.FieldPubDate, v)
+	return u
+}
+
+// UpdatePubDate sets the "pub_date" field to the value that was provided on create.
+func (u *ArticleUpsert) UpdatePubDate() *ArticleUpsert {
+	u.SetExcluded(article.FieldPubDate)
+	return u
+}
+
+// ClearPubDate clears the value of the "pub_date" field.
+func (u *ArticleUpsert) ClearPubDate() *ArticleUpsert {
+	u.SetNull(article.FieldPubDate)
+	return u
+}
+
+// SetContent sets the "content" field.
+func (u *ArticleUpsert) SetContent(v string) *ArticleUpsert {
+	u.Set(article.FieldContent, v)
+	return u
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *ArticleUpsert) UpdateContent() *ArticleUpsert {
+	u.SetExcluded(article.FieldContent)
+	return u
+}
+
+// ClearContent clears the value of the "content" field.
+func (u *ArticleUpsert) ClearContent() *ArticleUpsert {
+	u.SetNull(article.FieldContent)
+	return u
+}
+
+// SetRefIndex sets the "ref_index" field.
+func (u *ArticleUpsert) SetRefIndex(v int) *ArticleUpsert {
+	u.Set(article.FieldRefIndex, v)
+	return u
+}
+
+// UpdateRefIndex sets the "ref_index" field to the value that was provided on create.
+func (u *ArticleUpsert) UpdateRefIndex() *ArticleUpsert {
+	u.SetExcluded(article.FieldRefIndex)
+	return u
+}
+
+// AddRefIndex adds v to the "ref_index" field.
+func (u *ArticleUpsert) AddRefIndex(v int) *ArticleUpsert {
+	u.Add(article.FieldRefIndex, v)
+	return u
+}
+
+// ClearRefIndex clears the value of the "ref_index" field.
+func (u *ArticleUpsert) ClearRefIndex() *ArticleUpsert {
+	u.SetNull(article.FieldRefIndex)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Article.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(article.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ArticleUpsertOne) UpdateNewValues() *ArticleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(article.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Article.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ArticleUpsertOne) Ignore() *ArticleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ArticleUpsertOne) DoNothing() *ArticleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ArticleCreate.OnConflict
+// documentation for more info.
+func (u *ArticleUpsertOne) Update(set func(*ArticleUpsert)) *ArticleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ArticleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetDomainReportID sets the "domain_report_id" field.
+func (u *ArticleUpsertOne) SetDomainReportID(v int) *ArticleUpsertOne {
+	return u.Update(func(s *ArticleUpsert) {
+		s.SetDomainReportID(v)
+	})
+}
+
+// UpdateDomainReportID sets the "domain_report_id" field to the value that was provided on create.
+func (u *ArticleUpsertOne) UpdateDomainReportID() *ArticleUpsertOne {
+	return u.Update(func(s *ArticleUpsert) {
+		s.UpdateDomainReportID()
+	})
+}
+
+// ClearDomainReportID clears the value of the "domain_report_id" field.
+func (u *ArticleUpsertOne) ClearDomainReportID() *ArticleUpsertOne {
+	return u.Update(func(s *ArticleUpsert) {
+		s.ClearDomainReportID()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *ArticleUpsertOne) SetTitle(v string) *ArticleUpsertOne {
+	return u.Update(func(s *ArticleUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *ArticleUpsertOne) UpdateTitle() *ArticleUpsertOne {
+	return u.Update(func(s *ArticleUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// ClearTitle clears the value of the "title" field.
+func (u *ArticleUpsertOne) ClearTitle() *ArticleUpsertOne {
+	return u.Update(func(s *ArticleUpsert) {
+		s.ClearTitle()
+	})
+}
+
+// SetLink sets the "link" field.
+func (u *ArticleUpsertOne) SetLink(v string) *ArticleUpsertOne {
+	return u.Update(func(s *ArticleUpsert) {
+		s.SetLink(v)
+	})
+}
+
+// UpdateLink sets the "link" field to the value that was provided on create.
+func (u *ArticleUpsertOne) UpdateLink() *ArticleUpsertOne {
+	return u.Update(func(s *ArticleUpsert) {
+		s.UpdateLink()
+	})
+}
+
+// ClearLink clears the value of the "link" field.
+func (u *ArticleUpsertOne) ClearLink() *ArticleUpsertOne {
+	return u.Update(func(s *ArticleUpsert) {
+		s.ClearLink()
+	})
+}
+
+// SetSource sets the "source" field.
+func (u *ArticleUpsertOne) SetSource(v string) *ArticleUpsertOne {
+	return u.Update(func(s *ArticleUpsert) {
+		s.SetSource(v)
+	})
+}
+
+// UpdateSource sets the "source" field to the value that was provided on create.
+func (u *ArticleUpsertOne) UpdateSource() *ArticleUpsertOne {
+	return u.Update(func(s *ArticleUpsert) {
+		s.UpdateSource()
+	})
+}
+
+// ClearSource clears the value of the "source" field.
+func (u *ArticleUpsertOne) ClearSource() *ArticleUpsertOne {
+	return u.Update(func(s *ArticleUpsert) {
+		s.ClearSource()
+	})
+}
+
+// SetPubDate sets the "pub_date" field.
+func (u *ArticleUpsertOne) SetPubDate(v string) *ArticleUpsertOne {
+	return u.Update(func(s *ArticleUpsert) {
+		s.SetPubDate(v)
+	})
+}
+
+// UpdatePubDate sets the "pub_date" field to the value that was provided on create.
+func (u *ArticleUpsertOne) UpdatePubDate() *ArticleUpsertOne {
+	return u.Update(func(s *ArticleUpsert) {
+		s.UpdatePubDate()
+	})
+}
+
+// ClearPubDate clears the value of the "pub_date" field.
+func (u *ArticleUpsertOne) ClearPubDate() *ArticleUpsertOne {
+	return u.Update(func(s *ArticleUpsert) {
+		s.ClearPubDate()
+	})
+}
+
+// SetContent sets the "content" field.
+func (u *ArticleUpsertOne) SetContent(v string) *ArticleUpsertOne {
+	return u.Update(func(s *ArticleUpsert) {
+		s.SetContent(v)
+	})
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *ArticleUpsertOne) UpdateContent() *ArticleUpsertOne {
+	return u.Update(func(s *ArticleUpsert) {
+		s.UpdateContent()
+	})
+}
+
+// ClearContent clears the value of the "content" field.
+func (u *ArticleUpsertOne) ClearContent() *ArticleUpsertOne {
+	return u.Update(func(s *ArticleUpsert) {
+		s.ClearContent()
+	})
+}
+
+// SetRefIndex sets the "ref_index" field.
+func (u *ArticleUpsertOne) SetRefIndex(v int) *ArticleUpsertOne {
+	return u.Update(func(s *ArticleUpsert) {
+		s.SetRefIndex(v)
+	})
+}
+
+// AddRefIndex adds v to the "ref_index" field.
+func (u *ArticleUpsertOne) AddRefIndex(v int) *ArticleUpsertOne {
+	return u.Update(func(s *ArticleUpsert) {
+		s.AddRefIndex(v)
+	})
+}
+
+// UpdateRefIndex sets the "ref_index" field to the value that was provided on create.
+func (u *ArticleUpsertOne) UpdateRefIndex() *ArticleUpsertOne {
+	return u.Update(func(s *ArticleUpsert) {
+		s.UpdateRefIndex()
+	})
+}
+
+// ClearRefIndex clears the value of the "ref_index" field.
+func (u *ArticleUpsertOne) ClearRefIndex() *ArticleUpsertOne {
+	return u.Update(func(s *ArticleUpsert) {
+		s.ClearRefIndex()
+	})
+}
+
+// Exec executes the query.
+func (u *ArticleUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ArticleCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ArticleUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ArticleUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ArticleUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // ArticleCreateBulk is the builder for creating many Article entities in bulk.
 type ArticleCreateBulk struct {
 	config
 	err      error
 	builders []*ArticleCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the Article entities in the database.
@@ -253,6 +756,7 @@ func (_c *ArticleCreateBulk) Save(ctx context.Context) ([]*Article, error) {
 					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -306,3 +810,271 @@ func (_c *ArticleCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Article.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ArticleUpsert) {
+//			SetDomainReportID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ArticleCreateBulk) OnConflict(opts ...sql.ConflictOption) *ArticleUpsertBulk {
+	_c.conflict = opts
+	return &ArticleUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Article.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ArticleCreateBulk) OnConflictColumns(columns ...string) *ArticleUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ArticleUpsertBulk{
+		create: _c,
+	}
+}
+
+// ArticleUpsertBulk is the builder for "upsert"-ing
+// a bulk of Article nodes.
+type ArticleUpsertBulk struct {
+	create *ArticleCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Article.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(article.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ArticleUpsertBulk) UpdateNewValues() *ArticleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(article.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Article.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ArticleUpsertBulk) Ignore() *ArticleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ArticleUpsertBulk) DoNothing() *ArticleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ArticleCreateBulk.OnConflict
+// documentation for more info.
+func (u *ArticleUpsertBulk) Update(set func(*ArticleUpsert)) *ArticleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ArticleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetDomainReportID sets the "domain_report_id" field.
+func (u *ArticleUpsertBulk) SetDomainReportID(v int) *ArticleUpsertBulk {
+	return u.Update(func(s *ArticleUpsert) {
+		s.SetDomainReportID(v)
+	})
+}
+
+// UpdateDomainReportID sets the "domain_report_id" field to the value that was provided on create.
+func (u *ArticleUpsertBulk) UpdateDomainReportID() *ArticleUpsertBulk {
+	return u.Update(func(s *ArticleUpsert) {
+		s.UpdateDomainReportID()
+	})
+}
+
+// ClearDomainReportID clears the value of the "domain_report_id" field.
+func (u *ArticleUpsertBulk) ClearDomainReportID() *ArticleUpsertBulk {
+	return u.Update(func(s *ArticleUpsert) {
+		s.ClearDomainReportID()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *ArticleUpsertBulk) SetTitle(v string) *ArticleUpsertBulk {
+	return u.Update(func(s *ArticleUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *ArticleUpsertBulk) UpdateTitle() *ArticleUpsertBulk {
+	return u.Update(func(s *ArticleUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// ClearTitle clears the value of the "title" field.
+func (u *ArticleUpsertBulk) ClearTitle() *ArticleUpsertBulk {
+	return u.Update(func(s *ArticleUpsert) {
+		s.ClearTitle()
+	})
+}
+
+// SetLink sets the "link" field.
+func (u *ArticleUpsertBulk) SetLink(v string) *ArticleUpsertBulk {
+	return u.Update(func(s *ArticleUpsert) {
+		s.SetLink(v)
+	})
+}
+
+// UpdateLink sets the "link" field to the value that was provided on create.
+func (u *ArticleUpsertBulk) UpdateLink() *ArticleUpsertBulk {
+	return u.Update(func(s *ArticleUpsert) {
+		s.UpdateLink()
+	})
+}
+
+// ClearLink clears the value of the "link" field.
+func (u *ArticleUpsertBulk) ClearLink() *ArticleUpsertBulk {
+	return u.Update(func(s *ArticleUpsert) {
+		s.ClearLink()
+	})
+}
+
+// SetSource sets the "source" field.
+func (u *ArticleUpsertBulk) SetSource(v string) *ArticleUpsertBulk {
+	return u.Update(func(s *ArticleUpsert) {
+		s.SetSource(v)
+	})
+}
+
+// UpdateSource sets the "source" field to the value that was provided on create.
+func (u *ArticleUpsertBulk) UpdateSource() *ArticleUpsertBulk {
+	return u.Update(func(s *ArticleUpsert) {
+		s.UpdateSource()
+	})
+}
+
+// ClearSource clears the value of the "source" field.
+func (u *ArticleUpsertBulk) ClearSource() *ArticleUpsertBulk {
+	return u.Update(func(s *ArticleUpsert) {
+		s.ClearSource()
+	})
+}
+
+// SetPubDate sets the "pub_date" field.
+func (u *ArticleUpsertBulk) SetPubDate(v string) *ArticleUpsertBulk {
+	return u.Update(func(s *ArticleUpsert) {
+		s.SetPubDate(v)
+	})
+}
+
+// UpdatePubDate sets the "pub_date" field to the value that was provided on create.
+func (u *ArticleUpsertBulk) UpdatePubDate() *ArticleUpsertBulk {
+	return u.Update(func(s *ArticleUpsert) {
+		s.UpdatePubDate()
+	})
+}
+
+// ClearPubDate clears the value of the "pub_date" field.
+func (u *ArticleUpsertBulk) ClearPubDate() *ArticleUpsertBulk {
+	return u.Update(func(s *ArticleUpsert) {
+		s.ClearPubDate()
+	})
+}
+
+// SetContent sets the "content" field.
+func (u *ArticleUpsertBulk) SetContent(v string) *ArticleUpsertBulk {
+	return u.Update(func(s *ArticleUpsert) {
+		s.SetContent(v)
+	})
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *ArticleUpsertBulk) UpdateContent() *ArticleUpsertBulk {
+	return u.Update(func(s *ArticleUpsert) {
+		s.UpdateContent()
+	})
+}
+
+// ClearContent clears the value of the "content" field.
+func (u *ArticleUpsertBulk) ClearContent() *ArticleUpsertBulk {
+	return u.Update(func(s *ArticleUpsert) {
+		s.ClearContent()
+	})
+}
+
+// SetRefIndex sets the "ref_index" field.
+func (u *ArticleUpsertBulk) SetRefIndex(v int) *ArticleUpsertBulk {
+	return u.Update(func(s *ArticleUpsert) {
+		s.SetRefIndex(v)
+	})
+}
+
+// AddRefIndex adds v to the "ref_index" field.
+func (u *ArticleUpsertBulk) AddRefIndex(v int) *ArticleUpsertBulk {
+	return u.Update(func(s *ArticleUpsert) {
+		s.AddRefIndex(v)
+	})
+}
+
+// UpdateRefIndex sets the "ref_index" field to the value that was provided on create.
+func (u *ArticleUpsertBulk) UpdateRefIndex() *ArticleUpsertBulk {
+	return u.Update(func(s *ArticleUpsert) {
+		s.UpdateRefIndex()
+	})
+}
+
+// ClearRefIndex clears the value of the "ref_index" field.
+func (u *ArticleUpsertBulk) ClearRefIndex() *ArticleUpsertBulk {
+	return u.Update(func(s *ArticleUpsert) {
+		s.ClearRefIndex()
+	})
+}
+
+// Exec executes the query.
+func (u *ArticleUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ArticleCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ArticleCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ArticleUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -4,6 +4,7 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 
@@ -12,19 +13,23 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/iWorld-y/domain_radar/app/common/ent/article"
+	"github.com/iWorld-y/domain_radar/app/common/ent/articleentity"
 	"github.com/iWorld-y/domain_radar/app/common/ent/domainreport"
+	"github.com/iWorld-y/domain_radar/app/common/ent/keyevent"
 	"github.com/iWorld-y/domain_radar/app/common/ent/predicate"
 )
 
 // ArticleQuery is the builder for querying Article entities.
 type ArticleQuery struct {
 	config
-	ctx              *QueryContext
-	order            []article.OrderOption
-	inters           []Interceptor
-	predicates       []predicate.Article
-	withDomainReport *DomainReportQuery
-	modifiers        []func(*sql.Selector)
+	ctx                 *QueryContext
+	order               []article.OrderOption
+	inters              []Interceptor
+	predicates          []predicate.Article
+	withDomainReport    *DomainReportQuery
+	withKeyEvents       *KeyEventQuery
+	withArticleEntities *ArticleEntityQuery
+	modifiers           []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -83,6 +88,50 @@ func (_q *ArticleQuery) QueryDomainReport() *DomainReportQuery {
 	return query
 }
 
+// QueryKeyEvents chains the current query on the "key_events" edge.
+func (_q *ArticleQuery) QueryKeyEvents() *KeyEventQuery {
+	query := (&KeyEventClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(article.Table, article.FieldID, selector),
+			sqlgraph.To(keyevent.Table, keyevent.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, article.KeyEventsTable, article.KeyEventsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryArticleEntities chains the current query on the "article_entities" edge.
+func (_q *ArticleQuery) QueryArticleEntities() *ArticleEntityQuery {
+	query := (&ArticleEntityClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(article.Table, article.FieldID, selector),
+			sqlgraph.To(articleentity.Table, articleentity.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, article.ArticleEntitiesTable, article.ArticleEntitiesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Article entity from the query.
 // Returns a *NotFoundError when no Article was found.
 func (_q *ArticleQuery) First(ctx context.Context) (*Article, error) {
@@ -270,12 +319,14 @@ func (_q *ArticleQuery) Clone() *ArticleQuery {
 		return nil
 	}
 	return &ArticleQuery{
-		config:           _q.config,
-		ctx:              _q.ctx.Clone(),
-		order:            append([]article.OrderOption{}, _q.order...),
-		inters:           append([]Interceptor{}, _q.inters...),
-		predicates:       append([]predicate.Article{}, _q.predicates...),
-		withDomainReport: _q.withDomainReport.Clone(),
+		config:              _q.config,
+		ctx:                 _q.ctx.Clone(),
+		order:               append([]article.OrderOption{}, _q.order...),
+		inters:              append([]Interceptor{}, _q.inters...),
+		predicates:          append([]predicate.Article{}, _q.predicates...),
+		withDomainReport:    _q.withDomainReport.Clone(),
+		withKeyEvents:       _q.withKeyEvents.Clone(),
+		withArticleEntities: _q.withArticleEntities.Clone(),
 		// clone intermediate query.
 		sql:       _q.sql.Clone(),
 		path:      _q.path,
@@ -294,6 +345,28 @@ func (_q *ArticleQuery) WithDomainReport(opts ...func(*DomainReportQuery)) *Arti
 	return _q
 }
 
+// WithKeyEvents tells the query-builder to eager-load the nodes that are connected to
+// the "key_events" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ArticleQuery) WithKeyEvents(opts ...func(*KeyEventQuery)) *ArticleQuery {
+	query := (&KeyEventClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withKeyEvents = query
+	return _q
+}
+
+// WithArticleEntities tells the query-builder to eager-load the nodes that are connected to
+// the "article_entities" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ArticleQuery) WithArticleEntities(opts ...func(*ArticleEntityQuery)) *ArticleQuery {
+	query := (&ArticleEntityClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withArticleEntities = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -372,8 +445,10 @@ func (_q *ArticleQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Arti
 	var (
 		nodes       = []*Article{}
 		_spec       = _q.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [3]bool{
 			_q.withDomainReport != nil,
+			_q.withKeyEvents != nil,
+			_q.withArticleEntities != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -403,6 +478,20 @@ func (_q *ArticleQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Arti
 			return nil, err
 		}
 	}
+	if query := _q.withKeyEvents; query != nil {
+		if err := _q.loadKeyEvents(ctx, query, nodes,
+			func(n *Article) { n.Edges.KeyEvents = []*KeyEvent{} },
+			func(n *Article, e *KeyEvent) { n.Edges.KeyEvents = append(n.Edges.KeyEvents, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withArticleEntities; query != nil {
+		if err := _q.loadArticleEntities(ctx, query, nodes,
+			func(n *Article) { n.Edges.ArticleEntities = []*ArticleEntity{} },
+			func(n *Article, e *ArticleEntity) { n.Edges.ArticleEntities = append(n.Edges.ArticleEntities, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -435,6 +524,97 @@ func (_q *ArticleQuery) loadDomainReport(ctx context.Context, query *DomainRepor
 	}
 	return nil
 }
+func (_q *ArticleQuery) loadKeyEvents(ctx context.Context, query *KeyEventQuery, nodes []*Article, init func(*Article), assign func(*Article, *KeyEvent)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[int]*Article)
+	nids := make(map[int]map[*Article]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(article.KeyEventsTable)
+		s.Join(joinT).On(s.C(keyevent.FieldID), joinT.C(article.KeyEventsPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(article.KeyEventsPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(article.KeyEventsPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := int(values[0].(*sql.NullInt64).Int64)
+				inValue := int(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Article]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*KeyEvent](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "key_events" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (_q *ArticleQuery) loadArticleEntities(ctx context.Context, query *ArticleEntityQuery, nodes []*Article, init func(*Article), assign func(*Article, *ArticleEntity)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Article)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(articleentity.FieldArticleID)
+	}
+	query.Where(predicate.ArticleEntity(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(article.ArticleEntitiesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ArticleID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "article_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *ArticleQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -11,7 +11,9 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/iWorld-y/domain_radar/app/common/ent/article"
+	"github.com/iWorld-y/domain_radar/app/common/ent/articleentity"
 	"github.com/iWorld-y/domain_radar/app/common/ent/domainreport"
+	"github.com/iWorld-y/domain_radar/app/common/ent/keyevent"
 	"github.com/iWorld-y/domain_radar/app/common/ent/predicate"
 )
 
@@ -149,11 +151,68 @@ func (_u *ArticleUpdate) ClearContent() *ArticleUpdate {
 	return _u
 }
 
+// SetRefIndex sets the "ref_index" field.
+func (_u *ArticleUpdate) SetRefIndex(v int) *ArticleUpdate {
+	_u.mutation.ResetRefIndex()
+	_u.mutation.SetRefIndex(v)
+	return _u
+}
+
+// SetNillableRefIndex sets the "ref_index" field if the given value is not nil.
+func (_u *ArticleUpdate) SetNillableRefIndex(v *int) *ArticleUpdate {
+	if v != nil {
+		_u.SetRefIndex(*v)
+	}
+	return _u
+}
+
+// AddRefIndex adds value to the "ref_index" field.
+func (_u *ArticleUpdate) AddRefIndex(v int) *ArticleUpdate {
+	_u.mutation.AddRefIndex(v)
+	return _u
+}
+
+// ClearRefIndex clears the value of the "ref_index" field.
+func (_u *ArticleUpdate) ClearRefIndex() *ArticleUpdate {
+	_u.mutation.ClearRefIndex()
+	return _u
+}
+
 // SetDomainReport sets the "domain_report" edge to the DomainReport entity.
 func (_u *ArticleUpdate) SetDomainReport(v *DomainReport) *ArticleUpdate {
 	return _u.SetDomainReportID(v.ID)
 }
 
+// AddKeyEventIDs adds the "key_events" edge to the KeyEvent entity by IDs.
+func (_u *ArticleUpdate) AddKeyEventIDs(ids ...int) *ArticleUpdate {
+	_u.mutation.AddKeyEventIDs(ids...)
+	return _u
+}
+
+// AddKeyEvents adds the "key_events" edges to the KeyEvent entity.
+func (_u *ArticleUpdate) AddKeyEvents(v ...*KeyEvent) *ArticleUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddKeyEventIDs(ids...)
+}
+
+// AddArticleEntityIDs adds the "article_entities" edge to the ArticleEntity entity by IDs.
+func (_u *ArticleUpdate) AddArticleEntityIDs(ids ...int) *ArticleUpdate {
+	_u.mutation.AddArticleEntityIDs(ids...)
+	return _u
+}
+
+// AddArticleEntities adds the "article_entities" edges to the ArticleEntity entity.
+func (_u *ArticleUpdate) AddArticleEntities(v ...*ArticleEntity) *ArticleUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddArticleEntityIDs(ids...)
+}
+
 // Mutation returns the ArticleMutation object of the builder.
 func (_u *ArticleUpdate) Mutation() *ArticleMutation {
 	return _u.mutation
@@ -165,6 +224,48 @@ func (_u *ArticleUpdate) ClearDomainReport() *ArticleUpdate {
 	return _u
 }
 
+// ClearKeyEvents clears all "key_events" edges to the KeyEvent entity.
+func (_u *ArticleUpdate) ClearKeyEvents() *ArticleUpdate {
+	_u.mutation.ClearKeyEvents()
+	return _u
+}
+
+// RemoveKeyEventIDs removes the "key_events" edge to KeyEvent entities by IDs.
+func (_u *ArticleUpdate) RemoveKeyEventIDs(ids ...int) *ArticleUpdate {
+	_u.mutation.RemoveKeyEventIDs(ids...)
+	return _u
+}
+
+// RemoveKeyEvents removes "key_events" edges to KeyEvent entities.
+func (_u *ArticleUpdate) RemoveKeyEvents(v ...*KeyEvent) *ArticleUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveKeyEventIDs(ids...)
+}
+
+// ClearArticleEntities clears all "article_entities" edges to the ArticleEntity entity.
+func (_u *ArticleUpdate) ClearArticleEntities() *ArticleUpdate {
+	_u.mutation.ClearArticleEntities()
+	return _u
+}
+
+// RemoveArticleEntityIDs removes the "article_entities" edge to ArticleEntity entities by IDs.
+func (_u *ArticleUpdate) RemoveArticleEntityIDs(ids ...int) *ArticleUpdate {
+	_u.mutation.RemoveArticleEntityIDs(ids...)
+	return _u
+}
+
+// RemoveArticleEntities removes "article_entities" edges to ArticleEntity entities.
+func (_u *ArticleUpdate) RemoveArticleEntities(v ...*ArticleEntity) *ArticleUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveArticleEntityIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *ArticleUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -237,6 +338,15 @@ func (_u *ArticleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.ContentCleared() {
 		_spec.ClearField(article.FieldContent, field.TypeString)
 	}
+	if value, ok := _u.mutation.RefIndex(); ok {
+		_spec.SetField(article.FieldRefIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRefIndex(); ok {
+		_spec.AddField(article.FieldRefIndex, field.TypeInt, value)
+	}
+	if _u.mutation.RefIndexCleared() {
+		_spec.ClearField(article.FieldRefIndex, field.TypeInt)
+	}
 	if _u.mutation.DomainReportCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -266,6 +376,96 @@ func (_u *ArticleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.KeyEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   article.KeyEventsTable,
+			Columns: article.KeyEventsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(keyevent.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedKeyEventsIDs(); len(nodes) > 0 && !_u.mutation.KeyEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   article.KeyEventsTable,
+			Columns: article.KeyEventsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(keyevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.KeyEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   article.KeyEventsTable,
+			Columns: article.KeyEventsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(keyevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ArticleEntitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   article.ArticleEntitiesTable,
+			Columns: []string{article.ArticleEntitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(articleentity.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedArticleEntitiesIDs(); len(nodes) > 0 && !_u.mutation.ArticleEntitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   article.ArticleEntitiesTable,
+			Columns: []string{article.ArticleEntitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(articleentity.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ArticleEntitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   article.ArticleEntitiesTable,
+			Columns: []string{article.ArticleEntitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(articleentity.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -408,11 +608,68 @@ func (_u *ArticleUpdateOne) ClearContent() *ArticleUpdateOne {
 	return _u
 }
 
+// SetRefIndex sets the "ref_index" field.
+func (_u *ArticleUpdateOne) SetRefIndex(v int) *ArticleUpdateOne {
+	_u.mutation.ResetRefIndex()
+	_u.mutation.SetRefIndex(v)
+	return _u
+}
+
+// SetNillableRefIndex sets the "ref_index" field if the given value is not nil.
+func (_u *ArticleUpdateOne) SetNillableRefIndex(v *int) *ArticleUpdateOne {
+	if v != nil {
+		_u.SetRefIndex(*v)
+	}
+	return _u
+}
+
+// AddRefIndex adds value to the "ref_index" field.
+func (_u *ArticleUpdateOne) AddRefIndex(v int) *ArticleUpdateOne {
+	_u.mutation.AddRefIndex(v)
+	return _u
+}
+
+// ClearRefIndex clears the value of the "ref_index" field.
+func (_u *ArticleUpdateOne) ClearRefIndex() *ArticleUpdateOne {
+	_u.mutation.ClearRefIndex()
+	return _u
+}
+
 // SetDomainReport sets the "domain_report" edge to the DomainReport entity.
 func (_u *ArticleUpdateOne) SetDomainReport(v *DomainReport) *ArticleUpdateOne {
 	return _u.SetDomainReportID(v.ID)
 }
 
+// AddKeyEventIDs adds the "key_events" edge to the KeyEvent entity by IDs.
+func (_u *ArticleUpdateOne) AddKeyEventIDs(ids ...int) *ArticleUpdateOne {
+	_u.mutation.AddKeyEventIDs(ids...)
+	return _u
+}
+
+// AddKeyEvents adds the "key_events" edges to the KeyEvent entity.
+func (_u *ArticleUpdateOne) AddKeyEvents(v ...*KeyEvent) *ArticleUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddKeyEventIDs(ids...)
+}
+
+// AddArticleEntityIDs adds the "article_entities" edge to the ArticleEntity entity by IDs.
+func (_u *ArticleUpdateOne) AddArticleEntityIDs(ids ...int) *ArticleUpdateOne {
+	_u.mutation.AddArticleEntityIDs(ids...)
+	return _u
+}
+
+// AddArticleEntities adds the "article_entities" edges to the ArticleEntity entity.
+func (_u *ArticleUpdateOne) AddArticleEntities(v ...*ArticleEntity) *ArticleUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddArticleEntityIDs(ids...)
+}
+
 // Mutation returns the ArticleMutation object of the builder.
 func (_u *ArticleUpdateOne) Mutation() *ArticleMutation {
 	return _u.mutation
@@ -424,6 +681,48 @@ func (_u *ArticleUpdateOne) ClearDomainReport() *ArticleUpdateOne {
 	return _u
 }
 
+// ClearKeyEvents clears all "key_events" edges to the KeyEvent entity.
+func (_u *ArticleUpdateOne) ClearKeyEvents() *ArticleUpdateOne {
+	_u.mutation.ClearKeyEvents()
+	return _u
+}
+
+// RemoveKeyEventIDs removes the "key_events" edge to KeyEvent entities by IDs.
+func (_u *ArticleUpdateOne) RemoveKeyEventIDs(ids ...int) *ArticleUpdateOne {
+	_u.mutation.RemoveKeyEventIDs(ids...)
+	return _u
+}
+
+// RemoveKeyEvents removes "key_events" edges to KeyEvent entities.
+func (_u *ArticleUpdateOne) RemoveKeyEvents(v ...*KeyEvent) *ArticleUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveKeyEventIDs(ids...)
+}
+
+// ClearArticleEntities clears all "article_entities" edges to the ArticleEntity entity.
+func (_u *ArticleUpdateOne) ClearArticleEntities() *ArticleUpdateOne {
+	_u.mutation.ClearArticleEntities()
+	return _u
+}
+
+// RemoveArticleEntityIDs removes the "article_entities" edge to ArticleEntity entities by IDs.
+func (_u *ArticleUpdateOne) RemoveArticleEntityIDs(ids ...int) *ArticleUpdateOne {
+	_u.mutation.RemoveArticleEntityIDs(ids...)
+	return _u
+}
+
+// RemoveArticleEntities removes "article_entities" edges to ArticleEntity entities.
+func (_u *ArticleUpdateOne) RemoveArticleEntities(v ...*ArticleEntity) *ArticleUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveArticleEntityIDs(ids...)
+}
+
 // Where appends a list predicates to the ArticleUpdate builder.
 func (_u *ArticleUpdateOne) Where(ps ...predicate.Article) *ArticleUpdateOne {
 	_u.mutation.Where(ps...)
@@ -526,6 +825,15 @@ func (_u *ArticleUpdateOne) sqlSave(ctx context.Context) (_node *Article, err er
 	if _u.mutation.ContentCleared() {
 		_spec.ClearField(article.FieldContent, field.TypeString)
 	}
+	if value, ok := _u.mutation.RefIndex(); ok {
+		_spec.SetField(article.FieldRefIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRefIndex(); ok {
+		_spec.AddField(article.FieldRefIndex, field.TypeInt, value)
+	}
+	if _u.mutation.RefIndexCleared() {
+		_spec.ClearField(article.FieldRefIndex, field.TypeInt)
+	}
 	if _u.mutation.DomainReportCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -555,6 +863,96 @@ func (_u *ArticleUpdateOne) sqlSave(ctx context.Context) (_node *Article, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.KeyEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   article.KeyEventsTable,
+			Columns: article.KeyEventsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(keyevent.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedKeyEventsIDs(); len(nodes) > 0 && !_u.mutation.KeyEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   article.KeyEventsTable,
+			Columns: article.KeyEventsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(keyevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.KeyEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   article.KeyEventsTable,
+			Columns: article.KeyEventsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(keyevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ArticleEntitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   article.ArticleEntitiesTable,
+			Columns: []string{article.ArticleEntitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(articleentity.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedArticleEntitiesIDs(); len(nodes) > 0 && !_u.mutation.ArticleEntitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   article.ArticleEntitiesTable,
+			Columns: []string{article.ArticleEntitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(articleentity.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ArticleEntitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   article.ArticleEntitiesTable,
+			Columns: []string{article.ArticleEntitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(articleentity.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &Article{config: _u.config}
 	_spec.Assign = _node.assignValues
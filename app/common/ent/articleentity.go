@@ -0,0 +1,173 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/iWorld-y/domain_radar/app/common/ent/article"
+	"github.com/iWorld-y/domain_radar/app/common/ent/articleentity"
+	"github.com/iWorld-y/domain_radar/app/common/ent/entity"
+)
+
+// ArticleEntity is the model entity for the ArticleEntity schema.
+type ArticleEntity struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ArticleID holds the value of the "article_id" field.
+	ArticleID int `json:"article_id,omitempty"`
+	// EntityID holds the value of the "entity_id" field.
+	EntityID int `json:"entity_id,omitempty"`
+	// Semicolon separated surface forms of the entity before normalization
+	Mention string `json:"mention,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ArticleEntityQuery when eager-loading is set.
+	Edges        ArticleEntityEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ArticleEntityEdges holds the relations/edges for other nodes in the graph.
+type ArticleEntityEdges struct {
+	// Article holds the value of the article edge.
+	Article *Article `json:"article,omitempty"`
+	// Entity holds the value of the entity edge.
+	Entity *Entity `json:"entity,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// ArticleOrErr returns the Article value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ArticleEntityEdges) ArticleOrErr() (*Article, error) {
+	if e.Article != nil {
+		return e.Article, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: article.Label}
+	}
+	return nil, &NotLoadedError{edge: "article"}
+}
+
+// EntityOrErr returns the Entity value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ArticleEntityEdges) EntityOrErr() (*Entity, error) {
+	if e.Entity != nil {
+		return e.Entity, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: entity.Label}
+	}
+	return nil, &NotLoadedError{edge: "entity"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ArticleEntity) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case articleentity.FieldID, articleentity.FieldArticleID, articleentity.FieldEntityID:
+			values[i] = new(sql.NullInt64)
+		case articleentity.FieldMention:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ArticleEntity fields.
+func (_m *ArticleEntity) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case articleentity.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case articleentity.FieldArticleID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field article_id", values[i])
+			} else if value.Valid {
+				_m.ArticleID = int(value.Int64)
+			}
+		case articleentity.FieldEntityID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field entity_id", values[i])
+			} else if value.Valid {
+				_m.EntityID = int(value.Int64)
+			}
+		case articleentity.FieldMention:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field mention", values[i])
+			} else if value.Valid {
+				_m.Mention = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ArticleEntity.
+// This includes values selected through modifiers, order, etc.
+func (_m *ArticleEntity) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryArticle queries the "article" edge of the ArticleEntity entity.
+func (_m *ArticleEntity) QueryArticle() *ArticleQuery {
+	return NewArticleEntityClient(_m.config).QueryArticle(_m)
+}
+
+// QueryEntity queries the "entity" edge of the ArticleEntity entity.
+func (_m *ArticleEntity) QueryEntity() *EntityQuery {
+	return NewArticleEntityClient(_m.config).QueryEntity(_m)
+}
+
+// Update returns a builder for updating this ArticleEntity.
+// Note that you need to call ArticleEntity.Unwrap() before calling this method if this ArticleEntity
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ArticleEntity) Update() *ArticleEntityUpdateOne {
+	return NewArticleEntityClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ArticleEntity entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ArticleEntity) Unwrap() *ArticleEntity {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ArticleEntity is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ArticleEntity) String() string {
+	var builder strings.Builder
+	builder.WriteString("ArticleEntity(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("article_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ArticleID))
+	builder.WriteString(", ")
+	builder.WriteString("entity_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EntityID))
+	builder.WriteString(", ")
+	builder.WriteString("mention=")
+	builder.WriteString(_m.Mention)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ArticleEntities is a parsable slice of ArticleEntity.
+type ArticleEntities []*ArticleEntity
@@ -0,0 +1,245 @@
+// Code generated by ent, DO NOT EDIT.
+
+package articleentity
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/iWorld-y/domain_radar/app/common/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ArticleEntity {
+	return predicate.ArticleEntity(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ArticleEntity {
+	return predicate.ArticleEntity(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ArticleEntity {
+	return predicate.ArticleEntity(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ArticleEntity {
+	return predicate.ArticleEntity(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ArticleEntity {
+	return predicate.ArticleEntity(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ArticleEntity {
+	return predicate.ArticleEntity(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ArticleEntity {
+	return predicate.ArticleEntity(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ArticleEntity {
+	return predicate.ArticleEntity(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ArticleEntity {
+	return predicate.ArticleEntity(sql.FieldLTE(FieldID, id))
+}
+
+// ArticleID applies equality check predicate on the "article_id" field. It's identical to ArticleIDEQ.
+func ArticleID(v int) predicate.ArticleEntity {
+	return predicate.ArticleEntity(sql.FieldEQ(FieldArticleID, v))
+}
+
+// EntityID applies equality check predicate on the "entity_id" field. It's identical to EntityIDEQ.
+func EntityID(v int) predicate.ArticleEntity {
+	return predicate.ArticleEntity(sql.FieldEQ(FieldEntityID, v))
+}
+
+// Mention applies equality check predicate on the "mention" field. It's identical to MentionEQ.
+func Mention(v string) predicate.ArticleEntity {
+	return predicate.ArticleEntity(sql.FieldEQ(FieldMention, v))
+}
+
+// ArticleIDEQ applies the EQ predicate on the "article_id" field.
+func ArticleIDEQ(v int) predicate.ArticleEntity {
+	return predicate.ArticleEntity(sql.FieldEQ(FieldArticleID, v))
+}
+
+// ArticleIDNEQ applies the NEQ predicate on the "article_id" field.
+func ArticleIDNEQ(v int) predicate.ArticleEntity {
+	return predicate.ArticleEntity(sql.FieldNEQ(FieldArticleID, v))
+}
+
+// ArticleIDIn applies the In predicate on the "article_id" field.
+func ArticleIDIn(vs ...int) predicate.ArticleEntity {
+	return predicate.ArticleEntity(sql.FieldIn(FieldArticleID, vs...))
+}
+
+// ArticleIDNotIn applies the NotIn predicate on the "article_id" field.
+func ArticleIDNotIn(vs ...int) predicate.ArticleEntity {
+	return predicate.ArticleEntity(sql.FieldNotIn(FieldArticleID, vs...))
+}
+
+// EntityIDEQ applies the EQ predicate on the "entity_id" field.
+func EntityIDEQ(v int) predicate.ArticleEntity {
+	return predicate.ArticleEntity(sql.FieldEQ(FieldEntityID, v))
+}
+
+// EntityIDNEQ applies the NEQ predicate on the "entity_id" field.
+func EntityIDNEQ(v int) predicate.ArticleEntity {
+	return predicate.ArticleEntity(sql.FieldNEQ(FieldEntityID, v))
+}
+
+// EntityIDIn applies the In predicate on the "entity_id" field.
+func EntityIDIn(vs ...int) predicate.ArticleEntity {
+	return predicate.ArticleEntity(sql.FieldIn(FieldEntityID, vs...))
+}
+
+// EntityIDNotIn applies the NotIn predicate on the "entity_id" field.
+func EntityIDNotIn(vs ...int) predicate.ArticleEntity {
+	return predicate.ArticleEntity(sql.FieldNotIn(FieldEntityID, vs...))
+}
+
+// MentionEQ applies the EQ predicate on the "mention" field.
+func MentionEQ(v string) predicate.ArticleEntity {
+	return predicate.ArticleEntity(sql.FieldEQ(FieldMention, v))
+}
+
+// MentionNEQ applies the NEQ predicate on the "mention" field.
+func MentionNEQ(v string) predicate.ArticleEntity {
+	return predicate.ArticleEntity(sql.FieldNEQ(FieldMention, v))
+}
+
+// MentionIn applies the In predicate on the "mention" field.
+func MentionIn(vs ...string) predicate.ArticleEntity {
+	return predicate.ArticleEntity(sql.FieldIn(FieldMention, vs...))
+}
+
+// MentionNotIn applies the NotIn predicate on the "mention" field.
+func MentionNotIn(vs ...string) predicate.ArticleEntity {
+	return predicate.ArticleEntity(sql.FieldNotIn(FieldMention, vs...))
+}
+
+// MentionGT applies the GT predicate on the "mention" field.
+func MentionGT(v string) predicate.ArticleEntity {
+	return predicate.ArticleEntity(sql.FieldGT(FieldMention, v))
+}
+
+// MentionGTE applies the GTE predicate on the "mention" field.
+func MentionGTE(v string) predicate.ArticleEntity {
+	return predicate.ArticleEntity(sql.FieldGTE(FieldMention, v))
+}
+
+// MentionLT applies the LT predicate on the "mention" field.
+func MentionLT(v string) predicate.ArticleEntity {
+	return predicate.ArticleEntity(sql.FieldLT(FieldMention, v))
+}
+
+// MentionLTE applies the LTE predicate on the "mention" field.
+func MentionLTE(v string) predicate.ArticleEntity {
+	return predicate.ArticleEntity(sql.FieldLTE(FieldMention, v))
+}
+
+// MentionContains applies the Contains predicate on the "mention" field.
+func MentionContains(v string) predicate.ArticleEntity {
+	return predicate.ArticleEntity(sql.FieldContains(FieldMention, v))
+}
+
+// MentionHasPrefix applies the HasPrefix predicate on the "mention" field.
+func MentionHasPrefix(v string) predicate.ArticleEntity {
+	return predicate.ArticleEntity(sql.FieldHasPrefix(FieldMention, v))
+}
+
+// MentionHasSuffix applies the HasSuffix predicate on the "mention" field.
+func MentionHasSuffix(v string) predicate.ArticleEntity {
+	return predicate.ArticleEntity(sql.FieldHasSuffix(FieldMention, v))
+}
+
+// MentionIsNil applies the IsNil predicate on the "mention" field.
+func MentionIsNil() predicate.ArticleEntity {
+	return predicate.ArticleEntity(sql.FieldIsNull(FieldMention))
+}
+
+// MentionNotNil applies the NotNil predicate on the "mention" field.
+func MentionNotNil() predicate.ArticleEntity {
+	return predicate.ArticleEntity(sql.FieldNotNull(FieldMention))
+}
+
+// MentionEqualFold applies the EqualFold predicate on the "mention" field.
+func MentionEqualFold(v string) predicate.ArticleEntity {
+	return predicate.ArticleEntity(sql.FieldEqualFold(FieldMention, v))
+}
+
+// MentionContainsFold applies the ContainsFold predicate on the "mention" field.
+func MentionContainsFold(v string) predicate.ArticleEntity {
+	return predicate.ArticleEntity(sql.FieldContainsFold(FieldMention, v))
+}
+
+// HasArticle applies the HasEdge predicate on the "article" edge.
+func HasArticle() predicate.ArticleEntity {
+	return predicate.ArticleEntity(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ArticleTable, ArticleColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasArticleWith applies the HasEdge predicate on the "article" edge with a given conditions (other predicates).
+func HasArticleWith(preds ...predicate.Article) predicate.ArticleEntity {
+	return predicate.ArticleEntity(func(s *sql.Selector) {
+		step := newArticleStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasEntity applies the HasEdge predicate on the "entity" edge.
+func HasEntity() predicate.ArticleEntity {
+	return predicate.ArticleEntity(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, EntityTable, EntityColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEntityWith applies the HasEdge predicate on the "entity" edge with a given conditions (other predicates).
+func HasEntityWith(preds ...predicate.Entity) predicate.ArticleEntity {
+	return predicate.ArticleEntity(func(s *sql.Selector) {
+		step := newEntityStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ArticleEntity) predicate.ArticleEntity {
+	return predicate.ArticleEntity(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ArticleEntity) predicate.ArticleEntity {
+	return predicate.ArticleEntity(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ArticleEntity) predicate.ArticleEntity {
+	return predicate.ArticleEntity(sql.NotPredicates(p))
+}
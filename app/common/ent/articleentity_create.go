@@ -0,0 +1,659 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/iWorld-y/domain_radar/app/common/ent/article"
+	"github.com/iWorld-y/domain_radar/app/common/ent/articleentity"
+	"github.com/iWorld-y/domain_radar/app/common/ent/entity"
+)
+
+// ArticleEntityCreate is the builder for creating a ArticleEntity entity.
+type ArticleEntityCreate struct {
+	config
+	mutation *ArticleEntityMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetArticleID sets the "article_id" field.
+func (_c *ArticleEntityCreate) SetArticleID(v int) *ArticleEntityCreate {
+	_c.mutation.SetArticleID(v)
+	return _c
+}
+
+// SetEntityID sets the "entity_id" field.
+func (_c *ArticleEntityCreate) SetEntityID(v int) *ArticleEntityCreate {
+	_c.mutation.SetEntityID(v)
+	return _c
+}
+
+// SetMention sets the "mention" field.
+func (_c *ArticleEntityCreate) SetMention(v string) *ArticleEntityCreate {
+	_c.mutation.SetMention(v)
+	return _c
+}
+
+// SetNillableMention sets the "mention" field if the given value is not nil.
+func (_c *ArticleEntityCreate) SetNillableMention(v *string) *ArticleEntityCreate {
+	if v != nil {
+		_c.SetMention(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ArticleEntityCreate) SetID(v int) *ArticleEntityCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetArticle sets the "article" edge to the Article entity.
+func (_c *ArticleEntityCreate) SetArticle(v *Article) *ArticleEntityCreate {
+	return _c.SetArticleID(v.ID)
+}
+
+// SetEntity sets the "entity" edge to the Entity entity.
+func (_c *ArticleEntityCreate) SetEntity(v *Entity) *ArticleEntityCreate {
+	return _c.SetEntityID(v.ID)
+}
+
+// Mutation returns the ArticleEntityMutation object of the builder.
+func (_c *ArticleEntityCreate) Mutation() *ArticleEntityMutation {
+	return _c.mutation
+}
+
+// Save creates the ArticleEntity in the database.
+func (_c *ArticleEntityCreate) Save(ctx context.Context) (*ArticleEntity, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ArticleEntityCreate) SaveX(ctx context.Context) *ArticleEntity {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ArticleEntityCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ArticleEntityCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ArticleEntityCreate) check() error {
+	if _, ok := _c.mutation.ArticleID(); !ok {
+		return &ValidationError{Name: "article_id", err: errors.New(`ent: missing required field "ArticleEntity.article_id"`)}
+	}
+	if _, ok := _c.mutation.EntityID(); !ok {
+		return &ValidationError{Name: "entity_id", err: errors.New(`ent: missing required field "ArticleEntity.entity_id"`)}
+	}
+	if len(_c.mutation.ArticleIDs()) == 0 {
+		return &ValidationError{Name: "article", err: errors.New(`ent: missing required edge "ArticleEntity.article"`)}
+	}
+	if len(_c.mutation.EntityIDs()) == 0 {
+		return &ValidationError{Name: "entity", err: errors.New(`ent: missing required edge "ArticleEntity.entity"`)}
+	}
+	return nil
+}
+
+func (_c *ArticleEntityCreate) sqlSave(ctx context.Context) (*ArticleEntity, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ArticleEntityCreate) createSpec() (*ArticleEntity, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ArticleEntity{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(articleentity.Table, sqlgraph.NewFieldSpec(articleentity.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.Mention(); ok {
+		_spec.SetField(articleentity.FieldMention, field.TypeString, value)
+		_node.Mention = value
+	}
+	if nodes := _c.mutation.ArticleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   articleentity.ArticleTable,
+			Columns: []string{articleentity.ArticleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(article.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ArticleID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.EntityIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   articleentity.EntityTable,
+			Columns: []string{articleentity.EntityColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(entity.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.EntityID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ArticleEntity.Create().
+//		SetArticleID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ArticleEntityUpsert) {
+//			SetArticleID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ArticleEntityCreate) OnConflict(opts ...sql.ConflictOption) *ArticleEntityUpsertOne {
+	_c.conflict = opts
+	return &ArticleEntityUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ArticleEntity.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ArticleEntityCreate) OnConflictColumns(columns ...string) *ArticleEntityUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ArticleEntityUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ArticleEntityUpsertOne is the builder for "upsert"-ing
+	//  one ArticleEntity node.
+	ArticleEntityUpsertOne struct {
+		create *ArticleEntityCreate
+	}
+
+	// ArticleEntityUpsert is the "OnConflict" setter.
+	ArticleEntityUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetArticleID sets the "article_id" field.
+func (u *ArticleEntityUpsert) SetArticleID(v int) *ArticleEntityUpsert {
+	u.Set(articleentity.FieldArticleID, v)
+	return u
+}
+
+// UpdateArticleID sets the "article_id" field to the value that was provided on create.
+func (u *ArticleEntityUpsert) UpdateArticleID() *ArticleEntityUpsert {
+	u.SetExcluded(articleentity.FieldArticleID)
+	return u
+}
+
+// SetEntityID sets the "entity_id" field.
+func (u *ArticleEntityUpsert) SetEntityID(v int) *ArticleEntityUpsert {
+	u.Set(articleentity.FieldEntityID, v)
+	return u
+}
+
+// UpdateEntityID sets the "entity_id" field to the value that was provided on create.
+func (u *ArticleEntityUpsert) UpdateEntityID() *ArticleEntityUpsert {
+	u.SetExcluded(articleentity.FieldEntityID)
+	return u
+}
+
+// SetMention sets the "mention" field.
+func (u *ArticleEntityUpsert) SetMention(v string) *ArticleEntityUpsert {
+	u.Set(articleentity.FieldMention, v)
+	return u
+}
+
+// UpdateMention sets the "mention" field to the value that was provided on create.
+func (u *ArticleEntityUpsert) UpdateMention() *ArticleEntityUpsert {
+	u.SetExcluded(articleentity.FieldMention)
+	return u
+}
+
+// ClearMention clears the value of the "mention" field.
+func (u *ArticleEntityUpsert) ClearMention() *ArticleEntityUpsert {
+	u.SetNull(articleentity.FieldMention)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ArticleEntity.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(articleentity.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ArticleEntityUpsertOne) UpdateNewValues() *ArticleEntityUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(articleentity.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ArticleEntity.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ArticleEntityUpsertOne) Ignore() *ArticleEntityUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ArticleEntityUpsertOne) DoNothing() *ArticleEntityUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ArticleEntityCreate.OnConflict
+// documentation for more info.
+func (u *ArticleEntityUpsertOne) Update(set func(*ArticleEntityUpsert)) *ArticleEntityUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ArticleEntityUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetArticleID sets the "article_id" field.
+func (u *ArticleEntityUpsertOne) SetArticleID(v int) *ArticleEntityUpsertOne {
+	return u.Update(func(s *ArticleEntityUpsert) {
+		s.SetArticleID(v)
+	})
+}
+
+// UpdateArticleID sets the "article_id" field to the value that was provided on create.
+func (u *ArticleEntityUpsertOne) UpdateArticleID() *ArticleEntityUpsertOne {
+	return u.Update(func(s *ArticleEntityUpsert) {
+		s.UpdateArticleID()
+	})
+}
+
+// SetEntityID sets the "entity_id" field.
+func (u *ArticleEntityUpsertOne) SetEntityID(v int) *ArticleEntityUpsertOne {
+	return u.Update(func(s *ArticleEntityUpsert) {
+		s.SetEntityID(v)
+	})
+}
+
+// UpdateEntityID sets the "entity_id" field to the value that was provided on create.
+func (u *ArticleEntityUpsertOne) UpdateEntityID() *ArticleEntityUpsertOne {
+	return u.Update(func(s *ArticleEntityUpsert) {
+		s.UpdateEntityID()
+	})
+}
+
+// SetMention sets the "mention" field.
+func (u *ArticleEntityUpsertOne) SetMention(v string) *ArticleEntityUpsertOne {
+	return u.Update(func(s *ArticleEntityUpsert) {
+		s.SetMention(v)
+	})
+}
+
+// UpdateMention sets the "mention" field to the value that was provided on create.
+func (u *ArticleEntityUpsertOne) UpdateMention() *ArticleEntityUpsertOne {
+	return u.Update(func(s *ArticleEntityUpsert) {
+		s.UpdateMention()
+	})
+}
+
+// ClearMention clears the value of the "mention" field.
+func (u *ArticleEntityUpsertOne) ClearMention() *ArticleEntityUpsertOne {
+	return u.Update(func(s *ArticleEntityUpsert) {
+		s.ClearMention()
+	})
+}
+
+// Exec executes the query.
+func (u *ArticleEntityUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ArticleEntityCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ArticleEntityUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ArticleEntityUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ArticleEntityUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ArticleEntityCreateBulk is the builder for creating many ArticleEntity entities in bulk.
+type ArticleEntityCreateBulk struct {
+	config
+	err      error
+	builders []*ArticleEntityCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ArticleEntity entities in the database.
+func (_c *ArticleEntityCreateBulk) Save(ctx context.Context) ([]*ArticleEntity, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ArticleEntity, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ArticleEntityMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ArticleEntityCreateBulk) SaveX(ctx context.Context) []*ArticleEntity {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ArticleEntityCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ArticleEntityCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ArticleEntity.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ArticleEntityUpsert) {
+//			SetArticleID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ArticleEntityCreateBulk) OnConflict(opts ...sql.ConflictOption) *ArticleEntityUpsertBulk {
+	_c.conflict = opts
+	return &ArticleEntityUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ArticleEntity.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ArticleEntityCreateBulk) OnConflictColumns(columns ...string) *ArticleEntityUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ArticleEntityUpsertBulk{
+		create: _c,
+	}
+}
+
+// ArticleEntityUpsertBulk is the builder for "upsert"-ing
+// a bulk of ArticleEntity nodes.
+type ArticleEntityUpsertBulk struct {
+	create *ArticleEntityCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ArticleEntity.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(articleentity.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ArticleEntityUpsertBulk) UpdateNewValues() *ArticleEntityUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(articleentity.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ArticleEntity.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ArticleEntityUpsertBulk) Ignore() *ArticleEntityUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ArticleEntityUpsertBulk) DoNothing() *ArticleEntityUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ArticleEntityCreateBulk.OnConflict
+// documentation for more info.
+func (u *ArticleEntityUpsertBulk) Update(set func(*ArticleEntityUpsert)) *ArticleEntityUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ArticleEntityUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetArticleID sets the "article_id" field.
+func (u *ArticleEntityUpsertBulk) SetArticleID(v int) *ArticleEntityUpsertBulk {
+	return u.Update(func(s *ArticleEntityUpsert) {
+		s.SetArticleID(v)
+	})
+}
+
+// UpdateArticleID sets the "article_id" field to the value that was provided on create.
+func (u *ArticleEntityUpsertBulk) UpdateArticleID() *ArticleEntityUpsertBulk {
+	return u.Update(func(s *ArticleEntityUpsert) {
+		s.UpdateArticleID()
+	})
+}
+
+// SetEntityID sets the "entity_id" field.
+func (u *ArticleEntityUpsertBulk) SetEntityID(v int) *ArticleEntityUpsertBulk {
+	return u.Update(func(s *ArticleEntityUpsert) {
+		s.SetEntityID(v)
+	})
+}
+
+// UpdateEntityID sets the "entity_id" field to the value that was provided on create.
+func (u *ArticleEntityUpsertBulk) UpdateEntityID() *ArticleEntityUpsertBulk {
+	return u.Update(func(s *ArticleEntityUpsert) {
+		s.UpdateEntityID()
+	})
+}
+
+// SetMention sets the "mention" field.
+func (u *ArticleEntityUpsertBulk) SetMention(v string) *ArticleEntityUpsertBulk {
+	return u.Update(func(s *ArticleEntityUpsert) {
+		s.SetMention(v)
+	})
+}
+
+// UpdateMention sets the "mention" field to the value that was provided on create.
+func (u *ArticleEntityUpsertBulk) UpdateMention() *ArticleEntityUpsertBulk {
+	return u.Update(func(s *ArticleEntityUpsert) {
+		s.UpdateMention()
+	})
+}
+
+// ClearMention clears the value of the "mention" field.
+func (u *ArticleEntityUpsertBulk) ClearMention() *ArticleEntityUpsertBulk {
+	return u.Update(func(s *ArticleEntityUpsert) {
+		s.ClearMention()
+	})
+}
+
+// Exec executes the query.
+func (u *ArticleEntityUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ArticleEntityCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ArticleEntityCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ArticleEntityUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,489 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/iWorld-y/domain_radar/app/common/ent/article"
+	"github.com/iWorld-y/domain_radar/app/common/ent/articleentity"
+	"github.com/iWorld-y/domain_radar/app/common/ent/entity"
+	"github.com/iWorld-y/domain_radar/app/common/ent/predicate"
+)
+
+// ArticleEntityUpdate is the builder for updating ArticleEntity entities.
+type ArticleEntityUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ArticleEntityMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ArticleEntityUpdate builder.
+func (_u *ArticleEntityUpdate) Where(ps ...predicate.ArticleEntity) *ArticleEntityUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetArticleID sets the "article_id" field.
+func (_u *ArticleEntityUpdate) SetArticleID(v int) *ArticleEntityUpdate {
+	_u.mutation.SetArticleID(v)
+	return _u
+}
+
+// SetNillableArticleID sets the "article_id" field if the given value is not nil.
+func (_u *ArticleEntityUpdate) SetNillableArticleID(v *int) *ArticleEntityUpdate {
+	if v != nil {
+		_u.SetArticleID(*v)
+	}
+	return _u
+}
+
+// SetEntityID sets the "entity_id" field.
+func (_u *ArticleEntityUpdate) SetEntityID(v int) *ArticleEntityUpdate {
+	_u.mutation.SetEntityID(v)
+	return _u
+}
+
+// SetNillableEntityID sets the "entity_id" field if the given value is not nil.
+func (_u *ArticleEntityUpdate) SetNillableEntityID(v *int) *ArticleEntityUpdate {
+	if v != nil {
+		_u.SetEntityID(*v)
+	}
+	return _u
+}
+
+// SetMention sets the "mention" field.
+func (_u *ArticleEntityUpdate) SetMention(v string) *ArticleEntityUpdate {
+	_u.mutation.SetMention(v)
+	return _u
+}
+
+// SetNillableMention sets the "mention" field if the given value is not nil.
+func (_u *ArticleEntityUpdate) SetNillableMention(v *string) *ArticleEntityUpdate {
+	if v != nil {
+		_u.SetMention(*v)
+	}
+	return _u
+}
+
+// ClearMention clears the value of the "mention" field.
+func (_u *ArticleEntityUpdate) ClearMention() *ArticleEntityUpdate {
+	_u.mutation.ClearMention()
+	return _u
+}
+
+// SetArticle sets the "article" edge to the Article entity.
+func (_u *ArticleEntityUpdate) SetArticle(v *Article) *ArticleEntityUpdate {
+	return _u.SetArticleID(v.ID)
+}
+
+// SetEntity sets the "entity" edge to the Entity entity.
+func (_u *ArticleEntityUpdate) SetEntity(v *Entity) *ArticleEntityUpdate {
+	return _u.SetEntityID(v.ID)
+}
+
+// Mutation returns the ArticleEntityMutation object of the builder.
+func (_u *ArticleEntityUpdate) Mutation() *ArticleEntityMutation {
+	return _u.mutation
+}
+
+// ClearArticle clears the "article" edge to the Article entity.
+func (_u *ArticleEntityUpdate) ClearArticle() *ArticleEntityUpdate {
+	_u.mutation.ClearArticle()
+	return _u
+}
+
+// ClearEntity clears the "entity" edge to the Entity entity.
+func (_u *ArticleEntityUpdate) ClearEntity() *ArticleEntityUpdate {
+	_u.mutation.ClearEntity()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ArticleEntityUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ArticleEntityUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ArticleEntityUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ArticleEntityUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ArticleEntityUpdate) check() error {
+	if _u.mutation.ArticleCleared() && len(_u.mutation.ArticleIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ArticleEntity.article"`)
+	}
+	if _u.mutation.EntityCleared() && len(_u.mutation.EntityIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ArticleEntity.entity"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ArticleEntityUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ArticleEntityUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ArticleEntityUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(articleentity.Table, articleentity.Columns, sqlgraph.NewFieldSpec(articleentity.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Mention(); ok {
+		_spec.SetField(articleentity.FieldMention, field.TypeString, value)
+	}
+	if _u.mutation.MentionCleared() {
+		_spec.ClearField(articleentity.FieldMention, field.TypeString)
+	}
+	if _u.mutation.ArticleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   articleentity.ArticleTable,
+			Columns: []string{articleentity.ArticleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(article.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ArticleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   articleentity.ArticleTable,
+			Columns: []string{articleentity.ArticleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(article.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.EntityCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   articleentity.EntityTable,
+			Columns: []string{articleentity.EntityColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(entity.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EntityIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   articleentity.EntityTable,
+			Columns: []string{articleentity.EntityColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(entity.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{articleentity.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ArticleEntityUpdateOne is the builder for updating a single ArticleEntity entity.
+type ArticleEntityUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ArticleEntityMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetArticleID sets the "article_id" field.
+func (_u *ArticleEntityUpdateOne) SetArticleID(v int) *ArticleEntityUpdateOne {
+	_u.mutation.SetArticleID(v)
+	return _u
+}
+
+// SetNillableArticleID sets the "article_id" field if the given value is not nil.
+func (_u *ArticleEntityUpdateOne) SetNillableArticleID(v *int) *ArticleEntityUpdateOne {
+	if v != nil {
+		_u.SetArticleID(*v)
+	}
+	return _u
+}
+
+// SetEntityID sets the "entity_id" field.
+func (_u *ArticleEntityUpdateOne) SetEntityID(v int) *ArticleEntityUpdateOne {
+	_u.mutation.SetEntityID(v)
+	return _u
+}
+
+// SetNillableEntityID sets the "entity_id" field if the given value is not nil.
+func (_u *ArticleEntityUpdateOne) SetNillableEntityID(v *int) *ArticleEntityUpdateOne {
+	if v != nil {
+		_u.SetEntityID(*v)
+	}
+	return _u
+}
+
+// SetMention sets the "mention" field.
+func (_u *ArticleEntityUpdateOne) SetMention(v string) *ArticleEntityUpdateOne {
+	_u.mutation.SetMention(v)
+	return _u
+}
+
+// SetNillableMention sets the "mention" field if the given value is not nil.
+func (_u *ArticleEntityUpdateOne) SetNillableMention(v *string) *ArticleEntityUpdateOne {
+	if v != nil {
+		_u.SetMention(*v)
+	}
+	return _u
+}
+
+// ClearMention clears the value of the "mention" field.
+func (_u *ArticleEntityUpdateOne) ClearMention() *ArticleEntityUpdateOne {
+	_u.mutation.ClearMention()
+	return _u
+}
+
+// SetArticle sets the "article" edge to the Article entity.
+func (_u *ArticleEntityUpdateOne) SetArticle(v *Article) *ArticleEntityUpdateOne {
+	return _u.SetArticleID(v.ID)
+}
+
+// SetEntity sets the "entity" edge to the Entity entity.
+func (_u *ArticleEntityUpdateOne) SetEntity(v *Entity) *ArticleEntityUpdateOne {
+	return _u.SetEntityID(v.ID)
+}
+
+// Mutation returns the ArticleEntityMutation object of the builder.
+func (_u *ArticleEntityUpdateOne) Mutation() *ArticleEntityMutation {
+	return _u.mutation
+}
+
+// ClearArticle clears the "article" edge to the Article entity.
+func (_u *ArticleEntityUpdateOne) ClearArticle() *ArticleEntityUpdateOne {
+	_u.mutation.ClearArticle()
+	return _u
+}
+
+// ClearEntity clears the "entity" edge to the Entity entity.
+func (_u *ArticleEntityUpdateOne) ClearEntity() *ArticleEntityUpdateOne {
+	_u.mutation.ClearEntity()
+	return _u
+}
+
+// Where appends a list predicates to the ArticleEntityUpdate builder.
+func (_u *ArticleEntityUpdateOne) Where(ps ...predicate.ArticleEntity) *ArticleEntityUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ArticleEntityUpdateOne) Select(field string, fields ...string) *ArticleEntityUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ArticleEntity entity.
+func (_u *ArticleEntityUpdateOne) Save(ctx context.Context) (*ArticleEntity, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ArticleEntityUpdateOne) SaveX(ctx context.Context) *ArticleEntity {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ArticleEntityUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ArticleEntityUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ArticleEntityUpdateOne) check() error {
+	if _u.mutation.ArticleCleared() && len(_u.mutation.ArticleIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ArticleEntity.article"`)
+	}
+	if _u.mutation.EntityCleared() && len(_u.mutation.EntityIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ArticleEntity.entity"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ArticleEntityUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ArticleEntityUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ArticleEntityUpdateOne) sqlSave(ctx context.Context) (_node *ArticleEntity, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(articleentity.Table, articleentity.Columns, sqlgraph.NewFieldSpec(articleentity.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ArticleEntity.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, articleentity.FieldID)
+		for _, f := range fields {
+			if !articleentity.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != articleentity.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Mention(); ok {
+		_spec.SetField(articleentity.FieldMention, field.TypeString, value)
+	}
+	if _u.mutation.MentionCleared() {
+		_spec.ClearField(articleentity.FieldMention, field.TypeString)
+	}
+	if _u.mutation.ArticleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   articleentity.ArticleTable,
+			Columns: []string{articleentity.ArticleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(article.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ArticleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   articleentity.ArticleTable,
+			Columns: []string{articleentity.ArticleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(article.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.EntityCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   articleentity.EntityTable,
+			Columns: []string{articleentity.EntityColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(entity.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EntityIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   articleentity.EntityTable,
+			Columns: []string{articleentity.EntityColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(entity.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &ArticleEntity{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{articleentity.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
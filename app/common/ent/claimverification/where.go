@@ -0,0 +1,489 @@
+// Code generated by ent, DO NOT EDIT.
+
+package claimverification
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/iWorld-y/domain_radar/app/common/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldLTE(FieldID, id))
+}
+
+// DomainReportID applies equality check predicate on the "domain_report_id" field. It's identical to DomainReportIDEQ.
+func DomainReportID(v int) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldEQ(FieldDomainReportID, v))
+}
+
+// ClaimType applies equality check predicate on the "claim_type" field. It's identical to ClaimTypeEQ.
+func ClaimType(v string) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldEQ(FieldClaimType, v))
+}
+
+// Claim applies equality check predicate on the "claim" field. It's identical to ClaimEQ.
+func Claim(v string) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldEQ(FieldClaim, v))
+}
+
+// Verdict applies equality check predicate on the "verdict" field. It's identical to VerdictEQ.
+func Verdict(v string) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldEQ(FieldVerdict, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldEQ(FieldReason, v))
+}
+
+// Dropped applies equality check predicate on the "dropped" field. It's identical to DroppedEQ.
+func Dropped(v bool) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldEQ(FieldDropped, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// DomainReportIDEQ applies the EQ predicate on the "domain_report_id" field.
+func DomainReportIDEQ(v int) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldEQ(FieldDomainReportID, v))
+}
+
+// DomainReportIDNEQ applies the NEQ predicate on the "domain_report_id" field.
+func DomainReportIDNEQ(v int) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldNEQ(FieldDomainReportID, v))
+}
+
+// DomainReportIDIn applies the In predicate on the "domain_report_id" field.
+func DomainReportIDIn(vs ...int) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldIn(FieldDomainReportID, vs...))
+}
+
+// DomainReportIDNotIn applies the NotIn predicate on the "domain_report_id" field.
+func DomainReportIDNotIn(vs ...int) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldNotIn(FieldDomainReportID, vs...))
+}
+
+// DomainReportIDIsNil applies the IsNil predicate on the "domain_report_id" field.
+func DomainReportIDIsNil() predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldIsNull(FieldDomainReportID))
+}
+
+// DomainReportIDNotNil applies the NotNil predicate on the "domain_report_id" field.
+func DomainReportIDNotNil() predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldNotNull(FieldDomainReportID))
+}
+
+// ClaimTypeEQ applies the EQ predicate on the "claim_type" field.
+func ClaimTypeEQ(v string) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldEQ(FieldClaimType, v))
+}
+
+// ClaimTypeNEQ applies the NEQ predicate on the "claim_type" field.
+func ClaimTypeNEQ(v string) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldNEQ(FieldClaimType, v))
+}
+
+// ClaimTypeIn applies the In predicate on the "claim_type" field.
+func ClaimTypeIn(vs ...string) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldIn(FieldClaimType, vs...))
+}
+
+// ClaimTypeNotIn applies the NotIn predicate on the "claim_type" field.
+func ClaimTypeNotIn(vs ...string) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldNotIn(FieldClaimType, vs...))
+}
+
+// ClaimTypeGT applies the GT predicate on the "claim_type" field.
+func ClaimTypeGT(v string) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldGT(FieldClaimType, v))
+}
+
+// ClaimTypeGTE applies the GTE predicate on the "claim_type" field.
+func ClaimTypeGTE(v string) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldGTE(FieldClaimType, v))
+}
+
+// ClaimTypeLT applies the LT predicate on the "claim_type" field.
+func ClaimTypeLT(v string) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldLT(FieldClaimType, v))
+}
+
+// ClaimTypeLTE applies the LTE predicate on the "claim_type" field.
+func ClaimTypeLTE(v string) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldLTE(FieldClaimType, v))
+}
+
+// ClaimTypeContains applies the Contains predicate on the "claim_type" field.
+func ClaimTypeContains(v string) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldContains(FieldClaimType, v))
+}
+
+// ClaimTypeHasPrefix applies the HasPrefix predicate on the "claim_type" field.
+func ClaimTypeHasPrefix(v string) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldHasPrefix(FieldClaimType, v))
+}
+
+// ClaimTypeHasSuffix applies the HasSuffix predicate on the "claim_type" field.
+func ClaimTypeHasSuffix(v string) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldHasSuffix(FieldClaimType, v))
+}
+
+// ClaimTypeEqualFold applies the EqualFold predicate on the "claim_type" field.
+func ClaimTypeEqualFold(v string) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldEqualFold(FieldClaimType, v))
+}
+
+// ClaimTypeContainsFold applies the ContainsFold predicate on the "claim_type" field.
+func ClaimTypeContainsFold(v string) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldContainsFold(FieldClaimType, v))
+}
+
+// ClaimEQ applies the EQ predicate on the "claim" field.
+func ClaimEQ(v string) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldEQ(FieldClaim, v))
+}
+
+// ClaimNEQ applies the NEQ predicate on the "claim" field.
+func ClaimNEQ(v string) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldNEQ(FieldClaim, v))
+}
+
+// ClaimIn applies the In predicate on the "claim" field.
+func ClaimIn(vs ...string) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldIn(FieldClaim, vs...))
+}
+
+// ClaimNotIn applies the NotIn predicate on the "claim" field.
+func ClaimNotIn(vs ...string) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldNotIn(FieldClaim, vs...))
+}
+
+// ClaimGT applies the GT predicate on the "claim" field.
+func ClaimGT(v string) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldGT(FieldClaim, v))
+}
+
+// ClaimGTE applies the GTE predicate on the "claim" field.
+func ClaimGTE(v string) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldGTE(FieldClaim, v))
+}
+
+// ClaimLT applies the LT predicate on the "claim" field.
+func ClaimLT(v string) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldLT(FieldClaim, v))
+}
+
+// ClaimLTE applies the LTE predicate on the "claim" field.
+func ClaimLTE(v string) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldLTE(FieldClaim, v))
+}
+
+// ClaimContains applies the Contains predicate on the "claim" field.
+func ClaimContains(v string) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldContains(FieldClaim, v))
+}
+
+// ClaimHasPrefix applies the HasPrefix predicate on the "claim" field.
+func ClaimHasPrefix(v string) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldHasPrefix(FieldClaim, v))
+}
+
+// ClaimHasSuffix applies the HasSuffix predicate on the "claim" field.
+func ClaimHasSuffix(v string) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldHasSuffix(FieldClaim, v))
+}
+
+// ClaimIsNil applies the IsNil predicate on the "claim" field.
+func ClaimIsNil() predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldIsNull(FieldClaim))
+}
+
+// ClaimNotNil applies the NotNil predicate on the "claim" field.
+func ClaimNotNil() predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldNotNull(FieldClaim))
+}
+
+// ClaimEqualFold applies the EqualFold predicate on the "claim" field.
+func ClaimEqualFold(v string) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldEqualFold(FieldClaim, v))
+}
+
+// ClaimContainsFold applies the ContainsFold predicate on the "claim" field.
+func ClaimContainsFold(v string) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldContainsFold(FieldClaim, v))
+}
+
+// VerdictEQ applies the EQ predicate on the "verdict" field.
+func VerdictEQ(v string) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldEQ(FieldVerdict, v))
+}
+
+// VerdictNEQ applies the NEQ predicate on the "verdict" field.
+func VerdictNEQ(v string) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldNEQ(FieldVerdict, v))
+}
+
+// VerdictIn applies the In predicate on the "verdict" field.
+func VerdictIn(vs ...string) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldIn(FieldVerdict, vs...))
+}
+
+// VerdictNotIn applies the NotIn predicate on the "verdict" field.
+func VerdictNotIn(vs ...string) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldNotIn(FieldVerdict, vs...))
+}
+
+// VerdictGT applies the GT predicate on the "verdict" field.
+func VerdictGT(v string) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldGT(FieldVerdict, v))
+}
+
+// VerdictGTE applies the GTE predicate on the "verdict" field.
+func VerdictGTE(v string) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldGTE(FieldVerdict, v))
+}
+
+// VerdictLT applies the LT predicate on the "verdict" field.
+func VerdictLT(v string) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldLT(FieldVerdict, v))
+}
+
+// VerdictLTE applies the LTE predicate on the "verdict" field.
+func VerdictLTE(v string) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldLTE(FieldVerdict, v))
+}
+
+// VerdictContains applies the Contains predicate on the "verdict" field.
+func VerdictContains(v string) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldContains(FieldVerdict, v))
+}
+
+// VerdictHasPrefix applies the HasPrefix predicate on the "verdict" field.
+func VerdictHasPrefix(v string) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldHasPrefix(FieldVerdict, v))
+}
+
+// VerdictHasSuffix applies the HasSuffix predicate on the "verdict" field.
+func VerdictHasSuffix(v string) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldHasSuffix(FieldVerdict, v))
+}
+
+// VerdictEqualFold applies the EqualFold predicate on the "verdict" field.
+func VerdictEqualFold(v string) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldEqualFold(FieldVerdict, v))
+}
+
+// VerdictContainsFold applies the ContainsFold predicate on the "verdict" field.
+func VerdictContainsFold(v string) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldContainsFold(FieldVerdict, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonIsNil applies the IsNil predicate on the "reason" field.
+func ReasonIsNil() predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldIsNull(FieldReason))
+}
+
+// ReasonNotNil applies the NotNil predicate on the "reason" field.
+func ReasonNotNil() predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldNotNull(FieldReason))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldContainsFold(FieldReason, v))
+}
+
+// DroppedEQ applies the EQ predicate on the "dropped" field.
+func DroppedEQ(v bool) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldEQ(FieldDropped, v))
+}
+
+// DroppedNEQ applies the NEQ predicate on the "dropped" field.
+func DroppedNEQ(v bool) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldNEQ(FieldDropped, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasDomainReport applies the HasEdge predicate on the "domain_report" edge.
+func HasDomainReport() predicate.ClaimVerification {
+	return predicate.ClaimVerification(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, DomainReportTable, DomainReportColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDomainReportWith applies the HasEdge predicate on the "domain_report" edge with a given conditions (other predicates).
+func HasDomainReportWith(preds ...predicate.DomainReport) predicate.ClaimVerification {
+	return predicate.ClaimVerification(func(s *sql.Selector) {
+		step := newDomainReportStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ClaimVerification) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ClaimVerification) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ClaimVerification) predicate.ClaimVerification {
+	return predicate.ClaimVerification(sql.NotPredicates(p))
+}
@@ -0,0 +1,927 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/iWorld-y/domain_radar/app/common/ent/claimverification"
+	"github.com/iWorld-y/domain_radar/app/common/ent/domainreport"
+)
+
+// ClaimVerificationCreate is the builder for creating a ClaimVerification entity.
+type ClaimVerificationCreate struct {
+	config
+	mutation *ClaimVerificationMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetDomainReportID sets the "domain_report_id" field.
+func (_c *ClaimVerificationCreate) SetDomainReportID(v int) *ClaimVerificationCreate {
+	_c.mutation.SetDomainReportID(v)
+	return _c
+}
+
+// SetNillableDomainReportID sets the "domain_report_id" field if the given value is not nil.
+func (_c *ClaimVerificationCreate) SetNillableDomainReportID(v *int) *ClaimVerificationCreate {
+	if v != nil {
+		_c.SetDomainReportID(*v)
+	}
+	return _c
+}
+
+// SetClaimType sets the "claim_type" field.
+func (_c *ClaimVerificationCreate) SetClaimType(v string) *ClaimVerificationCreate {
+	_c.mutation.SetClaimType(v)
+	return _c
+}
+
+// SetClaim sets the "claim" field.
+func (_c *ClaimVerificationCreate) SetClaim(v string) *ClaimVerificationCreate {
+	_c.mutation.SetClaim(v)
+	return _c
+}
+
+// SetNillableClaim sets the "claim" field if the given value is not nil.
+func (_c *ClaimVerificationCreate) SetNillableClaim(v *string) *ClaimVerificationCreate {
+	if v != nil {
+		_c.SetClaim(*v)
+	}
+	return _c
+}
+
+// SetVerdict sets the "verdict" field.
+func (_c *ClaimVerificationCreate) SetVerdict(v string) *ClaimVerificationCreate {
+	_c.mutation.SetVerdict(v)
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *ClaimVerificationCreate) SetReason(v string) *ClaimVerificationCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_c *ClaimVerificationCreate) SetNillableReason(v *string) *ClaimVerificationCreate {
+	if v != nil {
+		_c.SetReason(*v)
+	}
+	return _c
+}
+
+// SetDropped sets the "dropped" field.
+func (_c *ClaimVerificationCreate) SetDropped(v bool) *ClaimVerificationCreate {
+	_c.mutation.SetDropped(v)
+	return _c
+}
+
+// SetNillableDropped sets the "dropped" field if the given value is not nil.
+func (_c *ClaimVerificationCreate) SetNillableDropped(v *bool) *ClaimVerificationCreate {
+	if v != nil {
+		_c.SetDropped(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ClaimVerificationCreate) SetCreatedAt(v time.Time) *ClaimVerificationCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ClaimVerificationCreate) SetNillableCreatedAt(v *time.Time) *ClaimVerificationCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ClaimVerificationCreate) SetID(v int) *ClaimVerificationCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetDomainReport sets the "domain_report" edge to the DomainReport entity.
+func (_c *ClaimVerificationCreate) SetDomainReport(v *DomainReport) *ClaimVerificationCreate {
+	return _c.SetDomainReportID(v.ID)
+}
+
+// Mutation returns the ClaimVerificationMutation object of the builder.
+func (_c *ClaimVerificationCreate) Mutation() *ClaimVerificationMutation {
+	return _c.mutation
+}
+
+// Save creates the ClaimVerification in the database.
+func (_c *ClaimVerificationCreate) Save(ctx context.Context) (*ClaimVerification, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ClaimVerificationCreate) SaveX(ctx context.Context) *ClaimVerification {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ClaimVerificationCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ClaimVerificationCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ClaimVerificationCreate) defaults() {
+	if _, ok := _c.mutation.Dropped(); !ok {
+		v := claimverification.DefaultDropped
+		_c.mutation.SetDropped(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := claimverification.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ClaimVerificationCreate) check() error {
+	if _, ok := _c.mutation.ClaimType(); !ok {
+		return &ValidationError{Name: "claim_type", err: errors.New(`ent: missing required field "ClaimVerification.claim_type"`)}
+	}
+	if _, ok := _c.mutation.Verdict(); !ok {
+		return &ValidationError{Name: "verdict", err: errors.New(`ent: missing required field "ClaimVerification.verdict"`)}
+	}
+	if _, ok := _c.mutation.Dropped(); !ok {
+		return &ValidationError{Name: "dropped", err: errors.New(`ent: missing required field "ClaimVerification.dropped"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ClaimVerification.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *ClaimVerificationCreate) sqlSave(ctx context.Context) (*ClaimVerification, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ClaimVerificationCreate) createSpec() (*ClaimVerification, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ClaimVerification{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(claimverification.Table, sqlgraph.NewFieldSpec(claimverification.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.ClaimType(); ok {
+		_spec.SetField(claimverification.FieldClaimType, field.TypeString, value)
+		_node.ClaimType = value
+	}
+	if value, ok := _c.mutation.Claim(); ok {
+		_spec.SetField(claimverification.FieldClaim, field.TypeString, value)
+		_node.Claim = value
+	}
+	if value, ok := _c.mutation.Verdict(); ok {
+		_spec.SetField(claimverification.FieldVerdict, field.TypeString, value)
+		_node.Verdict = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(claimverification.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := _c.mutation.Dropped(); ok {
+		_spec.SetField(claimverification.FieldDropped, field.TypeBool, value)
+		_node.Dropped = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(claimverification.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.DomainReportIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   claimverification.DomainReportTable,
+			Columns: []string{claimverification.DomainReportColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(domainreport.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.DomainReportID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ClaimVerification.Create().
+//		SetDomainReportID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ClaimVerificationUpsert) {
+//			SetDomainReportID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ClaimVerificationCreate) OnConflict(opts ...sql.ConflictOption) *ClaimVerificationUpsertOne {
+	_c.conflict = opts
+	return &ClaimVerificationUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ClaimVerification.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ClaimVerificationCreate) OnConflictColumns(columns ...string) *ClaimVerificationUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ClaimVerificationUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ClaimVerificationUpsertOne is the builder for "upsert"-ing
+	//  one ClaimVerification node.
+	ClaimVerificationUpsertOne struct {
+		create *ClaimVerificationCreate
+	}
+
+	// ClaimVerificationUpsert is the "OnConflict" setter.
+	ClaimVerificationUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetDomainReportID sets the "domain_report_id" field.
+func (u *ClaimVerificationUpsert) SetDomainReportID(v int) *ClaimVerificationUpsert {
+	u.Set(claimverification.FieldDomainReportID, v)
+	return u
+}
+
+// UpdateDomainReportID sets the "domain_report_id" field to the value that was provided on create.
+func (u *ClaimVerificationUpsert) UpdateDomainReportID() *ClaimVerificationUpsert {
+	u.SetExcluded(claimverification.FieldDomainReportID)
+	return u
+}
+
+// ClearDomainReportID clears the value of the "domain_report_id" field.
+func (u *ClaimVerificationUpsert) ClearDomainReportID() *ClaimVerificationUpsert {
+	u.SetNull(claimverification.FieldDomainReportID)
+	return u
+}
+
+// SetClaimType sets the "claim_type" field.
+func (u *ClaimVerificationUpsert) SetClaimType(v string) *ClaimVerificationUpsert {
+	u.Set(claimverification.FieldClaimType, v)
+	return u
+}
+
+// UpdateClaimType sets the "claim_type" field to the value that was provided on create.
+func (u *ClaimVerificationUpsert) UpdateClaimType() *ClaimVerificationUpsert {
+	u.SetExcluded(claimverification.FieldClaimType)
+	return u
+}
+
+// SetClaim sets the "claim" field.
+func (u *ClaimVerificationUpsert) SetClaim(v string) *ClaimVerificationUpsert {
+	u.Set(claimverification.FieldClaim, v)
+	return u
+}
+
+// UpdateClaim sets the "claim" field to the value that was provided on create.
+func (u *ClaimVerificationUpsert) UpdateClaim() *ClaimVerificationUpsert {
+	u.SetExcluded(claimverification.FieldClaim)
+	return u
+}
+
+// ClearClaim clears the value of the "claim" field.
+func (u *ClaimVerificationUpsert) ClearClaim() *ClaimVerificationUpsert {
+	u.SetNull(claimverification.FieldClaim)
+	return u
+}
+
+// SetVerdict sets the "verdict" field.
+func (u *ClaimVerificationUpsert) SetVerdict(v string) *ClaimVerificationUpsert {
+	u.Set(claimverification.FieldVerdict, v)
+	return u
+}
+
+// UpdateVerdict sets the "verdict" field to the value that was provided on create.
+func (u *ClaimVerificationUpsert) UpdateVerdict() *ClaimVerificationUpsert {
+	u.SetExcluded(claimverification.FieldVerdict)
+	return u
+}
+
+// SetReason sets the "reason" field.
+func (u *ClaimVerificationUpsert) SetReason(v string) *ClaimVerificationUpsert {
+	u.Set(claimverification.FieldReason, v)
+	return u
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *ClaimVerificationUpsert) UpdateReason() *ClaimVerificationUpsert {
+	u.SetExcluded(claimverification.FieldReason)
+	return u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (u *ClaimVerificationUpsert) ClearReason() *ClaimVerificationUpsert {
+	u.SetNull(claimverification.FieldReason)
+	return u
+}
+
+// SetDropped sets the "dropped" field.
+func (u *ClaimVerificationUpsert) SetDropped(v bool) *ClaimVerificationUpsert {
+	u.Set(claimverification.FieldDropped, v)
+	return u
+}
+
+// UpdateDropped sets the "dropped" field to the value that was provided on create.
+func (u *ClaimVerificationUpsert) UpdateDropped() *ClaimVerificationUpsert {
+	u.SetExcluded(claimverification.FieldDropped)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ClaimVerificationUpsert) SetCreatedAt(v time.Time) *ClaimVerificationUpsert {
+	u.Set(claimverification.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ClaimVerificationUpsert) UpdateCreatedAt() *ClaimVerificationUpsert {
+	u.SetExcluded(claimverification.FieldCreatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ClaimVerification.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(claimverification.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ClaimVerificationUpsertOne) UpdateNewValues() *ClaimVerificationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(claimverification.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ClaimVerification.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ClaimVerificationUpsertOne) Ignore() *ClaimVerificationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ClaimVerificationUpsertOne) DoNothing() *ClaimVerificationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ClaimVerificationCreate.OnConflict
+// documentation for more info.
+func (u *ClaimVerificationUpsertOne) Update(set func(*ClaimVerificationUpsert)) *ClaimVerificationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ClaimVerificationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetDomainReportID sets the "domain_report_id" field.
+func (u *ClaimVerificationUpsertOne) SetDomainReportID(v int) *ClaimVerificationUpsertOne {
+	return u.Update(func(s *ClaimVerificationUpsert) {
+		s.SetDomainReportID(v)
+	})
+}
+
+// UpdateDomainReportID sets the "domain_report_id" field to the value that was provided on create.
+func (u *ClaimVerificationUpsertOne) UpdateDomainReportID() *ClaimVerificationUpsertOne {
+	return u.Update(func(s *ClaimVerificationUpsert) {
+		s.UpdateDomainReportID()
+	})
+}
+
+// ClearDomainReportID clears the value of the "domain_report_id" field.
+func (u *ClaimVerificationUpsertOne) ClearDomainReportID() *ClaimVerificationUpsertOne {
+	return u.Update(func(s *ClaimVerificationUpsert) {
+		s.ClearDomainReportID()
+	})
+}
+
+// SetClaimType sets the "claim_type" field.
+func (u *ClaimVerificationUpsertOne) SetClaimType(v string) *ClaimVerificationUpsertOne {
+	return u.Update(func(s *ClaimVerificationUpsert) {
+		s.SetClaimType(v)
+	})
+}
+
+// UpdateClaimType sets the "claim_type" field to the value that was provided on create.
+func (u *ClaimVerificationUpsertOne) UpdateClaimType() *ClaimVerificationUpsertOne {
+	return u.Update(func(s *ClaimVerificationUpsert) {
+		s.UpdateClaimType()
+	})
+}
+
+// SetClaim sets the "claim" field.
+func (u *ClaimVerificationUpsertOne) SetClaim(v string) *ClaimVerificationUpsertOne {
+	return u.Update(func(s *ClaimVerificationUpsert) {
+		s.SetClaim(v)
+	})
+}
+
+// UpdateClaim sets the "claim" field to the value that was provided on create.
+func (u *ClaimVerificationUpsertOne) UpdateClaim() *ClaimVerificationUpsertOne {
+	return u.Update(func(s *ClaimVerificationUpsert) {
+		s.UpdateClaim()
+	})
+}
+
+// ClearClaim clears the value of the "claim" field.
+func (u *ClaimVerificationUpsertOne) ClearClaim() *ClaimVerificationUpsertOne {
+	return u.Update(func(s *ClaimVerificationUpsert) {
+		s.ClearClaim()
+	})
+}
+
+// SetVerdict sets the "verdict" field.
+func (u *ClaimVerificationUpsertOne) SetVerdict(v string) *ClaimVerificationUpsertOne {
+	return u.Update(func(s *ClaimVerificationUpsert) {
+		s.SetVerdict(v)
+	})
+}
+
+// UpdateVerdict sets the "verdict" field to the value that was provided on create.
+func (u *ClaimVerificationUpsertOne) UpdateVerdict() *ClaimVerificationUpsertOne {
+	return u.Update(func(s *ClaimVerificationUpsert) {
+		s.UpdateVerdict()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *ClaimVerificationUpsertOne) SetReason(v string) *ClaimVerificationUpsertOne {
+	return u.Update(func(s *ClaimVerificationUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *ClaimVerificationUpsertOne) UpdateReason() *ClaimVerificationUpsertOne {
+	return u.Update(func(s *ClaimVerificationUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// ClearReason clears the value of the "reason" field.
+func (u *ClaimVerificationUpsertOne) ClearReason() *ClaimVerificationUpsertOne {
+	return u.Update(func(s *ClaimVerificationUpsert) {
+		s.ClearReason()
+	})
+}
+
+// SetDropped sets the "dropped" field.
+func (u *ClaimVerificationUpsertOne) SetDropped(v bool) *ClaimVerificationUpsertOne {
+	return u.Update(func(s *ClaimVerificationUpsert) {
+		s.SetDropped(v)
+	})
+}
+
+// UpdateDropped sets the "dropped" field to the value that was provided on create.
+func (u *ClaimVerificationUpsertOne) UpdateDropped() *ClaimVerificationUpsertOne {
+	return u.Update(func(s *ClaimVerificationUpsert) {
+		s.UpdateDropped()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ClaimVerificationUpsertOne) SetCreatedAt(v time.Time) *ClaimVerificationUpsertOne {
+	return u.Update(func(s *ClaimVerificationUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ClaimVerificationUpsertOne) UpdateCreatedAt() *ClaimVerificationUpsertOne {
+	return u.Update(func(s *ClaimVerificationUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ClaimVerificationUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ClaimVerificationCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ClaimVerificationUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ClaimVerificationUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ClaimVerificationUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ClaimVerificationCreateBulk is the builder for creating many ClaimVerification entities in bulk.
+type ClaimVerificationCreateBulk struct {
+	config
+	err      error
+	builders []*ClaimVerificationCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ClaimVerification entities in the database.
+func (_c *ClaimVerificationCreateBulk) Save(ctx context.Context) ([]*ClaimVerification, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ClaimVerification, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ClaimVerificationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ClaimVerificationCreateBulk) SaveX(ctx context.Context) []*ClaimVerification {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ClaimVerificationCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ClaimVerificationCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ClaimVerification.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ClaimVerificationUpsert) {
+//			SetDomainReportID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ClaimVerificationCreateBulk) OnConflict(opts ...sql.ConflictOption) *ClaimVerificationUpsertBulk {
+	_c.conflict = opts
+	return &ClaimVerificationUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ClaimVerification.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ClaimVerificationCreateBulk) OnConflictColumns(columns ...string) *ClaimVerificationUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ClaimVerificationUpsertBulk{
+		create: _c,
+	}
+}
+
+// ClaimVerificationUpsertBulk is the builder for "upsert"-ing
+// a bulk of ClaimVerification nodes.
+type ClaimVerificationUpsertBulk struct {
+	create *ClaimVerificationCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ClaimVerification.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(claimverification.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ClaimVerificationUpsertBulk) UpdateNewValues() *ClaimVerificationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(claimverification.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ClaimVerification.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ClaimVerificationUpsertBulk) Ignore() *ClaimVerificationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ClaimVerificationUpsertBulk) DoNothing() *ClaimVerificationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ClaimVerificationCreateBulk.OnConflict
+// documentation for more info.
+func (u *ClaimVerificationUpsertBulk) Update(set func(*ClaimVerificationUpsert)) *ClaimVerificationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ClaimVerificationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetDomainReportID sets the "domain_report_id" field.
+func (u *ClaimVerificationUpsertBulk) SetDomainReportID(v int) *ClaimVerificationUpsertBulk {
+	return u.Update(func(s *ClaimVerificationUpsert) {
+		s.SetDomainReportID(v)
+	})
+}
+
+// UpdateDomainReportID sets the "domain_report_id" field to the value that was provided on create.
+func (u *ClaimVerificationUpsertBulk) UpdateDomainReportID() *ClaimVerificationUpsertBulk {
+	return u.Update(func(s *ClaimVerificationUpsert) {
+		s.UpdateDomainReportID()
+	})
+}
+
+// ClearDomainReportID clears the value of the "domain_report_id" field.
+func (u *ClaimVerificationUpsertBulk) ClearDomainReportID() *ClaimVerificationUpsertBulk {
+	return u.Update(func(s *ClaimVerificationUpsert) {
+		s.ClearDomainReportID()
+	})
+}
+
+// SetClaimType sets the "claim_type" field.
+func (u *ClaimVerificationUpsertBulk) SetClaimType(v string) *ClaimVerificationUpsertBulk {
+	return u.Update(func(s *ClaimVerificationUpsert) {
+		s.SetClaimType(v)
+	})
+}
+
+// UpdateClaimType sets the "claim_type" field to the value that was provided on create.
+func (u *ClaimVerificationUpsertBulk) UpdateClaimType() *ClaimVerificationUpsertBulk {
+	return u.Update(func(s *ClaimVerificationUpsert) {
+		s.UpdateClaimType()
+	})
+}
+
+// SetClaim sets the "claim" field.
+func (u *ClaimVerificationUpsertBulk) SetClaim(v string) *ClaimVerificationUpsertBulk {
+	return u.Update(func(s *ClaimVerificationUpsert) {
+		s.SetClaim(v)
+	})
+}
+
+// UpdateClaim sets the "claim" field to the value that was provided on create.
+func (u *ClaimVerificationUpsertBulk) UpdateClaim() *ClaimVerificationUpsertBulk {
+	return u.Update(func(s *ClaimVerificationUpsert) {
+		s.UpdateClaim()
+	})
+}
+
+// ClearClaim clears the value of the "claim" field.
+func (u *ClaimVerificationUpsertBulk) ClearClaim() *ClaimVerificationUpsertBulk {
+	return u.Update(func(s *ClaimVerificationUpsert) {
+		s.ClearClaim()
+	})
+}
+
+// SetVerdict sets the "verdict" field.
+func (u *ClaimVerificationUpsertBulk) SetVerdict(v string) *ClaimVerificationUpsertBulk {
+	return u.Update(func(s *ClaimVerificationUpsert) {
+		s.SetVerdict(v)
+	})
+}
+
+// UpdateVerdict sets the "verdict" field to the value that was provided on create.
+func (u *ClaimVerificationUpsertBulk) UpdateVerdict() *ClaimVerificationUpsertBulk {
+	return u.Update(func(s *ClaimVerificationUpsert) {
+		s.UpdateVerdict()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *ClaimVerificationUpsertBulk) SetReason(v string) *ClaimVerificationUpsertBulk {
+	return u.Update(func(s *ClaimVerificationUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *ClaimVerificationUpsertBulk) UpdateReason() *ClaimVerificationUpsertBulk {
+	return u.Update(func(s *ClaimVerificationUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// ClearReason clears the value of the "reason" field.
+func (u *ClaimVerificationUpsertBulk) ClearReason() *ClaimVerificationUpsertBulk {
+	return u.Update(func(s *ClaimVerificationUpsert) {
+		s.ClearReason()
+	})
+}
+
+// SetDropped sets the "dropped" field.
+func (u *ClaimVerificationUpsertBulk) SetDropped(v bool) *ClaimVerificationUpsertBulk {
+	return u.Update(func(s *ClaimVerificationUpsert) {
+		s.SetDropped(v)
+	})
+}
+
+// UpdateDropped sets the "dropped" field to the value that was provided on create.
+func (u *ClaimVerificationUpsertBulk) UpdateDropped() *ClaimVerificationUpsertBulk {
+	return u.Update(func(s *ClaimVerificationUpsert) {
+		s.UpdateDropped()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ClaimVerificationUpsertBulk) SetCreatedAt(v time.Time) *ClaimVerificationUpsertBulk {
+	return u.Update(func(s *ClaimVerificationUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ClaimVerificationUpsertBulk) UpdateCreatedAt() *ClaimVerificationUpsertBulk {
+	return u.Update(func(s *ClaimVerificationUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ClaimVerificationUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ClaimVerificationCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ClaimVerificationCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ClaimVerificationUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/iWorld-y/domain_radar/app/common/ent/claimverification"
+	"github.com/iWorld-y/domain_radar/app/common/ent/predicate"
+)
+
+// ClaimVerificationDelete is the builder for deleting a ClaimVerification entity.
+type ClaimVerificationDelete struct {
+	config
+	hooks    []Hook
+	mutation *ClaimVerificationMutation
+}
+
+// Where appends a list predicates to the ClaimVerificationDelete builder.
+func (_d *ClaimVerificationDelete) Where(ps ...predicate.ClaimVerification) *ClaimVerificationDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *ClaimVerificationDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ClaimVerificationDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *ClaimVerificationDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(claimverification.Table, sqlgraph.NewFieldSpec(claimverification.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// ClaimVerificationDeleteOne is the builder for deleting a single ClaimVerification entity.
+type ClaimVerificationDeleteOne struct {
+	_d *ClaimVerificationDelete
+}
+
+// Where appends a list predicates to the ClaimVerificationDelete builder.
+func (_d *ClaimVerificationDeleteOne) Where(ps ...predicate.ClaimVerification) *ClaimVerificationDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *ClaimVerificationDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{claimverification.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ClaimVerificationDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,553 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/iWorld-y/domain_radar/app/common/ent/claimverification"
+	"github.com/iWorld-y/domain_radar/app/common/ent/domainreport"
+	"github.com/iWorld-y/domain_radar/app/common/ent/predicate"
+)
+
+// ClaimVerificationUpdate is the builder for updating ClaimVerification entities.
+type ClaimVerificationUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ClaimVerificationMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ClaimVerificationUpdate builder.
+func (_u *ClaimVerificationUpdate) Where(ps ...predicate.ClaimVerification) *ClaimVerificationUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetDomainReportID sets the "domain_report_id" field.
+func (_u *ClaimVerificationUpdate) SetDomainReportID(v int) *ClaimVerificationUpdate {
+	_u.mutation.SetDomainReportID(v)
+	return _u
+}
+
+// SetNillableDomainReportID sets the "domain_report_id" field if the given value is not nil.
+func (_u *ClaimVerificationUpdate) SetNillableDomainReportID(v *int) *ClaimVerificationUpdate {
+	if v != nil {
+		_u.SetDomainReportID(*v)
+	}
+	return _u
+}
+
+// ClearDomainReportID clears the value of the "domain_report_id" field.
+func (_u *ClaimVerificationUpdate) ClearDomainReportID() *ClaimVerificationUpdate {
+	_u.mutation.ClearDomainReportID()
+	return _u
+}
+
+// SetClaimType sets the "claim_type" field.
+func (_u *ClaimVerificationUpdate) SetClaimType(v string) *ClaimVerificationUpdate {
+	_u.mutation.SetClaimType(v)
+	return _u
+}
+
+// SetNillableClaimType sets the "claim_type" field if the given value is not nil.
+func (_u *ClaimVerificationUpdate) SetNillableClaimType(v *string) *ClaimVerificationUpdate {
+	if v != nil {
+		_u.SetClaimType(*v)
+	}
+	return _u
+}
+
+// SetClaim sets the "claim" field.
+func (_u *ClaimVerificationUpdate) SetClaim(v string) *ClaimVerificationUpdate {
+	_u.mutation.SetClaim(v)
+	return _u
+}
+
+// SetNillableClaim sets the "claim" field if the given value is not nil.
+func (_u *ClaimVerificationUpdate) SetNillableClaim(v *string) *ClaimVerificationUpdate {
+	if v != nil {
+		_u.SetClaim(*v)
+	}
+	return _u
+}
+
+// ClearClaim clears the value of the "claim" field.
+func (_u *ClaimVerificationUpdate) ClearClaim() *ClaimVerificationUpdate {
+	_u.mutation.ClearClaim()
+	return _u
+}
+
+// SetVerdict sets the "verdict" field.
+func (_u *ClaimVerificationUpdate) SetVerdict(v string) *ClaimVerificationUpdate {
+	_u.mutation.SetVerdict(v)
+	return _u
+}
+
+// SetNillableVerdict sets the "verdict" field if the given value is not nil.
+func (_u *ClaimVerificationUpdate) SetNillableVerdict(v *string) *ClaimVerificationUpdate {
+	if v != nil {
+		_u.SetVerdict(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *ClaimVerificationUpdate) SetReason(v string) *ClaimVerificationUpdate {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *ClaimVerificationUpdate) SetNillableReason(v *string) *ClaimVerificationUpdate {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *ClaimVerificationUpdate) ClearReason() *ClaimVerificationUpdate {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// SetDropped sets the "dropped" field.
+func (_u *ClaimVerificationUpdate) SetDropped(v bool) *ClaimVerificationUpdate {
+	_u.mutation.SetDropped(v)
+	return _u
+}
+
+// SetNillableDropped sets the "dropped" field if the given value is not nil.
+func (_u *ClaimVerificationUpdate) SetNillableDropped(v *bool) *ClaimVerificationUpdate {
+	if v != nil {
+		_u.SetDropped(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *ClaimVerificationUpdate) SetCreatedAt(v time.Time) *ClaimVerificationUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *ClaimVerificationUpdate) SetNillableCreatedAt(v *time.Time) *ClaimVerificationUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetDomainReport sets the "domain_report" edge to the DomainReport entity.
+func (_u *ClaimVerificationUpdate) SetDomainReport(v *DomainReport) *ClaimVerificationUpdate {
+	return _u.SetDomainReportID(v.ID)
+}
+
+// Mutation returns the ClaimVerificationMutation object of the builder.
+func (_u *ClaimVerificationUpdate) Mutation() *ClaimVerificationMutation {
+	return _u.mutation
+}
+
+// ClearDomainReport clears the "domain_report" edge to the DomainReport entity.
+func (_u *ClaimVerificationUpdate) ClearDomainReport() *ClaimVerificationUpdate {
+	_u.mutation.ClearDomainReport()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ClaimVerificationUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ClaimVerificationUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ClaimVerificationUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ClaimVerificationUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ClaimVerificationUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ClaimVerificationUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ClaimVerificationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(claimverification.Table, claimverification.Columns, sqlgraph.NewFieldSpec(claimverification.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ClaimType(); ok {
+		_spec.SetField(claimverification.FieldClaimType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Claim(); ok {
+		_spec.SetField(claimverification.FieldClaim, field.TypeString, value)
+	}
+	if _u.mutation.ClaimCleared() {
+		_spec.ClearField(claimverification.FieldClaim, field.TypeString)
+	}
+	if value, ok := _u.mutation.Verdict(); ok {
+		_spec.SetField(claimverification.FieldVerdict, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(claimverification.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(claimverification.FieldReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.Dropped(); ok {
+		_spec.SetField(claimverification.FieldDropped, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(claimverification.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DomainReportCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   claimverification.DomainReportTable,
+			Columns: []string{claimverification.DomainReportColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(domainreport.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DomainReportIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   claimverification.DomainReportTable,
+			Columns: []string{claimverification.DomainReportColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(domainreport.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{claimverification.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ClaimVerificationUpdateOne is the builder for updating a single ClaimVerification entity.
+type ClaimVerificationUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ClaimVerificationMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetDomainReportID sets the "domain_report_id" field.
+func (_u *ClaimVerificationUpdateOne) SetDomainReportID(v int) *ClaimVerificationUpdateOne {
+	_u.mutation.SetDomainReportID(v)
+	return _u
+}
+
+// SetNillableDomainReportID sets the "domain_report_id" field if the given value is not nil.
+func (_u *ClaimVerificationUpdateOne) SetNillableDomainReportID(v *int) *ClaimVerificationUpdateOne {
+	if v != nil {
+		_u.SetDomainReportID(*v)
+	}
+	return _u
+}
+
+// ClearDomainReportID clears the value of the "domain_report_id" field.
+func (_u *ClaimVerificationUpdateOne) ClearDomainReportID() *ClaimVerificationUpdateOne {
+	_u.mutation.ClearDomainReportID()
+	return _u
+}
+
+// SetClaimType sets the "claim_type" field.
+func (_u *ClaimVerificationUpdateOne) SetClaimType(v string) *ClaimVerificationUpdateOne {
+	_u.mutation.SetClaimType(v)
+	return _u
+}
+
+// SetNillableClaimType sets the "claim_type" field if the given value is not nil.
+func (_u *ClaimVerificationUpdateOne) SetNillableClaimType(v *string) *ClaimVerificationUpdateOne {
+	if v != nil {
+		_u.SetClaimType(*v)
+	}
+	return _u
+}
+
+// SetClaim sets the "claim" field.
+func (_u *ClaimVerificationUpdateOne) SetClaim(v string) *ClaimVerificationUpdateOne {
+	_u.mutation.SetClaim(v)
+	return _u
+}
+
+// SetNillableClaim sets the "claim" field if the given value is not nil.
+func (_u *ClaimVerificationUpdateOne) SetNillableClaim(v *string) *ClaimVerificationUpdateOne {
+	if v != nil {
+		_u.SetClaim(*v)
+	}
+	return _u
+}
+
+// ClearClaim clears the value of the "claim" field.
+func (_u *ClaimVerificationUpdateOne) ClearClaim() *ClaimVerificationUpdateOne {
+	_u.mutation.ClearClaim()
+	return _u
+}
+
+// SetVerdict sets the "verdict" field.
+func (_u *ClaimVerificationUpdateOne) SetVerdict(v string) *ClaimVerificationUpdateOne {
+	_u.mutation.SetVerdict(v)
+	return _u
+}
+
+// SetNillableVerdict sets the "verdict" field if the given value is not nil.
+func (_u *ClaimVerificationUpdateOne) SetNillableVerdict(v *string) *ClaimVerificationUpdateOne {
+	if v != nil {
+		_u.SetVerdict(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *ClaimVerificationUpdateOne) SetReason(v string) *ClaimVerificationUpdateOne {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *ClaimVerificationUpdateOne) SetNillableReason(v *string) *ClaimVerificationUpdateOne {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *ClaimVerificationUpdateOne) ClearReason() *ClaimVerificationUpdateOne {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// SetDropped sets the "dropped" field.
+func (_u *ClaimVerificationUpdateOne) SetDropped(v bool) *ClaimVerificationUpdateOne {
+	_u.mutation.SetDropped(v)
+	return _u
+}
+
+// SetNillableDropped sets the "dropped" field if the given value is not nil.
+func (_u *ClaimVerificationUpdateOne) SetNillableDropped(v *bool) *ClaimVerificationUpdateOne {
+	if v != nil {
+		_u.SetDropped(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *ClaimVerificationUpdateOne) SetCreatedAt(v time.Time) *ClaimVerificationUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *ClaimVerificationUpdateOne) SetNillableCreatedAt(v *time.Time) *ClaimVerificationUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetDomainReport sets the "domain_report" edge to the DomainReport entity.
+func (_u *ClaimVerificationUpdateOne) SetDomainReport(v *DomainReport) *ClaimVerificationUpdateOne {
+	return _u.SetDomainReportID(v.ID)
+}
+
+// Mutation returns the ClaimVerificationMutation object of the builder.
+func (_u *ClaimVerificationUpdateOne) Mutation() *ClaimVerificationMutation {
+	return _u.mutation
+}
+
+// ClearDomainReport clears the "domain_report" edge to the DomainReport entity.
+func (_u *ClaimVerificationUpdateOne) ClearDomainReport() *ClaimVerificationUpdateOne {
+	_u.mutation.ClearDomainReport()
+	return _u
+}
+
+// Where appends a list predicates to the ClaimVerificationUpdate builder.
+func (_u *ClaimVerificationUpdateOne) Where(ps ...predicate.ClaimVerification) *ClaimVerificationUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ClaimVerificationUpdateOne) Select(field string, fields ...string) *ClaimVerificationUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ClaimVerification entity.
+func (_u *ClaimVerificationUpdateOne) Save(ctx context.Context) (*ClaimVerification, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ClaimVerificationUpdateOne) SaveX(ctx context.Context) *ClaimVerification {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ClaimVerificationUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ClaimVerificationUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ClaimVerificationUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ClaimVerificationUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ClaimVerificationUpdateOne) sqlSave(ctx context.Context) (_node *ClaimVerification, err error) {
+	_spec := sqlgraph.NewUpdateSpec(claimverification.Table, claimverification.Columns, sqlgraph.NewFieldSpec(claimverification.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ClaimVerification.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, claimverification.FieldID)
+		for _, f := range fields {
+			if !claimverification.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != claimverification.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ClaimType(); ok {
+		_spec.SetField(claimverification.FieldClaimType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Claim(); ok {
+		_spec.SetField(claimverification.FieldClaim, field.TypeString, value)
+	}
+	if _u.mutation.ClaimCleared() {
+		_spec.ClearField(claimverification.FieldClaim, field.TypeString)
+	}
+	if value, ok := _u.mutation.Verdict(); ok {
+		_spec.SetField(claimverification.FieldVerdict, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(claimverification.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(claimverification.FieldReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.Dropped(); ok {
+		_spec.SetField(claimverification.FieldDropped, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(claimverification.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DomainReportCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   claimverification.DomainReportTable,
+			Columns: []string{claimverification.DomainReportColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(domainreport.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DomainReportIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   claimverification.DomainReportTable,
+			Columns: []string{claimverification.DomainReportColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(domainreport.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &ClaimVerification{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{claimverification.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
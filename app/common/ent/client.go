@@ -16,11 +16,21 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/iWorld-y/domain_radar/app/common/ent/actionguide"
+	"github.com/iWorld-y/domain_radar/app/common/ent/analysislens"
+	"github.com/iWorld-y/domain_radar/app/common/ent/analysissection"
 	"github.com/iWorld-y/domain_radar/app/common/ent/article"
+	"github.com/iWorld-y/domain_radar/app/common/ent/articleentity"
+	"github.com/iWorld-y/domain_radar/app/common/ent/claimverification"
 	"github.com/iWorld-y/domain_radar/app/common/ent/deepanalysisresult"
 	"github.com/iWorld-y/domain_radar/app/common/ent/domainreport"
+	"github.com/iWorld-y/domain_radar/app/common/ent/domainrun"
+	"github.com/iWorld-y/domain_radar/app/common/ent/entity"
 	"github.com/iWorld-y/domain_radar/app/common/ent/keyevent"
+	"github.com/iWorld-y/domain_radar/app/common/ent/llmcache"
+	"github.com/iWorld-y/domain_radar/app/common/ent/llmcall"
+	"github.com/iWorld-y/domain_radar/app/common/ent/persona"
 	"github.com/iWorld-y/domain_radar/app/common/ent/reportrun"
+	"github.com/iWorld-y/domain_radar/app/common/ent/reportrunevent"
 	"github.com/iWorld-y/domain_radar/app/common/ent/user"
 )
 
@@ -31,16 +41,36 @@ type Client struct {
 	Schema *migrate.Schema
 	// ActionGuide is the client for interacting with the ActionGuide builders.
 	ActionGuide *ActionGuideClient
+	// AnalysisLens is the client for interacting with the AnalysisLens builders.
+	AnalysisLens *AnalysisLensClient
+	// AnalysisSection is the client for interacting with the AnalysisSection builders.
+	AnalysisSection *AnalysisSectionClient
 	// Article is the client for interacting with the Article builders.
 	Article *ArticleClient
+	// ArticleEntity is the client for interacting with the ArticleEntity builders.
+	ArticleEntity *ArticleEntityClient
+	// ClaimVerification is the client for interacting with the ClaimVerification builders.
+	ClaimVerification *ClaimVerificationClient
 	// DeepAnalysisResult is the client for interacting with the DeepAnalysisResult builders.
 	DeepAnalysisResult *DeepAnalysisResultClient
 	// DomainReport is the client for interacting with the DomainReport builders.
 	DomainReport *DomainReportClient
+	// DomainRun is the client for interacting with the DomainRun builders.
+	DomainRun *DomainRunClient
+	// Entity is the client for interacting with the Entity builders.
+	Entity *EntityClient
 	// KeyEvent is the client for interacting with the KeyEvent builders.
 	KeyEvent *KeyEventClient
+	// LLMCache is the client for interacting with the LLMCache builders.
+	LLMCache *LLMCacheClient
+	// LLMCall is the client for interacting with the LLMCall builders.
+	LLMCall *LLMCallClient
+	// Persona is the client for interacting with the Persona builders.
+	Persona *PersonaClient
 	// ReportRun is the client for interacting with the ReportRun builders.
 	ReportRun *ReportRunClient
+	// ReportRunEvent is the client for interacting with the ReportRunEvent builders.
+	ReportRunEvent *ReportRunEventClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 }
@@ -55,11 +85,21 @@ func NewClient(opts ...Option) *Client {
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.ActionGuide = NewActionGuideClient(c.config)
+	c.AnalysisLens = NewAnalysisLensClient(c.config)
+	c.AnalysisSection = NewAnalysisSectionClient(c.config)
 	c.Article = NewArticleClient(c.config)
+	c.ArticleEntity = NewArticleEntityClient(c.config)
+	c.ClaimVerification = NewClaimVerificationClient(c.config)
 	c.DeepAnalysisResult = NewDeepAnalysisResultClient(c.config)
 	c.DomainReport = NewDomainReportClient(c.config)
+	c.DomainRun = NewDomainRunClient(c.config)
+	c.Entity = NewEntityClient(c.config)
 	c.KeyEvent = NewKeyEventClient(c.config)
+	c.LLMCache = NewLLMCacheClient(c.config)
+	c.LLMCall = NewLLMCallClient(c.config)
+	c.Persona = NewPersonaClient(c.config)
 	c.ReportRun = NewReportRunClient(c.config)
+	c.ReportRunEvent = NewReportRunEventClient(c.config)
 	c.User = NewUserClient(c.config)
 }
 
@@ -154,11 +194,21 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		ctx:                ctx,
 		config:             cfg,
 		ActionGuide:        NewActionGuideClient(cfg),
+		AnalysisLens:       NewAnalysisLensClient(cfg),
+		AnalysisSection:    NewAnalysisSectionClient(cfg),
 		Article:            NewArticleClient(cfg),
+		ArticleEntity:      NewArticleEntityClient(cfg),
+		ClaimVerification:  NewClaimVerificationClient(cfg),
 		DeepAnalysisResult: NewDeepAnalysisResultClient(cfg),
 		DomainReport:       NewDomainReportClient(cfg),
+		DomainRun:          NewDomainRunClient(cfg),
+		Entity:             NewEntityClient(cfg),
 		KeyEvent:           NewKeyEventClient(cfg),
+		LLMCache:           NewLLMCacheClient(cfg),
+		LLMCall:            NewLLMCallClient(cfg),
+		Persona:            NewPersonaClient(cfg),
 		ReportRun:          NewReportRunClient(cfg),
+		ReportRunEvent:     NewReportRunEventClient(cfg),
 		User:               NewUserClient(cfg),
 	}, nil
 }
@@ -180,11 +230,21 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		ctx:                ctx,
 		config:             cfg,
 		ActionGuide:        NewActionGuideClient(cfg),
+		AnalysisLens:       NewAnalysisLensClient(cfg),
+		AnalysisSection:    NewAnalysisSectionClient(cfg),
 		Article:            NewArticleClient(cfg),
+		ArticleEntity:      NewArticleEntityClient(cfg),
+		ClaimVerification:  NewClaimVerificationClient(cfg),
 		DeepAnalysisResult: NewDeepAnalysisResultClient(cfg),
 		DomainReport:       NewDomainReportClient(cfg),
+		DomainRun:          NewDomainRunClient(cfg),
+		Entity:             NewEntityClient(cfg),
 		KeyEvent:           NewKeyEventClient(cfg),
+		LLMCache:           NewLLMCacheClient(cfg),
+		LLMCall:            NewLLMCallClient(cfg),
+		Persona:            NewPersonaClient(cfg),
 		ReportRun:          NewReportRunClient(cfg),
+		ReportRunEvent:     NewReportRunEventClient(cfg),
 		User:               NewUserClient(cfg),
 	}, nil
 }
@@ -215,8 +275,10 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.ActionGuide, c.Article, c.DeepAnalysisResult, c.DomainReport, c.KeyEvent,
-		c.ReportRun, c.User,
+		c.ActionGuide, c.AnalysisLens, c.AnalysisSection, c.Article, c.ArticleEntity,
+		c.ClaimVerification, c.DeepAnalysisResult, c.DomainReport, c.DomainRun,
+		c.Entity, c.KeyEvent, c.LLMCache, c.LLMCall, c.Persona, c.ReportRun,
+		c.ReportRunEvent, c.User,
 	} {
 		n.Use(hooks...)
 	}
@@ -226,8 +288,10 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.ActionGuide, c.Article, c.DeepAnalysisResult, c.DomainReport, c.KeyEvent,
-		c.ReportRun, c.User,
+		c.ActionGuide, c.AnalysisLens, c.AnalysisSection, c.Article, c.ArticleEntity,
+		c.ClaimVerification, c.DeepAnalysisResult, c.DomainReport, c.DomainRun,
+		c.Entity, c.KeyEvent, c.LLMCache, c.LLMCall, c.Persona, c.ReportRun,
+		c.ReportRunEvent, c.User,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -238,16 +302,36 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
 	case *ActionGuideMutation:
 		return c.ActionGuide.mutate(ctx, m)
+	case *AnalysisLensMutation:
+		return c.AnalysisLens.mutate(ctx, m)
+	case *AnalysisSectionMutation:
+		return c.AnalysisSection.mutate(ctx, m)
 	case *ArticleMutation:
 		return c.Article.mutate(ctx, m)
+	case *ArticleEntityMutation:
+		return c.ArticleEntity.mutate(ctx, m)
+	case *ClaimVerificationMutation:
+		return c.ClaimVerification.mutate(ctx, m)
 	case *DeepAnalysisResultMutation:
 		return c.DeepAnalysisResult.mutate(ctx, m)
 	case *DomainReportMutation:
 		return c.DomainReport.mutate(ctx, m)
+	case *DomainRunMutation:
+		return c.DomainRun.mutate(ctx, m)
+	case *EntityMutation:
+		return c.Entity.mutate(ctx, m)
 	case *KeyEventMutation:
 		return c.KeyEvent.mutate(ctx, m)
+	case *LLMCacheMutation:
+		return c.LLMCache.mutate(ctx, m)
+	case *LLMCallMutation:
+		return c.LLMCall.mutate(ctx, m)
+	case *PersonaMutation:
+		return c.Persona.mutate(ctx, m)
 	case *ReportRunMutation:
 		return c.ReportRun.mutate(ctx, m)
+	case *ReportRunEventMutation:
+		return c.ReportRunEvent.mutate(ctx, m)
 	case *UserMutation:
 		return c.User.mutate(ctx, m)
 	default:
@@ -404,107 +488,107 @@ func (c *ActionGuideClient) mutate(ctx context.Context, m *ActionGuideMutation)
 	}
 }
 
-// ArticleClient is a client for the Article schema.
-type ArticleClient struct {
+// AnalysisLensClient is a client for the AnalysisLens schema.
+type AnalysisLensClient struct {
 	config
 }
 
-// NewArticleClient returns a client for the Article from the given config.
-func NewArticleClient(c config) *ArticleClient {
-	return &ArticleClient{config: c}
+// NewAnalysisLensClient returns a client for the AnalysisLens from the given config.
+func NewAnalysisLensClient(c config) *AnalysisLensClient {
+	return &AnalysisLensClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `article.Hooks(f(g(h())))`.
-func (c *ArticleClient) Use(hooks ...Hook) {
-	c.hooks.Article = append(c.hooks.Article, hooks...)
+// A call to `Use(f, g, h)` equals to `analysislens.Hooks(f(g(h())))`.
+func (c *AnalysisLensClient) Use(hooks ...Hook) {
+	c.hooks.AnalysisLens = append(c.hooks.AnalysisLens, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `article.Intercept(f(g(h())))`.
-func (c *ArticleClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Article = append(c.inters.Article, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `analysislens.Intercept(f(g(h())))`.
+func (c *AnalysisLensClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AnalysisLens = append(c.inters.AnalysisLens, interceptors...)
 }
 
-// Create returns a builder for creating a Article entity.
-func (c *ArticleClient) Create() *ArticleCreate {
-	mutation := newArticleMutation(c.config, OpCreate)
-	return &ArticleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a AnalysisLens entity.
+func (c *AnalysisLensClient) Create() *AnalysisLensCreate {
+	mutation := newAnalysisLensMutation(c.config, OpCreate)
+	return &AnalysisLensCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Article entities.
-func (c *ArticleClient) CreateBulk(builders ...*ArticleCreate) *ArticleCreateBulk {
-	return &ArticleCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of AnalysisLens entities.
+func (c *AnalysisLensClient) CreateBulk(builders ...*AnalysisLensCreate) *AnalysisLensCreateBulk {
+	return &AnalysisLensCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ArticleClient) MapCreateBulk(slice any, setFunc func(*ArticleCreate, int)) *ArticleCreateBulk {
+func (c *AnalysisLensClient) MapCreateBulk(slice any, setFunc func(*AnalysisLensCreate, int)) *AnalysisLensCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ArticleCreateBulk{err: fmt.Errorf("calling to ArticleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &AnalysisLensCreateBulk{err: fmt.Errorf("calling to AnalysisLensClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ArticleCreate, rv.Len())
+	builders := make([]*AnalysisLensCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ArticleCreateBulk{config: c.config, builders: builders}
+	return &AnalysisLensCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Article.
-func (c *ArticleClient) Update() *ArticleUpdate {
-	mutation := newArticleMutation(c.config, OpUpdate)
-	return &ArticleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for AnalysisLens.
+func (c *AnalysisLensClient) Update() *AnalysisLensUpdate {
+	mutation := newAnalysisLensMutation(c.config, OpUpdate)
+	return &AnalysisLensUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ArticleClient) UpdateOne(_m *Article) *ArticleUpdateOne {
-	mutation := newArticleMutation(c.config, OpUpdateOne, withArticle(_m))
-	return &ArticleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AnalysisLensClient) UpdateOne(_m *AnalysisLens) *AnalysisLensUpdateOne {
+	mutation := newAnalysisLensMutation(c.config, OpUpdateOne, withAnalysisLens(_m))
+	return &AnalysisLensUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ArticleClient) UpdateOneID(id int) *ArticleUpdateOne {
-	mutation := newArticleMutation(c.config, OpUpdateOne, withArticleID(id))
-	return &ArticleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AnalysisLensClient) UpdateOneID(id int) *AnalysisLensUpdateOne {
+	mutation := newAnalysisLensMutation(c.config, OpUpdateOne, withAnalysisLensID(id))
+	return &AnalysisLensUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Article.
-func (c *ArticleClient) Delete() *ArticleDelete {
-	mutation := newArticleMutation(c.config, OpDelete)
-	return &ArticleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for AnalysisLens.
+func (c *AnalysisLensClient) Delete() *AnalysisLensDelete {
+	mutation := newAnalysisLensMutation(c.config, OpDelete)
+	return &AnalysisLensDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ArticleClient) DeleteOne(_m *Article) *ArticleDeleteOne {
+func (c *AnalysisLensClient) DeleteOne(_m *AnalysisLens) *AnalysisLensDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ArticleClient) DeleteOneID(id int) *ArticleDeleteOne {
-	builder := c.Delete().Where(article.ID(id))
+func (c *AnalysisLensClient) DeleteOneID(id int) *AnalysisLensDeleteOne {
+	builder := c.Delete().Where(analysislens.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ArticleDeleteOne{builder}
+	return &AnalysisLensDeleteOne{builder}
 }
 
-// Query returns a query builder for Article.
-func (c *ArticleClient) Query() *ArticleQuery {
-	return &ArticleQuery{
+// Query returns a query builder for AnalysisLens.
+func (c *AnalysisLensClient) Query() *AnalysisLensQuery {
+	return &AnalysisLensQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeArticle},
+		ctx:    &QueryContext{Type: TypeAnalysisLens},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Article entity by its id.
-func (c *ArticleClient) Get(ctx context.Context, id int) (*Article, error) {
-	return c.Query().Where(article.ID(id)).Only(ctx)
+// Get returns a AnalysisLens entity by its id.
+func (c *AnalysisLensClient) Get(ctx context.Context, id int) (*AnalysisLens, error) {
+	return c.Query().Where(analysislens.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ArticleClient) GetX(ctx context.Context, id int) *Article {
+func (c *AnalysisLensClient) GetX(ctx context.Context, id int) *AnalysisLens {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -512,15 +596,15 @@ func (c *ArticleClient) GetX(ctx context.Context, id int) *Article {
 	return obj
 }
 
-// QueryDomainReport queries the domain_report edge of a Article.
-func (c *ArticleClient) QueryDomainReport(_m *Article) *DomainReportQuery {
-	query := (&DomainReportClient{config: c.config}).Query()
+// QueryUser queries the user edge of a AnalysisLens.
+func (c *AnalysisLensClient) QueryUser(_m *AnalysisLens) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(article.Table, article.FieldID, id),
-			sqlgraph.To(domainreport.Table, domainreport.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, article.DomainReportTable, article.DomainReportColumn),
+			sqlgraph.From(analysislens.Table, analysislens.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, analysislens.UserTable, analysislens.UserColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -529,131 +613,131 @@ func (c *ArticleClient) QueryDomainReport(_m *Article) *DomainReportQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *ArticleClient) Hooks() []Hook {
-	return c.hooks.Article
+func (c *AnalysisLensClient) Hooks() []Hook {
+	return c.hooks.AnalysisLens
 }
 
 // Interceptors returns the client interceptors.
-func (c *ArticleClient) Interceptors() []Interceptor {
-	return c.inters.Article
+func (c *AnalysisLensClient) Interceptors() []Interceptor {
+	return c.inters.AnalysisLens
 }
 
-func (c *ArticleClient) mutate(ctx context.Context, m *ArticleMutation) (Value, error) {
+func (c *AnalysisLensClient) mutate(ctx context.Context, m *AnalysisLensMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ArticleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AnalysisLensCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ArticleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AnalysisLensUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ArticleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AnalysisLensUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ArticleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&AnalysisLensDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Article mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown AnalysisLens mutation op: %q", m.Op())
 	}
 }
 
-// DeepAnalysisResultClient is a client for the DeepAnalysisResult schema.
-type DeepAnalysisResultClient struct {
+// AnalysisSectionClient is a client for the AnalysisSection schema.
+type AnalysisSectionClient struct {
 	config
 }
 
-// NewDeepAnalysisResultClient returns a client for the DeepAnalysisResult from the given config.
-func NewDeepAnalysisResultClient(c config) *DeepAnalysisResultClient {
-	return &DeepAnalysisResultClient{config: c}
+// NewAnalysisSectionClient returns a client for the AnalysisSection from the given config.
+func NewAnalysisSectionClient(c config) *AnalysisSectionClient {
+	return &AnalysisSectionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `deepanalysisresult.Hooks(f(g(h())))`.
-func (c *DeepAnalysisResultClient) Use(hooks ...Hook) {
-	c.hooks.DeepAnalysisResult = append(c.hooks.DeepAnalysisResult, hooks...)
+// A call to `Use(f, g, h)` equals to `analysissection.Hooks(f(g(h())))`.
+func (c *AnalysisSectionClient) Use(hooks ...Hook) {
+	c.hooks.AnalysisSection = append(c.hooks.AnalysisSection, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `deepanalysisresult.Intercept(f(g(h())))`.
-func (c *DeepAnalysisResultClient) Intercept(interceptors ...Interceptor) {
-	c.inters.DeepAnalysisResult = append(c.inters.DeepAnalysisResult, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `analysissection.Intercept(f(g(h())))`.
+func (c *AnalysisSectionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AnalysisSection = append(c.inters.AnalysisSection, interceptors...)
 }
 
-// Create returns a builder for creating a DeepAnalysisResult entity.
-func (c *DeepAnalysisResultClient) Create() *DeepAnalysisResultCreate {
-	mutation := newDeepAnalysisResultMutation(c.config, OpCreate)
-	return &DeepAnalysisResultCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a AnalysisSection entity.
+func (c *AnalysisSectionClient) Create() *AnalysisSectionCreate {
+	mutation := newAnalysisSectionMutation(c.config, OpCreate)
+	return &AnalysisSectionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of DeepAnalysisResult entities.
-func (c *DeepAnalysisResultClient) CreateBulk(builders ...*DeepAnalysisResultCreate) *DeepAnalysisResultCreateBulk {
-	return &DeepAnalysisResultCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of AnalysisSection entities.
+func (c *AnalysisSectionClient) CreateBulk(builders ...*AnalysisSectionCreate) *AnalysisSectionCreateBulk {
+	return &AnalysisSectionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *DeepAnalysisResultClient) MapCreateBulk(slice any, setFunc func(*DeepAnalysisResultCreate, int)) *DeepAnalysisResultCreateBulk {
+func (c *AnalysisSectionClient) MapCreateBulk(slice any, setFunc func(*AnalysisSectionCreate, int)) *AnalysisSectionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &DeepAnalysisResultCreateBulk{err: fmt.Errorf("calling to DeepAnalysisResultClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &AnalysisSectionCreateBulk{err: fmt.Errorf("calling to AnalysisSectionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*DeepAnalysisResultCreate, rv.Len())
+	builders := make([]*AnalysisSectionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &DeepAnalysisResultCreateBulk{config: c.config, builders: builders}
+	return &AnalysisSectionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for DeepAnalysisResult.
-func (c *DeepAnalysisResultClient) Update() *DeepAnalysisResultUpdate {
-	mutation := newDeepAnalysisResultMutation(c.config, OpUpdate)
-	return &DeepAnalysisResultUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for AnalysisSection.
+func (c *AnalysisSectionClient) Update() *AnalysisSectionUpdate {
+	mutation := newAnalysisSectionMutation(c.config, OpUpdate)
+	return &AnalysisSectionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *DeepAnalysisResultClient) UpdateOne(_m *DeepAnalysisResult) *DeepAnalysisResultUpdateOne {
-	mutation := newDeepAnalysisResultMutation(c.config, OpUpdateOne, withDeepAnalysisResult(_m))
-	return &DeepAnalysisResultUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AnalysisSectionClient) UpdateOne(_m *AnalysisSection) *AnalysisSectionUpdateOne {
+	mutation := newAnalysisSectionMutation(c.config, OpUpdateOne, withAnalysisSection(_m))
+	return &AnalysisSectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *DeepAnalysisResultClient) UpdateOneID(id int) *DeepAnalysisResultUpdateOne {
-	mutation := newDeepAnalysisResultMutation(c.config, OpUpdateOne, withDeepAnalysisResultID(id))
-	return &DeepAnalysisResultUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AnalysisSectionClient) UpdateOneID(id int) *AnalysisSectionUpdateOne {
+	mutation := newAnalysisSectionMutation(c.config, OpUpdateOne, withAnalysisSectionID(id))
+	return &AnalysisSectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for DeepAnalysisResult.
-func (c *DeepAnalysisResultClient) Delete() *DeepAnalysisResultDelete {
-	mutation := newDeepAnalysisResultMutation(c.config, OpDelete)
-	return &DeepAnalysisResultDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for AnalysisSection.
+func (c *AnalysisSectionClient) Delete() *AnalysisSectionDelete {
+	mutation := newAnalysisSectionMutation(c.config, OpDelete)
+	return &AnalysisSectionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *DeepAnalysisResultClient) DeleteOne(_m *DeepAnalysisResult) *DeepAnalysisResultDeleteOne {
+func (c *AnalysisSectionClient) DeleteOne(_m *AnalysisSection) *AnalysisSectionDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *DeepAnalysisResultClient) DeleteOneID(id int) *DeepAnalysisResultDeleteOne {
-	builder := c.Delete().Where(deepanalysisresult.ID(id))
+func (c *AnalysisSectionClient) DeleteOneID(id int) *AnalysisSectionDeleteOne {
+	builder := c.Delete().Where(analysissection.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &DeepAnalysisResultDeleteOne{builder}
+	return &AnalysisSectionDeleteOne{builder}
 }
 
-// Query returns a query builder for DeepAnalysisResult.
-func (c *DeepAnalysisResultClient) Query() *DeepAnalysisResultQuery {
-	return &DeepAnalysisResultQuery{
+// Query returns a query builder for AnalysisSection.
+func (c *AnalysisSectionClient) Query() *AnalysisSectionQuery {
+	return &AnalysisSectionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeDeepAnalysisResult},
+		ctx:    &QueryContext{Type: TypeAnalysisSection},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a DeepAnalysisResult entity by its id.
-func (c *DeepAnalysisResultClient) Get(ctx context.Context, id int) (*DeepAnalysisResult, error) {
-	return c.Query().Where(deepanalysisresult.ID(id)).Only(ctx)
+// Get returns a AnalysisSection entity by its id.
+func (c *AnalysisSectionClient) Get(ctx context.Context, id int) (*AnalysisSection, error) {
+	return c.Query().Where(analysissection.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *DeepAnalysisResultClient) GetX(ctx context.Context, id int) *DeepAnalysisResult {
+func (c *AnalysisSectionClient) GetX(ctx context.Context, id int) *AnalysisSection {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -661,31 +745,15 @@ func (c *DeepAnalysisResultClient) GetX(ctx context.Context, id int) *DeepAnalys
 	return obj
 }
 
-// QueryReportRun queries the report_run edge of a DeepAnalysisResult.
-func (c *DeepAnalysisResultClient) QueryReportRun(_m *DeepAnalysisResult) *ReportRunQuery {
-	query := (&ReportRunClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(deepanalysisresult.Table, deepanalysisresult.FieldID, id),
-			sqlgraph.To(reportrun.Table, reportrun.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, deepanalysisresult.ReportRunTable, deepanalysisresult.ReportRunColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryActionGuides queries the action_guides edge of a DeepAnalysisResult.
-func (c *DeepAnalysisResultClient) QueryActionGuides(_m *DeepAnalysisResult) *ActionGuideQuery {
-	query := (&ActionGuideClient{config: c.config}).Query()
+// QueryDeepAnalysisResult queries the deep_analysis_result edge of a AnalysisSection.
+func (c *AnalysisSectionClient) QueryDeepAnalysisResult(_m *AnalysisSection) *DeepAnalysisResultQuery {
+	query := (&DeepAnalysisResultClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(deepanalysisresult.Table, deepanalysisresult.FieldID, id),
-			sqlgraph.To(actionguide.Table, actionguide.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, deepanalysisresult.ActionGuidesTable, deepanalysisresult.ActionGuidesColumn),
+			sqlgraph.From(analysissection.Table, analysissection.FieldID, id),
+			sqlgraph.To(deepanalysisresult.Table, deepanalysisresult.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, analysissection.DeepAnalysisResultTable, analysissection.DeepAnalysisResultColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -694,131 +762,131 @@ func (c *DeepAnalysisResultClient) QueryActionGuides(_m *DeepAnalysisResult) *Ac
 }
 
 // Hooks returns the client hooks.
-func (c *DeepAnalysisResultClient) Hooks() []Hook {
-	return c.hooks.DeepAnalysisResult
+func (c *AnalysisSectionClient) Hooks() []Hook {
+	return c.hooks.AnalysisSection
 }
 
 // Interceptors returns the client interceptors.
-func (c *DeepAnalysisResultClient) Interceptors() []Interceptor {
-	return c.inters.DeepAnalysisResult
+func (c *AnalysisSectionClient) Interceptors() []Interceptor {
+	return c.inters.AnalysisSection
 }
 
-func (c *DeepAnalysisResultClient) mutate(ctx context.Context, m *DeepAnalysisResultMutation) (Value, error) {
+func (c *AnalysisSectionClient) mutate(ctx context.Context, m *AnalysisSectionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&DeepAnalysisResultCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AnalysisSectionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&DeepAnalysisResultUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AnalysisSectionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&DeepAnalysisResultUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AnalysisSectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&DeepAnalysisResultDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&AnalysisSectionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown DeepAnalysisResult mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown AnalysisSection mutation op: %q", m.Op())
 	}
 }
 
-// DomainReportClient is a client for the DomainReport schema.
-type DomainReportClient struct {
+// ArticleClient is a client for the Article schema.
+type ArticleClient struct {
 	config
 }
 
-// NewDomainReportClient returns a client for the DomainReport from the given config.
-func NewDomainReportClient(c config) *DomainReportClient {
-	return &DomainReportClient{config: c}
+// NewArticleClient returns a client for the Article from the given config.
+func NewArticleClient(c config) *ArticleClient {
+	return &ArticleClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `domainreport.Hooks(f(g(h())))`.
-func (c *DomainReportClient) Use(hooks ...Hook) {
-	c.hooks.DomainReport = append(c.hooks.DomainReport, hooks...)
+// A call to `Use(f, g, h)` equals to `article.Hooks(f(g(h())))`.
+func (c *ArticleClient) Use(hooks ...Hook) {
+	c.hooks.Article = append(c.hooks.Article, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `domainreport.Intercept(f(g(h())))`.
-func (c *DomainReportClient) Intercept(interceptors ...Interceptor) {
-	c.inters.DomainReport = append(c.inters.DomainReport, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `article.Intercept(f(g(h())))`.
+func (c *ArticleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Article = append(c.inters.Article, interceptors...)
 }
 
-// Create returns a builder for creating a DomainReport entity.
-func (c *DomainReportClient) Create() *DomainReportCreate {
-	mutation := newDomainReportMutation(c.config, OpCreate)
-	return &DomainReportCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Article entity.
+func (c *ArticleClient) Create() *ArticleCreate {
+	mutation := newArticleMutation(c.config, OpCreate)
+	return &ArticleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of DomainReport entities.
-func (c *DomainReportClient) CreateBulk(builders ...*DomainReportCreate) *DomainReportCreateBulk {
-	return &DomainReportCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Article entities.
+func (c *ArticleClient) CreateBulk(builders ...*ArticleCreate) *ArticleCreateBulk {
+	return &ArticleCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *DomainReportClient) MapCreateBulk(slice any, setFunc func(*DomainReportCreate, int)) *DomainReportCreateBulk {
+func (c *ArticleClient) MapCreateBulk(slice any, setFunc func(*ArticleCreate, int)) *ArticleCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &DomainReportCreateBulk{err: fmt.Errorf("calling to DomainReportClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ArticleCreateBulk{err: fmt.Errorf("calling to ArticleClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*DomainReportCreate, rv.Len())
+	builders := make([]*ArticleCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &DomainReportCreateBulk{config: c.config, builders: builders}
+	return &ArticleCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for DomainReport.
-func (c *DomainReportClient) Update() *DomainReportUpdate {
-	mutation := newDomainReportMutation(c.config, OpUpdate)
-	return &DomainReportUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Article.
+func (c *ArticleClient) Update() *ArticleUpdate {
+	mutation := newArticleMutation(c.config, OpUpdate)
+	return &ArticleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *DomainReportClient) UpdateOne(_m *DomainReport) *DomainReportUpdateOne {
-	mutation := newDomainReportMutation(c.config, OpUpdateOne, withDomainReport(_m))
-	return &DomainReportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ArticleClient) UpdateOne(_m *Article) *ArticleUpdateOne {
+	mutation := newArticleMutation(c.config, OpUpdateOne, withArticle(_m))
+	return &ArticleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *DomainReportClient) UpdateOneID(id int) *DomainReportUpdateOne {
-	mutation := newDomainReportMutation(c.config, OpUpdateOne, withDomainReportID(id))
-	return &DomainReportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ArticleClient) UpdateOneID(id int) *ArticleUpdateOne {
+	mutation := newArticleMutation(c.config, OpUpdateOne, withArticleID(id))
+	return &ArticleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for DomainReport.
-func (c *DomainReportClient) Delete() *DomainReportDelete {
-	mutation := newDomainReportMutation(c.config, OpDelete)
-	return &DomainReportDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Article.
+func (c *ArticleClient) Delete() *ArticleDelete {
+	mutation := newArticleMutation(c.config, OpDelete)
+	return &ArticleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *DomainReportClient) DeleteOne(_m *DomainReport) *DomainReportDeleteOne {
+func (c *ArticleClient) DeleteOne(_m *Article) *ArticleDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *DomainReportClient) DeleteOneID(id int) *DomainReportDeleteOne {
-	builder := c.Delete().Where(domainreport.ID(id))
+func (c *ArticleClient) DeleteOneID(id int) *ArticleDeleteOne {
+	builder := c.Delete().Where(article.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &DomainReportDeleteOne{builder}
+	return &ArticleDeleteOne{builder}
 }
 
-// Query returns a query builder for DomainReport.
-func (c *DomainReportClient) Query() *DomainReportQuery {
-	return &DomainReportQuery{
+// Query returns a query builder for Article.
+func (c *ArticleClient) Query() *ArticleQuery {
+	return &ArticleQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeDomainReport},
+		ctx:    &QueryContext{Type: TypeArticle},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a DomainReport entity by its id.
-func (c *DomainReportClient) Get(ctx context.Context, id int) (*DomainReport, error) {
-	return c.Query().Where(domainreport.ID(id)).Only(ctx)
+// Get returns a Article entity by its id.
+func (c *ArticleClient) Get(ctx context.Context, id int) (*Article, error) {
+	return c.Query().Where(article.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *DomainReportClient) GetX(ctx context.Context, id int) *DomainReport {
+func (c *ArticleClient) GetX(ctx context.Context, id int) *Article {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -826,15 +894,15 @@ func (c *DomainReportClient) GetX(ctx context.Context, id int) *DomainReport {
 	return obj
 }
 
-// QueryReportRun queries the report_run edge of a DomainReport.
-func (c *DomainReportClient) QueryReportRun(_m *DomainReport) *ReportRunQuery {
-	query := (&ReportRunClient{config: c.config}).Query()
+// QueryDomainReport queries the domain_report edge of a Article.
+func (c *ArticleClient) QueryDomainReport(_m *Article) *DomainReportQuery {
+	query := (&DomainReportClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(domainreport.Table, domainreport.FieldID, id),
-			sqlgraph.To(reportrun.Table, reportrun.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, domainreport.ReportRunTable, domainreport.ReportRunColumn),
+			sqlgraph.From(article.Table, article.FieldID, id),
+			sqlgraph.To(domainreport.Table, domainreport.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, article.DomainReportTable, article.DomainReportColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -842,31 +910,1500 @@ func (c *DomainReportClient) QueryReportRun(_m *DomainReport) *ReportRunQuery {
 	return query
 }
 
-// QueryArticles queries the articles edge of a DomainReport.
-func (c *DomainReportClient) QueryArticles(_m *DomainReport) *ArticleQuery {
-	query := (&ArticleClient{config: c.config}).Query()
+// QueryKeyEvents queries the key_events edge of a Article.
+func (c *ArticleClient) QueryKeyEvents(_m *Article) *KeyEventQuery {
+	query := (&KeyEventClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(domainreport.Table, domainreport.FieldID, id),
+			sqlgraph.From(article.Table, article.FieldID, id),
+			sqlgraph.To(keyevent.Table, keyevent.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, article.KeyEventsTable, article.KeyEventsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryArticleEntities queries the article_entities edge of a Article.
+func (c *ArticleClient) QueryArticleEntities(_m *Article) *ArticleEntityQuery {
+	query := (&ArticleEntityClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(article.Table, article.FieldID, id),
+			sqlgraph.To(articleentity.Table, articleentity.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, article.ArticleEntitiesTable, article.ArticleEntitiesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ArticleClient) Hooks() []Hook {
+	return c.hooks.Article
+}
+
+// Interceptors returns the client interceptors.
+func (c *ArticleClient) Interceptors() []Interceptor {
+	return c.inters.Article
+}
+
+func (c *ArticleClient) mutate(ctx context.Context, m *ArticleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ArticleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ArticleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ArticleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ArticleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Article mutation op: %q", m.Op())
+	}
+}
+
+// ArticleEntityClient is a client for the ArticleEntity schema.
+type ArticleEntityClient struct {
+	config
+}
+
+// NewArticleEntityClient returns a client for the ArticleEntity from the given config.
+func NewArticleEntityClient(c config) *ArticleEntityClient {
+	return &ArticleEntityClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `articleentity.Hooks(f(g(h())))`.
+func (c *ArticleEntityClient) Use(hooks ...Hook) {
+	c.hooks.ArticleEntity = append(c.hooks.ArticleEntity, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `articleentity.Intercept(f(g(h())))`.
+func (c *ArticleEntityClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ArticleEntity = append(c.inters.ArticleEntity, interceptors...)
+}
+
+// Create returns a builder for creating a ArticleEntity entity.
+func (c *ArticleEntityClient) Create() *ArticleEntityCreate {
+	mutation := newArticleEntityMutation(c.config, OpCreate)
+	return &ArticleEntityCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ArticleEntity entities.
+func (c *ArticleEntityClient) CreateBulk(builders ...*ArticleEntityCreate) *ArticleEntityCreateBulk {
+	return &ArticleEntityCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ArticleEntityClient) MapCreateBulk(slice any, setFunc func(*ArticleEntityCreate, int)) *ArticleEntityCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ArticleEntityCreateBulk{err: fmt.Errorf("calling to ArticleEntityClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ArticleEntityCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ArticleEntityCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ArticleEntity.
+func (c *ArticleEntityClient) Update() *ArticleEntityUpdate {
+	mutation := newArticleEntityMutation(c.config, OpUpdate)
+	return &ArticleEntityUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ArticleEntityClient) UpdateOne(_m *ArticleEntity) *ArticleEntityUpdateOne {
+	mutation := newArticleEntityMutation(c.config, OpUpdateOne, withArticleEntity(_m))
+	return &ArticleEntityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ArticleEntityClient) UpdateOneID(id int) *ArticleEntityUpdateOne {
+	mutation := newArticleEntityMutation(c.config, OpUpdateOne, withArticleEntityID(id))
+	return &ArticleEntityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ArticleEntity.
+func (c *ArticleEntityClient) Delete() *ArticleEntityDelete {
+	mutation := newArticleEntityMutation(c.config, OpDelete)
+	return &ArticleEntityDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ArticleEntityClient) DeleteOne(_m *ArticleEntity) *ArticleEntityDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ArticleEntityClient) DeleteOneID(id int) *ArticleEntityDeleteOne {
+	builder := c.Delete().Where(articleentity.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ArticleEntityDeleteOne{builder}
+}
+
+// Query returns a query builder for ArticleEntity.
+func (c *ArticleEntityClient) Query() *ArticleEntityQuery {
+	return &ArticleEntityQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeArticleEntity},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ArticleEntity entity by its id.
+func (c *ArticleEntityClient) Get(ctx context.Context, id int) (*ArticleEntity, error) {
+	return c.Query().Where(articleentity.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ArticleEntityClient) GetX(ctx context.Context, id int) *ArticleEntity {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryArticle queries the article edge of a ArticleEntity.
+func (c *ArticleEntityClient) QueryArticle(_m *ArticleEntity) *ArticleQuery {
+	query := (&ArticleClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(articleentity.Table, articleentity.FieldID, id),
+			sqlgraph.To(article.Table, article.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, articleentity.ArticleTable, articleentity.ArticleColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryEntity queries the entity edge of a ArticleEntity.
+func (c *ArticleEntityClient) QueryEntity(_m *ArticleEntity) *EntityQuery {
+	query := (&EntityClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(articleentity.Table, articleentity.FieldID, id),
+			sqlgraph.To(entity.Table, entity.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, articleentity.EntityTable, articleentity.EntityColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ArticleEntityClient) Hooks() []Hook {
+	return c.hooks.ArticleEntity
+}
+
+// Interceptors returns the client interceptors.
+func (c *ArticleEntityClient) Interceptors() []Interceptor {
+	return c.inters.ArticleEntity
+}
+
+func (c *ArticleEntityClient) mutate(ctx context.Context, m *ArticleEntityMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ArticleEntityCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ArticleEntityUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ArticleEntityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ArticleEntityDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ArticleEntity mutation op: %q", m.Op())
+	}
+}
+
+// ClaimVerificationClient is a client for the ClaimVerification schema.
+type ClaimVerificationClient struct {
+	config
+}
+
+// NewClaimVerificationClient returns a client for the ClaimVerification from the given config.
+func NewClaimVerificationClient(c config) *ClaimVerificationClient {
+	return &ClaimVerificationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `claimverification.Hooks(f(g(h())))`.
+func (c *ClaimVerificationClient) Use(hooks ...Hook) {
+	c.hooks.ClaimVerification = append(c.hooks.ClaimVerification, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `claimverification.Intercept(f(g(h())))`.
+func (c *ClaimVerificationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ClaimVerification = append(c.inters.ClaimVerification, interceptors...)
+}
+
+// Create returns a builder for creating a ClaimVerification entity.
+func (c *ClaimVerificationClient) Create() *ClaimVerificationCreate {
+	mutation := newClaimVerificationMutation(c.config, OpCreate)
+	return &ClaimVerificationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ClaimVerification entities.
+func (c *ClaimVerificationClient) CreateBulk(builders ...*ClaimVerificationCreate) *ClaimVerificationCreateBulk {
+	return &ClaimVerificationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ClaimVerificationClient) MapCreateBulk(slice any, setFunc func(*ClaimVerificationCreate, int)) *ClaimVerificationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ClaimVerificationCreateBulk{err: fmt.Errorf("calling to ClaimVerificationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ClaimVerificationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ClaimVerificationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ClaimVerification.
+func (c *ClaimVerificationClient) Update() *ClaimVerificationUpdate {
+	mutation := newClaimVerificationMutation(c.config, OpUpdate)
+	return &ClaimVerificationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ClaimVerificationClient) UpdateOne(_m *ClaimVerification) *ClaimVerificationUpdateOne {
+	mutation := newClaimVerificationMutation(c.config, OpUpdateOne, withClaimVerification(_m))
+	return &ClaimVerificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ClaimVerificationClient) UpdateOneID(id int) *ClaimVerificationUpdateOne {
+	mutation := newClaimVerificationMutation(c.config, OpUpdateOne, withClaimVerificationID(id))
+	return &ClaimVerificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ClaimVerification.
+func (c *ClaimVerificationClient) Delete() *ClaimVerificationDelete {
+	mutation := newClaimVerificationMutation(c.config, OpDelete)
+	return &ClaimVerificationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ClaimVerificationClient) DeleteOne(_m *ClaimVerification) *ClaimVerificationDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ClaimVerificationClient) DeleteOneID(id int) *ClaimVerificationDeleteOne {
+	builder := c.Delete().Where(claimverification.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ClaimVerificationDeleteOne{builder}
+}
+
+// Query returns a query builder for ClaimVerification.
+func (c *ClaimVerificationClient) Query() *ClaimVerificationQuery {
+	return &ClaimVerificationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeClaimVerification},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ClaimVerification entity by its id.
+func (c *ClaimVerificationClient) Get(ctx context.Context, id int) (*ClaimVerification, error) {
+	return c.Query().Where(claimverification.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ClaimVerificationClient) GetX(ctx context.Context, id int) *ClaimVerification {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryDomainReport queries the domain_report edge of a ClaimVerification.
+func (c *ClaimVerificationClient) QueryDomainReport(_m *ClaimVerification) *DomainReportQuery {
+	query := (&DomainReportClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(claimverification.Table, claimverification.FieldID, id),
+			sqlgraph.To(domainreport.Table, domainreport.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, claimverification.DomainReportTable, claimverification.DomainReportColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ClaimVerificationClient) Hooks() []Hook {
+	return c.hooks.ClaimVerification
+}
+
+// Interceptors returns the client interceptors.
+func (c *ClaimVerificationClient) Interceptors() []Interceptor {
+	return c.inters.ClaimVerification
+}
+
+func (c *ClaimVerificationClient) mutate(ctx context.Context, m *ClaimVerificationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ClaimVerificationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ClaimVerificationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ClaimVerificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ClaimVerificationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ClaimVerification mutation op: %q", m.Op())
+	}
+}
+
+// DeepAnalysisResultClient is a client for the DeepAnalysisResult schema.
+type DeepAnalysisResultClient struct {
+	config
+}
+
+// NewDeepAnalysisResultClient returns a client for the DeepAnalysisResult from the given config.
+func NewDeepAnalysisResultClient(c config) *DeepAnalysisResultClient {
+	return &DeepAnalysisResultClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `deepanalysisresult.Hooks(f(g(h())))`.
+func (c *DeepAnalysisResultClient) Use(hooks ...Hook) {
+	c.hooks.DeepAnalysisResult = append(c.hooks.DeepAnalysisResult, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `deepanalysisresult.Intercept(f(g(h())))`.
+func (c *DeepAnalysisResultClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DeepAnalysisResult = append(c.inters.DeepAnalysisResult, interceptors...)
+}
+
+// Create returns a builder for creating a DeepAnalysisResult entity.
+func (c *DeepAnalysisResultClient) Create() *DeepAnalysisResultCreate {
+	mutation := newDeepAnalysisResultMutation(c.config, OpCreate)
+	return &DeepAnalysisResultCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DeepAnalysisResult entities.
+func (c *DeepAnalysisResultClient) CreateBulk(builders ...*DeepAnalysisResultCreate) *DeepAnalysisResultCreateBulk {
+	return &DeepAnalysisResultCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DeepAnalysisResultClient) MapCreateBulk(slice any, setFunc func(*DeepAnalysisResultCreate, int)) *DeepAnalysisResultCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DeepAnalysisResultCreateBulk{err: fmt.Errorf("calling to DeepAnalysisResultClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DeepAnalysisResultCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DeepAnalysisResultCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DeepAnalysisResult.
+func (c *DeepAnalysisResultClient) Update() *DeepAnalysisResultUpdate {
+	mutation := newDeepAnalysisResultMutation(c.config, OpUpdate)
+	return &DeepAnalysisResultUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DeepAnalysisResultClient) UpdateOne(_m *DeepAnalysisResult) *DeepAnalysisResultUpdateOne {
+	mutation := newDeepAnalysisResultMutation(c.config, OpUpdateOne, withDeepAnalysisResult(_m))
+	return &DeepAnalysisResultUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DeepAnalysisResultClient) UpdateOneID(id int) *DeepAnalysisResultUpdateOne {
+	mutation := newDeepAnalysisResultMutation(c.config, OpUpdateOne, withDeepAnalysisResultID(id))
+	return &DeepAnalysisResultUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DeepAnalysisResult.
+func (c *DeepAnalysisResultClient) Delete() *DeepAnalysisResultDelete {
+	mutation := newDeepAnalysisResultMutation(c.config, OpDelete)
+	return &DeepAnalysisResultDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DeepAnalysisResultClient) DeleteOne(_m *DeepAnalysisResult) *DeepAnalysisResultDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DeepAnalysisResultClient) DeleteOneID(id int) *DeepAnalysisResultDeleteOne {
+	builder := c.Delete().Where(deepanalysisresult.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DeepAnalysisResultDeleteOne{builder}
+}
+
+// Query returns a query builder for DeepAnalysisResult.
+func (c *DeepAnalysisResultClient) Query() *DeepAnalysisResultQuery {
+	return &DeepAnalysisResultQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDeepAnalysisResult},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DeepAnalysisResult entity by its id.
+func (c *DeepAnalysisResultClient) Get(ctx context.Context, id int) (*DeepAnalysisResult, error) {
+	return c.Query().Where(deepanalysisresult.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DeepAnalysisResultClient) GetX(ctx context.Context, id int) *DeepAnalysisResult {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryReportRun queries the report_run edge of a DeepAnalysisResult.
+func (c *DeepAnalysisResultClient) QueryReportRun(_m *DeepAnalysisResult) *ReportRunQuery {
+	query := (&ReportRunClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(deepanalysisresult.Table, deepanalysisresult.FieldID, id),
+			sqlgraph.To(reportrun.Table, reportrun.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, deepanalysisresult.ReportRunTable, deepanalysisresult.ReportRunColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryActionGuides queries the action_guides edge of a DeepAnalysisResult.
+func (c *DeepAnalysisResultClient) QueryActionGuides(_m *DeepAnalysisResult) *ActionGuideQuery {
+	query := (&ActionGuideClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(deepanalysisresult.Table, deepanalysisresult.FieldID, id),
+			sqlgraph.To(actionguide.Table, actionguide.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, deepanalysisresult.ActionGuidesTable, deepanalysisresult.ActionGuidesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySections queries the sections edge of a DeepAnalysisResult.
+func (c *DeepAnalysisResultClient) QuerySections(_m *DeepAnalysisResult) *AnalysisSectionQuery {
+	query := (&AnalysisSectionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(deepanalysisresult.Table, deepanalysisresult.FieldID, id),
+			sqlgraph.To(analysissection.Table, analysissection.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, deepanalysisresult.SectionsTable, deepanalysisresult.SectionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPersona queries the persona edge of a DeepAnalysisResult.
+func (c *DeepAnalysisResultClient) QueryPersona(_m *DeepAnalysisResult) *PersonaQuery {
+	query := (&PersonaClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(deepanalysisresult.Table, deepanalysisresult.FieldID, id),
+			sqlgraph.To(persona.Table, persona.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, deepanalysisresult.PersonaTable, deepanalysisresult.PersonaColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *DeepAnalysisResultClient) Hooks() []Hook {
+	return c.hooks.DeepAnalysisResult
+}
+
+// Interceptors returns the client interceptors.
+func (c *DeepAnalysisResultClient) Interceptors() []Interceptor {
+	return c.inters.DeepAnalysisResult
+}
+
+func (c *DeepAnalysisResultClient) mutate(ctx context.Context, m *DeepAnalysisResultMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DeepAnalysisResultCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DeepAnalysisResultUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DeepAnalysisResultUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DeepAnalysisResultDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DeepAnalysisResult mutation op: %q", m.Op())
+	}
+}
+
+// DomainReportClient is a client for the DomainReport schema.
+type DomainReportClient struct {
+	config
+}
+
+// NewDomainReportClient returns a client for the DomainReport from the given config.
+func NewDomainReportClient(c config) *DomainReportClient {
+	return &DomainReportClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `domainreport.Hooks(f(g(h())))`.
+func (c *DomainReportClient) Use(hooks ...Hook) {
+	c.hooks.DomainReport = append(c.hooks.DomainReport, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `domainreport.Intercept(f(g(h())))`.
+func (c *DomainReportClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DomainReport = append(c.inters.DomainReport, interceptors...)
+}
+
+// Create returns a builder for creating a DomainReport entity.
+func (c *DomainReportClient) Create() *DomainReportCreate {
+	mutation := newDomainReportMutation(c.config, OpCreate)
+	return &DomainReportCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DomainReport entities.
+func (c *DomainReportClient) CreateBulk(builders ...*DomainReportCreate) *DomainReportCreateBulk {
+	return &DomainReportCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DomainReportClient) MapCreateBulk(slice any, setFunc func(*DomainReportCreate, int)) *DomainReportCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DomainReportCreateBulk{err: fmt.Errorf("calling to DomainReportClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DomainReportCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DomainReportCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DomainReport.
+func (c *DomainReportClient) Update() *DomainReportUpdate {
+	mutation := newDomainReportMutation(c.config, OpUpdate)
+	return &DomainReportUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DomainReportClient) UpdateOne(_m *DomainReport) *DomainReportUpdateOne {
+	mutation := newDomainReportMutation(c.config, OpUpdateOne, withDomainReport(_m))
+	return &DomainReportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DomainReportClient) UpdateOneID(id int) *DomainReportUpdateOne {
+	mutation := newDomainReportMutation(c.config, OpUpdateOne, withDomainReportID(id))
+	return &DomainReportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DomainReport.
+func (c *DomainReportClient) Delete() *DomainReportDelete {
+	mutation := newDomainReportMutation(c.config, OpDelete)
+	return &DomainReportDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DomainReportClient) DeleteOne(_m *DomainReport) *DomainReportDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DomainReportClient) DeleteOneID(id int) *DomainReportDeleteOne {
+	builder := c.Delete().Where(domainreport.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DomainReportDeleteOne{builder}
+}
+
+// Query returns a query builder for DomainReport.
+func (c *DomainReportClient) Query() *DomainReportQuery {
+	return &DomainReportQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDomainReport},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DomainReport entity by its id.
+func (c *DomainReportClient) Get(ctx context.Context, id int) (*DomainReport, error) {
+	return c.Query().Where(domainreport.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DomainReportClient) GetX(ctx context.Context, id int) *DomainReport {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySourceRun queries the source_run edge of a DomainReport.
+func (c *DomainReportClient) QuerySourceRun(_m *DomainReport) *ReportRunQuery {
+	query := (&ReportRunClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(domainreport.Table, domainreport.FieldID, id),
+			sqlgraph.To(reportrun.Table, reportrun.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, domainreport.SourceRunTable, domainreport.SourceRunColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReportRuns queries the report_runs edge of a DomainReport.
+func (c *DomainReportClient) QueryReportRuns(_m *DomainReport) *ReportRunQuery {
+	query := (&ReportRunClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(domainreport.Table, domainreport.FieldID, id),
+			sqlgraph.To(reportrun.Table, reportrun.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, domainreport.ReportRunsTable, domainreport.ReportRunsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryArticles queries the articles edge of a DomainReport.
+func (c *DomainReportClient) QueryArticles(_m *DomainReport) *ArticleQuery {
+	query := (&ArticleClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(domainreport.Table, domainreport.FieldID, id),
+			sqlgraph.To(article.Table, article.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, domainreport.ArticlesTable, domainreport.ArticlesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryKeyEvents queries the key_events edge of a DomainReport.
+func (c *DomainReportClient) QueryKeyEvents(_m *DomainReport) *KeyEventQuery {
+	query := (&KeyEventClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(domainreport.Table, domainreport.FieldID, id),
+			sqlgraph.To(keyevent.Table, keyevent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, domainreport.KeyEventsTable, domainreport.KeyEventsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryClaimVerifications queries the claim_verifications edge of a DomainReport.
+func (c *DomainReportClient) QueryClaimVerifications(_m *DomainReport) *ClaimVerificationQuery {
+	query := (&ClaimVerificationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(domainreport.Table, domainreport.FieldID, id),
+			sqlgraph.To(claimverification.Table, claimverification.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, domainreport.ClaimVerificationsTable, domainreport.ClaimVerificationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *DomainReportClient) Hooks() []Hook {
+	return c.hooks.DomainReport
+}
+
+// Interceptors returns the client interceptors.
+func (c *DomainReportClient) Interceptors() []Interceptor {
+	return c.inters.DomainReport
+}
+
+func (c *DomainReportClient) mutate(ctx context.Context, m *DomainReportMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DomainReportCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DomainReportUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DomainReportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DomainReportDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DomainReport mutation op: %q", m.Op())
+	}
+}
+
+// DomainRunClient is a client for the DomainRun schema.
+type DomainRunClient struct {
+	config
+}
+
+// NewDomainRunClient returns a client for the DomainRun from the given config.
+func NewDomainRunClient(c config) *DomainRunClient {
+	return &DomainRunClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `domainrun.Hooks(f(g(h())))`.
+func (c *DomainRunClient) Use(hooks ...Hook) {
+	c.hooks.DomainRun = append(c.hooks.DomainRun, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `domainrun.Intercept(f(g(h())))`.
+func (c *DomainRunClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DomainRun = append(c.inters.DomainRun, interceptors...)
+}
+
+// Create returns a builder for creating a DomainRun entity.
+func (c *DomainRunClient) Create() *DomainRunCreate {
+	mutation := newDomainRunMutation(c.config, OpCreate)
+	return &DomainRunCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DomainRun entities.
+func (c *DomainRunClient) CreateBulk(builders ...*DomainRunCreate) *DomainRunCreateBulk {
+	return &DomainRunCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DomainRunClient) MapCreateBulk(slice any, setFunc func(*DomainRunCreate, int)) *DomainRunCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DomainRunCreateBulk{err: fmt.Errorf("calling to DomainRunClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DomainRunCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DomainRunCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DomainRun.
+func (c *DomainRunClient) Update() *DomainRunUpdate {
+	mutation := newDomainRunMutation(c.config, OpUpdate)
+	return &DomainRunUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DomainRunClient) UpdateOne(_m *DomainRun) *DomainRunUpdateOne {
+	mutation := newDomainRunMutation(c.config, OpUpdateOne, withDomainRun(_m))
+	return &DomainRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DomainRunClient) UpdateOneID(id int) *DomainRunUpdateOne {
+	mutation := newDomainRunMutation(c.config, OpUpdateOne, withDomainRunID(id))
+	return &DomainRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DomainRun.
+func (c *DomainRunClient) Delete() *DomainRunDelete {
+	mutation := newDomainRunMutation(c.config, OpDelete)
+	return &DomainRunDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DomainRunClient) DeleteOne(_m *DomainRun) *DomainRunDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DomainRunClient) DeleteOneID(id int) *DomainRunDeleteOne {
+	builder := c.Delete().Where(domainrun.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DomainRunDeleteOne{builder}
+}
+
+// Query returns a query builder for DomainRun.
+func (c *DomainRunClient) Query() *DomainRunQuery {
+	return &DomainRunQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDomainRun},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DomainRun entity by its id.
+func (c *DomainRunClient) Get(ctx context.Context, id int) (*DomainRun, error) {
+	return c.Query().Where(domainrun.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DomainRunClient) GetX(ctx context.Context, id int) *DomainRun {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryReportRun queries the report_run edge of a DomainRun.
+func (c *DomainRunClient) QueryReportRun(_m *DomainRun) *ReportRunQuery {
+	query := (&ReportRunClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(domainrun.Table, domainrun.FieldID, id),
+			sqlgraph.To(reportrun.Table, reportrun.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, domainrun.ReportRunTable, domainrun.ReportRunColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *DomainRunClient) Hooks() []Hook {
+	return c.hooks.DomainRun
+}
+
+// Interceptors returns the client interceptors.
+func (c *DomainRunClient) Interceptors() []Interceptor {
+	return c.inters.DomainRun
+}
+
+func (c *DomainRunClient) mutate(ctx context.Context, m *DomainRunMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DomainRunCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DomainRunUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DomainRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DomainRunDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DomainRun mutation op: %q", m.Op())
+	}
+}
+
+// EntityClient is a client for the Entity schema.
+type EntityClient struct {
+	config
+}
+
+// NewEntityClient returns a client for the Entity from the given config.
+func NewEntityClient(c config) *EntityClient {
+	return &EntityClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `entity.Hooks(f(g(h())))`.
+func (c *EntityClient) Use(hooks ...Hook) {
+	c.hooks.Entity = append(c.hooks.Entity, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `entity.Intercept(f(g(h())))`.
+func (c *EntityClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Entity = append(c.inters.Entity, interceptors...)
+}
+
+// Create returns a builder for creating a Entity entity.
+func (c *EntityClient) Create() *EntityCreate {
+	mutation := newEntityMutation(c.config, OpCreate)
+	return &EntityCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Entity entities.
+func (c *EntityClient) CreateBulk(builders ...*EntityCreate) *EntityCreateBulk {
+	return &EntityCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EntityClient) MapCreateBulk(slice any, setFunc func(*EntityCreate, int)) *EntityCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EntityCreateBulk{err: fmt.Errorf("calling to EntityClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EntityCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EntityCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Entity.
+func (c *EntityClient) Update() *EntityUpdate {
+	mutation := newEntityMutation(c.config, OpUpdate)
+	return &EntityUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EntityClient) UpdateOne(_m *Entity) *EntityUpdateOne {
+	mutation := newEntityMutation(c.config, OpUpdateOne, withEntity(_m))
+	return &EntityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EntityClient) UpdateOneID(id int) *EntityUpdateOne {
+	mutation := newEntityMutation(c.config, OpUpdateOne, withEntityID(id))
+	return &EntityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Entity.
+func (c *EntityClient) Delete() *EntityDelete {
+	mutation := newEntityMutation(c.config, OpDelete)
+	return &EntityDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EntityClient) DeleteOne(_m *Entity) *EntityDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EntityClient) DeleteOneID(id int) *EntityDeleteOne {
+	builder := c.Delete().Where(entity.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EntityDeleteOne{builder}
+}
+
+// Query returns a query builder for Entity.
+func (c *EntityClient) Query() *EntityQuery {
+	return &EntityQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEntity},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Entity entity by its id.
+func (c *EntityClient) Get(ctx context.Context, id int) (*Entity, error) {
+	return c.Query().Where(entity.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EntityClient) GetX(ctx context.Context, id int) *Entity {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryArticleEntities queries the article_entities edge of a Entity.
+func (c *EntityClient) QueryArticleEntities(_m *Entity) *ArticleEntityQuery {
+	query := (&ArticleEntityClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(entity.Table, entity.FieldID, id),
+			sqlgraph.To(articleentity.Table, articleentity.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, entity.ArticleEntitiesTable, entity.ArticleEntitiesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *EntityClient) Hooks() []Hook {
+	return c.hooks.Entity
+}
+
+// Interceptors returns the client interceptors.
+func (c *EntityClient) Interceptors() []Interceptor {
+	return c.inters.Entity
+}
+
+func (c *EntityClient) mutate(ctx context.Context, m *EntityMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EntityCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EntityUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EntityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EntityDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Entity mutation op: %q", m.Op())
+	}
+}
+
+// KeyEventClient is a client for the KeyEvent schema.
+type KeyEventClient struct {
+	config
+}
+
+// NewKeyEventClient returns a client for the KeyEvent from the given config.
+func NewKeyEventClient(c config) *KeyEventClient {
+	return &KeyEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `keyevent.Hooks(f(g(h())))`.
+func (c *KeyEventClient) Use(hooks ...Hook) {
+	c.hooks.KeyEvent = append(c.hooks.KeyEvent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `keyevent.Intercept(f(g(h())))`.
+func (c *KeyEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.KeyEvent = append(c.inters.KeyEvent, interceptors...)
+}
+
+// Create returns a builder for creating a KeyEvent entity.
+func (c *KeyEventClient) Create() *KeyEventCreate {
+	mutation := newKeyEventMutation(c.config, OpCreate)
+	return &KeyEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of KeyEvent entities.
+func (c *KeyEventClient) CreateBulk(builders ...*KeyEventCreate) *KeyEventCreateBulk {
+	return &KeyEventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *KeyEventClient) MapCreateBulk(slice any, setFunc func(*KeyEventCreate, int)) *KeyEventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &KeyEventCreateBulk{err: fmt.Errorf("calling to KeyEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*KeyEventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &KeyEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for KeyEvent.
+func (c *KeyEventClient) Update() *KeyEventUpdate {
+	mutation := newKeyEventMutation(c.config, OpUpdate)
+	return &KeyEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *KeyEventClient) UpdateOne(_m *KeyEvent) *KeyEventUpdateOne {
+	mutation := newKeyEventMutation(c.config, OpUpdateOne, withKeyEvent(_m))
+	return &KeyEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *KeyEventClient) UpdateOneID(id int) *KeyEventUpdateOne {
+	mutation := newKeyEventMutation(c.config, OpUpdateOne, withKeyEventID(id))
+	return &KeyEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for KeyEvent.
+func (c *KeyEventClient) Delete() *KeyEventDelete {
+	mutation := newKeyEventMutation(c.config, OpDelete)
+	return &KeyEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *KeyEventClient) DeleteOne(_m *KeyEvent) *KeyEventDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *KeyEventClient) DeleteOneID(id int) *KeyEventDeleteOne {
+	builder := c.Delete().Where(keyevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &KeyEventDeleteOne{builder}
+}
+
+// Query returns a query builder for KeyEvent.
+func (c *KeyEventClient) Query() *KeyEventQuery {
+	return &KeyEventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeKeyEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a KeyEvent entity by its id.
+func (c *KeyEventClient) Get(ctx context.Context, id int) (*KeyEvent, error) {
+	return c.Query().Where(keyevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *KeyEventClient) GetX(ctx context.Context, id int) *KeyEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryDomainReport queries the domain_report edge of a KeyEvent.
+func (c *KeyEventClient) QueryDomainReport(_m *KeyEvent) *DomainReportQuery {
+	query := (&DomainReportClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(keyevent.Table, keyevent.FieldID, id),
+			sqlgraph.To(domainreport.Table, domainreport.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, keyevent.DomainReportTable, keyevent.DomainReportColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryArticles queries the articles edge of a KeyEvent.
+func (c *KeyEventClient) QueryArticles(_m *KeyEvent) *ArticleQuery {
+	query := (&ArticleClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(keyevent.Table, keyevent.FieldID, id),
 			sqlgraph.To(article.Table, article.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, domainreport.ArticlesTable, domainreport.ArticlesColumn),
+			sqlgraph.Edge(sqlgraph.M2M, false, keyevent.ArticlesTable, keyevent.ArticlesPrimaryKey...),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
 	}
-	return query
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *KeyEventClient) Hooks() []Hook {
+	return c.hooks.KeyEvent
+}
+
+// Interceptors returns the client interceptors.
+func (c *KeyEventClient) Interceptors() []Interceptor {
+	return c.inters.KeyEvent
+}
+
+func (c *KeyEventClient) mutate(ctx context.Context, m *KeyEventMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&KeyEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&KeyEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&KeyEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&KeyEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown KeyEvent mutation op: %q", m.Op())
+	}
+}
+
+// LLMCacheClient is a client for the LLMCache schema.
+type LLMCacheClient struct {
+	config
+}
+
+// NewLLMCacheClient returns a client for the LLMCache from the given config.
+func NewLLMCacheClient(c config) *LLMCacheClient {
+	return &LLMCacheClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `llmcache.Hooks(f(g(h())))`.
+func (c *LLMCacheClient) Use(hooks ...Hook) {
+	c.hooks.LLMCache = append(c.hooks.LLMCache, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `llmcache.Intercept(f(g(h())))`.
+func (c *LLMCacheClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LLMCache = append(c.inters.LLMCache, interceptors...)
+}
+
+// Create returns a builder for creating a LLMCache entity.
+func (c *LLMCacheClient) Create() *LLMCacheCreate {
+	mutation := newLLMCacheMutation(c.config, OpCreate)
+	return &LLMCacheCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LLMCache entities.
+func (c *LLMCacheClient) CreateBulk(builders ...*LLMCacheCreate) *LLMCacheCreateBulk {
+	return &LLMCacheCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LLMCacheClient) MapCreateBulk(slice any, setFunc func(*LLMCacheCreate, int)) *LLMCacheCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LLMCacheCreateBulk{err: fmt.Errorf("calling to LLMCacheClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LLMCacheCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LLMCacheCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LLMCache.
+func (c *LLMCacheClient) Update() *LLMCacheUpdate {
+	mutation := newLLMCacheMutation(c.config, OpUpdate)
+	return &LLMCacheUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LLMCacheClient) UpdateOne(_m *LLMCache) *LLMCacheUpdateOne {
+	mutation := newLLMCacheMutation(c.config, OpUpdateOne, withLLMCache(_m))
+	return &LLMCacheUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LLMCacheClient) UpdateOneID(id int) *LLMCacheUpdateOne {
+	mutation := newLLMCacheMutation(c.config, OpUpdateOne, withLLMCacheID(id))
+	return &LLMCacheUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LLMCache.
+func (c *LLMCacheClient) Delete() *LLMCacheDelete {
+	mutation := newLLMCacheMutation(c.config, OpDelete)
+	return &LLMCacheDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LLMCacheClient) DeleteOne(_m *LLMCache) *LLMCacheDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LLMCacheClient) DeleteOneID(id int) *LLMCacheDeleteOne {
+	builder := c.Delete().Where(llmcache.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LLMCacheDeleteOne{builder}
+}
+
+// Query returns a query builder for LLMCache.
+func (c *LLMCacheClient) Query() *LLMCacheQuery {
+	return &LLMCacheQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLLMCache},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LLMCache entity by its id.
+func (c *LLMCacheClient) Get(ctx context.Context, id int) (*LLMCache, error) {
+	return c.Query().Where(llmcache.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LLMCacheClient) GetX(ctx context.Context, id int) *LLMCache {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *LLMCacheClient) Hooks() []Hook {
+	return c.hooks.LLMCache
+}
+
+// Interceptors returns the client interceptors.
+func (c *LLMCacheClient) Interceptors() []Interceptor {
+	return c.inters.LLMCache
+}
+
+func (c *LLMCacheClient) mutate(ctx context.Context, m *LLMCacheMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LLMCacheCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LLMCacheUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LLMCacheUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LLMCacheDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LLMCache mutation op: %q", m.Op())
+	}
+}
+
+// LLMCallClient is a client for the LLMCall schema.
+type LLMCallClient struct {
+	config
+}
+
+// NewLLMCallClient returns a client for the LLMCall from the given config.
+func NewLLMCallClient(c config) *LLMCallClient {
+	return &LLMCallClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `llmcall.Hooks(f(g(h())))`.
+func (c *LLMCallClient) Use(hooks ...Hook) {
+	c.hooks.LLMCall = append(c.hooks.LLMCall, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `llmcall.Intercept(f(g(h())))`.
+func (c *LLMCallClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LLMCall = append(c.inters.LLMCall, interceptors...)
+}
+
+// Create returns a builder for creating a LLMCall entity.
+func (c *LLMCallClient) Create() *LLMCallCreate {
+	mutation := newLLMCallMutation(c.config, OpCreate)
+	return &LLMCallCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LLMCall entities.
+func (c *LLMCallClient) CreateBulk(builders ...*LLMCallCreate) *LLMCallCreateBulk {
+	return &LLMCallCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LLMCallClient) MapCreateBulk(slice any, setFunc func(*LLMCallCreate, int)) *LLMCallCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LLMCallCreateBulk{err: fmt.Errorf("calling to LLMCallClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LLMCallCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LLMCallCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LLMCall.
+func (c *LLMCallClient) Update() *LLMCallUpdate {
+	mutation := newLLMCallMutation(c.config, OpUpdate)
+	return &LLMCallUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LLMCallClient) UpdateOne(_m *LLMCall) *LLMCallUpdateOne {
+	mutation := newLLMCallMutation(c.config, OpUpdateOne, withLLMCall(_m))
+	return &LLMCallUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LLMCallClient) UpdateOneID(id int) *LLMCallUpdateOne {
+	mutation := newLLMCallMutation(c.config, OpUpdateOne, withLLMCallID(id))
+	return &LLMCallUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LLMCall.
+func (c *LLMCallClient) Delete() *LLMCallDelete {
+	mutation := newLLMCallMutation(c.config, OpDelete)
+	return &LLMCallDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LLMCallClient) DeleteOne(_m *LLMCall) *LLMCallDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LLMCallClient) DeleteOneID(id int) *LLMCallDeleteOne {
+	builder := c.Delete().Where(llmcall.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LLMCallDeleteOne{builder}
+}
+
+// Query returns a query builder for LLMCall.
+func (c *LLMCallClient) Query() *LLMCallQuery {
+	return &LLMCallQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLLMCall},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LLMCall entity by its id.
+func (c *LLMCallClient) Get(ctx context.Context, id int) (*LLMCall, error) {
+	return c.Query().Where(llmcall.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LLMCallClient) GetX(ctx context.Context, id int) *LLMCall {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
 }
 
-// QueryKeyEvents queries the key_events edge of a DomainReport.
-func (c *DomainReportClient) QueryKeyEvents(_m *DomainReport) *KeyEventQuery {
-	query := (&KeyEventClient{config: c.config}).Query()
+// QueryReportRun queries the report_run edge of a LLMCall.
+func (c *LLMCallClient) QueryReportRun(_m *LLMCall) *ReportRunQuery {
+	query := (&ReportRunClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(domainreport.Table, domainreport.FieldID, id),
-			sqlgraph.To(keyevent.Table, keyevent.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, domainreport.KeyEventsTable, domainreport.KeyEventsColumn),
+			sqlgraph.From(llmcall.Table, llmcall.FieldID, id),
+			sqlgraph.To(reportrun.Table, reportrun.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, llmcall.ReportRunTable, llmcall.ReportRunColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -875,131 +2412,131 @@ func (c *DomainReportClient) QueryKeyEvents(_m *DomainReport) *KeyEventQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *DomainReportClient) Hooks() []Hook {
-	return c.hooks.DomainReport
+func (c *LLMCallClient) Hooks() []Hook {
+	return c.hooks.LLMCall
 }
 
 // Interceptors returns the client interceptors.
-func (c *DomainReportClient) Interceptors() []Interceptor {
-	return c.inters.DomainReport
+func (c *LLMCallClient) Interceptors() []Interceptor {
+	return c.inters.LLMCall
 }
 
-func (c *DomainReportClient) mutate(ctx context.Context, m *DomainReportMutation) (Value, error) {
+func (c *LLMCallClient) mutate(ctx context.Context, m *LLMCallMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&DomainReportCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&LLMCallCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&DomainReportUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&LLMCallUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&DomainReportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&LLMCallUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&DomainReportDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&LLMCallDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown DomainReport mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown LLMCall mutation op: %q", m.Op())
 	}
 }
 
-// KeyEventClient is a client for the KeyEvent schema.
-type KeyEventClient struct {
+// PersonaClient is a client for the Persona schema.
+type PersonaClient struct {
 	config
 }
 
-// NewKeyEventClient returns a client for the KeyEvent from the given config.
-func NewKeyEventClient(c config) *KeyEventClient {
-	return &KeyEventClient{config: c}
+// NewPersonaClient returns a client for the Persona from the given config.
+func NewPersonaClient(c config) *PersonaClient {
+	return &PersonaClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `keyevent.Hooks(f(g(h())))`.
-func (c *KeyEventClient) Use(hooks ...Hook) {
-	c.hooks.KeyEvent = append(c.hooks.KeyEvent, hooks...)
+// A call to `Use(f, g, h)` equals to `persona.Hooks(f(g(h())))`.
+func (c *PersonaClient) Use(hooks ...Hook) {
+	c.hooks.Persona = append(c.hooks.Persona, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `keyevent.Intercept(f(g(h())))`.
-func (c *KeyEventClient) Intercept(interceptors ...Interceptor) {
-	c.inters.KeyEvent = append(c.inters.KeyEvent, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `persona.Intercept(f(g(h())))`.
+func (c *PersonaClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Persona = append(c.inters.Persona, interceptors...)
 }
 
-// Create returns a builder for creating a KeyEvent entity.
-func (c *KeyEventClient) Create() *KeyEventCreate {
-	mutation := newKeyEventMutation(c.config, OpCreate)
-	return &KeyEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Persona entity.
+func (c *PersonaClient) Create() *PersonaCreate {
+	mutation := newPersonaMutation(c.config, OpCreate)
+	return &PersonaCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of KeyEvent entities.
-func (c *KeyEventClient) CreateBulk(builders ...*KeyEventCreate) *KeyEventCreateBulk {
-	return &KeyEventCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Persona entities.
+func (c *PersonaClient) CreateBulk(builders ...*PersonaCreate) *PersonaCreateBulk {
+	return &PersonaCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *KeyEventClient) MapCreateBulk(slice any, setFunc func(*KeyEventCreate, int)) *KeyEventCreateBulk {
+func (c *PersonaClient) MapCreateBulk(slice any, setFunc func(*PersonaCreate, int)) *PersonaCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &KeyEventCreateBulk{err: fmt.Errorf("calling to KeyEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &PersonaCreateBulk{err: fmt.Errorf("calling to PersonaClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*KeyEventCreate, rv.Len())
+	builders := make([]*PersonaCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &KeyEventCreateBulk{config: c.config, builders: builders}
+	return &PersonaCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for KeyEvent.
-func (c *KeyEventClient) Update() *KeyEventUpdate {
-	mutation := newKeyEventMutation(c.config, OpUpdate)
-	return &KeyEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Persona.
+func (c *PersonaClient) Update() *PersonaUpdate {
+	mutation := newPersonaMutation(c.config, OpUpdate)
+	return &PersonaUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *KeyEventClient) UpdateOne(_m *KeyEvent) *KeyEventUpdateOne {
-	mutation := newKeyEventMutation(c.config, OpUpdateOne, withKeyEvent(_m))
-	return &KeyEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *PersonaClient) UpdateOne(_m *Persona) *PersonaUpdateOne {
+	mutation := newPersonaMutation(c.config, OpUpdateOne, withPersona(_m))
+	return &PersonaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *KeyEventClient) UpdateOneID(id int) *KeyEventUpdateOne {
-	mutation := newKeyEventMutation(c.config, OpUpdateOne, withKeyEventID(id))
-	return &KeyEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *PersonaClient) UpdateOneID(id int) *PersonaUpdateOne {
+	mutation := newPersonaMutation(c.config, OpUpdateOne, withPersonaID(id))
+	return &PersonaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for KeyEvent.
-func (c *KeyEventClient) Delete() *KeyEventDelete {
-	mutation := newKeyEventMutation(c.config, OpDelete)
-	return &KeyEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Persona.
+func (c *PersonaClient) Delete() *PersonaDelete {
+	mutation := newPersonaMutation(c.config, OpDelete)
+	return &PersonaDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *KeyEventClient) DeleteOne(_m *KeyEvent) *KeyEventDeleteOne {
+func (c *PersonaClient) DeleteOne(_m *Persona) *PersonaDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *KeyEventClient) DeleteOneID(id int) *KeyEventDeleteOne {
-	builder := c.Delete().Where(keyevent.ID(id))
+func (c *PersonaClient) DeleteOneID(id int) *PersonaDeleteOne {
+	builder := c.Delete().Where(persona.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &KeyEventDeleteOne{builder}
+	return &PersonaDeleteOne{builder}
 }
 
-// Query returns a query builder for KeyEvent.
-func (c *KeyEventClient) Query() *KeyEventQuery {
-	return &KeyEventQuery{
+// Query returns a query builder for Persona.
+func (c *PersonaClient) Query() *PersonaQuery {
+	return &PersonaQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeKeyEvent},
+		ctx:    &QueryContext{Type: TypePersona},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a KeyEvent entity by its id.
-func (c *KeyEventClient) Get(ctx context.Context, id int) (*KeyEvent, error) {
-	return c.Query().Where(keyevent.ID(id)).Only(ctx)
+// Get returns a Persona entity by its id.
+func (c *PersonaClient) Get(ctx context.Context, id int) (*Persona, error) {
+	return c.Query().Where(persona.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *KeyEventClient) GetX(ctx context.Context, id int) *KeyEvent {
+func (c *PersonaClient) GetX(ctx context.Context, id int) *Persona {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1007,15 +2544,31 @@ func (c *KeyEventClient) GetX(ctx context.Context, id int) *KeyEvent {
 	return obj
 }
 
-// QueryDomainReport queries the domain_report edge of a KeyEvent.
-func (c *KeyEventClient) QueryDomainReport(_m *KeyEvent) *DomainReportQuery {
-	query := (&DomainReportClient{config: c.config}).Query()
+// QueryUser queries the user edge of a Persona.
+func (c *PersonaClient) QueryUser(_m *Persona) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(keyevent.Table, keyevent.FieldID, id),
-			sqlgraph.To(domainreport.Table, domainreport.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, keyevent.DomainReportTable, keyevent.DomainReportColumn),
+			sqlgraph.From(persona.Table, persona.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, persona.UserTable, persona.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDeepAnalysisResults queries the deep_analysis_results edge of a Persona.
+func (c *PersonaClient) QueryDeepAnalysisResults(_m *Persona) *DeepAnalysisResultQuery {
+	query := (&DeepAnalysisResultClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(persona.Table, persona.FieldID, id),
+			sqlgraph.To(deepanalysisresult.Table, deepanalysisresult.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, persona.DeepAnalysisResultsTable, persona.DeepAnalysisResultsColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1024,27 +2577,27 @@ func (c *KeyEventClient) QueryDomainReport(_m *KeyEvent) *DomainReportQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *KeyEventClient) Hooks() []Hook {
-	return c.hooks.KeyEvent
+func (c *PersonaClient) Hooks() []Hook {
+	return c.hooks.Persona
 }
 
 // Interceptors returns the client interceptors.
-func (c *KeyEventClient) Interceptors() []Interceptor {
-	return c.inters.KeyEvent
+func (c *PersonaClient) Interceptors() []Interceptor {
+	return c.inters.Persona
 }
 
-func (c *KeyEventClient) mutate(ctx context.Context, m *KeyEventMutation) (Value, error) {
+func (c *PersonaClient) mutate(ctx context.Context, m *PersonaMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&KeyEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PersonaCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&KeyEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PersonaUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&KeyEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PersonaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&KeyEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&PersonaDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown KeyEvent mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Persona mutation op: %q", m.Op())
 	}
 }
 
@@ -1164,7 +2717,23 @@ func (c *ReportRunClient) QueryDomainReports(_m *ReportRun) *DomainReportQuery {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(reportrun.Table, reportrun.FieldID, id),
 			sqlgraph.To(domainreport.Table, domainreport.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, reportrun.DomainReportsTable, reportrun.DomainReportsColumn),
+			sqlgraph.Edge(sqlgraph.M2M, false, reportrun.DomainReportsTable, reportrun.DomainReportsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryGeneratedDomainReports queries the generated_domain_reports edge of a ReportRun.
+func (c *ReportRunClient) QueryGeneratedDomainReports(_m *ReportRun) *DomainReportQuery {
+	query := (&DomainReportClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(reportrun.Table, reportrun.FieldID, id),
+			sqlgraph.To(domainreport.Table, domainreport.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, reportrun.GeneratedDomainReportsTable, reportrun.GeneratedDomainReportsColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1188,6 +2757,54 @@ func (c *ReportRunClient) QueryDeepAnalysisResults(_m *ReportRun) *DeepAnalysisR
 	return query
 }
 
+// QueryLlmCalls queries the llm_calls edge of a ReportRun.
+func (c *ReportRunClient) QueryLlmCalls(_m *ReportRun) *LLMCallQuery {
+	query := (&LLMCallClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(reportrun.Table, reportrun.FieldID, id),
+			sqlgraph.To(llmcall.Table, llmcall.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, reportrun.LlmCallsTable, reportrun.LlmCallsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDomainRuns queries the domain_runs edge of a ReportRun.
+func (c *ReportRunClient) QueryDomainRuns(_m *ReportRun) *DomainRunQuery {
+	query := (&DomainRunClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(reportrun.Table, reportrun.FieldID, id),
+			sqlgraph.To(domainrun.Table, domainrun.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, reportrun.DomainRunsTable, reportrun.DomainRunsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryEvents queries the events edge of a ReportRun.
+func (c *ReportRunClient) QueryEvents(_m *ReportRun) *ReportRunEventQuery {
+	query := (&ReportRunEventClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(reportrun.Table, reportrun.FieldID, id),
+			sqlgraph.To(reportrunevent.Table, reportrunevent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, reportrun.EventsTable, reportrun.EventsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *ReportRunClient) Hooks() []Hook {
 	return c.hooks.ReportRun
@@ -1213,6 +2830,155 @@ func (c *ReportRunClient) mutate(ctx context.Context, m *ReportRunMutation) (Val
 	}
 }
 
+// ReportRunEventClient is a client for the ReportRunEvent schema.
+type ReportRunEventClient struct {
+	config
+}
+
+// NewReportRunEventClient returns a client for the ReportRunEvent from the given config.
+func NewReportRunEventClient(c config) *ReportRunEventClient {
+	return &ReportRunEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `reportrunevent.Hooks(f(g(h())))`.
+func (c *ReportRunEventClient) Use(hooks ...Hook) {
+	c.hooks.ReportRunEvent = append(c.hooks.ReportRunEvent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `reportrunevent.Intercept(f(g(h())))`.
+func (c *ReportRunEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ReportRunEvent = append(c.inters.ReportRunEvent, interceptors...)
+}
+
+// Create returns a builder for creating a ReportRunEvent entity.
+func (c *ReportRunEventClient) Create() *ReportRunEventCreate {
+	mutation := newReportRunEventMutation(c.config, OpCreate)
+	return &ReportRunEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ReportRunEvent entities.
+func (c *ReportRunEventClient) CreateBulk(builders ...*ReportRunEventCreate) *ReportRunEventCreateBulk {
+	return &ReportRunEventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ReportRunEventClient) MapCreateBulk(slice any, setFunc func(*ReportRunEventCreate, int)) *ReportRunEventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ReportRunEventCreateBulk{err: fmt.Errorf("calling to ReportRunEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ReportRunEventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ReportRunEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ReportRunEvent.
+func (c *ReportRunEventClient) Update() *ReportRunEventUpdate {
+	mutation := newReportRunEventMutation(c.config, OpUpdate)
+	return &ReportRunEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ReportRunEventClient) UpdateOne(_m *ReportRunEvent) *ReportRunEventUpdateOne {
+	mutation := newReportRunEventMutation(c.config, OpUpdateOne, withReportRunEvent(_m))
+	return &ReportRunEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ReportRunEventClient) UpdateOneID(id int) *ReportRunEventUpdateOne {
+	mutation := newReportRunEventMutation(c.config, OpUpdateOne, withReportRunEventID(id))
+	return &ReportRunEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ReportRunEvent.
+func (c *ReportRunEventClient) Delete() *ReportRunEventDelete {
+	mutation := newReportRunEventMutation(c.config, OpDelete)
+	return &ReportRunEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ReportRunEventClient) DeleteOne(_m *ReportRunEvent) *ReportRunEventDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ReportRunEventClient) DeleteOneID(id int) *ReportRunEventDeleteOne {
+	builder := c.Delete().Where(reportrunevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ReportRunEventDeleteOne{builder}
+}
+
+// Query returns a query builder for ReportRunEvent.
+func (c *ReportRunEventClient) Query() *ReportRunEventQuery {
+	return &ReportRunEventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeReportRunEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ReportRunEvent entity by its id.
+func (c *ReportRunEventClient) Get(ctx context.Context, id int) (*ReportRunEvent, error) {
+	return c.Query().Where(reportrunevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ReportRunEventClient) GetX(ctx context.Context, id int) *ReportRunEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryReportRun queries the report_run edge of a ReportRunEvent.
+func (c *ReportRunEventClient) QueryReportRun(_m *ReportRunEvent) *ReportRunQuery {
+	query := (&ReportRunClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(reportrunevent.Table, reportrunevent.FieldID, id),
+			sqlgraph.To(reportrun.Table, reportrun.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, reportrunevent.ReportRunTable, reportrunevent.ReportRunColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ReportRunEventClient) Hooks() []Hook {
+	return c.hooks.ReportRunEvent
+}
+
+// Interceptors returns the client interceptors.
+func (c *ReportRunEventClient) Interceptors() []Interceptor {
+	return c.inters.ReportRunEvent
+}
+
+func (c *ReportRunEventClient) mutate(ctx context.Context, m *ReportRunEventMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ReportRunEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ReportRunEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ReportRunEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ReportRunEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ReportRunEvent mutation op: %q", m.Op())
+	}
+}
+
 // UserClient is a client for the User schema.
 type UserClient struct {
 	config
@@ -1321,6 +3087,38 @@ func (c *UserClient) GetX(ctx context.Context, id int) *User {
 	return obj
 }
 
+// QueryPersonas queries the personas edge of a User.
+func (c *UserClient) QueryPersonas(_m *User) *PersonaQuery {
+	query := (&PersonaClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(persona.Table, persona.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.PersonasTable, user.PersonasColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAnalysisLenses queries the analysis_lenses edge of a User.
+func (c *UserClient) QueryAnalysisLenses(_m *User) *AnalysisLensQuery {
+	query := (&AnalysisLensClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(analysislens.Table, analysislens.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.AnalysisLensesTable, user.AnalysisLensesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *UserClient) Hooks() []Hook {
 	return c.hooks.User
@@ -1349,11 +3147,15 @@ func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error)
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		ActionGuide, Article, DeepAnalysisResult, DomainReport, KeyEvent, ReportRun,
+		ActionGuide, AnalysisLens, AnalysisSection, Article, ArticleEntity,
+		ClaimVerification, DeepAnalysisResult, DomainReport, DomainRun, Entity,
+		KeyEvent, LLMCache, LLMCall, Persona, ReportRun, ReportRunEvent,
 		User []ent.Hook
 	}
 	inters struct {
-		ActionGuide, Article, DeepAnalysisResult, DomainReport, KeyEvent, ReportRun,
+		ActionGuide, AnalysisLens, AnalysisSection, Article, ArticleEntity,
+		ClaimVerification, DeepAnalysisResult, DomainReport, DomainRun, Entity,
+		KeyEvent, LLMCache, LLMCall, Persona, ReportRun, ReportRunEvent,
 		User []ent.Interceptor
 	}
 )
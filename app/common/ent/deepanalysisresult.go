@@ -10,6 +10,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/iWorld-y/domain_radar/app/common/ent/deepanalysisresult"
+	"github.com/iWorld-y/domain_radar/app/common/ent/persona"
 	"github.com/iWorld-y/domain_radar/app/common/ent/reportrun"
 )
 
@@ -22,6 +23,10 @@ type DeepAnalysisResult struct {
 	RunID int `json:"run_id,omitempty"`
 	// UserID holds the value of the "user_id" field.
 	UserID int `json:"user_id,omitempty"`
+	// Persona version the analysis was written for, empty for the free-text persona
+	PersonaID int `json:"persona_id,omitempty"`
+	// Name of the persona at the time of the run
+	PersonaName string `json:"persona_name,omitempty"`
 	// MacroTrends holds the value of the "macro_trends" field.
 	MacroTrends string `json:"macro_trends,omitempty"`
 	// Opportunities holds the value of the "opportunities" field.
@@ -42,9 +47,13 @@ type DeepAnalysisResultEdges struct {
 	ReportRun *ReportRun `json:"report_run,omitempty"`
 	// ActionGuides holds the value of the action_guides edge.
 	ActionGuides []*ActionGuide `json:"action_guides,omitempty"`
+	// Sections holds the value of the sections edge.
+	Sections []*AnalysisSection `json:"sections,omitempty"`
+	// Persona holds the value of the persona edge.
+	Persona *Persona `json:"persona,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [4]bool
 }
 
 // ReportRunOrErr returns the ReportRun value or an error if the edge
@@ -67,14 +76,34 @@ func (e DeepAnalysisResultEdges) ActionGuidesOrErr() ([]*ActionGuide, error) {
 	return nil, &NotLoadedError{edge: "action_guides"}
 }
 
+// SectionsOrErr returns the Sections value or an error if the edge
+// was not loaded in eager-loading.
+func (e DeepAnalysisResultEdges) SectionsOrErr() ([]*AnalysisSection, error) {
+	if e.loadedTypes[2] {
+		return e.Sections, nil
+	}
+	return nil, &NotLoadedError{edge: "sections"}
+}
+
+// PersonaOrErr returns the Persona value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DeepAnalysisResultEdges) PersonaOrErr() (*Persona, error) {
+	if e.Persona != nil {
+		return e.Persona, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: persona.Label}
+	}
+	return nil, &NotLoadedError{edge: "persona"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*DeepAnalysisResult) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case deepanalysisresult.FieldID, deepanalysisresult.FieldRunID, deepanalysisresult.FieldUserID:
+		case deepanalysisresult.FieldID, deepanalysisresult.FieldRunID, deepanalysisresult.FieldUserID, deepanalysisresult.FieldPersonaID:
 			values[i] = new(sql.NullInt64)
-		case deepanalysisresult.FieldMacroTrends, deepanalysisresult.FieldOpportunities, deepanalysisresult.FieldRisks:
+		case deepanalysisresult.FieldPersonaName, deepanalysisresult.FieldMacroTrends, deepanalysisresult.FieldOpportunities, deepanalysisresult.FieldRisks:
 			values[i] = new(sql.NullString)
 		case deepanalysisresult.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
@@ -111,6 +140,18 @@ func (_m *DeepAnalysisResult) assignValues(columns []string, values []any) error
 			} else if value.Valid {
 				_m.UserID = int(value.Int64)
 			}
+		case deepanalysisresult.FieldPersonaID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field persona_id", values[i])
+			} else if value.Valid {
+				_m.PersonaID = int(value.Int64)
+			}
+		case deepanalysisresult.FieldPersonaName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field persona_name", values[i])
+			} else if value.Valid {
+				_m.PersonaName = value.String
+			}
 		case deepanalysisresult.FieldMacroTrends:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field macro_trends", values[i])
@@ -158,6 +199,16 @@ func (_m *DeepAnalysisResult) QueryActionGuides() *ActionGuideQuery {
 	return NewDeepAnalysisResultClient(_m.config).QueryActionGuides(_m)
 }
 
+// QuerySections queries the "sections" edge of the DeepAnalysisResult entity.
+func (_m *DeepAnalysisResult) QuerySections() *AnalysisSectionQuery {
+	return NewDeepAnalysisResultClient(_m.config).QuerySections(_m)
+}
+
+// QueryPersona queries the "persona" edge of the DeepAnalysisResult entity.
+func (_m *DeepAnalysisResult) QueryPersona() *PersonaQuery {
+	return NewDeepAnalysisResultClient(_m.config).QueryPersona(_m)
+}
+
 // Update returns a builder for updating this DeepAnalysisResult.
 // Note that you need to call DeepAnalysisResult.Unwrap() before calling this method if this DeepAnalysisResult
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -187,6 +238,12 @@ func (_m *DeepAnalysisResult) String() string {
 	builder.WriteString("user_id=")
 	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
 	builder.WriteString(", ")
+	builder.WriteString("persona_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PersonaID))
+	builder.WriteString(", ")
+	builder.WriteString("persona_name=")
+	builder.WriteString(_m.PersonaName)
+	builder.WriteString(", ")
 	builder.WriteString("macro_trends=")
 	builder.WriteString(_m.MacroTrends)
 	builder.WriteString(", ")
@@ -18,6 +18,10 @@ const (
 	FieldRunID = "run_id"
 	// FieldUserID holds the string denoting the user_id field in the database.
 	FieldUserID = "user_id"
+	// FieldPersonaID holds the string denoting the persona_id field in the database.
+	FieldPersonaID = "persona_id"
+	// FieldPersonaName holds the string denoting the persona_name field in the database.
+	FieldPersonaName = "persona_name"
 	// FieldMacroTrends holds the string denoting the macro_trends field in the database.
 	FieldMacroTrends = "macro_trends"
 	// FieldOpportunities holds the string denoting the opportunities field in the database.
@@ -30,6 +34,10 @@ const (
 	EdgeReportRun = "report_run"
 	// EdgeActionGuides holds the string denoting the action_guides edge name in mutations.
 	EdgeActionGuides = "action_guides"
+	// EdgeSections holds the string denoting the sections edge name in mutations.
+	EdgeSections = "sections"
+	// EdgePersona holds the string denoting the persona edge name in mutations.
+	EdgePersona = "persona"
 	// Table holds the table name of the deepanalysisresult in the database.
 	Table = "deep_analysis_results"
 	// ReportRunTable is the table that holds the report_run relation/edge.
@@ -46,6 +54,20 @@ const (
 	ActionGuidesInverseTable = "action_guides"
 	// ActionGuidesColumn is the table column denoting the action_guides relation/edge.
 	ActionGuidesColumn = "deep_analysis_id"
+	// SectionsTable is the table that holds the sections relation/edge.
+	SectionsTable = "analysis_sections"
+	// SectionsInverseTable is the table name for the AnalysisSection entity.
+	// It exists in this package in order to avoid circular dependency with the "analysissection" package.
+	SectionsInverseTable = "analysis_sections"
+	// SectionsColumn is the table column denoting the sections relation/edge.
+	SectionsColumn = "deep_analysis_id"
+	// PersonaTable is the table that holds the persona relation/edge.
+	PersonaTable = "deep_analysis_results"
+	// PersonaInverseTable is the table name for the Persona entity.
+	// It exists in this package in order to avoid circular dependency with the "persona" package.
+	PersonaInverseTable = "personas"
+	// PersonaColumn is the table column denoting the persona relation/edge.
+	PersonaColumn = "persona_id"
 )
 
 // Columns holds all SQL columns for deepanalysisresult fields.
@@ -53,6 +75,8 @@ var Columns = []string{
 	FieldID,
 	FieldRunID,
 	FieldUserID,
+	FieldPersonaID,
+	FieldPersonaName,
 	FieldMacroTrends,
 	FieldOpportunities,
 	FieldRisks,
@@ -92,6 +116,16 @@ func ByUserID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUserID, opts...).ToFunc()
 }
 
+// ByPersonaID orders the results by the persona_id field.
+func ByPersonaID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPersonaID, opts...).ToFunc()
+}
+
+// ByPersonaName orders the results by the persona_name field.
+func ByPersonaName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPersonaName, opts...).ToFunc()
+}
+
 // ByMacroTrends orders the results by the macro_trends field.
 func ByMacroTrends(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldMacroTrends, opts...).ToFunc()
@@ -132,6 +166,27 @@ func ByActionGuides(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newActionGuidesStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// BySectionsCount orders the results by sections count.
+func BySectionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSectionsStep(), opts...)
+	}
+}
+
+// BySections orders the results by sections terms.
+func BySections(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSectionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByPersonaField orders the results by persona field.
+func ByPersonaField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPersonaStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newReportRunStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -146,3 +201,17 @@ func newActionGuidesStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, ActionGuidesTable, ActionGuidesColumn),
 	)
 }
+func newSectionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SectionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SectionsTable, SectionsColumn),
+	)
+}
+func newPersonaStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PersonaInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, PersonaTable, PersonaColumn),
+	)
+}
@@ -65,6 +65,16 @@ func UserID(v int) predicate.DeepAnalysisResult {
 	return predicate.DeepAnalysisResult(sql.FieldEQ(FieldUserID, v))
 }
 
+// PersonaID applies equality check predicate on the "persona_id" field. It's identical to PersonaIDEQ.
+func PersonaID(v int) predicate.DeepAnalysisResult {
+	return predicate.DeepAnalysisResult(sql.FieldEQ(FieldPersonaID, v))
+}
+
+// PersonaName applies equality check predicate on the "persona_name" field. It's identical to PersonaNameEQ.
+func PersonaName(v string) predicate.DeepAnalysisResult {
+	return predicate.DeepAnalysisResult(sql.FieldEQ(FieldPersonaName, v))
+}
+
 // MacroTrends applies equality check predicate on the "macro_trends" field. It's identical to MacroTrendsEQ.
 func MacroTrends(v string) predicate.DeepAnalysisResult {
 	return predicate.DeepAnalysisResult(sql.FieldEQ(FieldMacroTrends, v))
@@ -165,6 +175,111 @@ func UserIDNotNil() predicate.DeepAnalysisResult {
 	return predicate.DeepAnalysisResult(sql.FieldNotNull(FieldUserID))
 }
 
+// PersonaIDEQ applies the EQ predicate on the "persona_id" field.
+func PersonaIDEQ(v int) predicate.DeepAnalysisResult {
+	return predicate.DeepAnalysisResult(sql.FieldEQ(FieldPersonaID, v))
+}
+
+// PersonaIDNEQ applies the NEQ predicate on the "persona_id" field.
+func PersonaIDNEQ(v int) predicate.DeepAnalysisResult {
+	return predicate.DeepAnalysisResult(sql.FieldNEQ(FieldPersonaID, v))
+}
+
+// PersonaIDIn applies the In predicate on the "persona_id" field.
+func PersonaIDIn(vs ...int) predicate.DeepAnalysisResult {
+	return predicate.DeepAnalysisResult(sql.FieldIn(FieldPersonaID, vs...))
+}
+
+// PersonaIDNotIn applies the NotIn predicate on the "persona_id" field.
+func PersonaIDNotIn(vs ...int) predicate.DeepAnalysisResult {
+	return predicate.DeepAnalysisResult(sql.FieldNotIn(FieldPersonaID, vs...))
+}
+
+// PersonaIDIsNil applies the IsNil predicate on the "persona_id" field.
+func PersonaIDIsNil() predicate.DeepAnalysisResult {
+	return predicate.DeepAnalysisResult(sql.FieldIsNull(FieldPersonaID))
+}
+
+// PersonaIDNotNil applies the NotNil predicate on the "persona_id" field.
+func PersonaIDNotNil() predicate.DeepAnalysisResult {
+	return predicate.DeepAnalysisResult(sql.FieldNotNull(FieldPersonaID))
+}
+
+// PersonaNameEQ applies the EQ predicate on the "persona_name" field.
+func PersonaNameEQ(v string) predicate.DeepAnalysisResult {
+	return predicate.DeepAnalysisResult(sql.FieldEQ(FieldPersonaName, v))
+}
+
+// PersonaNameNEQ applies the NEQ predicate on the "persona_name" field.
+func PersonaNameNEQ(v string) predicate.DeepAnalysisResult {
+	return predicate.DeepAnalysisResult(sql.FieldNEQ(FieldPersonaName, v))
+}
+
+// PersonaNameIn applies the In predicate on the "persona_name" field.
+func PersonaNameIn(vs ...string) predicate.DeepAnalysisResult {
+	return predicate.DeepAnalysisResult(sql.FieldIn(FieldPersonaName, vs...))
+}
+
+// PersonaNameNotIn applies the NotIn predicate on the "persona_name" field.
+func PersonaNameNotIn(vs ...string) predicate.DeepAnalysisResult {
+	return predicate.DeepAnalysisResult(sql.FieldNotIn(FieldPersonaName, vs...))
+}
+
+// PersonaNameGT applies the GT predicate on the "persona_name" field.
+func PersonaNameGT(v string) predicate.DeepAnalysisResult {
+	return predicate.DeepAnalysisResult(sql.FieldGT(FieldPersonaName, v))
+}
+
+// PersonaNameGTE applies the GTE predicate on the "persona_name" field.
+func PersonaNameGTE(v string) predicate.DeepAnalysisResult {
+	return predicate.DeepAnalysisResult(sql.FieldGTE(FieldPersonaName, v))
+}
+
+// PersonaNameLT applies the LT predicate on the "persona_name" field.
+func PersonaNameLT(v string) predicate.DeepAnalysisResult {
+	return predicate.DeepAnalysisResult(sql.FieldLT(FieldPersonaName, v))
+}
+
+// PersonaNameLTE applies the LTE predicate on the "persona_name" field.
+func PersonaNameLTE(v string) predicate.DeepAnalysisResult {
+	return predicate.DeepAnalysisResult(sql.FieldLTE(FieldPersonaName, v))
+}
+
+// PersonaNameContains applies the Contains predicate on the "persona_name" field.
+func PersonaNameContains(v string) predicate.DeepAnalysisResult {
+	return predicate.DeepAnalysisResult(sql.FieldContains(FieldPersonaName, v))
+}
+
+// PersonaNameHasPrefix applies the HasPrefix predicate on the "persona_name" field.
+func PersonaNameHasPrefix(v string) predicate.DeepAnalysisResult {
+	return predicate.DeepAnalysisResult(sql.FieldHasPrefix(FieldPersonaName, v))
+}
+
+// PersonaNameHasSuffix applies the HasSuffix predicate on the "persona_name" field.
+func PersonaNameHasSuffix(v string) predicate.DeepAnalysisResult {
+	return predicate.DeepAnalysisResult(sql.FieldHasSuffix(FieldPersonaName, v))
+}
+
+// PersonaNameIsNil applies the IsNil predicate on the "persona_name" field.
+func PersonaNameIsNil() predicate.DeepAnalysisResult {
+	return predicate.DeepAnalysisResult(sql.FieldIsNull(FieldPersonaName))
+}
+
+// PersonaNameNotNil applies the NotNil predicate on the "persona_name" field.
+func PersonaNameNotNil() predicate.DeepAnalysisResult {
+	return predicate.DeepAnalysisResult(sql.FieldNotNull(FieldPersonaName))
+}
+
+// PersonaNameEqualFold applies the EqualFold predicate on the "persona_name" field.
+func PersonaNameEqualFold(v string) predicate.DeepAnalysisResult {
+	return predicate.DeepAnalysisResult(sql.FieldEqualFold(FieldPersonaName, v))
+}
+
+// PersonaNameContainsFold applies the ContainsFold predicate on the "persona_name" field.
+func PersonaNameContainsFold(v string) predicate.DeepAnalysisResult {
+	return predicate.DeepAnalysisResult(sql.FieldContainsFold(FieldPersonaName, v))
+}
+
 // MacroTrendsEQ applies the EQ predicate on the "macro_trends" field.
 func MacroTrendsEQ(v string) predicate.DeepAnalysisResult {
 	return predicate.DeepAnalysisResult(sql.FieldEQ(FieldMacroTrends, v))
@@ -476,6 +591,52 @@ func HasActionGuidesWith(preds ...predicate.ActionGuide) predicate.DeepAnalysisR
 	})
 }
 
+// HasSections applies the HasEdge predicate on the "sections" edge.
+func HasSections() predicate.DeepAnalysisResult {
+	return predicate.DeepAnalysisResult(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SectionsTable, SectionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSectionsWith applies the HasEdge predicate on the "sections" edge with a given conditions (other predicates).
+func HasSectionsWith(preds ...predicate.AnalysisSection) predicate.DeepAnalysisResult {
+	return predicate.DeepAnalysisResult(func(s *sql.Selector) {
+		step := newSectionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPersona applies the HasEdge predicate on the "persona" edge.
+func HasPersona() predicate.DeepAnalysisResult {
+	return predicate.DeepAnalysisResult(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PersonaTable, PersonaColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPersonaWith applies the HasEdge predicate on the "persona" edge with a given conditions (other predicates).
+func HasPersonaWith(preds ...predicate.Persona) predicate.DeepAnalysisResult {
+	return predicate.DeepAnalysisResult(func(s *sql.Selector) {
+		step := newPersonaStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.DeepAnalysisResult) predicate.DeepAnalysisResult {
 	return predicate.DeepAnalysisResult(sql.AndPredicates(predicates...))
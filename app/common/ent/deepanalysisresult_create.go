@@ -8,10 +8,13 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/iWorld-y/domain_radar/app/common/ent/actionguide"
+	"github.com/iWorld-y/domain_radar/app/common/ent/analysissection"
 	"github.com/iWorld-y/domain_radar/app/common/ent/deepanalysisresult"
+	"github.com/iWorld-y/domain_radar/app/common/ent/persona"
 	"github.com/iWorld-y/domain_radar/app/common/ent/reportrun"
 )
 
@@ -20,6 +23,7 @@ type DeepAnalysisResultCreate struct {
 	config
 	mutation *DeepAnalysisResultMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetRunID sets the "run_id" field.
@@ -50,6 +54,34 @@ func (_c *DeepAnalysisResultCreate) SetNillableUserID(v *int) *DeepAnalysisResul
 	return _c
 }
 
+// SetPersonaID sets the "persona_id" field.
+func (_c *DeepAnalysisResultCreate) SetPersonaID(v int) *DeepAnalysisResultCreate {
+	_c.mutation.SetPersonaID(v)
+	return _c
+}
+
+// SetNillablePersonaID sets the "persona_id" field if the given value is not nil.
+func (_c *DeepAnalysisResultCreate) SetNillablePersonaID(v *int) *DeepAnalysisResultCreate {
+	if v != nil {
+		_c.SetPersonaID(*v)
+	}
+	return _c
+}
+
+// SetPersonaName sets the "persona_name" field.
+func (_c *DeepAnalysisResultCreate) SetPersonaName(v string) *DeepAnalysisResultCreate {
+	_c.mutation.SetPersonaName(v)
+	return _c
+}
+
+// SetNillablePersonaName sets the "persona_name" field if the given value is not nil.
+func (_c *DeepAnalysisResultCreate) SetNillablePersonaName(v *string) *DeepAnalysisResultCreate {
+	if v != nil {
+		_c.SetPersonaName(*v)
+	}
+	return _c
+}
+
 // SetMacroTrends sets the "macro_trends" field.
 func (_c *DeepAnalysisResultCreate) SetMacroTrends(v string) *DeepAnalysisResultCreate {
 	_c.mutation.SetMacroTrends(v)
@@ -146,6 +178,26 @@ func (_c *DeepAnalysisResultCreate) AddActionGuides(v ...*ActionGuide) *DeepAnal
 	return _c.AddActionGuideIDs(ids...)
 }
 
+// AddSectionIDs adds the "sections" edge to the AnalysisSection entity by IDs.
+func (_c *DeepAnalysisResultCreate) AddSectionIDs(ids ...int) *DeepAnalysisResultCreate {
+	_c.mutation.AddSectionIDs(ids...)
+	return _c
+}
+
+// AddSections adds the "sections" edges to the AnalysisSection entity.
+func (_c *DeepAnalysisResultCreate) AddSections(v ...*AnalysisSection) *DeepAnalysisResultCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddSectionIDs(ids...)
+}
+
+// SetPersona sets the "persona" edge to the Persona entity.
+func (_c *DeepAnalysisResultCreate) SetPersona(v *Persona) *DeepAnalysisResultCreate {
+	return _c.SetPersonaID(v.ID)
+}
+
 // Mutation returns the DeepAnalysisResultMutation object of the builder.
 func (_c *DeepAnalysisResultCreate) Mutation() *DeepAnalysisResultMutation {
 	return _c.mutation
@@ -220,6 +272,7 @@ func (_c *DeepAnalysisResultCreate) createSpec() (*DeepAnalysisResult, *sqlgraph
 		_node = &DeepAnalysisResult{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(deepanalysisresult.Table, sqlgraph.NewFieldSpec(deepanalysisresult.FieldID, field.TypeInt))
 	)
+	_spec.OnConflict = _c.conflict
 	if id, ok := _c.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = id
@@ -228,6 +281,10 @@ func (_c *DeepAnalysisResultCreate) createSpec() (*DeepAnalysisResult, *sqlgraph
 		_spec.SetField(deepanalysisresult.FieldUserID, field.TypeInt, value)
 		_node.UserID = value
 	}
+	if value, ok := _c.mutation.PersonaName(); ok {
+		_spec.SetField(deepanalysisresult.FieldPersonaName, field.TypeString, value)
+		_node.PersonaName = value
+	}
 	if value, ok := _c.mutation.MacroTrends(); ok {
 		_spec.SetField(deepanalysisresult.FieldMacroTrends, field.TypeString, value)
 		_node.MacroTrends = value
@@ -277,14 +334,490 @@ func (_c *DeepAnalysisResultCreate) createSpec() (*DeepAnalysisResult, *sqlgraph
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.SectionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   deepanalysisresult.SectionsTable,
+			Columns: []string{deepanalysisresult.SectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(analysissection.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PersonaIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   deepanalysisresult.PersonaTable,
+			Columns: []string{deepanalysisresult.PersonaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(persona.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PersonaID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DeepAnalysisResult.Create().
+//		SetRunID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DeepAnalysisResultUpsert) {
+//			SetRunID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DeepAnalysisResultCreate) OnConflict(opts ...sql.ConflictOption) *DeepAnalysisResultUpsertOne {
+	_c.conflict = opts
+	return &DeepAnalysisResultUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DeepAnalysisResult.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DeepAnalysisResultCreate) OnConflictColumns(columns ...string) *DeepAnalysisResultUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DeepAnalysisResultUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// DeepAnalysisResultUpsertOne is the builder for "upsert"-ing
+	//  one DeepAnalysisResult node.
+	DeepAnalysisResultUpsertOne struct {
+		create *DeepAnalysisResultCreate
+	}
+
+	// DeepAnalysisResultUpsert is the "OnConflict" setter.
+	DeepAnalysisResultUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetRunID sets the "run_id" field.
+func (u *DeepAnalysisResultUpsert) SetRunID(v int) *DeepAnalysisResultUpsert {
+	u.Set(deepanalysisresult.FieldRunID, v)
+	return u
+}
+
+// UpdateRunID sets the "run_id" field to the value that was provided on create.
+func (u *DeepAnalysisResultUpsert) UpdateRunID() *DeepAnalysisResultUpsert {
+	u.SetExcluded(deepanalysisresult.FieldRunID)
+	return u
+}
+
+// ClearRunID clears the value of the "run_id" field.
+func (u *DeepAnalysisResultUpsert) ClearRunID() *DeepAnalysisResultUpsert {
+	u.SetNull(deepanalysisresult.FieldRunID)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *DeepAnalysisResultUpsert) SetUserID(v int) *DeepAnalysisResultUpsert {
+	u.Set(deepanalysisresult.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *DeepAnalysisResultUpsert) UpdateUserID() *DeepAnalysisResultUpsert {
+	u.SetExcluded(deepanalysisresult.FieldUserID)
+	return u
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *DeepAnalysisResultUpsert) AddUserID(v int) *DeepAnalysisResultUpsert {
+	u.Add(deepanalysisresult.FieldUserID, v)
+	return u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (u *DeepAnalysisResultUpsert) ClearUserID() *DeepAnalysisResultUpsert {
+	u.SetNull(deepanalysisresult.FieldUserID)
+	return u
+}
+
+// SetPersonaID sets the "persona_id" field.
+func (u *DeepAnalysisResultUpsert) SetPersonaID(v int) *DeepAnalysisResultUpsert {
+	u.Set(deepanalysisresult.FieldPersonaID, v)
+	return u
+}
+
+// UpdatePersonaID sets the "persona_id" field to the value that was provided on create.
+func (u *DeepAnalysisResultUpsert) UpdatePersonaID() *DeepAnalysisResultUpsert {
+	u.SetExcluded(deepanalysisresult.FieldPersonaID)
+	return u
+}
+
+// ClearPersonaID clears the value of the "persona_id" field.
+func (u *DeepAnalysisResultUpsert) ClearPersonaID() *DeepAnalysisResultUpsert {
+	u.SetNull(deepanalysisresult.FieldPersonaID)
+	return u
+}
+
+// SetPersonaName sets the "persona_name" field.
+func (u *DeepAnalysisResultUpsert) SetPersonaName(v string) *DeepAnalysisResultUpsert {
+	u.Set(deepanalysisresult.FieldPersonaName, v)
+	return u
+}
+
+// UpdatePersonaName sets the "persona_name" field to the value that was provided on create.
+func (u *DeepAnalysisResultUpsert) UpdatePersonaName() *DeepAnalysisResultUpsert {
+	u.SetExcluded(deepanalysisresult.FieldPersonaName)
+	return u
+}
+
+// ClearPersonaName clears the value of the "persona_name" field.
+func (u *DeepAnalysisResultUpsert) ClearPersonaName() *DeepAnalysisResultUpsert {
+	u.SetNull(deepanalysisresult.FieldPersonaName)
+	return u
+}
+
+// SetMacroTrends sets the "macro_trends" field.
+func (u *DeepAnalysisResultUpsert) SetMacroTrends(v string) *DeepAnalysisResultUpsert {
+	u.Set(deepanalysisresult.FieldMacroTrends, v)
+	return u
+}
+
+// UpdateMacroTrends sets the "macro_trends" field to the value that was provided on create.
+func (u *DeepAnalysisResultUpsert) UpdateMacroTrends() *DeepAnalysisResultUpsert {
+	u.SetExcluded(deepanalysisresult.FieldMacroTrends)
+	return u
+}
+
+// ClearMacroTrends clears the value of the "macro_trends" field.
+func (u *DeepAnalysisResultUpsert) ClearMacroTrends() *DeepAnalysisResultUpsert {
+	u.SetNull(deepanalysisresult.FieldMacroTrends)
+	return u
+}
+
+// SetOpportunities sets the "opportunities" field.
+func (u *DeepAnalysisResultUpsert) SetOpportunities(v string) *DeepAnalysisResultUpsert {
+	u.Set(deepanalysisresult.FieldOpportunities, v)
+	return u
+}
+
+// UpdateOpportunities sets the "opportunities" field to the value that was provided on create.
+func (u *DeepAnalysisResultUpsert) UpdateOpportunities() *DeepAnalysisResultUpsert {
+	u.SetExcluded(deepanalysisresult.FieldOpportunities)
+	return u
+}
+
+// ClearOpportunities clears the value of the "opportunities" field.
+func (u *DeepAnalysisResultUpsert) ClearOpportunities() *DeepAnalysisResultUpsert {
+	u.SetNull(deepanalysisresult.FieldOpportunities)
+	return u
+}
+
+// SetRisks sets the "risks" field.
+func (u *DeepAnalysisResultUpsert) SetRisks(v string) *DeepAnalysisResultUpsert {
+	u.Set(deepanalysisresult.FieldRisks, v)
+	return u
+}
+
+// UpdateRisks sets the "risks" field to the value that was provided on create.
+func (u *DeepAnalysisResultUpsert) UpdateRisks() *DeepAnalysisResultUpsert {
+	u.SetExcluded(deepanalysisresult.FieldRisks)
+	return u
+}
+
+// ClearRisks clears the value of the "risks" field.
+func (u *DeepAnalysisResultUpsert) ClearRisks() *DeepAnalysisResultUpsert {
+	u.SetNull(deepanalysisresult.FieldRisks)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *DeepAnalysisResultUpsert) SetCreatedAt(v time.Time) *DeepAnalysisResultUpsert {
+	u.Set(deepanalysisresult.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *DeepAnalysisResultUpsert) UpdateCreatedAt() *DeepAnalysisResultUpsert {
+	u.SetExcluded(deepanalysisresult.FieldCreatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.DeepAnalysisResult.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(deepanalysisresult.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DeepAnalysisResultUpsertOne) UpdateNewValues() *DeepAnalysisResultUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(deepanalysisresult.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DeepAnalysisResult.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *DeepAnalysisResultUpsertOne) Ignore() *DeepAnalysisResultUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DeepAnalysisResultUpsertOne) DoNothing() *DeepAnalysisResultUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DeepAnalysisResultCreate.OnConflict
+// documentation for more info.
+func (u *DeepAnalysisResultUpsertOne) Update(set func(*DeepAnalysisResultUpsert)) *DeepAnalysisResultUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DeepAnalysisResultUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetRunID sets the "run_id" field.
+func (u *DeepAnalysisResultUpsertOne) SetRunID(v int) *DeepAnalysisResultUpsertOne {
+	return u.Update(func(s *DeepAnalysisResultUpsert) {
+		s.SetRunID(v)
+	})
+}
+
+// UpdateRunID sets the "run_id" field to the value that was provided on create.
+func (u *DeepAnalysisResultUpsertOne) UpdateRunID() *DeepAnalysisResultUpsertOne {
+	return u.Update(func(s *DeepAnalysisResultUpsert) {
+		s.UpdateRunID()
+	})
+}
+
+// ClearRunID clears the value of the "run_id" field.
+func (u *DeepAnalysisResultUpsertOne) ClearRunID() *DeepAnalysisResultUpsertOne {
+	return u.Update(func(s *DeepAnalysisResultUpsert) {
+		s.ClearRunID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *DeepAnalysisResultUpsertOne) SetUserID(v int) *DeepAnalysisResultUpsertOne {
+	return u.Update(func(s *DeepAnalysisResultUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *DeepAnalysisResultUpsertOne) AddUserID(v int) *DeepAnalysisResultUpsertOne {
+	return u.Update(func(s *DeepAnalysisResultUpsert) {
+		s.AddUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *DeepAnalysisResultUpsertOne) UpdateUserID() *DeepAnalysisResultUpsertOne {
+	return u.Update(func(s *DeepAnalysisResultUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (u *DeepAnalysisResultUpsertOne) ClearUserID() *DeepAnalysisResultUpsertOne {
+	return u.Update(func(s *DeepAnalysisResultUpsert) {
+		s.ClearUserID()
+	})
+}
+
+// SetPersonaID sets the "persona_id" field.
+func (u *DeepAnalysisResultUpsertOne) SetPersonaID(v int) *DeepAnalysisResultUpsertOne {
+	return u.Update(func(s *DeepAnalysisResultUpsert) {
+		s.SetPersonaID(v)
+	})
+}
+
+// UpdatePersonaID sets the "persona_id" field to the value that was provided on create.
+func (u *DeepAnalysisResultUpsertOne) UpdatePersonaID() *DeepAnalysisResultUpsertOne {
+	return u.Update(func(s *DeepAnalysisResultUpsert) {
+		s.UpdatePersonaID()
+	})
+}
+
+// ClearPersonaID clears the value of the "persona_id" field.
+func (u *DeepAnalysisResultUpsertOne) ClearPersonaID() *DeepAnalysisResultUpsertOne {
+	return u.Update(func(s *DeepAnalysisResultUpsert) {
+		s.ClearPersonaID()
+	})
+}
+
+// SetPersonaName sets the "persona_name" field.
+func (u *DeepAnalysisResultUpsertOne) SetPersonaName(v string) *DeepAnalysisResultUpsertOne {
+	return u.Update(func(s *DeepAnalysisResultUpsert) {
+		s.SetPersonaName(v)
+	})
+}
+
+// UpdatePersonaName sets the "persona_name" field to the value that was provided on create.
+func (u *DeepAnalysisResultUpsertOne) UpdatePersonaName() *DeepAnalysisResultUpsertOne {
+	return u.Update(func(s *DeepAnalysisResultUpsert) {
+		s.UpdatePersonaName()
+	})
+}
+
+// ClearPersonaName clears the value of the "persona_name" field.
+func (u *DeepAnalysisResultUpsertOne) ClearPersonaName() *DeepAnalysisResultUpsertOne {
+	return u.Update(func(s *DeepAnalysisResultUpsert) {
+		s.ClearPersonaName()
+	})
+}
+
+// SetMacroTrends sets the "macro_trends" field.
+func (u *DeepAnalysisResultUpsertOne) SetMacroTrends(v string) *DeepAnalysisResultUpsertOne {
+	return u.Update(func(s *DeepAnalysisResultUpsert) {
+		s.SetMacroTrends(v)
+	})
+}
+
+// UpdateMacroTrends sets the "macro_trends" field to the value that was provided on create.
+func (u *DeepAnalysisResultUpsertOne) UpdateMacroTrends() *DeepAnalysisResultUpsertOne {
+	return u.Update(func(s *DeepAnalysisResultUpsert) {
+		s.UpdateMacroTrends()
+	})
+}
+
+// ClearMacroTrends clears the value of the "macro_trends" field.
+func (u *DeepAnalysisResultUpsertOne) ClearMacroTrends() *DeepAnalysisResultUpsertOne {
+	return u.Update(func(s *DeepAnalysisResultUpsert) {
+		s.ClearMacroTrends()
+	})
+}
+
+// SetOpportunities sets the "opportunities" field.
+func (u *DeepAnalysisResultUpsertOne) SetOpportunities(v string) *DeepAnalysisResultUpsertOne {
+	return u.Update(func(s *DeepAnalysisResultUpsert) {
+		s.SetOpportunities(v)
+	})
+}
+
+// UpdateOpportunities sets the "opportunities" field to the value that was provided on create.
+func (u *DeepAnalysisResultUpsertOne) UpdateOpportunities() *DeepAnalysisResultUpsertOne {
+	return u.Update(func(s *DeepAnalysisResultUpsert) {
+		s.UpdateOpportunities()
+	})
+}
+
+// ClearOpportunities clears the value of the "opportunities" field.
+func (u *DeepAnalysisResultUpsertOne) ClearOpportunities() *DeepAnalysisResultUpsertOne {
+	return u.Update(func(s *DeepAnalysisResultUpsert) {
+		s.ClearOpportunities()
+	})
+}
+
+// SetRisks sets the "risks" field.
+func (u *DeepAnalysisResultUpsertOne) SetRisks(v string) *DeepAnalysisResultUpsertOne {
+	return u.Update(func(s *DeepAnalysisResultUpsert) {
+		s.SetRisks(v)
+	})
+}
+
+// UpdateRisks sets the "risks" field to the value that was provided on create.
+func (u *DeepAnalysisResultUpsertOne) UpdateRisks() *DeepAnalysisResultUpsertOne {
+	return u.Update(func(s *DeepAnalysisResultUpsert) {
+		s.UpdateRisks()
+	})
+}
+
+// ClearRisks clears the value of the "risks" field.
+func (u *DeepAnalysisResultUpsertOne) ClearRisks() *DeepAnalysisResultUpsertOne {
+	return u.Update(func(s *DeepAnalysisResultUpsert) {
+		s.ClearRisks()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *DeepAnalysisResultUpsertOne) SetCreatedAt(v time.Time) *DeepAnalysisResultUpsertOne {
+	return u.Update(func(s *DeepAnalysisResultUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *DeepAnalysisResultUpsertOne) UpdateCreatedAt() *DeepAnalysisResultUpsertOne {
+	return u.Update(func(s *DeepAnalysisResultUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *DeepAnalysisResultUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DeepAnalysisResultCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DeepAnalysisResultUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *DeepAnalysisResultUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *DeepAnalysisResultUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // DeepAnalysisResultCreateBulk is the builder for creating many DeepAnalysisResult entities in bulk.
 type DeepAnalysisResultCreateBulk struct {
 	config
 	err      error
 	builders []*DeepAnalysisResultCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the DeepAnalysisResult entities in the database.
@@ -314,6 +847,7 @@ func (_c *DeepAnalysisResultCreateBulk) Save(ctx context.Context) ([]*DeepAnalys
 					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -367,3 +901,285 @@ func (_c *DeepAnalysisResultCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DeepAnalysisResult.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DeepAnalysisResultUpsert) {
+//			SetRunID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DeepAnalysisResultCreateBulk) OnConflict(opts ...sql.ConflictOption) *DeepAnalysisResultUpsertBulk {
+	_c.conflict = opts
+	return &DeepAnalysisResultUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DeepAnalysisResult.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DeepAnalysisResultCreateBulk) OnConflictColumns(columns ...string) *DeepAnalysisResultUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DeepAnalysisResultUpsertBulk{
+		create: _c,
+	}
+}
+
+// DeepAnalysisResultUpsertBulk is the builder for "upsert"-ing
+// a bulk of DeepAnalysisResult nodes.
+type DeepAnalysisResultUpsertBulk struct {
+	create *DeepAnalysisResultCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.DeepAnalysisResult.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(deepanalysisresult.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DeepAnalysisResultUpsertBulk) UpdateNewValues() *DeepAnalysisResultUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(deepanalysisresult.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DeepAnalysisResult.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *DeepAnalysisResultUpsertBulk) Ignore() *DeepAnalysisResultUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DeepAnalysisResultUpsertBulk) DoNothing() *DeepAnalysisResultUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DeepAnalysisResultCreateBulk.OnConflict
+// documentation for more info.
+func (u *DeepAnalysisResultUpsertBulk) Update(set func(*DeepAnalysisResultUpsert)) *DeepAnalysisResultUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DeepAnalysisResultUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetRunID sets the "run_id" field.
+func (u *DeepAnalysisResultUpsertBulk) SetRunID(v int) *DeepAnalysisResultUpsertBulk {
+	return u.Update(func(s *DeepAnalysisResultUpsert) {
+		s.SetRunID(v)
+	})
+}
+
+// UpdateRunID sets the "run_id" field to the value that was provided on create.
+func (u *DeepAnalysisResultUpsertBulk) UpdateRunID() *DeepAnalysisResultUpsertBulk {
+	return u.Update(func(s *DeepAnalysisResultUpsert) {
+		s.UpdateRunID()
+	})
+}
+
+// ClearRunID clears the value of the "run_id" field.
+func (u *DeepAnalysisResultUpsertBulk) ClearRunID() *DeepAnalysisResultUpsertBulk {
+	return u.Update(func(s *DeepAnalysisResultUpsert) {
+		s.ClearRunID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *DeepAnalysisResultUpsertBulk) SetUserID(v int) *DeepAnalysisResultUpsertBulk {
+	return u.Update(func(s *DeepAnalysisResultUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *DeepAnalysisResultUpsertBulk) AddUserID(v int) *DeepAnalysisResultUpsertBulk {
+	return u.Update(func(s *DeepAnalysisResultUpsert) {
+		s.AddUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *DeepAnalysisResultUpsertBulk) UpdateUserID() *DeepAnalysisResultUpsertBulk {
+	return u.Update(func(s *DeepAnalysisResultUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (u *DeepAnalysisResultUpsertBulk) ClearUserID() *DeepAnalysisResultUpsertBulk {
+	return u.Update(func(s *DeepAnalysisResultUpsert) {
+		s.ClearUserID()
+	})
+}
+
+// SetPersonaID sets the "persona_id" field.
+func (u *DeepAnalysisResultUpsertBulk) SetPersonaID(v int) *DeepAnalysisResultUpsertBulk {
+	return u.Update(func(s *DeepAnalysisResultUpsert) {
+		s.SetPersonaID(v)
+	})
+}
+
+// UpdatePersonaID sets the "persona_id" field to the value that was provided on create.
+func (u *DeepAnalysisResultUpsertBulk) UpdatePersonaID() *DeepAnalysisResultUpsertBulk {
+	return u.Update(func(s *DeepAnalysisResultUpsert) {
+		s.UpdatePersonaID()
+	})
+}
+
+// ClearPersonaID clears the value of the "persona_id" field.
+func (u *DeepAnalysisResultUpsertBulk) ClearPersonaID() *DeepAnalysisResultUpsertBulk {
+	return u.Update(func(s *DeepAnalysisResultUpsert) {
+		s.ClearPersonaID()
+	})
+}
+
+// SetPersonaName sets the "persona_name" field.
+func (u *DeepAnalysisResultUpsertBulk) SetPersonaName(v string) *DeepAnalysisResultUpsertBulk {
+	return u.Update(func(s *DeepAnalysisResultUpsert) {
+		s.SetPersonaName(v)
+	})
+}
+
+// UpdatePersonaName sets the "persona_name" field to the value that was provided on create.
+func (u *DeepAnalysisResultUpsertBulk) UpdatePersonaName() *DeepAnalysisResultUpsertBulk {
+	return u.Update(func(s *DeepAnalysisResultUpsert) {
+		s.UpdatePersonaName()
+	})
+}
+
+// ClearPersonaName clears the value of the "persona_name" field.
+func (u *DeepAnalysisResultUpsertBulk) ClearPersonaName() *DeepAnalysisResultUpsertBulk {
+	return u.Update(func(s *DeepAnalysisResultUpsert) {
+		s.ClearPersonaName()
+	})
+}
+
+// SetMacroTrends sets the "macro_trends" field.
+func (u *DeepAnalysisResultUpsertBulk) SetMacroTrends(v string) *DeepAnalysisResultUpsertBulk {
+	return u.Update(func(s *DeepAnalysisResultUpsert) {
+		s.SetMacroTrends(v)
+	})
+}
+
+// UpdateMacroTrends sets the "macro_trends" field to the value that was provided on create.
+func (u *DeepAnalysisResultUpsertBulk) UpdateMacroTrends() *DeepAnalysisResultUpsertBulk {
+	return u.Update(func(s *DeepAnalysisResultUpsert) {
+		s.UpdateMacroTrends()
+	})
+}
+
+// ClearMacroTrends clears the value of the "macro_trends" field.
+func (u *DeepAnalysisResultUpsertBulk) ClearMacroTrends() *DeepAnalysisResultUpsertBulk {
+	return u.Update(func(s *DeepAnalysisResultUpsert) {
+		s.ClearMacroTrends()
+	})
+}
+
+// SetOpportunities sets the "opportunities" field.
+func (u *DeepAnalysisResultUpsertBulk) SetOpportunities(v string) *DeepAnalysisResultUpsertBulk {
+	return u.Update(func(s *DeepAnalysisResultUpsert) {
+		s.SetOpportunities(v)
+	})
+}
+
+// UpdateOpportunities sets the "opportunities" field to the value that was provided on create.
+func (u *DeepAnalysisResultUpsertBulk) UpdateOpportunities() *DeepAnalysisResultUpsertBulk {
+	return u.Update(func(s *DeepAnalysisResultUpsert) {
+		s.UpdateOpportunities()
+	})
+}
+
+// ClearOpportunities clears the value of the "opportunities" field.
+func (u *DeepAnalysisResultUpsertBulk) ClearOpportunities() *DeepAnalysisResultUpsertBulk {
+	return u.Update(func(s *DeepAnalysisResultUpsert) {
+		s.ClearOpportunities()
+	})
+}
+
+// SetRisks sets the "risks" field.
+func (u *DeepAnalysisResultUpsertBulk) SetRisks(v string) *DeepAnalysisResultUpsertBulk {
+	return u.Update(func(s *DeepAnalysisResultUpsert) {
+		s.SetRisks(v)
+	})
+}
+
+// UpdateRisks sets the "risks" field to the value that was provided on create.
+func (u *DeepAnalysisResultUpsertBulk) UpdateRisks() *DeepAnalysisResultUpsertBulk {
+	return u.Update(func(s *DeepAnalysisResultUpsert) {
+		s.UpdateRisks()
+	})
+}
+
+// ClearRisks clears the value of the "risks" field.
+func (u *DeepAnalysisResultUpsertBulk) ClearRisks() *DeepAnalysisResultUpsertBulk {
+	return u.Update(func(s *DeepAnalysisResultUpsert) {
+		s.ClearRisks()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *DeepAnalysisResultUpsertBulk) SetCreatedAt(v time.Time) *DeepAnalysisResultUpsertBulk {
+	return u.Update(func(s *DeepAnalysisResultUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *DeepAnalysisResultUpsertBulk) UpdateCreatedAt() *DeepAnalysisResultUpsertBulk {
+	return u.Update(func(s *DeepAnalysisResultUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *DeepAnalysisResultUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the DeepAnalysisResultCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DeepAnalysisResultCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DeepAnalysisResultUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
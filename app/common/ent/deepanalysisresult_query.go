@@ -13,7 +13,9 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/iWorld-y/domain_radar/app/common/ent/actionguide"
+	"github.com/iWorld-y/domain_radar/app/common/ent/analysissection"
 	"github.com/iWorld-y/domain_radar/app/common/ent/deepanalysisresult"
+	"github.com/iWorld-y/domain_radar/app/common/ent/persona"
 	"github.com/iWorld-y/domain_radar/app/common/ent/predicate"
 	"github.com/iWorld-y/domain_radar/app/common/ent/reportrun"
 )
@@ -27,6 +29,8 @@ type DeepAnalysisResultQuery struct {
 	predicates       []predicate.DeepAnalysisResult
 	withReportRun    *ReportRunQuery
 	withActionGuides *ActionGuideQuery
+	withSections     *AnalysisSectionQuery
+	withPersona      *PersonaQuery
 	modifiers        []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -108,6 +112,50 @@ func (_q *DeepAnalysisResultQuery) QueryActionGuides() *ActionGuideQuery {
 	return query
 }
 
+// QuerySections chains the current query on the "sections" edge.
+func (_q *DeepAnalysisResultQuery) QuerySections() *AnalysisSectionQuery {
+	query := (&AnalysisSectionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(deepanalysisresult.Table, deepanalysisresult.FieldID, selector),
+			sqlgraph.To(analysissection.Table, analysissection.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, deepanalysisresult.SectionsTable, deepanalysisresult.SectionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPersona chains the current query on the "persona" edge.
+func (_q *DeepAnalysisResultQuery) QueryPersona() *PersonaQuery {
+	query := (&PersonaClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(deepanalysisresult.Table, deepanalysisresult.FieldID, selector),
+			sqlgraph.To(persona.Table, persona.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, deepanalysisresult.PersonaTable, deepanalysisresult.PersonaColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first DeepAnalysisResult entity from the query.
 // Returns a *NotFoundError when no DeepAnalysisResult was found.
 func (_q *DeepAnalysisResultQuery) First(ctx context.Context) (*DeepAnalysisResult, error) {
@@ -302,6 +350,8 @@ func (_q *DeepAnalysisResultQuery) Clone() *DeepAnalysisResultQuery {
 		predicates:       append([]predicate.DeepAnalysisResult{}, _q.predicates...),
 		withReportRun:    _q.withReportRun.Clone(),
 		withActionGuides: _q.withActionGuides.Clone(),
+		withSections:     _q.withSections.Clone(),
+		withPersona:      _q.withPersona.Clone(),
 		// clone intermediate query.
 		sql:       _q.sql.Clone(),
 		path:      _q.path,
@@ -331,6 +381,28 @@ func (_q *DeepAnalysisResultQuery) WithActionGuides(opts ...func(*ActionGuideQue
 	return _q
 }
 
+// WithSections tells the query-builder to eager-load the nodes that are connected to
+// the "sections" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *DeepAnalysisResultQuery) WithSections(opts ...func(*AnalysisSectionQuery)) *DeepAnalysisResultQuery {
+	query := (&AnalysisSectionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withSections = query
+	return _q
+}
+
+// WithPersona tells the query-builder to eager-load the nodes that are connected to
+// the "persona" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *DeepAnalysisResultQuery) WithPersona(opts ...func(*PersonaQuery)) *DeepAnalysisResultQuery {
+	query := (&PersonaClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPersona = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -409,9 +481,11 @@ func (_q *DeepAnalysisResultQuery) sqlAll(ctx context.Context, hooks ...queryHoo
 	var (
 		nodes       = []*DeepAnalysisResult{}
 		_spec       = _q.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [4]bool{
 			_q.withReportRun != nil,
 			_q.withActionGuides != nil,
+			_q.withSections != nil,
+			_q.withPersona != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -448,6 +522,19 @@ func (_q *DeepAnalysisResultQuery) sqlAll(ctx context.Context, hooks ...queryHoo
 			return nil, err
 		}
 	}
+	if query := _q.withSections; query != nil {
+		if err := _q.loadSections(ctx, query, nodes,
+			func(n *DeepAnalysisResult) { n.Edges.Sections = []*AnalysisSection{} },
+			func(n *DeepAnalysisResult, e *AnalysisSection) { n.Edges.Sections = append(n.Edges.Sections, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withPersona; query != nil {
+		if err := _q.loadPersona(ctx, query, nodes, nil,
+			func(n *DeepAnalysisResult, e *Persona) { n.Edges.Persona = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -510,6 +597,65 @@ func (_q *DeepAnalysisResultQuery) loadActionGuides(ctx context.Context, query *
 	}
 	return nil
 }
+func (_q *DeepAnalysisResultQuery) loadSections(ctx context.Context, query *AnalysisSectionQuery, nodes []*DeepAnalysisResult, init func(*DeepAnalysisResult), assign func(*DeepAnalysisResult, *AnalysisSection)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*DeepAnalysisResult)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(analysissection.FieldDeepAnalysisID)
+	}
+	query.Where(predicate.AnalysisSection(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(deepanalysisresult.SectionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.DeepAnalysisID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "deep_analysis_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *DeepAnalysisResultQuery) loadPersona(ctx context.Context, query *PersonaQuery, nodes []*DeepAnalysisResult, init func(*DeepAnalysisResult), assign func(*DeepAnalysisResult, *Persona)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*DeepAnalysisResult)
+	for i := range nodes {
+		fk := nodes[i].PersonaID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(persona.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "persona_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 
 func (_q *DeepAnalysisResultQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -542,6 +688,9 @@ func (_q *DeepAnalysisResultQuery) querySpec() *sqlgraph.QuerySpec {
 		if _q.withReportRun != nil {
 			_spec.Node.AddColumnOnce(deepanalysisresult.FieldRunID)
 		}
+		if _q.withPersona != nil {
+			_spec.Node.AddColumnOnce(deepanalysisresult.FieldPersonaID)
+		}
 	}
 	if ps := _q.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
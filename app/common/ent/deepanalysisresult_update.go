@@ -12,7 +12,9 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/iWorld-y/domain_radar/app/common/ent/actionguide"
+	"github.com/iWorld-y/domain_radar/app/common/ent/analysissection"
 	"github.com/iWorld-y/domain_radar/app/common/ent/deepanalysisresult"
+	"github.com/iWorld-y/domain_radar/app/common/ent/persona"
 	"github.com/iWorld-y/domain_radar/app/common/ent/predicate"
 	"github.com/iWorld-y/domain_radar/app/common/ent/reportrun"
 )
@@ -78,6 +80,46 @@ func (_u *DeepAnalysisResultUpdate) ClearUserID() *DeepAnalysisResultUpdate {
 	return _u
 }
 
+// SetPersonaID sets the "persona_id" field.
+func (_u *DeepAnalysisResultUpdate) SetPersonaID(v int) *DeepAnalysisResultUpdate {
+	_u.mutation.SetPersonaID(v)
+	return _u
+}
+
+// SetNillablePersonaID sets the "persona_id" field if the given value is not nil.
+func (_u *DeepAnalysisResultUpdate) SetNillablePersonaID(v *int) *DeepAnalysisResultUpdate {
+	if v != nil {
+		_u.SetPersonaID(*v)
+	}
+	return _u
+}
+
+// ClearPersonaID clears the value of the "persona_id" field.
+func (_u *DeepAnalysisResultUpdate) ClearPersonaID() *DeepAnalysisResultUpdate {
+	_u.mutation.ClearPersonaID()
+	return _u
+}
+
+// SetPersonaName sets the "persona_name" field.
+func (_u *DeepAnalysisResultUpdate) SetPersonaName(v string) *DeepAnalysisResultUpdate {
+	_u.mutation.SetPersonaName(v)
+	return _u
+}
+
+// SetNillablePersonaName sets the "persona_name" field if the given value is not nil.
+func (_u *DeepAnalysisResultUpdate) SetNillablePersonaName(v *string) *DeepAnalysisResultUpdate {
+	if v != nil {
+		_u.SetPersonaName(*v)
+	}
+	return _u
+}
+
+// ClearPersonaName clears the value of the "persona_name" field.
+func (_u *DeepAnalysisResultUpdate) ClearPersonaName() *DeepAnalysisResultUpdate {
+	_u.mutation.ClearPersonaName()
+	return _u
+}
+
 // SetMacroTrends sets the "macro_trends" field.
 func (_u *DeepAnalysisResultUpdate) SetMacroTrends(v string) *DeepAnalysisResultUpdate {
 	_u.mutation.SetMacroTrends(v)
@@ -186,6 +228,26 @@ func (_u *DeepAnalysisResultUpdate) AddActionGuides(v ...*ActionGuide) *DeepAnal
 	return _u.AddActionGuideIDs(ids...)
 }
 
+// AddSectionIDs adds the "sections" edge to the AnalysisSection entity by IDs.
+func (_u *DeepAnalysisResultUpdate) AddSectionIDs(ids ...int) *DeepAnalysisResultUpdate {
+	_u.mutation.AddSectionIDs(ids...)
+	return _u
+}
+
+// AddSections adds the "sections" edges to the AnalysisSection entity.
+func (_u *DeepAnalysisResultUpdate) AddSections(v ...*AnalysisSection) *DeepAnalysisResultUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSectionIDs(ids...)
+}
+
+// SetPersona sets the "persona" edge to the Persona entity.
+func (_u *DeepAnalysisResultUpdate) SetPersona(v *Persona) *DeepAnalysisResultUpdate {
+	return _u.SetPersonaID(v.ID)
+}
+
 // Mutation returns the DeepAnalysisResultMutation object of the builder.
 func (_u *DeepAnalysisResultUpdate) Mutation() *DeepAnalysisResultMutation {
 	return _u.mutation
@@ -218,6 +280,33 @@ func (_u *DeepAnalysisResultUpdate) RemoveActionGuides(v ...*ActionGuide) *DeepA
 	return _u.RemoveActionGuideIDs(ids...)
 }
 
+// ClearSections clears all "sections" edges to the AnalysisSection entity.
+func (_u *DeepAnalysisResultUpdate) ClearSections() *DeepAnalysisResultUpdate {
+	_u.mutation.ClearSections()
+	return _u
+}
+
+// RemoveSectionIDs removes the "sections" edge to AnalysisSection entities by IDs.
+func (_u *DeepAnalysisResultUpdate) RemoveSectionIDs(ids ...int) *DeepAnalysisResultUpdate {
+	_u.mutation.RemoveSectionIDs(ids...)
+	return _u
+}
+
+// RemoveSections removes "sections" edges to AnalysisSection entities.
+func (_u *DeepAnalysisResultUpdate) RemoveSections(v ...*AnalysisSection) *DeepAnalysisResultUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSectionIDs(ids...)
+}
+
+// ClearPersona clears the "persona" edge to the Persona entity.
+func (_u *DeepAnalysisResultUpdate) ClearPersona() *DeepAnalysisResultUpdate {
+	_u.mutation.ClearPersona()
+	return _u
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *DeepAnalysisResultUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -269,6 +358,12 @@ func (_u *DeepAnalysisResultUpdate) sqlSave(ctx context.Context) (_node int, err
 	if _u.mutation.UserIDCleared() {
 		_spec.ClearField(deepanalysisresult.FieldUserID, field.TypeInt)
 	}
+	if value, ok := _u.mutation.PersonaName(); ok {
+		_spec.SetField(deepanalysisresult.FieldPersonaName, field.TypeString, value)
+	}
+	if _u.mutation.PersonaNameCleared() {
+		_spec.ClearField(deepanalysisresult.FieldPersonaName, field.TypeString)
+	}
 	if value, ok := _u.mutation.MacroTrends(); ok {
 		_spec.SetField(deepanalysisresult.FieldMacroTrends, field.TypeString, value)
 	}
@@ -364,6 +459,80 @@ func (_u *DeepAnalysisResultUpdate) sqlSave(ctx context.Context) (_node int, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.SectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   deepanalysisresult.SectionsTable,
+			Columns: []string{deepanalysisresult.SectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(analysissection.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSectionsIDs(); len(nodes) > 0 && !_u.mutation.SectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   deepanalysisresult.SectionsTable,
+			Columns: []string{deepanalysisresult.SectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(analysissection.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SectionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   deepanalysisresult.SectionsTable,
+			Columns: []string{deepanalysisresult.SectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(analysissection.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PersonaCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   deepanalysisresult.PersonaTable,
+			Columns: []string{deepanalysisresult.PersonaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(persona.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PersonaIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   deepanalysisresult.PersonaTable,
+			Columns: []string{deepanalysisresult.PersonaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(persona.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -433,6 +602,46 @@ func (_u *DeepAnalysisResultUpdateOne) ClearUserID() *DeepAnalysisResultUpdateOn
 	return _u
 }
 
+// SetPersonaID sets the "persona_id" field.
+func (_u *DeepAnalysisResultUpdateOne) SetPersonaID(v int) *DeepAnalysisResultUpdateOne {
+	_u.mutation.SetPersonaID(v)
+	return _u
+}
+
+// SetNillablePersonaID sets the "persona_id" field if the given value is not nil.
+func (_u *DeepAnalysisResultUpdateOne) SetNillablePersonaID(v *int) *DeepAnalysisResultUpdateOne {
+	if v != nil {
+		_u.SetPersonaID(*v)
+	}
+	return _u
+}
+
+// ClearPersonaID clears the value of the "persona_id" field.
+func (_u *DeepAnalysisResultUpdateOne) ClearPersonaID() *DeepAnalysisResultUpdateOne {
+	_u.mutation.ClearPersonaID()
+	return _u
+}
+
+// SetPersonaName sets the "persona_name" field.
+func (_u *DeepAnalysisResultUpdateOne) SetPersonaName(v string) *DeepAnalysisResultUpdateOne {
+	_u.mutation.SetPersonaName(v)
+	return _u
+}
+
+// SetNillablePersonaName sets the "persona_name" field if the given value is not nil.
+func (_u *DeepAnalysisResultUpdateOne) SetNillablePersonaName(v *string) *DeepAnalysisResultUpdateOne {
+	if v != nil {
+		_u.SetPersonaName(*v)
+	}
+	return _u
+}
+
+// ClearPersonaName clears the value of the "persona_name" field.
+func (_u *DeepAnalysisResultUpdateOne) ClearPersonaName() *DeepAnalysisResultUpdateOne {
+	_u.mutation.ClearPersonaName()
+	return _u
+}
+
 // SetMacroTrends sets the "macro_trends" field.
 func (_u *DeepAnalysisResultUpdateOne) SetMacroTrends(v string) *DeepAnalysisResultUpdateOne {
 	_u.mutation.SetMacroTrends(v)
@@ -541,6 +750,26 @@ func (_u *DeepAnalysisResultUpdateOne) AddActionGuides(v ...*ActionGuide) *DeepA
 	return _u.AddActionGuideIDs(ids...)
 }
 
+// AddSectionIDs adds the "sections" edge to the AnalysisSection entity by IDs.
+func (_u *DeepAnalysisResultUpdateOne) AddSectionIDs(ids ...int) *DeepAnalysisResultUpdateOne {
+	_u.mutation.AddSectionIDs(ids...)
+	return _u
+}
+
+// AddSections adds the "sections" edges to the AnalysisSection entity.
+func (_u *DeepAnalysisResultUpdateOne) AddSections(v ...*AnalysisSection) *DeepAnalysisResultUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSectionIDs(ids...)
+}
+
+// SetPersona sets the "persona" edge to the Persona entity.
+func (_u *DeepAnalysisResultUpdateOne) SetPersona(v *Persona) *DeepAnalysisResultUpdateOne {
+	return _u.SetPersonaID(v.ID)
+}
+
 // Mutation returns the DeepAnalysisResultMutation object of the builder.
 func (_u *DeepAnalysisResultUpdateOne) Mutation() *DeepAnalysisResultMutation {
 	return _u.mutation
@@ -573,6 +802,33 @@ func (_u *DeepAnalysisResultUpdateOne) RemoveActionGuides(v ...*ActionGuide) *De
 	return _u.RemoveActionGuideIDs(ids...)
 }
 
+// ClearSections clears all "sections" edges to the AnalysisSection entity.
+func (_u *DeepAnalysisResultUpdateOne) ClearSections() *DeepAnalysisResultUpdateOne {
+	_u.mutation.ClearSections()
+	return _u
+}
+
+// RemoveSectionIDs removes the "sections" edge to AnalysisSection entities by IDs.
+func (_u *DeepAnalysisResultUpdateOne) RemoveSectionIDs(ids ...int) *DeepAnalysisResultUpdateOne {
+	_u.mutation.RemoveSectionIDs(ids...)
+	return _u
+}
+
+// RemoveSections removes "sections" edges to AnalysisSection entities.
+func (_u *DeepAnalysisResultUpdateOne) RemoveSections(v ...*AnalysisSection) *DeepAnalysisResultUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSectionIDs(ids...)
+}
+
+// ClearPersona clears the "persona" edge to the Persona entity.
+func (_u *DeepAnalysisResultUpdateOne) ClearPersona() *DeepAnalysisResultUpdateOne {
+	_u.mutation.ClearPersona()
+	return _u
+}
+
 // Where appends a list predicates to the DeepAnalysisResultUpdate builder.
 func (_u *DeepAnalysisResultUpdateOne) Where(ps ...predicate.DeepAnalysisResult) *DeepAnalysisResultUpdateOne {
 	_u.mutation.Where(ps...)
@@ -654,6 +910,12 @@ func (_u *DeepAnalysisResultUpdateOne) sqlSave(ctx context.Context) (_node *Deep
 	if _u.mutation.UserIDCleared() {
 		_spec.ClearField(deepanalysisresult.FieldUserID, field.TypeInt)
 	}
+	if value, ok := _u.mutation.PersonaName(); ok {
+		_spec.SetField(deepanalysisresult.FieldPersonaName, field.TypeString, value)
+	}
+	if _u.mutation.PersonaNameCleared() {
+		_spec.ClearField(deepanalysisresult.FieldPersonaName, field.TypeString)
+	}
 	if value, ok := _u.mutation.MacroTrends(); ok {
 		_spec.SetField(deepanalysisresult.FieldMacroTrends, field.TypeString, value)
 	}
@@ -749,6 +1011,80 @@ func (_u *DeepAnalysisResultUpdateOne) sqlSave(ctx context.Context) (_node *Deep
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.SectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   deepanalysisresult.SectionsTable,
+			Columns: []string{deepanalysisresult.SectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(analysissection.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSectionsIDs(); len(nodes) > 0 && !_u.mutation.SectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   deepanalysisresult.SectionsTable,
+			Columns: []string{deepanalysisresult.SectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(analysissection.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SectionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   deepanalysisresult.SectionsTable,
+			Columns: []string{deepanalysisresult.SectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(analysissection.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PersonaCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   deepanalysisresult.PersonaTable,
+			Columns: []string{deepanalysisresult.PersonaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(persona.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PersonaIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   deepanalysisresult.PersonaTable,
+			Columns: []string{deepanalysisresult.PersonaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(persona.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &DeepAnalysisResult{config: _u.config}
 	_spec.Assign = _node.assignValues
@@ -18,16 +18,36 @@ type DomainReport struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID int `json:"id,omitempty"`
-	// RunID holds the value of the "run_id" field.
+	// Run that generated the report; runs sharing it are linked through report_runs
 	RunID int `json:"run_id,omitempty"`
+	// Hash of the normalized domain config, search window, report language and pipeline stages; reports with the same key can be shared across runs
+	ShareKey string `json:"share_key,omitempty"`
 	// DomainName holds the value of the "domain_name" field.
 	DomainName string `json:"domain_name,omitempty"`
 	// Overview holds the value of the "overview" field.
 	Overview string `json:"overview,omitempty"`
 	// Trends holds the value of the "trends" field.
 	Trends string `json:"trends,omitempty"`
-	// Score holds the value of the "score" field.
+	// Heat score from 1 to 10, calibrated against the domain's history
 	Score int `json:"score,omitempty"`
+	// Significance score from 1 to 10 judged by the LLM
+	LlmScore int `json:"llm_score,omitempty"`
+	// Number of search results found for the domain
+	ResultCount int `json:"result_count,omitempty"`
+	// Average result count of the domain's recent reports used as the volume baseline
+	HeatBaseline float64 `json:"heat_baseline,omitempty"`
+	// Article volume relative to the domain's baseline, from 0 to 1
+	HeatVolume float64 `json:"heat_volume,omitempty"`
+	// Share of distinct sources among the results, from 0 to 1
+	HeatDiversity float64 `json:"heat_diversity,omitempty"`
+	// Average freshness of the results, from 0 to 1, empty when no publish dates are known
+	HeatRecency *float64 `json:"heat_recency,omitempty"`
+	// Community engagement of the results, from 0 to 1, empty when the search provider reports none
+	HeatEngagement *float64 `json:"heat_engagement,omitempty"`
+	// LLM-judged significance, from 0 to 1
+	HeatSignificance float64 `json:"heat_significance,omitempty"`
+	// Weighted sum of the available components before calibration, from 0 to 1
+	HeatRaw *float64 `json:"heat_raw,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -38,32 +58,45 @@ type DomainReport struct {
 
 // DomainReportEdges holds the relations/edges for other nodes in the graph.
 type DomainReportEdges struct {
-	// ReportRun holds the value of the report_run edge.
-	ReportRun *ReportRun `json:"report_run,omitempty"`
+	// SourceRun holds the value of the source_run edge.
+	SourceRun *ReportRun `json:"source_run,omitempty"`
+	// ReportRuns holds the value of the report_runs edge.
+	ReportRuns []*ReportRun `json:"report_runs,omitempty"`
 	// Articles holds the value of the articles edge.
 	Articles []*Article `json:"articles,omitempty"`
 	// KeyEvents holds the value of the key_events edge.
 	KeyEvents []*KeyEvent `json:"key_events,omitempty"`
+	// ClaimVerifications holds the value of the claim_verifications edge.
+	ClaimVerifications []*ClaimVerification `json:"claim_verifications,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [5]bool
 }
 
-// ReportRunOrErr returns the ReportRun value or an error if the edge
+// SourceRunOrErr returns the SourceRun value or an error if the edge
 // was not loaded in eager-loading, or loaded but was not found.
-func (e DomainReportEdges) ReportRunOrErr() (*ReportRun, error) {
-	if e.ReportRun != nil {
-		return e.ReportRun, nil
+func (e DomainReportEdges) SourceRunOrErr() (*ReportRun, error) {
+	if e.SourceRun != nil {
+		return e.SourceRun, nil
 	} else if e.loadedTypes[0] {
 		return nil, &NotFoundError{label: reportrun.Label}
 	}
-	return nil, &NotLoadedError{edge: "report_run"}
+	return nil, &NotLoadedError{edge: "source_run"}
+}
+
+// ReportRunsOrErr returns the ReportRuns value or an error if the edge
+// was not loaded in eager-loading.
+func (e DomainReportEdges) ReportRunsOrErr() ([]*ReportRun, error) {
+	if e.loadedTypes[1] {
+		return e.ReportRuns, nil
+	}
+	return nil, &NotLoadedError{edge: "report_runs"}
 }
 
 // ArticlesOrErr returns the Articles value or an error if the edge
 // was not loaded in eager-loading.
 func (e DomainReportEdges) ArticlesOrErr() ([]*Article, error) {
-	if e.loadedTypes[1] {
+	if e.loadedTypes[2] {
 		return e.Articles, nil
 	}
 	return nil, &NotLoadedError{edge: "articles"}
@@ -72,20 +105,31 @@ func (e DomainReportEdges) ArticlesOrErr() ([]*Article, error) {
 // KeyEventsOrErr returns the KeyEvents value or an error if the edge
 // was not loaded in eager-loading.
 func (e DomainReportEdges) KeyEventsOrErr() ([]*KeyEvent, error) {
-	if e.loadedTypes[2] {
+	if e.loadedTypes[3] {
 		return e.KeyEvents, nil
 	}
 	return nil, &NotLoadedError{edge: "key_events"}
 }
 
+// ClaimVerificationsOrErr returns the ClaimVerifications value or an error if the edge
+// was not loaded in eager-loading.
+func (e DomainReportEdges) ClaimVerificationsOrErr() ([]*ClaimVerification, error) {
+	if e.loadedTypes[4] {
+		return e.ClaimVerifications, nil
+	}
+	return nil, &NotLoadedError{edge: "claim_verifications"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*DomainReport) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case domainreport.FieldID, domainreport.FieldRunID, domainreport.FieldScore:
+		case domainreport.FieldHeatBaseline, domainreport.FieldHeatVolume, domainreport.FieldHeatDiversity, domainreport.FieldHeatRecency, domainreport.FieldHeatEngagement, domainreport.FieldHeatSignificance, domainreport.FieldHeatRaw:
+			values[i] = new(sql.NullFloat64)
+		case domainreport.FieldID, domainreport.FieldRunID, domainreport.FieldScore, domainreport.FieldLlmScore, domainreport.FieldResultCount:
 			values[i] = new(sql.NullInt64)
-		case domainreport.FieldDomainName, domainreport.FieldOverview, domainreport.FieldTrends:
+		case domainreport.FieldShareKey, domainreport.FieldDomainName, domainreport.FieldOverview, domainreport.FieldTrends:
 			values[i] = new(sql.NullString)
 		case domainreport.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
@@ -116,6 +160,12 @@ func (_m *DomainReport) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.RunID = int(value.Int64)
 			}
+		case domainreport.FieldShareKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field share_key", values[i])
+			} else if value.Valid {
+				_m.ShareKey = value.String
+			}
 		case domainreport.FieldDomainName:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field domain_name", values[i])
@@ -140,6 +190,63 @@ func (_m *DomainReport) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Score = int(value.Int64)
 			}
+		case domainreport.FieldLlmScore:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field llm_score", values[i])
+			} else if value.Valid {
+				_m.LlmScore = int(value.Int64)
+			}
+		case domainreport.FieldResultCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field result_count", values[i])
+			} else if value.Valid {
+				_m.ResultCount = int(value.Int64)
+			}
+		case domainreport.FieldHeatBaseline:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field heat_baseline", values[i])
+			} else if value.Valid {
+				_m.HeatBaseline = value.Float64
+			}
+		case domainreport.FieldHeatVolume:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field heat_volume", values[i])
+			} else if value.Valid {
+				_m.HeatVolume = value.Float64
+			}
+		case domainreport.FieldHeatDiversity:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field heat_diversity", values[i])
+			} else if value.Valid {
+				_m.HeatDiversity = value.Float64
+			}
+		case domainreport.FieldHeatRecency:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field heat_recency", values[i])
+			} else if value.Valid {
+				_m.HeatRecency = new(float64)
+				*_m.HeatRecency = value.Float64
+			}
+		case domainreport.FieldHeatEngagement:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field heat_engagement", values[i])
+			} else if value.Valid {
+				_m.HeatEngagement = new(float64)
+				*_m.HeatEngagement = value.Float64
+			}
+		case domainreport.FieldHeatSignificance:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field heat_significance", values[i])
+			} else if value.Valid {
+				_m.HeatSignificance = value.Float64
+			}
+		case domainreport.FieldHeatRaw:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field heat_raw", values[i])
+			} else if value.Valid {
+				_m.HeatRaw = new(float64)
+				*_m.HeatRaw = value.Float64
+			}
 		case domainreport.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -159,9 +266,14 @@ func (_m *DomainReport) Value(name string) (ent.Value, error) {
 	return _m.selectValues.Get(name)
 }
 
-// QueryReportRun queries the "report_run" edge of the DomainReport entity.
-func (_m *DomainReport) QueryReportRun() *ReportRunQuery {
-	return NewDomainReportClient(_m.config).QueryReportRun(_m)
+// QuerySourceRun queries the "source_run" edge of the DomainReport entity.
+func (_m *DomainReport) QuerySourceRun() *ReportRunQuery {
+	return NewDomainReportClient(_m.config).QuerySourceRun(_m)
+}
+
+// QueryReportRuns queries the "report_runs" edge of the DomainReport entity.
+func (_m *DomainReport) QueryReportRuns() *ReportRunQuery {
+	return NewDomainReportClient(_m.config).QueryReportRuns(_m)
 }
 
 // QueryArticles queries the "articles" edge of the DomainReport entity.
@@ -174,6 +286,11 @@ func (_m *DomainReport) QueryKeyEvents() *KeyEventQuery {
 	return NewDomainReportClient(_m.config).QueryKeyEvents(_m)
 }
 
+// QueryClaimVerifications queries the "claim_verifications" edge of the DomainReport entity.
+func (_m *DomainReport) QueryClaimVerifications() *ClaimVerificationQuery {
+	return NewDomainReportClient(_m.config).QueryClaimVerifications(_m)
+}
+
 // Update returns a builder for updating this DomainReport.
 // Note that you need to call DomainReport.Unwrap() before calling this method if this DomainReport
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -200,6 +317,9 @@ func (_m *DomainReport) String() string {
 	builder.WriteString("run_id=")
 	builder.WriteString(fmt.Sprintf("%v", _m.RunID))
 	builder.WriteString(", ")
+	builder.WriteString("share_key=")
+	builder.WriteString(_m.ShareKey)
+	builder.WriteString(", ")
 	builder.WriteString("domain_name=")
 	builder.WriteString(_m.DomainName)
 	builder.WriteString(", ")
@@ -212,6 +332,39 @@ func (_m *DomainReport) String() string {
 	builder.WriteString("score=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Score))
 	builder.WriteString(", ")
+	builder.WriteString("llm_score=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LlmScore))
+	builder.WriteString(", ")
+	builder.WriteString("result_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ResultCount))
+	builder.WriteString(", ")
+	builder.WriteString("heat_baseline=")
+	builder.WriteString(fmt.Sprintf("%v", _m.HeatBaseline))
+	builder.WriteString(", ")
+	builder.WriteString("heat_volume=")
+	builder.WriteString(fmt.Sprintf("%v", _m.HeatVolume))
+	builder.WriteString(", ")
+	builder.WriteString("heat_diversity=")
+	builder.WriteString(fmt.Sprintf("%v", _m.HeatDiversity))
+	builder.WriteString(", ")
+	if v := _m.HeatRecency; v != nil {
+		builder.WriteString("heat_recency=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.HeatEngagement; v != nil {
+		builder.WriteString("heat_engagement=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("heat_significance=")
+	builder.WriteString(fmt.Sprintf("%v", _m.HeatSignificance))
+	builder.WriteString(", ")
+	if v := _m.HeatRaw; v != nil {
+		builder.WriteString("heat_raw=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
@@ -16,6 +16,8 @@ const (
 	FieldID = "id"
 	// FieldRunID holds the string denoting the run_id field in the database.
 	FieldRunID = "run_id"
+	// FieldShareKey holds the string denoting the share_key field in the database.
+	FieldShareKey = "share_key"
 	// FieldDomainName holds the string denoting the domain_name field in the database.
 	FieldDomainName = "domain_name"
 	// FieldOverview holds the string denoting the overview field in the database.
@@ -24,23 +26,50 @@ const (
 	FieldTrends = "trends"
 	// FieldScore holds the string denoting the score field in the database.
 	FieldScore = "score"
+	// FieldLlmScore holds the string denoting the llm_score field in the database.
+	FieldLlmScore = "llm_score"
+	// FieldResultCount holds the string denoting the result_count field in the database.
+	FieldResultCount = "result_count"
+	// FieldHeatBaseline holds the string denoting the heat_baseline field in the database.
+	FieldHeatBaseline = "heat_baseline"
+	// FieldHeatVolume holds the string denoting the heat_volume field in the database.
+	FieldHeatVolume = "heat_volume"
+	// FieldHeatDiversity holds the string denoting the heat_diversity field in the database.
+	FieldHeatDiversity = "heat_diversity"
+	// FieldHeatRecency holds the string denoting the heat_recency field in the database.
+	FieldHeatRecency = "heat_recency"
+	// FieldHeatEngagement holds the string denoting the heat_engagement field in the database.
+	FieldHeatEngagement = "heat_engagement"
+	// FieldHeatSignificance holds the string denoting the heat_significance field in the database.
+	FieldHeatSignificance = "heat_significance"
+	// FieldHeatRaw holds the string denoting the heat_raw field in the database.
+	FieldHeatRaw = "heat_raw"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
-	// EdgeReportRun holds the string denoting the report_run edge name in mutations.
-	EdgeReportRun = "report_run"
+	// EdgeSourceRun holds the string denoting the source_run edge name in mutations.
+	EdgeSourceRun = "source_run"
+	// EdgeReportRuns holds the string denoting the report_runs edge name in mutations.
+	EdgeReportRuns = "report_runs"
 	// EdgeArticles holds the string denoting the articles edge name in mutations.
 	EdgeArticles = "articles"
 	// EdgeKeyEvents holds the string denoting the key_events edge name in mutations.
 	EdgeKeyEvents = "key_events"
+	// EdgeClaimVerifications holds the string denoting the claim_verifications edge name in mutations.
+	EdgeClaimVerifications = "claim_verifications"
 	// Table holds the table name of the domainreport in the database.
 	Table = "domain_reports"
-	// ReportRunTable is the table that holds the report_run relation/edge.
-	ReportRunTable = "domain_reports"
-	// ReportRunInverseTable is the table name for the ReportRun entity.
+	// SourceRunTable is the table that holds the source_run relation/edge.
+	SourceRunTable = "domain_reports"
+	// SourceRunInverseTable is the table name for the ReportRun entity.
 	// It exists in this package in order to avoid circular dependency with the "reportrun" package.
-	ReportRunInverseTable = "report_runs"
-	// ReportRunColumn is the table column denoting the report_run relation/edge.
-	ReportRunColumn = "run_id"
+	SourceRunInverseTable = "report_runs"
+	// SourceRunColumn is the table column denoting the source_run relation/edge.
+	SourceRunColumn = "run_id"
+	// ReportRunsTable is the table that holds the report_runs relation/edge. The primary key declared below.
+	ReportRunsTable = "report_run_domain_reports"
+	// ReportRunsInverseTable is the table name for the ReportRun entity.
+	// It exists in this package in order to avoid circular dependency with the "reportrun" package.
+	ReportRunsInverseTable = "report_runs"
 	// ArticlesTable is the table that holds the articles relation/edge.
 	ArticlesTable = "articles"
 	// ArticlesInverseTable is the table name for the Article entity.
@@ -55,19 +84,42 @@ const (
 	KeyEventsInverseTable = "key_events"
 	// KeyEventsColumn is the table column denoting the key_events relation/edge.
 	KeyEventsColumn = "domain_report_id"
+	// ClaimVerificationsTable is the table that holds the claim_verifications relation/edge.
+	ClaimVerificationsTable = "claim_verifications"
+	// ClaimVerificationsInverseTable is the table name for the ClaimVerification entity.
+	// It exists in this package in order to avoid circular dependency with the "claimverification" package.
+	ClaimVerificationsInverseTable = "claim_verifications"
+	// ClaimVerificationsColumn is the table column denoting the claim_verifications relation/edge.
+	ClaimVerificationsColumn = "domain_report_id"
 )
 
 // Columns holds all SQL columns for domainreport fields.
 var Columns = []string{
 	FieldID,
 	FieldRunID,
+	FieldShareKey,
 	FieldDomainName,
 	FieldOverview,
 	FieldTrends,
 	FieldScore,
+	FieldLlmScore,
+	FieldResultCount,
+	FieldHeatBaseline,
+	FieldHeatVolume,
+	FieldHeatDiversity,
+	FieldHeatRecency,
+	FieldHeatEngagement,
+	FieldHeatSignificance,
+	FieldHeatRaw,
 	FieldCreatedAt,
 }
 
+var (
+	// ReportRunsPrimaryKey and ReportRunsColumn2 are the table columns denoting the
+	// primary key for the report_runs relation (M2M).
+	ReportRunsPrimaryKey = []string{"report_run_id", "domain_report_id"}
+)
+
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -96,6 +148,11 @@ func ByRunID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldRunID, opts...).ToFunc()
 }
 
+// ByShareKey orders the results by the share_key field.
+func ByShareKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldShareKey, opts...).ToFunc()
+}
+
 // ByDomainName orders the results by the domain_name field.
 func ByDomainName(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDomainName, opts...).ToFunc()
@@ -116,15 +173,74 @@ func ByScore(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldScore, opts...).ToFunc()
 }
 
+// ByLlmScore orders the results by the llm_score field.
+func ByLlmScore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLlmScore, opts...).ToFunc()
+}
+
+// ByResultCount orders the results by the result_count field.
+func ByResultCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResultCount, opts...).ToFunc()
+}
+
+// ByHeatBaseline orders the results by the heat_baseline field.
+func ByHeatBaseline(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHeatBaseline, opts...).ToFunc()
+}
+
+// ByHeatVolume orders the results by the heat_volume field.
+func ByHeatVolume(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHeatVolume, opts...).ToFunc()
+}
+
+// ByHeatDiversity orders the results by the heat_diversity field.
+func ByHeatDiversity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHeatDiversity, opts...).ToFunc()
+}
+
+// ByHeatRecency orders the results by the heat_recency field.
+func ByHeatRecency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHeatRecency, opts...).ToFunc()
+}
+
+// ByHeatEngagement orders the results by the heat_engagement field.
+func ByHeatEngagement(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHeatEngagement, opts...).ToFunc()
+}
+
+// ByHeatSignificance orders the results by the heat_significance field.
+func ByHeatSignificance(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHeatSignificance, opts...).ToFunc()
+}
+
+// ByHeatRaw orders the results by the heat_raw field.
+func ByHeatRaw(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHeatRaw, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
 }
 
-// ByReportRunField orders the results by report_run field.
-func ByReportRunField(field string, opts ...sql.OrderTermOption) OrderOption {
+// BySourceRunField orders the results by source_run field.
+func BySourceRunField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
-		sqlgraph.OrderByNeighborTerms(s, newReportRunStep(), sql.OrderByField(field, opts...))
+		sqlgraph.OrderByNeighborTerms(s, newSourceRunStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByReportRunsCount orders the results by report_runs count.
+func ByReportRunsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newReportRunsStep(), opts...)
+	}
+}
+
+// ByReportRuns orders the results by report_runs terms.
+func ByReportRuns(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReportRunsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
 
@@ -155,11 +271,32 @@ func ByKeyEvents(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newKeyEventsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
-func newReportRunStep() *sqlgraph.Step {
+
+// ByClaimVerificationsCount orders the results by claim_verifications count.
+func ByClaimVerificationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newClaimVerificationsStep(), opts...)
+	}
+}
+
+// ByClaimVerifications orders the results by claim_verifications terms.
+func ByClaimVerifications(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newClaimVerificationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newSourceRunStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SourceRunInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, SourceRunTable, SourceRunColumn),
+	)
+}
+func newReportRunsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
-		sqlgraph.To(ReportRunInverseTable, FieldID),
-		sqlgraph.Edge(sqlgraph.M2O, true, ReportRunTable, ReportRunColumn),
+		sqlgraph.To(ReportRunsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, ReportRunsTable, ReportRunsPrimaryKey...),
 	)
 }
 func newArticlesStep() *sqlgraph.Step {
@@ -176,3 +313,10 @@ func newKeyEventsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, KeyEventsTable, KeyEventsColumn),
 	)
 }
+func newClaimVerificationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ClaimVerificationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ClaimVerificationsTable, ClaimVerificationsColumn),
+	)
+}
@@ -60,6 +60,11 @@ func RunID(v int) predicate.DomainReport {
 	return predicate.DomainReport(sql.FieldEQ(FieldRunID, v))
 }
 
+// ShareKey applies equality check predicate on the "share_key" field. It's identical to ShareKeyEQ.
+func ShareKey(v string) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldEQ(FieldShareKey, v))
+}
+
 // DomainName applies equality check predicate on the "domain_name" field. It's identical to DomainNameEQ.
 func DomainName(v string) predicate.DomainReport {
 	return predicate.DomainReport(sql.FieldEQ(FieldDomainName, v))
@@ -80,6 +85,51 @@ func Score(v int) predicate.DomainReport {
 	return predicate.DomainReport(sql.FieldEQ(FieldScore, v))
 }
 
+// LlmScore applies equality check predicate on the "llm_score" field. It's identical to LlmScoreEQ.
+func LlmScore(v int) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldEQ(FieldLlmScore, v))
+}
+
+// ResultCount applies equality check predicate on the "result_count" field. It's identical to ResultCountEQ.
+func ResultCount(v int) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldEQ(FieldResultCount, v))
+}
+
+// HeatBaseline applies equality check predicate on the "heat_baseline" field. It's identical to HeatBaselineEQ.
+func HeatBaseline(v float64) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldEQ(FieldHeatBaseline, v))
+}
+
+// HeatVolume applies equality check predicate on the "heat_volume" field. It's identical to HeatVolumeEQ.
+func HeatVolume(v float64) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldEQ(FieldHeatVolume, v))
+}
+
+// HeatDiversity applies equality check predicate on the "heat_diversity" field. It's identical to HeatDiversityEQ.
+func HeatDiversity(v float64) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldEQ(FieldHeatDiversity, v))
+}
+
+// HeatRecency applies equality check predicate on the "heat_recency" field. It's identical to HeatRecencyEQ.
+func HeatRecency(v float64) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldEQ(FieldHeatRecency, v))
+}
+
+// HeatEngagement applies equality check predicate on the "heat_engagement" field. It's identical to HeatEngagementEQ.
+func HeatEngagement(v float64) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldEQ(FieldHeatEngagement, v))
+}
+
+// HeatSignificance applies equality check predicate on the "heat_significance" field. It's identical to HeatSignificanceEQ.
+func HeatSignificance(v float64) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldEQ(FieldHeatSignificance, v))
+}
+
+// HeatRaw applies equality check predicate on the "heat_raw" field. It's identical to HeatRawEQ.
+func HeatRaw(v float64) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldEQ(FieldHeatRaw, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.DomainReport {
 	return predicate.DomainReport(sql.FieldEQ(FieldCreatedAt, v))
@@ -115,6 +165,81 @@ func RunIDNotNil() predicate.DomainReport {
 	return predicate.DomainReport(sql.FieldNotNull(FieldRunID))
 }
 
+// ShareKeyEQ applies the EQ predicate on the "share_key" field.
+func ShareKeyEQ(v string) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldEQ(FieldShareKey, v))
+}
+
+// ShareKeyNEQ applies the NEQ predicate on the "share_key" field.
+func ShareKeyNEQ(v string) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldNEQ(FieldShareKey, v))
+}
+
+// ShareKeyIn applies the In predicate on the "share_key" field.
+func ShareKeyIn(vs ...string) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldIn(FieldShareKey, vs...))
+}
+
+// ShareKeyNotIn applies the NotIn predicate on the "share_key" field.
+func ShareKeyNotIn(vs ...string) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldNotIn(FieldShareKey, vs...))
+}
+
+// ShareKeyGT applies the GT predicate on the "share_key" field.
+func ShareKeyGT(v string) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldGT(FieldShareKey, v))
+}
+
+// ShareKeyGTE applies the GTE predicate on the "share_key" field.
+func ShareKeyGTE(v string) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldGTE(FieldShareKey, v))
+}
+
+// ShareKeyLT applies the LT predicate on the "share_key" field.
+func ShareKeyLT(v string) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldLT(FieldShareKey, v))
+}
+
+// ShareKeyLTE applies the LTE predicate on the "share_key" field.
+func ShareKeyLTE(v string) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldLTE(FieldShareKey, v))
+}
+
+// ShareKeyContains applies the Contains predicate on the "share_key" field.
+func ShareKeyContains(v string) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldContains(FieldShareKey, v))
+}
+
+// ShareKeyHasPrefix applies the HasPrefix predicate on the "share_key" field.
+func ShareKeyHasPrefix(v string) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldHasPrefix(FieldShareKey, v))
+}
+
+// ShareKeyHasSuffix applies the HasSuffix predicate on the "share_key" field.
+func ShareKeyHasSuffix(v string) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldHasSuffix(FieldShareKey, v))
+}
+
+// ShareKeyIsNil applies the IsNil predicate on the "share_key" field.
+func ShareKeyIsNil() predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldIsNull(FieldShareKey))
+}
+
+// ShareKeyNotNil applies the NotNil predicate on the "share_key" field.
+func ShareKeyNotNil() predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldNotNull(FieldShareKey))
+}
+
+// ShareKeyEqualFold applies the EqualFold predicate on the "share_key" field.
+func ShareKeyEqualFold(v string) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldEqualFold(FieldShareKey, v))
+}
+
+// ShareKeyContainsFold applies the ContainsFold predicate on the "share_key" field.
+func ShareKeyContainsFold(v string) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldContainsFold(FieldShareKey, v))
+}
+
 // DomainNameEQ applies the EQ predicate on the "domain_name" field.
 func DomainNameEQ(v string) predicate.DomainReport {
 	return predicate.DomainReport(sql.FieldEQ(FieldDomainName, v))
@@ -380,6 +505,456 @@ func ScoreNotNil() predicate.DomainReport {
 	return predicate.DomainReport(sql.FieldNotNull(FieldScore))
 }
 
+// LlmScoreEQ applies the EQ predicate on the "llm_score" field.
+func LlmScoreEQ(v int) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldEQ(FieldLlmScore, v))
+}
+
+// LlmScoreNEQ applies the NEQ predicate on the "llm_score" field.
+func LlmScoreNEQ(v int) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldNEQ(FieldLlmScore, v))
+}
+
+// LlmScoreIn applies the In predicate on the "llm_score" field.
+func LlmScoreIn(vs ...int) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldIn(FieldLlmScore, vs...))
+}
+
+// LlmScoreNotIn applies the NotIn predicate on the "llm_score" field.
+func LlmScoreNotIn(vs ...int) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldNotIn(FieldLlmScore, vs...))
+}
+
+// LlmScoreGT applies the GT predicate on the "llm_score" field.
+func LlmScoreGT(v int) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldGT(FieldLlmScore, v))
+}
+
+// LlmScoreGTE applies the GTE predicate on the "llm_score" field.
+func LlmScoreGTE(v int) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldGTE(FieldLlmScore, v))
+}
+
+// LlmScoreLT applies the LT predicate on the "llm_score" field.
+func LlmScoreLT(v int) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldLT(FieldLlmScore, v))
+}
+
+// LlmScoreLTE applies the LTE predicate on the "llm_score" field.
+func LlmScoreLTE(v int) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldLTE(FieldLlmScore, v))
+}
+
+// LlmScoreIsNil applies the IsNil predicate on the "llm_score" field.
+func LlmScoreIsNil() predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldIsNull(FieldLlmScore))
+}
+
+// LlmScoreNotNil applies the NotNil predicate on the "llm_score" field.
+func LlmScoreNotNil() predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldNotNull(FieldLlmScore))
+}
+
+// ResultCountEQ applies the EQ predicate on the "result_count" field.
+func ResultCountEQ(v int) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldEQ(FieldResultCount, v))
+}
+
+// ResultCountNEQ applies the NEQ predicate on the "result_count" field.
+func ResultCountNEQ(v int) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldNEQ(FieldResultCount, v))
+}
+
+// ResultCountIn applies the In predicate on the "result_count" field.
+func ResultCountIn(vs ...int) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldIn(FieldResultCount, vs...))
+}
+
+// ResultCountNotIn applies the NotIn predicate on the "result_count" field.
+func ResultCountNotIn(vs ...int) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldNotIn(FieldResultCount, vs...))
+}
+
+// ResultCountGT applies the GT predicate on the "result_count" field.
+func ResultCountGT(v int) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldGT(FieldResultCount, v))
+}
+
+// ResultCountGTE applies the GTE predicate on the "result_count" field.
+func ResultCountGTE(v int) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldGTE(FieldResultCount, v))
+}
+
+// ResultCountLT applies the LT predicate on the "result_count" field.
+func ResultCountLT(v int) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldLT(FieldResultCount, v))
+}
+
+// ResultCountLTE applies the LTE predicate on the "result_count" field.
+func ResultCountLTE(v int) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldLTE(FieldResultCount, v))
+}
+
+// ResultCountIsNil applies the IsNil predicate on the "result_count" field.
+func ResultCountIsNil() predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldIsNull(FieldResultCount))
+}
+
+// ResultCountNotNil applies the NotNil predicate on the "result_count" field.
+func ResultCountNotNil() predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldNotNull(FieldResultCount))
+}
+
+// HeatBaselineEQ applies the EQ predicate on the "heat_baseline" field.
+func HeatBaselineEQ(v float64) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldEQ(FieldHeatBaseline, v))
+}
+
+// HeatBaselineNEQ applies the NEQ predicate on the "heat_baseline" field.
+func HeatBaselineNEQ(v float64) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldNEQ(FieldHeatBaseline, v))
+}
+
+// HeatBaselineIn applies the In predicate on the "heat_baseline" field.
+func HeatBaselineIn(vs ...float64) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldIn(FieldHeatBaseline, vs...))
+}
+
+// HeatBaselineNotIn applies the NotIn predicate on the "heat_baseline" field.
+func HeatBaselineNotIn(vs ...float64) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldNotIn(FieldHeatBaseline, vs...))
+}
+
+// HeatBaselineGT applies the GT predicate on the "heat_baseline" field.
+func HeatBaselineGT(v float64) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldGT(FieldHeatBaseline, v))
+}
+
+// HeatBaselineGTE applies the GTE predicate on the "heat_baseline" field.
+func HeatBaselineGTE(v float64) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldGTE(FieldHeatBaseline, v))
+}
+
+// HeatBaselineLT applies the LT predicate on the "heat_baseline" field.
+func HeatBaselineLT(v float64) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldLT(FieldHeatBaseline, v))
+}
+
+// HeatBaselineLTE applies the LTE predicate on the "heat_baseline" field.
+func HeatBaselineLTE(v float64) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldLTE(FieldHeatBaseline, v))
+}
+
+// HeatBaselineIsNil applies the IsNil predicate on the "heat_baseline" field.
+func HeatBaselineIsNil() predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldIsNull(FieldHeatBaseline))
+}
+
+// HeatBaselineNotNil applies the NotNil predicate on the "heat_baseline" field.
+func HeatBaselineNotNil() predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldNotNull(FieldHeatBaseline))
+}
+
+// HeatVolumeEQ applies the EQ predicate on the "heat_volume" field.
+func HeatVolumeEQ(v float64) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldEQ(FieldHeatVolume, v))
+}
+
+// HeatVolumeNEQ applies the NEQ predicate on the "heat_volume" field.
+func HeatVolumeNEQ(v float64) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldNEQ(FieldHeatVolume, v))
+}
+
+// HeatVolumeIn applies the In predicate on the "heat_volume" field.
+func HeatVolumeIn(vs ...float64) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldIn(FieldHeatVolume, vs...))
+}
+
+// HeatVolumeNotIn applies the NotIn predicate on the "heat_volume" field.
+func HeatVolumeNotIn(vs ...float64) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldNotIn(FieldHeatVolume, vs...))
+}
+
+// HeatVolumeGT applies the GT predicate on the "heat_volume" field.
+func HeatVolumeGT(v float64) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldGT(FieldHeatVolume, v))
+}
+
+// HeatVolumeGTE applies the GTE predicate on the "heat_volume" field.
+func HeatVolumeGTE(v float64) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldGTE(FieldHeatVolume, v))
+}
+
+// HeatVolumeLT applies the LT predicate on the "heat_volume" field.
+func HeatVolumeLT(v float64) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldLT(FieldHeatVolume, v))
+}
+
+// HeatVolumeLTE applies the LTE predicate on the "heat_volume" field.
+func HeatVolumeLTE(v float64) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldLTE(FieldHeatVolume, v))
+}
+
+// HeatVolumeIsNil applies the IsNil predicate on the "heat_volume" field.
+func HeatVolumeIsNil() predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldIsNull(FieldHeatVolume))
+}
+
+// HeatVolumeNotNil applies the NotNil predicate on the "heat_volume" field.
+func HeatVolumeNotNil() predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldNotNull(FieldHeatVolume))
+}
+
+// HeatDiversityEQ applies the EQ predicate on the "heat_diversity" field.
+func HeatDiversityEQ(v float64) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldEQ(FieldHeatDiversity, v))
+}
+
+// HeatDiversityNEQ applies the NEQ predicate on the "heat_diversity" field.
+func HeatDiversityNEQ(v float64) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldNEQ(FieldHeatDiversity, v))
+}
+
+// HeatDiversityIn applies the In predicate on the "heat_diversity" field.
+func HeatDiversityIn(vs ...float64) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldIn(FieldHeatDiversity, vs...))
+}
+
+// HeatDiversityNotIn applies the NotIn predicate on the "heat_diversity" field.
+func HeatDiversityNotIn(vs ...float64) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldNotIn(FieldHeatDiversity, vs...))
+}
+
+// HeatDiversityGT applies the GT predicate on the "heat_diversity" field.
+func HeatDiversityGT(v float64) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldGT(FieldHeatDiversity, v))
+}
+
+// HeatDiversityGTE applies the GTE predicate on the "heat_diversity" field.
+func HeatDiversityGTE(v float64) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldGTE(FieldHeatDiversity, v))
+}
+
+// HeatDiversityLT applies the LT predicate on the "heat_diversity" field.
+func HeatDiversityLT(v float64) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldLT(FieldHeatDiversity, v))
+}
+
+// HeatDiversityLTE applies the LTE predicate on the "heat_diversity" field.
+func HeatDiversityLTE(v float64) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldLTE(FieldHeatDiversity, v))
+}
+
+// HeatDiversityIsNil applies the IsNil predicate on the "heat_diversity" field.
+func HeatDiversityIsNil() predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldIsNull(FieldHeatDiversity))
+}
+
+// HeatDiversityNotNil applies the NotNil predicate on the "heat_diversity" field.
+func HeatDiversityNotNil() predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldNotNull(FieldHeatDiversity))
+}
+
+// HeatRecencyEQ applies the EQ predicate on the "heat_recency" field.
+func HeatRecencyEQ(v float64) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldEQ(FieldHeatRecency, v))
+}
+
+// HeatRecencyNEQ applies the NEQ predicate on the "heat_recency" field.
+func HeatRecencyNEQ(v float64) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldNEQ(FieldHeatRecency, v))
+}
+
+// HeatRecencyIn applies the In predicate on the "heat_recency" field.
+func HeatRecencyIn(vs ...float64) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldIn(FieldHeatRecency, vs...))
+}
+
+// HeatRecencyNotIn applies the NotIn predicate on the "heat_recency" field.
+func HeatRecencyNotIn(vs ...float64) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldNotIn(FieldHeatRecency, vs...))
+}
+
+// HeatRecencyGT applies the GT predicate on the "heat_recency" field.
+func HeatRecencyGT(v float64) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldGT(FieldHeatRecency, v))
+}
+
+// HeatRecencyGTE applies the GTE predicate on the "heat_recency" field.
+func HeatRecencyGTE(v float64) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldGTE(FieldHeatRecency, v))
+}
+
+// HeatRecencyLT applies the LT predicate on the "heat_recency" field.
+func HeatRecencyLT(v float64) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldLT(FieldHeatRecency, v))
+}
+
+// HeatRecencyLTE applies the LTE predicate on the "heat_recency" field.
+func HeatRecencyLTE(v float64) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldLTE(FieldHeatRecency, v))
+}
+
+// HeatRecencyIsNil applies the IsNil predicate on the "heat_recency" field.
+func HeatRecencyIsNil() predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldIsNull(FieldHeatRecency))
+}
+
+// HeatRecencyNotNil applies the NotNil predicate on the "heat_recency" field.
+func HeatRecencyNotNil() predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldNotNull(FieldHeatRecency))
+}
+
+// HeatEngagementEQ applies the EQ predicate on the "heat_engagement" field.
+func HeatEngagementEQ(v float64) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldEQ(FieldHeatEngagement, v))
+}
+
+// HeatEngagementNEQ applies the NEQ predicate on the "heat_engagement" field.
+func HeatEngagementNEQ(v float64) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldNEQ(FieldHeatEngagement, v))
+}
+
+// HeatEngagementIn applies the In predicate on the "heat_engagement" field.
+func HeatEngagementIn(vs ...float64) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldIn(FieldHeatEngagement, vs...))
+}
+
+// HeatEngagementNotIn applies the NotIn predicate on the "heat_engagement" field.
+func HeatEngagementNotIn(vs ...float64) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldNotIn(FieldHeatEngagement, vs...))
+}
+
+// HeatEngagementGT applies the GT predicate on the "heat_engagement" field.
+func HeatEngagementGT(v float64) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldGT(FieldHeatEngagement, v))
+}
+
+// HeatEngagementGTE applies the GTE predicate on the "heat_engagement" field.
+func HeatEngagementGTE(v float64) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldGTE(FieldHeatEngagement, v))
+}
+
+// HeatEngagementLT applies the LT predicate on the "heat_engagement" field.
+func HeatEngagementLT(v float64) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldLT(FieldHeatEngagement, v))
+}
+
+// HeatEngagementLTE applies the LTE predicate on the "heat_engagement" field.
+func HeatEngagementLTE(v float64) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldLTE(FieldHeatEngagement, v))
+}
+
+// HeatEngagementIsNil applies the IsNil predicate on the "heat_engagement" field.
+func HeatEngagementIsNil() predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldIsNull(FieldHeatEngagement))
+}
+
+// HeatEngagementNotNil applies the NotNil predicate on the "heat_engagement" field.
+func HeatEngagementNotNil() predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldNotNull(FieldHeatEngagement))
+}
+
+// HeatSignificanceEQ applies the EQ predicate on the "heat_significance" field.
+func HeatSignificanceEQ(v float64) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldEQ(FieldHeatSignificance, v))
+}
+
+// HeatSignificanceNEQ applies the NEQ predicate on the "heat_significance" field.
+func HeatSignificanceNEQ(v float64) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldNEQ(FieldHeatSignificance, v))
+}
+
+// HeatSignificanceIn applies the In predicate on the "heat_significance" field.
+func HeatSignificanceIn(vs ...float64) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldIn(FieldHeatSignificance, vs...))
+}
+
+// HeatSignificanceNotIn applies the NotIn predicate on the "heat_significance" field.
+func HeatSignificanceNotIn(vs ...float64) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldNotIn(FieldHeatSignificance, vs...))
+}
+
+// HeatSignificanceGT applies the GT predicate on the "heat_significance" field.
+func HeatSignificanceGT(v float64) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldGT(FieldHeatSignificance, v))
+}
+
+// HeatSignificanceGTE applies the GTE predicate on the "heat_significance" field.
+func HeatSignificanceGTE(v float64) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldGTE(FieldHeatSignificance, v))
+}
+
+// HeatSignificanceLT applies the LT predicate on the "heat_significance" field.
+func HeatSignificanceLT(v float64) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldLT(FieldHeatSignificance, v))
+}
+
+// HeatSignificanceLTE applies the LTE predicate on the "heat_significance" field.
+func HeatSignificanceLTE(v float64) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldLTE(FieldHeatSignificance, v))
+}
+
+// HeatSignificanceIsNil applies the IsNil predicate on the "heat_significance" field.
+func HeatSignificanceIsNil() predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldIsNull(FieldHeatSignificance))
+}
+
+// HeatSignificanceNotNil applies the NotNil predicate on the "heat_significance" field.
+func HeatSignificanceNotNil() predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldNotNull(FieldHeatSignificance))
+}
+
+// HeatRawEQ applies the EQ predicate on the "heat_raw" field.
+func HeatRawEQ(v float64) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldEQ(FieldHeatRaw, v))
+}
+
+// HeatRawNEQ applies the NEQ predicate on the "heat_raw" field.
+func HeatRawNEQ(v float64) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldNEQ(FieldHeatRaw, v))
+}
+
+// HeatRawIn applies the In predicate on the "heat_raw" field.
+func HeatRawIn(vs ...float64) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldIn(FieldHeatRaw, vs...))
+}
+
+// HeatRawNotIn applies the NotIn predicate on the "heat_raw" field.
+func HeatRawNotIn(vs ...float64) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldNotIn(FieldHeatRaw, vs...))
+}
+
+// HeatRawGT applies the GT predicate on the "heat_raw" field.
+func HeatRawGT(v float64) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldGT(FieldHeatRaw, v))
+}
+
+// HeatRawGTE applies the GTE predicate on the "heat_raw" field.
+func HeatRawGTE(v float64) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldGTE(FieldHeatRaw, v))
+}
+
+// HeatRawLT applies the LT predicate on the "heat_raw" field.
+func HeatRawLT(v float64) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldLT(FieldHeatRaw, v))
+}
+
+// HeatRawLTE applies the LTE predicate on the "heat_raw" field.
+func HeatRawLTE(v float64) predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldLTE(FieldHeatRaw, v))
+}
+
+// HeatRawIsNil applies the IsNil predicate on the "heat_raw" field.
+func HeatRawIsNil() predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldIsNull(FieldHeatRaw))
+}
+
+// HeatRawNotNil applies the NotNil predicate on the "heat_raw" field.
+func HeatRawNotNil() predicate.DomainReport {
+	return predicate.DomainReport(sql.FieldNotNull(FieldHeatRaw))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.DomainReport {
 	return predicate.DomainReport(sql.FieldEQ(FieldCreatedAt, v))
@@ -420,21 +995,44 @@ func CreatedAtLTE(v time.Time) predicate.DomainReport {
 	return predicate.DomainReport(sql.FieldLTE(FieldCreatedAt, v))
 }
 
-// HasReportRun applies the HasEdge predicate on the "report_run" edge.
-func HasReportRun() predicate.DomainReport {
+// HasSourceRun applies the HasEdge predicate on the "source_run" edge.
+func HasSourceRun() predicate.DomainReport {
+	return predicate.DomainReport(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, SourceRunTable, SourceRunColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSourceRunWith applies the HasEdge predicate on the "source_run" edge with a given conditions (other predicates).
+func HasSourceRunWith(preds ...predicate.ReportRun) predicate.DomainReport {
+	return predicate.DomainReport(func(s *sql.Selector) {
+		step := newSourceRunStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReportRuns applies the HasEdge predicate on the "report_runs" edge.
+func HasReportRuns() predicate.DomainReport {
 	return predicate.DomainReport(func(s *sql.Selector) {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, ReportRunTable, ReportRunColumn),
+			sqlgraph.Edge(sqlgraph.M2M, true, ReportRunsTable, ReportRunsPrimaryKey...),
 		)
 		sqlgraph.HasNeighbors(s, step)
 	})
 }
 
-// HasReportRunWith applies the HasEdge predicate on the "report_run" edge with a given conditions (other predicates).
-func HasReportRunWith(preds ...predicate.ReportRun) predicate.DomainReport {
+// HasReportRunsWith applies the HasEdge predicate on the "report_runs" edge with a given conditions (other predicates).
+func HasReportRunsWith(preds ...predicate.ReportRun) predicate.DomainReport {
 	return predicate.DomainReport(func(s *sql.Selector) {
-		step := newReportRunStep()
+		step := newReportRunsStep()
 		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
 			for _, p := range preds {
 				p(s)
@@ -489,6 +1087,29 @@ func HasKeyEventsWith(preds ...predicate.KeyEvent) predicate.DomainReport {
 	})
 }
 
+// HasClaimVerifications applies the HasEdge predicate on the "claim_verifications" edge.
+func HasClaimVerifications() predicate.DomainReport {
+	return predicate.DomainReport(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ClaimVerificationsTable, ClaimVerificationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasClaimVerificationsWith applies the HasEdge predicate on the "claim_verifications" edge with a given conditions (other predicates).
+func HasClaimVerificationsWith(preds ...predicate.ClaimVerification) predicate.DomainReport {
+	return predicate.DomainReport(func(s *sql.Selector) {
+		step := newClaimVerificationsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.DomainReport) predicate.DomainReport {
 	return predicate.DomainReport(sql.AndPredicates(predicates...))
@@ -8,9 +8,11 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/iWorld-y/domain_radar/app/common/ent/article"
+	"github.com/iWorld-y/domain_radar/app/common/ent/claimverification"
 	"github.com/iWorld-y/domain_radar/app/common/ent/domainreport"
 	"github.com/iWorld-y/domain_radar/app/common/ent/keyevent"
 	"github.com/iWorld-y/domain_radar/app/common/ent/reportrun"
@@ -21,6 +23,7 @@ type DomainReportCreate struct {
 	config
 	mutation *DomainReportMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetRunID sets the "run_id" field.
@@ -37,6 +40,20 @@ func (_c *DomainReportCreate) SetNillableRunID(v *int) *DomainReportCreate {
 	return _c
 }
 
+// SetShareKey sets the "share_key" field.
+func (_c *DomainReportCreate) SetShareKey(v string) *DomainReportCreate {
+	_c.mutation.SetShareKey(v)
+	return _c
+}
+
+// SetNillableShareKey sets the "share_key" field if the given value is not nil.
+func (_c *DomainReportCreate) SetNillableShareKey(v *string) *DomainReportCreate {
+	if v != nil {
+		_c.SetShareKey(*v)
+	}
+	return _c
+}
+
 // SetDomainName sets the "domain_name" field.
 func (_c *DomainReportCreate) SetDomainName(v string) *DomainReportCreate {
 	_c.mutation.SetDomainName(v)
@@ -85,6 +102,132 @@ func (_c *DomainReportCreate) SetNillableScore(v *int) *DomainReportCreate {
 	return _c
 }
 
+// SetLlmScore sets the "llm_score" field.
+func (_c *DomainReportCreate) SetLlmScore(v int) *DomainReportCreate {
+	_c.mutation.SetLlmScore(v)
+	return _c
+}
+
+// SetNillableLlmScore sets the "llm_score" field if the given value is not nil.
+func (_c *DomainReportCreate) SetNillableLlmScore(v *int) *DomainReportCreate {
+	if v != nil {
+		_c.SetLlmScore(*v)
+	}
+	return _c
+}
+
+// SetResultCount sets the "result_count" field.
+func (_c *DomainReportCreate) SetResultCount(v int) *DomainReportCreate {
+	_c.mutation.SetResultCount(v)
+	return _c
+}
+
+// SetNillableResultCount sets the "result_count" field if the given value is not nil.
+func (_c *DomainReportCreate) SetNillableResultCount(v *int) *DomainReportCreate {
+	if v != nil {
+		_c.SetResultCount(*v)
+	}
+	return _c
+}
+
+// SetHeatBaseline sets the "heat_baseline" field.
+func (_c *DomainReportCreate) SetHeatBaseline(v float64) *DomainReportCreate {
+	_c.mutation.SetHeatBaseline(v)
+	return _c
+}
+
+// SetNillableHeatBaseline sets the "heat_baseline" field if the given value is not nil.
+func (_c *DomainReportCreate) SetNillableHeatBaseline(v *float64) *DomainReportCreate {
+	if v != nil {
+		_c.SetHeatBaseline(*v)
+	}
+	return _c
+}
+
+// SetHeatVolume sets the "heat_volume" field.
+func (_c *DomainReportCreate) SetHeatVolume(v float64) *DomainReportCreate {
+	_c.mutation.SetHeatVolume(v)
+	return _c
+}
+
+// SetNillableHeatVolume sets the "heat_volume" field if the given value is not nil.
+func (_c *DomainReportCreate) SetNillableHeatVolume(v *float64) *DomainReportCreate {
+	if v != nil {
+		_c.SetHeatVolume(*v)
+	}
+	return _c
+}
+
+// SetHeatDiversity sets the "heat_diversity" field.
+func (_c *DomainReportCreate) SetHeatDiversity(v float64) *DomainReportCreate {
+	_c.mutation.SetHeatDiversity(v)
+	return _c
+}
+
+// SetNillableHeatDiversity sets the "heat_diversity" field if the given value is not nil.
+func (_c *DomainReportCreate) SetNillableHeatDiversity(v *float64) *DomainReportCreate {
+	if v != nil {
+		_c.SetHeatDiversity(*v)
+	}
+	return _c
+}
+
+// SetHeatRecency sets the "heat_recency" field.
+func (_c *DomainReportCreate) SetHeatRecency(v float64) *DomainReportCreate {
+	_c.mutation.SetHeatRecency(v)
+	return _c
+}
+
+// SetNillableHeatRecency sets the "heat_recency" field if the given value is not nil.
+func (_c *DomainReportCreate) SetNillableHeatRecency(v *float64) *DomainReportCreate {
+	if v != nil {
+		_c.SetHeatRecency(*v)
+	}
+	return _c
+}
+
+// SetHeatEngagement sets the "heat_engagement" field.
+func (_c *DomainReportCreate) SetHeatEngagement(v float64) *DomainReportCreate {
+	_c.mutation.SetHeatEngagement(v)
+	return _c
+}
+
+// SetNillableHeatEngagement sets the "heat_engagement" field if the given value is not nil.
+func (_c *DomainReportCreate) SetNillableHeatEngagement(v *float64) *DomainReportCreate {
+	if v != nil {
+		_c.SetHeatEngagement(*v)
+	}
+	return _c
+}
+
+// SetHeatSignificance sets the "heat_significance" field.
+func (_c *DomainReportCreate) SetHeatSignificance(v float64) *DomainReportCreate {
+	_c.mutation.SetHeatSignificance(v)
+	return _c
+}
+
+// SetNillableHeatSignificance sets the "heat_significance" field if the given value is not nil.
+func (_c *DomainReportCreate) SetNillableHeatSignificance(v *float64) *DomainReportCreate {
+	if v != nil {
+		_c.SetHeatSignificance(*v)
+	}
+	return _c
+}
+
+// SetHeatRaw sets the "heat_raw" field.
+func (_c *DomainReportCreate) SetHeatRaw(v float64) *DomainReportCreate {
+	_c.mutation.SetHeatRaw(v)
+	return _c
+}
+
+// SetNillableHeatRaw sets the "heat_raw" field if the given value is not nil.
+func (_c *DomainReportCreate) SetNillableHeatRaw(v *float64) *DomainReportCreate {
+	if v != nil {
+		_c.SetHeatRaw(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *DomainReportCreate) SetCreatedAt(v time.Time) *DomainReportCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -105,23 +248,38 @@ func (_c *DomainReportCreate) SetID(v int) *DomainReportCreate {
 	return _c
 }
 
-// SetReportRunID sets the "report_run" edge to the ReportRun entity by ID.
-func (_c *DomainReportCreate) SetReportRunID(id int) *DomainReportCreate {
-	_c.mutation.SetReportRunID(id)
+// SetSourceRunID sets the "source_run" edge to the ReportRun entity by ID.
+func (_c *DomainReportCreate) SetSourceRunID(id int) *DomainReportCreate {
+	_c.mutation.SetSourceRunID(id)
 	return _c
 }
 
-// SetNillableReportRunID sets the "report_run" edge to the ReportRun entity by ID if the given value is not nil.
-func (_c *DomainReportCreate) SetNillableReportRunID(id *int) *DomainReportCreate {
+// SetNillableSourceRunID sets the "source_run" edge to the ReportRun entity by ID if the given value is not nil.
+func (_c *DomainReportCreate) SetNillableSourceRunID(id *int) *DomainReportCreate {
 	if id != nil {
-		_c = _c.SetReportRunID(*id)
+		_c = _c.SetSourceRunID(*id)
 	}
 	return _c
 }
 
-// SetReportRun sets the "report_run" edge to the ReportRun entity.
-func (_c *DomainReportCreate) SetReportRun(v *ReportRun) *DomainReportCreate {
-	return _c.SetReportRunID(v.ID)
+// SetSourceRun sets the "source_run" edge to the ReportRun entity.
+func (_c *DomainReportCreate) SetSourceRun(v *ReportRun) *DomainReportCreate {
+	return _c.SetSourceRunID(v.ID)
+}
+
+// AddReportRunIDs adds the "report_runs" edge to the ReportRun entity by IDs.
+func (_c *DomainReportCreate) AddReportRunIDs(ids ...int) *DomainReportCreate {
+	_c.mutation.AddReportRunIDs(ids...)
+	return _c
+}
+
+// AddReportRuns adds the "report_runs" edges to the ReportRun entity.
+func (_c *DomainReportCreate) AddReportRuns(v ...*ReportRun) *DomainReportCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddReportRunIDs(ids...)
 }
 
 // AddArticleIDs adds the "articles" edge to the Article entity by IDs.
@@ -154,6 +312,21 @@ func (_c *DomainReportCreate) AddKeyEvents(v ...*KeyEvent) *DomainReportCreate {
 	return _c.AddKeyEventIDs(ids...)
 }
 
+// AddClaimVerificationIDs adds the "claim_verifications" edge to the ClaimVerification entity by IDs.
+func (_c *DomainReportCreate) AddClaimVerificationIDs(ids ...int) *DomainReportCreate {
+	_c.mutation.AddClaimVerificationIDs(ids...)
+	return _c
+}
+
+// AddClaimVerifications adds the "claim_verifications" edges to the ClaimVerification entity.
+func (_c *DomainReportCreate) AddClaimVerifications(v ...*ClaimVerification) *DomainReportCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddClaimVerificationIDs(ids...)
+}
+
 // Mutation returns the DomainReportMutation object of the builder.
 func (_c *DomainReportCreate) Mutation() *DomainReportMutation {
 	return _c.mutation
@@ -231,10 +404,15 @@ func (_c *DomainReportCreate) createSpec() (*DomainReport, *sqlgraph.CreateSpec)
 		_node = &DomainReport{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(domainreport.Table, sqlgraph.NewFieldSpec(domainreport.FieldID, field.TypeInt))
 	)
+	_spec.OnConflict = _c.conflict
 	if id, ok := _c.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = id
 	}
+	if value, ok := _c.mutation.ShareKey(); ok {
+		_spec.SetField(domainreport.FieldShareKey, field.TypeString, value)
+		_node.ShareKey = value
+	}
 	if value, ok := _c.mutation.DomainName(); ok {
 		_spec.SetField(domainreport.FieldDomainName, field.TypeString, value)
 		_node.DomainName = value
@@ -251,16 +429,52 @@ func (_c *DomainReportCreate) createSpec() (*DomainReport, *sqlgraph.CreateSpec)
 		_spec.SetField(domainreport.FieldScore, field.TypeInt, value)
 		_node.Score = value
 	}
+	if value, ok := _c.mutation.LlmScore(); ok {
+		_spec.SetField(domainreport.FieldLlmScore, field.TypeInt, value)
+		_node.LlmScore = value
+	}
+	if value, ok := _c.mutation.ResultCount(); ok {
+		_spec.SetField(domainreport.FieldResultCount, field.TypeInt, value)
+		_node.ResultCount = value
+	}
+	if value, ok := _c.mutation.HeatBaseline(); ok {
+		_spec.SetField(domainreport.FieldHeatBaseline, field.TypeFloat64, value)
+		_node.HeatBaseline = value
+	}
+	if value, ok := _c.mutation.HeatVolume(); ok {
+		_spec.SetField(domainreport.FieldHeatVolume, field.TypeFloat64, value)
+		_node.HeatVolume = value
+	}
+	if value, ok := _c.mutation.HeatDiversity(); ok {
+		_spec.SetField(domainreport.FieldHeatDiversity, field.TypeFloat64, value)
+		_node.HeatDiversity = value
+	}
+	if value, ok := _c.mutation.HeatRecency(); ok {
+		_spec.SetField(domainreport.FieldHeatRecency, field.TypeFloat64, value)
+		_node.HeatRecency = &value
+	}
+	if value, ok := _c.mutation.HeatEngagement(); ok {
+		_spec.SetField(domainreport.FieldHeatEngagement, field.TypeFloat64, value)
+		_node.HeatEngagement = &value
+	}
+	if value, ok := _c.mutation.HeatSignificance(); ok {
+		_spec.SetField(domainreport.FieldHeatSignificance, field.TypeFloat64, value)
+		_node.HeatSignificance = value
+	}
+	if value, ok := _c.mutation.HeatRaw(); ok {
+		_spec.SetField(domainreport.FieldHeatRaw, field.TypeFloat64, value)
+		_node.HeatRaw = &value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(domainreport.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
 	}
-	if nodes := _c.mutation.ReportRunIDs(); len(nodes) > 0 {
+	if nodes := _c.mutation.SourceRunIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
 			Inverse: true,
-			Table:   domainreport.ReportRunTable,
-			Columns: []string{domainreport.ReportRunColumn},
+			Table:   domainreport.SourceRunTable,
+			Columns: []string{domainreport.SourceRunColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(reportrun.FieldID, field.TypeInt),
@@ -272,6 +486,22 @@ func (_c *DomainReportCreate) createSpec() (*DomainReport, *sqlgraph.CreateSpec)
 		_node.RunID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.ReportRunsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   domainreport.ReportRunsTable,
+			Columns: domainreport.ReportRunsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reportrun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := _c.mutation.ArticlesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -304,93 +534,1475 @@ func (_c *DomainReportCreate) createSpec() (*DomainReport, *sqlgraph.CreateSpec)
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.ClaimVerificationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   domainreport.ClaimVerificationsTable,
+			Columns: []string{domainreport.ClaimVerificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(claimverification.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
-// DomainReportCreateBulk is the builder for creating many DomainReport entities in bulk.
-type DomainReportCreateBulk struct {
-	config
-	err      error
-	builders []*DomainReportCreate
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DomainReport.Create().
+//		SetRunID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DomainReportUpsert) {
+//			SetRunID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DomainReportCreate) OnConflict(opts ...sql.ConflictOption) *DomainReportUpsertOne {
+	_c.conflict = opts
+	return &DomainReportUpsertOne{
+		create: _c,
+	}
 }
 
-// Save creates the DomainReport entities in the database.
-func (_c *DomainReportCreateBulk) Save(ctx context.Context) ([]*DomainReport, error) {
-	if _c.err != nil {
-		return nil, _c.err
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DomainReport.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DomainReportCreate) OnConflictColumns(columns ...string) *DomainReportUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DomainReportUpsertOne{
+		create: _c,
 	}
-	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
-	nodes := make([]*DomainReport, len(_c.builders))
-	mutators := make([]Mutator, len(_c.builders))
-	for i := range _c.builders {
-		func(i int, root context.Context) {
-			builder := _c.builders[i]
-			builder.defaults()
-			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
-				mutation, ok := m.(*DomainReportMutation)
-				if !ok {
-					return nil, fmt.Errorf("unexpected mutation type %T", m)
-				}
-				if err := builder.check(); err != nil {
-					return nil, err
-				}
-				builder.mutation = mutation
-				var err error
-				nodes[i], specs[i] = builder.createSpec()
-				if i < len(mutators)-1 {
-					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
-				} else {
-					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
-					// Invoke the actual operation on the latest mutation in the chain.
-					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
-						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
-						}
-					}
-				}
-				if err != nil {
-					return nil, err
-				}
-				mutation.id = &nodes[i].ID
-				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
-					id := specs[i].ID.Value.(int64)
-					nodes[i].ID = int(id)
-				}
-				mutation.done = true
-				return nodes[i], nil
-			})
-			for i := len(builder.hooks) - 1; i >= 0; i-- {
-				mut = builder.hooks[i](mut)
-			}
-			mutators[i] = mut
-		}(i, ctx)
+}
+
+type (
+	// DomainReportUpsertOne is the builder for "upsert"-ing
+	//  one DomainReport node.
+	DomainReportUpsertOne struct {
+		create *DomainReportCreate
 	}
-	if len(mutators) > 0 {
-		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
-			return nil, err
-		}
+
+	// DomainReportUpsert is the "OnConflict" setter.
+	DomainReportUpsert struct {
+		*sql.UpdateSet
 	}
-	return nodes, nil
+)
+
+// SetRunID sets the "run_id" field.
+func (u *DomainReportUpsert) SetRunID(v int) *DomainReportUpsert {
+	u.Set(domainreport.FieldRunID, v)
+	return u
 }
 
-// SaveX is like Save, but panics if an error occurs.
-func (_c *DomainReportCreateBulk) SaveX(ctx context.Context) []*DomainReport {
-	v, err := _c.Save(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return v
+// UpdateRunID sets the "run_id" field to the value that was provided on create.
+func (u *DomainReportUpsert) UpdateRunID() *DomainReportUpsert {
+	u.SetExcluded(domainreport.FieldRunID)
+	return u
 }
 
-// Exec executes the query.
-func (_c *DomainReportCreateBulk) Exec(ctx context.Context) error {
-	_, err := _c.Save(ctx)
-	return err
+// ClearRunID clears the value of the "run_id" field.
+func (u *DomainReportUpsert) ClearRunID() *DomainReportUpsert {
+	u.SetNull(domainreport.FieldRunID)
+	return u
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (_c *DomainReportCreateBulk) ExecX(ctx context.Context) {
-	if err := _c.Exec(ctx); err != nil {
+// SetShareKey sets the "share_key" field.
+func (u *DomainReportUpsert) SetShareKey(v string) *DomainReportUpsert {
+	u.Set(domainreport.FieldShareKey, v)
+	return u
+}
+
+// UpdateShareKey sets the "share_key" field to the value that was provided on create.
+func (u *DomainReportUpsert) UpdateShareKey() *DomainReportUpsert {
+	u.SetExcluded(domainreport.FieldShareKey)
+	return u
+}
+
+// ClearShareKey clears the value of the "share_key" field.
+func (u *DomainReportUpsert) ClearShareKey() *DomainReportUpsert {
+	u.SetNull(domainreport.FieldShareKey)
+	return u
+}
+
+// SetDomainName sets the "domain_name" field.
+func (u *DomainReportUpsert) SetDomainName(v string) *DomainReportUpsert {
+	u.Set(domainreport.FieldDomainName, v)
+	return u
+}
+
+// UpdateDomainName sets the "domain_name" field to the value that was provided on create.
+func (u *DomainReportUpsert) UpdateDomainName() *DomainReportUpsert {
+	u.SetExcluded(domainreport.FieldDomainName)
+	return u
+}
+
+// SetOverview sets the "overview" field.
+func (u *DomainReportUpsert) SetOverview(v string) *DomainReportUpsert {
+	u.Set(domainreport.FieldOverview, v)
+	return u
+}
+
+// UpdateOverview sets the "overview" field to the value that was provided on create.
+func (u *DomainReportUpsert) UpdateOverview() *DomainReportUpsert {
+	u.SetExcluded(domainreport.FieldOverview)
+	return u
+}
+
+// ClearOverview clears the value of the "overview" field.
+func (u *DomainReportUpsert) ClearOverview() *DomainReportUpsert {
+	u.SetNull(domainreport.FieldOverview)
+	return u
+}
+
+// SetTrends sets the "trends" field.
+func (u *DomainReportUpsert) SetTrends(v string) *DomainReportUpsert {
+	u.Set(domainreport.FieldTrends, v)
+	return u
+}
+
+// UpdateTrends sets the "trends" field to the value that was provided on create.
+func (u *DomainReportUpsert) UpdateTrends() *DomainReportUpsert {
+	u.SetExcluded(domainreport.FieldTrends)
+	return u
+}
+
+// ClearTrends clears the value of the "trends" field.
+func (u *DomainReportUpsert) ClearTrends() *DomainReportUpsert {
+	u.SetNull(domainreport.FieldTrends)
+	return u
+}
+
+// SetScore sets the "score" field.
+func (u *DomainReportUpsert) SetScore(v int) *DomainReportUpsert {
+	u.Set(domainreport.FieldScore, v)
+	return u
+}
+
+// UpdateScore sets the "score" field to the value that was provided on create.
+func (u *DomainReportUpsert) UpdateScore() *DomainReportUpsert {
+	u.SetExcluded(domainreport.FieldScore)
+	return u
+}
+
+// AddScore adds v to the "score" field.
+func (u *DomainReportUpsert) AddScore(v int) *DomainReportUpsert {
+	u.Add(domainreport.FieldScore, v)
+	return u
+}
+
+// ClearScore clears the value of the "score" field.
+func (u *DomainReportUpsert) ClearScore() *DomainReportUpsert {
+	u.SetNull(domainreport.FieldScore)
+	return u
+}
+
+// SetLlmScore sets the "llm_score" field.
+func (u *DomainReportUpsert) SetLlmScore(v int) *DomainReportUpsert {
+	u.Set(domainreport.FieldLlmScore, v)
+	return u
+}
+
+// UpdateLlmScore sets the "llm_score" field to the value that was provided on create.
+func (u *DomainReportUpsert) UpdateLlmScore() *DomainReportUpsert {
+	u.SetExcluded(domainreport.FieldLlmScore)
+	return u
+}
+
+// AddLlmScore adds v to the "llm_score" field.
+func (u *DomainReportUpsert) AddLlmScore(v int) *DomainReportUpsert {
+	u.Add(domainreport.FieldLlmScore, v)
+	return u
+}
+
+// ClearLlmScore clears the value of the "llm_score" field.
+func (u *DomainReportUpsert) ClearLlmScore() *DomainReportUpsert {
+	u.SetNull(domainreport.FieldLlmScore)
+	return u
+}
+
+// SetResultCount sets the "result_count" field.
+func (u *DomainReportUpsert) SetResultCount(v int) *DomainReportUpsert {
+	u.Set(domainreport.FieldResultCount, v)
+	return u
+}
+
+// UpdateResultCount sets the "result_count" field to the value that was provided on create.
+func (u *DomainReportUpsert) UpdateResultCount() *DomainReportUpsert {
+	u.SetExcluded(domainreport.FieldResultCount)
+	return u
+}
+
+// AddResultCount adds v to the "result_count" field.
+func (u *DomainReportUpsert) AddResultCount(v int) *DomainReportUpsert {
+	u.Add(domainreport.FieldResultCount, v)
+	return u
+}
+
+// ClearResultCount clears the value of the "result_count" field.
+func (u *DomainReportUpsert) ClearResultCount() *DomainReportUpsert {
+	u.SetNull(domainreport.FieldResultCount)
+	return u
+}
+
+// SetHeatBaseline sets the "heat_baseline" field.
+func (u *DomainReportUpsert) SetHeatBaseline(v float64) *DomainReportUpsert {
+	u.Set(domainreport.FieldHeatBaseline, v)
+	return u
+}
+
+// UpdateHeatBaseline sets the "heat_baseline" field to the value that was provided on create.
+func (u *DomainReportUpsert) UpdateHeatBaseline() *DomainReportUpsert {
+	u.SetExcluded(domainreport.FieldHeatBaseline)
+	return u
+}
+
+// AddHeatBaseline adds v to the "heat_baseline" field.
+func (u *DomainReportUpsert) AddHeatBaseline(v float64) *DomainReportUpsert {
+	u.Add(domainreport.FieldHeatBaseline, v)
+	return u
+}
+
+// ClearHeatBaseline clears the value of the "heat_baseline" field.
+func (u *DomainReportUpsert) ClearHeatBaseline() *DomainReportUpsert {
+	u.SetNull(domainreport.FieldHeatBaseline)
+	return u
+}
+
+// SetHeatVolume sets the "heat_volume" field.
+func (u *DomainReportUpsert) SetHeatVolume(v float64) *DomainReportUpsert {
+	u.Set(domainreport.FieldHeatVolume, v)
+	return u
+}
+
+// UpdateHeatVolume sets the "heat_volume" field to the value that was provided on create.
+func (u *DomainReportUpsert) UpdateHeatVolume() *DomainReportUpsert {
+	u.SetExcluded(domainreport.FieldHeatVolume)
+	return u
+}
+
+// AddHeatVolume adds v to the "heat_volume" field.
+func (u *DomainReportUpsert) AddHeatVolume(v float64) *DomainReportUpsert {
+	u.Add(domainreport.FieldHeatVolume, v)
+	return u
+}
+
+// ClearHeatVolume clears the value of the "heat_volume" field.
+func (u *DomainReportUpsert) ClearHeatVolume() *DomainReportUpsert {
+	u.SetNull(domainreport.FieldHeatVolume)
+	return u
+}
+
+// SetHeatDiversity sets the "heat_diversity" field.
+func (u *DomainReportUpsert) SetHeatDiversity(v float64) *DomainReportUpsert {
+	u.Set(domainreport.FieldHeatDiversity, v)
+	return u
+}
+
+// UpdateHeatDiversity sets the "heat_diversity" field to the value that was provided on create.
+func (u *DomainReportUpsert) UpdateHeatDiversity() *DomainReportUpsert {
+	u.SetExcluded(domainreport.FieldHeatDiversity)
+	return u
+}
+
+// AddHeatDiversity adds v to the "heat_diversity" field.
+func (u *DomainReportUpsert) AddHeatDiversity(v float64) *DomainReportUpsert {
+	u.Add(domainreport.FieldHeatDiversity, v)
+	return u
+}
+
+// ClearHeatDiversity clears the value of the "heat_diversity" field.
+func (u *DomainReportUpsert) ClearHeatDiversity() *DomainReportUpsert {
+	u.SetNull(domainreport.FieldHeatDiversity)
+	return u
+}
+
+// SetHeatRecency sets the "heat_recency" field.
+func (u *DomainReportUpsert) SetHeatRecency(v float64) *DomainReportUpsert {
+	u.Set(domainreport.FieldHeatRecency, v)
+	return u
+}
+
+// UpdateHeatRecency sets the "heat_recency" field to the value that was provided on create.
+func (u *DomainReportUpsert) UpdateHeatRecency() *DomainReportUpsert {
+	u.SetExcluded(domainreport.FieldHeatRecency)
+	return u
+}
+
+// AddHeatRecency adds v to the "heat_recency" field.
+func (u *DomainReportUpsert) AddHeatRecency(v float64) *DomainReportUpsert {
+	u.Add(domainreport.FieldHeatRecency, v)
+	return u
+}
+
+// ClearHeatRecency clears the value of the "heat_recency" field.
+func (u *DomainReportUpsert) ClearHeatRecency() *DomainReportUpsert {
+	u.SetNull(domainreport.FieldHeatRecency)
+	return u
+}
+
+// SetHeatEngagement sets the "heat_engagement" field.
+func (u *DomainReportUpsert) SetHeatEngagement(v float64) *DomainReportUpsert {
+	u.Set(domainreport.FieldHeatEngagement, v)
+	return u
+}
+
+// UpdateHeatEngagement sets the "heat_engagement" field to the value that was provided on create.
+func (u *DomainReportUpsert) UpdateHeatEngagement() *DomainReportUpsert {
+	u.SetExcluded(domainreport.FieldHeatEngagement)
+	return u
+}
+
+// AddHeatEngagement adds v to the "heat_engagement" field.
+func (u *DomainReportUpsert) AddHeatEngagement(v float64) *DomainReportUpsert {
+	u.Add(domainreport.FieldHeatEngagement, v)
+	return u
+}
+
+// ClearHeatEngagement clears the value of the "heat_engagement" field.
+func (u *DomainReportUpsert) ClearHeatEngagement() *DomainReportUpsert {
+	u.SetNull(domainreport.FieldHeatEngagement)
+	return u
+}
+
+// SetHeatSignificance sets the "heat_significance" field.
+func (u *DomainReportUpsert) SetHeatSignificance(v float64) *DomainReportUpsert {
+	u.Set(domainreport.FieldHeatSignificance, v)
+	return u
+}
+
+// UpdateHeatSignificance sets the "heat_significance" field to the value that was provided on create.
+func (u *DomainReportUpsert) UpdateHeatSignificance() *DomainReportUpsert {
+	u.SetExcluded(domainreport.FieldHeatSignificance)
+	return u
+}
+
+// AddHeatSignificance adds v to the "heat_significance" field.
+func (u *DomainReportUpsert) AddHeatSignificance(v float64) *DomainReportUpsert {
+	u.Add(domainreport.FieldHeatSignificance, v)
+	return u
+}
+
+// ClearHeatSignificance clears the value of the "heat_significance" field.
+func (u *DomainReportUpsert) ClearHeatSignificance() *DomainReportUpsert {
+	u.SetNull(domainreport.FieldHeatSignificance)
+	return u
+}
+
+// SetHeatRaw sets the "heat_raw" field.
+func (u *DomainReportUpsert) SetHeatRaw(v float64) *DomainReportUpsert {
+	u.Set(domainreport.FieldHeatRaw, v)
+	return u
+}
+
+// UpdateHeatRaw sets the "heat_raw" field to the value that was provided on create.
+func (u *DomainReportUpsert) UpdateHeatRaw() *DomainReportUpsert {
+	u.SetExcluded(domainreport.FieldHeatRaw)
+	return u
+}
+
+// AddHeatRaw adds v to the "heat_raw" field.
+func (u *DomainReportUpsert) AddHeatRaw(v float64) *DomainReportUpsert {
+	u.Add(domainreport.FieldHeatRaw, v)
+	return u
+}
+
+// ClearHeatRaw clears the value of the "heat_raw" field.
+func (u *DomainReportUpsert) ClearHeatRaw() *DomainReportUpsert {
+	u.SetNull(domainreport.FieldHeatRaw)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *DomainReportUpsert) SetCreatedAt(v time.Time) *DomainReportUpsert {
+	u.Set(domainreport.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *DomainReportUpsert) UpdateCreatedAt() *DomainReportUpsert {
+	u.SetExcluded(domainreport.FieldCreatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.DomainReport.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(domainreport.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DomainReportUpsertOne) UpdateNewValues() *DomainReportUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(domainreport.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DomainReport.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *DomainReportUpsertOne) Ignore() *DomainReportUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DomainReportUpsertOne) DoNothing() *DomainReportUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DomainReportCreate.OnConflict
+// documentation for more info.
+func (u *DomainReportUpsertOne) Update(set func(*DomainReportUpsert)) *DomainReportUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DomainReportUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetRunID sets the "run_id" field.
+func (u *DomainReportUpsertOne) SetRunID(v int) *DomainReportUpsertOne {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.SetRunID(v)
+	})
+}
+
+// UpdateRunID sets the "run_id" field to the value that was provided on create.
+func (u *DomainReportUpsertOne) UpdateRunID() *DomainReportUpsertOne {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.UpdateRunID()
+	})
+}
+
+// ClearRunID clears the value of the "run_id" field.
+func (u *DomainReportUpsertOne) ClearRunID() *DomainReportUpsertOne {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.ClearRunID()
+	})
+}
+
+// SetShareKey sets the "share_key" field.
+func (u *DomainReportUpsertOne) SetShareKey(v string) *DomainReportUpsertOne {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.SetShareKey(v)
+	})
+}
+
+// UpdateShareKey sets the "share_key" field to the value that was provided on create.
+func (u *DomainReportUpsertOne) UpdateShareKey() *DomainReportUpsertOne {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.UpdateShareKey()
+	})
+}
+
+// ClearShareKey clears the value of the "share_key" field.
+func (u *DomainReportUpsertOne) ClearShareKey() *DomainReportUpsertOne {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.ClearShareKey()
+	})
+}
+
+// SetDomainName sets the "domain_name" field.
+func (u *DomainReportUpsertOne) SetDomainName(v string) *DomainReportUpsertOne {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.SetDomainName(v)
+	})
+}
+
+// UpdateDomainName sets the "domain_name" field to the value that was provided on create.
+func (u *DomainReportUpsertOne) UpdateDomainName() *DomainReportUpsertOne {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.UpdateDomainName()
+	})
+}
+
+// SetOverview sets the "overview" field.
+func (u *DomainReportUpsertOne) SetOverview(v string) *DomainReportUpsertOne {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.SetOverview(v)
+	})
+}
+
+// UpdateOverview sets the "overview" field to the value that was provided on create.
+func (u *DomainReportUpsertOne) UpdateOverview() *DomainReportUpsertOne {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.UpdateOverview()
+	})
+}
+
+// ClearOverview clears the value of the "overview" field.
+func (u *DomainReportUpsertOne) ClearOverview() *DomainReportUpsertOne {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.ClearOverview()
+	})
+}
+
+// SetTrends sets the "trends" field.
+func (u *DomainReportUpsertOne) SetTrends(v string) *DomainReportUpsertOne {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.SetTrends(v)
+	})
+}
+
+// UpdateTrends sets the "trends" field to the value that was provided on create.
+func (u *DomainReportUpsertOne) UpdateTrends() *DomainReportUpsertOne {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.UpdateTrends()
+	})
+}
+
+// ClearTrends clears the value of the "trends" field.
+func (u *DomainReportUpsertOne) ClearTrends() *DomainReportUpsertOne {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.ClearTrends()
+	})
+}
+
+// SetScore sets the "score" field.
+func (u *DomainReportUpsertOne) SetScore(v int) *DomainReportUpsertOne {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.SetScore(v)
+	})
+}
+
+// AddScore adds v to the "score" field.
+func (u *DomainReportUpsertOne) AddScore(v int) *DomainReportUpsertOne {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.AddScore(v)
+	})
+}
+
+// UpdateScore sets the "score" field to the value that was provided on create.
+func (u *DomainReportUpsertOne) UpdateScore() *DomainReportUpsertOne {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.UpdateScore()
+	})
+}
+
+// ClearScore clears the value of the "score" field.
+func (u *DomainReportUpsertOne) ClearScore() *DomainReportUpsertOne {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.ClearScore()
+	})
+}
+
+// SetLlmScore sets the "llm_score" field.
+func (u *DomainReportUpsertOne) SetLlmScore(v int) *DomainReportUpsertOne {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.SetLlmScore(v)
+	})
+}
+
+// AddLlmScore adds v to the "llm_score" field.
+func (u *DomainReportUpsertOne) AddLlmScore(v int) *DomainReportUpsertOne {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.AddLlmScore(v)
+	})
+}
+
+// UpdateLlmScore sets the "llm_score" field to the value that was provided on create.
+func (u *DomainReportUpsertOne) UpdateLlmScore() *DomainReportUpsertOne {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.UpdateLlmScore()
+	})
+}
+
+// ClearLlmScore clears the value of the "llm_score" field.
+func (u *DomainReportUpsertOne) ClearLlmScore() *DomainReportUpsertOne {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.ClearLlmScore()
+	})
+}
+
+// SetResultCount sets the "result_count" field.
+func (u *DomainReportUpsertOne) SetResultCount(v int) *DomainReportUpsertOne {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.SetResultCount(v)
+	})
+}
+
+// AddResultCount adds v to the "result_count" field.
+func (u *DomainReportUpsertOne) AddResultCount(v int) *DomainReportUpsertOne {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.AddResultCount(v)
+	})
+}
+
+// UpdateResultCount sets the "result_count" field to the value that was provided on create.
+func (u *DomainReportUpsertOne) UpdateResultCount() *DomainReportUpsertOne {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.UpdateResultCount()
+	})
+}
+
+// ClearResultCount clears the value of the "result_count" field.
+func (u *DomainReportUpsertOne) ClearResultCount() *DomainReportUpsertOne {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.ClearResultCount()
+	})
+}
+
+// SetHeatBaseline sets the "heat_baseline" field.
+func (u *DomainReportUpsertOne) SetHeatBaseline(v float64) *DomainReportUpsertOne {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.SetHeatBaseline(v)
+	})
+}
+
+// AddHeatBaseline adds v to the "heat_baseline" field.
+func (u *DomainReportUpsertOne) AddHeatBaseline(v float64) *DomainReportUpsertOne {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.AddHeatBaseline(v)
+	})
+}
+
+// UpdateHeatBaseline sets the "heat_baseline" field to the value that was provided on create.
+func (u *DomainReportUpsertOne) UpdateHeatBaseline() *DomainReportUpsertOne {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.UpdateHeatBaseline()
+	})
+}
+
+// ClearHeatBaseline clears the value of the "heat_baseline" field.
+func (u *DomainReportUpsertOne) ClearHeatBaseline() *DomainReportUpsertOne {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.ClearHeatBaseline()
+	})
+}
+
+// SetHeatVolume sets the "heat_volume" field.
+func (u *DomainReportUpsertOne) SetHeatVolume(v float64) *DomainReportUpsertOne {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.SetHeatVolume(v)
+	})
+}
+
+// AddHeatVolume adds v to the "heat_volume" field.
+func (u *DomainReportUpsertOne) AddHeatVolume(v float64) *DomainReportUpsertOne {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.AddHeatVolume(v)
+	})
+}
+
+// UpdateHeatVolume sets the "heat_volume" field to the value that was provided on create.
+func (u *DomainReportUpsertOne) UpdateHeatVolume() *DomainReportUpsertOne {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.UpdateHeatVolume()
+	})
+}
+
+// ClearHeatVolume clears the value of the "heat_volume" field.
+func (u *DomainReportUpsertOne) ClearHeatVolume() *DomainReportUpsertOne {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.ClearHeatVolume()
+	})
+}
+
+// SetHeatDiversity sets the "heat_diversity" field.
+func (u *DomainReportUpsertOne) SetHeatDiversity(v float64) *DomainReportUpsertOne {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.SetHeatDiversity(v)
+	})
+}
+
+// AddHeatDiversity adds v to the "heat_diversity" field.
+func (u *DomainReportUpsertOne) AddHeatDiversity(v float64) *DomainReportUpsertOne {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.AddHeatDiversity(v)
+	})
+}
+
+// UpdateHeatDiversity sets the "heat_diversity" field to the value that was provided on create.
+func (u *DomainReportUpsertOne) UpdateHeatDiversity() *DomainReportUpsertOne {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.UpdateHeatDiversity()
+	})
+}
+
+// ClearHeatDiversity clears the value of the "heat_diversity" field.
+func (u *DomainReportUpsertOne) ClearHeatDiversity() *DomainReportUpsertOne {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.ClearHeatDiversity()
+	})
+}
+
+// SetHeatRecency sets the "heat_recency" field.
+func (u *DomainReportUpsertOne) SetHeatRecency(v float64) *DomainReportUpsertOne {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.SetHeatRecency(v)
+	})
+}
+
+// AddHeatRecency adds v to the "heat_recency" field.
+func (u *DomainReportUpsertOne) AddHeatRecency(v float64) *DomainReportUpsertOne {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.AddHeatRecency(v)
+	})
+}
+
+// UpdateHeatRecency sets the "heat_recency" field to the value that was provided on create.
+func (u *DomainReportUpsertOne) UpdateHeatRecency() *DomainReportUpsertOne {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.UpdateHeatRecency()
+	})
+}
+
+// ClearHeatRecency clears the value of the "heat_recency" field.
+func (u *DomainReportUpsertOne) ClearHeatRecency() *DomainReportUpsertOne {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.ClearHeatRecency()
+	})
+}
+
+// SetHeatEngagement sets the "heat_engagement" field.
+func (u *DomainReportUpsertOne) SetHeatEngagement(v float64) *DomainReportUpsertOne {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.SetHeatEngagement(v)
+	})
+}
+
+// AddHeatEngagement adds v to the "heat_engagement" field.
+func (u *DomainReportUpsertOne) AddHeatEngagement(v float64) *DomainReportUpsertOne {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.AddHeatEngagement(v)
+	})
+}
+
+// UpdateHeatEngagement sets the "heat_engagement" field to the value that was provided on create.
+func (u *DomainReportUpsertOne) UpdateHeatEngagement() *DomainReportUpsertOne {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.UpdateHeatEngagement()
+	})
+}
+
+// ClearHeatEngagement clears the value of the "heat_engagement" field.
+func (u *DomainReportUpsertOne) ClearHeatEngagement() *DomainReportUpsertOne {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.ClearHeatEngagement()
+	})
+}
+
+// SetHeatSignificance sets the "heat_significance" field.
+func (u *DomainReportUpsertOne) SetHeatSignificance(v float64) *DomainReportUpsertOne {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.SetHeatSignificance(v)
+	})
+}
+
+// AddHeatSignificance adds v to the "heat_significance" field.
+func (u *DomainReportUpsertOne) AddHeatSignificance(v float64) *DomainReportUpsertOne {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.AddHeatSignificance(v)
+	})
+}
+
+// UpdateHeatSignificance sets the "heat_significance" field to the value that was provided on create.
+func (u *DomainReportUpsertOne) UpdateHeatSignificance() *DomainReportUpsertOne {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.UpdateHeatSignificance()
+	})
+}
+
+// ClearHeatSignificance clears the value of the "heat_significance" field.
+func (u *DomainReportUpsertOne) ClearHeatSignificance() *DomainReportUpsertOne {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.ClearHeatSignificance()
+	})
+}
+
+// SetHeatRaw sets the "heat_raw" field.
+func (u *DomainReportUpsertOne) SetHeatRaw(v float64) *DomainReportUpsertOne {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.SetHeatRaw(v)
+	})
+}
+
+// AddHeatRaw adds v to the "heat_raw" field.
+func (u *DomainReportUpsertOne) AddHeatRaw(v float64) *DomainReportUpsertOne {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.AddHeatRaw(v)
+	})
+}
+
+// UpdateHeatRaw sets the "heat_raw" field to the value that was provided on create.
+func (u *DomainReportUpsertOne) UpdateHeatRaw() *DomainReportUpsertOne {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.UpdateHeatRaw()
+	})
+}
+
+// ClearHeatRaw clears the value of the "heat_raw" field.
+func (u *DomainReportUpsertOne) ClearHeatRaw() *DomainReportUpsertOne {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.ClearHeatRaw()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *DomainReportUpsertOne) SetCreatedAt(v time.Time) *DomainReportUpsertOne {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *DomainReportUpsertOne) UpdateCreatedAt() *DomainReportUpsertOne {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *DomainReportUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DomainReportCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DomainReportUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *DomainReportUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *DomainReportUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// DomainReportCreateBulk is the builder for creating many DomainReport entities in bulk.
+type DomainReportCreateBulk struct {
+	config
+	err      error
+	builders []*DomainReportCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the DomainReport entities in the database.
+func (_c *DomainReportCreateBulk) Save(ctx context.Context) ([]*DomainReport, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*DomainReport, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DomainReportMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *DomainReportCreateBulk) SaveX(ctx context.Context) []*DomainReport {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DomainReportCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DomainReportCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DomainReport.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DomainReportUpsert) {
+//			SetRunID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DomainReportCreateBulk) OnConflict(opts ...sql.ConflictOption) *DomainReportUpsertBulk {
+	_c.conflict = opts
+	return &DomainReportUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DomainReport.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DomainReportCreateBulk) OnConflictColumns(columns ...string) *DomainReportUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DomainReportUpsertBulk{
+		create: _c,
+	}
+}
+
+// DomainReportUpsertBulk is the builder for "upsert"-ing
+// a bulk of DomainReport nodes.
+type DomainReportUpsertBulk struct {
+	create *DomainReportCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.DomainReport.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(domainreport.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DomainReportUpsertBulk) UpdateNewValues() *DomainReportUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(domainreport.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DomainReport.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *DomainReportUpsertBulk) Ignore() *DomainReportUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DomainReportUpsertBulk) DoNothing() *DomainReportUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DomainReportCreateBulk.OnConflict
+// documentation for more info.
+func (u *DomainReportUpsertBulk) Update(set func(*DomainReportUpsert)) *DomainReportUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DomainReportUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetRunID sets the "run_id" field.
+func (u *DomainReportUpsertBulk) SetRunID(v int) *DomainReportUpsertBulk {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.SetRunID(v)
+	})
+}
+
+// UpdateRunID sets the "run_id" field to the value that was provided on create.
+func (u *DomainReportUpsertBulk) UpdateRunID() *DomainReportUpsertBulk {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.UpdateRunID()
+	})
+}
+
+// ClearRunID clears the value of the "run_id" field.
+func (u *DomainReportUpsertBulk) ClearRunID() *DomainReportUpsertBulk {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.ClearRunID()
+	})
+}
+
+// SetShareKey sets the "share_key" field.
+func (u *DomainReportUpsertBulk) SetShareKey(v string) *DomainReportUpsertBulk {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.SetShareKey(v)
+	})
+}
+
+// UpdateShareKey sets the "share_key" field to the value that was provided on create.
+func (u *DomainReportUpsertBulk) UpdateShareKey() *DomainReportUpsertBulk {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.UpdateShareKey()
+	})
+}
+
+// ClearShareKey clears the value of the "share_key" field.
+func (u *DomainReportUpsertBulk) ClearShareKey() *DomainReportUpsertBulk {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.ClearShareKey()
+	})
+}
+
+// SetDomainName sets the "domain_name" field.
+func (u *DomainReportUpsertBulk) SetDomainName(v string) *DomainReportUpsertBulk {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.SetDomainName(v)
+	})
+}
+
+// UpdateDomainName sets the "domain_name" field to the value that was provided on create.
+func (u *DomainReportUpsertBulk) UpdateDomainName() *DomainReportUpsertBulk {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.UpdateDomainName()
+	})
+}
+
+// SetOverview sets the "overview" field.
+func (u *DomainReportUpsertBulk) SetOverview(v string) *DomainReportUpsertBulk {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.SetOverview(v)
+	})
+}
+
+// UpdateOverview sets the "overview" field to the value that was provided on create.
+func (u *DomainReportUpsertBulk) UpdateOverview() *DomainReportUpsertBulk {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.UpdateOverview()
+	})
+}
+
+// ClearOverview clears the value of the "overview" field.
+func (u *DomainReportUpsertBulk) ClearOverview() *DomainReportUpsertBulk {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.ClearOverview()
+	})
+}
+
+// SetTrends sets the "trends" field.
+func (u *DomainReportUpsertBulk) SetTrends(v string) *DomainReportUpsertBulk {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.SetTrends(v)
+	})
+}
+
+// UpdateTrends sets the "trends" field to the value that was provided on create.
+func (u *DomainReportUpsertBulk) UpdateTrends() *DomainReportUpsertBulk {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.UpdateTrends()
+	})
+}
+
+// ClearTrends clears the value of the "trends" field.
+func (u *DomainReportUpsertBulk) ClearTrends() *DomainReportUpsertBulk {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.ClearTrends()
+	})
+}
+
+// SetScore sets the "score" field.
+func (u *DomainReportUpsertBulk) SetScore(v int) *DomainReportUpsertBulk {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.SetScore(v)
+	})
+}
+
+// AddScore adds v to the "score" field.
+func (u *DomainReportUpsertBulk) AddScore(v int) *DomainReportUpsertBulk {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.AddScore(v)
+	})
+}
+
+// UpdateScore sets the "score" field to the value that was provided on create.
+func (u *DomainReportUpsertBulk) UpdateScore() *DomainReportUpsertBulk {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.UpdateScore()
+	})
+}
+
+// ClearScore clears the value of the "score" field.
+func (u *DomainReportUpsertBulk) ClearScore() *DomainReportUpsertBulk {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.ClearScore()
+	})
+}
+
+// SetLlmScore sets the "llm_score" field.
+func (u *DomainReportUpsertBulk) SetLlmScore(v int) *DomainReportUpsertBulk {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.SetLlmScore(v)
+	})
+}
+
+// AddLlmScore adds v to the "llm_score" field.
+func (u *DomainReportUpsertBulk) AddLlmScore(v int) *DomainReportUpsertBulk {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.AddLlmScore(v)
+	})
+}
+
+// UpdateLlmScore sets the "llm_score" field to the value that was provided on create.
+func (u *DomainReportUpsertBulk) UpdateLlmScore() *DomainReportUpsertBulk {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.UpdateLlmScore()
+	})
+}
+
+// ClearLlmScore clears the value of the "llm_score" field.
+func (u *DomainReportUpsertBulk) ClearLlmScore() *DomainReportUpsertBulk {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.ClearLlmScore()
+	})
+}
+
+// SetResultCount sets the "result_count" field.
+func (u *DomainReportUpsertBulk) SetResultCount(v int) *DomainReportUpsertBulk {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.SetResultCount(v)
+	})
+}
+
+// AddResultCount adds v to the "result_count" field.
+func (u *DomainReportUpsertBulk) AddResultCount(v int) *DomainReportUpsertBulk {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.AddResultCount(v)
+	})
+}
+
+// UpdateResultCount sets the "result_count" field to the value that was provided on create.
+func (u *DomainReportUpsertBulk) UpdateResultCount() *DomainReportUpsertBulk {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.UpdateResultCount()
+	})
+}
+
+// ClearResultCount clears the value of the "result_count" field.
+func (u *DomainReportUpsertBulk) ClearResultCount() *DomainReportUpsertBulk {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.ClearResultCount()
+	})
+}
+
+// SetHeatBaseline sets the "heat_baseline" field.
+func (u *DomainReportUpsertBulk) SetHeatBaseline(v float64) *DomainReportUpsertBulk {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.SetHeatBaseline(v)
+	})
+}
+
+// AddHeatBaseline adds v to the "heat_baseline" field.
+func (u *DomainReportUpsertBulk) AddHeatBaseline(v float64) *DomainReportUpsertBulk {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.AddHeatBaseline(v)
+	})
+}
+
+// UpdateHeatBaseline sets the "heat_baseline" field to the value that was provided on create.
+func (u *DomainReportUpsertBulk) UpdateHeatBaseline() *DomainReportUpsertBulk {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.UpdateHeatBaseline()
+	})
+}
+
+// ClearHeatBaseline clears the value of the "heat_baseline" field.
+func (u *DomainReportUpsertBulk) ClearHeatBaseline() *DomainReportUpsertBulk {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.ClearHeatBaseline()
+	})
+}
+
+// SetHeatVolume sets the "heat_volume" field.
+func (u *DomainReportUpsertBulk) SetHeatVolume(v float64) *DomainReportUpsertBulk {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.SetHeatVolume(v)
+	})
+}
+
+// AddHeatVolume adds v to the "heat_volume" field.
+func (u *DomainReportUpsertBulk) AddHeatVolume(v float64) *DomainReportUpsertBulk {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.AddHeatVolume(v)
+	})
+}
+
+// UpdateHeatVolume sets the "heat_volume" field to the value that was provided on create.
+func (u *DomainReportUpsertBulk) UpdateHeatVolume() *DomainReportUpsertBulk {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.UpdateHeatVolume()
+	})
+}
+
+// ClearHeatVolume clears the value of the "heat_volume" field.
+func (u *DomainReportUpsertBulk) ClearHeatVolume() *DomainReportUpsertBulk {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.ClearHeatVolume()
+	})
+}
+
+// SetHeatDiversity sets the "heat_diversity" field.
+func (u *DomainReportUpsertBulk) SetHeatDiversity(v float64) *DomainReportUpsertBulk {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.SetHeatDiversity(v)
+	})
+}
+
+// AddHeatDiversity adds v to the "heat_diversity" field.
+func (u *DomainReportUpsertBulk) AddHeatDiversity(v float64) *DomainReportUpsertBulk {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.AddHeatDiversity(v)
+	})
+}
+
+// UpdateHeatDiversity sets the "heat_diversity" field to the value that was provided on create.
+func (u *DomainReportUpsertBulk) UpdateHeatDiversity() *DomainReportUpsertBulk {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.UpdateHeatDiversity()
+	})
+}
+
+// ClearHeatDiversity clears the value of the "heat_diversity" field.
+func (u *DomainReportUpsertBulk) ClearHeatDiversity() *DomainReportUpsertBulk {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.ClearHeatDiversity()
+	})
+}
+
+// SetHeatRecency sets the "heat_recency" field.
+func (u *DomainReportUpsertBulk) SetHeatRecency(v float64) *DomainReportUpsertBulk {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.SetHeatRecency(v)
+	})
+}
+
+// AddHeatRecency adds v to the "heat_recency" field.
+func (u *DomainReportUpsertBulk) AddHeatRecency(v float64) *DomainReportUpsertBulk {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.AddHeatRecency(v)
+	})
+}
+
+// UpdateHeatRecency sets the "heat_recency" field to the value that was provided on create.
+func (u *DomainReportUpsertBulk) UpdateHeatRecency() *DomainReportUpsertBulk {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.UpdateHeatRecency()
+	})
+}
+
+// ClearHeatRecency clears the value of the "heat_recency" field.
+func (u *DomainReportUpsertBulk) ClearHeatRecency() *DomainReportUpsertBulk {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.ClearHeatRecency()
+	})
+}
+
+// SetHeatEngagement sets the "heat_engagement" field.
+func (u *DomainReportUpsertBulk) SetHeatEngagement(v float64) *DomainReportUpsertBulk {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.SetHeatEngagement(v)
+	})
+}
+
+// AddHeatEngagement adds v to the "heat_engagement" field.
+func (u *DomainReportUpsertBulk) AddHeatEngagement(v float64) *DomainReportUpsertBulk {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.AddHeatEngagement(v)
+	})
+}
+
+// UpdateHeatEngagement sets the "heat_engagement" field to the value that was provided on create.
+func (u *DomainReportUpsertBulk) UpdateHeatEngagement() *DomainReportUpsertBulk {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.UpdateHeatEngagement()
+	})
+}
+
+// ClearHeatEngagement clears the value of the "heat_engagement" field.
+func (u *DomainReportUpsertBulk) ClearHeatEngagement() *DomainReportUpsertBulk {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.ClearHeatEngagement()
+	})
+}
+
+// SetHeatSignificance sets the "heat_significance" field.
+func (u *DomainReportUpsertBulk) SetHeatSignificance(v float64) *DomainReportUpsertBulk {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.SetHeatSignificance(v)
+	})
+}
+
+// AddHeatSignificance adds v to the "heat_significance" field.
+func (u *DomainReportUpsertBulk) AddHeatSignificance(v float64) *DomainReportUpsertBulk {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.AddHeatSignificance(v)
+	})
+}
+
+// UpdateHeatSignificance sets the "heat_significance" field to the value that was provided on create.
+func (u *DomainReportUpsertBulk) UpdateHeatSignificance() *DomainReportUpsertBulk {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.UpdateHeatSignificance()
+	})
+}
+
+// ClearHeatSignificance clears the value of the "heat_significance" field.
+func (u *DomainReportUpsertBulk) ClearHeatSignificance() *DomainReportUpsertBulk {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.ClearHeatSignificance()
+	})
+}
+
+// SetHeatRaw sets the "heat_raw" field.
+func (u *DomainReportUpsertBulk) SetHeatRaw(v float64) *DomainReportUpsertBulk {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.SetHeatRaw(v)
+	})
+}
+
+// AddHeatRaw adds v to the "heat_raw" field.
+func (u *DomainReportUpsertBulk) AddHeatRaw(v float64) *DomainReportUpsertBulk {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.AddHeatRaw(v)
+	})
+}
+
+// UpdateHeatRaw sets the "heat_raw" field to the value that was provided on create.
+func (u *DomainReportUpsertBulk) UpdateHeatRaw() *DomainReportUpsertBulk {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.UpdateHeatRaw()
+	})
+}
+
+// ClearHeatRaw clears the value of the "heat_raw" field.
+func (u *DomainReportUpsertBulk) ClearHeatRaw() *DomainReportUpsertBulk {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.ClearHeatRaw()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *DomainReportUpsertBulk) SetCreatedAt(v time.Time) *DomainReportUpsertBulk {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *DomainReportUpsertBulk) UpdateCreatedAt() *DomainReportUpsertBulk {
+	return u.Update(func(s *DomainReportUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *DomainReportUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the DomainReportCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DomainReportCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DomainReportUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
@@ -13,6 +13,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/iWorld-y/domain_radar/app/common/ent/article"
+	"github.com/iWorld-y/domain_radar/app/common/ent/claimverification"
 	"github.com/iWorld-y/domain_radar/app/common/ent/domainreport"
 	"github.com/iWorld-y/domain_radar/app/common/ent/keyevent"
 	"github.com/iWorld-y/domain_radar/app/common/ent/predicate"
@@ -22,14 +23,16 @@ import (
 // DomainReportQuery is the builder for querying DomainReport entities.
 type DomainReportQuery struct {
 	config
-	ctx           *QueryContext
-	order         []domainreport.OrderOption
-	inters        []Interceptor
-	predicates    []predicate.DomainReport
-	withReportRun *ReportRunQuery
-	withArticles  *ArticleQuery
-	withKeyEvents *KeyEventQuery
-	modifiers     []func(*sql.Selector)
+	ctx                    *QueryContext
+	order                  []domainreport.OrderOption
+	inters                 []Interceptor
+	predicates             []predicate.DomainReport
+	withSourceRun          *ReportRunQuery
+	withReportRuns         *ReportRunQuery
+	withArticles           *ArticleQuery
+	withKeyEvents          *KeyEventQuery
+	withClaimVerifications *ClaimVerificationQuery
+	modifiers              []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -66,8 +69,8 @@ func (_q *DomainReportQuery) Order(o ...domainreport.OrderOption) *DomainReportQ
 	return _q
 }
 
-// QueryReportRun chains the current query on the "report_run" edge.
-func (_q *DomainReportQuery) QueryReportRun() *ReportRunQuery {
+// QuerySourceRun chains the current query on the "source_run" edge.
+func (_q *DomainReportQuery) QuerySourceRun() *ReportRunQuery {
 	query := (&ReportRunClient{config: _q.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := _q.prepareQuery(ctx); err != nil {
@@ -80,7 +83,29 @@ func (_q *DomainReportQuery) QueryReportRun() *ReportRunQuery {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(domainreport.Table, domainreport.FieldID, selector),
 			sqlgraph.To(reportrun.Table, reportrun.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, domainreport.ReportRunTable, domainreport.ReportRunColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, domainreport.SourceRunTable, domainreport.SourceRunColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryReportRuns chains the current query on the "report_runs" edge.
+func (_q *DomainReportQuery) QueryReportRuns() *ReportRunQuery {
+	query := (&ReportRunClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(domainreport.Table, domainreport.FieldID, selector),
+			sqlgraph.To(reportrun.Table, reportrun.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, domainreport.ReportRunsTable, domainreport.ReportRunsPrimaryKey...),
 		)
 		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
 		return fromU, nil
@@ -132,6 +157,28 @@ func (_q *DomainReportQuery) QueryKeyEvents() *KeyEventQuery {
 	return query
 }
 
+// QueryClaimVerifications chains the current query on the "claim_verifications" edge.
+func (_q *DomainReportQuery) QueryClaimVerifications() *ClaimVerificationQuery {
+	query := (&ClaimVerificationClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(domainreport.Table, domainreport.FieldID, selector),
+			sqlgraph.To(claimverification.Table, claimverification.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, domainreport.ClaimVerificationsTable, domainreport.ClaimVerificationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first DomainReport entity from the query.
 // Returns a *NotFoundError when no DomainReport was found.
 func (_q *DomainReportQuery) First(ctx context.Context) (*DomainReport, error) {
@@ -319,14 +366,16 @@ func (_q *DomainReportQuery) Clone() *DomainReportQuery {
 		return nil
 	}
 	return &DomainReportQuery{
-		config:        _q.config,
-		ctx:           _q.ctx.Clone(),
-		order:         append([]domainreport.OrderOption{}, _q.order...),
-		inters:        append([]Interceptor{}, _q.inters...),
-		predicates:    append([]predicate.DomainReport{}, _q.predicates...),
-		withReportRun: _q.withReportRun.Clone(),
-		withArticles:  _q.withArticles.Clone(),
-		withKeyEvents: _q.withKeyEvents.Clone(),
+		config:                 _q.config,
+		ctx:                    _q.ctx.Clone(),
+		order:                  append([]domainreport.OrderOption{}, _q.order...),
+		inters:                 append([]Interceptor{}, _q.inters...),
+		predicates:             append([]predicate.DomainReport{}, _q.predicates...),
+		withSourceRun:          _q.withSourceRun.Clone(),
+		withReportRuns:         _q.withReportRuns.Clone(),
+		withArticles:           _q.withArticles.Clone(),
+		withKeyEvents:          _q.withKeyEvents.Clone(),
+		withClaimVerifications: _q.withClaimVerifications.Clone(),
 		// clone intermediate query.
 		sql:       _q.sql.Clone(),
 		path:      _q.path,
@@ -334,14 +383,25 @@ func (_q *DomainReportQuery) Clone() *DomainReportQuery {
 	}
 }
 
-// WithReportRun tells the query-builder to eager-load the nodes that are connected to
-// the "report_run" edge. The optional arguments are used to configure the query builder of the edge.
-func (_q *DomainReportQuery) WithReportRun(opts ...func(*ReportRunQuery)) *DomainReportQuery {
+// WithSourceRun tells the query-builder to eager-load the nodes that are connected to
+// the "source_run" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *DomainReportQuery) WithSourceRun(opts ...func(*ReportRunQuery)) *DomainReportQuery {
+	query := (&ReportRunClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withSourceRun = query
+	return _q
+}
+
+// WithReportRuns tells the query-builder to eager-load the nodes that are connected to
+// the "report_runs" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *DomainReportQuery) WithReportRuns(opts ...func(*ReportRunQuery)) *DomainReportQuery {
 	query := (&ReportRunClient{config: _q.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
-	_q.withReportRun = query
+	_q.withReportRuns = query
 	return _q
 }
 
@@ -367,6 +427,17 @@ func (_q *DomainReportQuery) WithKeyEvents(opts ...func(*KeyEventQuery)) *Domain
 	return _q
 }
 
+// WithClaimVerifications tells the query-builder to eager-load the nodes that are connected to
+// the "claim_verifications" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *DomainReportQuery) WithClaimVerifications(opts ...func(*ClaimVerificationQuery)) *DomainReportQuery {
+	query := (&ClaimVerificationClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withClaimVerifications = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -445,10 +516,12 @@ func (_q *DomainReportQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 	var (
 		nodes       = []*DomainReport{}
 		_spec       = _q.querySpec()
-		loadedTypes = [3]bool{
-			_q.withReportRun != nil,
+		loadedTypes = [5]bool{
+			_q.withSourceRun != nil,
+			_q.withReportRuns != nil,
 			_q.withArticles != nil,
 			_q.withKeyEvents != nil,
+			_q.withClaimVerifications != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -472,9 +545,16 @@ func (_q *DomainReportQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
-	if query := _q.withReportRun; query != nil {
-		if err := _q.loadReportRun(ctx, query, nodes, nil,
-			func(n *DomainReport, e *ReportRun) { n.Edges.ReportRun = e }); err != nil {
+	if query := _q.withSourceRun; query != nil {
+		if err := _q.loadSourceRun(ctx, query, nodes, nil,
+			func(n *DomainReport, e *ReportRun) { n.Edges.SourceRun = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withReportRuns; query != nil {
+		if err := _q.loadReportRuns(ctx, query, nodes,
+			func(n *DomainReport) { n.Edges.ReportRuns = []*ReportRun{} },
+			func(n *DomainReport, e *ReportRun) { n.Edges.ReportRuns = append(n.Edges.ReportRuns, e) }); err != nil {
 			return nil, err
 		}
 	}
@@ -492,10 +572,19 @@ func (_q *DomainReportQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 			return nil, err
 		}
 	}
+	if query := _q.withClaimVerifications; query != nil {
+		if err := _q.loadClaimVerifications(ctx, query, nodes,
+			func(n *DomainReport) { n.Edges.ClaimVerifications = []*ClaimVerification{} },
+			func(n *DomainReport, e *ClaimVerification) {
+				n.Edges.ClaimVerifications = append(n.Edges.ClaimVerifications, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
-func (_q *DomainReportQuery) loadReportRun(ctx context.Context, query *ReportRunQuery, nodes []*DomainReport, init func(*DomainReport), assign func(*DomainReport, *ReportRun)) error {
+func (_q *DomainReportQuery) loadSourceRun(ctx context.Context, query *ReportRunQuery, nodes []*DomainReport, init func(*DomainReport), assign func(*DomainReport, *ReportRun)) error {
 	ids := make([]int, 0, len(nodes))
 	nodeids := make(map[int][]*DomainReport)
 	for i := range nodes {
@@ -524,6 +613,67 @@ func (_q *DomainReportQuery) loadReportRun(ctx context.Context, query *ReportRun
 	}
 	return nil
 }
+func (_q *DomainReportQuery) loadReportRuns(ctx context.Context, query *ReportRunQuery, nodes []*DomainReport, init func(*DomainReport), assign func(*DomainReport, *ReportRun)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[int]*DomainReport)
+	nids := make(map[int]map[*DomainReport]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(domainreport.ReportRunsTable)
+		s.Join(joinT).On(s.C(reportrun.FieldID), joinT.C(domainreport.ReportRunsPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(domainreport.ReportRunsPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(domainreport.ReportRunsPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := int(values[0].(*sql.NullInt64).Int64)
+				inValue := int(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*DomainReport]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*ReportRun](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "report_runs" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
 func (_q *DomainReportQuery) loadArticles(ctx context.Context, query *ArticleQuery, nodes []*DomainReport, init func(*DomainReport), assign func(*DomainReport, *Article)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[int]*DomainReport)
@@ -584,6 +734,36 @@ func (_q *DomainReportQuery) loadKeyEvents(ctx context.Context, query *KeyEventQ
 	}
 	return nil
 }
+func (_q *DomainReportQuery) loadClaimVerifications(ctx context.Context, query *ClaimVerificationQuery, nodes []*DomainReport, init func(*DomainReport), assign func(*DomainReport, *ClaimVerification)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*DomainReport)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(claimverification.FieldDomainReportID)
+	}
+	query.Where(predicate.ClaimVerification(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(domainreport.ClaimVerificationsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.DomainReportID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "domain_report_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *DomainReportQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -613,7 +793,7 @@ func (_q *DomainReportQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
-		if _q.withReportRun != nil {
+		if _q.withSourceRun != nil {
 			_spec.Node.AddColumnOnce(domainreport.FieldRunID)
 		}
 	}
@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/iWorld-y/domain_radar/app/common/ent/article"
+	"github.com/iWorld-y/domain_radar/app/common/ent/claimverification"
 	"github.com/iWorld-y/domain_radar/app/common/ent/domainreport"
 	"github.com/iWorld-y/domain_radar/app/common/ent/keyevent"
 	"github.com/iWorld-y/domain_radar/app/common/ent/predicate"
@@ -52,6 +53,26 @@ func (_u *DomainReportUpdate) ClearRunID() *DomainReportUpdate {
 	return _u
 }
 
+// SetShareKey sets the "share_key" field.
+func (_u *DomainReportUpdate) SetShareKey(v string) *DomainReportUpdate {
+	_u.mutation.SetShareKey(v)
+	return _u
+}
+
+// SetNillableShareKey sets the "share_key" field if the given value is not nil.
+func (_u *DomainReportUpdate) SetNillableShareKey(v *string) *DomainReportUpdate {
+	if v != nil {
+		_u.SetShareKey(*v)
+	}
+	return _u
+}
+
+// ClearShareKey clears the value of the "share_key" field.
+func (_u *DomainReportUpdate) ClearShareKey() *DomainReportUpdate {
+	_u.mutation.ClearShareKey()
+	return _u
+}
+
 // SetDomainName sets the "domain_name" field.
 func (_u *DomainReportUpdate) SetDomainName(v string) *DomainReportUpdate {
 	_u.mutation.SetDomainName(v)
@@ -133,6 +154,249 @@ func (_u *DomainReportUpdate) ClearScore() *DomainReportUpdate {
 	return _u
 }
 
+// SetLlmScore sets the "llm_score" field.
+func (_u *DomainReportUpdate) SetLlmScore(v int) *DomainReportUpdate {
+	_u.mutation.ResetLlmScore()
+	_u.mutation.SetLlmScore(v)
+	return _u
+}
+
+// SetNillableLlmScore sets the "llm_score" field if the given value is not nil.
+func (_u *DomainReportUpdate) SetNillableLlmScore(v *int) *DomainReportUpdate {
+	if v != nil {
+		_u.SetLlmScore(*v)
+	}
+	return _u
+}
+
+// AddLlmScore adds value to the "llm_score" field.
+func (_u *DomainReportUpdate) AddLlmScore(v int) *DomainReportUpdate {
+	_u.mutation.AddLlmScore(v)
+	return _u
+}
+
+// ClearLlmScore clears the value of the "llm_score" field.
+func (_u *DomainReportUpdate) ClearLlmScore() *DomainReportUpdate {
+	_u.mutation.ClearLlmScore()
+	return _u
+}
+
+// SetResultCount sets the "result_count" field.
+func (_u *DomainReportUpdate) SetResultCount(v int) *DomainReportUpdate {
+	_u.mutation.ResetResultCount()
+	_u.mutation.SetResultCount(v)
+	return _u
+}
+
+// SetNillableResultCount sets the "result_count" field if the given value is not nil.
+func (_u *DomainReportUpdate) SetNillableResultCount(v *int) *DomainReportUpdate {
+	if v != nil {
+		_u.SetResultCount(*v)
+	}
+	return _u
+}
+
+// AddResultCount adds value to the "result_count" field.
+func (_u *DomainReportUpdate) AddResultCount(v int) *DomainReportUpdate {
+	_u.mutation.AddResultCount(v)
+	return _u
+}
+
+// ClearResultCount clears the value of the "result_count" field.
+func (_u *DomainReportUpdate) ClearResultCount() *DomainReportUpdate {
+	_u.mutation.ClearResultCount()
+	return _u
+}
+
+// SetHeatBaseline sets the "heat_baseline" field.
+func (_u *DomainReportUpdate) SetHeatBaseline(v float64) *DomainReportUpdate {
+	_u.mutation.ResetHeatBaseline()
+	_u.mutation.SetHeatBaseline(v)
+	return _u
+}
+
+// SetNillableHeatBaseline sets the "heat_baseline" field if the given value is not nil.
+func (_u *DomainReportUpdate) SetNillableHeatBaseline(v *float64) *DomainReportUpdate {
+	if v != nil {
+		_u.SetHeatBaseline(*v)
+	}
+	return _u
+}
+
+// AddHeatBaseline adds value to the "heat_baseline" field.
+func (_u *DomainReportUpdate) AddHeatBaseline(v float64) *DomainReportUpdate {
+	_u.mutation.AddHeatBaseline(v)
+	return _u
+}
+
+// ClearHeatBaseline clears the value of the "heat_baseline" field.
+func (_u *DomainReportUpdate) ClearHeatBaseline() *DomainReportUpdate {
+	_u.mutation.ClearHeatBaseline()
+	return _u
+}
+
+// SetHeatVolume sets the "heat_volume" field.
+func (_u *DomainReportUpdate) SetHeatVolume(v float64) *DomainReportUpdate {
+	_u.mutation.ResetHeatVolume()
+	_u.mutation.SetHeatVolume(v)
+	return _u
+}
+
+// SetNillableHeatVolume sets the "heat_volume" field if the given value is not nil.
+func (_u *DomainReportUpdate) SetNillableHeatVolume(v *float64) *DomainReportUpdate {
+	if v != nil {
+		_u.SetHeatVolume(*v)
+	}
+	return _u
+}
+
+// AddHeatVolume adds value to the "heat_volume" field.
+func (_u *DomainReportUpdate) AddHeatVolume(v float64) *DomainReportUpdate {
+	_u.mutation.AddHeatVolume(v)
+	return _u
+}
+
+// ClearHeatVolume clears the value of the "heat_volume" field.
+func (_u *DomainReportUpdate) ClearHeatVolume() *DomainReportUpdate {
+	_u.mutation.ClearHeatVolume()
+	return _u
+}
+
+// SetHeatDiversity sets the "heat_diversity" field.
+func (_u *DomainReportUpdate) SetHeatDiversity(v float64) *DomainReportUpdate {
+	_u.mutation.ResetHeatDiversity()
+	_u.mutation.SetHeatDiversity(v)
+	return _u
+}
+
+// SetNillableHeatDiversity sets the "heat_diversity" field if the given value is not nil.
+func (_u *DomainReportUpdate) SetNillableHeatDiversity(v *float64) *DomainReportUpdate {
+	if v != nil {
+		_u.SetHeatDiversity(*v)
+	}
+	return _u
+}
+
+// AddHeatDiversity adds value to the "heat_diversity" field.
+func (_u *DomainReportUpdate) AddHeatDiversity(v float64) *DomainReportUpdate {
+	_u.mutation.AddHeatDiversity(v)
+	return _u
+}
+
+// ClearHeatDiversity clears the value of the "heat_diversity" field.
+func (_u *DomainReportUpdate) ClearHeatDiversity() *DomainReportUpdate {
+	_u.mutation.ClearHeatDiversity()
+	return _u
+}
+
+// SetHeatRecency sets the "heat_recency" field.
+func (_u *DomainReportUpdate) SetHeatRecency(v float64) *DomainReportUpdate {
+	_u.mutation.ResetHeatRecency()
+	_u.mutation.SetHeatRecency(v)
+	return _u
+}
+
+// SetNillableHeatRecency sets the "heat_recency" field if the given value is not nil.
+func (_u *DomainReportUpdate) SetNillableHeatRecency(v *float64) *DomainReportUpdate {
+	if v != nil {
+		_u.SetHeatRecency(*v)
+	}
+	return _u
+}
+
+// AddHeatRecency adds value to the "heat_recency" field.
+func (_u *DomainReportUpdate) AddHeatRecency(v float64) *DomainReportUpdate {
+	_u.mutation.AddHeatRecency(v)
+	return _u
+}
+
+// ClearHeatRecency clears the value of the "heat_recency" field.
+func (_u *DomainReportUpdate) ClearHeatRecency() *DomainReportUpdate {
+	_u.mutation.ClearHeatRecency()
+	return _u
+}
+
+// SetHeatEngagement sets the "heat_engagement" field.
+func (_u *DomainReportUpdate) SetHeatEngagement(v float64) *DomainReportUpdate {
+	_u.mutation.ResetHeatEngagement()
+	_u.mutation.SetHeatEngagement(v)
+	return _u
+}
+
+// SetNillableHeatEngagement sets the "heat_engagement" field if the given value is not nil.
+func (_u *DomainReportUpdate) SetNillableHeatEngagement(v *float64) *DomainReportUpdate {
+	if v != nil {
+		_u.SetHeatEngagement(*v)
+	}
+	return _u
+}
+
+// AddHeatEngagement adds value to the "heat_engagement" field.
+func (_u *DomainReportUpdate) AddHeatEngagement(v float64) *DomainReportUpdate {
+	_u.mutation.AddHeatEngagement(v)
+	return _u
+}
+
+// ClearHeatEngagement clears the value of the "heat_engagement" field.
+func (_u *DomainReportUpdate) ClearHeatEngagement() *DomainReportUpdate {
+	_u.mutation.ClearHeatEngagement()
+	return _u
+}
+
+// SetHeatSignificance sets the "heat_significance" field.
+func (_u *DomainReportUpdate) SetHeatSignificance(v float64) *DomainReportUpdate {
+	_u.mutation.ResetHeatSignificance()
+	_u.mutation.SetHeatSignificance(v)
+	return _u
+}
+
+// SetNillableHeatSignificance sets the "heat_significance" field if the given value is not nil.
+func (_u *DomainReportUpdate) SetNillableHeatSignificance(v *float64) *DomainReportUpdate {
+	if v != nil {
+		_u.SetHeatSignificance(*v)
+	}
+	return _u
+}
+
+// AddHeatSignificance adds value to the "heat_significance" field.
+func (_u *DomainReportUpdate) AddHeatSignificance(v float64) *DomainReportUpdate {
+	_u.mutation.AddHeatSignificance(v)
+	return _u
+}
+
+// ClearHeatSignificance clears the value of the "heat_significance" field.
+func (_u *DomainReportUpdate) ClearHeatSignificance() *DomainReportUpdate {
+	_u.mutation.ClearHeatSignificance()
+	return _u
+}
+
+// SetHeatRaw sets the "heat_raw" field.
+func (_u *DomainReportUpdate) SetHeatRaw(v float64) *DomainReportUpdate {
+	_u.mutation.ResetHeatRaw()
+	_u.mutation.SetHeatRaw(v)
+	return _u
+}
+
+// SetNillableHeatRaw sets the "heat_raw" field if the given value is not nil.
+func (_u *DomainReportUpdate) SetNillableHeatRaw(v *float64) *DomainReportUpdate {
+	if v != nil {
+		_u.SetHeatRaw(*v)
+	}
+	return _u
+}
+
+// AddHeatRaw adds value to the "heat_raw" field.
+func (_u *DomainReportUpdate) AddHeatRaw(v float64) *DomainReportUpdate {
+	_u.mutation.AddHeatRaw(v)
+	return _u
+}
+
+// ClearHeatRaw clears the value of the "heat_raw" field.
+func (_u *DomainReportUpdate) ClearHeatRaw() *DomainReportUpdate {
+	_u.mutation.ClearHeatRaw()
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *DomainReportUpdate) SetCreatedAt(v time.Time) *DomainReportUpdate {
 	_u.mutation.SetCreatedAt(v)
@@ -147,23 +411,38 @@ func (_u *DomainReportUpdate) SetNillableCreatedAt(v *time.Time) *DomainReportUp
 	return _u
 }
 
-// SetReportRunID sets the "report_run" edge to the ReportRun entity by ID.
-func (_u *DomainReportUpdate) SetReportRunID(id int) *DomainReportUpdate {
-	_u.mutation.SetReportRunID(id)
+// SetSourceRunID sets the "source_run" edge to the ReportRun entity by ID.
+func (_u *DomainReportUpdate) SetSourceRunID(id int) *DomainReportUpdate {
+	_u.mutation.SetSourceRunID(id)
 	return _u
 }
 
-// SetNillableReportRunID sets the "report_run" edge to the ReportRun entity by ID if the given value is not nil.
-func (_u *DomainReportUpdate) SetNillableReportRunID(id *int) *DomainReportUpdate {
+// SetNillableSourceRunID sets the "source_run" edge to the ReportRun entity by ID if the given value is not nil.
+func (_u *DomainReportUpdate) SetNillableSourceRunID(id *int) *DomainReportUpdate {
 	if id != nil {
-		_u = _u.SetReportRunID(*id)
+		_u = _u.SetSourceRunID(*id)
 	}
 	return _u
 }
 
-// SetReportRun sets the "report_run" edge to the ReportRun entity.
-func (_u *DomainReportUpdate) SetReportRun(v *ReportRun) *DomainReportUpdate {
-	return _u.SetReportRunID(v.ID)
+// SetSourceRun sets the "source_run" edge to the ReportRun entity.
+func (_u *DomainReportUpdate) SetSourceRun(v *ReportRun) *DomainReportUpdate {
+	return _u.SetSourceRunID(v.ID)
+}
+
+// AddReportRunIDs adds the "report_runs" edge to the ReportRun entity by IDs.
+func (_u *DomainReportUpdate) AddReportRunIDs(ids ...int) *DomainReportUpdate {
+	_u.mutation.AddReportRunIDs(ids...)
+	return _u
+}
+
+// AddReportRuns adds the "report_runs" edges to the ReportRun entity.
+func (_u *DomainReportUpdate) AddReportRuns(v ...*ReportRun) *DomainReportUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReportRunIDs(ids...)
 }
 
 // AddArticleIDs adds the "articles" edge to the Article entity by IDs.
@@ -196,17 +475,53 @@ func (_u *DomainReportUpdate) AddKeyEvents(v ...*KeyEvent) *DomainReportUpdate {
 	return _u.AddKeyEventIDs(ids...)
 }
 
+// AddClaimVerificationIDs adds the "claim_verifications" edge to the ClaimVerification entity by IDs.
+func (_u *DomainReportUpdate) AddClaimVerificationIDs(ids ...int) *DomainReportUpdate {
+	_u.mutation.AddClaimVerificationIDs(ids...)
+	return _u
+}
+
+// AddClaimVerifications adds the "claim_verifications" edges to the ClaimVerification entity.
+func (_u *DomainReportUpdate) AddClaimVerifications(v ...*ClaimVerification) *DomainReportUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddClaimVerificationIDs(ids...)
+}
+
 // Mutation returns the DomainReportMutation object of the builder.
 func (_u *DomainReportUpdate) Mutation() *DomainReportMutation {
 	return _u.mutation
 }
 
-// ClearReportRun clears the "report_run" edge to the ReportRun entity.
-func (_u *DomainReportUpdate) ClearReportRun() *DomainReportUpdate {
-	_u.mutation.ClearReportRun()
+// ClearSourceRun clears the "source_run" edge to the ReportRun entity.
+func (_u *DomainReportUpdate) ClearSourceRun() *DomainReportUpdate {
+	_u.mutation.ClearSourceRun()
+	return _u
+}
+
+// ClearReportRuns clears all "report_runs" edges to the ReportRun entity.
+func (_u *DomainReportUpdate) ClearReportRuns() *DomainReportUpdate {
+	_u.mutation.ClearReportRuns()
+	return _u
+}
+
+// RemoveReportRunIDs removes the "report_runs" edge to ReportRun entities by IDs.
+func (_u *DomainReportUpdate) RemoveReportRunIDs(ids ...int) *DomainReportUpdate {
+	_u.mutation.RemoveReportRunIDs(ids...)
 	return _u
 }
 
+// RemoveReportRuns removes "report_runs" edges to ReportRun entities.
+func (_u *DomainReportUpdate) RemoveReportRuns(v ...*ReportRun) *DomainReportUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReportRunIDs(ids...)
+}
+
 // ClearArticles clears all "articles" edges to the Article entity.
 func (_u *DomainReportUpdate) ClearArticles() *DomainReportUpdate {
 	_u.mutation.ClearArticles()
@@ -249,6 +564,27 @@ func (_u *DomainReportUpdate) RemoveKeyEvents(v ...*KeyEvent) *DomainReportUpdat
 	return _u.RemoveKeyEventIDs(ids...)
 }
 
+// ClearClaimVerifications clears all "claim_verifications" edges to the ClaimVerification entity.
+func (_u *DomainReportUpdate) ClearClaimVerifications() *DomainReportUpdate {
+	_u.mutation.ClearClaimVerifications()
+	return _u
+}
+
+// RemoveClaimVerificationIDs removes the "claim_verifications" edge to ClaimVerification entities by IDs.
+func (_u *DomainReportUpdate) RemoveClaimVerificationIDs(ids ...int) *DomainReportUpdate {
+	_u.mutation.RemoveClaimVerificationIDs(ids...)
+	return _u
+}
+
+// RemoveClaimVerifications removes "claim_verifications" edges to ClaimVerification entities.
+func (_u *DomainReportUpdate) RemoveClaimVerifications(v ...*ClaimVerification) *DomainReportUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveClaimVerificationIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *DomainReportUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -291,6 +627,12 @@ func (_u *DomainReportUpdate) sqlSave(ctx context.Context) (_node int, err error
 			}
 		}
 	}
+	if value, ok := _u.mutation.ShareKey(); ok {
+		_spec.SetField(domainreport.FieldShareKey, field.TypeString, value)
+	}
+	if _u.mutation.ShareKeyCleared() {
+		_spec.ClearField(domainreport.FieldShareKey, field.TypeString)
+	}
 	if value, ok := _u.mutation.DomainName(); ok {
 		_spec.SetField(domainreport.FieldDomainName, field.TypeString, value)
 	}
@@ -315,15 +657,96 @@ func (_u *DomainReportUpdate) sqlSave(ctx context.Context) (_node int, err error
 	if _u.mutation.ScoreCleared() {
 		_spec.ClearField(domainreport.FieldScore, field.TypeInt)
 	}
+	if value, ok := _u.mutation.LlmScore(); ok {
+		_spec.SetField(domainreport.FieldLlmScore, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLlmScore(); ok {
+		_spec.AddField(domainreport.FieldLlmScore, field.TypeInt, value)
+	}
+	if _u.mutation.LlmScoreCleared() {
+		_spec.ClearField(domainreport.FieldLlmScore, field.TypeInt)
+	}
+	if value, ok := _u.mutation.ResultCount(); ok {
+		_spec.SetField(domainreport.FieldResultCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedResultCount(); ok {
+		_spec.AddField(domainreport.FieldResultCount, field.TypeInt, value)
+	}
+	if _u.mutation.ResultCountCleared() {
+		_spec.ClearField(domainreport.FieldResultCount, field.TypeInt)
+	}
+	if value, ok := _u.mutation.HeatBaseline(); ok {
+		_spec.SetField(domainreport.FieldHeatBaseline, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedHeatBaseline(); ok {
+		_spec.AddField(domainreport.FieldHeatBaseline, field.TypeFloat64, value)
+	}
+	if _u.mutation.HeatBaselineCleared() {
+		_spec.ClearField(domainreport.FieldHeatBaseline, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.HeatVolume(); ok {
+		_spec.SetField(domainreport.FieldHeatVolume, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedHeatVolume(); ok {
+		_spec.AddField(domainreport.FieldHeatVolume, field.TypeFloat64, value)
+	}
+	if _u.mutation.HeatVolumeCleared() {
+		_spec.ClearField(domainreport.FieldHeatVolume, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.HeatDiversity(); ok {
+		_spec.SetField(domainreport.FieldHeatDiversity, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedHeatDiversity(); ok {
+		_spec.AddField(domainreport.FieldHeatDiversity, field.TypeFloat64, value)
+	}
+	if _u.mutation.HeatDiversityCleared() {
+		_spec.ClearField(domainreport.FieldHeatDiversity, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.HeatRecency(); ok {
+		_spec.SetField(domainreport.FieldHeatRecency, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedHeatRecency(); ok {
+		_spec.AddField(domainreport.FieldHeatRecency, field.TypeFloat64, value)
+	}
+	if _u.mutation.HeatRecencyCleared() {
+		_spec.ClearField(domainreport.FieldHeatRecency, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.HeatEngagement(); ok {
+		_spec.SetField(domainreport.FieldHeatEngagement, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedHeatEngagement(); ok {
+		_spec.AddField(domainreport.FieldHeatEngagement, field.TypeFloat64, value)
+	}
+	if _u.mutation.HeatEngagementCleared() {
+		_spec.ClearField(domainreport.FieldHeatEngagement, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.HeatSignificance(); ok {
+		_spec.SetField(domainreport.FieldHeatSignificance, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedHeatSignificance(); ok {
+		_spec.AddField(domainreport.FieldHeatSignificance, field.TypeFloat64, value)
+	}
+	if _u.mutation.HeatSignificanceCleared() {
+		_spec.ClearField(domainreport.FieldHeatSignificance, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.HeatRaw(); ok {
+		_spec.SetField(domainreport.FieldHeatRaw, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedHeatRaw(); ok {
+		_spec.AddField(domainreport.FieldHeatRaw, field.TypeFloat64, value)
+	}
+	if _u.mutation.HeatRawCleared() {
+		_spec.ClearField(domainreport.FieldHeatRaw, field.TypeFloat64)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(domainreport.FieldCreatedAt, field.TypeTime, value)
 	}
-	if _u.mutation.ReportRunCleared() {
+	if _u.mutation.SourceRunCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
 			Inverse: true,
-			Table:   domainreport.ReportRunTable,
-			Columns: []string{domainreport.ReportRunColumn},
+			Table:   domainreport.SourceRunTable,
+			Columns: []string{domainreport.SourceRunColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(reportrun.FieldID, field.TypeInt),
@@ -331,12 +754,57 @@ func (_u *DomainReportUpdate) sqlSave(ctx context.Context) (_node int, err error
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.ReportRunIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.SourceRunIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
 			Inverse: true,
-			Table:   domainreport.ReportRunTable,
-			Columns: []string{domainreport.ReportRunColumn},
+			Table:   domainreport.SourceRunTable,
+			Columns: []string{domainreport.SourceRunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reportrun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReportRunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   domainreport.ReportRunsTable,
+			Columns: domainreport.ReportRunsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reportrun.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedReportRunsIDs(); len(nodes) > 0 && !_u.mutation.ReportRunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   domainreport.ReportRunsTable,
+			Columns: domainreport.ReportRunsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reportrun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReportRunsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   domainreport.ReportRunsTable,
+			Columns: domainreport.ReportRunsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(reportrun.FieldID, field.TypeInt),
@@ -437,126 +905,434 @@ func (_u *DomainReportUpdate) sqlSave(ctx context.Context) (_node int, err error
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	_spec.AddModifiers(_u.modifiers...)
-	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{domainreport.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
-		}
-		return 0, err
+	if _u.mutation.ClaimVerificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   domainreport.ClaimVerificationsTable,
+			Columns: []string{domainreport.ClaimVerificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(claimverification.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedClaimVerificationsIDs(); len(nodes) > 0 && !_u.mutation.ClaimVerificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   domainreport.ClaimVerificationsTable,
+			Columns: []string{domainreport.ClaimVerificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(claimverification.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ClaimVerificationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   domainreport.ClaimVerificationsTable,
+			Columns: []string{domainreport.ClaimVerificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(claimverification.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{domainreport.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// DomainReportUpdateOne is the builder for updating a single DomainReport entity.
+type DomainReportUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *DomainReportMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetRunID sets the "run_id" field.
+func (_u *DomainReportUpdateOne) SetRunID(v int) *DomainReportUpdateOne {
+	_u.mutation.SetRunID(v)
+	return _u
+}
+
+// SetNillableRunID sets the "run_id" field if the given value is not nil.
+func (_u *DomainReportUpdateOne) SetNillableRunID(v *int) *DomainReportUpdateOne {
+	if v != nil {
+		_u.SetRunID(*v)
+	}
+	return _u
+}
+
+// ClearRunID clears the value of the "run_id" field.
+func (_u *DomainReportUpdateOne) ClearRunID() *DomainReportUpdateOne {
+	_u.mutation.ClearRunID()
+	return _u
+}
+
+// SetShareKey sets the "share_key" field.
+func (_u *DomainReportUpdateOne) SetShareKey(v string) *DomainReportUpdateOne {
+	_u.mutation.SetShareKey(v)
+	return _u
+}
+
+// SetNillableShareKey sets the "share_key" field if the given value is not nil.
+func (_u *DomainReportUpdateOne) SetNillableShareKey(v *string) *DomainReportUpdateOne {
+	if v != nil {
+		_u.SetShareKey(*v)
+	}
+	return _u
+}
+
+// ClearShareKey clears the value of the "share_key" field.
+func (_u *DomainReportUpdateOne) ClearShareKey() *DomainReportUpdateOne {
+	_u.mutation.ClearShareKey()
+	return _u
+}
+
+// SetDomainName sets the "domain_name" field.
+func (_u *DomainReportUpdateOne) SetDomainName(v string) *DomainReportUpdateOne {
+	_u.mutation.SetDomainName(v)
+	return _u
+}
+
+// SetNillableDomainName sets the "domain_name" field if the given value is not nil.
+func (_u *DomainReportUpdateOne) SetNillableDomainName(v *string) *DomainReportUpdateOne {
+	if v != nil {
+		_u.SetDomainName(*v)
+	}
+	return _u
+}
+
+// SetOverview sets the "overview" field.
+func (_u *DomainReportUpdateOne) SetOverview(v string) *DomainReportUpdateOne {
+	_u.mutation.SetOverview(v)
+	return _u
+}
+
+// SetNillableOverview sets the "overview" field if the given value is not nil.
+func (_u *DomainReportUpdateOne) SetNillableOverview(v *string) *DomainReportUpdateOne {
+	if v != nil {
+		_u.SetOverview(*v)
+	}
+	return _u
+}
+
+// ClearOverview clears the value of the "overview" field.
+func (_u *DomainReportUpdateOne) ClearOverview() *DomainReportUpdateOne {
+	_u.mutation.ClearOverview()
+	return _u
+}
+
+// SetTrends sets the "trends" field.
+func (_u *DomainReportUpdateOne) SetTrends(v string) *DomainReportUpdateOne {
+	_u.mutation.SetTrends(v)
+	return _u
+}
+
+// SetNillableTrends sets the "trends" field if the given value is not nil.
+func (_u *DomainReportUpdateOne) SetNillableTrends(v *string) *DomainReportUpdateOne {
+	if v != nil {
+		_u.SetTrends(*v)
+	}
+	return _u
+}
+
+// ClearTrends clears the value of the "trends" field.
+func (_u *DomainReportUpdateOne) ClearTrends() *DomainReportUpdateOne {
+	_u.mutation.ClearTrends()
+	return _u
+}
+
+// SetScore sets the "score" field.
+func (_u *DomainReportUpdateOne) SetScore(v int) *DomainReportUpdateOne {
+	_u.mutation.ResetScore()
+	_u.mutation.SetScore(v)
+	return _u
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (_u *DomainReportUpdateOne) SetNillableScore(v *int) *DomainReportUpdateOne {
+	if v != nil {
+		_u.SetScore(*v)
+	}
+	return _u
+}
+
+// AddScore adds value to the "score" field.
+func (_u *DomainReportUpdateOne) AddScore(v int) *DomainReportUpdateOne {
+	_u.mutation.AddScore(v)
+	return _u
+}
+
+// ClearScore clears the value of the "score" field.
+func (_u *DomainReportUpdateOne) ClearScore() *DomainReportUpdateOne {
+	_u.mutation.ClearScore()
+	return _u
+}
+
+// SetLlmScore sets the "llm_score" field.
+func (_u *DomainReportUpdateOne) SetLlmScore(v int) *DomainReportUpdateOne {
+	_u.mutation.ResetLlmScore()
+	_u.mutation.SetLlmScore(v)
+	return _u
+}
+
+// SetNillableLlmScore sets the "llm_score" field if the given value is not nil.
+func (_u *DomainReportUpdateOne) SetNillableLlmScore(v *int) *DomainReportUpdateOne {
+	if v != nil {
+		_u.SetLlmScore(*v)
+	}
+	return _u
+}
+
+// AddLlmScore adds value to the "llm_score" field.
+func (_u *DomainReportUpdateOne) AddLlmScore(v int) *DomainReportUpdateOne {
+	_u.mutation.AddLlmScore(v)
+	return _u
+}
+
+// ClearLlmScore clears the value of the "llm_score" field.
+func (_u *DomainReportUpdateOne) ClearLlmScore() *DomainReportUpdateOne {
+	_u.mutation.ClearLlmScore()
+	return _u
+}
+
+// SetResultCount sets the "result_count" field.
+func (_u *DomainReportUpdateOne) SetResultCount(v int) *DomainReportUpdateOne {
+	_u.mutation.ResetResultCount()
+	_u.mutation.SetResultCount(v)
+	return _u
+}
+
+// SetNillableResultCount sets the "result_count" field if the given value is not nil.
+func (_u *DomainReportUpdateOne) SetNillableResultCount(v *int) *DomainReportUpdateOne {
+	if v != nil {
+		_u.SetResultCount(*v)
+	}
+	return _u
+}
+
+// AddResultCount adds value to the "result_count" field.
+func (_u *DomainReportUpdateOne) AddResultCount(v int) *DomainReportUpdateOne {
+	_u.mutation.AddResultCount(v)
+	return _u
+}
+
+// ClearResultCount clears the value of the "result_count" field.
+func (_u *DomainReportUpdateOne) ClearResultCount() *DomainReportUpdateOne {
+	_u.mutation.ClearResultCount()
+	return _u
+}
+
+// SetHeatBaseline sets the "heat_baseline" field.
+func (_u *DomainReportUpdateOne) SetHeatBaseline(v float64) *DomainReportUpdateOne {
+	_u.mutation.ResetHeatBaseline()
+	_u.mutation.SetHeatBaseline(v)
+	return _u
+}
+
+// SetNillableHeatBaseline sets the "heat_baseline" field if the given value is not nil.
+func (_u *DomainReportUpdateOne) SetNillableHeatBaseline(v *float64) *DomainReportUpdateOne {
+	if v != nil {
+		_u.SetHeatBaseline(*v)
 	}
-	_u.mutation.done = true
-	return _node, nil
+	return _u
 }
 
-// DomainReportUpdateOne is the builder for updating a single DomainReport entity.
-type DomainReportUpdateOne struct {
-	config
-	fields    []string
-	hooks     []Hook
-	mutation  *DomainReportMutation
-	modifiers []func(*sql.UpdateBuilder)
+// AddHeatBaseline adds value to the "heat_baseline" field.
+func (_u *DomainReportUpdateOne) AddHeatBaseline(v float64) *DomainReportUpdateOne {
+	_u.mutation.AddHeatBaseline(v)
+	return _u
 }
 
-// SetRunID sets the "run_id" field.
-func (_u *DomainReportUpdateOne) SetRunID(v int) *DomainReportUpdateOne {
-	_u.mutation.SetRunID(v)
+// ClearHeatBaseline clears the value of the "heat_baseline" field.
+func (_u *DomainReportUpdateOne) ClearHeatBaseline() *DomainReportUpdateOne {
+	_u.mutation.ClearHeatBaseline()
 	return _u
 }
 
-// SetNillableRunID sets the "run_id" field if the given value is not nil.
-func (_u *DomainReportUpdateOne) SetNillableRunID(v *int) *DomainReportUpdateOne {
+// SetHeatVolume sets the "heat_volume" field.
+func (_u *DomainReportUpdateOne) SetHeatVolume(v float64) *DomainReportUpdateOne {
+	_u.mutation.ResetHeatVolume()
+	_u.mutation.SetHeatVolume(v)
+	return _u
+}
+
+// SetNillableHeatVolume sets the "heat_volume" field if the given value is not nil.
+func (_u *DomainReportUpdateOne) SetNillableHeatVolume(v *float64) *DomainReportUpdateOne {
 	if v != nil {
-		_u.SetRunID(*v)
+		_u.SetHeatVolume(*v)
 	}
 	return _u
 }
 
-// ClearRunID clears the value of the "run_id" field.
-func (_u *DomainReportUpdateOne) ClearRunID() *DomainReportUpdateOne {
-	_u.mutation.ClearRunID()
+// AddHeatVolume adds value to the "heat_volume" field.
+func (_u *DomainReportUpdateOne) AddHeatVolume(v float64) *DomainReportUpdateOne {
+	_u.mutation.AddHeatVolume(v)
 	return _u
 }
 
-// SetDomainName sets the "domain_name" field.
-func (_u *DomainReportUpdateOne) SetDomainName(v string) *DomainReportUpdateOne {
-	_u.mutation.SetDomainName(v)
+// ClearHeatVolume clears the value of the "heat_volume" field.
+func (_u *DomainReportUpdateOne) ClearHeatVolume() *DomainReportUpdateOne {
+	_u.mutation.ClearHeatVolume()
 	return _u
 }
 
-// SetNillableDomainName sets the "domain_name" field if the given value is not nil.
-func (_u *DomainReportUpdateOne) SetNillableDomainName(v *string) *DomainReportUpdateOne {
+// SetHeatDiversity sets the "heat_diversity" field.
+func (_u *DomainReportUpdateOne) SetHeatDiversity(v float64) *DomainReportUpdateOne {
+	_u.mutation.ResetHeatDiversity()
+	_u.mutation.SetHeatDiversity(v)
+	return _u
+}
+
+// SetNillableHeatDiversity sets the "heat_diversity" field if the given value is not nil.
+func (_u *DomainReportUpdateOne) SetNillableHeatDiversity(v *float64) *DomainReportUpdateOne {
 	if v != nil {
-		_u.SetDomainName(*v)
+		_u.SetHeatDiversity(*v)
 	}
 	return _u
 }
 
-// SetOverview sets the "overview" field.
-func (_u *DomainReportUpdateOne) SetOverview(v string) *DomainReportUpdateOne {
-	_u.mutation.SetOverview(v)
+// AddHeatDiversity adds value to the "heat_diversity" field.
+func (_u *DomainReportUpdateOne) AddHeatDiversity(v float64) *DomainReportUpdateOne {
+	_u.mutation.AddHeatDiversity(v)
 	return _u
 }
 
-// SetNillableOverview sets the "overview" field if the given value is not nil.
-func (_u *DomainReportUpdateOne) SetNillableOverview(v *string) *DomainReportUpdateOne {
+// ClearHeatDiversity clears the value of the "heat_diversity" field.
+func (_u *DomainReportUpdateOne) ClearHeatDiversity() *DomainReportUpdateOne {
+	_u.mutation.ClearHeatDiversity()
+	return _u
+}
+
+// SetHeatRecency sets the "heat_recency" field.
+func (_u *DomainReportUpdateOne) SetHeatRecency(v float64) *DomainReportUpdateOne {
+	_u.mutation.ResetHeatRecency()
+	_u.mutation.SetHeatRecency(v)
+	return _u
+}
+
+// SetNillableHeatRecency sets the "heat_recency" field if the given value is not nil.
+func (_u *DomainReportUpdateOne) SetNillableHeatRecency(v *float64) *DomainReportUpdateOne {
 	if v != nil {
-		_u.SetOverview(*v)
+		_u.SetHeatRecency(*v)
 	}
 	return _u
 }
 
-// ClearOverview clears the value of the "overview" field.
-func (_u *DomainReportUpdateOne) ClearOverview() *DomainReportUpdateOne {
-	_u.mutation.ClearOverview()
+// AddHeatRecency adds value to the "heat_recency" field.
+func (_u *DomainReportUpdateOne) AddHeatRecency(v float64) *DomainReportUpdateOne {
+	_u.mutation.AddHeatRecency(v)
 	return _u
 }
 
-// SetTrends sets the "trends" field.
-func (_u *DomainReportUpdateOne) SetTrends(v string) *DomainReportUpdateOne {
-	_u.mutation.SetTrends(v)
+// ClearHeatRecency clears the value of the "heat_recency" field.
+func (_u *DomainReportUpdateOne) ClearHeatRecency() *DomainReportUpdateOne {
+	_u.mutation.ClearHeatRecency()
 	return _u
 }
 
-// SetNillableTrends sets the "trends" field if the given value is not nil.
-func (_u *DomainReportUpdateOne) SetNillableTrends(v *string) *DomainReportUpdateOne {
+// SetHeatEngagement sets the "heat_engagement" field.
+func (_u *DomainReportUpdateOne) SetHeatEngagement(v float64) *DomainReportUpdateOne {
+	_u.mutation.ResetHeatEngagement()
+	_u.mutation.SetHeatEngagement(v)
+	return _u
+}
+
+// SetNillableHeatEngagement sets the "heat_engagement" field if the given value is not nil.
+func (_u *DomainReportUpdateOne) SetNillableHeatEngagement(v *float64) *DomainReportUpdateOne {
 	if v != nil {
-		_u.SetTrends(*v)
+		_u.SetHeatEngagement(*v)
 	}
 	return _u
 }
 
-// ClearTrends clears the value of the "trends" field.
-func (_u *DomainReportUpdateOne) ClearTrends() *DomainReportUpdateOne {
-	_u.mutation.ClearTrends()
+// AddHeatEngagement adds value to the "heat_engagement" field.
+func (_u *DomainReportUpdateOne) AddHeatEngagement(v float64) *DomainReportUpdateOne {
+	_u.mutation.AddHeatEngagement(v)
 	return _u
 }
 
-// SetScore sets the "score" field.
-func (_u *DomainReportUpdateOne) SetScore(v int) *DomainReportUpdateOne {
-	_u.mutation.ResetScore()
-	_u.mutation.SetScore(v)
+// ClearHeatEngagement clears the value of the "heat_engagement" field.
+func (_u *DomainReportUpdateOne) ClearHeatEngagement() *DomainReportUpdateOne {
+	_u.mutation.ClearHeatEngagement()
 	return _u
 }
 
-// SetNillableScore sets the "score" field if the given value is not nil.
-func (_u *DomainReportUpdateOne) SetNillableScore(v *int) *DomainReportUpdateOne {
+// SetHeatSignificance sets the "heat_significance" field.
+func (_u *DomainReportUpdateOne) SetHeatSignificance(v float64) *DomainReportUpdateOne {
+	_u.mutation.ResetHeatSignificance()
+	_u.mutation.SetHeatSignificance(v)
+	return _u
+}
+
+// SetNillableHeatSignificance sets the "heat_significance" field if the given value is not nil.
+func (_u *DomainReportUpdateOne) SetNillableHeatSignificance(v *float64) *DomainReportUpdateOne {
 	if v != nil {
-		_u.SetScore(*v)
+		_u.SetHeatSignificance(*v)
 	}
 	return _u
 }
 
-// AddScore adds value to the "score" field.
-func (_u *DomainReportUpdateOne) AddScore(v int) *DomainReportUpdateOne {
-	_u.mutation.AddScore(v)
+// AddHeatSignificance adds value to the "heat_significance" field.
+func (_u *DomainReportUpdateOne) AddHeatSignificance(v float64) *DomainReportUpdateOne {
+	_u.mutation.AddHeatSignificance(v)
 	return _u
 }
 
-// ClearScore clears the value of the "score" field.
-func (_u *DomainReportUpdateOne) ClearScore() *DomainReportUpdateOne {
-	_u.mutation.ClearScore()
+// ClearHeatSignificance clears the value of the "heat_significance" field.
+func (_u *DomainReportUpdateOne) ClearHeatSignificance() *DomainReportUpdateOne {
+	_u.mutation.ClearHeatSignificance()
+	return _u
+}
+
+// SetHeatRaw sets the "heat_raw" field.
+func (_u *DomainReportUpdateOne) SetHeatRaw(v float64) *DomainReportUpdateOne {
+	_u.mutation.ResetHeatRaw()
+	_u.mutation.SetHeatRaw(v)
+	return _u
+}
+
+// SetNillableHeatRaw sets the "heat_raw" field if the given value is not nil.
+func (_u *DomainReportUpdateOne) SetNillableHeatRaw(v *float64) *DomainReportUpdateOne {
+	if v != nil {
+		_u.SetHeatRaw(*v)
+	}
+	return _u
+}
+
+// AddHeatRaw adds value to the "heat_raw" field.
+func (_u *DomainReportUpdateOne) AddHeatRaw(v float64) *DomainReportUpdateOne {
+	_u.mutation.AddHeatRaw(v)
+	return _u
+}
+
+// ClearHeatRaw clears the value of the "heat_raw" field.
+func (_u *DomainReportUpdateOne) ClearHeatRaw() *DomainReportUpdateOne {
+	_u.mutation.ClearHeatRaw()
 	return _u
 }
 
@@ -574,23 +1350,38 @@ func (_u *DomainReportUpdateOne) SetNillableCreatedAt(v *time.Time) *DomainRepor
 	return _u
 }
 
-// SetReportRunID sets the "report_run" edge to the ReportRun entity by ID.
-func (_u *DomainReportUpdateOne) SetReportRunID(id int) *DomainReportUpdateOne {
-	_u.mutation.SetReportRunID(id)
+// SetSourceRunID sets the "source_run" edge to the ReportRun entity by ID.
+func (_u *DomainReportUpdateOne) SetSourceRunID(id int) *DomainReportUpdateOne {
+	_u.mutation.SetSourceRunID(id)
 	return _u
 }
 
-// SetNillableReportRunID sets the "report_run" edge to the ReportRun entity by ID if the given value is not nil.
-func (_u *DomainReportUpdateOne) SetNillableReportRunID(id *int) *DomainReportUpdateOne {
+// SetNillableSourceRunID sets the "source_run" edge to the ReportRun entity by ID if the given value is not nil.
+func (_u *DomainReportUpdateOne) SetNillableSourceRunID(id *int) *DomainReportUpdateOne {
 	if id != nil {
-		_u = _u.SetReportRunID(*id)
+		_u = _u.SetSourceRunID(*id)
 	}
 	return _u
 }
 
-// SetReportRun sets the "report_run" edge to the ReportRun entity.
-func (_u *DomainReportUpdateOne) SetReportRun(v *ReportRun) *DomainReportUpdateOne {
-	return _u.SetReportRunID(v.ID)
+// SetSourceRun sets the "source_run" edge to the ReportRun entity.
+func (_u *DomainReportUpdateOne) SetSourceRun(v *ReportRun) *DomainReportUpdateOne {
+	return _u.SetSourceRunID(v.ID)
+}
+
+// AddReportRunIDs adds the "report_runs" edge to the ReportRun entity by IDs.
+func (_u *DomainReportUpdateOne) AddReportRunIDs(ids ...int) *DomainReportUpdateOne {
+	_u.mutation.AddReportRunIDs(ids...)
+	return _u
+}
+
+// AddReportRuns adds the "report_runs" edges to the ReportRun entity.
+func (_u *DomainReportUpdateOne) AddReportRuns(v ...*ReportRun) *DomainReportUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReportRunIDs(ids...)
 }
 
 // AddArticleIDs adds the "articles" edge to the Article entity by IDs.
@@ -623,17 +1414,53 @@ func (_u *DomainReportUpdateOne) AddKeyEvents(v ...*KeyEvent) *DomainReportUpdat
 	return _u.AddKeyEventIDs(ids...)
 }
 
+// AddClaimVerificationIDs adds the "claim_verifications" edge to the ClaimVerification entity by IDs.
+func (_u *DomainReportUpdateOne) AddClaimVerificationIDs(ids ...int) *DomainReportUpdateOne {
+	_u.mutation.AddClaimVerificationIDs(ids...)
+	return _u
+}
+
+// AddClaimVerifications adds the "claim_verifications" edges to the ClaimVerification entity.
+func (_u *DomainReportUpdateOne) AddClaimVerifications(v ...*ClaimVerification) *DomainReportUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddClaimVerificationIDs(ids...)
+}
+
 // Mutation returns the DomainReportMutation object of the builder.
 func (_u *DomainReportUpdateOne) Mutation() *DomainReportMutation {
 	return _u.mutation
 }
 
-// ClearReportRun clears the "report_run" edge to the ReportRun entity.
-func (_u *DomainReportUpdateOne) ClearReportRun() *DomainReportUpdateOne {
-	_u.mutation.ClearReportRun()
+// ClearSourceRun clears the "source_run" edge to the ReportRun entity.
+func (_u *DomainReportUpdateOne) ClearSourceRun() *DomainReportUpdateOne {
+	_u.mutation.ClearSourceRun()
+	return _u
+}
+
+// ClearReportRuns clears all "report_runs" edges to the ReportRun entity.
+func (_u *DomainReportUpdateOne) ClearReportRuns() *DomainReportUpdateOne {
+	_u.mutation.ClearReportRuns()
 	return _u
 }
 
+// RemoveReportRunIDs removes the "report_runs" edge to ReportRun entities by IDs.
+func (_u *DomainReportUpdateOne) RemoveReportRunIDs(ids ...int) *DomainReportUpdateOne {
+	_u.mutation.RemoveReportRunIDs(ids...)
+	return _u
+}
+
+// RemoveReportRuns removes "report_runs" edges to ReportRun entities.
+func (_u *DomainReportUpdateOne) RemoveReportRuns(v ...*ReportRun) *DomainReportUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReportRunIDs(ids...)
+}
+
 // ClearArticles clears all "articles" edges to the Article entity.
 func (_u *DomainReportUpdateOne) ClearArticles() *DomainReportUpdateOne {
 	_u.mutation.ClearArticles()
@@ -676,6 +1503,27 @@ func (_u *DomainReportUpdateOne) RemoveKeyEvents(v ...*KeyEvent) *DomainReportUp
 	return _u.RemoveKeyEventIDs(ids...)
 }
 
+// ClearClaimVerifications clears all "claim_verifications" edges to the ClaimVerification entity.
+func (_u *DomainReportUpdateOne) ClearClaimVerifications() *DomainReportUpdateOne {
+	_u.mutation.ClearClaimVerifications()
+	return _u
+}
+
+// RemoveClaimVerificationIDs removes the "claim_verifications" edge to ClaimVerification entities by IDs.
+func (_u *DomainReportUpdateOne) RemoveClaimVerificationIDs(ids ...int) *DomainReportUpdateOne {
+	_u.mutation.RemoveClaimVerificationIDs(ids...)
+	return _u
+}
+
+// RemoveClaimVerifications removes "claim_verifications" edges to ClaimVerification entities.
+func (_u *DomainReportUpdateOne) RemoveClaimVerifications(v ...*ClaimVerification) *DomainReportUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveClaimVerificationIDs(ids...)
+}
+
 // Where appends a list predicates to the DomainReportUpdate builder.
 func (_u *DomainReportUpdateOne) Where(ps ...predicate.DomainReport) *DomainReportUpdateOne {
 	_u.mutation.Where(ps...)
@@ -748,6 +1596,12 @@ func (_u *DomainReportUpdateOne) sqlSave(ctx context.Context) (_node *DomainRepo
 			}
 		}
 	}
+	if value, ok := _u.mutation.ShareKey(); ok {
+		_spec.SetField(domainreport.FieldShareKey, field.TypeString, value)
+	}
+	if _u.mutation.ShareKeyCleared() {
+		_spec.ClearField(domainreport.FieldShareKey, field.TypeString)
+	}
 	if value, ok := _u.mutation.DomainName(); ok {
 		_spec.SetField(domainreport.FieldDomainName, field.TypeString, value)
 	}
@@ -772,15 +1626,96 @@ func (_u *DomainReportUpdateOne) sqlSave(ctx context.Context) (_node *DomainRepo
 	if _u.mutation.ScoreCleared() {
 		_spec.ClearField(domainreport.FieldScore, field.TypeInt)
 	}
+	if value, ok := _u.mutation.LlmScore(); ok {
+		_spec.SetField(domainreport.FieldLlmScore, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLlmScore(); ok {
+		_spec.AddField(domainreport.FieldLlmScore, field.TypeInt, value)
+	}
+	if _u.mutation.LlmScoreCleared() {
+		_spec.ClearField(domainreport.FieldLlmScore, field.TypeInt)
+	}
+	if value, ok := _u.mutation.ResultCount(); ok {
+		_spec.SetField(domainreport.FieldResultCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedResultCount(); ok {
+		_spec.AddField(domainreport.FieldResultCount, field.TypeInt, value)
+	}
+	if _u.mutation.ResultCountCleared() {
+		_spec.ClearField(domainreport.FieldResultCount, field.TypeInt)
+	}
+	if value, ok := _u.mutation.HeatBaseline(); ok {
+		_spec.SetField(domainreport.FieldHeatBaseline, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedHeatBaseline(); ok {
+		_spec.AddField(domainreport.FieldHeatBaseline, field.TypeFloat64, value)
+	}
+	if _u.mutation.HeatBaselineCleared() {
+		_spec.ClearField(domainreport.FieldHeatBaseline, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.HeatVolume(); ok {
+		_spec.SetField(domainreport.FieldHeatVolume, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedHeatVolume(); ok {
+		_spec.AddField(domainreport.FieldHeatVolume, field.TypeFloat64, value)
+	}
+	if _u.mutation.HeatVolumeCleared() {
+		_spec.ClearField(domainreport.FieldHeatVolume, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.HeatDiversity(); ok {
+		_spec.SetField(domainreport.FieldHeatDiversity, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedHeatDiversity(); ok {
+		_spec.AddField(domainreport.FieldHeatDiversity, field.TypeFloat64, value)
+	}
+	if _u.mutation.HeatDiversityCleared() {
+		_spec.ClearField(domainreport.FieldHeatDiversity, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.HeatRecency(); ok {
+		_spec.SetField(domainreport.FieldHeatRecency, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedHeatRecency(); ok {
+		_spec.AddField(domainreport.FieldHeatRecency, field.TypeFloat64, value)
+	}
+	if _u.mutation.HeatRecencyCleared() {
+		_spec.ClearField(domainreport.FieldHeatRecency, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.HeatEngagement(); ok {
+		_spec.SetField(domainreport.FieldHeatEngagement, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedHeatEngagement(); ok {
+		_spec.AddField(domainreport.FieldHeatEngagement, field.TypeFloat64, value)
+	}
+	if _u.mutation.HeatEngagementCleared() {
+		_spec.ClearField(domainreport.FieldHeatEngagement, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.HeatSignificance(); ok {
+		_spec.SetField(domainreport.FieldHeatSignificance, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedHeatSignificance(); ok {
+		_spec.AddField(domainreport.FieldHeatSignificance, field.TypeFloat64, value)
+	}
+	if _u.mutation.HeatSignificanceCleared() {
+		_spec.ClearField(domainreport.FieldHeatSignificance, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.HeatRaw(); ok {
+		_spec.SetField(domainreport.FieldHeatRaw, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedHeatRaw(); ok {
+		_spec.AddField(domainreport.FieldHeatRaw, field.TypeFloat64, value)
+	}
+	if _u.mutation.HeatRawCleared() {
+		_spec.ClearField(domainreport.FieldHeatRaw, field.TypeFloat64)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(domainreport.FieldCreatedAt, field.TypeTime, value)
 	}
-	if _u.mutation.ReportRunCleared() {
+	if _u.mutation.SourceRunCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
 			Inverse: true,
-			Table:   domainreport.ReportRunTable,
-			Columns: []string{domainreport.ReportRunColumn},
+			Table:   domainreport.SourceRunTable,
+			Columns: []string{domainreport.SourceRunColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(reportrun.FieldID, field.TypeInt),
@@ -788,12 +1723,57 @@ func (_u *DomainReportUpdateOne) sqlSave(ctx context.Context) (_node *DomainRepo
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.ReportRunIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.SourceRunIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
 			Inverse: true,
-			Table:   domainreport.ReportRunTable,
-			Columns: []string{domainreport.ReportRunColumn},
+			Table:   domainreport.SourceRunTable,
+			Columns: []string{domainreport.SourceRunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reportrun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReportRunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   domainreport.ReportRunsTable,
+			Columns: domainreport.ReportRunsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reportrun.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedReportRunsIDs(); len(nodes) > 0 && !_u.mutation.ReportRunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   domainreport.ReportRunsTable,
+			Columns: domainreport.ReportRunsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reportrun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReportRunsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   domainreport.ReportRunsTable,
+			Columns: domainreport.ReportRunsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(reportrun.FieldID, field.TypeInt),
@@ -894,6 +1874,51 @@ func (_u *DomainReportUpdateOne) sqlSave(ctx context.Context) (_node *DomainRepo
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ClaimVerificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   domainreport.ClaimVerificationsTable,
+			Columns: []string{domainreport.ClaimVerificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(claimverification.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedClaimVerificationsIDs(); len(nodes) > 0 && !_u.mutation.ClaimVerificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   domainreport.ClaimVerificationsTable,
+			Columns: []string{domainreport.ClaimVerificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(claimverification.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ClaimVerificationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   domainreport.ClaimVerificationsTable,
+			Columns: []string{domainreport.ClaimVerificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(claimverification.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &DomainReport{config: _u.config}
 	_spec.Assign = _node.assignValues
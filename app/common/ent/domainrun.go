@@ -0,0 +1,190 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/iWorld-y/domain_radar/app/common/ent/domainrun"
+	"github.com/iWorld-y/domain_radar/app/common/ent/reportrun"
+)
+
+// DomainRun is the model entity for the DomainRun schema.
+type DomainRun struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// RunID holds the value of the "run_id" field.
+	RunID int `json:"run_id,omitempty"`
+	// DomainName holds the value of the "domain_name" field.
+	DomainName string `json:"domain_name,omitempty"`
+	// Outcome of the domain: succeeded, no_articles, search_failed, llm_failed, failed or skipped
+	Status string `json:"status,omitempty"`
+	// Error details when the domain did not succeed
+	Error string `json:"error,omitempty"`
+	// Number of articles collected for the domain
+	ArticleCount int `json:"article_count,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the DomainRunQuery when eager-loading is set.
+	Edges        DomainRunEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// DomainRunEdges holds the relations/edges for other nodes in the graph.
+type DomainRunEdges struct {
+	// ReportRun holds the value of the report_run edge.
+	ReportRun *ReportRun `json:"report_run,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ReportRunOrErr returns the ReportRun value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DomainRunEdges) ReportRunOrErr() (*ReportRun, error) {
+	if e.ReportRun != nil {
+		return e.ReportRun, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: reportrun.Label}
+	}
+	return nil, &NotLoadedError{edge: "report_run"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DomainRun) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case domainrun.FieldID, domainrun.FieldRunID, domainrun.FieldArticleCount:
+			values[i] = new(sql.NullInt64)
+		case domainrun.FieldDomainName, domainrun.FieldStatus, domainrun.FieldError:
+			values[i] = new(sql.NullString)
+		case domainrun.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DomainRun fields.
+func (_m *DomainRun) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case domainrun.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case domainrun.FieldRunID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field run_id", values[i])
+			} else if value.Valid {
+				_m.RunID = int(value.Int64)
+			}
+		case domainrun.FieldDomainName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field domain_name", values[i])
+			} else if value.Valid {
+				_m.DomainName = value.String
+			}
+		case domainrun.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = value.String
+			}
+		case domainrun.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				_m.Error = value.String
+			}
+		case domainrun.FieldArticleCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field article_count", values[i])
+			} else if value.Valid {
+				_m.ArticleCount = int(value.Int64)
+			}
+		case domainrun.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DomainRun.
+// This includes values selected through modifiers, order, etc.
+func (_m *DomainRun) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryReportRun queries the "report_run" edge of the DomainRun entity.
+func (_m *DomainRun) QueryReportRun() *ReportRunQuery {
+	return NewDomainRunClient(_m.config).QueryReportRun(_m)
+}
+
+// Update returns a builder for updating this DomainRun.
+// Note that you need to call DomainRun.Unwrap() before calling this method if this DomainRun
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *DomainRun) Update() *DomainRunUpdateOne {
+	return NewDomainRunClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the DomainRun entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *DomainRun) Unwrap() *DomainRun {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DomainRun is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *DomainRun) String() string {
+	var builder strings.Builder
+	builder.WriteString("DomainRun(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("run_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RunID))
+	builder.WriteString(", ")
+	builder.WriteString("domain_name=")
+	builder.WriteString(_m.DomainName)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(_m.Status)
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(_m.Error)
+	builder.WriteString(", ")
+	builder.WriteString("article_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ArticleCount))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DomainRuns is a parsable slice of DomainRun.
+type DomainRuns []*DomainRun
@@ -0,0 +1,120 @@
+// Code generated by ent, DO NOT EDIT.
+
+package domainrun
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the domainrun type in the database.
+	Label = "domain_run"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldRunID holds the string denoting the run_id field in the database.
+	FieldRunID = "run_id"
+	// FieldDomainName holds the string denoting the domain_name field in the database.
+	FieldDomainName = "domain_name"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldError holds the string denoting the error field in the database.
+	FieldError = "error"
+	// FieldArticleCount holds the string denoting the article_count field in the database.
+	FieldArticleCount = "article_count"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeReportRun holds the string denoting the report_run edge name in mutations.
+	EdgeReportRun = "report_run"
+	// Table holds the table name of the domainrun in the database.
+	Table = "domain_runs"
+	// ReportRunTable is the table that holds the report_run relation/edge.
+	ReportRunTable = "domain_runs"
+	// ReportRunInverseTable is the table name for the ReportRun entity.
+	// It exists in this package in order to avoid circular dependency with the "reportrun" package.
+	ReportRunInverseTable = "report_runs"
+	// ReportRunColumn is the table column denoting the report_run relation/edge.
+	ReportRunColumn = "run_id"
+)
+
+// Columns holds all SQL columns for domainrun fields.
+var Columns = []string{
+	FieldID,
+	FieldRunID,
+	FieldDomainName,
+	FieldStatus,
+	FieldError,
+	FieldArticleCount,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultArticleCount holds the default value on creation for the "article_count" field.
+	DefaultArticleCount int
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the DomainRun queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByRunID orders the results by the run_id field.
+func ByRunID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRunID, opts...).ToFunc()
+}
+
+// ByDomainName orders the results by the domain_name field.
+func ByDomainName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDomainName, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByError orders the results by the error field.
+func ByError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldError, opts...).ToFunc()
+}
+
+// ByArticleCount orders the results by the article_count field.
+func ByArticleCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldArticleCount, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByReportRunField orders the results by report_run field.
+func ByReportRunField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReportRunStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newReportRunStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReportRunInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ReportRunTable, ReportRunColumn),
+	)
+}
@@ -0,0 +1,439 @@
+// Code generated by ent, DO NOT EDIT.
+
+package domainrun
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/iWorld-y/domain_radar/app/common/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldLTE(FieldID, id))
+}
+
+// RunID applies equality check predicate on the "run_id" field. It's identical to RunIDEQ.
+func RunID(v int) predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldEQ(FieldRunID, v))
+}
+
+// DomainName applies equality check predicate on the "domain_name" field. It's identical to DomainNameEQ.
+func DomainName(v string) predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldEQ(FieldDomainName, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldEQ(FieldStatus, v))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldEQ(FieldError, v))
+}
+
+// ArticleCount applies equality check predicate on the "article_count" field. It's identical to ArticleCountEQ.
+func ArticleCount(v int) predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldEQ(FieldArticleCount, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// RunIDEQ applies the EQ predicate on the "run_id" field.
+func RunIDEQ(v int) predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldEQ(FieldRunID, v))
+}
+
+// RunIDNEQ applies the NEQ predicate on the "run_id" field.
+func RunIDNEQ(v int) predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldNEQ(FieldRunID, v))
+}
+
+// RunIDIn applies the In predicate on the "run_id" field.
+func RunIDIn(vs ...int) predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldIn(FieldRunID, vs...))
+}
+
+// RunIDNotIn applies the NotIn predicate on the "run_id" field.
+func RunIDNotIn(vs ...int) predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldNotIn(FieldRunID, vs...))
+}
+
+// RunIDIsNil applies the IsNil predicate on the "run_id" field.
+func RunIDIsNil() predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldIsNull(FieldRunID))
+}
+
+// RunIDNotNil applies the NotNil predicate on the "run_id" field.
+func RunIDNotNil() predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldNotNull(FieldRunID))
+}
+
+// DomainNameEQ applies the EQ predicate on the "domain_name" field.
+func DomainNameEQ(v string) predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldEQ(FieldDomainName, v))
+}
+
+// DomainNameNEQ applies the NEQ predicate on the "domain_name" field.
+func DomainNameNEQ(v string) predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldNEQ(FieldDomainName, v))
+}
+
+// DomainNameIn applies the In predicate on the "domain_name" field.
+func DomainNameIn(vs ...string) predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldIn(FieldDomainName, vs...))
+}
+
+// DomainNameNotIn applies the NotIn predicate on the "domain_name" field.
+func DomainNameNotIn(vs ...string) predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldNotIn(FieldDomainName, vs...))
+}
+
+// DomainNameGT applies the GT predicate on the "domain_name" field.
+func DomainNameGT(v string) predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldGT(FieldDomainName, v))
+}
+
+// DomainNameGTE applies the GTE predicate on the "domain_name" field.
+func DomainNameGTE(v string) predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldGTE(FieldDomainName, v))
+}
+
+// DomainNameLT applies the LT predicate on the "domain_name" field.
+func DomainNameLT(v string) predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldLT(FieldDomainName, v))
+}
+
+// DomainNameLTE applies the LTE predicate on the "domain_name" field.
+func DomainNameLTE(v string) predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldLTE(FieldDomainName, v))
+}
+
+// DomainNameContains applies the Contains predicate on the "domain_name" field.
+func DomainNameContains(v string) predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldContains(FieldDomainName, v))
+}
+
+// DomainNameHasPrefix applies the HasPrefix predicate on the "domain_name" field.
+func DomainNameHasPrefix(v string) predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldHasPrefix(FieldDomainName, v))
+}
+
+// DomainNameHasSuffix applies the HasSuffix predicate on the "domain_name" field.
+func DomainNameHasSuffix(v string) predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldHasSuffix(FieldDomainName, v))
+}
+
+// DomainNameEqualFold applies the EqualFold predicate on the "domain_name" field.
+func DomainNameEqualFold(v string) predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldEqualFold(FieldDomainName, v))
+}
+
+// DomainNameContainsFold applies the ContainsFold predicate on the "domain_name" field.
+func DomainNameContainsFold(v string) predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldContainsFold(FieldDomainName, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorIsNil applies the IsNil predicate on the "error" field.
+func ErrorIsNil() predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldIsNull(FieldError))
+}
+
+// ErrorNotNil applies the NotNil predicate on the "error" field.
+func ErrorNotNil() predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldNotNull(FieldError))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldContainsFold(FieldError, v))
+}
+
+// ArticleCountEQ applies the EQ predicate on the "article_count" field.
+func ArticleCountEQ(v int) predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldEQ(FieldArticleCount, v))
+}
+
+// ArticleCountNEQ applies the NEQ predicate on the "article_count" field.
+func ArticleCountNEQ(v int) predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldNEQ(FieldArticleCount, v))
+}
+
+// ArticleCountIn applies the In predicate on the "article_count" field.
+func ArticleCountIn(vs ...int) predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldIn(FieldArticleCount, vs...))
+}
+
+// ArticleCountNotIn applies the NotIn predicate on the "article_count" field.
+func ArticleCountNotIn(vs ...int) predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldNotIn(FieldArticleCount, vs...))
+}
+
+// ArticleCountGT applies the GT predicate on the "article_count" field.
+func ArticleCountGT(v int) predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldGT(FieldArticleCount, v))
+}
+
+// ArticleCountGTE applies the GTE predicate on the "article_count" field.
+func ArticleCountGTE(v int) predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldGTE(FieldArticleCount, v))
+}
+
+// ArticleCountLT applies the LT predicate on the "article_count" field.
+func ArticleCountLT(v int) predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldLT(FieldArticleCount, v))
+}
+
+// ArticleCountLTE applies the LTE predicate on the "article_count" field.
+func ArticleCountLTE(v int) predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldLTE(FieldArticleCount, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.DomainRun {
+	return predicate.DomainRun(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasReportRun applies the HasEdge predicate on the "report_run" edge.
+func HasReportRun() predicate.DomainRun {
+	return predicate.DomainRun(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ReportRunTable, ReportRunColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReportRunWith applies the HasEdge predicate on the "report_run" edge with a given conditions (other predicates).
+func HasReportRunWith(preds ...predicate.ReportRun) predicate.DomainRun {
+	return predicate.DomainRun(func(s *sql.Selector) {
+		step := newReportRunStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DomainRun) predicate.DomainRun {
+	return predicate.DomainRun(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DomainRun) predicate.DomainRun {
+	return predicate.DomainRun(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DomainRun) predicate.DomainRun {
+	return predicate.DomainRun(sql.NotPredicates(p))
+}
@@ -0,0 +1,883 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/iWorld-y/domain_radar/app/common/ent/domainrun"
+	"github.com/iWorld-y/domain_radar/app/common/ent/reportrun"
+)
+
+// DomainRunCreate is the builder for creating a DomainRun entity.
+type DomainRunCreate struct {
+	config
+	mutation *DomainRunMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetRunID sets the "run_id" field.
+func (_c *DomainRunCreate) SetRunID(v int) *DomainRunCreate {
+	_c.mutation.SetRunID(v)
+	return _c
+}
+
+// SetNillableRunID sets the "run_id" field if the given value is not nil.
+func (_c *DomainRunCreate) SetNillableRunID(v *int) *DomainRunCreate {
+	if v != nil {
+		_c.SetRunID(*v)
+	}
+	return _c
+}
+
+// SetDomainName sets the "domain_name" field.
+func (_c *DomainRunCreate) SetDomainName(v string) *DomainRunCreate {
+	_c.mutation.SetDomainName(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *DomainRunCreate) SetStatus(v string) *DomainRunCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetError sets the "error" field.
+func (_c *DomainRunCreate) SetError(v string) *DomainRunCreate {
+	_c.mutation.SetError(v)
+	return _c
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_c *DomainRunCreate) SetNillableError(v *string) *DomainRunCreate {
+	if v != nil {
+		_c.SetError(*v)
+	}
+	return _c
+}
+
+// SetArticleCount sets the "article_count" field.
+func (_c *DomainRunCreate) SetArticleCount(v int) *DomainRunCreate {
+	_c.mutation.SetArticleCount(v)
+	return _c
+}
+
+// SetNillableArticleCount sets the "article_count" field if the given value is not nil.
+func (_c *DomainRunCreate) SetNillableArticleCount(v *int) *DomainRunCreate {
+	if v != nil {
+		_c.SetArticleCount(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *DomainRunCreate) SetCreatedAt(v time.Time) *DomainRunCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *DomainRunCreate) SetNillableCreatedAt(v *time.Time) *DomainRunCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *DomainRunCreate) SetID(v int) *DomainRunCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetReportRunID sets the "report_run" edge to the ReportRun entity by ID.
+func (_c *DomainRunCreate) SetReportRunID(id int) *DomainRunCreate {
+	_c.mutation.SetReportRunID(id)
+	return _c
+}
+
+// SetNillableReportRunID sets the "report_run" edge to the ReportRun entity by ID if the given value is not nil.
+func (_c *DomainRunCreate) SetNillableReportRunID(id *int) *DomainRunCreate {
+	if id != nil {
+		_c = _c.SetReportRunID(*id)
+	}
+	return _c
+}
+
+// SetReportRun sets the "report_run" edge to the ReportRun entity.
+func (_c *DomainRunCreate) SetReportRun(v *ReportRun) *DomainRunCreate {
+	return _c.SetReportRunID(v.ID)
+}
+
+// Mutation returns the DomainRunMutation object of the builder.
+func (_c *DomainRunCreate) Mutation() *DomainRunMutation {
+	return _c.mutation
+}
+
+// Save creates the DomainRun in the database.
+func (_c *DomainRunCreate) Save(ctx context.Context) (*DomainRun, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *DomainRunCreate) SaveX(ctx context.Context) *DomainRun {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DomainRunCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DomainRunCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *DomainRunCreate) defaults() {
+	if _, ok := _c.mutation.ArticleCount(); !ok {
+		v := domainrun.DefaultArticleCount
+		_c.mutation.SetArticleCount(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := domainrun.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *DomainRunCreate) check() error {
+	if _, ok := _c.mutation.DomainName(); !ok {
+		return &ValidationError{Name: "domain_name", err: errors.New(`ent: missing required field "DomainRun.domain_name"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "DomainRun.status"`)}
+	}
+	if _, ok := _c.mutation.ArticleCount(); !ok {
+		return &ValidationError{Name: "article_count", err: errors.New(`ent: missing required field "DomainRun.article_count"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "DomainRun.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *DomainRunCreate) sqlSave(ctx context.Context) (*DomainRun, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *DomainRunCreate) createSpec() (*DomainRun, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DomainRun{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(domainrun.Table, sqlgraph.NewFieldSpec(domainrun.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.DomainName(); ok {
+		_spec.SetField(domainrun.FieldDomainName, field.TypeString, value)
+		_node.DomainName = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(domainrun.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Error(); ok {
+		_spec.SetField(domainrun.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := _c.mutation.ArticleCount(); ok {
+		_spec.SetField(domainrun.FieldArticleCount, field.TypeInt, value)
+		_node.ArticleCount = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(domainrun.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.ReportRunIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   domainrun.ReportRunTable,
+			Columns: []string{domainrun.ReportRunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reportrun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.RunID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DomainRun.Create().
+//		SetRunID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DomainRunUpsert) {
+//			SetRunID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DomainRunCreate) OnConflict(opts ...sql.ConflictOption) *DomainRunUpsertOne {
+	_c.conflict = opts
+	return &DomainRunUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DomainRun.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DomainRunCreate) OnConflictColumns(columns ...string) *DomainRunUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DomainRunUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// DomainRunUpsertOne is the builder for "upsert"-ing
+	//  one DomainRun node.
+	DomainRunUpsertOne struct {
+		create *DomainRunCreate
+	}
+
+	// DomainRunUpsert is the "OnConflict" setter.
+	DomainRunUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetRunID sets the "run_id" field.
+func (u *DomainRunUpsert) SetRunID(v int) *DomainRunUpsert {
+	u.Set(domainrun.FieldRunID, v)
+	return u
+}
+
+// UpdateRunID sets the "run_id" field to the value that was provided on create.
+func (u *DomainRunUpsert) UpdateRunID() *DomainRunUpsert {
+	u.SetExcluded(domainrun.FieldRunID)
+	return u
+}
+
+// ClearRunID clears the value of the "run_id" field.
+func (u *DomainRunUpsert) ClearRunID() *DomainRunUpsert {
+	u.SetNull(domainrun.FieldRunID)
+	return u
+}
+
+// SetDomainName sets the "domain_name" field.
+func (u *DomainRunUpsert) SetDomainName(v string) *DomainRunUpsert {
+	u.Set(domainrun.FieldDomainName, v)
+	return u
+}
+
+// UpdateDomainName sets the "domain_name" field to the value that was provided on create.
+func (u *DomainRunUpsert) UpdateDomainName() *DomainRunUpsert {
+	u.SetExcluded(domainrun.FieldDomainName)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *DomainRunUpsert) SetStatus(v string) *DomainRunUpsert {
+	u.Set(domainrun.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *DomainRunUpsert) UpdateStatus() *DomainRunUpsert {
+	u.SetExcluded(domainrun.FieldStatus)
+	return u
+}
+
+// SetError sets the "error" field.
+func (u *DomainRunUpsert) SetError(v string) *DomainRunUpsert {
+	u.Set(domainrun.FieldError, v)
+	return u
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *DomainRunUpsert) UpdateError() *DomainRunUpsert {
+	u.SetExcluded(domainrun.FieldError)
+	return u
+}
+
+// ClearError clears the value of the "error" field.
+func (u *DomainRunUpsert) ClearError() *DomainRunUpsert {
+	u.SetNull(domainrun.FieldError)
+	return u
+}
+
+// SetArticleCount sets the "article_count" field.
+func (u *DomainRunUpsert) SetArticleCount(v int) *DomainRunUpsert {
+	u.Set(domainrun.FieldArticleCount, v)
+	return u
+}
+
+// UpdateArticleCount sets the "article_count" field to the value that was provided on create.
+func (u *DomainRunUpsert) UpdateArticleCount() *DomainRunUpsert {
+	u.SetExcluded(domainrun.FieldArticleCount)
+	return u
+}
+
+// AddArticleCount adds v to the "article_count" field.
+func (u *DomainRunUpsert) AddArticleCount(v int) *DomainRunUpsert {
+	u.Add(domainrun.FieldArticleCount, v)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *DomainRunUpsert) SetCreatedAt(v time.Time) *DomainRunUpsert {
+	u.Set(domainrun.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *DomainRunUpsert) UpdateCreatedAt() *DomainRunUpsert {
+	u.SetExcluded(domainrun.FieldCreatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.DomainRun.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(domainrun.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DomainRunUpsertOne) UpdateNewValues() *DomainRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(domainrun.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DomainRun.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *DomainRunUpsertOne) Ignore() *DomainRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DomainRunUpsertOne) DoNothing() *DomainRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DomainRunCreate.OnConflict
+// documentation for more info.
+func (u *DomainRunUpsertOne) Update(set func(*DomainRunUpsert)) *DomainRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DomainRunUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetRunID sets the "run_id" field.
+func (u *DomainRunUpsertOne) SetRunID(v int) *DomainRunUpsertOne {
+	return u.Update(func(s *DomainRunUpsert) {
+		s.SetRunID(v)
+	})
+}
+
+// UpdateRunID sets the "run_id" field to the value that was provided on create.
+func (u *DomainRunUpsertOne) UpdateRunID() *DomainRunUpsertOne {
+	return u.Update(func(s *DomainRunUpsert) {
+		s.UpdateRunID()
+	})
+}
+
+// ClearRunID clears the value of the "run_id" field.
+func (u *DomainRunUpsertOne) ClearRunID() *DomainRunUpsertOne {
+	return u.Update(func(s *DomainRunUpsert) {
+		s.ClearRunID()
+	})
+}
+
+// SetDomainName sets the "domain_name" field.
+func (u *DomainRunUpsertOne) SetDomainName(v string) *DomainRunUpsertOne {
+	return u.Update(func(s *DomainRunUpsert) {
+		s.SetDomainName(v)
+	})
+}
+
+// UpdateDomainName sets the "domain_name" field to the value that was provided on create.
+func (u *DomainRunUpsertOne) UpdateDomainName() *DomainRunUpsertOne {
+	return u.Update(func(s *DomainRunUpsert) {
+		s.UpdateDomainName()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *DomainRunUpsertOne) SetStatus(v string) *DomainRunUpsertOne {
+	return u.Update(func(s *DomainRunUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *DomainRunUpsertOne) UpdateStatus() *DomainRunUpsertOne {
+	return u.Update(func(s *DomainRunUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *DomainRunUpsertOne) SetError(v string) *DomainRunUpsertOne {
+	return u.Update(func(s *DomainRunUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *DomainRunUpsertOne) UpdateError() *DomainRunUpsertOne {
+	return u.Update(func(s *DomainRunUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *DomainRunUpsertOne) ClearError() *DomainRunUpsertOne {
+	return u.Update(func(s *DomainRunUpsert) {
+		s.ClearError()
+	})
+}
+
+// SetArticleCount sets the "article_count" field.
+func (u *DomainRunUpsertOne) SetArticleCount(v int) *DomainRunUpsertOne {
+	return u.Update(func(s *DomainRunUpsert) {
+		s.SetArticleCount(v)
+	})
+}
+
+// AddArticleCount adds v to the "article_count" field.
+func (u *DomainRunUpsertOne) AddArticleCount(v int) *DomainRunUpsertOne {
+	return u.Update(func(s *DomainRunUpsert) {
+		s.AddArticleCount(v)
+	})
+}
+
+// UpdateArticleCount sets the "article_count" field to the value that was provided on create.
+func (u *DomainRunUpsertOne) UpdateArticleCount() *DomainRunUpsertOne {
+	return u.Update(func(s *DomainRunUpsert) {
+		s.UpdateArticleCount()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *DomainRunUpsertOne) SetCreatedAt(v time.Time) *DomainRunUpsertOne {
+	return u.Update(func(s *DomainRunUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *DomainRunUpsertOne) UpdateCreatedAt() *DomainRunUpsertOne {
+	return u.Update(func(s *DomainRunUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *DomainRunUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DomainRunCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DomainRunUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *DomainRunUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *DomainRunUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// DomainRunCreateBulk is the builder for creating many DomainRun entities in bulk.
+type DomainRunCreateBulk struct {
+	config
+	err      error
+	builders []*DomainRunCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the DomainRun entities in the database.
+func (_c *DomainRunCreateBulk) Save(ctx context.Context) ([]*DomainRun, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*DomainRun, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DomainRunMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *DomainRunCreateBulk) SaveX(ctx context.Context) []*DomainRun {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DomainRunCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DomainRunCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DomainRun.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DomainRunUpsert) {
+//			SetRunID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DomainRunCreateBulk) OnConflict(opts ...sql.ConflictOption) *DomainRunUpsertBulk {
+	_c.conflict = opts
+	return &DomainRunUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DomainRun.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DomainRunCreateBulk) OnConflictColumns(columns ...string) *DomainRunUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DomainRunUpsertBulk{
+		create: _c,
+	}
+}
+
+// DomainRunUpsertBulk is the builder for "upsert"-ing
+// a bulk of DomainRun nodes.
+type DomainRunUpsertBulk struct {
+	create *DomainRunCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.DomainRun.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(domainrun.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DomainRunUpsertBulk) UpdateNewValues() *DomainRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(domainrun.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DomainRun.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *DomainRunUpsertBulk) Ignore() *DomainRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DomainRunUpsertBulk) DoNothing() *DomainRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DomainRunCreateBulk.OnConflict
+// documentation for more info.
+func (u *DomainRunUpsertBulk) Update(set func(*DomainRunUpsert)) *DomainRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DomainRunUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetRunID sets the "run_id" field.
+func (u *DomainRunUpsertBulk) SetRunID(v int) *DomainRunUpsertBulk {
+	return u.Update(func(s *DomainRunUpsert) {
+		s.SetRunID(v)
+	})
+}
+
+// UpdateRunID sets the "run_id" field to the value that was provided on create.
+func (u *DomainRunUpsertBulk) UpdateRunID() *DomainRunUpsertBulk {
+	return u.Update(func(s *DomainRunUpsert) {
+		s.UpdateRunID()
+	})
+}
+
+// ClearRunID clears the value of the "run_id" field.
+func (u *DomainRunUpsertBulk) ClearRunID() *DomainRunUpsertBulk {
+	return u.Update(func(s *DomainRunUpsert) {
+		s.ClearRunID()
+	})
+}
+
+// SetDomainName sets the "domain_name" field.
+func (u *DomainRunUpsertBulk) SetDomainName(v string) *DomainRunUpsertBulk {
+	return u.Update(func(s *DomainRunUpsert) {
+		s.SetDomainName(v)
+	})
+}
+
+// UpdateDomainName sets the "domain_name" field to the value that was provided on create.
+func (u *DomainRunUpsertBulk) UpdateDomainName() *DomainRunUpsertBulk {
+	return u.Update(func(s *DomainRunUpsert) {
+		s.UpdateDomainName()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *DomainRunUpsertBulk) SetStatus(v string) *DomainRunUpsertBulk {
+	return u.Update(func(s *DomainRunUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *DomainRunUpsertBulk) UpdateStatus() *DomainRunUpsertBulk {
+	return u.Update(func(s *DomainRunUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *DomainRunUpsertBulk) SetError(v string) *DomainRunUpsertBulk {
+	return u.Update(func(s *DomainRunUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *DomainRunUpsertBulk) UpdateError() *DomainRunUpsertBulk {
+	return u.Update(func(s *DomainRunUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *DomainRunUpsertBulk) ClearError() *DomainRunUpsertBulk {
+	return u.Update(func(s *DomainRunUpsert) {
+		s.ClearError()
+	})
+}
+
+// SetArticleCount sets the "article_count" field.
+func (u *DomainRunUpsertBulk) SetArticleCount(v int) *DomainRunUpsertBulk {
+	return u.Update(func(s *DomainRunUpsert) {
+		s.SetArticleCount(v)
+	})
+}
+
+// AddArticleCount adds v to the "article_count" field.
+func (u *DomainRunUpsertBulk) AddArticleCount(v int) *DomainRunUpsertBulk {
+	return u.Update(func(s *DomainRunUpsert) {
+		s.AddArticleCount(v)
+	})
+}
+
+// UpdateArticleCount sets the "article_count" field to the value that was provided on create.
+func (u *DomainRunUpsertBulk) UpdateArticleCount() *DomainRunUpsertBulk {
+	return u.Update(func(s *DomainRunUpsert) {
+		s.UpdateArticleCount()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *DomainRunUpsertBulk) SetCreatedAt(v time.Time) *DomainRunUpsertBulk {
+	return u.Update(func(s *DomainRunUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *DomainRunUpsertBulk) UpdateCreatedAt() *DomainRunUpsertBulk {
+	return u.Update(func(s *DomainRunUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *DomainRunUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the DomainRunCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DomainRunCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DomainRunUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,549 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/iWorld-y/domain_radar/app/common/ent/domainrun"
+	"github.com/iWorld-y/domain_radar/app/common/ent/predicate"
+	"github.com/iWorld-y/domain_radar/app/common/ent/reportrun"
+)
+
+// DomainRunUpdate is the builder for updating DomainRun entities.
+type DomainRunUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *DomainRunMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the DomainRunUpdate builder.
+func (_u *DomainRunUpdate) Where(ps ...predicate.DomainRun) *DomainRunUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetRunID sets the "run_id" field.
+func (_u *DomainRunUpdate) SetRunID(v int) *DomainRunUpdate {
+	_u.mutation.SetRunID(v)
+	return _u
+}
+
+// SetNillableRunID sets the "run_id" field if the given value is not nil.
+func (_u *DomainRunUpdate) SetNillableRunID(v *int) *DomainRunUpdate {
+	if v != nil {
+		_u.SetRunID(*v)
+	}
+	return _u
+}
+
+// ClearRunID clears the value of the "run_id" field.
+func (_u *DomainRunUpdate) ClearRunID() *DomainRunUpdate {
+	_u.mutation.ClearRunID()
+	return _u
+}
+
+// SetDomainName sets the "domain_name" field.
+func (_u *DomainRunUpdate) SetDomainName(v string) *DomainRunUpdate {
+	_u.mutation.SetDomainName(v)
+	return _u
+}
+
+// SetNillableDomainName sets the "domain_name" field if the given value is not nil.
+func (_u *DomainRunUpdate) SetNillableDomainName(v *string) *DomainRunUpdate {
+	if v != nil {
+		_u.SetDomainName(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *DomainRunUpdate) SetStatus(v string) *DomainRunUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *DomainRunUpdate) SetNillableStatus(v *string) *DomainRunUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *DomainRunUpdate) SetError(v string) *DomainRunUpdate {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *DomainRunUpdate) SetNillableError(v *string) *DomainRunUpdate {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *DomainRunUpdate) ClearError() *DomainRunUpdate {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetArticleCount sets the "article_count" field.
+func (_u *DomainRunUpdate) SetArticleCount(v int) *DomainRunUpdate {
+	_u.mutation.ResetArticleCount()
+	_u.mutation.SetArticleCount(v)
+	return _u
+}
+
+// SetNillableArticleCount sets the "article_count" field if the given value is not nil.
+func (_u *DomainRunUpdate) SetNillableArticleCount(v *int) *DomainRunUpdate {
+	if v != nil {
+		_u.SetArticleCount(*v)
+	}
+	return _u
+}
+
+// AddArticleCount adds value to the "article_count" field.
+func (_u *DomainRunUpdate) AddArticleCount(v int) *DomainRunUpdate {
+	_u.mutation.AddArticleCount(v)
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *DomainRunUpdate) SetCreatedAt(v time.Time) *DomainRunUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *DomainRunUpdate) SetNillableCreatedAt(v *time.Time) *DomainRunUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetReportRunID sets the "report_run" edge to the ReportRun entity by ID.
+func (_u *DomainRunUpdate) SetReportRunID(id int) *DomainRunUpdate {
+	_u.mutation.SetReportRunID(id)
+	return _u
+}
+
+// SetNillableReportRunID sets the "report_run" edge to the ReportRun entity by ID if the given value is not nil.
+func (_u *DomainRunUpdate) SetNillableReportRunID(id *int) *DomainRunUpdate {
+	if id != nil {
+		_u = _u.SetReportRunID(*id)
+	}
+	return _u
+}
+
+// SetReportRun sets the "report_run" edge to the ReportRun entity.
+func (_u *DomainRunUpdate) SetReportRun(v *ReportRun) *DomainRunUpdate {
+	return _u.SetReportRunID(v.ID)
+}
+
+// Mutation returns the DomainRunMutation object of the builder.
+func (_u *DomainRunUpdate) Mutation() *DomainRunMutation {
+	return _u.mutation
+}
+
+// ClearReportRun clears the "report_run" edge to the ReportRun entity.
+func (_u *DomainRunUpdate) ClearReportRun() *DomainRunUpdate {
+	_u.mutation.ClearReportRun()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *DomainRunUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DomainRunUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *DomainRunUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DomainRunUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *DomainRunUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *DomainRunUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *DomainRunUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(domainrun.Table, domainrun.Columns, sqlgraph.NewFieldSpec(domainrun.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.DomainName(); ok {
+		_spec.SetField(domainrun.FieldDomainName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(domainrun.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(domainrun.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(domainrun.FieldError, field.TypeString)
+	}
+	if value, ok := _u.mutation.ArticleCount(); ok {
+		_spec.SetField(domainrun.FieldArticleCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedArticleCount(); ok {
+		_spec.AddField(domainrun.FieldArticleCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(domainrun.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReportRunCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   domainrun.ReportRunTable,
+			Columns: []string{domainrun.ReportRunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reportrun.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReportRunIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   domainrun.ReportRunTable,
+			Columns: []string{domainrun.ReportRunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reportrun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{domainrun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// DomainRunUpdateOne is the builder for updating a single DomainRun entity.
+type DomainRunUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *DomainRunMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetRunID sets the "run_id" field.
+func (_u *DomainRunUpdateOne) SetRunID(v int) *DomainRunUpdateOne {
+	_u.mutation.SetRunID(v)
+	return _u
+}
+
+// SetNillableRunID sets the "run_id" field if the given value is not nil.
+func (_u *DomainRunUpdateOne) SetNillableRunID(v *int) *DomainRunUpdateOne {
+	if v != nil {
+		_u.SetRunID(*v)
+	}
+	return _u
+}
+
+// ClearRunID clears the value of the "run_id" field.
+func (_u *DomainRunUpdateOne) ClearRunID() *DomainRunUpdateOne {
+	_u.mutation.ClearRunID()
+	return _u
+}
+
+// SetDomainName sets the "domain_name" field.
+func (_u *DomainRunUpdateOne) SetDomainName(v string) *DomainRunUpdateOne {
+	_u.mutation.SetDomainName(v)
+	return _u
+}
+
+// SetNillableDomainName sets the "domain_name" field if the given value is not nil.
+func (_u *DomainRunUpdateOne) SetNillableDomainName(v *string) *DomainRunUpdateOne {
+	if v != nil {
+		_u.SetDomainName(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *DomainRunUpdateOne) SetStatus(v string) *DomainRunUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *DomainRunUpdateOne) SetNillableStatus(v *string) *DomainRunUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *DomainRunUpdateOne) SetError(v string) *DomainRunUpdateOne {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *DomainRunUpdateOne) SetNillableError(v *string) *DomainRunUpdateOne {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *DomainRunUpdateOne) ClearError() *DomainRunUpdateOne {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetArticleCount sets the "article_count" field.
+func (_u *DomainRunUpdateOne) SetArticleCount(v int) *DomainRunUpdateOne {
+	_u.mutation.ResetArticleCount()
+	_u.mutation.SetArticleCount(v)
+	return _u
+}
+
+// SetNillableArticleCount sets the "article_count" field if the given value is not nil.
+func (_u *DomainRunUpdateOne) SetNillableArticleCount(v *int) *DomainRunUpdateOne {
+	if v != nil {
+		_u.SetArticleCount(*v)
+	}
+	return _u
+}
+
+// AddArticleCount adds value to the "article_count" field.
+func (_u *DomainRunUpdateOne) AddArticleCount(v int) *DomainRunUpdateOne {
+	_u.mutation.AddArticleCount(v)
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *DomainRunUpdateOne) SetCreatedAt(v time.Time) *DomainRunUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *DomainRunUpdateOne) SetNillableCreatedAt(v *time.Time) *DomainRunUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetReportRunID sets the "report_run" edge to the ReportRun entity by ID.
+func (_u *DomainRunUpdateOne) SetReportRunID(id int) *DomainRunUpdateOne {
+	_u.mutation.SetReportRunID(id)
+	return _u
+}
+
+// SetNillableReportRunID sets the "report_run" edge to the ReportRun entity by ID if the given value is not nil.
+func (_u *DomainRunUpdateOne) SetNillableReportRunID(id *int) *DomainRunUpdateOne {
+	if id != nil {
+		_u = _u.SetReportRunID(*id)
+	}
+	return _u
+}
+
+// SetReportRun sets the "report_run" edge to the ReportRun entity.
+func (_u *DomainRunUpdateOne) SetReportRun(v *ReportRun) *DomainRunUpdateOne {
+	return _u.SetReportRunID(v.ID)
+}
+
+// Mutation returns the DomainRunMutation object of the builder.
+func (_u *DomainRunUpdateOne) Mutation() *DomainRunMutation {
+	return _u.mutation
+}
+
+// ClearReportRun clears the "report_run" edge to the ReportRun entity.
+func (_u *DomainRunUpdateOne) ClearReportRun() *DomainRunUpdateOne {
+	_u.mutation.ClearReportRun()
+	return _u
+}
+
+// Where appends a list predicates to the DomainRunUpdate builder.
+func (_u *DomainRunUpdateOne) Where(ps ...predicate.DomainRun) *DomainRunUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *DomainRunUpdateOne) Select(field string, fields ...string) *DomainRunUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated DomainRun entity.
+func (_u *DomainRunUpdateOne) Save(ctx context.Context) (*DomainRun, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DomainRunUpdateOne) SaveX(ctx context.Context) *DomainRun {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *DomainRunUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DomainRunUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *DomainRunUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *DomainRunUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *DomainRunUpdateOne) sqlSave(ctx context.Context) (_node *DomainRun, err error) {
+	_spec := sqlgraph.NewUpdateSpec(domainrun.Table, domainrun.Columns, sqlgraph.NewFieldSpec(domainrun.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DomainRun.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, domainrun.FieldID)
+		for _, f := range fields {
+			if !domainrun.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != domainrun.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.DomainName(); ok {
+		_spec.SetField(domainrun.FieldDomainName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(domainrun.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(domainrun.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(domainrun.FieldError, field.TypeString)
+	}
+	if value, ok := _u.mutation.ArticleCount(); ok {
+		_spec.SetField(domainrun.FieldArticleCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedArticleCount(); ok {
+		_spec.AddField(domainrun.FieldArticleCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(domainrun.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReportRunCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   domainrun.ReportRunTable,
+			Columns: []string{domainrun.ReportRunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reportrun.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReportRunIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   domainrun.ReportRunTable,
+			Columns: []string{domainrun.ReportRunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reportrun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &DomainRun{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{domainrun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
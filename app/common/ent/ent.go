@@ -13,11 +13,21 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/iWorld-y/domain_radar/app/common/ent/actionguide"
+	"github.com/iWorld-y/domain_radar/app/common/ent/analysislens"
+	"github.com/iWorld-y/domain_radar/app/common/ent/analysissection"
 	"github.com/iWorld-y/domain_radar/app/common/ent/article"
+	"github.com/iWorld-y/domain_radar/app/common/ent/articleentity"
+	"github.com/iWorld-y/domain_radar/app/common/ent/claimverification"
 	"github.com/iWorld-y/domain_radar/app/common/ent/deepanalysisresult"
 	"github.com/iWorld-y/domain_radar/app/common/ent/domainreport"
+	"github.com/iWorld-y/domain_radar/app/common/ent/domainrun"
+	"github.com/iWorld-y/domain_radar/app/common/ent/entity"
 	"github.com/iWorld-y/domain_radar/app/common/ent/keyevent"
+	"github.com/iWorld-y/domain_radar/app/common/ent/llmcache"
+	"github.com/iWorld-y/domain_radar/app/common/ent/llmcall"
+	"github.com/iWorld-y/domain_radar/app/common/ent/persona"
 	"github.com/iWorld-y/domain_radar/app/common/ent/reportrun"
+	"github.com/iWorld-y/domain_radar/app/common/ent/reportrunevent"
 	"github.com/iWorld-y/domain_radar/app/common/ent/user"
 )
 
@@ -80,11 +90,21 @@ func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
 			actionguide.Table:        actionguide.ValidColumn,
+			analysislens.Table:       analysislens.ValidColumn,
+			analysissection.Table:    analysissection.ValidColumn,
 			article.Table:            article.ValidColumn,
+			articleentity.Table:      articleentity.ValidColumn,
+			claimverification.Table:  claimverification.ValidColumn,
 			deepanalysisresult.Table: deepanalysisresult.ValidColumn,
 			domainreport.Table:       domainreport.ValidColumn,
+			domainrun.Table:          domainrun.ValidColumn,
+			entity.Table:             entity.ValidColumn,
 			keyevent.Table:           keyevent.ValidColumn,
+			llmcache.Table:           llmcache.ValidColumn,
+			llmcall.Table:            llmcall.ValidColumn,
+			persona.Table:            persona.ValidColumn,
 			reportrun.Table:          reportrun.ValidColumn,
+			reportrunevent.Table:     reportrunevent.ValidColumn,
 			user.Table:               user.ValidColumn,
 		})
 	})
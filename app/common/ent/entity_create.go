@@ -0,0 +1,694 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/iWorld-y/domain_radar/app/common/ent/articleentity"
+	"github.com/iWorld-y/domain_radar/app/common/ent/entity"
+)
+
+// EntityCreate is the builder for creating a Entity entity.
+type EntityCreate struct {
+	config
+	mutation *EntityMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetName sets the "name" field.
+func (_c *EntityCreate) SetName(v string) *EntityCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetType sets the "type" field.
+func (_c *EntityCreate) SetType(v string) *EntityCreate {
+	_c.mutation.SetType(v)
+	return _c
+}
+
+// SetKey sets the "key" field.
+func (_c *EntityCreate) SetKey(v string) *EntityCreate {
+	_c.mutation.SetKey(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *EntityCreate) SetCreatedAt(v time.Time) *EntityCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *EntityCreate) SetNillableCreatedAt(v *time.Time) *EntityCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *EntityCreate) SetID(v int) *EntityCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// AddArticleEntityIDs adds the "article_entities" edge to the ArticleEntity entity by IDs.
+func (_c *EntityCreate) AddArticleEntityIDs(ids ...int) *EntityCreate {
+	_c.mutation.AddArticleEntityIDs(ids...)
+	return _c
+}
+
+// AddArticleEntities adds the "article_entities" edges to the ArticleEntity entity.
+func (_c *EntityCreate) AddArticleEntities(v ...*ArticleEntity) *EntityCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddArticleEntityIDs(ids...)
+}
+
+// Mutation returns the EntityMutation object of the builder.
+func (_c *EntityCreate) Mutation() *EntityMutation {
+	return _c.mutation
+}
+
+// Save creates the Entity in the database.
+func (_c *EntityCreate) Save(ctx context.Context) (*Entity, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *EntityCreate) SaveX(ctx context.Context) *Entity {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EntityCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EntityCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *EntityCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := entity.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *EntityCreate) check() error {
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Entity.name"`)}
+	}
+	if _, ok := _c.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "Entity.type"`)}
+	}
+	if _, ok := _c.mutation.Key(); !ok {
+		return &ValidationError{Name: "key", err: errors.New(`ent: missing required field "Entity.key"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Entity.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *EntityCreate) sqlSave(ctx context.Context) (*Entity, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *EntityCreate) createSpec() (*Entity, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Entity{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(entity.Table, sqlgraph.NewFieldSpec(entity.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(entity.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.GetType(); ok {
+		_spec.SetField(entity.FieldType, field.TypeString, value)
+		_node.Type = value
+	}
+	if value, ok := _c.mutation.Key(); ok {
+		_spec.SetField(entity.FieldKey, field.TypeString, value)
+		_node.Key = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(entity.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.ArticleEntitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   entity.ArticleEntitiesTable,
+			Columns: []string{entity.ArticleEntitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(articleentity.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Entity.Create().
+//		SetName(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EntityUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *EntityCreate) OnConflict(opts ...sql.ConflictOption) *EntityUpsertOne {
+	_c.conflict = opts
+	return &EntityUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Entity.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *EntityCreate) OnConflictColumns(columns ...string) *EntityUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &EntityUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// EntityUpsertOne is the builder for "upsert"-ing
+	//  one Entity node.
+	EntityUpsertOne struct {
+		create *EntityCreate
+	}
+
+	// EntityUpsert is the "OnConflict" setter.
+	EntityUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetName sets the "name" field.
+func (u *EntityUpsert) SetName(v string) *EntityUpsert {
+	u.Set(entity.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *EntityUpsert) UpdateName() *EntityUpsert {
+	u.SetExcluded(entity.FieldName)
+	return u
+}
+
+// SetType sets the "type" field.
+func (u *EntityUpsert) SetType(v string) *EntityUpsert {
+	u.Set(entity.FieldType, v)
+	return u
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *EntityUpsert) UpdateType() *EntityUpsert {
+	u.SetExcluded(entity.FieldType)
+	return u
+}
+
+// SetKey sets the "key" field.
+func (u *EntityUpsert) SetKey(v string) *EntityUpsert {
+	u.Set(entity.FieldKey, v)
+	return u
+}
+
+// UpdateKey sets the "key" field to the value that was provided on create.
+func (u *EntityUpsert) UpdateKey() *EntityUpsert {
+	u.SetExcluded(entity.FieldKey)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *EntityUpsert) SetCreatedAt(v time.Time) *EntityUpsert {
+	u.Set(entity.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *EntityUpsert) UpdateCreatedAt() *EntityUpsert {
+	u.SetExcluded(entity.FieldCreatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Entity.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(entity.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *EntityUpsertOne) UpdateNewValues() *EntityUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(entity.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Entity.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *EntityUpsertOne) Ignore() *EntityUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EntityUpsertOne) DoNothing() *EntityUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EntityCreate.OnConflict
+// documentation for more info.
+func (u *EntityUpsertOne) Update(set func(*EntityUpsert)) *EntityUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EntityUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *EntityUpsertOne) SetName(v string) *EntityUpsertOne {
+	return u.Update(func(s *EntityUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *EntityUpsertOne) UpdateName() *EntityUpsertOne {
+	return u.Update(func(s *EntityUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *EntityUpsertOne) SetType(v string) *EntityUpsertOne {
+	return u.Update(func(s *EntityUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *EntityUpsertOne) UpdateType() *EntityUpsertOne {
+	return u.Update(func(s *EntityUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetKey sets the "key" field.
+func (u *EntityUpsertOne) SetKey(v string) *EntityUpsertOne {
+	return u.Update(func(s *EntityUpsert) {
+		s.SetKey(v)
+	})
+}
+
+// UpdateKey sets the "key" field to the value that was provided on create.
+func (u *EntityUpsertOne) UpdateKey() *EntityUpsertOne {
+	return u.Update(func(s *EntityUpsert) {
+		s.UpdateKey()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *EntityUpsertOne) SetCreatedAt(v time.Time) *EntityUpsertOne {
+	return u.Update(func(s *EntityUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *EntityUpsertOne) UpdateCreatedAt() *EntityUpsertOne {
+	return u.Update(func(s *EntityUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *EntityUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for EntityCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EntityUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *EntityUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *EntityUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// EntityCreateBulk is the builder for creating many Entity entities in bulk.
+type EntityCreateBulk struct {
+	config
+	err      error
+	builders []*EntityCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Entity entities in the database.
+func (_c *EntityCreateBulk) Save(ctx context.Context) ([]*Entity, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Entity, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EntityMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *EntityCreateBulk) SaveX(ctx context.Context) []*Entity {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EntityCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EntityCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Entity.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EntityUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *EntityCreateBulk) OnConflict(opts ...sql.ConflictOption) *EntityUpsertBulk {
+	_c.conflict = opts
+	return &EntityUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Entity.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *EntityCreateBulk) OnConflictColumns(columns ...string) *EntityUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &EntityUpsertBulk{
+		create: _c,
+	}
+}
+
+// EntityUpsertBulk is the builder for "upsert"-ing
+// a bulk of Entity nodes.
+type EntityUpsertBulk struct {
+	create *EntityCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Entity.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(entity.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *EntityUpsertBulk) UpdateNewValues() *EntityUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(entity.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Entity.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *EntityUpsertBulk) Ignore() *EntityUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EntityUpsertBulk) DoNothing() *EntityUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EntityCreateBulk.OnConflict
+// documentation for more info.
+func (u *EntityUpsertBulk) Update(set func(*EntityUpsert)) *EntityUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EntityUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *EntityUpsertBulk) SetName(v string) *EntityUpsertBulk {
+	return u.Update(func(s *EntityUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *EntityUpsertBulk) UpdateName() *EntityUpsertBulk {
+	return u.Update(func(s *EntityUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *EntityUpsertBulk) SetType(v string) *EntityUpsertBulk {
+	return u.Update(func(s *EntityUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *EntityUpsertBulk) UpdateType() *EntityUpsertBulk {
+	return u.Update(func(s *EntityUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetKey sets the "key" field.
+func (u *EntityUpsertBulk) SetKey(v string) *EntityUpsertBulk {
+	return u.Update(func(s *EntityUpsert) {
+		s.SetKey(v)
+	})
+}
+
+// UpdateKey sets the "key" field to the value that was provided on create.
+func (u *EntityUpsertBulk) UpdateKey() *EntityUpsertBulk {
+	return u.Update(func(s *EntityUpsert) {
+		s.UpdateKey()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *EntityUpsertBulk) SetCreatedAt(v time.Time) *EntityUpsertBulk {
+	return u.Update(func(s *EntityUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *EntityUpsertBulk) UpdateCreatedAt() *EntityUpsertBulk {
+	return u.Update(func(s *EntityUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *EntityUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the EntityCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for EntityCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EntityUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -21,6 +21,30 @@ func (f ActionGuideFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ActionGuideMutation", m)
 }
 
+// The AnalysisLensFunc type is an adapter to allow the use of ordinary
+// function as AnalysisLens mutator.
+type AnalysisLensFunc func(context.Context, *ent.AnalysisLensMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AnalysisLensFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AnalysisLensMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AnalysisLensMutation", m)
+}
+
+// The AnalysisSectionFunc type is an adapter to allow the use of ordinary
+// function as AnalysisSection mutator.
+type AnalysisSectionFunc func(context.Context, *ent.AnalysisSectionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AnalysisSectionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AnalysisSectionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AnalysisSectionMutation", m)
+}
+
 // The ArticleFunc type is an adapter to allow the use of ordinary
 // function as Article mutator.
 type ArticleFunc func(context.Context, *ent.ArticleMutation) (ent.Value, error)
@@ -33,6 +57,30 @@ func (f ArticleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, err
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ArticleMutation", m)
 }
 
+// The ArticleEntityFunc type is an adapter to allow the use of ordinary
+// function as ArticleEntity mutator.
+type ArticleEntityFunc func(context.Context, *ent.ArticleEntityMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ArticleEntityFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ArticleEntityMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ArticleEntityMutation", m)
+}
+
+// The ClaimVerificationFunc type is an adapter to allow the use of ordinary
+// function as ClaimVerification mutator.
+type ClaimVerificationFunc func(context.Context, *ent.ClaimVerificationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ClaimVerificationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ClaimVerificationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ClaimVerificationMutation", m)
+}
+
 // The DeepAnalysisResultFunc type is an adapter to allow the use of ordinary
 // function as DeepAnalysisResult mutator.
 type DeepAnalysisResultFunc func(context.Context, *ent.DeepAnalysisResultMutation) (ent.Value, error)
@@ -57,6 +105,30 @@ func (f DomainReportFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DomainReportMutation", m)
 }
 
+// The DomainRunFunc type is an adapter to allow the use of ordinary
+// function as DomainRun mutator.
+type DomainRunFunc func(context.Context, *ent.DomainRunMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DomainRunFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DomainRunMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DomainRunMutation", m)
+}
+
+// The EntityFunc type is an adapter to allow the use of ordinary
+// function as Entity mutator.
+type EntityFunc func(context.Context, *ent.EntityMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f EntityFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.EntityMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.EntityMutation", m)
+}
+
 // The KeyEventFunc type is an adapter to allow the use of ordinary
 // function as KeyEvent mutator.
 type KeyEventFunc func(context.Context, *ent.KeyEventMutation) (ent.Value, error)
@@ -69,6 +141,42 @@ func (f KeyEventFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, er
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.KeyEventMutation", m)
 }
 
+// The LLMCacheFunc type is an adapter to allow the use of ordinary
+// function as LLMCache mutator.
+type LLMCacheFunc func(context.Context, *ent.LLMCacheMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LLMCacheFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LLMCacheMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LLMCacheMutation", m)
+}
+
+// The LLMCallFunc type is an adapter to allow the use of ordinary
+// function as LLMCall mutator.
+type LLMCallFunc func(context.Context, *ent.LLMCallMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LLMCallFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LLMCallMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LLMCallMutation", m)
+}
+
+// The PersonaFunc type is an adapter to allow the use of ordinary
+// function as Persona mutator.
+type PersonaFunc func(context.Context, *ent.PersonaMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PersonaFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PersonaMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PersonaMutation", m)
+}
+
 // The ReportRunFunc type is an adapter to allow the use of ordinary
 // function as ReportRun mutator.
 type ReportRunFunc func(context.Context, *ent.ReportRunMutation) (ent.Value, error)
@@ -81,6 +189,18 @@ func (f ReportRunFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, e
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ReportRunMutation", m)
 }
 
+// The ReportRunEventFunc type is an adapter to allow the use of ordinary
+// function as ReportRunEvent mutator.
+type ReportRunEventFunc func(context.Context, *ent.ReportRunEventMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ReportRunEventFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ReportRunEventMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ReportRunEventMutation", m)
+}
+
 // The UserFunc type is an adapter to allow the use of ordinary
 // function as User mutator.
 type UserFunc func(context.Context, *ent.UserMutation) (ent.Value, error)
@@ -21,6 +21,8 @@ type KeyEvent struct {
 	DomainReportID int `json:"domain_report_id,omitempty"`
 	// EventContent holds the value of the "event_content" field.
 	EventContent string `json:"event_content,omitempty"`
+	// Faithfulness verdict, empty when not verified
+	Verdict string `json:"verdict,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the KeyEventQuery when eager-loading is set.
 	Edges        KeyEventEdges `json:"edges"`
@@ -31,9 +33,11 @@ type KeyEvent struct {
 type KeyEventEdges struct {
 	// DomainReport holds the value of the domain_report edge.
 	DomainReport *DomainReport `json:"domain_report,omitempty"`
+	// Articles holds the value of the articles edge.
+	Articles []*Article `json:"articles,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 }
 
 // DomainReportOrErr returns the DomainReport value or an error if the edge
@@ -47,6 +51,15 @@ func (e KeyEventEdges) DomainReportOrErr() (*DomainReport, error) {
 	return nil, &NotLoadedError{edge: "domain_report"}
 }
 
+// ArticlesOrErr returns the Articles value or an error if the edge
+// was not loaded in eager-loading.
+func (e KeyEventEdges) ArticlesOrErr() ([]*Article, error) {
+	if e.loadedTypes[1] {
+		return e.Articles, nil
+	}
+	return nil, &NotLoadedError{edge: "articles"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*KeyEvent) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -54,7 +67,7 @@ func (*KeyEvent) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case keyevent.FieldID, keyevent.FieldDomainReportID:
 			values[i] = new(sql.NullInt64)
-		case keyevent.FieldEventContent:
+		case keyevent.FieldEventContent, keyevent.FieldVerdict:
 			values[i] = new(sql.NullString)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -89,6 +102,12 @@ func (_m *KeyEvent) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.EventContent = value.String
 			}
+		case keyevent.FieldVerdict:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field verdict", values[i])
+			} else if value.Valid {
+				_m.Verdict = value.String
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -107,6 +126,11 @@ func (_m *KeyEvent) QueryDomainReport() *DomainReportQuery {
 	return NewKeyEventClient(_m.config).QueryDomainReport(_m)
 }
 
+// QueryArticles queries the "articles" edge of the KeyEvent entity.
+func (_m *KeyEvent) QueryArticles() *ArticleQuery {
+	return NewKeyEventClient(_m.config).QueryArticles(_m)
+}
+
 // Update returns a builder for updating this KeyEvent.
 // Note that you need to call KeyEvent.Unwrap() before calling this method if this KeyEvent
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -135,6 +159,9 @@ func (_m *KeyEvent) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("event_content=")
 	builder.WriteString(_m.EventContent)
+	builder.WriteString(", ")
+	builder.WriteString("verdict=")
+	builder.WriteString(_m.Verdict)
 	builder.WriteByte(')')
 	return builder.String()
 }
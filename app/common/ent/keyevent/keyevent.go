@@ -16,8 +16,12 @@ const (
 	FieldDomainReportID = "domain_report_id"
 	// FieldEventContent holds the string denoting the event_content field in the database.
 	FieldEventContent = "event_content"
+	// FieldVerdict holds the string denoting the verdict field in the database.
+	FieldVerdict = "verdict"
 	// EdgeDomainReport holds the string denoting the domain_report edge name in mutations.
 	EdgeDomainReport = "domain_report"
+	// EdgeArticles holds the string denoting the articles edge name in mutations.
+	EdgeArticles = "articles"
 	// Table holds the table name of the keyevent in the database.
 	Table = "key_events"
 	// DomainReportTable is the table that holds the domain_report relation/edge.
@@ -27,6 +31,11 @@ const (
 	DomainReportInverseTable = "domain_reports"
 	// DomainReportColumn is the table column denoting the domain_report relation/edge.
 	DomainReportColumn = "domain_report_id"
+	// ArticlesTable is the table that holds the articles relation/edge. The primary key declared below.
+	ArticlesTable = "key_event_articles"
+	// ArticlesInverseTable is the table name for the Article entity.
+	// It exists in this package in order to avoid circular dependency with the "article" package.
+	ArticlesInverseTable = "articles"
 )
 
 // Columns holds all SQL columns for keyevent fields.
@@ -34,8 +43,15 @@ var Columns = []string{
 	FieldID,
 	FieldDomainReportID,
 	FieldEventContent,
+	FieldVerdict,
 }
 
+var (
+	// ArticlesPrimaryKey and ArticlesColumn2 are the table columns denoting the
+	// primary key for the articles relation (M2M).
+	ArticlesPrimaryKey = []string{"key_event_id", "article_id"}
+)
+
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -64,12 +80,31 @@ func ByEventContent(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEventContent, opts...).ToFunc()
 }
 
+// ByVerdict orders the results by the verdict field.
+func ByVerdict(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVerdict, opts...).ToFunc()
+}
+
 // ByDomainReportField orders the results by domain_report field.
 func ByDomainReportField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
 		sqlgraph.OrderByNeighborTerms(s, newDomainReportStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByArticlesCount orders the results by articles count.
+func ByArticlesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newArticlesStep(), opts...)
+	}
+}
+
+// ByArticles orders the results by articles terms.
+func ByArticles(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newArticlesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newDomainReportStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -77,3 +112,10 @@ func newDomainReportStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, DomainReportTable, DomainReportColumn),
 	)
 }
+func newArticlesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ArticlesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, ArticlesTable, ArticlesPrimaryKey...),
+	)
+}
@@ -63,6 +63,11 @@ func EventContent(v string) predicate.KeyEvent {
 	return predicate.KeyEvent(sql.FieldEQ(FieldEventContent, v))
 }
 
+// Verdict applies equality check predicate on the "verdict" field. It's identical to VerdictEQ.
+func Verdict(v string) predicate.KeyEvent {
+	return predicate.KeyEvent(sql.FieldEQ(FieldVerdict, v))
+}
+
 // DomainReportIDEQ applies the EQ predicate on the "domain_report_id" field.
 func DomainReportIDEQ(v int) predicate.KeyEvent {
 	return predicate.KeyEvent(sql.FieldEQ(FieldDomainReportID, v))
@@ -168,6 +173,81 @@ func EventContentContainsFold(v string) predicate.KeyEvent {
 	return predicate.KeyEvent(sql.FieldContainsFold(FieldEventContent, v))
 }
 
+// VerdictEQ applies the EQ predicate on the "verdict" field.
+func VerdictEQ(v string) predicate.KeyEvent {
+	return predicate.KeyEvent(sql.FieldEQ(FieldVerdict, v))
+}
+
+// VerdictNEQ applies the NEQ predicate on the "verdict" field.
+func VerdictNEQ(v string) predicate.KeyEvent {
+	return predicate.KeyEvent(sql.FieldNEQ(FieldVerdict, v))
+}
+
+// VerdictIn applies the In predicate on the "verdict" field.
+func VerdictIn(vs ...string) predicate.KeyEvent {
+	return predicate.KeyEvent(sql.FieldIn(FieldVerdict, vs...))
+}
+
+// VerdictNotIn applies the NotIn predicate on the "verdict" field.
+func VerdictNotIn(vs ...string) predicate.KeyEvent {
+	return predicate.KeyEvent(sql.FieldNotIn(FieldVerdict, vs...))
+}
+
+// VerdictGT applies the GT predicate on the "verdict" field.
+func VerdictGT(v string) predicate.KeyEvent {
+	return predicate.KeyEvent(sql.FieldGT(FieldVerdict, v))
+}
+
+// VerdictGTE applies the GTE predicate on the "verdict" field.
+func VerdictGTE(v string) predicate.KeyEvent {
+	return predicate.KeyEvent(sql.FieldGTE(FieldVerdict, v))
+}
+
+// VerdictLT applies the LT predicate on the "verdict" field.
+func VerdictLT(v string) predicate.KeyEvent {
+	return predicate.KeyEvent(sql.FieldLT(FieldVerdict, v))
+}
+
+// VerdictLTE applies the LTE predicate on the "verdict" field.
+func VerdictLTE(v string) predicate.KeyEvent {
+	return predicate.KeyEvent(sql.FieldLTE(FieldVerdict, v))
+}
+
+// VerdictContains applies the Contains predicate on the "verdict" field.
+func VerdictContains(v string) predicate.KeyEvent {
+	return predicate.KeyEvent(sql.FieldContains(FieldVerdict, v))
+}
+
+// VerdictHasPrefix applies the HasPrefix predicate on the "verdict" field.
+func VerdictHasPrefix(v string) predicate.KeyEvent {
+	return predicate.KeyEvent(sql.FieldHasPrefix(FieldVerdict, v))
+}
+
+// VerdictHasSuffix applies the HasSuffix predicate on the "verdict" field.
+func VerdictHasSuffix(v string) predicate.KeyEvent {
+	return predicate.KeyEvent(sql.FieldHasSuffix(FieldVerdict, v))
+}
+
+// VerdictIsNil applies the IsNil predicate on the "verdict" field.
+func VerdictIsNil() predicate.KeyEvent {
+	return predicate.KeyEvent(sql.FieldIsNull(FieldVerdict))
+}
+
+// VerdictNotNil applies the NotNil predicate on the "verdict" field.
+func VerdictNotNil() predicate.KeyEvent {
+	return predicate.KeyEvent(sql.FieldNotNull(FieldVerdict))
+}
+
+// VerdictEqualFold applies the EqualFold predicate on the "verdict" field.
+func VerdictEqualFold(v string) predicate.KeyEvent {
+	return predicate.KeyEvent(sql.FieldEqualFold(FieldVerdict, v))
+}
+
+// VerdictContainsFold applies the ContainsFold predicate on the "verdict" field.
+func VerdictContainsFold(v string) predicate.KeyEvent {
+	return predicate.KeyEvent(sql.FieldContainsFold(FieldVerdict, v))
+}
+
 // HasDomainReport applies the HasEdge predicate on the "domain_report" edge.
 func HasDomainReport() predicate.KeyEvent {
 	return predicate.KeyEvent(func(s *sql.Selector) {
@@ -191,6 +271,29 @@ func HasDomainReportWith(preds ...predicate.DomainReport) predicate.KeyEvent {
 	})
 }
 
+// HasArticles applies the HasEdge predicate on the "articles" edge.
+func HasArticles() predicate.KeyEvent {
+	return predicate.KeyEvent(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, ArticlesTable, ArticlesPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasArticlesWith applies the HasEdge predicate on the "articles" edge with a given conditions (other predicates).
+func HasArticlesWith(preds ...predicate.Article) predicate.KeyEvent {
+	return predicate.KeyEvent(func(s *sql.Selector) {
+		step := newArticlesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.KeyEvent) predicate.KeyEvent {
 	return predicate.KeyEvent(sql.AndPredicates(predicates...))
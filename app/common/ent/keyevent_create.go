@@ -4,10 +4,13 @@ package ent
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/iWorld-y/domain_radar/app/common/ent/article"
 	"github.com/iWorld-y/domain_radar/app/common/ent/domainreport"
 	"github.com/iWorld-y/domain_radar/app/common/ent/keyevent"
 )
@@ -17,6 +20,7 @@ type KeyEventCreate struct {
 	config
 	mutation *KeyEventMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetDomainReportID sets the "domain_report_id" field.
@@ -47,6 +51,20 @@ func (_c *KeyEventCreate) SetNillableEventContent(v *string) *KeyEventCreate {
 	return _c
 }
 
+// SetVerdict sets the "verdict" field.
+func (_c *KeyEventCreate) SetVerdict(v string) *KeyEventCreate {
+	_c.mutation.SetVerdict(v)
+	return _c
+}
+
+// SetNillableVerdict sets the "verdict" field if the given value is not nil.
+func (_c *KeyEventCreate) SetNillableVerdict(v *string) *KeyEventCreate {
+	if v != nil {
+		_c.SetVerdict(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *KeyEventCreate) SetID(v int) *KeyEventCreate {
 	_c.mutation.SetID(v)
@@ -58,6 +76,21 @@ func (_c *KeyEventCreate) SetDomainReport(v *DomainReport) *KeyEventCreate {
 	return _c.SetDomainReportID(v.ID)
 }
 
+// AddArticleIDs adds the "articles" edge to the Article entity by IDs.
+func (_c *KeyEventCreate) AddArticleIDs(ids ...int) *KeyEventCreate {
+	_c.mutation.AddArticleIDs(ids...)
+	return _c
+}
+
+// AddArticles adds the "articles" edges to the Article entity.
+func (_c *KeyEventCreate) AddArticles(v ...*Article) *KeyEventCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddArticleIDs(ids...)
+}
+
 // Mutation returns the KeyEventMutation object of the builder.
 func (_c *KeyEventCreate) Mutation() *KeyEventMutation {
 	return _c.mutation
@@ -120,6 +153,7 @@ func (_c *KeyEventCreate) createSpec() (*KeyEvent, *sqlgraph.CreateSpec) {
 		_node = &KeyEvent{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(keyevent.Table, sqlgraph.NewFieldSpec(keyevent.FieldID, field.TypeInt))
 	)
+	_spec.OnConflict = _c.conflict
 	if id, ok := _c.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = id
@@ -128,6 +162,10 @@ func (_c *KeyEventCreate) createSpec() (*KeyEvent, *sqlgraph.CreateSpec) {
 		_spec.SetField(keyevent.FieldEventContent, field.TypeString, value)
 		_node.EventContent = value
 	}
+	if value, ok := _c.mutation.Verdict(); ok {
+		_spec.SetField(keyevent.FieldVerdict, field.TypeString, value)
+		_node.Verdict = value
+	}
 	if nodes := _c.mutation.DomainReportIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -145,14 +183,278 @@ func (_c *KeyEventCreate) createSpec() (*KeyEvent, *sqlgraph.CreateSpec) {
 		_node.DomainReportID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.ArticlesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   keyevent.ArticlesTable,
+			Columns: keyevent.ArticlesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(article.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.KeyEvent.Create().
+//		SetDomainReportID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.KeyEventUpsert) {
+//			SetDomainReportID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *KeyEventCreate) OnConflict(opts ...sql.ConflictOption) *KeyEventUpsertOne {
+	_c.conflict = opts
+	return &KeyEventUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.KeyEvent.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *KeyEventCreate) OnConflictColumns(columns ...string) *KeyEventUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &KeyEventUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// KeyEventUpsertOne is the builder for "upsert"-ing
+	//  one KeyEvent node.
+	KeyEventUpsertOne struct {
+		create *KeyEventCreate
+	}
+
+	// KeyEventUpsert is the "OnConflict" setter.
+	KeyEventUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetDomainReportID sets the "domain_report_id" field.
+func (u *KeyEventUpsert) SetDomainReportID(v int) *KeyEventUpsert {
+	u.Set(keyevent.FieldDomainReportID, v)
+	return u
+}
+
+// UpdateDomainReportID sets the "domain_report_id" field to the value that was provided on create.
+func (u *KeyEventUpsert) UpdateDomainReportID() *KeyEventUpsert {
+	u.SetExcluded(keyevent.FieldDomainReportID)
+	return u
+}
+
+// ClearDomainReportID clears the value of the "domain_report_id" field.
+func (u *KeyEventUpsert) ClearDomainReportID() *KeyEventUpsert {
+	u.SetNull(keyevent.FieldDomainReportID)
+	return u
+}
+
+// SetEventContent sets the "event_content" field.
+func (u *KeyEventUpsert) SetEventContent(v string) *KeyEventUpsert {
+	u.Set(keyevent.FieldEventContent, v)
+	return u
+}
+
+// UpdateEventContent sets the "event_content" field to the value that was provided on create.
+func (u *KeyEventUpsert) UpdateEventContent() *KeyEventUpsert {
+	u.SetExcluded(keyevent.FieldEventContent)
+	return u
+}
+
+// ClearEventContent clears the value of the "event_content" field.
+func (u *KeyEventUpsert) ClearEventContent() *KeyEventUpsert {
+	u.SetNull(keyevent.FieldEventContent)
+	return u
+}
+
+// SetVerdict sets the "verdict" field.
+func (u *KeyEventUpsert) SetVerdict(v string) *KeyEventUpsert {
+	u.Set(keyevent.FieldVerdict, v)
+	return u
+}
+
+// UpdateVerdict sets the "verdict" field to the value that was provided on create.
+func (u *KeyEventUpsert) UpdateVerdict() *KeyEventUpsert {
+	u.SetExcluded(keyevent.FieldVerdict)
+	return u
+}
+
+// ClearVerdict clears the value of the "verdict" field.
+func (u *KeyEventUpsert) ClearVerdict() *KeyEventUpsert {
+	u.SetNull(keyevent.FieldVerdict)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.KeyEvent.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(keyevent.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *KeyEventUpsertOne) UpdateNewValues() *KeyEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(keyevent.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.KeyEvent.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *KeyEventUpsertOne) Ignore() *KeyEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *KeyEventUpsertOne) DoNothing() *KeyEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the KeyEventCreate.OnConflict
+// documentation for more info.
+func (u *KeyEventUpsertOne) Update(set func(*KeyEventUpsert)) *KeyEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&KeyEventUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetDomainReportID sets the "domain_report_id" field.
+func (u *KeyEventUpsertOne) SetDomainReportID(v int) *KeyEventUpsertOne {
+	return u.Update(func(s *KeyEventUpsert) {
+		s.SetDomainReportID(v)
+	})
+}
+
+// UpdateDomainReportID sets the "domain_report_id" field to the value that was provided on create.
+func (u *KeyEventUpsertOne) UpdateDomainReportID() *KeyEventUpsertOne {
+	return u.Update(func(s *KeyEventUpsert) {
+		s.UpdateDomainReportID()
+	})
+}
+
+// ClearDomainReportID clears the value of the "domain_report_id" field.
+func (u *KeyEventUpsertOne) ClearDomainReportID() *KeyEventUpsertOne {
+	return u.Update(func(s *KeyEventUpsert) {
+		s.ClearDomainReportID()
+	})
+}
+
+// SetEventContent sets the "event_content" field.
+func (u *KeyEventUpsertOne) SetEventContent(v string) *KeyEventUpsertOne {
+	return u.Update(func(s *KeyEventUpsert) {
+		s.SetEventContent(v)
+	})
+}
+
+// UpdateEventContent sets the "event_content" field to the value that was provided on create.
+func (u *KeyEventUpsertOne) UpdateEventContent() *KeyEventUpsertOne {
+	return u.Update(func(s *KeyEventUpsert) {
+		s.UpdateEventContent()
+	})
+}
+
+// ClearEventContent clears the value of the "event_content" field.
+func (u *KeyEventUpsertOne) ClearEventContent() *KeyEventUpsertOne {
+	return u.Update(func(s *KeyEventUpsert) {
+		s.ClearEventContent()
+	})
+}
+
+// SetVerdict sets the "verdict" field.
+func (u *KeyEventUpsertOne) SetVerdict(v string) *KeyEventUpsertOne {
+	return u.Update(func(s *KeyEventUpsert) {
+		s.SetVerdict(v)
+	})
+}
+
+// UpdateVerdict sets the "verdict" field to the value that was provided on create.
+func (u *KeyEventUpsertOne) UpdateVerdict() *KeyEventUpsertOne {
+	return u.Update(func(s *KeyEventUpsert) {
+		s.UpdateVerdict()
+	})
+}
+
+// ClearVerdict clears the value of the "verdict" field.
+func (u *KeyEventUpsertOne) ClearVerdict() *KeyEventUpsertOne {
+	return u.Update(func(s *KeyEventUpsert) {
+		s.ClearVerdict()
+	})
+}
+
+// Exec executes the query.
+func (u *KeyEventUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for KeyEventCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *KeyEventUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *KeyEventUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *KeyEventUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // KeyEventCreateBulk is the builder for creating many KeyEvent entities in bulk.
 type KeyEventCreateBulk struct {
 	config
 	err      error
 	builders []*KeyEventCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the KeyEvent entities in the database.
@@ -181,6 +483,7 @@ func (_c *KeyEventCreateBulk) Save(ctx context.Context) ([]*KeyEvent, error) {
 					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -234,3 +537,180 @@ func (_c *KeyEventCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.KeyEvent.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.KeyEventUpsert) {
+//			SetDomainReportID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *KeyEventCreateBulk) OnConflict(opts ...sql.ConflictOption) *KeyEventUpsertBulk {
+	_c.conflict = opts
+	return &KeyEventUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.KeyEvent.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *KeyEventCreateBulk) OnConflictColumns(columns ...string) *KeyEventUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &KeyEventUpsertBulk{
+		create: _c,
+	}
+}
+
+// KeyEventUpsertBulk is the builder for "upsert"-ing
+// a bulk of KeyEvent nodes.
+type KeyEventUpsertBulk struct {
+	create *KeyEventCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.KeyEvent.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(keyevent.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *KeyEventUpsertBulk) UpdateNewValues() *KeyEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(keyevent.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.KeyEvent.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *KeyEventUpsertBulk) Ignore() *KeyEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *KeyEventUpsertBulk) DoNothing() *KeyEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the KeyEventCreateBulk.OnConflict
+// documentation for more info.
+func (u *KeyEventUpsertBulk) Update(set func(*KeyEventUpsert)) *KeyEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&KeyEventUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetDomainReportID sets the "domain_report_id" field.
+func (u *KeyEventUpsertBulk) SetDomainReportID(v int) *KeyEventUpsertBulk {
+	return u.Update(func(s *KeyEventUpsert) {
+		s.SetDomainReportID(v)
+	})
+}
+
+// UpdateDomainReportID sets the "domain_report_id" field to the value that was provided on create.
+func (u *KeyEventUpsertBulk) UpdateDomainReportID() *KeyEventUpsertBulk {
+	return u.Update(func(s *KeyEventUpsert) {
+		s.UpdateDomainReportID()
+	})
+}
+
+// ClearDomainReportID clears the value of the "domain_report_id" field.
+func (u *KeyEventUpsertBulk) ClearDomainReportID() *KeyEventUpsertBulk {
+	return u.Update(func(s *KeyEventUpsert) {
+		s.ClearDomainReportID()
+	})
+}
+
+// SetEventContent sets the "event_content" field.
+func (u *KeyEventUpsertBulk) SetEventContent(v string) *KeyEventUpsertBulk {
+	return u.Update(func(s *KeyEventUpsert) {
+		s.SetEventContent(v)
+	})
+}
+
+// UpdateEventContent sets the "event_content" field to the value that was provided on create.
+func (u *KeyEventUpsertBulk) UpdateEventContent() *KeyEventUpsertBulk {
+	return u.Update(func(s *KeyEventUpsert) {
+		s.UpdateEventContent()
+	})
+}
+
+// ClearEventContent clears the value of the "event_content" field.
+func (u *KeyEventUpsertBulk) ClearEventContent() *KeyEventUpsertBulk {
+	return u.Update(func(s *KeyEventUpsert) {
+		s.ClearEventContent()
+	})
+}
+
+// SetVerdict sets the "verdict" field.
+func (u *KeyEventUpsertBulk) SetVerdict(v string) *KeyEventUpsertBulk {
+	return u.Update(func(s *KeyEventUpsert) {
+		s.SetVerdict(v)
+	})
+}
+
+// UpdateVerdict sets the "verdict" field to the value that was provided on create.
+func (u *KeyEventUpsertBulk) UpdateVerdict() *KeyEventUpsertBulk {
+	return u.Update(func(s *KeyEventUpsert) {
+		s.UpdateVerdict()
+	})
+}
+
+// ClearVerdict clears the value of the "verdict" field.
+func (u *KeyEventUpsertBulk) ClearVerdict() *KeyEventUpsertBulk {
+	return u.Update(func(s *KeyEventUpsert) {
+		s.ClearVerdict()
+	})
+}
+
+// Exec executes the query.
+func (u *KeyEventUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the KeyEventCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for KeyEventCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *KeyEventUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
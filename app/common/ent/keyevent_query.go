@@ -4,6 +4,7 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 
@@ -11,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/iWorld-y/domain_radar/app/common/ent/article"
 	"github.com/iWorld-y/domain_radar/app/common/ent/domainreport"
 	"github.com/iWorld-y/domain_radar/app/common/ent/keyevent"
 	"github.com/iWorld-y/domain_radar/app/common/ent/predicate"
@@ -24,6 +26,7 @@ type KeyEventQuery struct {
 	inters           []Interceptor
 	predicates       []predicate.KeyEvent
 	withDomainReport *DomainReportQuery
+	withArticles     *ArticleQuery
 	modifiers        []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -83,6 +86,28 @@ func (_q *KeyEventQuery) QueryDomainReport() *DomainReportQuery {
 	return query
 }
 
+// QueryArticles chains the current query on the "articles" edge.
+func (_q *KeyEventQuery) QueryArticles() *ArticleQuery {
+	query := (&ArticleClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(keyevent.Table, keyevent.FieldID, selector),
+			sqlgraph.To(article.Table, article.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, keyevent.ArticlesTable, keyevent.ArticlesPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first KeyEvent entity from the query.
 // Returns a *NotFoundError when no KeyEvent was found.
 func (_q *KeyEventQuery) First(ctx context.Context) (*KeyEvent, error) {
@@ -276,6 +301,7 @@ func (_q *KeyEventQuery) Clone() *KeyEventQuery {
 		inters:           append([]Interceptor{}, _q.inters...),
 		predicates:       append([]predicate.KeyEvent{}, _q.predicates...),
 		withDomainReport: _q.withDomainReport.Clone(),
+		withArticles:     _q.withArticles.Clone(),
 		// clone intermediate query.
 		sql:       _q.sql.Clone(),
 		path:      _q.path,
@@ -294,6 +320,17 @@ func (_q *KeyEventQuery) WithDomainReport(opts ...func(*DomainReportQuery)) *Key
 	return _q
 }
 
+// WithArticles tells the query-builder to eager-load the nodes that are connected to
+// the "articles" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *KeyEventQuery) WithArticles(opts ...func(*ArticleQuery)) *KeyEventQuery {
+	query := (&ArticleClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withArticles = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -372,8 +409,9 @@ func (_q *KeyEventQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Key
 	var (
 		nodes       = []*KeyEvent{}
 		_spec       = _q.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [2]bool{
 			_q.withDomainReport != nil,
+			_q.withArticles != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -403,6 +441,13 @@ func (_q *KeyEventQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Key
 			return nil, err
 		}
 	}
+	if query := _q.withArticles; query != nil {
+		if err := _q.loadArticles(ctx, query, nodes,
+			func(n *KeyEvent) { n.Edges.Articles = []*Article{} },
+			func(n *KeyEvent, e *Article) { n.Edges.Articles = append(n.Edges.Articles, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -435,6 +480,67 @@ func (_q *KeyEventQuery) loadDomainReport(ctx context.Context, query *DomainRepo
 	}
 	return nil
 }
+func (_q *KeyEventQuery) loadArticles(ctx context.Context, query *ArticleQuery, nodes []*KeyEvent, init func(*KeyEvent), assign func(*KeyEvent, *Article)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[int]*KeyEvent)
+	nids := make(map[int]map[*KeyEvent]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(keyevent.ArticlesTable)
+		s.Join(joinT).On(s.C(article.FieldID), joinT.C(keyevent.ArticlesPrimaryKey[1]))
+		s.Where(sql.InValues(joinT.C(keyevent.ArticlesPrimaryKey[0]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(keyevent.ArticlesPrimaryKey[0]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := int(values[0].(*sql.NullInt64).Int64)
+				inValue := int(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*KeyEvent]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Article](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "articles" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
 
 func (_q *KeyEventQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -10,6 +10,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/iWorld-y/domain_radar/app/common/ent/article"
 	"github.com/iWorld-y/domain_radar/app/common/ent/domainreport"
 	"github.com/iWorld-y/domain_radar/app/common/ent/keyevent"
 	"github.com/iWorld-y/domain_radar/app/common/ent/predicate"
@@ -69,11 +70,46 @@ func (_u *KeyEventUpdate) ClearEventContent() *KeyEventUpdate {
 	return _u
 }
 
+// SetVerdict sets the "verdict" field.
+func (_u *KeyEventUpdate) SetVerdict(v string) *KeyEventUpdate {
+	_u.mutation.SetVerdict(v)
+	return _u
+}
+
+// SetNillableVerdict sets the "verdict" field if the given value is not nil.
+func (_u *KeyEventUpdate) SetNillableVerdict(v *string) *KeyEventUpdate {
+	if v != nil {
+		_u.SetVerdict(*v)
+	}
+	return _u
+}
+
+// ClearVerdict clears the value of the "verdict" field.
+func (_u *KeyEventUpdate) ClearVerdict() *KeyEventUpdate {
+	_u.mutation.ClearVerdict()
+	return _u
+}
+
 // SetDomainReport sets the "domain_report" edge to the DomainReport entity.
 func (_u *KeyEventUpdate) SetDomainReport(v *DomainReport) *KeyEventUpdate {
 	return _u.SetDomainReportID(v.ID)
 }
 
+// AddArticleIDs adds the "articles" edge to the Article entity by IDs.
+func (_u *KeyEventUpdate) AddArticleIDs(ids ...int) *KeyEventUpdate {
+	_u.mutation.AddArticleIDs(ids...)
+	return _u
+}
+
+// AddArticles adds the "articles" edges to the Article entity.
+func (_u *KeyEventUpdate) AddArticles(v ...*Article) *KeyEventUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddArticleIDs(ids...)
+}
+
 // Mutation returns the KeyEventMutation object of the builder.
 func (_u *KeyEventUpdate) Mutation() *KeyEventMutation {
 	return _u.mutation
@@ -85,6 +121,27 @@ func (_u *KeyEventUpdate) ClearDomainReport() *KeyEventUpdate {
 	return _u
 }
 
+// ClearArticles clears all "articles" edges to the Article entity.
+func (_u *KeyEventUpdate) ClearArticles() *KeyEventUpdate {
+	_u.mutation.ClearArticles()
+	return _u
+}
+
+// RemoveArticleIDs removes the "articles" edge to Article entities by IDs.
+func (_u *KeyEventUpdate) RemoveArticleIDs(ids ...int) *KeyEventUpdate {
+	_u.mutation.RemoveArticleIDs(ids...)
+	return _u
+}
+
+// RemoveArticles removes "articles" edges to Article entities.
+func (_u *KeyEventUpdate) RemoveArticles(v ...*Article) *KeyEventUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveArticleIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *KeyEventUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -133,6 +190,12 @@ func (_u *KeyEventUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.EventContentCleared() {
 		_spec.ClearField(keyevent.FieldEventContent, field.TypeString)
 	}
+	if value, ok := _u.mutation.Verdict(); ok {
+		_spec.SetField(keyevent.FieldVerdict, field.TypeString, value)
+	}
+	if _u.mutation.VerdictCleared() {
+		_spec.ClearField(keyevent.FieldVerdict, field.TypeString)
+	}
 	if _u.mutation.DomainReportCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -162,6 +225,51 @@ func (_u *KeyEventUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ArticlesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   keyevent.ArticlesTable,
+			Columns: keyevent.ArticlesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(article.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedArticlesIDs(); len(nodes) > 0 && !_u.mutation.ArticlesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   keyevent.ArticlesTable,
+			Columns: keyevent.ArticlesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(article.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ArticlesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   keyevent.ArticlesTable,
+			Columns: keyevent.ArticlesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(article.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -224,11 +332,46 @@ func (_u *KeyEventUpdateOne) ClearEventContent() *KeyEventUpdateOne {
 	return _u
 }
 
+// SetVerdict sets the "verdict" field.
+func (_u *KeyEventUpdateOne) SetVerdict(v string) *KeyEventUpdateOne {
+	_u.mutation.SetVerdict(v)
+	return _u
+}
+
+// SetNillableVerdict sets the "verdict" field if the given value is not nil.
+func (_u *KeyEventUpdateOne) SetNillableVerdict(v *string) *KeyEventUpdateOne {
+	if v != nil {
+		_u.SetVerdict(*v)
+	}
+	return _u
+}
+
+// ClearVerdict clears the value of the "verdict" field.
+func (_u *KeyEventUpdateOne) ClearVerdict() *KeyEventUpdateOne {
+	_u.mutation.ClearVerdict()
+	return _u
+}
+
 // SetDomainReport sets the "domain_report" edge to the DomainReport entity.
 func (_u *KeyEventUpdateOne) SetDomainReport(v *DomainReport) *KeyEventUpdateOne {
 	return _u.SetDomainReportID(v.ID)
 }
 
+// AddArticleIDs adds the "articles" edge to the Article entity by IDs.
+func (_u *KeyEventUpdateOne) AddArticleIDs(ids ...int) *KeyEventUpdateOne {
+	_u.mutation.AddArticleIDs(ids...)
+	return _u
+}
+
+// AddArticles adds the "articles" edges to the Article entity.
+func (_u *KeyEventUpdateOne) AddArticles(v ...*Article) *KeyEventUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddArticleIDs(ids...)
+}
+
 // Mutation returns the KeyEventMutation object of the builder.
 func (_u *KeyEventUpdateOne) Mutation() *KeyEventMutation {
 	return _u.mutation
@@ -240,6 +383,27 @@ func (_u *KeyEventUpdateOne) ClearDomainReport() *KeyEventUpdateOne {
 	return _u
 }
 
+// ClearArticles clears all "articles" edges to the Article entity.
+func (_u *KeyEventUpdateOne) ClearArticles() *KeyEventUpdateOne {
+	_u.mutation.ClearArticles()
+	return _u
+}
+
+// RemoveArticleIDs removes the "articles" edge to Article entities by IDs.
+func (_u *KeyEventUpdateOne) RemoveArticleIDs(ids ...int) *KeyEventUpdateOne {
+	_u.mutation.RemoveArticleIDs(ids...)
+	return _u
+}
+
+// RemoveArticles removes "articles" edges to Article entities.
+func (_u *KeyEventUpdateOne) RemoveArticles(v ...*Article) *KeyEventUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveArticleIDs(ids...)
+}
+
 // Where appends a list predicates to the KeyEventUpdate builder.
 func (_u *KeyEventUpdateOne) Where(ps ...predicate.KeyEvent) *KeyEventUpdateOne {
 	_u.mutation.Where(ps...)
@@ -318,6 +482,12 @@ func (_u *KeyEventUpdateOne) sqlSave(ctx context.Context) (_node *KeyEvent, err
 	if _u.mutation.EventContentCleared() {
 		_spec.ClearField(keyevent.FieldEventContent, field.TypeString)
 	}
+	if value, ok := _u.mutation.Verdict(); ok {
+		_spec.SetField(keyevent.FieldVerdict, field.TypeString, value)
+	}
+	if _u.mutation.VerdictCleared() {
+		_spec.ClearField(keyevent.FieldVerdict, field.TypeString)
+	}
 	if _u.mutation.DomainReportCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -347,6 +517,51 @@ func (_u *KeyEventUpdateOne) sqlSave(ctx context.Context) (_node *KeyEvent, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ArticlesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   keyevent.ArticlesTable,
+			Columns: keyevent.ArticlesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(article.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedArticlesIDs(); len(nodes) > 0 && !_u.mutation.ArticlesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   keyevent.ArticlesTable,
+			Columns: keyevent.ArticlesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(article.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ArticlesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   keyevent.ArticlesTable,
+			Columns: keyevent.ArticlesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(article.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &KeyEvent{config: _u.config}
 	_spec.Assign = _node.assignValues
@@ -0,0 +1,1023 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/iWorld-y/domain_radar/app/common/ent/llmcache"
+)
+
+// LLMCacheCreate is the builder for creating a LLMCache entity.
+type LLMCacheCreate struct {
+	config
+	mutation *LLMCacheMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetKey sets the "key" field.
+func (_c *LLMCacheCreate) SetKey(v string) *LLMCacheCreate {
+	_c.mutation.SetKey(v)
+	return _c
+}
+
+// SetModel sets the "model" field.
+func (_c *LLMCacheCreate) SetModel(v string) *LLMCacheCreate {
+	_c.mutation.SetModel(v)
+	return _c
+}
+
+// SetTemplateVersion sets the "template_version" field.
+func (_c *LLMCacheCreate) SetTemplateVersion(v string) *LLMCacheCreate {
+	_c.mutation.SetTemplateVersion(v)
+	return _c
+}
+
+// SetContent sets the "content" field.
+func (_c *LLMCacheCreate) SetContent(v string) *LLMCacheCreate {
+	_c.mutation.SetContent(v)
+	return _c
+}
+
+// SetPromptTokens sets the "prompt_tokens" field.
+func (_c *LLMCacheCreate) SetPromptTokens(v int) *LLMCacheCreate {
+	_c.mutation.SetPromptTokens(v)
+	return _c
+}
+
+// SetNillablePromptTokens sets the "prompt_tokens" field if the given value is not nil.
+func (_c *LLMCacheCreate) SetNillablePromptTokens(v *int) *LLMCacheCreate {
+	if v != nil {
+		_c.SetPromptTokens(*v)
+	}
+	return _c
+}
+
+// SetCompletionTokens sets the "completion_tokens" field.
+func (_c *LLMCacheCreate) SetCompletionTokens(v int) *LLMCacheCreate {
+	_c.mutation.SetCompletionTokens(v)
+	return _c
+}
+
+// SetNillableCompletionTokens sets the "completion_tokens" field if the given value is not nil.
+func (_c *LLMCacheCreate) SetNillableCompletionTokens(v *int) *LLMCacheCreate {
+	if v != nil {
+		_c.SetCompletionTokens(*v)
+	}
+	return _c
+}
+
+// SetHits sets the "hits" field.
+func (_c *LLMCacheCreate) SetHits(v int) *LLMCacheCreate {
+	_c.mutation.SetHits(v)
+	return _c
+}
+
+// SetNillableHits sets the "hits" field if the given value is not nil.
+func (_c *LLMCacheCreate) SetNillableHits(v *int) *LLMCacheCreate {
+	if v != nil {
+		_c.SetHits(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *LLMCacheCreate) SetExpiresAt(v time.Time) *LLMCacheCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *LLMCacheCreate) SetCreatedAt(v time.Time) *LLMCacheCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *LLMCacheCreate) SetNillableCreatedAt(v *time.Time) *LLMCacheCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *LLMCacheCreate) SetID(v int) *LLMCacheCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the LLMCacheMutation object of the builder.
+func (_c *LLMCacheCreate) Mutation() *LLMCacheMutation {
+	return _c.mutation
+}
+
+// Save creates the LLMCache in the database.
+func (_c *LLMCacheCreate) Save(ctx context.Context) (*LLMCache, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *LLMCacheCreate) SaveX(ctx context.Context) *LLMCache {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LLMCacheCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LLMCacheCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *LLMCacheCreate) defaults() {
+	if _, ok := _c.mutation.PromptTokens(); !ok {
+		v := llmcache.DefaultPromptTokens
+		_c.mutation.SetPromptTokens(v)
+	}
+	if _, ok := _c.mutation.CompletionTokens(); !ok {
+		v := llmcache.DefaultCompletionTokens
+		_c.mutation.SetCompletionTokens(v)
+	}
+	if _, ok := _c.mutation.Hits(); !ok {
+		v := llmcache.DefaultHits
+		_c.mutation.SetHits(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := llmcache.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *LLMCacheCreate) check() error {
+	if _, ok := _c.mutation.Key(); !ok {
+		return &ValidationError{Name: "key", err: errors.New(`ent: missing required field "LLMCache.key"`)}
+	}
+	if _, ok := _c.mutation.Model(); !ok {
+		return &ValidationError{Name: "model", err: errors.New(`ent: missing required field "LLMCache.model"`)}
+	}
+	if _, ok := _c.mutation.TemplateVersion(); !ok {
+		return &ValidationError{Name: "template_version", err: errors.New(`ent: missing required field "LLMCache.template_version"`)}
+	}
+	if _, ok := _c.mutation.Content(); !ok {
+		return &ValidationError{Name: "content", err: errors.New(`ent: missing required field "LLMCache.content"`)}
+	}
+	if _, ok := _c.mutation.PromptTokens(); !ok {
+		return &ValidationError{Name: "prompt_tokens", err: errors.New(`ent: missing required field "LLMCache.prompt_tokens"`)}
+	}
+	if _, ok := _c.mutation.CompletionTokens(); !ok {
+		return &ValidationError{Name: "completion_tokens", err: errors.New(`ent: missing required field "LLMCache.completion_tokens"`)}
+	}
+	if _, ok := _c.mutation.Hits(); !ok {
+		return &ValidationError{Name: "hits", err: errors.New(`ent: missing required field "LLMCache.hits"`)}
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "LLMCache.expires_at"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "LLMCache.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *LLMCacheCreate) sqlSave(ctx context.Context) (*LLMCache, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *LLMCacheCreate) createSpec() (*LLMCache, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LLMCache{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(llmcache.Table, sqlgraph.NewFieldSpec(llmcache.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.Key(); ok {
+		_spec.SetField(llmcache.FieldKey, field.TypeString, value)
+		_node.Key = value
+	}
+	if value, ok := _c.mutation.Model(); ok {
+		_spec.SetField(llmcache.FieldModel, field.TypeString, value)
+		_node.Model = value
+	}
+	if value, ok := _c.mutation.TemplateVersion(); ok {
+		_spec.SetField(llmcache.FieldTemplateVersion, field.TypeString, value)
+		_node.TemplateVersion = value
+	}
+	if value, ok := _c.mutation.Content(); ok {
+		_spec.SetField(llmcache.FieldContent, field.TypeString, value)
+		_node.Content = value
+	}
+	if value, ok := _c.mutation.PromptTokens(); ok {
+		_spec.SetField(llmcache.FieldPromptTokens, field.TypeInt, value)
+		_node.PromptTokens = value
+	}
+	if value, ok := _c.mutation.CompletionTokens(); ok {
+		_spec.SetField(llmcache.FieldCompletionTokens, field.TypeInt, value)
+		_node.CompletionTokens = value
+	}
+	if value, ok := _c.mutation.Hits(); ok {
+		_spec.SetField(llmcache.FieldHits, field.TypeInt, value)
+		_node.Hits = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(llmcache.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(llmcache.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LLMCache.Create().
+//		SetKey(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LLMCacheUpsert) {
+//			SetKey(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *LLMCacheCreate) OnConflict(opts ...sql.ConflictOption) *LLMCacheUpsertOne {
+	_c.conflict = opts
+	return &LLMCacheUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LLMCache.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *LLMCacheCreate) OnConflictColumns(columns ...string) *LLMCacheUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &LLMCacheUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// LLMCacheUpsertOne is the builder for "upsert"-ing
+	//  one LLMCache node.
+	LLMCacheUpsertOne struct {
+		create *LLMCacheCreate
+	}
+
+	// LLMCacheUpsert is the "OnConflict" setter.
+	LLMCacheUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetKey sets the "key" field.
+func (u *LLMCacheUpsert) SetKey(v string) *LLMCacheUpsert {
+	u.Set(llmcache.FieldKey, v)
+	return u
+}
+
+// UpdateKey sets the "key" field to the value that was provided on create.
+func (u *LLMCacheUpsert) UpdateKey() *LLMCacheUpsert {
+	u.SetExcluded(llmcache.FieldKey)
+	return u
+}
+
+// SetModel sets the "model" field.
+func (u *LLMCacheUpsert) SetModel(v string) *LLMCacheUpsert {
+	u.Set(llmcache.FieldModel, v)
+	return u
+}
+
+// UpdateModel sets the "model" field to the value that was provided on create.
+func (u *LLMCacheUpsert) UpdateModel() *LLMCacheUpsert {
+	u.SetExcluded(llmcache.FieldModel)
+	return u
+}
+
+// SetTemplateVersion sets the "template_version" field.
+func (u *LLMCacheUpsert) SetTemplateVersion(v string) *LLMCacheUpsert {
+	u.Set(llmcache.FieldTemplateVersion, v)
+	return u
+}
+
+// UpdateTemplateVersion sets the "template_version" field to the value that was provided on create.
+func (u *LLMCacheUpsert) UpdateTemplateVersion() *LLMCacheUpsert {
+	u.SetExcluded(llmcache.FieldTemplateVersion)
+	return u
+}
+
+// SetContent sets the "content" field.
+func (u *LLMCacheUpsert) SetContent(v string) *LLMCacheUpsert {
+	u.Set(llmcache.FieldContent, v)
+	return u
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *LLMCacheUpsert) UpdateContent() *LLMCacheUpsert {
+	u.SetExcluded(llmcache.FieldContent)
+	return u
+}
+
+// SetPromptTokens sets the "prompt_tokens" field.
+func (u *LLMCacheUpsert) SetPromptTokens(v int) *LLMCacheUpsert {
+	u.Set(llmcache.FieldPromptTokens, v)
+	return u
+}
+
+// UpdatePromptTokens sets the "prompt_tokens" field to the value that was provided on create.
+func (u *LLMCacheUpsert) UpdatePromptTokens() *LLMCacheUpsert {
+	u.SetExcluded(llmcache.FieldPromptTokens)
+	return u
+}
+
+// AddPromptTokens adds v to the "prompt_tokens" field.
+func (u *LLMCacheUpsert) AddPromptTokens(v int) *LLMCacheUpsert {
+	u.Add(llmcache.FieldPromptTokens, v)
+	return u
+}
+
+// SetCompletionTokens sets the "completion_tokens" field.
+func (u *LLMCacheUpsert) SetCompletionTokens(v int) *LLMCacheUpsert {
+	u.Set(llmcache.FieldCompletionTokens, v)
+	return u
+}
+
+// UpdateCompletionTokens sets the "completion_tokens" field to the value that was provided on create.
+func (u *LLMCacheUpsert) UpdateCompletionTokens() *LLMCacheUpsert {
+	u.SetExcluded(llmcache.FieldCompletionTokens)
+	return u
+}
+
+// AddCompletionTokens adds v to the "completion_tokens" field.
+func (u *LLMCacheUpsert) AddCompletionTokens(v int) *LLMCacheUpsert {
+	u.Add(llmcache.FieldCompletionTokens, v)
+	return u
+}
+
+// SetHits sets the "hits" field.
+func (u *LLMCacheUpsert) SetHits(v int) *LLMCacheUpsert {
+	u.Set(llmcache.FieldHits, v)
+	return u
+}
+
+// UpdateHits sets the "hits" field to the value that was provided on create.
+func (u *LLMCacheUpsert) UpdateHits() *LLMCacheUpsert {
+	u.SetExcluded(llmcache.FieldHits)
+	return u
+}
+
+// AddHits adds v to the "hits" field.
+func (u *LLMCacheUpsert) AddHits(v int) *LLMCacheUpsert {
+	u.Add(llmcache.FieldHits, v)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *LLMCacheUpsert) SetExpiresAt(v time.Time) *LLMCacheUpsert {
+	u.Set(llmcache.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *LLMCacheUpsert) UpdateExpiresAt() *LLMCacheUpsert {
+	u.SetExcluded(llmcache.FieldExpiresAt)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *LLMCacheUpsert) SetCreatedAt(v time.Time) *LLMCacheUpsert {
+	u.Set(llmcache.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *LLMCacheUpsert) UpdateCreatedAt() *LLMCacheUpsert {
+	u.SetExcluded(llmcache.FieldCreatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.LLMCache.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(llmcache.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LLMCacheUpsertOne) UpdateNewValues() *LLMCacheUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(llmcache.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LLMCache.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *LLMCacheUpsertOne) Ignore() *LLMCacheUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LLMCacheUpsertOne) DoNothing() *LLMCacheUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LLMCacheCreate.OnConflict
+// documentation for more info.
+func (u *LLMCacheUpsertOne) Update(set func(*LLMCacheUpsert)) *LLMCacheUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LLMCacheUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetKey sets the "key" field.
+func (u *LLMCacheUpsertOne) SetKey(v string) *LLMCacheUpsertOne {
+	return u.Update(func(s *LLMCacheUpsert) {
+		s.SetKey(v)
+	})
+}
+
+// UpdateKey sets the "key" field to the value that was provided on create.
+func (u *LLMCacheUpsertOne) UpdateKey() *LLMCacheUpsertOne {
+	return u.Update(func(s *LLMCacheUpsert) {
+		s.UpdateKey()
+	})
+}
+
+// SetModel sets the "model" field.
+func (u *LLMCacheUpsertOne) SetModel(v string) *LLMCacheUpsertOne {
+	return u.Update(func(s *LLMCacheUpsert) {
+		s.SetModel(v)
+	})
+}
+
+// UpdateModel sets the "model" field to the value that was provided on create.
+func (u *LLMCacheUpsertOne) UpdateModel() *LLMCacheUpsertOne {
+	return u.Update(func(s *LLMCacheUpsert) {
+		s.UpdateModel()
+	})
+}
+
+// SetTemplateVersion sets the "template_version" field.
+func (u *LLMCacheUpsertOne) SetTemplateVersion(v string) *LLMCacheUpsertOne {
+	return u.Update(func(s *LLMCacheUpsert) {
+		s.SetTemplateVersion(v)
+	})
+}
+
+// UpdateTemplateVersion sets the "template_version" field to the value that was provided on create.
+func (u *LLMCacheUpsertOne) UpdateTemplateVersion() *LLMCacheUpsertOne {
+	return u.Update(func(s *LLMCacheUpsert) {
+		s.UpdateTemplateVersion()
+	})
+}
+
+// SetContent sets the "content" field.
+func (u *LLMCacheUpsertOne) SetContent(v string) *LLMCacheUpsertOne {
+	return u.Update(func(s *LLMCacheUpsert) {
+		s.SetContent(v)
+	})
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *LLMCacheUpsertOne) UpdateContent() *LLMCacheUpsertOne {
+	return u.Update(func(s *LLMCacheUpsert) {
+		s.UpdateContent()
+	})
+}
+
+// SetPromptTokens sets the "prompt_tokens" field.
+func (u *LLMCacheUpsertOne) SetPromptTokens(v int) *LLMCacheUpsertOne {
+	return u.Update(func(s *LLMCacheUpsert) {
+		s.SetPromptTokens(v)
+	})
+}
+
+// AddPromptTokens adds v to the "prompt_tokens" field.
+func (u *LLMCacheUpsertOne) AddPromptTokens(v int) *LLMCacheUpsertOne {
+	return u.Update(func(s *LLMCacheUpsert) {
+		s.AddPromptTokens(v)
+	})
+}
+
+// UpdatePromptTokens sets the "prompt_tokens" field to the value that was provided on create.
+func (u *LLMCacheUpsertOne) UpdatePromptTokens() *LLMCacheUpsertOne {
+	return u.Update(func(s *LLMCacheUpsert) {
+		s.UpdatePromptTokens()
+	})
+}
+
+// SetCompletionTokens sets the "completion_tokens" field.
+func (u *LLMCacheUpsertOne) SetCompletionTokens(v int) *LLMCacheUpsertOne {
+	return u.Update(func(s *LLMCacheUpsert) {
+		s.SetCompletionTokens(v)
+	})
+}
+
+// AddCompletionTokens adds v to the "completion_tokens" field.
+func (u *LLMCacheUpsertOne) AddCompletionTokens(v int) *LLMCacheUpsertOne {
+	return u.Update(func(s *LLMCacheUpsert) {
+		s.AddCompletionTokens(v)
+	})
+}
+
+// UpdateCompletionTokens sets the "completion_tokens" field to the value that was provided on create.
+func (u *LLMCacheUpsertOne) UpdateCompletionTokens() *LLMCacheUpsertOne {
+	return u.Update(func(s *LLMCacheUpsert) {
+		s.UpdateCompletionTokens()
+	})
+}
+
+// SetHits sets the "hits" field.
+func (u *LLMCacheUpsertOne) SetHits(v int) *LLMCacheUpsertOne {
+	return u.Update(func(s *LLMCacheUpsert) {
+		s.SetHits(v)
+	})
+}
+
+// AddHits adds v to the "hits" field.
+func (u *LLMCacheUpsertOne) AddHits(v int) *LLMCacheUpsertOne {
+	return u.Update(func(s *LLMCacheUpsert) {
+		s.AddHits(v)
+	})
+}
+
+// UpdateHits sets the "hits" field to the value that was provided on create.
+func (u *LLMCacheUpsertOne) UpdateHits() *LLMCacheUpsertOne {
+	return u.Update(func(s *LLMCacheUpsert) {
+		s.UpdateHits()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *LLMCacheUpsertOne) SetExpiresAt(v time.Time) *LLMCacheUpsertOne {
+	return u.Update(func(s *LLMCacheUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *LLMCacheUpsertOne) UpdateExpiresAt() *LLMCacheUpsertOne {
+	return u.Update(func(s *LLMCacheUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *LLMCacheUpsertOne) SetCreatedAt(v time.Time) *LLMCacheUpsertOne {
+	return u.Update(func(s *LLMCacheUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *LLMCacheUpsertOne) UpdateCreatedAt() *LLMCacheUpsertOne {
+	return u.Update(func(s *LLMCacheUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *LLMCacheUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LLMCacheCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LLMCacheUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *LLMCacheUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *LLMCacheUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// LLMCacheCreateBulk is the builder for creating many LLMCache entities in bulk.
+type LLMCacheCreateBulk struct {
+	config
+	err      error
+	builders []*LLMCacheCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the LLMCache entities in the database.
+func (_c *LLMCacheCreateBulk) Save(ctx context.Context) ([]*LLMCache, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*LLMCache, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LLMCacheMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *LLMCacheCreateBulk) SaveX(ctx context.Context) []*LLMCache {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LLMCacheCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LLMCacheCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LLMCache.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LLMCacheUpsert) {
+//			SetKey(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *LLMCacheCreateBulk) OnConflict(opts ...sql.ConflictOption) *LLMCacheUpsertBulk {
+	_c.conflict = opts
+	return &LLMCacheUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LLMCache.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *LLMCacheCreateBulk) OnConflictColumns(columns ...string) *LLMCacheUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &LLMCacheUpsertBulk{
+		create: _c,
+	}
+}
+
+// LLMCacheUpsertBulk is the builder for "upsert"-ing
+// a bulk of LLMCache nodes.
+type LLMCacheUpsertBulk struct {
+	create *LLMCacheCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.LLMCache.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(llmcache.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LLMCacheUpsertBulk) UpdateNewValues() *LLMCacheUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(llmcache.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LLMCache.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *LLMCacheUpsertBulk) Ignore() *LLMCacheUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LLMCacheUpsertBulk) DoNothing() *LLMCacheUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LLMCacheCreateBulk.OnConflict
+// documentation for more info.
+func (u *LLMCacheUpsertBulk) Update(set func(*LLMCacheUpsert)) *LLMCacheUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LLMCacheUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetKey sets the "key" field.
+func (u *LLMCacheUpsertBulk) SetKey(v string) *LLMCacheUpsertBulk {
+	return u.Update(func(s *LLMCacheUpsert) {
+		s.SetKey(v)
+	})
+}
+
+// UpdateKey sets the "key" field to the value that was provided on create.
+func (u *LLMCacheUpsertBulk) UpdateKey() *LLMCacheUpsertBulk {
+	return u.Update(func(s *LLMCacheUpsert) {
+		s.UpdateKey()
+	})
+}
+
+// SetModel sets the "model" field.
+func (u *LLMCacheUpsertBulk) SetModel(v string) *LLMCacheUpsertBulk {
+	return u.Update(func(s *LLMCacheUpsert) {
+		s.SetModel(v)
+	})
+}
+
+// UpdateModel sets the "model" field to the value that was provided on create.
+func (u *LLMCacheUpsertBulk) UpdateModel() *LLMCacheUpsertBulk {
+	return u.Update(func(s *LLMCacheUpsert) {
+		s.UpdateModel()
+	})
+}
+
+// SetTemplateVersion sets the "template_version" field.
+func (u *LLMCacheUpsertBulk) SetTemplateVersion(v string) *LLMCacheUpsertBulk {
+	return u.Update(func(s *LLMCacheUpsert) {
+		s.SetTemplateVersion(v)
+	})
+}
+
+// UpdateTemplateVersion sets the "template_version" field to the value that was provided on create.
+func (u *LLMCacheUpsertBulk) UpdateTemplateVersion() *LLMCacheUpsertBulk {
+	return u.Update(func(s *LLMCacheUpsert) {
+		s.UpdateTemplateVersion()
+	})
+}
+
+// SetContent sets the "content" field.
+func (u *LLMCacheUpsertBulk) SetContent(v string) *LLMCacheUpsertBulk {
+	return u.Update(func(s *LLMCacheUpsert) {
+		s.SetContent(v)
+	})
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *LLMCacheUpsertBulk) UpdateContent() *LLMCacheUpsertBulk {
+	return u.Update(func(s *LLMCacheUpsert) {
+		s.UpdateContent()
+	})
+}
+
+// SetPromptTokens sets the "prompt_tokens" field.
+func (u *LLMCacheUpsertBulk) SetPromptTokens(v int) *LLMCacheUpsertBulk {
+	return u.Update(func(s *LLMCacheUpsert) {
+		s.SetPromptTokens(v)
+	})
+}
+
+// AddPromptTokens adds v to the "prompt_tokens" field.
+func (u *LLMCacheUpsertBulk) AddPromptTokens(v int) *LLMCacheUpsertBulk {
+	return u.Update(func(s *LLMCacheUpsert) {
+		s.AddPromptTokens(v)
+	})
+}
+
+// UpdatePromptTokens sets the "prompt_tokens" field to the value that was provided on create.
+func (u *LLMCacheUpsertBulk) UpdatePromptTokens() *LLMCacheUpsertBulk {
+	return u.Update(func(s *LLMCacheUpsert) {
+		s.UpdatePromptTokens()
+	})
+}
+
+// SetCompletionTokens sets the "completion_tokens" field.
+func (u *LLMCacheUpsertBulk) SetCompletionTokens(v int) *LLMCacheUpsertBulk {
+	return u.Update(func(s *LLMCacheUpsert) {
+		s.SetCompletionTokens(v)
+	})
+}
+
+// AddCompletionTokens adds v to the "completion_tokens" field.
+func (u *LLMCacheUpsertBulk) AddCompletionTokens(v int) *LLMCacheUpsertBulk {
+	return u.Update(func(s *LLMCacheUpsert) {
+		s.AddCompletionTokens(v)
+	})
+}
+
+// UpdateCompletionTokens sets the "completion_tokens" field to the value that was provided on create.
+func (u *LLMCacheUpsertBulk) UpdateCompletionTokens() *LLMCacheUpsertBulk {
+	return u.Update(func(s *LLMCacheUpsert) {
+		s.UpdateCompletionTokens()
+	})
+}
+
+// SetHits sets the "hits" field.
+func (u *LLMCacheUpsertBulk) SetHits(v int) *LLMCacheUpsertBulk {
+	return u.Update(func(s *LLMCacheUpsert) {
+		s.SetHits(v)
+	})
+}
+
+// AddHits adds v to the "hits" field.
+func (u *LLMCacheUpsertBulk) AddHits(v int) *LLMCacheUpsertBulk {
+	return u.Update(func(s *LLMCacheUpsert) {
+		s.AddHits(v)
+	})
+}
+
+// UpdateHits sets the "hits" field to the value that was provided on create.
+func (u *LLMCacheUpsertBulk) UpdateHits() *LLMCacheUpsertBulk {
+	return u.Update(func(s *LLMCacheUpsert) {
+		s.UpdateHits()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *LLMCacheUpsertBulk) SetExpiresAt(v time.Time) *LLMCacheUpsertBulk {
+	return u.Update(func(s *LLMCacheUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *LLMCacheUpsertBulk) UpdateExpiresAt() *LLMCacheUpsertBulk {
+	return u.Update(func(s *LLMCacheUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *LLMCacheUpsertBulk) SetCreatedAt(v time.Time) *LLMCacheUpsertBulk {
+	return u.Update(func(s *LLMCacheUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *LLMCacheUpsertBulk) UpdateCreatedAt() *LLMCacheUpsertBulk {
+	return u.Update(func(s *LLMCacheUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *LLMCacheUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the LLMCacheCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LLMCacheCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LLMCacheUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,558 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/iWorld-y/domain_radar/app/common/ent/llmcache"
+	"github.com/iWorld-y/domain_radar/app/common/ent/predicate"
+)
+
+// LLMCacheUpdate is the builder for updating LLMCache entities.
+type LLMCacheUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *LLMCacheMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the LLMCacheUpdate builder.
+func (_u *LLMCacheUpdate) Where(ps ...predicate.LLMCache) *LLMCacheUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetKey sets the "key" field.
+func (_u *LLMCacheUpdate) SetKey(v string) *LLMCacheUpdate {
+	_u.mutation.SetKey(v)
+	return _u
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (_u *LLMCacheUpdate) SetNillableKey(v *string) *LLMCacheUpdate {
+	if v != nil {
+		_u.SetKey(*v)
+	}
+	return _u
+}
+
+// SetModel sets the "model" field.
+func (_u *LLMCacheUpdate) SetModel(v string) *LLMCacheUpdate {
+	_u.mutation.SetModel(v)
+	return _u
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (_u *LLMCacheUpdate) SetNillableModel(v *string) *LLMCacheUpdate {
+	if v != nil {
+		_u.SetModel(*v)
+	}
+	return _u
+}
+
+// SetTemplateVersion sets the "template_version" field.
+func (_u *LLMCacheUpdate) SetTemplateVersion(v string) *LLMCacheUpdate {
+	_u.mutation.SetTemplateVersion(v)
+	return _u
+}
+
+// SetNillableTemplateVersion sets the "template_version" field if the given value is not nil.
+func (_u *LLMCacheUpdate) SetNillableTemplateVersion(v *string) *LLMCacheUpdate {
+	if v != nil {
+		_u.SetTemplateVersion(*v)
+	}
+	return _u
+}
+
+// SetContent sets the "content" field.
+func (_u *LLMCacheUpdate) SetContent(v string) *LLMCacheUpdate {
+	_u.mutation.SetContent(v)
+	return _u
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (_u *LLMCacheUpdate) SetNillableContent(v *string) *LLMCacheUpdate {
+	if v != nil {
+		_u.SetContent(*v)
+	}
+	return _u
+}
+
+// SetPromptTokens sets the "prompt_tokens" field.
+func (_u *LLMCacheUpdate) SetPromptTokens(v int) *LLMCacheUpdate {
+	_u.mutation.ResetPromptTokens()
+	_u.mutation.SetPromptTokens(v)
+	return _u
+}
+
+// SetNillablePromptTokens sets the "prompt_tokens" field if the given value is not nil.
+func (_u *LLMCacheUpdate) SetNillablePromptTokens(v *int) *LLMCacheUpdate {
+	if v != nil {
+		_u.SetPromptTokens(*v)
+	}
+	return _u
+}
+
+// AddPromptTokens adds value to the "prompt_tokens" field.
+func (_u *LLMCacheUpdate) AddPromptTokens(v int) *LLMCacheUpdate {
+	_u.mutation.AddPromptTokens(v)
+	return _u
+}
+
+// SetCompletionTokens sets the "completion_tokens" field.
+func (_u *LLMCacheUpdate) SetCompletionTokens(v int) *LLMCacheUpdate {
+	_u.mutation.ResetCompletionTokens()
+	_u.mutation.SetCompletionTokens(v)
+	return _u
+}
+
+// SetNillableCompletionTokens sets the "completion_tokens" field if the given value is not nil.
+func (_u *LLMCacheUpdate) SetNillableCompletionTokens(v *int) *LLMCacheUpdate {
+	if v != nil {
+		_u.SetCompletionTokens(*v)
+	}
+	return _u
+}
+
+// AddCompletionTokens adds value to the "completion_tokens" field.
+func (_u *LLMCacheUpdate) AddCompletionTokens(v int) *LLMCacheUpdate {
+	_u.mutation.AddCompletionTokens(v)
+	return _u
+}
+
+// SetHits sets the "hits" field.
+func (_u *LLMCacheUpdate) SetHits(v int) *LLMCacheUpdate {
+	_u.mutation.ResetHits()
+	_u.mutation.SetHits(v)
+	return _u
+}
+
+// SetNillableHits sets the "hits" field if the given value is not nil.
+func (_u *LLMCacheUpdate) SetNillableHits(v *int) *LLMCacheUpdate {
+	if v != nil {
+		_u.SetHits(*v)
+	}
+	return _u
+}
+
+// AddHits adds value to the "hits" field.
+func (_u *LLMCacheUpdate) AddHits(v int) *LLMCacheUpdate {
+	_u.mutation.AddHits(v)
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *LLMCacheUpdate) SetExpiresAt(v time.Time) *LLMCacheUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *LLMCacheUpdate) SetNillableExpiresAt(v *time.Time) *LLMCacheUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *LLMCacheUpdate) SetCreatedAt(v time.Time) *LLMCacheUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *LLMCacheUpdate) SetNillableCreatedAt(v *time.Time) *LLMCacheUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the LLMCacheMutation object of the builder.
+func (_u *LLMCacheUpdate) Mutation() *LLMCacheMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *LLMCacheUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LLMCacheUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *LLMCacheUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LLMCacheUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LLMCacheUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LLMCacheUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *LLMCacheUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(llmcache.Table, llmcache.Columns, sqlgraph.NewFieldSpec(llmcache.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Key(); ok {
+		_spec.SetField(llmcache.FieldKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Model(); ok {
+		_spec.SetField(llmcache.FieldModel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TemplateVersion(); ok {
+		_spec.SetField(llmcache.FieldTemplateVersion, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Content(); ok {
+		_spec.SetField(llmcache.FieldContent, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PromptTokens(); ok {
+		_spec.SetField(llmcache.FieldPromptTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPromptTokens(); ok {
+		_spec.AddField(llmcache.FieldPromptTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CompletionTokens(); ok {
+		_spec.SetField(llmcache.FieldCompletionTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCompletionTokens(); ok {
+		_spec.AddField(llmcache.FieldCompletionTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Hits(); ok {
+		_spec.SetField(llmcache.FieldHits, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedHits(); ok {
+		_spec.AddField(llmcache.FieldHits, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(llmcache.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(llmcache.FieldCreatedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{llmcache.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// LLMCacheUpdateOne is the builder for updating a single LLMCache entity.
+type LLMCacheUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *LLMCacheMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetKey sets the "key" field.
+func (_u *LLMCacheUpdateOne) SetKey(v string) *LLMCacheUpdateOne {
+	_u.mutation.SetKey(v)
+	return _u
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (_u *LLMCacheUpdateOne) SetNillableKey(v *string) *LLMCacheUpdateOne {
+	if v != nil {
+		_u.SetKey(*v)
+	}
+	return _u
+}
+
+// SetModel sets the "model" field.
+func (_u *LLMCacheUpdateOne) SetModel(v string) *LLMCacheUpdateOne {
+	_u.mutation.SetModel(v)
+	return _u
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (_u *LLMCacheUpdateOne) SetNillableModel(v *string) *LLMCacheUpdateOne {
+	if v != nil {
+		_u.SetModel(*v)
+	}
+	return _u
+}
+
+// SetTemplateVersion sets the "template_version" field.
+func (_u *LLMCacheUpdateOne) SetTemplateVersion(v string) *LLMCacheUpdateOne {
+	_u.mutation.SetTemplateVersion(v)
+	return _u
+}
+
+// SetNillableTemplateVersion sets the "template_version" field if the given value is not nil.
+func (_u *LLMCacheUpdateOne) SetNillableTemplateVersion(v *string) *LLMCacheUpdateOne {
+	if v != nil {
+		_u.SetTemplateVersion(*v)
+	}
+	return _u
+}
+
+// SetContent sets the "content" field.
+func (_u *LLMCacheUpdateOne) SetContent(v string) *LLMCacheUpdateOne {
+	_u.mutation.SetContent(v)
+	return _u
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (_u *LLMCacheUpdateOne) SetNillableContent(v *string) *LLMCacheUpdateOne {
+	if v != nil {
+		_u.SetContent(*v)
+	}
+	return _u
+}
+
+// SetPromptTokens sets the "prompt_tokens" field.
+func (_u *LLMCacheUpdateOne) SetPromptTokens(v int) *LLMCacheUpdateOne {
+	_u.mutation.ResetPromptTokens()
+	_u.mutation.SetPromptTokens(v)
+	return _u
+}
+
+// SetNillablePromptTokens sets the "prompt_tokens" field if the given value is not nil.
+func (_u *LLMCacheUpdateOne) SetNillablePromptTokens(v *int) *LLMCacheUpdateOne {
+	if v != nil {
+		_u.SetPromptTokens(*v)
+	}
+	return _u
+}
+
+// AddPromptTokens adds value to the "prompt_tokens" field.
+func (_u *LLMCacheUpdateOne) AddPromptTokens(v int) *LLMCacheUpdateOne {
+	_u.mutation.AddPromptTokens(v)
+	return _u
+}
+
+// SetCompletionTokens sets the "completion_tokens" field.
+func (_u *LLMCacheUpdateOne) SetCompletionTokens(v int) *LLMCacheUpdateOne {
+	_u.mutation.ResetCompletionTokens()
+	_u.mutation.SetCompletionTokens(v)
+	return _u
+}
+
+// SetNillableCompletionTokens sets the "completion_tokens" field if the given value is not nil.
+func (_u *LLMCacheUpdateOne) SetNillableCompletionTokens(v *int) *LLMCacheUpdateOne {
+	if v != nil {
+		_u.SetCompletionTokens(*v)
+	}
+	return _u
+}
+
+// AddCompletionTokens adds value to the "completion_tokens" field.
+func (_u *LLMCacheUpdateOne) AddCompletionTokens(v int) *LLMCacheUpdateOne {
+	_u.mutation.AddCompletionTokens(v)
+	return _u
+}
+
+// SetHits sets the "hits" field.
+func (_u *LLMCacheUpdateOne) SetHits(v int) *LLMCacheUpdateOne {
+	_u.mutation.ResetHits()
+	_u.mutation.SetHits(v)
+	return _u
+}
+
+// SetNillableHits sets the "hits" field if the given value is not nil.
+func (_u *LLMCacheUpdateOne) SetNillableHits(v *int) *LLMCacheUpdateOne {
+	if v != nil {
+		_u.SetHits(*v)
+	}
+	return _u
+}
+
+// AddHits adds value to the "hits" field.
+func (_u *LLMCacheUpdateOne) AddHits(v int) *LLMCacheUpdateOne {
+	_u.mutation.AddHits(v)
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *LLMCacheUpdateOne) SetExpiresAt(v time.Time) *LLMCacheUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *LLMCacheUpdateOne) SetNillableExpiresAt(v *time.Time) *LLMCacheUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *LLMCacheUpdateOne) SetCreatedAt(v time.Time) *LLMCacheUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *LLMCacheUpdateOne) SetNillableCreatedAt(v *time.Time) *LLMCacheUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the LLMCacheMutation object of the builder.
+func (_u *LLMCacheUpdateOne) Mutation() *LLMCacheMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the LLMCacheUpdate builder.
+func (_u *LLMCacheUpdateOne) Where(ps ...predicate.LLMCache) *LLMCacheUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *LLMCacheUpdateOne) Select(field string, fields ...string) *LLMCacheUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated LLMCache entity.
+func (_u *LLMCacheUpdateOne) Save(ctx context.Context) (*LLMCache, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LLMCacheUpdateOne) SaveX(ctx context.Context) *LLMCache {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *LLMCacheUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LLMCacheUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LLMCacheUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LLMCacheUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *LLMCacheUpdateOne) sqlSave(ctx context.Context) (_node *LLMCache, err error) {
+	_spec := sqlgraph.NewUpdateSpec(llmcache.Table, llmcache.Columns, sqlgraph.NewFieldSpec(llmcache.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LLMCache.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, llmcache.FieldID)
+		for _, f := range fields {
+			if !llmcache.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != llmcache.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Key(); ok {
+		_spec.SetField(llmcache.FieldKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Model(); ok {
+		_spec.SetField(llmcache.FieldModel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TemplateVersion(); ok {
+		_spec.SetField(llmcache.FieldTemplateVersion, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Content(); ok {
+		_spec.SetField(llmcache.FieldContent, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PromptTokens(); ok {
+		_spec.SetField(llmcache.FieldPromptTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPromptTokens(); ok {
+		_spec.AddField(llmcache.FieldPromptTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CompletionTokens(); ok {
+		_spec.SetField(llmcache.FieldCompletionTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCompletionTokens(); ok {
+		_spec.AddField(llmcache.FieldCompletionTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Hits(); ok {
+		_spec.SetField(llmcache.FieldHits, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedHits(); ok {
+		_spec.AddField(llmcache.FieldHits, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(llmcache.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(llmcache.FieldCreatedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &LLMCache{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{llmcache.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
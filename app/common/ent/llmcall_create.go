@@ -0,0 +1,1489 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/iWorld-y/domain_radar/app/common/ent/llmcall"
+	"github.com/iWorld-y/domain_radar/app/common/ent/reportrun"
+)
+
+// LLMCallCreate is the builder for creating a LLMCall entity.
+type LLMCallCreate struct {
+	config
+	mutation *LLMCallMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetRunID sets the "run_id" field.
+func (_c *LLMCallCreate) SetRunID(v int) *LLMCallCreate {
+	_c.mutation.SetRunID(v)
+	return _c
+}
+
+// SetNillableRunID sets the "run_id" field if the given value is not nil.
+func (_c *LLMCallCreate) SetNillableRunID(v *int) *LLMCallCreate {
+	if v != nil {
+		_c.SetRunID(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *LLMCallCreate) SetUserID(v int) *LLMCallCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_c *LLMCallCreate) SetNillableUserID(v *int) *LLMCallCreate {
+	if v != nil {
+		_c.SetUserID(*v)
+	}
+	return _c
+}
+
+// SetDomain sets the "domain" field.
+func (_c *LLMCallCreate) SetDomain(v string) *LLMCallCreate {
+	_c.mutation.SetDomain(v)
+	return _c
+}
+
+// SetNillableDomain sets the "domain" field if the given value is not nil.
+func (_c *LLMCallCreate) SetNillableDomain(v *string) *LLMCallCreate {
+	if v != nil {
+		_c.SetDomain(*v)
+	}
+	return _c
+}
+
+// SetStage sets the "stage" field.
+func (_c *LLMCallCreate) SetStage(v string) *LLMCallCreate {
+	_c.mutation.SetStage(v)
+	return _c
+}
+
+// SetNillableStage sets the "stage" field if the given value is not nil.
+func (_c *LLMCallCreate) SetNillableStage(v *string) *LLMCallCreate {
+	if v != nil {
+		_c.SetStage(*v)
+	}
+	return _c
+}
+
+// SetModel sets the "model" field.
+func (_c *LLMCallCreate) SetModel(v string) *LLMCallCreate {
+	_c.mutation.SetModel(v)
+	return _c
+}
+
+// SetPromptTokens sets the "prompt_tokens" field.
+func (_c *LLMCallCreate) SetPromptTokens(v int) *LLMCallCreate {
+	_c.mutation.SetPromptTokens(v)
+	return _c
+}
+
+// SetNillablePromptTokens sets the "prompt_tokens" field if the given value is not nil.
+func (_c *LLMCallCreate) SetNillablePromptTokens(v *int) *LLMCallCreate {
+	if v != nil {
+		_c.SetPromptTokens(*v)
+	}
+	return _c
+}
+
+// SetCompletionTokens sets the "completion_tokens" field.
+func (_c *LLMCallCreate) SetCompletionTokens(v int) *LLMCallCreate {
+	_c.mutation.SetCompletionTokens(v)
+	return _c
+}
+
+// SetNillableCompletionTokens sets the "completion_tokens" field if the given value is not nil.
+func (_c *LLMCallCreate) SetNillableCompletionTokens(v *int) *LLMCallCreate {
+	if v != nil {
+		_c.SetCompletionTokens(*v)
+	}
+	return _c
+}
+
+// SetTotalTokens sets the "total_tokens" field.
+func (_c *LLMCallCreate) SetTotalTokens(v int) *LLMCallCreate {
+	_c.mutation.SetTotalTokens(v)
+	return _c
+}
+
+// SetNillableTotalTokens sets the "total_tokens" field if the given value is not nil.
+func (_c *LLMCallCreate) SetNillableTotalTokens(v *int) *LLMCallCreate {
+	if v != nil {
+		_c.SetTotalTokens(*v)
+	}
+	return _c
+}
+
+// SetCost sets the "cost" field.
+func (_c *LLMCallCreate) SetCost(v float64) *LLMCallCreate {
+	_c.mutation.SetCost(v)
+	return _c
+}
+
+// SetNillableCost sets the "cost" field if the given value is not nil.
+func (_c *LLMCallCreate) SetNillableCost(v *float64) *LLMCallCreate {
+	if v != nil {
+		_c.SetCost(*v)
+	}
+	return _c
+}
+
+// SetLatencyMs sets the "latency_ms" field.
+func (_c *LLMCallCreate) SetLatencyMs(v int64) *LLMCallCreate {
+	_c.mutation.SetLatencyMs(v)
+	return _c
+}
+
+// SetNillableLatencyMs sets the "latency_ms" field if the given value is not nil.
+func (_c *LLMCallCreate) SetNillableLatencyMs(v *int64) *LLMCallCreate {
+	if v != nil {
+		_c.SetLatencyMs(*v)
+	}
+	return _c
+}
+
+// SetSuccess sets the "success" field.
+func (_c *LLMCallCreate) SetSuccess(v bool) *LLMCallCreate {
+	_c.mutation.SetSuccess(v)
+	return _c
+}
+
+// SetNillableSuccess sets the "success" field if the given value is not nil.
+func (_c *LLMCallCreate) SetNillableSuccess(v *bool) *LLMCallCreate {
+	if v != nil {
+		_c.SetSuccess(*v)
+	}
+	return _c
+}
+
+// SetError sets the "error" field.
+func (_c *LLMCallCreate) SetError(v string) *LLMCallCreate {
+	_c.mutation.SetError(v)
+	return _c
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_c *LLMCallCreate) SetNillableError(v *string) *LLMCallCreate {
+	if v != nil {
+		_c.SetError(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *LLMCallCreate) SetCreatedAt(v time.Time) *LLMCallCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *LLMCallCreate) SetNillableCreatedAt(v *time.Time) *LLMCallCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *LLMCallCreate) SetID(v int) *LLMCallCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetReportRunID sets the "report_run" edge to the ReportRun entity by ID.
+func (_c *LLMCallCreate) SetReportRunID(id int) *LLMCallCreate {
+	_c.mutation.SetReportRunID(id)
+	return _c
+}
+
+// SetNillableReportRunID sets the "report_run" edge to the ReportRun entity by ID if the given value is not nil.
+func (_c *LLMCallCreate) SetNillableReportRunID(id *int) *LLMCallCreate {
+	if id != nil {
+		_c = _c.SetReportRunID(*id)
+	}
+	return _c
+}
+
+// SetReportRun sets the "report_run" edge to the ReportRun entity.
+func (_c *LLMCallCreate) SetReportRun(v *ReportRun) *LLMCallCreate {
+	return _c.SetReportRunID(v.ID)
+}
+
+// Mutation returns the LLMCallMutation object of the builder.
+func (_c *LLMCallCreate) Mutation() *LLMCallMutation {
+	return _c.mutation
+}
+
+// Save creates the LLMCall in the database.
+func (_c *LLMCallCreate) Save(ctx context.Context) (*LLMCall, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *LLMCallCreate) SaveX(ctx context.Context) *LLMCall {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LLMCallCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LLMCallCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *LLMCallCreate) defaults() {
+	if _, ok := _c.mutation.PromptTokens(); !ok {
+		v := llmcall.DefaultPromptTokens
+		_c.mutation.SetPromptTokens(v)
+	}
+	if _, ok := _c.mutation.CompletionTokens(); !ok {
+		v := llmcall.DefaultCompletionTokens
+		_c.mutation.SetCompletionTokens(v)
+	}
+	if _, ok := _c.mutation.TotalTokens(); !ok {
+		v := llmcall.DefaultTotalTokens
+		_c.mutation.SetTotalTokens(v)
+	}
+	if _, ok := _c.mutation.Cost(); !ok {
+		v := llmcall.DefaultCost
+		_c.mutation.SetCost(v)
+	}
+	if _, ok := _c.mutation.LatencyMs(); !ok {
+		v := llmcall.DefaultLatencyMs
+		_c.mutation.SetLatencyMs(v)
+	}
+	if _, ok := _c.mutation.Success(); !ok {
+		v := llmcall.DefaultSuccess
+		_c.mutation.SetSuccess(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := llmcall.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *LLMCallCreate) check() error {
+	if _, ok := _c.mutation.Model(); !ok {
+		return &ValidationError{Name: "model", err: errors.New(`ent: missing required field "LLMCall.model"`)}
+	}
+	if _, ok := _c.mutation.PromptTokens(); !ok {
+		return &ValidationError{Name: "prompt_tokens", err: errors.New(`ent: missing required field "LLMCall.prompt_tokens"`)}
+	}
+	if _, ok := _c.mutation.CompletionTokens(); !ok {
+		return &ValidationError{Name: "completion_tokens", err: errors.New(`ent: missing required field "LLMCall.completion_tokens"`)}
+	}
+	if _, ok := _c.mutation.TotalTokens(); !ok {
+		return &ValidationError{Name: "total_tokens", err: errors.New(`ent: missing required field "LLMCall.total_tokens"`)}
+	}
+	if _, ok := _c.mutation.Cost(); !ok {
+		return &ValidationError{Name: "cost", err: errors.New(`ent: missing required field "LLMCall.cost"`)}
+	}
+	if _, ok := _c.mutation.LatencyMs(); !ok {
+		return &ValidationError{Name: "latency_ms", err: errors.New(`ent: missing required field "LLMCall.latency_ms"`)}
+	}
+	if _, ok := _c.mutation.Success(); !ok {
+		return &ValidationError{Name: "success", err: errors.New(`ent: missing required field "LLMCall.success"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "LLMCall.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *LLMCallCreate) sqlSave(ctx context.Context) (*LLMCall, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *LLMCallCreate) createSpec() (*LLMCall, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LLMCall{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(llmcall.Table, sqlgraph.NewFieldSpec(llmcall.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(llmcall.FieldUserID, field.TypeInt, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.Domain(); ok {
+		_spec.SetField(llmcall.FieldDomain, field.TypeString, value)
+		_node.Domain = value
+	}
+	if value, ok := _c.mutation.Stage(); ok {
+		_spec.SetField(llmcall.FieldStage, field.TypeString, value)
+		_node.Stage = value
+	}
+	if value, ok := _c.mutation.Model(); ok {
+		_spec.SetField(llmcall.FieldModel, field.TypeString, value)
+		_node.Model = value
+	}
+	if value, ok := _c.mutation.PromptTokens(); ok {
+		_spec.SetField(llmcall.FieldPromptTokens, field.TypeInt, value)
+		_node.PromptTokens = value
+	}
+	if value, ok := _c.mutation.CompletionTokens(); ok {
+		_spec.SetField(llmcall.FieldCompletionTokens, field.TypeInt, value)
+		_node.CompletionTokens = value
+	}
+	if value, ok := _c.mutation.TotalTokens(); ok {
+		_spec.SetField(llmcall.FieldTotalTokens, field.TypeInt, value)
+		_node.TotalTokens = value
+	}
+	if value, ok := _c.mutation.Cost(); ok {
+		_spec.SetField(llmcall.FieldCost, field.TypeFloat64, value)
+		_node.Cost = value
+	}
+	if value, ok := _c.mutation.LatencyMs(); ok {
+		_spec.SetField(llmcall.FieldLatencyMs, field.TypeInt64, value)
+		_node.LatencyMs = value
+	}
+	if value, ok := _c.mutation.Success(); ok {
+		_spec.SetField(llmcall.FieldSuccess, field.TypeBool, value)
+		_node.Success = value
+	}
+	if value, ok := _c.mutation.Error(); ok {
+		_spec.SetField(llmcall.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(llmcall.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.ReportRunIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   llmcall.ReportRunTable,
+			Columns: []string{llmcall.ReportRunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reportrun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.RunID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LLMCall.Create().
+//		SetRunID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LLMCallUpsert) {
+//			SetRunID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *LLMCallCreate) OnConflict(opts ...sql.ConflictOption) *LLMCallUpsertOne {
+	_c.conflict = opts
+	return &LLMCallUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LLMCall.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *LLMCallCreate) OnConflictColumns(columns ...string) *LLMCallUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &LLMCallUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// LLMCallUpsertOne is the builder for "upsert"-ing
+	//  one LLMCall node.
+	LLMCallUpsertOne struct {
+		create *LLMCallCreate
+	}
+
+	// LLMCallUpsert is the "OnConflict" setter.
+	LLMCallUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetRunID sets the "run_id" field.
+func (u *LLMCallUpsert) SetRunID(v int) *LLMCallUpsert {
+	u.Set(llmcall.FieldRunID, v)
+	return u
+}
+
+// UpdateRunID sets the "run_id" field to the value that was provided on create.
+func (u *LLMCallUpsert) UpdateRunID() *LLMCallUpsert {
+	u.SetExcluded(llmcall.FieldRunID)
+	return u
+}
+
+// ClearRunID clears the value of the "run_id" field.
+func (u *LLMCallUpsert) ClearRunID() *LLMCallUpsert {
+	u.SetNull(llmcall.FieldRunID)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *LLMCallUpsert) SetUserID(v int) *LLMCallUpsert {
+	u.Set(llmcall.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *LLMCallUpsert) UpdateUserID() *LLMCallUpsert {
+	u.SetExcluded(llmcall.FieldUserID)
+	return u
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *LLMCallUpsert) AddUserID(v int) *LLMCallUpsert {
+	u.Add(llmcall.FieldUserID, v)
+	return u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (u *LLMCallUpsert) ClearUserID() *LLMCallUpsert {
+	u.SetNull(llmcall.FieldUserID)
+	return u
+}
+
+// SetDomain sets the "domain" field.
+func (u *LLMCallUpsert) SetDomain(v string) *LLMCallUpsert {
+	u.Set(llmcall.FieldDomain, v)
+	return u
+}
+
+// UpdateDomain sets the "domain" field to the value that was provided on create.
+func (u *LLMCallUpsert) UpdateDomain() *LLMCallUpsert {
+	u.SetExcluded(llmcall.FieldDomain)
+	return u
+}
+
+// ClearDomain clears the value of the "domain" field.
+func (u *LLMCallUpsert) ClearDomain() *LLMCallUpsert {
+	u.SetNull(llmcall.FieldDomain)
+	return u
+}
+
+// SetStage sets the "stage" field.
+func (u *LLMCallUpsert) SetStage(v string) *LLMCallUpsert {
+	u.Set(llmcall.FieldStage, v)
+	return u
+}
+
+// UpdateStage sets the "stage" field to the value that was provided on create.
+func (u *LLMCallUpsert) UpdateStage() *LLMCallUpsert {
+	u.SetExcluded(llmcall.FieldStage)
+	return u
+}
+
+// ClearStage clears the value of the "stage" field.
+func (u *LLMCallUpsert) ClearStage() *LLMCallUpsert {
+	u.SetNull(llmcall.FieldStage)
+	return u
+}
+
+// SetModel sets the "model" field.
+func (u *LLMCallUpsert) SetModel(v string) *LLMCallUpsert {
+	u.Set(llmcall.FieldModel, v)
+	return u
+}
+
+// UpdateModel sets the "model" field to the value that was provided on create.
+func (u *LLMCallUpsert) UpdateModel() *LLMCallUpsert {
+	u.SetExcluded(llmcall.FieldModel)
+	return u
+}
+
+// SetPromptTokens sets the "prompt_tokens" field.
+func (u *LLMCallUpsert) SetPromptTokens(v int) *LLMCallUpsert {
+	u.Set(llmcall.FieldPromptTokens, v)
+	return u
+}
+
+// UpdatePromptTokens sets the "prompt_tokens" field to the value that was provided on create.
+func (u *LLMCallUpsert) UpdatePromptTokens() *LLMCallUpsert {
+	u.SetExcluded(llmcall.FieldPromptTokens)
+	return u
+}
+
+// AddPromptTokens adds v to the "prompt_tokens" field.
+func (u *LLMCallUpsert) AddPromptTokens(v int) *LLMCallUpsert {
+	u.Add(llmcall.FieldPromptTokens, v)
+	return u
+}
+
+// SetCompletionTokens sets the "completion_tokens" field.
+func (u *LLMCallUpsert) SetCompletionTokens(v int) *LLMCallUpsert {
+	u.Set(llmcall.FieldCompletionTokens, v)
+	return u
+}
+
+// UpdateCompletionTokens sets the "completion_tokens" field to the value that was provided on create.
+func (u *LLMCallUpsert) UpdateCompletionTokens() *LLMCallUpsert {
+	u.SetExcluded(llmcall.FieldCompletionTokens)
+	return u
+}
+
+// AddCompletionTokens adds v to the "completion_tokens" field.
+func (u *LLMCallUpsert) AddCompletionTokens(v int) *LLMCallUpsert {
+	u.Add(llmcall.FieldCompletionTokens, v)
+	return u
+}
+
+// SetTotalTokens sets the "total_tokens" field.
+func (u *LLMCallUpsert) SetTotalTokens(v int) *LLMCallUpsert {
+	u.Set(llmcall.FieldTotalTokens, v)
+	return u
+}
+
+// UpdateTotalTokens sets the "total_tokens" field to the value that was provided on create.
+func (u *LLMCallUpsert) UpdateTotalTokens() *LLMCallUpsert {
+	u.SetExcluded(llmcall.FieldTotalTokens)
+	return u
+}
+
+// AddTotalTokens adds v to the "total_tokens" field.
+func (u *LLMCallUpsert) AddTotalTokens(v int) *LLMCallUpsert {
+	u.Add(llmcall.FieldTotalTokens, v)
+	return u
+}
+
+// SetCost sets the "cost" field.
+func (u *LLMCallUpsert) SetCost(v float64) *LLMCallUpsert {
+	u.Set(llmcall.FieldCost, v)
+	return u
+}
+
+// UpdateCost sets the "cost" field to the value that was provided on create.
+func (u *LLMCallUpsert) UpdateCost() *LLMCallUpsert {
+	u.SetExcluded(llmcall.FieldCost)
+	return u
+}
+
+// AddCost adds v to the "cost" field.
+func (u *LLMCallUpsert) AddCost(v float64) *LLMCallUpsert {
+	u.Add(llmcall.FieldCost, v)
+	return u
+}
+
+// SetLatencyMs sets the "latency_ms" field.
+func (u *LLMCallUpsert) SetLatencyMs(v int64) *LLMCallUpsert {
+	u.Set(llmcall.FieldLatencyMs, v)
+	return u
+}
+
+// UpdateLatencyMs sets the "latency_ms" field to the value that was provided on create.
+func (u *LLMCallUpsert) UpdateLatencyMs() *LLMCallUpsert {
+	u.SetExcluded(llmcall.FieldLatencyMs)
+	return u
+}
+
+// AddLatencyMs adds v to the "latency_ms" field.
+func (u *LLMCallUpsert) AddLatencyMs(v int64) *LLMCallUpsert {
+	u.Add(llmcall.FieldLatencyMs, v)
+	return u
+}
+
+// SetSuccess sets the "success" field.
+func (u *LLMCallUpsert) SetSuccess(v bool) *LLMCallUpsert {
+	u.Set(llmcall.FieldSuccess, v)
+	return u
+}
+
+// UpdateSuccess sets the "success" field to the value that was provided on create.
+func (u *LLMCallUpsert) UpdateSuccess() *LLMCallUpsert {
+	u.SetExcluded(llmcall.FieldSuccess)
+	return u
+}
+
+// SetError sets the "error" field.
+func (u *LLMCallUpsert) SetError(v string) *LLMCallUpsert {
+	u.Set(llmcall.FieldError, v)
+	return u
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *LLMCallUpsert) UpdateError() *LLMCallUpsert {
+	u.SetExcluded(llmcall.FieldError)
+	return u
+}
+
+// ClearError clears the value of the "error" field.
+func (u *LLMCallUpsert) ClearError() *LLMCallUpsert {
+	u.SetNull(llmcall.FieldError)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *LLMCallUpsert) SetCreatedAt(v time.Time) *LLMCallUpsert {
+	u.Set(llmcall.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *LLMCallUpsert) UpdateCreatedAt() *LLMCallUpsert {
+	u.SetExcluded(llmcall.FieldCreatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.LLMCall.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(llmcall.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LLMCallUpsertOne) UpdateNewValues() *LLMCallUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(llmcall.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LLMCall.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *LLMCallUpsertOne) Ignore() *LLMCallUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LLMCallUpsertOne) DoNothing() *LLMCallUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LLMCallCreate.OnConflict
+// documentation for more info.
+func (u *LLMCallUpsertOne) Update(set func(*LLMCallUpsert)) *LLMCallUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LLMCallUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetRunID sets the "run_id" field.
+func (u *LLMCallUpsertOne) SetRunID(v int) *LLMCallUpsertOne {
+	return u.Update(func(s *LLMCallUpsert) {
+		s.SetRunID(v)
+	})
+}
+
+// UpdateRunID sets the "run_id" field to the value that was provided on create.
+func (u *LLMCallUpsertOne) UpdateRunID() *LLMCallUpsertOne {
+	return u.Update(func(s *LLMCallUpsert) {
+		s.UpdateRunID()
+	})
+}
+
+// ClearRunID clears the value of the "run_id" field.
+func (u *LLMCallUpsertOne) ClearRunID() *LLMCallUpsertOne {
+	return u.Update(func(s *LLMCallUpsert) {
+		s.ClearRunID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *LLMCallUpsertOne) SetUserID(v int) *LLMCallUpsertOne {
+	return u.Update(func(s *LLMCallUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *LLMCallUpsertOne) AddUserID(v int) *LLMCallUpsertOne {
+	return u.Update(func(s *LLMCallUpsert) {
+		s.AddUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *LLMCallUpsertOne) UpdateUserID() *LLMCallUpsertOne {
+	return u.Update(func(s *LLMCallUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (u *LLMCallUpsertOne) ClearUserID() *LLMCallUpsertOne {
+	return u.Update(func(s *LLMCallUpsert) {
+		s.ClearUserID()
+	})
+}
+
+// SetDomain sets the "domain" field.
+func (u *LLMCallUpsertOne) SetDomain(v string) *LLMCallUpsertOne {
+	return u.Update(func(s *LLMCallUpsert) {
+		s.SetDomain(v)
+	})
+}
+
+// UpdateDomain sets the "domain" field to the value that was provided on create.
+func (u *LLMCallUpsertOne) UpdateDomain() *LLMCallUpsertOne {
+	return u.Update(func(s *LLMCallUpsert) {
+		s.UpdateDomain()
+	})
+}
+
+// ClearDomain clears the value of the "domain" field.
+func (u *LLMCallUpsertOne) ClearDomain() *LLMCallUpsertOne {
+	return u.Update(func(s *LLMCallUpsert) {
+		s.ClearDomain()
+	})
+}
+
+// SetStage sets the "stage" field.
+func (u *LLMCallUpsertOne) SetStage(v string) *LLMCallUpsertOne {
+	return u.Update(func(s *LLMCallUpsert) {
+		s.SetStage(v)
+	})
+}
+
+// UpdateStage sets the "stage" field to the value that was provided on create.
+func (u *LLMCallUpsertOne) UpdateStage() *LLMCallUpsertOne {
+	return u.Update(func(s *LLMCallUpsert) {
+		s.UpdateStage()
+	})
+}
+
+// ClearStage clears the value of the "stage" field.
+func (u *LLMCallUpsertOne) ClearStage() *LLMCallUpsertOne {
+	return u.Update(func(s *LLMCallUpsert) {
+		s.ClearStage()
+	})
+}
+
+// SetModel sets the "model" field.
+func (u *LLMCallUpsertOne) SetModel(v string) *LLMCallUpsertOne {
+	return u.Update(func(s *LLMCallUpsert) {
+		s.SetModel(v)
+	})
+}
+
+// UpdateModel sets the "model" field to the value that was provided on create.
+func (u *LLMCallUpsertOne) UpdateModel() *LLMCallUpsertOne {
+	return u.Update(func(s *LLMCallUpsert) {
+		s.UpdateModel()
+	})
+}
+
+// SetPromptTokens sets the "prompt_tokens" field.
+func (u *LLMCallUpsertOne) SetPromptTokens(v int) *LLMCallUpsertOne {
+	return u.Update(func(s *LLMCallUpsert) {
+		s.SetPromptTokens(v)
+	})
+}
+
+// AddPromptTokens adds v to the "prompt_tokens" field.
+func (u *LLMCallUpsertOne) AddPromptTokens(v int) *LLMCallUpsertOne {
+	return u.Update(func(s *LLMCallUpsert) {
+		s.AddPromptTokens(v)
+	})
+}
+
+// UpdatePromptTokens sets the "prompt_tokens" field to the value that was provided on create.
+func (u *LLMCallUpsertOne) UpdatePromptTokens() *LLMCallUpsertOne {
+	return u.Update(func(s *LLMCallUpsert) {
+		s.UpdatePromptTokens()
+	})
+}
+
+// SetCompletionTokens sets the "completion_tokens" field.
+func (u *LLMCallUpsertOne) SetCompletionTokens(v int) *LLMCallUpsertOne {
+	return u.Update(func(s *LLMCallUpsert) {
+		s.SetCompletionTokens(v)
+	})
+}
+
+// AddCompletionTokens adds v to the "completion_tokens" field.
+func (u *LLMCallUpsertOne) AddCompletionTokens(v int) *LLMCallUpsertOne {
+	return u.Update(func(s *LLMCallUpsert) {
+		s.AddCompletionTokens(v)
+	})
+}
+
+// UpdateCompletionTokens sets the "completion_tokens" field to the value that was provided on create.
+func (u *LLMCallUpsertOne) UpdateCompletionTokens() *LLMCallUpsertOne {
+	return u.Update(func(s *LLMCallUpsert) {
+		s.UpdateCompletionTokens()
+	})
+}
+
+// SetTotalTokens sets the "total_tokens" field.
+func (u *LLMCallUpsertOne) SetTotalTokens(v int) *LLMCallUpsertOne {
+	return u.Update(func(s *LLMCallUpsert) {
+		s.SetTotalTokens(v)
+	})
+}
+
+// AddTotalTokens adds v to the "total_tokens" field.
+func (u *LLMCallUpsertOne) AddTotalTokens(v int) *LLMCallUpsertOne {
+	return u.Update(func(s *LLMCallUpsert) {
+		s.AddTotalTokens(v)
+	})
+}
+
+// UpdateTotalTokens sets the "total_tokens" field to the value that was provided on create.
+func (u *LLMCallUpsertOne) UpdateTotalTokens() *LLMCallUpsertOne {
+	return u.Update(func(s *LLMCallUpsert) {
+		s.UpdateTotalTokens()
+	})
+}
+
+// SetCost sets the "cost" field.
+func (u *LLMCallUpsertOne) SetCost(v float64) *LLMCallUpsertOne {
+	return u.Update(func(s *LLMCallUpsert) {
+		s.SetCost(v)
+	})
+}
+
+// AddCost adds v to the "cost" field.
+func (u *LLMCallUpsertOne) AddCost(v float64) *LLMCallUpsertOne {
+	return u.Update(func(s *LLMCallUpsert) {
+		s.AddCost(v)
+	})
+}
+
+// UpdateCost sets the "cost" field to the value that was provided on create.
+func (u *LLMCallUpsertOne) UpdateCost() *LLMCallUpsertOne {
+	return u.Update(func(s *LLMCallUpsert) {
+		s.UpdateCost()
+	})
+}
+
+// SetLatencyMs sets the "latency_ms" field.
+func (u *LLMCallUpsertOne) SetLatencyMs(v int64) *LLMCallUpsertOne {
+	return u.Update(func(s *LLMCallUpsert) {
+		s.SetLatencyMs(v)
+	})
+}
+
+// AddLatencyMs adds v to the "latency_ms" field.
+func (u *LLMCallUpsertOne) AddLatencyMs(v int64) *LLMCallUpsertOne {
+	return u.Update(func(s *LLMCallUpsert) {
+		s.AddLatencyMs(v)
+	})
+}
+
+// UpdateLatencyMs sets the "latency_ms" field to the value that was provided on create.
+func (u *LLMCallUpsertOne) UpdateLatencyMs() *LLMCallUpsertOne {
+	return u.Update(func(s *LLMCallUpsert) {
+		s.UpdateLatencyMs()
+	})
+}
+
+// SetSuccess sets the "success" field.
+func (u *LLMCallUpsertOne) SetSuccess(v bool) *LLMCallUpsertOne {
+	return u.Update(func(s *LLMCallUpsert) {
+		s.SetSuccess(v)
+	})
+}
+
+// UpdateSuccess sets the "success" field to the value that was provided on create.
+func (u *LLMCallUpsertOne) UpdateSuccess() *LLMCallUpsertOne {
+	return u.Update(func(s *LLMCallUpsert) {
+		s.UpdateSuccess()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *LLMCallUpsertOne) SetError(v string) *LLMCallUpsertOne {
+	return u.Update(func(s *LLMCallUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *LLMCallUpsertOne) UpdateError() *LLMCallUpsertOne {
+	return u.Update(func(s *LLMCallUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *LLMCallUpsertOne) ClearError() *LLMCallUpsertOne {
+	return u.Update(func(s *LLMCallUpsert) {
+		s.ClearError()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *LLMCallUpsertOne) SetCreatedAt(v time.Time) *LLMCallUpsertOne {
+	return u.Update(func(s *LLMCallUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *LLMCallUpsertOne) UpdateCreatedAt() *LLMCallUpsertOne {
+	return u.Update(func(s *LLMCallUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *LLMCallUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LLMCallCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LLMCallUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *LLMCallUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *LLMCallUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// LLMCallCreateBulk is the builder for creating many LLMCall entities in bulk.
+type LLMCallCreateBulk struct {
+	config
+	err      error
+	builders []*LLMCallCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the LLMCall entities in the database.
+func (_c *LLMCallCreateBulk) Save(ctx context.Context) ([]*LLMCall, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*LLMCall, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LLMCallMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *LLMCallCreateBulk) SaveX(ctx context.Context) []*LLMCall {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LLMCallCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LLMCallCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LLMCall.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LLMCallUpsert) {
+//			SetRunID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *LLMCallCreateBulk) OnConflict(opts ...sql.ConflictOption) *LLMCallUpsertBulk {
+	_c.conflict = opts
+	return &LLMCallUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LLMCall.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *LLMCallCreateBulk) OnConflictColumns(columns ...string) *LLMCallUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &LLMCallUpsertBulk{
+		create: _c,
+	}
+}
+
+// LLMCallUpsertBulk is the builder for "upsert"-ing
+// a bulk of LLMCall nodes.
+type LLMCallUpsertBulk struct {
+	create *LLMCallCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.LLMCall.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(llmcall.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LLMCallUpsertBulk) UpdateNewValues() *LLMCallUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(llmcall.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LLMCall.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *LLMCallUpsertBulk) Ignore() *LLMCallUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LLMCallUpsertBulk) DoNothing() *LLMCallUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LLMCallCreateBulk.OnConflict
+// documentation for more info.
+func (u *LLMCallUpsertBulk) Update(set func(*LLMCallUpsert)) *LLMCallUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LLMCallUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetRunID sets the "run_id" field.
+func (u *LLMCallUpsertBulk) SetRunID(v int) *LLMCallUpsertBulk {
+	return u.Update(func(s *LLMCallUpsert) {
+		s.SetRunID(v)
+	})
+}
+
+// UpdateRunID sets the "run_id" field to the value that was provided on create.
+func (u *LLMCallUpsertBulk) UpdateRunID() *LLMCallUpsertBulk {
+	return u.Update(func(s *LLMCallUpsert) {
+		s.UpdateRunID()
+	})
+}
+
+// ClearRunID clears the value of the "run_id" field.
+func (u *LLMCallUpsertBulk) ClearRunID() *LLMCallUpsertBulk {
+	return u.Update(func(s *LLMCallUpsert) {
+		s.ClearRunID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *LLMCallUpsertBulk) SetUserID(v int) *LLMCallUpsertBulk {
+	return u.Update(func(s *LLMCallUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *LLMCallUpsertBulk) AddUserID(v int) *LLMCallUpsertBulk {
+	return u.Update(func(s *LLMCallUpsert) {
+		s.AddUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *LLMCallUpsertBulk) UpdateUserID() *LLMCallUpsertBulk {
+	return u.Update(func(s *LLMCallUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (u *LLMCallUpsertBulk) ClearUserID() *LLMCallUpsertBulk {
+	return u.Update(func(s *LLMCallUpsert) {
+		s.ClearUserID()
+	})
+}
+
+// SetDomain sets the "domain" field.
+func (u *LLMCallUpsertBulk) SetDomain(v string) *LLMCallUpsertBulk {
+	return u.Update(func(s *LLMCallUpsert) {
+		s.SetDomain(v)
+	})
+}
+
+// UpdateDomain sets the "domain" field to the value that was provided on create.
+func (u *LLMCallUpsertBulk) UpdateDomain() *LLMCallUpsertBulk {
+	return u.Update(func(s *LLMCallUpsert) {
+		s.UpdateDomain()
+	})
+}
+
+// ClearDomain clears the value of the "domain" field.
+func (u *LLMCallUpsertBulk) ClearDomain() *LLMCallUpsertBulk {
+	return u.Update(func(s *LLMCallUpsert) {
+		s.ClearDomain()
+	})
+}
+
+// SetStage sets the "stage" field.
+func (u *LLMCallUpsertBulk) SetStage(v string) *LLMCallUpsertBulk {
+	return u.Update(func(s *LLMCallUpsert) {
+		s.SetStage(v)
+	})
+}
+
+// UpdateStage sets the "stage" field to the value that was provided on create.
+func (u *LLMCallUpsertBulk) UpdateStage() *LLMCallUpsertBulk {
+	return u.Update(func(s *LLMCallUpsert) {
+		s.UpdateStage()
+	})
+}
+
+// ClearStage clears the value of the "stage" field.
+func (u *LLMCallUpsertBulk) ClearStage() *LLMCallUpsertBulk {
+	return u.Update(func(s *LLMCallUpsert) {
+		s.ClearStage()
+	})
+}
+
+// SetModel sets the "model" field.
+func (u *LLMCallUpsertBulk) SetModel(v string) *LLMCallUpsertBulk {
+	return u.Update(func(s *LLMCallUpsert) {
+		s.SetModel(v)
+	})
+}
+
+// UpdateModel sets the "model" field to the value that was provided on create.
+func (u *LLMCallUpsertBulk) UpdateModel() *LLMCallUpsertBulk {
+	return u.Update(func(s *LLMCallUpsert) {
+		s.UpdateModel()
+	})
+}
+
+// SetPromptTokens sets the "prompt_tokens" field.
+func (u *LLMCallUpsertBulk) SetPromptTokens(v int) *LLMCallUpsertBulk {
+	return u.Update(func(s *LLMCallUpsert) {
+		s.SetPromptTokens(v)
+	})
+}
+
+// AddPromptTokens adds v to the "prompt_tokens" field.
+func (u *LLMCallUpsertBulk) AddPromptTokens(v int) *LLMCallUpsertBulk {
+	return u.Update(func(s *LLMCallUpsert) {
+		s.AddPromptTokens(v)
+	})
+}
+
+// UpdatePromptTokens sets the "prompt_tokens" field to the value that was provided on create.
+func (u *LLMCallUpsertBulk) UpdatePromptTokens() *LLMCallUpsertBulk {
+	return u.Update(func(s *LLMCallUpsert) {
+		s.UpdatePromptTokens()
+	})
+}
+
+// SetCompletionTokens sets the "completion_tokens" field.
+func (u *LLMCallUpsertBulk) SetCompletionTokens(v int) *LLMCallUpsertBulk {
+	return u.Update(func(s *LLMCallUpsert) {
+		s.SetCompletionTokens(v)
+	})
+}
+
+// AddCompletionTokens adds v to the "completion_tokens" field.
+func (u *LLMCallUpsertBulk) AddCompletionTokens(v int) *LLMCallUpsertBulk {
+	return u.Update(func(s *LLMCallUpsert) {
+		s.AddCompletionTokens(v)
+	})
+}
+
+// UpdateCompletionTokens sets the "completion_tokens" field to the value that was provided on create.
+func (u *LLMCallUpsertBulk) UpdateCompletionTokens() *LLMCallUpsertBulk {
+	return u.Update(func(s *LLMCallUpsert) {
+		s.UpdateCompletionTokens()
+	})
+}
+
+// SetTotalTokens sets the "total_tokens" field.
+func (u *LLMCallUpsertBulk) SetTotalTokens(v int) *LLMCallUpsertBulk {
+	return u.Update(func(s *LLMCallUpsert) {
+		s.SetTotalTokens(v)
+	})
+}
+
+// AddTotalTokens adds v to the "total_tokens" field.
+func (u *LLMCallUpsertBulk) AddTotalTokens(v int) *LLMCallUpsertBulk {
+	return u.Update(func(s *LLMCallUpsert) {
+		s.AddTotalTokens(v)
+	})
+}
+
+// UpdateTotalTokens sets the "total_tokens" field to the value that was provided on create.
+func (u *LLMCallUpsertBulk) UpdateTotalTokens() *LLMCallUpsertBulk {
+	return u.Update(func(s *LLMCallUpsert) {
+		s.UpdateTotalTokens()
+	})
+}
+
+// SetCost sets the "cost" field.
+func (u *LLMCallUpsertBulk) SetCost(v float64) *LLMCallUpsertBulk {
+	return u.Update(func(s *LLMCallUpsert) {
+		s.SetCost(v)
+	})
+}
+
+// AddCost adds v to the "cost" field.
+func (u *LLMCallUpsertBulk) AddCost(v float64) *LLMCallUpsertBulk {
+	return u.Update(func(s *LLMCallUpsert) {
+		s.AddCost(v)
+	})
+}
+
+// UpdateCost sets the "cost" field to the value that was provided on create.
+func (u *LLMCallUpsertBulk) UpdateCost() *LLMCallUpsertBulk {
+	return u.Update(func(s *LLMCallUpsert) {
+		s.UpdateCost()
+	})
+}
+
+// SetLatencyMs sets the "latency_ms" field.
+func (u *LLMCallUpsertBulk) SetLatencyMs(v int64) *LLMCallUpsertBulk {
+	return u.Update(func(s *LLMCallUpsert) {
+		s.SetLatencyMs(v)
+	})
+}
+
+// AddLatencyMs adds v to the "latency_ms" field.
+func (u *LLMCallUpsertBulk) AddLatencyMs(v int64) *LLMCallUpsertBulk {
+	return u.Update(func(s *LLMCallUpsert) {
+		s.AddLatencyMs(v)
+	})
+}
+
+// UpdateLatencyMs sets the "latency_ms" field to the value that was provided on create.
+func (u *LLMCallUpsertBulk) UpdateLatencyMs() *LLMCallUpsertBulk {
+	return u.Update(func(s *LLMCallUpsert) {
+		s.UpdateLatencyMs()
+	})
+}
+
+// SetSuccess sets the "success" field.
+func (u *LLMCallUpsertBulk) SetSuccess(v bool) *LLMCallUpsertBulk {
+	return u.Update(func(s *LLMCallUpsert) {
+		s.SetSuccess(v)
+	})
+}
+
+// UpdateSuccess sets the "success" field to the value that was provided on create.
+func (u *LLMCallUpsertBulk) UpdateSuccess() *LLMCallUpsertBulk {
+	return u.Update(func(s *LLMCallUpsert) {
+		s.UpdateSuccess()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *LLMCallUpsertBulk) SetError(v string) *LLMCallUpsertBulk {
+	return u.Update(func(s *LLMCallUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *LLMCallUpsertBulk) UpdateError() *LLMCallUpsertBulk {
+	return u.Update(func(s *LLMCallUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *LLMCallUpsertBulk) ClearError() *LLMCallUpsertBulk {
+	return u.Update(func(s *LLMCallUpsert) {
+		s.ClearError()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *LLMCallUpsertBulk) SetCreatedAt(v time.Time) *LLMCallUpsertBulk {
+	return u.Update(func(s *LLMCallUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *LLMCallUpsertBulk) UpdateCreatedAt() *LLMCallUpsertBulk {
+	return u.Update(func(s *LLMCallUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *LLMCallUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the LLMCallCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LLMCallCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LLMCallUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,941 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/iWorld-y/domain_radar/app/common/ent/llmcall"
+	"github.com/iWorld-y/domain_radar/app/common/ent/predicate"
+	"github.com/iWorld-y/domain_radar/app/common/ent/reportrun"
+)
+
+// LLMCallUpdate is the builder for updating LLMCall entities.
+type LLMCallUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *LLMCallMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the LLMCallUpdate builder.
+func (_u *LLMCallUpdate) Where(ps ...predicate.LLMCall) *LLMCallUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetRunID sets the "run_id" field.
+func (_u *LLMCallUpdate) SetRunID(v int) *LLMCallUpdate {
+	_u.mutation.SetRunID(v)
+	return _u
+}
+
+// SetNillableRunID sets the "run_id" field if the given value is not nil.
+func (_u *LLMCallUpdate) SetNillableRunID(v *int) *LLMCallUpdate {
+	if v != nil {
+		_u.SetRunID(*v)
+	}
+	return _u
+}
+
+// ClearRunID clears the value of the "run_id" field.
+func (_u *LLMCallUpdate) ClearRunID() *LLMCallUpdate {
+	_u.mutation.ClearRunID()
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *LLMCallUpdate) SetUserID(v int) *LLMCallUpdate {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *LLMCallUpdate) SetNillableUserID(v *int) *LLMCallUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *LLMCallUpdate) AddUserID(v int) *LLMCallUpdate {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *LLMCallUpdate) ClearUserID() *LLMCallUpdate {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetDomain sets the "domain" field.
+func (_u *LLMCallUpdate) SetDomain(v string) *LLMCallUpdate {
+	_u.mutation.SetDomain(v)
+	return _u
+}
+
+// SetNillableDomain sets the "domain" field if the given value is not nil.
+func (_u *LLMCallUpdate) SetNillableDomain(v *string) *LLMCallUpdate {
+	if v != nil {
+		_u.SetDomain(*v)
+	}
+	return _u
+}
+
+// ClearDomain clears the value of the "domain" field.
+func (_u *LLMCallUpdate) ClearDomain() *LLMCallUpdate {
+	_u.mutation.ClearDomain()
+	return _u
+}
+
+// SetStage sets the "stage" field.
+func (_u *LLMCallUpdate) SetStage(v string) *LLMCallUpdate {
+	_u.mutation.SetStage(v)
+	return _u
+}
+
+// SetNillableStage sets the "stage" field if the given value is not nil.
+func (_u *LLMCallUpdate) SetNillableStage(v *string) *LLMCallUpdate {
+	if v != nil {
+		_u.SetStage(*v)
+	}
+	return _u
+}
+
+// ClearStage clears the value of the "stage" field.
+func (_u *LLMCallUpdate) ClearStage() *LLMCallUpdate {
+	_u.mutation.ClearStage()
+	return _u
+}
+
+// SetModel sets the "model" field.
+func (_u *LLMCallUpdate) SetModel(v string) *LLMCallUpdate {
+	_u.mutation.SetModel(v)
+	return _u
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (_u *LLMCallUpdate) SetNillableModel(v *string) *LLMCallUpdate {
+	if v != nil {
+		_u.SetModel(*v)
+	}
+	return _u
+}
+
+// SetPromptTokens sets the "prompt_tokens" field.
+func (_u *LLMCallUpdate) SetPromptTokens(v int) *LLMCallUpdate {
+	_u.mutation.ResetPromptTokens()
+	_u.mutation.SetPromptTokens(v)
+	return _u
+}
+
+// SetNillablePromptTokens sets the "prompt_tokens" field if the given value is not nil.
+func (_u *LLMCallUpdate) SetNillablePromptTokens(v *int) *LLMCallUpdate {
+	if v != nil {
+		_u.SetPromptTokens(*v)
+	}
+	return _u
+}
+
+// AddPromptTokens adds value to the "prompt_tokens" field.
+func (_u *LLMCallUpdate) AddPromptTokens(v int) *LLMCallUpdate {
+	_u.mutation.AddPromptTokens(v)
+	return _u
+}
+
+// SetCompletionTokens sets the "completion_tokens" field.
+func (_u *LLMCallUpdate) SetCompletionTokens(v int) *LLMCallUpdate {
+	_u.mutation.ResetCompletionTokens()
+	_u.mutation.SetCompletionTokens(v)
+	return _u
+}
+
+// SetNillableCompletionTokens sets the "completion_tokens" field if the given value is not nil.
+func (_u *LLMCallUpdate) SetNillableCompletionTokens(v *int) *LLMCallUpdate {
+	if v != nil {
+		_u.SetCompletionTokens(*v)
+	}
+	return _u
+}
+
+// AddCompletionTokens adds value to the "completion_tokens" field.
+func (_u *LLMCallUpdate) AddCompletionTokens(v int) *LLMCallUpdate {
+	_u.mutation.AddCompletionTokens(v)
+	return _u
+}
+
+// SetTotalTokens sets the "total_tokens" field.
+func (_u *LLMCallUpdate) SetTotalTokens(v int) *LLMCallUpdate {
+	_u.mutation.ResetTotalTokens()
+	_u.mutation.SetTotalTokens(v)
+	return _u
+}
+
+// SetNillableTotalTokens sets the "total_tokens" field if the given value is not nil.
+func (_u *LLMCallUpdate) SetNillableTotalTokens(v *int) *LLMCallUpdate {
+	if v != nil {
+		_u.SetTotalTokens(*v)
+	}
+	return _u
+}
+
+// AddTotalTokens adds value to the "total_tokens" field.
+func (_u *LLMCallUpdate) AddTotalTokens(v int) *LLMCallUpdate {
+	_u.mutation.AddTotalTokens(v)
+	return _u
+}
+
+// SetCost sets the "cost" field.
+func (_u *LLMCallUpdate) SetCost(v float64) *LLMCallUpdate {
+	_u.mutation.ResetCost()
+	_u.mutation.SetCost(v)
+	return _u
+}
+
+// SetNillableCost sets the "cost" field if the given value is not nil.
+func (_u *LLMCallUpdate) SetNillableCost(v *float64) *LLMCallUpdate {
+	if v != nil {
+		_u.SetCost(*v)
+	}
+	return _u
+}
+
+// AddCost adds value to the "cost" field.
+func (_u *LLMCallUpdate) AddCost(v float64) *LLMCallUpdate {
+	_u.mutation.AddCost(v)
+	return _u
+}
+
+// SetLatencyMs sets the "latency_ms" field.
+func (_u *LLMCallUpdate) SetLatencyMs(v int64) *LLMCallUpdate {
+	_u.mutation.ResetLatencyMs()
+	_u.mutation.SetLatencyMs(v)
+	return _u
+}
+
+// SetNillableLatencyMs sets the "latency_ms" field if the given value is not nil.
+func (_u *LLMCallUpdate) SetNillableLatencyMs(v *int64) *LLMCallUpdate {
+	if v != nil {
+		_u.SetLatencyMs(*v)
+	}
+	return _u
+}
+
+// AddLatencyMs adds value to the "latency_ms" field.
+func (_u *LLMCallUpdate) AddLatencyMs(v int64) *LLMCallUpdate {
+	_u.mutation.AddLatencyMs(v)
+	return _u
+}
+
+// SetSuccess sets the "success" field.
+func (_u *LLMCallUpdate) SetSuccess(v bool) *LLMCallUpdate {
+	_u.mutation.SetSuccess(v)
+	return _u
+}
+
+// SetNillableSuccess sets the "success" field if the given value is not nil.
+func (_u *LLMCallUpdate) SetNillableSuccess(v *bool) *LLMCallUpdate {
+	if v != nil {
+		_u.SetSuccess(*v)
+	}
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *LLMCallUpdate) SetError(v string) *LLMCallUpdate {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *LLMCallUpdate) SetNillableError(v *string) *LLMCallUpdate {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *LLMCallUpdate) ClearError() *LLMCallUpdate {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *LLMCallUpdate) SetCreatedAt(v time.Time) *LLMCallUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *LLMCallUpdate) SetNillableCreatedAt(v *time.Time) *LLMCallUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetReportRunID sets the "report_run" edge to the ReportRun entity by ID.
+func (_u *LLMCallUpdate) SetReportRunID(id int) *LLMCallUpdate {
+	_u.mutation.SetReportRunID(id)
+	return _u
+}
+
+// SetNillableReportRunID sets the "report_run" edge to the ReportRun entity by ID if the given value is not nil.
+func (_u *LLMCallUpdate) SetNillableReportRunID(id *int) *LLMCallUpdate {
+	if id != nil {
+		_u = _u.SetReportRunID(*id)
+	}
+	return _u
+}
+
+// SetReportRun sets the "report_run" edge to the ReportRun entity.
+func (_u *LLMCallUpdate) SetReportRun(v *ReportRun) *LLMCallUpdate {
+	return _u.SetReportRunID(v.ID)
+}
+
+// Mutation returns the LLMCallMutation object of the builder.
+func (_u *LLMCallUpdate) Mutation() *LLMCallMutation {
+	return _u.mutation
+}
+
+// ClearReportRun clears the "report_run" edge to the ReportRun entity.
+func (_u *LLMCallUpdate) ClearReportRun() *LLMCallUpdate {
+	_u.mutation.ClearReportRun()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *LLMCallUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LLMCallUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *LLMCallUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LLMCallUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LLMCallUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LLMCallUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *LLMCallUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(llmcall.Table, llmcall.Columns, sqlgraph.NewFieldSpec(llmcall.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(llmcall.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(llmcall.FieldUserID, field.TypeInt, value)
+	}
+	if _u.mutation.UserIDCleared() {
+		_spec.ClearField(llmcall.FieldUserID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Domain(); ok {
+		_spec.SetField(llmcall.FieldDomain, field.TypeString, value)
+	}
+	if _u.mutation.DomainCleared() {
+		_spec.ClearField(llmcall.FieldDomain, field.TypeString)
+	}
+	if value, ok := _u.mutation.Stage(); ok {
+		_spec.SetField(llmcall.FieldStage, field.TypeString, value)
+	}
+	if _u.mutation.StageCleared() {
+		_spec.ClearField(llmcall.FieldStage, field.TypeString)
+	}
+	if value, ok := _u.mutation.Model(); ok {
+		_spec.SetField(llmcall.FieldModel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PromptTokens(); ok {
+		_spec.SetField(llmcall.FieldPromptTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPromptTokens(); ok {
+		_spec.AddField(llmcall.FieldPromptTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CompletionTokens(); ok {
+		_spec.SetField(llmcall.FieldCompletionTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCompletionTokens(); ok {
+		_spec.AddField(llmcall.FieldCompletionTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TotalTokens(); ok {
+		_spec.SetField(llmcall.FieldTotalTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTotalTokens(); ok {
+		_spec.AddField(llmcall.FieldTotalTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Cost(); ok {
+		_spec.SetField(llmcall.FieldCost, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedCost(); ok {
+		_spec.AddField(llmcall.FieldCost, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.LatencyMs(); ok {
+		_spec.SetField(llmcall.FieldLatencyMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedLatencyMs(); ok {
+		_spec.AddField(llmcall.FieldLatencyMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Success(); ok {
+		_spec.SetField(llmcall.FieldSuccess, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(llmcall.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(llmcall.FieldError, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(llmcall.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReportRunCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   llmcall.ReportRunTable,
+			Columns: []string{llmcall.ReportRunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reportrun.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReportRunIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   llmcall.ReportRunTable,
+			Columns: []string{llmcall.ReportRunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reportrun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{llmcall.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// LLMCallUpdateOne is the builder for updating a single LLMCall entity.
+type LLMCallUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *LLMCallMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetRunID sets the "run_id" field.
+func (_u *LLMCallUpdateOne) SetRunID(v int) *LLMCallUpdateOne {
+	_u.mutation.SetRunID(v)
+	return _u
+}
+
+// SetNillableRunID sets the "run_id" field if the given value is not nil.
+func (_u *LLMCallUpdateOne) SetNillableRunID(v *int) *LLMCallUpdateOne {
+	if v != nil {
+		_u.SetRunID(*v)
+	}
+	return _u
+}
+
+// ClearRunID clears the value of the "run_id" field.
+func (_u *LLMCallUpdateOne) ClearRunID() *LLMCallUpdateOne {
+	_u.mutation.ClearRunID()
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *LLMCallUpdateOne) SetUserID(v int) *LLMCallUpdateOne {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *LLMCallUpdateOne) SetNillableUserID(v *int) *LLMCallUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *LLMCallUpdateOne) AddUserID(v int) *LLMCallUpdateOne {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *LLMCallUpdateOne) ClearUserID() *LLMCallUpdateOne {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetDomain sets the "domain" field.
+func (_u *LLMCallUpdateOne) SetDomain(v string) *LLMCallUpdateOne {
+	_u.mutation.SetDomain(v)
+	return _u
+}
+
+// SetNillableDomain sets the "domain" field if the given value is not nil.
+func (_u *LLMCallUpdateOne) SetNillableDomain(v *string) *LLMCallUpdateOne {
+	if v != nil {
+		_u.SetDomain(*v)
+	}
+	return _u
+}
+
+// ClearDomain clears the value of the "domain" field.
+func (_u *LLMCallUpdateOne) ClearDomain() *LLMCallUpdateOne {
+	_u.mutation.ClearDomain()
+	return _u
+}
+
+// SetStage sets the "stage" field.
+func (_u *LLMCallUpdateOne) SetStage(v string) *LLMCallUpdateOne {
+	_u.mutation.SetStage(v)
+	return _u
+}
+
+// SetNillableStage sets the "stage" field if the given value is not nil.
+func (_u *LLMCallUpdateOne) SetNillableStage(v *string) *LLMCallUpdateOne {
+	if v != nil {
+		_u.SetStage(*v)
+	}
+	return _u
+}
+
+// ClearStage clears the value of the "stage" field.
+func (_u *LLMCallUpdateOne) ClearStage() *LLMCallUpdateOne {
+	_u.mutation.ClearStage()
+	return _u
+}
+
+// SetModel sets the "model" field.
+func (_u *LLMCallUpdateOne) SetModel(v string) *LLMCallUpdateOne {
+	_u.mutation.SetModel(v)
+	return _u
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (_u *LLMCallUpdateOne) SetNillableModel(v *string) *LLMCallUpdateOne {
+	if v != nil {
+		_u.SetModel(*v)
+	}
+	return _u
+}
+
+// SetPromptTokens sets the "prompt_tokens" field.
+func (_u *LLMCallUpdateOne) SetPromptTokens(v int) *LLMCallUpdateOne {
+	_u.mutation.ResetPromptTokens()
+	_u.mutation.SetPromptTokens(v)
+	return _u
+}
+
+// SetNillablePromptTokens sets the "prompt_tokens" field if the given value is not nil.
+func (_u *LLMCallUpdateOne) SetNillablePromptTokens(v *int) *LLMCallUpdateOne {
+	if v != nil {
+		_u.SetPromptTokens(*v)
+	}
+	return _u
+}
+
+// AddPromptTokens adds value to the "prompt_tokens" field.
+func (_u *LLMCallUpdateOne) AddPromptTokens(v int) *LLMCallUpdateOne {
+	_u.mutation.AddPromptTokens(v)
+	return _u
+}
+
+// SetCompletionTokens sets the "completion_tokens" field.
+func (_u *LLMCallUpdateOne) SetCompletionTokens(v int) *LLMCallUpdateOne {
+	_u.mutation.ResetCompletionTokens()
+	_u.mutation.SetCompletionTokens(v)
+	return _u
+}
+
+// SetNillableCompletionTokens sets the "completion_tokens" field if the given value is not nil.
+func (_u *LLMCallUpdateOne) SetNillableCompletionTokens(v *int) *LLMCallUpdateOne {
+	if v != nil {
+		_u.SetCompletionTokens(*v)
+	}
+	return _u
+}
+
+// AddCompletionTokens adds value to the "completion_tokens" field.
+func (_u *LLMCallUpdateOne) AddCompletionTokens(v int) *LLMCallUpdateOne {
+	_u.mutation.AddCompletionTokens(v)
+	return _u
+}
+
+// SetTotalTokens sets the "total_tokens" field.
+func (_u *LLMCallUpdateOne) SetTotalTokens(v int) *LLMCallUpdateOne {
+	_u.mutation.ResetTotalTokens()
+	_u.mutation.SetTotalTokens(v)
+	return _u
+}
+
+// SetNillableTotalTokens sets the "total_tokens" field if the given value is not nil.
+func (_u *LLMCallUpdateOne) SetNillableTotalTokens(v *int) *LLMCallUpdateOne {
+	if v != nil {
+		_u.SetTotalTokens(*v)
+	}
+	return _u
+}
+
+// AddTotalTokens adds value to the "total_tokens" field.
+func (_u *LLMCallUpdateOne) AddTotalTokens(v int) *LLMCallUpdateOne {
+	_u.mutation.AddTotalTokens(v)
+	return _u
+}
+
+// SetCost sets the "cost" field.
+func (_u *LLMCallUpdateOne) SetCost(v float64) *LLMCallUpdateOne {
+	_u.mutation.ResetCost()
+	_u.mutation.SetCost(v)
+	return _u
+}
+
+// SetNillableCost sets the "cost" field if the given value is not nil.
+func (_u *LLMCallUpdateOne) SetNillableCost(v *float64) *LLMCallUpdateOne {
+	if v != nil {
+		_u.SetCost(*v)
+	}
+	return _u
+}
+
+// AddCost adds value to the "cost" field.
+func (_u *LLMCallUpdateOne) AddCost(v float64) *LLMCallUpdateOne {
+	_u.mutation.AddCost(v)
+	return _u
+}
+
+// SetLatencyMs sets the "latency_ms" field.
+func (_u *LLMCallUpdateOne) SetLatencyMs(v int64) *LLMCallUpdateOne {
+	_u.mutation.ResetLatencyMs()
+	_u.mutation.SetLatencyMs(v)
+	return _u
+}
+
+// SetNillableLatencyMs sets the "latency_ms" field if the given value is not nil.
+func (_u *LLMCallUpdateOne) SetNillableLatencyMs(v *int64) *LLMCallUpdateOne {
+	if v != nil {
+		_u.SetLatencyMs(*v)
+	}
+	return _u
+}
+
+// AddLatencyMs adds value to the "latency_ms" field.
+func (_u *LLMCallUpdateOne) AddLatencyMs(v int64) *LLMCallUpdateOne {
+	_u.mutation.AddLatencyMs(v)
+	return _u
+}
+
+// SetSuccess sets the "success" field.
+func (_u *LLMCallUpdateOne) SetSuccess(v bool) *LLMCallUpdateOne {
+	_u.mutation.SetSuccess(v)
+	return _u
+}
+
+// SetNillableSuccess sets the "success" field if the given value is not nil.
+func (_u *LLMCallUpdateOne) SetNillableSuccess(v *bool) *LLMCallUpdateOne {
+	if v != nil {
+		_u.SetSuccess(*v)
+	}
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *LLMCallUpdateOne) SetError(v string) *LLMCallUpdateOne {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *LLMCallUpdateOne) SetNillableError(v *string) *LLMCallUpdateOne {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *LLMCallUpdateOne) ClearError() *LLMCallUpdateOne {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *LLMCallUpdateOne) SetCreatedAt(v time.Time) *LLMCallUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *LLMCallUpdateOne) SetNillableCreatedAt(v *time.Time) *LLMCallUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetReportRunID sets the "report_run" edge to the ReportRun entity by ID.
+func (_u *LLMCallUpdateOne) SetReportRunID(id int) *LLMCallUpdateOne {
+	_u.mutation.SetReportRunID(id)
+	return _u
+}
+
+// SetNillableReportRunID sets the "report_run" edge to the ReportRun entity by ID if the given value is not nil.
+func (_u *LLMCallUpdateOne) SetNillableReportRunID(id *int) *LLMCallUpdateOne {
+	if id != nil {
+		_u = _u.SetReportRunID(*id)
+	}
+	return _u
+}
+
+// SetReportRun sets the "report_run" edge to the ReportRun entity.
+func (_u *LLMCallUpdateOne) SetReportRun(v *ReportRun) *LLMCallUpdateOne {
+	return _u.SetReportRunID(v.ID)
+}
+
+// Mutation returns the LLMCallMutation object of the builder.
+func (_u *LLMCallUpdateOne) Mutation() *LLMCallMutation {
+	return _u.mutation
+}
+
+// ClearReportRun clears the "report_run" edge to the ReportRun entity.
+func (_u *LLMCallUpdateOne) ClearReportRun() *LLMCallUpdateOne {
+	_u.mutation.ClearReportRun()
+	return _u
+}
+
+// Where appends a list predicates to the LLMCallUpdate builder.
+func (_u *LLMCallUpdateOne) Where(ps ...predicate.LLMCall) *LLMCallUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *LLMCallUpdateOne) Select(field string, fields ...string) *LLMCallUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated LLMCall entity.
+func (_u *LLMCallUpdateOne) Save(ctx context.Context) (*LLMCall, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LLMCallUpdateOne) SaveX(ctx context.Context) *LLMCall {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *LLMCallUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LLMCallUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LLMCallUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LLMCallUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *LLMCallUpdateOne) sqlSave(ctx context.Context) (_node *LLMCall, err error) {
+	_spec := sqlgraph.NewUpdateSpec(llmcall.Table, llmcall.Columns, sqlgraph.NewFieldSpec(llmcall.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LLMCall.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, llmcall.FieldID)
+		for _, f := range fields {
+			if !llmcall.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != llmcall.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(llmcall.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(llmcall.FieldUserID, field.TypeInt, value)
+	}
+	if _u.mutation.UserIDCleared() {
+		_spec.ClearField(llmcall.FieldUserID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Domain(); ok {
+		_spec.SetField(llmcall.FieldDomain, field.TypeString, value)
+	}
+	if _u.mutation.DomainCleared() {
+		_spec.ClearField(llmcall.FieldDomain, field.TypeString)
+	}
+	if value, ok := _u.mutation.Stage(); ok {
+		_spec.SetField(llmcall.FieldStage, field.TypeString, value)
+	}
+	if _u.mutation.StageCleared() {
+		_spec.ClearField(llmcall.FieldStage, field.TypeString)
+	}
+	if value, ok := _u.mutation.Model(); ok {
+		_spec.SetField(llmcall.FieldModel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PromptTokens(); ok {
+		_spec.SetField(llmcall.FieldPromptTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPromptTokens(); ok {
+		_spec.AddField(llmcall.FieldPromptTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CompletionTokens(); ok {
+		_spec.SetField(llmcall.FieldCompletionTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCompletionTokens(); ok {
+		_spec.AddField(llmcall.FieldCompletionTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TotalTokens(); ok {
+		_spec.SetField(llmcall.FieldTotalTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTotalTokens(); ok {
+		_spec.AddField(llmcall.FieldTotalTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Cost(); ok {
+		_spec.SetField(llmcall.FieldCost, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedCost(); ok {
+		_spec.AddField(llmcall.FieldCost, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.LatencyMs(); ok {
+		_spec.SetField(llmcall.FieldLatencyMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedLatencyMs(); ok {
+		_spec.AddField(llmcall.FieldLatencyMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Success(); ok {
+		_spec.SetField(llmcall.FieldSuccess, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(llmcall.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(llmcall.FieldError, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(llmcall.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReportRunCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   llmcall.ReportRunTable,
+			Columns: []string{llmcall.ReportRunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reportrun.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReportRunIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   llmcall.ReportRunTable,
+			Columns: []string{llmcall.ReportRunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reportrun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &LLMCall{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{llmcall.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
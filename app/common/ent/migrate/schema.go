@@ -28,6 +28,58 @@ var (
 			},
 		},
 	}
+	// AnalysisLensColumns holds the columns for the "analysis_lens" table.
+	AnalysisLensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true, SchemaType: map[string]string{"postgres": "serial"}},
+		{Name: "name", Type: field.TypeString},
+		{Name: "instruction", Type: field.TypeString, Size: 2147483647},
+		{Name: "position", Type: field.TypeInt, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt, SchemaType: map[string]string{"postgres": "serial"}},
+	}
+	// AnalysisLensTable holds the schema information for the "analysis_lens" table.
+	AnalysisLensTable = &schema.Table{
+		Name:       "analysis_lens",
+		Columns:    AnalysisLensColumns,
+		PrimaryKey: []*schema.Column{AnalysisLensColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "analysis_lens_users_analysis_lenses",
+				Columns:    []*schema.Column{AnalysisLensColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "analysislens_user_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{AnalysisLensColumns[5], AnalysisLensColumns[1]},
+			},
+		},
+	}
+	// AnalysisSectionsColumns holds the columns for the "analysis_sections" table.
+	AnalysisSectionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true, SchemaType: map[string]string{"postgres": "serial"}},
+		{Name: "name", Type: field.TypeString},
+		{Name: "content", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "position", Type: field.TypeInt, Default: 0},
+		{Name: "deep_analysis_id", Type: field.TypeInt, Nullable: true, SchemaType: map[string]string{"postgres": "serial"}},
+	}
+	// AnalysisSectionsTable holds the schema information for the "analysis_sections" table.
+	AnalysisSectionsTable = &schema.Table{
+		Name:       "analysis_sections",
+		Columns:    AnalysisSectionsColumns,
+		PrimaryKey: []*schema.Column{AnalysisSectionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "analysis_sections_deep_analysis_results_sections",
+				Columns:    []*schema.Column{AnalysisSectionsColumns[4]},
+				RefColumns: []*schema.Column{DeepAnalysisResultsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
 	// ArticlesColumns holds the columns for the "articles" table.
 	ArticlesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true, SchemaType: map[string]string{"postgres": "serial"}},
@@ -36,6 +88,7 @@ var (
 		{Name: "source", Type: field.TypeString, Nullable: true},
 		{Name: "pub_date", Type: field.TypeString, Nullable: true},
 		{Name: "content", Type: field.TypeString, Nullable: true},
+		{Name: "ref_index", Type: field.TypeInt, Nullable: true},
 		{Name: "domain_report_id", Type: field.TypeInt, Nullable: true, SchemaType: map[string]string{"postgres": "serial"}},
 	}
 	// ArticlesTable holds the schema information for the "articles" table.
@@ -46,7 +99,66 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "articles_domain_reports_articles",
-				Columns:    []*schema.Column{ArticlesColumns[6]},
+				Columns:    []*schema.Column{ArticlesColumns[7]},
+				RefColumns: []*schema.Column{DomainReportsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// ArticleEntitiesColumns holds the columns for the "article_entities" table.
+	ArticleEntitiesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true, SchemaType: map[string]string{"postgres": "serial"}},
+		{Name: "mention", Type: field.TypeString, Nullable: true},
+		{Name: "article_id", Type: field.TypeInt, SchemaType: map[string]string{"postgres": "serial"}},
+		{Name: "entity_id", Type: field.TypeInt, SchemaType: map[string]string{"postgres": "serial"}},
+	}
+	// ArticleEntitiesTable holds the schema information for the "article_entities" table.
+	ArticleEntitiesTable = &schema.Table{
+		Name:       "article_entities",
+		Columns:    ArticleEntitiesColumns,
+		PrimaryKey: []*schema.Column{ArticleEntitiesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "article_entities_articles_article_entities",
+				Columns:    []*schema.Column{ArticleEntitiesColumns[2]},
+				RefColumns: []*schema.Column{ArticlesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "article_entities_entities_article_entities",
+				Columns:    []*schema.Column{ArticleEntitiesColumns[3]},
+				RefColumns: []*schema.Column{EntitiesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "articleentity_article_id_entity_id",
+				Unique:  true,
+				Columns: []*schema.Column{ArticleEntitiesColumns[2], ArticleEntitiesColumns[3]},
+			},
+		},
+	}
+	// ClaimVerificationsColumns holds the columns for the "claim_verifications" table.
+	ClaimVerificationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true, SchemaType: map[string]string{"postgres": "serial"}},
+		{Name: "claim_type", Type: field.TypeString},
+		{Name: "claim", Type: field.TypeString, Nullable: true},
+		{Name: "verdict", Type: field.TypeString},
+		{Name: "reason", Type: field.TypeString, Nullable: true},
+		{Name: "dropped", Type: field.TypeBool, Default: false},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "domain_report_id", Type: field.TypeInt, Nullable: true, SchemaType: map[string]string{"postgres": "serial"}},
+	}
+	// ClaimVerificationsTable holds the schema information for the "claim_verifications" table.
+	ClaimVerificationsTable = &schema.Table{
+		Name:       "claim_verifications",
+		Columns:    ClaimVerificationsColumns,
+		PrimaryKey: []*schema.Column{ClaimVerificationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "claim_verifications_domain_reports_claim_verifications",
+				Columns:    []*schema.Column{ClaimVerificationsColumns[7]},
 				RefColumns: []*schema.Column{DomainReportsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -56,10 +168,12 @@ var (
 	DeepAnalysisResultsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true, SchemaType: map[string]string{"postgres": "serial"}},
 		{Name: "user_id", Type: field.TypeInt, Nullable: true},
+		{Name: "persona_name", Type: field.TypeString, Nullable: true},
 		{Name: "macro_trends", Type: field.TypeString, Nullable: true},
 		{Name: "opportunities", Type: field.TypeString, Nullable: true},
 		{Name: "risks", Type: field.TypeString, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
+		{Name: "persona_id", Type: field.TypeInt, Nullable: true, SchemaType: map[string]string{"postgres": "serial"}},
 		{Name: "run_id", Type: field.TypeInt, Nullable: true, SchemaType: map[string]string{"postgres": "serial"}},
 	}
 	// DeepAnalysisResultsTable holds the schema information for the "deep_analysis_results" table.
@@ -68,9 +182,15 @@ var (
 		Columns:    DeepAnalysisResultsColumns,
 		PrimaryKey: []*schema.Column{DeepAnalysisResultsColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "deep_analysis_results_personas_deep_analysis_results",
+				Columns:    []*schema.Column{DeepAnalysisResultsColumns[7]},
+				RefColumns: []*schema.Column{PersonasColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "deep_analysis_results_report_runs_deep_analysis_results",
-				Columns:    []*schema.Column{DeepAnalysisResultsColumns[6]},
+				Columns:    []*schema.Column{DeepAnalysisResultsColumns[8]},
 				RefColumns: []*schema.Column{ReportRunsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -79,10 +199,20 @@ var (
 	// DomainReportsColumns holds the columns for the "domain_reports" table.
 	DomainReportsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true, SchemaType: map[string]string{"postgres": "serial"}},
+		{Name: "share_key", Type: field.TypeString, Nullable: true},
 		{Name: "domain_name", Type: field.TypeString},
 		{Name: "overview", Type: field.TypeString, Nullable: true},
 		{Name: "trends", Type: field.TypeString, Nullable: true},
 		{Name: "score", Type: field.TypeInt, Nullable: true},
+		{Name: "llm_score", Type: field.TypeInt, Nullable: true},
+		{Name: "result_count", Type: field.TypeInt, Nullable: true},
+		{Name: "heat_baseline", Type: field.TypeFloat64, Nullable: true},
+		{Name: "heat_volume", Type: field.TypeFloat64, Nullable: true},
+		{Name: "heat_diversity", Type: field.TypeFloat64, Nullable: true},
+		{Name: "heat_recency", Type: field.TypeFloat64, Nullable: true},
+		{Name: "heat_engagement", Type: field.TypeFloat64, Nullable: true},
+		{Name: "heat_significance", Type: field.TypeFloat64, Nullable: true},
+		{Name: "heat_raw", Type: field.TypeFloat64, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "run_id", Type: field.TypeInt, Nullable: true, SchemaType: map[string]string{"postgres": "serial"}},
 	}
@@ -93,17 +223,70 @@ var (
 		PrimaryKey: []*schema.Column{DomainReportsColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
 			{
-				Symbol:     "domain_reports_report_runs_domain_reports",
-				Columns:    []*schema.Column{DomainReportsColumns[6]},
+				Symbol:     "domain_reports_report_runs_generated_domain_reports",
+				Columns:    []*schema.Column{DomainReportsColumns[16]},
+				RefColumns: []*schema.Column{ReportRunsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "domainreport_share_key_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{DomainReportsColumns[1], DomainReportsColumns[15]},
+			},
+		},
+	}
+	// DomainRunsColumns holds the columns for the "domain_runs" table.
+	DomainRunsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true, SchemaType: map[string]string{"postgres": "serial"}},
+		{Name: "domain_name", Type: field.TypeString},
+		{Name: "status", Type: field.TypeString},
+		{Name: "error", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "article_count", Type: field.TypeInt, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "run_id", Type: field.TypeInt, Nullable: true, SchemaType: map[string]string{"postgres": "serial"}},
+	}
+	// DomainRunsTable holds the schema information for the "domain_runs" table.
+	DomainRunsTable = &schema.Table{
+		Name:       "domain_runs",
+		Columns:    DomainRunsColumns,
+		PrimaryKey: []*schema.Column{DomainRunsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "domain_runs_report_runs_domain_runs",
+				Columns:    []*schema.Column{DomainRunsColumns[6]},
 				RefColumns: []*schema.Column{ReportRunsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
 	}
+	// EntitiesColumns holds the columns for the "entities" table.
+	EntitiesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true, SchemaType: map[string]string{"postgres": "serial"}},
+		{Name: "name", Type: field.TypeString},
+		{Name: "type", Type: field.TypeString},
+		{Name: "key", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// EntitiesTable holds the schema information for the "entities" table.
+	EntitiesTable = &schema.Table{
+		Name:       "entities",
+		Columns:    EntitiesColumns,
+		PrimaryKey: []*schema.Column{EntitiesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "entity_key_type",
+				Unique:  true,
+				Columns: []*schema.Column{EntitiesColumns[3], EntitiesColumns[2]},
+			},
+		},
+	}
 	// KeyEventsColumns holds the columns for the "key_events" table.
 	KeyEventsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true, SchemaType: map[string]string{"postgres": "serial"}},
 		{Name: "event_content", Type: field.TypeString, Nullable: true},
+		{Name: "verdict", Type: field.TypeString, Nullable: true},
 		{Name: "domain_report_id", Type: field.TypeInt, Nullable: true, SchemaType: map[string]string{"postgres": "serial"}},
 	}
 	// KeyEventsTable holds the schema information for the "key_events" table.
@@ -114,17 +297,123 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "key_events_domain_reports_key_events",
-				Columns:    []*schema.Column{KeyEventsColumns[2]},
+				Columns:    []*schema.Column{KeyEventsColumns[3]},
 				RefColumns: []*schema.Column{DomainReportsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
 	}
+	// LlmCachesColumns holds the columns for the "llm_caches" table.
+	LlmCachesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true, SchemaType: map[string]string{"postgres": "serial"}},
+		{Name: "key", Type: field.TypeString, Unique: true},
+		{Name: "model", Type: field.TypeString},
+		{Name: "template_version", Type: field.TypeString},
+		{Name: "content", Type: field.TypeString, Size: 2147483647},
+		{Name: "prompt_tokens", Type: field.TypeInt, Default: 0},
+		{Name: "completion_tokens", Type: field.TypeInt, Default: 0},
+		{Name: "hits", Type: field.TypeInt, Default: 0},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// LlmCachesTable holds the schema information for the "llm_caches" table.
+	LlmCachesTable = &schema.Table{
+		Name:       "llm_caches",
+		Columns:    LlmCachesColumns,
+		PrimaryKey: []*schema.Column{LlmCachesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "llmcache_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{LlmCachesColumns[8]},
+			},
+		},
+	}
+	// LlmCallsColumns holds the columns for the "llm_calls" table.
+	LlmCallsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true, SchemaType: map[string]string{"postgres": "serial"}},
+		{Name: "user_id", Type: field.TypeInt, Nullable: true},
+		{Name: "domain", Type: field.TypeString, Nullable: true},
+		{Name: "stage", Type: field.TypeString, Nullable: true},
+		{Name: "model", Type: field.TypeString},
+		{Name: "prompt_tokens", Type: field.TypeInt, Default: 0},
+		{Name: "completion_tokens", Type: field.TypeInt, Default: 0},
+		{Name: "total_tokens", Type: field.TypeInt, Default: 0},
+		{Name: "cost", Type: field.TypeFloat64, Default: 0},
+		{Name: "latency_ms", Type: field.TypeInt64, Default: 0},
+		{Name: "success", Type: field.TypeBool, Default: true},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "run_id", Type: field.TypeInt, Nullable: true, SchemaType: map[string]string{"postgres": "serial"}},
+	}
+	// LlmCallsTable holds the schema information for the "llm_calls" table.
+	LlmCallsTable = &schema.Table{
+		Name:       "llm_calls",
+		Columns:    LlmCallsColumns,
+		PrimaryKey: []*schema.Column{LlmCallsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "llm_calls_report_runs_llm_calls",
+				Columns:    []*schema.Column{LlmCallsColumns[13]},
+				RefColumns: []*schema.Column{ReportRunsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "llmcall_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{LlmCallsColumns[1], LlmCallsColumns[12]},
+			},
+		},
+	}
+	// PersonasColumns holds the columns for the "personas" table.
+	PersonasColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true, SchemaType: map[string]string{"postgres": "serial"}},
+		{Name: "name", Type: field.TypeString, Default: "default"},
+		{Name: "version", Type: field.TypeInt},
+		{Name: "role", Type: field.TypeString, Nullable: true},
+		{Name: "seniority", Type: field.TypeString, Nullable: true},
+		{Name: "tech_stack", Type: field.TypeJSON, Nullable: true},
+		{Name: "goals", Type: field.TypeJSON, Nullable: true},
+		{Name: "risk_appetite", Type: field.TypeString, Nullable: true},
+		{Name: "time_horizon", Type: field.TypeString, Nullable: true},
+		{Name: "constraints", Type: field.TypeJSON, Nullable: true},
+		{Name: "source", Type: field.TypeString, Default: "manual"},
+		{Name: "archived", Type: field.TypeBool, Default: false},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt, SchemaType: map[string]string{"postgres": "serial"}},
+	}
+	// PersonasTable holds the schema information for the "personas" table.
+	PersonasTable = &schema.Table{
+		Name:       "personas",
+		Columns:    PersonasColumns,
+		PrimaryKey: []*schema.Column{PersonasColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "personas_users_personas",
+				Columns:    []*schema.Column{PersonasColumns[13]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "persona_user_id_name_version",
+				Unique:  true,
+				Columns: []*schema.Column{PersonasColumns[13], PersonasColumns[1], PersonasColumns[2]},
+			},
+		},
+	}
 	// ReportRunsColumns holds the columns for the "report_runs" table.
 	ReportRunsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true, SchemaType: map[string]string{"postgres": "serial"}},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "title", Type: field.TypeString, Nullable: true, Default: "Daily Report"},
+		{Name: "user_id", Type: field.TypeInt, Nullable: true},
+		{Name: "window_start", Type: field.TypeTime, Nullable: true},
+		{Name: "window_end", Type: field.TypeTime, Nullable: true},
+		{Name: "status", Type: field.TypeString, Default: "completed"},
 	}
 	// ReportRunsTable holds the schema information for the "report_runs" table.
 	ReportRunsTable = &schema.Table{
@@ -132,6 +421,37 @@ var (
 		Columns:    ReportRunsColumns,
 		PrimaryKey: []*schema.Column{ReportRunsColumns[0]},
 	}
+	// ReportRunEventsColumns holds the columns for the "report_run_events" table.
+	ReportRunEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true, SchemaType: map[string]string{"postgres": "serial"}},
+		{Name: "domain", Type: field.TypeString, Nullable: true},
+		{Name: "type", Type: field.TypeString},
+		{Name: "message", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "data", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "run_id", Type: field.TypeInt, Nullable: true, SchemaType: map[string]string{"postgres": "serial"}},
+	}
+	// ReportRunEventsTable holds the schema information for the "report_run_events" table.
+	ReportRunEventsTable = &schema.Table{
+		Name:       "report_run_events",
+		Columns:    ReportRunEventsColumns,
+		PrimaryKey: []*schema.Column{ReportRunEventsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "report_run_events_report_runs_events",
+				Columns:    []*schema.Column{ReportRunEventsColumns[6]},
+				RefColumns: []*schema.Column{ReportRunsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "reportrunevent_run_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{ReportRunEventsColumns[6], ReportRunEventsColumns[5]},
+			},
+		},
+	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true, SchemaType: map[string]string{"postgres": "serial"}},
@@ -139,6 +459,9 @@ var (
 		{Name: "password_hash", Type: field.TypeString},
 		{Name: "persona", Type: field.TypeString, Nullable: true},
 		{Name: "domains", Type: field.TypeJSON, Nullable: true},
+		{Name: "max_tokens_per_run", Type: field.TypeInt, Nullable: true},
+		{Name: "max_cost_per_run", Type: field.TypeFloat64, Nullable: true},
+		{Name: "report_language", Type: field.TypeString, Default: "zh"},
 		{Name: "created_at", Type: field.TypeTime},
 	}
 	// UsersTable holds the schema information for the "users" table.
@@ -147,22 +470,98 @@ var (
 		Columns:    UsersColumns,
 		PrimaryKey: []*schema.Column{UsersColumns[0]},
 	}
+	// KeyEventArticlesColumns holds the columns for the "key_event_articles" table.
+	KeyEventArticlesColumns = []*schema.Column{
+		{Name: "key_event_id", Type: field.TypeInt, SchemaType: map[string]string{"postgres": "serial"}},
+		{Name: "article_id", Type: field.TypeInt, SchemaType: map[string]string{"postgres": "serial"}},
+	}
+	// KeyEventArticlesTable holds the schema information for the "key_event_articles" table.
+	KeyEventArticlesTable = &schema.Table{
+		Name:       "key_event_articles",
+		Columns:    KeyEventArticlesColumns,
+		PrimaryKey: []*schema.Column{KeyEventArticlesColumns[0], KeyEventArticlesColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "key_event_articles_key_event_id",
+				Columns:    []*schema.Column{KeyEventArticlesColumns[0]},
+				RefColumns: []*schema.Column{KeyEventsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "key_event_articles_article_id",
+				Columns:    []*schema.Column{KeyEventArticlesColumns[1]},
+				RefColumns: []*schema.Column{ArticlesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// ReportRunDomainReportsColumns holds the columns for the "report_run_domain_reports" table.
+	ReportRunDomainReportsColumns = []*schema.Column{
+		{Name: "report_run_id", Type: field.TypeInt, SchemaType: map[string]string{"postgres": "serial"}},
+		{Name: "domain_report_id", Type: field.TypeInt, SchemaType: map[string]string{"postgres": "serial"}},
+	}
+	// ReportRunDomainReportsTable holds the schema information for the "report_run_domain_reports" table.
+	ReportRunDomainReportsTable = &schema.Table{
+		Name:       "report_run_domain_reports",
+		Columns:    ReportRunDomainReportsColumns,
+		PrimaryKey: []*schema.Column{ReportRunDomainReportsColumns[0], ReportRunDomainReportsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "report_run_domain_reports_report_run_id",
+				Columns:    []*schema.Column{ReportRunDomainReportsColumns[0]},
+				RefColumns: []*schema.Column{ReportRunsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "report_run_domain_reports_domain_report_id",
+				Columns:    []*schema.Column{ReportRunDomainReportsColumns[1]},
+				RefColumns: []*schema.Column{DomainReportsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		ActionGuidesTable,
+		AnalysisLensTable,
+		AnalysisSectionsTable,
 		ArticlesTable,
+		ArticleEntitiesTable,
+		ClaimVerificationsTable,
 		DeepAnalysisResultsTable,
 		DomainReportsTable,
+		DomainRunsTable,
+		EntitiesTable,
 		KeyEventsTable,
+		LlmCachesTable,
+		LlmCallsTable,
+		PersonasTable,
 		ReportRunsTable,
+		ReportRunEventsTable,
 		UsersTable,
+		KeyEventArticlesTable,
+		ReportRunDomainReportsTable,
 	}
 )
 
 func init() {
 	ActionGuidesTable.ForeignKeys[0].RefTable = DeepAnalysisResultsTable
+	AnalysisLensTable.ForeignKeys[0].RefTable = UsersTable
+	AnalysisSectionsTable.ForeignKeys[0].RefTable = DeepAnalysisResultsTable
 	ArticlesTable.ForeignKeys[0].RefTable = DomainReportsTable
-	DeepAnalysisResultsTable.ForeignKeys[0].RefTable = ReportRunsTable
+	ArticleEntitiesTable.ForeignKeys[0].RefTable = ArticlesTable
+	ArticleEntitiesTable.ForeignKeys[1].RefTable = EntitiesTable
+	ClaimVerificationsTable.ForeignKeys[0].RefTable = DomainReportsTable
+	DeepAnalysisResultsTable.ForeignKeys[0].RefTable = PersonasTable
+	DeepAnalysisResultsTable.ForeignKeys[1].RefTable = ReportRunsTable
 	DomainReportsTable.ForeignKeys[0].RefTable = ReportRunsTable
+	DomainRunsTable.ForeignKeys[0].RefTable = ReportRunsTable
 	KeyEventsTable.ForeignKeys[0].RefTable = DomainReportsTable
+	LlmCallsTable.ForeignKeys[0].RefTable = ReportRunsTable
+	PersonasTable.ForeignKeys[0].RefTable = UsersTable
+	ReportRunEventsTable.ForeignKeys[0].RefTable = ReportRunsTable
+	KeyEventArticlesTable.ForeignKeys[0].RefTable = KeyEventsTable
+	KeyEventArticlesTable.ForeignKeys[1].RefTable = ArticlesTable
+	ReportRunDomainReportsTable.ForeignKeys[0].RefTable = ReportRunsTable
+	ReportRunDomainReportsTable.ForeignKeys[1].RefTable = DomainReportsTable
 }
@@ -12,13 +12,24 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/iWorld-y/domain_radar/app/common/ent/actionguide"
+	"github.com/iWorld-y/domain_radar/app/common/ent/analysislens"
+	"github.com/iWorld-y/domain_radar/app/common/ent/analysissection"
 	"github.com/iWorld-y/domain_radar/app/common/ent/article"
+	"github.com/iWorld-y/domain_radar/app/common/ent/articleentity"
+	"github.com/iWorld-y/domain_radar/app/common/ent/claimverification"
 	"github.com/iWorld-y/domain_radar/app/common/ent/deepanalysisresult"
 	"github.com/iWorld-y/domain_radar/app/common/ent/domainreport"
+	"github.com/iWorld-y/domain_radar/app/common/ent/domainrun"
+	"github.com/iWorld-y/domain_radar/app/common/ent/entity"
 	"github.com/iWorld-y/domain_radar/app/common/ent/keyevent"
+	"github.com/iWorld-y/domain_radar/app/common/ent/llmcache"
+	"github.com/iWorld-y/domain_radar/app/common/ent/llmcall"
+	"github.com/iWorld-y/domain_radar/app/common/ent/persona"
 	"github.com/iWorld-y/domain_radar/app/common/ent/predicate"
 	"github.com/iWorld-y/domain_radar/app/common/ent/reportrun"
+	"github.com/iWorld-y/domain_radar/app/common/ent/reportrunevent"
 	"github.com/iWorld-y/domain_radar/app/common/ent/user"
+	"github.com/iWorld-y/domain_radar/app/domain_radar/pkg/model"
 )
 
 const (
@@ -31,11 +42,21 @@ const (
 
 	// Node types.
 	TypeActionGuide        = "ActionGuide"
+	TypeAnalysisLens       = "AnalysisLens"
+	TypeAnalysisSection    = "AnalysisSection"
 	TypeArticle            = "Article"
+	TypeArticleEntity      = "ArticleEntity"
+	TypeClaimVerification  = "ClaimVerification"
 	TypeDeepAnalysisResult = "DeepAnalysisResult"
 	TypeDomainReport       = "DomainReport"
+	TypeDomainRun          = "DomainRun"
+	TypeEntity             = "Entity"
 	TypeKeyEvent           = "KeyEvent"
+	TypeLLMCache           = "LLMCache"
+	TypeLLMCall            = "LLMCall"
+	TypePersona            = "Persona"
 	TypeReportRun          = "ReportRun"
+	TypeReportRunEvent     = "ReportRunEvent"
 	TypeUser               = "User"
 )
 
@@ -536,36 +557,36 @@ func (m *ActionGuideMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown ActionGuide edge %s", name)
 }
 
-// ArticleMutation represents an operation that mutates the Article nodes in the graph.
-type ArticleMutation struct {
+// AnalysisLensMutation represents an operation that mutates the AnalysisLens nodes in the graph.
+type AnalysisLensMutation struct {
 	config
-	op                   Op
-	typ                  string
-	id                   *int
-	title                *string
-	link                 *string
-	source               *string
-	pub_date             *string
-	content              *string
-	clearedFields        map[string]struct{}
-	domain_report        *int
-	cleareddomain_report bool
-	done                 bool
-	oldValue             func(context.Context) (*Article, error)
-	predicates           []predicate.Article
+	op            Op
+	typ           string
+	id            *int
+	name          *string
+	instruction   *string
+	position      *int
+	addposition   *int
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	user          *int
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*AnalysisLens, error)
+	predicates    []predicate.AnalysisLens
 }
 
-var _ ent.Mutation = (*ArticleMutation)(nil)
+var _ ent.Mutation = (*AnalysisLensMutation)(nil)
 
-// articleOption allows management of the mutation configuration using functional options.
-type articleOption func(*ArticleMutation)
+// analysislensOption allows management of the mutation configuration using functional options.
+type analysislensOption func(*AnalysisLensMutation)
 
-// newArticleMutation creates new mutation for the Article entity.
-func newArticleMutation(c config, op Op, opts ...articleOption) *ArticleMutation {
-	m := &ArticleMutation{
+// newAnalysisLensMutation creates new mutation for the AnalysisLens entity.
+func newAnalysisLensMutation(c config, op Op, opts ...analysislensOption) *AnalysisLensMutation {
+	m := &AnalysisLensMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeArticle,
+		typ:           TypeAnalysisLens,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -574,20 +595,20 @@ func newArticleMutation(c config, op Op, opts ...articleOption) *ArticleMutation
 	return m
 }
 
-// withArticleID sets the ID field of the mutation.
-func withArticleID(id int) articleOption {
-	return func(m *ArticleMutation) {
+// withAnalysisLensID sets the ID field of the mutation.
+func withAnalysisLensID(id int) analysislensOption {
+	return func(m *AnalysisLensMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Article
+			value *AnalysisLens
 		)
-		m.oldValue = func(ctx context.Context) (*Article, error) {
+		m.oldValue = func(ctx context.Context) (*AnalysisLens, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Article.Get(ctx, id)
+					value, err = m.Client().AnalysisLens.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -596,10 +617,10 @@ func withArticleID(id int) articleOption {
 	}
 }
 
-// withArticle sets the old Article of the mutation.
-func withArticle(node *Article) articleOption {
-	return func(m *ArticleMutation) {
-		m.oldValue = func(context.Context) (*Article, error) {
+// withAnalysisLens sets the old AnalysisLens of the mutation.
+func withAnalysisLens(node *AnalysisLens) analysislensOption {
+	return func(m *AnalysisLensMutation) {
+		m.oldValue = func(context.Context) (*AnalysisLens, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -608,7 +629,7 @@ func withArticle(node *Article) articleOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ArticleMutation) Client() *Client {
+func (m AnalysisLensMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -616,7 +637,7 @@ func (m ArticleMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ArticleMutation) Tx() (*Tx, error) {
+func (m AnalysisLensMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -626,14 +647,14 @@ func (m ArticleMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Article entities.
-func (m *ArticleMutation) SetID(id int) {
+// operation is only accepted on creation of AnalysisLens entities.
+func (m *AnalysisLensMutation) SetID(id int) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ArticleMutation) ID() (id int, exists bool) {
+func (m *AnalysisLensMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -644,7 +665,7 @@ func (m *ArticleMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ArticleMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *AnalysisLensMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -653,385 +674,288 @@ func (m *ArticleMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Article.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().AnalysisLens.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetDomainReportID sets the "domain_report_id" field.
-func (m *ArticleMutation) SetDomainReportID(i int) {
-	m.domain_report = &i
+// SetUserID sets the "user_id" field.
+func (m *AnalysisLensMutation) SetUserID(i int) {
+	m.user = &i
 }
 
-// DomainReportID returns the value of the "domain_report_id" field in the mutation.
-func (m *ArticleMutation) DomainReportID() (r int, exists bool) {
-	v := m.domain_report
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *AnalysisLensMutation) UserID() (r int, exists bool) {
+	v := m.user
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDomainReportID returns the old "domain_report_id" field's value of the Article entity.
-// If the Article object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the AnalysisLens entity.
+// If the AnalysisLens object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ArticleMutation) OldDomainReportID(ctx context.Context) (v int, err error) {
+func (m *AnalysisLensMutation) OldUserID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDomainReportID is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDomainReportID requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDomainReportID: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.DomainReportID, nil
-}
-
-// ClearDomainReportID clears the value of the "domain_report_id" field.
-func (m *ArticleMutation) ClearDomainReportID() {
-	m.domain_report = nil
-	m.clearedFields[article.FieldDomainReportID] = struct{}{}
-}
-
-// DomainReportIDCleared returns if the "domain_report_id" field was cleared in this mutation.
-func (m *ArticleMutation) DomainReportIDCleared() bool {
-	_, ok := m.clearedFields[article.FieldDomainReportID]
-	return ok
+	return oldValue.UserID, nil
 }
 
-// ResetDomainReportID resets all changes to the "domain_report_id" field.
-func (m *ArticleMutation) ResetDomainReportID() {
-	m.domain_report = nil
-	delete(m.clearedFields, article.FieldDomainReportID)
+// ResetUserID resets all changes to the "user_id" field.
+func (m *AnalysisLensMutation) ResetUserID() {
+	m.user = nil
 }
 
-// SetTitle sets the "title" field.
-func (m *ArticleMutation) SetTitle(s string) {
-	m.title = &s
+// SetName sets the "name" field.
+func (m *AnalysisLensMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Title returns the value of the "title" field in the mutation.
-func (m *ArticleMutation) Title() (r string, exists bool) {
-	v := m.title
+// Name returns the value of the "name" field in the mutation.
+func (m *AnalysisLensMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTitle returns the old "title" field's value of the Article entity.
-// If the Article object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the AnalysisLens entity.
+// If the AnalysisLens object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ArticleMutation) OldTitle(ctx context.Context) (v string, err error) {
+func (m *AnalysisLensMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTitle requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.Title, nil
-}
-
-// ClearTitle clears the value of the "title" field.
-func (m *ArticleMutation) ClearTitle() {
-	m.title = nil
-	m.clearedFields[article.FieldTitle] = struct{}{}
-}
-
-// TitleCleared returns if the "title" field was cleared in this mutation.
-func (m *ArticleMutation) TitleCleared() bool {
-	_, ok := m.clearedFields[article.FieldTitle]
-	return ok
+	return oldValue.Name, nil
 }
 
-// ResetTitle resets all changes to the "title" field.
-func (m *ArticleMutation) ResetTitle() {
-	m.title = nil
-	delete(m.clearedFields, article.FieldTitle)
+// ResetName resets all changes to the "name" field.
+func (m *AnalysisLensMutation) ResetName() {
+	m.name = nil
 }
 
-// SetLink sets the "link" field.
-func (m *ArticleMutation) SetLink(s string) {
-	m.link = &s
+// SetInstruction sets the "instruction" field.
+func (m *AnalysisLensMutation) SetInstruction(s string) {
+	m.instruction = &s
 }
 
-// Link returns the value of the "link" field in the mutation.
-func (m *ArticleMutation) Link() (r string, exists bool) {
-	v := m.link
+// Instruction returns the value of the "instruction" field in the mutation.
+func (m *AnalysisLensMutation) Instruction() (r string, exists bool) {
+	v := m.instruction
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLink returns the old "link" field's value of the Article entity.
-// If the Article object wasn't provided to the builder, the object is fetched from the database.
+// OldInstruction returns the old "instruction" field's value of the AnalysisLens entity.
+// If the AnalysisLens object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ArticleMutation) OldLink(ctx context.Context) (v string, err error) {
+func (m *AnalysisLensMutation) OldInstruction(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLink is only allowed on UpdateOne operations")
+		return v, errors.New("OldInstruction is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLink requires an ID field in the mutation")
+		return v, errors.New("OldInstruction requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLink: %w", err)
+		return v, fmt.Errorf("querying old value for OldInstruction: %w", err)
 	}
-	return oldValue.Link, nil
-}
-
-// ClearLink clears the value of the "link" field.
-func (m *ArticleMutation) ClearLink() {
-	m.link = nil
-	m.clearedFields[article.FieldLink] = struct{}{}
-}
-
-// LinkCleared returns if the "link" field was cleared in this mutation.
-func (m *ArticleMutation) LinkCleared() bool {
-	_, ok := m.clearedFields[article.FieldLink]
-	return ok
+	return oldValue.Instruction, nil
 }
 
-// ResetLink resets all changes to the "link" field.
-func (m *ArticleMutation) ResetLink() {
-	m.link = nil
-	delete(m.clearedFields, article.FieldLink)
+// ResetInstruction resets all changes to the "instruction" field.
+func (m *AnalysisLensMutation) ResetInstruction() {
+	m.instruction = nil
 }
 
-// SetSource sets the "source" field.
-func (m *ArticleMutation) SetSource(s string) {
-	m.source = &s
+// SetPosition sets the "position" field.
+func (m *AnalysisLensMutation) SetPosition(i int) {
+	m.position = &i
+	m.addposition = nil
 }
 
-// Source returns the value of the "source" field in the mutation.
-func (m *ArticleMutation) Source() (r string, exists bool) {
-	v := m.source
+// Position returns the value of the "position" field in the mutation.
+func (m *AnalysisLensMutation) Position() (r int, exists bool) {
+	v := m.position
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSource returns the old "source" field's value of the Article entity.
-// If the Article object wasn't provided to the builder, the object is fetched from the database.
+// OldPosition returns the old "position" field's value of the AnalysisLens entity.
+// If the AnalysisLens object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ArticleMutation) OldSource(ctx context.Context) (v string, err error) {
+func (m *AnalysisLensMutation) OldPosition(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSource is only allowed on UpdateOne operations")
+		return v, errors.New("OldPosition is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSource requires an ID field in the mutation")
+		return v, errors.New("OldPosition requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSource: %w", err)
+		return v, fmt.Errorf("querying old value for OldPosition: %w", err)
 	}
-	return oldValue.Source, nil
+	return oldValue.Position, nil
 }
 
-// ClearSource clears the value of the "source" field.
-func (m *ArticleMutation) ClearSource() {
-	m.source = nil
-	m.clearedFields[article.FieldSource] = struct{}{}
+// AddPosition adds i to the "position" field.
+func (m *AnalysisLensMutation) AddPosition(i int) {
+	if m.addposition != nil {
+		*m.addposition += i
+	} else {
+		m.addposition = &i
+	}
 }
 
-// SourceCleared returns if the "source" field was cleared in this mutation.
-func (m *ArticleMutation) SourceCleared() bool {
-	_, ok := m.clearedFields[article.FieldSource]
-	return ok
+// AddedPosition returns the value that was added to the "position" field in this mutation.
+func (m *AnalysisLensMutation) AddedPosition() (r int, exists bool) {
+	v := m.addposition
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetSource resets all changes to the "source" field.
-func (m *ArticleMutation) ResetSource() {
-	m.source = nil
-	delete(m.clearedFields, article.FieldSource)
+// ResetPosition resets all changes to the "position" field.
+func (m *AnalysisLensMutation) ResetPosition() {
+	m.position = nil
+	m.addposition = nil
 }
 
-// SetPubDate sets the "pub_date" field.
-func (m *ArticleMutation) SetPubDate(s string) {
-	m.pub_date = &s
+// SetCreatedAt sets the "created_at" field.
+func (m *AnalysisLensMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// PubDate returns the value of the "pub_date" field in the mutation.
-func (m *ArticleMutation) PubDate() (r string, exists bool) {
-	v := m.pub_date
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *AnalysisLensMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPubDate returns the old "pub_date" field's value of the Article entity.
-// If the Article object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the AnalysisLens entity.
+// If the AnalysisLens object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ArticleMutation) OldPubDate(ctx context.Context) (v string, err error) {
+func (m *AnalysisLensMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPubDate is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPubDate requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPubDate: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.PubDate, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ClearPubDate clears the value of the "pub_date" field.
-func (m *ArticleMutation) ClearPubDate() {
-	m.pub_date = nil
-	m.clearedFields[article.FieldPubDate] = struct{}{}
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *AnalysisLensMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// PubDateCleared returns if the "pub_date" field was cleared in this mutation.
-func (m *ArticleMutation) PubDateCleared() bool {
-	_, ok := m.clearedFields[article.FieldPubDate]
-	return ok
+// ClearUser clears the "user" edge to the User entity.
+func (m *AnalysisLensMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[analysislens.FieldUserID] = struct{}{}
 }
 
-// ResetPubDate resets all changes to the "pub_date" field.
-func (m *ArticleMutation) ResetPubDate() {
-	m.pub_date = nil
-	delete(m.clearedFields, article.FieldPubDate)
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *AnalysisLensMutation) UserCleared() bool {
+	return m.cleareduser
 }
 
-// SetContent sets the "content" field.
-func (m *ArticleMutation) SetContent(s string) {
-	m.content = &s
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *AnalysisLensMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
 }
 
-// Content returns the value of the "content" field in the mutation.
-func (m *ArticleMutation) Content() (r string, exists bool) {
-	v := m.content
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetUser resets all changes to the "user" edge.
+func (m *AnalysisLensMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
 }
 
-// OldContent returns the old "content" field's value of the Article entity.
-// If the Article object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ArticleMutation) OldContent(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldContent is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldContent requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldContent: %w", err)
-	}
-	return oldValue.Content, nil
+// Where appends a list predicates to the AnalysisLensMutation builder.
+func (m *AnalysisLensMutation) Where(ps ...predicate.AnalysisLens) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// ClearContent clears the value of the "content" field.
-func (m *ArticleMutation) ClearContent() {
-	m.content = nil
-	m.clearedFields[article.FieldContent] = struct{}{}
+// WhereP appends storage-level predicates to the AnalysisLensMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *AnalysisLensMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AnalysisLens, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
 }
 
-// ContentCleared returns if the "content" field was cleared in this mutation.
-func (m *ArticleMutation) ContentCleared() bool {
-	_, ok := m.clearedFields[article.FieldContent]
-	return ok
+// Op returns the operation name.
+func (m *AnalysisLensMutation) Op() Op {
+	return m.op
 }
 
-// ResetContent resets all changes to the "content" field.
-func (m *ArticleMutation) ResetContent() {
-	m.content = nil
-	delete(m.clearedFields, article.FieldContent)
+// SetOp allows setting the mutation operation.
+func (m *AnalysisLensMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// ClearDomainReport clears the "domain_report" edge to the DomainReport entity.
-func (m *ArticleMutation) ClearDomainReport() {
-	m.cleareddomain_report = true
-	m.clearedFields[article.FieldDomainReportID] = struct{}{}
-}
-
-// DomainReportCleared reports if the "domain_report" edge to the DomainReport entity was cleared.
-func (m *ArticleMutation) DomainReportCleared() bool {
-	return m.DomainReportIDCleared() || m.cleareddomain_report
-}
-
-// DomainReportIDs returns the "domain_report" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// DomainReportID instead. It exists only for internal usage by the builders.
-func (m *ArticleMutation) DomainReportIDs() (ids []int) {
-	if id := m.domain_report; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetDomainReport resets all changes to the "domain_report" edge.
-func (m *ArticleMutation) ResetDomainReport() {
-	m.domain_report = nil
-	m.cleareddomain_report = false
-}
-
-// Where appends a list predicates to the ArticleMutation builder.
-func (m *ArticleMutation) Where(ps ...predicate.Article) {
-	m.predicates = append(m.predicates, ps...)
-}
-
-// WhereP appends storage-level predicates to the ArticleMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ArticleMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Article, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
-}
-
-// Op returns the operation name.
-func (m *ArticleMutation) Op() Op {
-	return m.op
-}
-
-// SetOp allows setting the mutation operation.
-func (m *ArticleMutation) SetOp(op Op) {
-	m.op = op
-}
-
-// Type returns the node type of this mutation (Article).
-func (m *ArticleMutation) Type() string {
-	return m.typ
+// Type returns the node type of this mutation (AnalysisLens).
+func (m *AnalysisLensMutation) Type() string {
+	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ArticleMutation) Fields() []string {
-	fields := make([]string, 0, 6)
-	if m.domain_report != nil {
-		fields = append(fields, article.FieldDomainReportID)
-	}
-	if m.title != nil {
-		fields = append(fields, article.FieldTitle)
+func (m *AnalysisLensMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.user != nil {
+		fields = append(fields, analysislens.FieldUserID)
 	}
-	if m.link != nil {
-		fields = append(fields, article.FieldLink)
+	if m.name != nil {
+		fields = append(fields, analysislens.FieldName)
 	}
-	if m.source != nil {
-		fields = append(fields, article.FieldSource)
+	if m.instruction != nil {
+		fields = append(fields, analysislens.FieldInstruction)
 	}
-	if m.pub_date != nil {
-		fields = append(fields, article.FieldPubDate)
+	if m.position != nil {
+		fields = append(fields, analysislens.FieldPosition)
 	}
-	if m.content != nil {
-		fields = append(fields, article.FieldContent)
+	if m.created_at != nil {
+		fields = append(fields, analysislens.FieldCreatedAt)
 	}
 	return fields
 }
@@ -1039,20 +963,18 @@ func (m *ArticleMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ArticleMutation) Field(name string) (ent.Value, bool) {
+func (m *AnalysisLensMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case article.FieldDomainReportID:
-		return m.DomainReportID()
-	case article.FieldTitle:
-		return m.Title()
-	case article.FieldLink:
-		return m.Link()
-	case article.FieldSource:
-		return m.Source()
-	case article.FieldPubDate:
-		return m.PubDate()
-	case article.FieldContent:
-		return m.Content()
+	case analysislens.FieldUserID:
+		return m.UserID()
+	case analysislens.FieldName:
+		return m.Name()
+	case analysislens.FieldInstruction:
+		return m.Instruction()
+	case analysislens.FieldPosition:
+		return m.Position()
+	case analysislens.FieldCreatedAt:
+		return m.CreatedAt()
 	}
 	return nil, false
 }
@@ -1060,87 +982,83 @@ func (m *ArticleMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ArticleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *AnalysisLensMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case article.FieldDomainReportID:
-		return m.OldDomainReportID(ctx)
-	case article.FieldTitle:
-		return m.OldTitle(ctx)
-	case article.FieldLink:
-		return m.OldLink(ctx)
-	case article.FieldSource:
-		return m.OldSource(ctx)
-	case article.FieldPubDate:
-		return m.OldPubDate(ctx)
-	case article.FieldContent:
-		return m.OldContent(ctx)
+	case analysislens.FieldUserID:
+		return m.OldUserID(ctx)
+	case analysislens.FieldName:
+		return m.OldName(ctx)
+	case analysislens.FieldInstruction:
+		return m.OldInstruction(ctx)
+	case analysislens.FieldPosition:
+		return m.OldPosition(ctx)
+	case analysislens.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Article field %s", name)
+	return nil, fmt.Errorf("unknown AnalysisLens field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ArticleMutation) SetField(name string, value ent.Value) error {
+func (m *AnalysisLensMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case article.FieldDomainReportID:
+	case analysislens.FieldUserID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDomainReportID(v)
-		return nil
-	case article.FieldTitle:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTitle(v)
+		m.SetUserID(v)
 		return nil
-	case article.FieldLink:
+	case analysislens.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLink(v)
+		m.SetName(v)
 		return nil
-	case article.FieldSource:
+	case analysislens.FieldInstruction:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSource(v)
+		m.SetInstruction(v)
 		return nil
-	case article.FieldPubDate:
-		v, ok := value.(string)
+	case analysislens.FieldPosition:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPubDate(v)
+		m.SetPosition(v)
 		return nil
-	case article.FieldContent:
-		v, ok := value.(string)
+	case analysislens.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetContent(v)
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Article field %s", name)
+	return fmt.Errorf("unknown AnalysisLens field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ArticleMutation) AddedFields() []string {
+func (m *AnalysisLensMutation) AddedFields() []string {
 	var fields []string
+	if m.addposition != nil {
+		fields = append(fields, analysislens.FieldPosition)
+	}
 	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ArticleMutation) AddedField(name string) (ent.Value, bool) {
+func (m *AnalysisLensMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
+	case analysislens.FieldPosition:
+		return m.AddedPosition()
 	}
 	return nil, false
 }
@@ -1148,111 +1066,76 @@ func (m *ArticleMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ArticleMutation) AddField(name string, value ent.Value) error {
+func (m *AnalysisLensMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case analysislens.FieldPosition:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPosition(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Article numeric field %s", name)
+	return fmt.Errorf("unknown AnalysisLens numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ArticleMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(article.FieldDomainReportID) {
-		fields = append(fields, article.FieldDomainReportID)
-	}
-	if m.FieldCleared(article.FieldTitle) {
-		fields = append(fields, article.FieldTitle)
-	}
-	if m.FieldCleared(article.FieldLink) {
-		fields = append(fields, article.FieldLink)
-	}
-	if m.FieldCleared(article.FieldSource) {
-		fields = append(fields, article.FieldSource)
-	}
-	if m.FieldCleared(article.FieldPubDate) {
-		fields = append(fields, article.FieldPubDate)
-	}
-	if m.FieldCleared(article.FieldContent) {
-		fields = append(fields, article.FieldContent)
-	}
-	return fields
+func (m *AnalysisLensMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ArticleMutation) FieldCleared(name string) bool {
+func (m *AnalysisLensMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ArticleMutation) ClearField(name string) error {
-	switch name {
-	case article.FieldDomainReportID:
-		m.ClearDomainReportID()
-		return nil
-	case article.FieldTitle:
-		m.ClearTitle()
-		return nil
-	case article.FieldLink:
-		m.ClearLink()
-		return nil
-	case article.FieldSource:
-		m.ClearSource()
-		return nil
-	case article.FieldPubDate:
-		m.ClearPubDate()
-		return nil
-	case article.FieldContent:
-		m.ClearContent()
-		return nil
-	}
-	return fmt.Errorf("unknown Article nullable field %s", name)
+func (m *AnalysisLensMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown AnalysisLens nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ArticleMutation) ResetField(name string) error {
+func (m *AnalysisLensMutation) ResetField(name string) error {
 	switch name {
-	case article.FieldDomainReportID:
-		m.ResetDomainReportID()
-		return nil
-	case article.FieldTitle:
-		m.ResetTitle()
+	case analysislens.FieldUserID:
+		m.ResetUserID()
 		return nil
-	case article.FieldLink:
-		m.ResetLink()
+	case analysislens.FieldName:
+		m.ResetName()
 		return nil
-	case article.FieldSource:
-		m.ResetSource()
+	case analysislens.FieldInstruction:
+		m.ResetInstruction()
 		return nil
-	case article.FieldPubDate:
-		m.ResetPubDate()
+	case analysislens.FieldPosition:
+		m.ResetPosition()
 		return nil
-	case article.FieldContent:
-		m.ResetContent()
+	case analysislens.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Article field %s", name)
+	return fmt.Errorf("unknown AnalysisLens field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ArticleMutation) AddedEdges() []string {
+func (m *AnalysisLensMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.domain_report != nil {
-		edges = append(edges, article.EdgeDomainReport)
+	if m.user != nil {
+		edges = append(edges, analysislens.EdgeUser)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ArticleMutation) AddedIDs(name string) []ent.Value {
+func (m *AnalysisLensMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case article.EdgeDomainReport:
-		if id := m.domain_report; id != nil {
+	case analysislens.EdgeUser:
+		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -1260,92 +1143,87 @@ func (m *ArticleMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ArticleMutation) RemovedEdges() []string {
+func (m *AnalysisLensMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ArticleMutation) RemovedIDs(name string) []ent.Value {
+func (m *AnalysisLensMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ArticleMutation) ClearedEdges() []string {
+func (m *AnalysisLensMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.cleareddomain_report {
-		edges = append(edges, article.EdgeDomainReport)
+	if m.cleareduser {
+		edges = append(edges, analysislens.EdgeUser)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ArticleMutation) EdgeCleared(name string) bool {
+func (m *AnalysisLensMutation) EdgeCleared(name string) bool {
 	switch name {
-	case article.EdgeDomainReport:
-		return m.cleareddomain_report
+	case analysislens.EdgeUser:
+		return m.cleareduser
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ArticleMutation) ClearEdge(name string) error {
+func (m *AnalysisLensMutation) ClearEdge(name string) error {
 	switch name {
-	case article.EdgeDomainReport:
-		m.ClearDomainReport()
+	case analysislens.EdgeUser:
+		m.ClearUser()
 		return nil
 	}
-	return fmt.Errorf("unknown Article unique edge %s", name)
+	return fmt.Errorf("unknown AnalysisLens unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ArticleMutation) ResetEdge(name string) error {
+func (m *AnalysisLensMutation) ResetEdge(name string) error {
 	switch name {
-	case article.EdgeDomainReport:
-		m.ResetDomainReport()
+	case analysislens.EdgeUser:
+		m.ResetUser()
 		return nil
 	}
-	return fmt.Errorf("unknown Article edge %s", name)
+	return fmt.Errorf("unknown AnalysisLens edge %s", name)
 }
 
-// DeepAnalysisResultMutation represents an operation that mutates the DeepAnalysisResult nodes in the graph.
-type DeepAnalysisResultMutation struct {
+// AnalysisSectionMutation represents an operation that mutates the AnalysisSection nodes in the graph.
+type AnalysisSectionMutation struct {
 	config
-	op                   Op
-	typ                  string
-	id                   *int
-	user_id              *int
-	adduser_id           *int
-	macro_trends         *string
-	opportunities        *string
-	risks                *string
-	created_at           *time.Time
-	clearedFields        map[string]struct{}
-	report_run           *int
-	clearedreport_run    bool
-	action_guides        map[int]struct{}
-	removedaction_guides map[int]struct{}
-	clearedaction_guides bool
-	done                 bool
-	oldValue             func(context.Context) (*DeepAnalysisResult, error)
-	predicates           []predicate.DeepAnalysisResult
+	op                          Op
+	typ                         string
+	id                          *int
+	name                        *string
+	content                     *string
+	position                    *int
+	addposition                 *int
+	clearedFields               map[string]struct{}
+	deep_analysis_result        *int
+	cleareddeep_analysis_result bool
+	done                        bool
+	oldValue                    func(context.Context) (*AnalysisSection, error)
+	predicates                  []predicate.AnalysisSection
 }
 
-var _ ent.Mutation = (*DeepAnalysisResultMutation)(nil)
+var _ ent.Mutation = (*AnalysisSectionMutation)(nil)
 
-// deepanalysisresultOption allows management of the mutation configuration using functional options.
-type deepanalysisresultOption func(*DeepAnalysisResultMutation)
+// analysissectionOption allows management of the mutation configuration using functional options.
+type analysissectionOption func(*AnalysisSectionMutation)
 
-// newDeepAnalysisResultMutation creates new mutation for the DeepAnalysisResult entity.
-func newDeepAnalysisResultMutation(c config, op Op, opts ...deepanalysisresultOption) *DeepAnalysisResultMutation {
-	m := &DeepAnalysisResultMutation{
+// newAnalysisSectionMutation creates new mutation for the AnalysisSection entity.
+func newAnalysisSectionMutation(c config, op Op, opts ...analysissectionOption) *AnalysisSectionMutation {
+	m := &AnalysisSectionMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeDeepAnalysisResult,
+		typ:           TypeAnalysisSection,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1354,20 +1232,20 @@ func newDeepAnalysisResultMutation(c config, op Op, opts ...deepanalysisresultOp
 	return m
 }
 
-// withDeepAnalysisResultID sets the ID field of the mutation.
-func withDeepAnalysisResultID(id int) deepanalysisresultOption {
-	return func(m *DeepAnalysisResultMutation) {
+// withAnalysisSectionID sets the ID field of the mutation.
+func withAnalysisSectionID(id int) analysissectionOption {
+	return func(m *AnalysisSectionMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *DeepAnalysisResult
+			value *AnalysisSection
 		)
-		m.oldValue = func(ctx context.Context) (*DeepAnalysisResult, error) {
+		m.oldValue = func(ctx context.Context) (*AnalysisSection, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().DeepAnalysisResult.Get(ctx, id)
+					value, err = m.Client().AnalysisSection.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1376,10 +1254,10 @@ func withDeepAnalysisResultID(id int) deepanalysisresultOption {
 	}
 }
 
-// withDeepAnalysisResult sets the old DeepAnalysisResult of the mutation.
-func withDeepAnalysisResult(node *DeepAnalysisResult) deepanalysisresultOption {
-	return func(m *DeepAnalysisResultMutation) {
-		m.oldValue = func(context.Context) (*DeepAnalysisResult, error) {
+// withAnalysisSection sets the old AnalysisSection of the mutation.
+func withAnalysisSection(node *AnalysisSection) analysissectionOption {
+	return func(m *AnalysisSectionMutation) {
+		m.oldValue = func(context.Context) (*AnalysisSection, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1388,7 +1266,7 @@ func withDeepAnalysisResult(node *DeepAnalysisResult) deepanalysisresultOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m DeepAnalysisResultMutation) Client() *Client {
+func (m AnalysisSectionMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1396,7 +1274,7 @@ func (m DeepAnalysisResultMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m DeepAnalysisResultMutation) Tx() (*Tx, error) {
+func (m AnalysisSectionMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1406,14 +1284,14 @@ func (m DeepAnalysisResultMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of DeepAnalysisResult entities.
-func (m *DeepAnalysisResultMutation) SetID(id int) {
+// operation is only accepted on creation of AnalysisSection entities.
+func (m *AnalysisSectionMutation) SetID(id int) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *DeepAnalysisResultMutation) ID() (id int, exists bool) {
+func (m *AnalysisSectionMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1424,7 +1302,7 @@ func (m *DeepAnalysisResultMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *DeepAnalysisResultMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *AnalysisSectionMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1433,417 +1311,251 @@ func (m *DeepAnalysisResultMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().DeepAnalysisResult.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().AnalysisSection.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetRunID sets the "run_id" field.
-func (m *DeepAnalysisResultMutation) SetRunID(i int) {
-	m.report_run = &i
+// SetDeepAnalysisID sets the "deep_analysis_id" field.
+func (m *AnalysisSectionMutation) SetDeepAnalysisID(i int) {
+	m.deep_analysis_result = &i
 }
 
-// RunID returns the value of the "run_id" field in the mutation.
-func (m *DeepAnalysisResultMutation) RunID() (r int, exists bool) {
-	v := m.report_run
+// DeepAnalysisID returns the value of the "deep_analysis_id" field in the mutation.
+func (m *AnalysisSectionMutation) DeepAnalysisID() (r int, exists bool) {
+	v := m.deep_analysis_result
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRunID returns the old "run_id" field's value of the DeepAnalysisResult entity.
-// If the DeepAnalysisResult object wasn't provided to the builder, the object is fetched from the database.
+// OldDeepAnalysisID returns the old "deep_analysis_id" field's value of the AnalysisSection entity.
+// If the AnalysisSection object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeepAnalysisResultMutation) OldRunID(ctx context.Context) (v int, err error) {
+func (m *AnalysisSectionMutation) OldDeepAnalysisID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRunID is only allowed on UpdateOne operations")
+		return v, errors.New("OldDeepAnalysisID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRunID requires an ID field in the mutation")
+		return v, errors.New("OldDeepAnalysisID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRunID: %w", err)
+		return v, fmt.Errorf("querying old value for OldDeepAnalysisID: %w", err)
 	}
-	return oldValue.RunID, nil
+	return oldValue.DeepAnalysisID, nil
 }
 
-// ClearRunID clears the value of the "run_id" field.
-func (m *DeepAnalysisResultMutation) ClearRunID() {
-	m.report_run = nil
-	m.clearedFields[deepanalysisresult.FieldRunID] = struct{}{}
+// ClearDeepAnalysisID clears the value of the "deep_analysis_id" field.
+func (m *AnalysisSectionMutation) ClearDeepAnalysisID() {
+	m.deep_analysis_result = nil
+	m.clearedFields[analysissection.FieldDeepAnalysisID] = struct{}{}
 }
 
-// RunIDCleared returns if the "run_id" field was cleared in this mutation.
-func (m *DeepAnalysisResultMutation) RunIDCleared() bool {
-	_, ok := m.clearedFields[deepanalysisresult.FieldRunID]
+// DeepAnalysisIDCleared returns if the "deep_analysis_id" field was cleared in this mutation.
+func (m *AnalysisSectionMutation) DeepAnalysisIDCleared() bool {
+	_, ok := m.clearedFields[analysissection.FieldDeepAnalysisID]
 	return ok
 }
 
-// ResetRunID resets all changes to the "run_id" field.
-func (m *DeepAnalysisResultMutation) ResetRunID() {
-	m.report_run = nil
-	delete(m.clearedFields, deepanalysisresult.FieldRunID)
+// ResetDeepAnalysisID resets all changes to the "deep_analysis_id" field.
+func (m *AnalysisSectionMutation) ResetDeepAnalysisID() {
+	m.deep_analysis_result = nil
+	delete(m.clearedFields, analysissection.FieldDeepAnalysisID)
 }
 
-// SetUserID sets the "user_id" field.
-func (m *DeepAnalysisResultMutation) SetUserID(i int) {
-	m.user_id = &i
-	m.adduser_id = nil
+// SetName sets the "name" field.
+func (m *AnalysisSectionMutation) SetName(s string) {
+	m.name = &s
 }
 
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *DeepAnalysisResultMutation) UserID() (r int, exists bool) {
-	v := m.user_id
+// Name returns the value of the "name" field in the mutation.
+func (m *AnalysisSectionMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the DeepAnalysisResult entity.
-// If the DeepAnalysisResult object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the AnalysisSection entity.
+// If the AnalysisSection object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeepAnalysisResultMutation) OldUserID(ctx context.Context) (v int, err error) {
+func (m *AnalysisSectionMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
-	}
-	return oldValue.UserID, nil
-}
-
-// AddUserID adds i to the "user_id" field.
-func (m *DeepAnalysisResultMutation) AddUserID(i int) {
-	if m.adduser_id != nil {
-		*m.adduser_id += i
-	} else {
-		m.adduser_id = &i
-	}
-}
-
-// AddedUserID returns the value that was added to the "user_id" field in this mutation.
-func (m *DeepAnalysisResultMutation) AddedUserID() (r int, exists bool) {
-	v := m.adduser_id
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return *v, true
-}
-
-// ClearUserID clears the value of the "user_id" field.
-func (m *DeepAnalysisResultMutation) ClearUserID() {
-	m.user_id = nil
-	m.adduser_id = nil
-	m.clearedFields[deepanalysisresult.FieldUserID] = struct{}{}
-}
-
-// UserIDCleared returns if the "user_id" field was cleared in this mutation.
-func (m *DeepAnalysisResultMutation) UserIDCleared() bool {
-	_, ok := m.clearedFields[deepanalysisresult.FieldUserID]
-	return ok
+	return oldValue.Name, nil
 }
 
-// ResetUserID resets all changes to the "user_id" field.
-func (m *DeepAnalysisResultMutation) ResetUserID() {
-	m.user_id = nil
-	m.adduser_id = nil
-	delete(m.clearedFields, deepanalysisresult.FieldUserID)
+// ResetName resets all changes to the "name" field.
+func (m *AnalysisSectionMutation) ResetName() {
+	m.name = nil
 }
 
-// SetMacroTrends sets the "macro_trends" field.
-func (m *DeepAnalysisResultMutation) SetMacroTrends(s string) {
-	m.macro_trends = &s
+// SetContent sets the "content" field.
+func (m *AnalysisSectionMutation) SetContent(s string) {
+	m.content = &s
 }
 
-// MacroTrends returns the value of the "macro_trends" field in the mutation.
-func (m *DeepAnalysisResultMutation) MacroTrends() (r string, exists bool) {
-	v := m.macro_trends
+// Content returns the value of the "content" field in the mutation.
+func (m *AnalysisSectionMutation) Content() (r string, exists bool) {
+	v := m.content
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMacroTrends returns the old "macro_trends" field's value of the DeepAnalysisResult entity.
-// If the DeepAnalysisResult object wasn't provided to the builder, the object is fetched from the database.
+// OldContent returns the old "content" field's value of the AnalysisSection entity.
+// If the AnalysisSection object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeepAnalysisResultMutation) OldMacroTrends(ctx context.Context) (v string, err error) {
+func (m *AnalysisSectionMutation) OldContent(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMacroTrends is only allowed on UpdateOne operations")
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMacroTrends requires an ID field in the mutation")
+		return v, errors.New("OldContent requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMacroTrends: %w", err)
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
 	}
-	return oldValue.MacroTrends, nil
+	return oldValue.Content, nil
 }
 
-// ClearMacroTrends clears the value of the "macro_trends" field.
-func (m *DeepAnalysisResultMutation) ClearMacroTrends() {
-	m.macro_trends = nil
-	m.clearedFields[deepanalysisresult.FieldMacroTrends] = struct{}{}
+// ClearContent clears the value of the "content" field.
+func (m *AnalysisSectionMutation) ClearContent() {
+	m.content = nil
+	m.clearedFields[analysissection.FieldContent] = struct{}{}
 }
 
-// MacroTrendsCleared returns if the "macro_trends" field was cleared in this mutation.
-func (m *DeepAnalysisResultMutation) MacroTrendsCleared() bool {
-	_, ok := m.clearedFields[deepanalysisresult.FieldMacroTrends]
+// ContentCleared returns if the "content" field was cleared in this mutation.
+func (m *AnalysisSectionMutation) ContentCleared() bool {
+	_, ok := m.clearedFields[analysissection.FieldContent]
 	return ok
 }
 
-// ResetMacroTrends resets all changes to the "macro_trends" field.
-func (m *DeepAnalysisResultMutation) ResetMacroTrends() {
-	m.macro_trends = nil
-	delete(m.clearedFields, deepanalysisresult.FieldMacroTrends)
+// ResetContent resets all changes to the "content" field.
+func (m *AnalysisSectionMutation) ResetContent() {
+	m.content = nil
+	delete(m.clearedFields, analysissection.FieldContent)
 }
 
-// SetOpportunities sets the "opportunities" field.
-func (m *DeepAnalysisResultMutation) SetOpportunities(s string) {
-	m.opportunities = &s
+// SetPosition sets the "position" field.
+func (m *AnalysisSectionMutation) SetPosition(i int) {
+	m.position = &i
+	m.addposition = nil
 }
 
-// Opportunities returns the value of the "opportunities" field in the mutation.
-func (m *DeepAnalysisResultMutation) Opportunities() (r string, exists bool) {
-	v := m.opportunities
+// Position returns the value of the "position" field in the mutation.
+func (m *AnalysisSectionMutation) Position() (r int, exists bool) {
+	v := m.position
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOpportunities returns the old "opportunities" field's value of the DeepAnalysisResult entity.
-// If the DeepAnalysisResult object wasn't provided to the builder, the object is fetched from the database.
+// OldPosition returns the old "position" field's value of the AnalysisSection entity.
+// If the AnalysisSection object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeepAnalysisResultMutation) OldOpportunities(ctx context.Context) (v string, err error) {
+func (m *AnalysisSectionMutation) OldPosition(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOpportunities is only allowed on UpdateOne operations")
+		return v, errors.New("OldPosition is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOpportunities requires an ID field in the mutation")
+		return v, errors.New("OldPosition requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOpportunities: %w", err)
+		return v, fmt.Errorf("querying old value for OldPosition: %w", err)
 	}
-	return oldValue.Opportunities, nil
-}
-
-// ClearOpportunities clears the value of the "opportunities" field.
-func (m *DeepAnalysisResultMutation) ClearOpportunities() {
-	m.opportunities = nil
-	m.clearedFields[deepanalysisresult.FieldOpportunities] = struct{}{}
-}
-
-// OpportunitiesCleared returns if the "opportunities" field was cleared in this mutation.
-func (m *DeepAnalysisResultMutation) OpportunitiesCleared() bool {
-	_, ok := m.clearedFields[deepanalysisresult.FieldOpportunities]
-	return ok
-}
-
-// ResetOpportunities resets all changes to the "opportunities" field.
-func (m *DeepAnalysisResultMutation) ResetOpportunities() {
-	m.opportunities = nil
-	delete(m.clearedFields, deepanalysisresult.FieldOpportunities)
+	return oldValue.Position, nil
 }
 
-// SetRisks sets the "risks" field.
-func (m *DeepAnalysisResultMutation) SetRisks(s string) {
-	m.risks = &s
+// AddPosition adds i to the "position" field.
+func (m *AnalysisSectionMutation) AddPosition(i int) {
+	if m.addposition != nil {
+		*m.addposition += i
+	} else {
+		m.addposition = &i
+	}
 }
 
-// Risks returns the value of the "risks" field in the mutation.
-func (m *DeepAnalysisResultMutation) Risks() (r string, exists bool) {
-	v := m.risks
+// AddedPosition returns the value that was added to the "position" field in this mutation.
+func (m *AnalysisSectionMutation) AddedPosition() (r int, exists bool) {
+	v := m.addposition
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRisks returns the old "risks" field's value of the DeepAnalysisResult entity.
-// If the DeepAnalysisResult object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeepAnalysisResultMutation) OldRisks(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRisks is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRisks requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRisks: %w", err)
-	}
-	return oldValue.Risks, nil
-}
-
-// ClearRisks clears the value of the "risks" field.
-func (m *DeepAnalysisResultMutation) ClearRisks() {
-	m.risks = nil
-	m.clearedFields[deepanalysisresult.FieldRisks] = struct{}{}
+// ResetPosition resets all changes to the "position" field.
+func (m *AnalysisSectionMutation) ResetPosition() {
+	m.position = nil
+	m.addposition = nil
 }
 
-// RisksCleared returns if the "risks" field was cleared in this mutation.
-func (m *DeepAnalysisResultMutation) RisksCleared() bool {
-	_, ok := m.clearedFields[deepanalysisresult.FieldRisks]
-	return ok
+// SetDeepAnalysisResultID sets the "deep_analysis_result" edge to the DeepAnalysisResult entity by id.
+func (m *AnalysisSectionMutation) SetDeepAnalysisResultID(id int) {
+	m.deep_analysis_result = &id
 }
 
-// ResetRisks resets all changes to the "risks" field.
-func (m *DeepAnalysisResultMutation) ResetRisks() {
-	m.risks = nil
-	delete(m.clearedFields, deepanalysisresult.FieldRisks)
+// ClearDeepAnalysisResult clears the "deep_analysis_result" edge to the DeepAnalysisResult entity.
+func (m *AnalysisSectionMutation) ClearDeepAnalysisResult() {
+	m.cleareddeep_analysis_result = true
+	m.clearedFields[analysissection.FieldDeepAnalysisID] = struct{}{}
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *DeepAnalysisResultMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// DeepAnalysisResultCleared reports if the "deep_analysis_result" edge to the DeepAnalysisResult entity was cleared.
+func (m *AnalysisSectionMutation) DeepAnalysisResultCleared() bool {
+	return m.DeepAnalysisIDCleared() || m.cleareddeep_analysis_result
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *DeepAnalysisResultMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
+// DeepAnalysisResultID returns the "deep_analysis_result" edge ID in the mutation.
+func (m *AnalysisSectionMutation) DeepAnalysisResultID() (id int, exists bool) {
+	if m.deep_analysis_result != nil {
+		return *m.deep_analysis_result, true
 	}
-	return *v, true
+	return
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the DeepAnalysisResult entity.
-// If the DeepAnalysisResult object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeepAnalysisResultMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+// DeepAnalysisResultIDs returns the "deep_analysis_result" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// DeepAnalysisResultID instead. It exists only for internal usage by the builders.
+func (m *AnalysisSectionMutation) DeepAnalysisResultIDs() (ids []int) {
+	if id := m.deep_analysis_result; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.CreatedAt, nil
+	return
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *DeepAnalysisResultMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetDeepAnalysisResult resets all changes to the "deep_analysis_result" edge.
+func (m *AnalysisSectionMutation) ResetDeepAnalysisResult() {
+	m.deep_analysis_result = nil
+	m.cleareddeep_analysis_result = false
 }
 
-// SetReportRunID sets the "report_run" edge to the ReportRun entity by id.
-func (m *DeepAnalysisResultMutation) SetReportRunID(id int) {
-	m.report_run = &id
+// Where appends a list predicates to the AnalysisSectionMutation builder.
+func (m *AnalysisSectionMutation) Where(ps ...predicate.AnalysisSection) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// ClearReportRun clears the "report_run" edge to the ReportRun entity.
-func (m *DeepAnalysisResultMutation) ClearReportRun() {
-	m.clearedreport_run = true
-	m.clearedFields[deepanalysisresult.FieldRunID] = struct{}{}
-}
-
-// ReportRunCleared reports if the "report_run" edge to the ReportRun entity was cleared.
-func (m *DeepAnalysisResultMutation) ReportRunCleared() bool {
-	return m.RunIDCleared() || m.clearedreport_run
-}
-
-// ReportRunID returns the "report_run" edge ID in the mutation.
-func (m *DeepAnalysisResultMutation) ReportRunID() (id int, exists bool) {
-	if m.report_run != nil {
-		return *m.report_run, true
-	}
-	return
-}
-
-// ReportRunIDs returns the "report_run" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ReportRunID instead. It exists only for internal usage by the builders.
-func (m *DeepAnalysisResultMutation) ReportRunIDs() (ids []int) {
-	if id := m.report_run; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetReportRun resets all changes to the "report_run" edge.
-func (m *DeepAnalysisResultMutation) ResetReportRun() {
-	m.report_run = nil
-	m.clearedreport_run = false
-}
-
-// AddActionGuideIDs adds the "action_guides" edge to the ActionGuide entity by ids.
-func (m *DeepAnalysisResultMutation) AddActionGuideIDs(ids ...int) {
-	if m.action_guides == nil {
-		m.action_guides = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.action_guides[ids[i]] = struct{}{}
-	}
-}
-
-// ClearActionGuides clears the "action_guides" edge to the ActionGuide entity.
-func (m *DeepAnalysisResultMutation) ClearActionGuides() {
-	m.clearedaction_guides = true
-}
-
-// ActionGuidesCleared reports if the "action_guides" edge to the ActionGuide entity was cleared.
-func (m *DeepAnalysisResultMutation) ActionGuidesCleared() bool {
-	return m.clearedaction_guides
-}
-
-// RemoveActionGuideIDs removes the "action_guides" edge to the ActionGuide entity by IDs.
-func (m *DeepAnalysisResultMutation) RemoveActionGuideIDs(ids ...int) {
-	if m.removedaction_guides == nil {
-		m.removedaction_guides = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.action_guides, ids[i])
-		m.removedaction_guides[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedActionGuides returns the removed IDs of the "action_guides" edge to the ActionGuide entity.
-func (m *DeepAnalysisResultMutation) RemovedActionGuidesIDs() (ids []int) {
-	for id := range m.removedaction_guides {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ActionGuidesIDs returns the "action_guides" edge IDs in the mutation.
-func (m *DeepAnalysisResultMutation) ActionGuidesIDs() (ids []int) {
-	for id := range m.action_guides {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ResetActionGuides resets all changes to the "action_guides" edge.
-func (m *DeepAnalysisResultMutation) ResetActionGuides() {
-	m.action_guides = nil
-	m.clearedaction_guides = false
-	m.removedaction_guides = nil
-}
-
-// Where appends a list predicates to the DeepAnalysisResultMutation builder.
-func (m *DeepAnalysisResultMutation) Where(ps ...predicate.DeepAnalysisResult) {
-	m.predicates = append(m.predicates, ps...)
-}
-
-// WhereP appends storage-level predicates to the DeepAnalysisResultMutation builder. Using this method,
+// WhereP appends storage-level predicates to the AnalysisSectionMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *DeepAnalysisResultMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.DeepAnalysisResult, len(ps))
+func (m *AnalysisSectionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AnalysisSection, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1851,42 +1563,36 @@ func (m *DeepAnalysisResultMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *DeepAnalysisResultMutation) Op() Op {
+func (m *AnalysisSectionMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *DeepAnalysisResultMutation) SetOp(op Op) {
+func (m *AnalysisSectionMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (DeepAnalysisResult).
-func (m *DeepAnalysisResultMutation) Type() string {
+// Type returns the node type of this mutation (AnalysisSection).
+func (m *AnalysisSectionMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *DeepAnalysisResultMutation) Fields() []string {
-	fields := make([]string, 0, 6)
-	if m.report_run != nil {
-		fields = append(fields, deepanalysisresult.FieldRunID)
-	}
-	if m.user_id != nil {
-		fields = append(fields, deepanalysisresult.FieldUserID)
-	}
-	if m.macro_trends != nil {
-		fields = append(fields, deepanalysisresult.FieldMacroTrends)
+func (m *AnalysisSectionMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.deep_analysis_result != nil {
+		fields = append(fields, analysissection.FieldDeepAnalysisID)
 	}
-	if m.opportunities != nil {
-		fields = append(fields, deepanalysisresult.FieldOpportunities)
+	if m.name != nil {
+		fields = append(fields, analysissection.FieldName)
 	}
-	if m.risks != nil {
-		fields = append(fields, deepanalysisresult.FieldRisks)
+	if m.content != nil {
+		fields = append(fields, analysissection.FieldContent)
 	}
-	if m.created_at != nil {
-		fields = append(fields, deepanalysisresult.FieldCreatedAt)
+	if m.position != nil {
+		fields = append(fields, analysissection.FieldPosition)
 	}
 	return fields
 }
@@ -1894,20 +1600,16 @@ func (m *DeepAnalysisResultMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *DeepAnalysisResultMutation) Field(name string) (ent.Value, bool) {
+func (m *AnalysisSectionMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case deepanalysisresult.FieldRunID:
-		return m.RunID()
-	case deepanalysisresult.FieldUserID:
-		return m.UserID()
-	case deepanalysisresult.FieldMacroTrends:
-		return m.MacroTrends()
-	case deepanalysisresult.FieldOpportunities:
-		return m.Opportunities()
-	case deepanalysisresult.FieldRisks:
-		return m.Risks()
-	case deepanalysisresult.FieldCreatedAt:
-		return m.CreatedAt()
+	case analysissection.FieldDeepAnalysisID:
+		return m.DeepAnalysisID()
+	case analysissection.FieldName:
+		return m.Name()
+	case analysissection.FieldContent:
+		return m.Content()
+	case analysissection.FieldPosition:
+		return m.Position()
 	}
 	return nil, false
 }
@@ -1915,81 +1617,63 @@ func (m *DeepAnalysisResultMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *DeepAnalysisResultMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *AnalysisSectionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case deepanalysisresult.FieldRunID:
-		return m.OldRunID(ctx)
-	case deepanalysisresult.FieldUserID:
-		return m.OldUserID(ctx)
-	case deepanalysisresult.FieldMacroTrends:
-		return m.OldMacroTrends(ctx)
-	case deepanalysisresult.FieldOpportunities:
-		return m.OldOpportunities(ctx)
-	case deepanalysisresult.FieldRisks:
-		return m.OldRisks(ctx)
-	case deepanalysisresult.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
+	case analysissection.FieldDeepAnalysisID:
+		return m.OldDeepAnalysisID(ctx)
+	case analysissection.FieldName:
+		return m.OldName(ctx)
+	case analysissection.FieldContent:
+		return m.OldContent(ctx)
+	case analysissection.FieldPosition:
+		return m.OldPosition(ctx)
 	}
-	return nil, fmt.Errorf("unknown DeepAnalysisResult field %s", name)
+	return nil, fmt.Errorf("unknown AnalysisSection field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DeepAnalysisResultMutation) SetField(name string, value ent.Value) error {
+func (m *AnalysisSectionMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case deepanalysisresult.FieldRunID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetRunID(v)
-		return nil
-	case deepanalysisresult.FieldUserID:
+	case analysissection.FieldDeepAnalysisID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUserID(v)
-		return nil
-	case deepanalysisresult.FieldMacroTrends:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetMacroTrends(v)
+		m.SetDeepAnalysisID(v)
 		return nil
-	case deepanalysisresult.FieldOpportunities:
+	case analysissection.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetOpportunities(v)
+		m.SetName(v)
 		return nil
-	case deepanalysisresult.FieldRisks:
+	case analysissection.FieldContent:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRisks(v)
+		m.SetContent(v)
 		return nil
-	case deepanalysisresult.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case analysissection.FieldPosition:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetPosition(v)
 		return nil
 	}
-	return fmt.Errorf("unknown DeepAnalysisResult field %s", name)
+	return fmt.Errorf("unknown AnalysisSection field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *DeepAnalysisResultMutation) AddedFields() []string {
+func (m *AnalysisSectionMutation) AddedFields() []string {
 	var fields []string
-	if m.adduser_id != nil {
-		fields = append(fields, deepanalysisresult.FieldUserID)
+	if m.addposition != nil {
+		fields = append(fields, analysissection.FieldPosition)
 	}
 	return fields
 }
@@ -1997,10 +1681,10 @@ func (m *DeepAnalysisResultMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *DeepAnalysisResultMutation) AddedField(name string) (ent.Value, bool) {
+func (m *AnalysisSectionMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case deepanalysisresult.FieldUserID:
-		return m.AddedUserID()
+	case analysissection.FieldPosition:
+		return m.AddedPosition()
 	}
 	return nil, false
 }
@@ -2008,236 +1692,185 @@ func (m *DeepAnalysisResultMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DeepAnalysisResultMutation) AddField(name string, value ent.Value) error {
+func (m *AnalysisSectionMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case deepanalysisresult.FieldUserID:
+	case analysissection.FieldPosition:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddUserID(v)
+		m.AddPosition(v)
 		return nil
 	}
-	return fmt.Errorf("unknown DeepAnalysisResult numeric field %s", name)
+	return fmt.Errorf("unknown AnalysisSection numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *DeepAnalysisResultMutation) ClearedFields() []string {
+func (m *AnalysisSectionMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(deepanalysisresult.FieldRunID) {
-		fields = append(fields, deepanalysisresult.FieldRunID)
-	}
-	if m.FieldCleared(deepanalysisresult.FieldUserID) {
-		fields = append(fields, deepanalysisresult.FieldUserID)
+	if m.FieldCleared(analysissection.FieldDeepAnalysisID) {
+		fields = append(fields, analysissection.FieldDeepAnalysisID)
 	}
-	if m.FieldCleared(deepanalysisresult.FieldMacroTrends) {
-		fields = append(fields, deepanalysisresult.FieldMacroTrends)
-	}
-	if m.FieldCleared(deepanalysisresult.FieldOpportunities) {
-		fields = append(fields, deepanalysisresult.FieldOpportunities)
-	}
-	if m.FieldCleared(deepanalysisresult.FieldRisks) {
-		fields = append(fields, deepanalysisresult.FieldRisks)
+	if m.FieldCleared(analysissection.FieldContent) {
+		fields = append(fields, analysissection.FieldContent)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *DeepAnalysisResultMutation) FieldCleared(name string) bool {
+func (m *AnalysisSectionMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *DeepAnalysisResultMutation) ClearField(name string) error {
+func (m *AnalysisSectionMutation) ClearField(name string) error {
 	switch name {
-	case deepanalysisresult.FieldRunID:
-		m.ClearRunID()
-		return nil
-	case deepanalysisresult.FieldUserID:
-		m.ClearUserID()
-		return nil
-	case deepanalysisresult.FieldMacroTrends:
-		m.ClearMacroTrends()
-		return nil
-	case deepanalysisresult.FieldOpportunities:
-		m.ClearOpportunities()
+	case analysissection.FieldDeepAnalysisID:
+		m.ClearDeepAnalysisID()
 		return nil
-	case deepanalysisresult.FieldRisks:
-		m.ClearRisks()
+	case analysissection.FieldContent:
+		m.ClearContent()
 		return nil
 	}
-	return fmt.Errorf("unknown DeepAnalysisResult nullable field %s", name)
+	return fmt.Errorf("unknown AnalysisSection nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *DeepAnalysisResultMutation) ResetField(name string) error {
+func (m *AnalysisSectionMutation) ResetField(name string) error {
 	switch name {
-	case deepanalysisresult.FieldRunID:
-		m.ResetRunID()
-		return nil
-	case deepanalysisresult.FieldUserID:
-		m.ResetUserID()
-		return nil
-	case deepanalysisresult.FieldMacroTrends:
-		m.ResetMacroTrends()
+	case analysissection.FieldDeepAnalysisID:
+		m.ResetDeepAnalysisID()
 		return nil
-	case deepanalysisresult.FieldOpportunities:
-		m.ResetOpportunities()
+	case analysissection.FieldName:
+		m.ResetName()
 		return nil
-	case deepanalysisresult.FieldRisks:
-		m.ResetRisks()
+	case analysissection.FieldContent:
+		m.ResetContent()
 		return nil
-	case deepanalysisresult.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case analysissection.FieldPosition:
+		m.ResetPosition()
 		return nil
 	}
-	return fmt.Errorf("unknown DeepAnalysisResult field %s", name)
+	return fmt.Errorf("unknown AnalysisSection field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *DeepAnalysisResultMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.report_run != nil {
-		edges = append(edges, deepanalysisresult.EdgeReportRun)
-	}
-	if m.action_guides != nil {
-		edges = append(edges, deepanalysisresult.EdgeActionGuides)
+func (m *AnalysisSectionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.deep_analysis_result != nil {
+		edges = append(edges, analysissection.EdgeDeepAnalysisResult)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *DeepAnalysisResultMutation) AddedIDs(name string) []ent.Value {
+func (m *AnalysisSectionMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case deepanalysisresult.EdgeReportRun:
-		if id := m.report_run; id != nil {
+	case analysissection.EdgeDeepAnalysisResult:
+		if id := m.deep_analysis_result; id != nil {
 			return []ent.Value{*id}
 		}
-	case deepanalysisresult.EdgeActionGuides:
-		ids := make([]ent.Value, 0, len(m.action_guides))
-		for id := range m.action_guides {
-			ids = append(ids, id)
-		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *DeepAnalysisResultMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedaction_guides != nil {
-		edges = append(edges, deepanalysisresult.EdgeActionGuides)
-	}
+func (m *AnalysisSectionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *DeepAnalysisResultMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case deepanalysisresult.EdgeActionGuides:
-		ids := make([]ent.Value, 0, len(m.removedaction_guides))
-		for id := range m.removedaction_guides {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *AnalysisSectionMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *DeepAnalysisResultMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedreport_run {
-		edges = append(edges, deepanalysisresult.EdgeReportRun)
-	}
-	if m.clearedaction_guides {
-		edges = append(edges, deepanalysisresult.EdgeActionGuides)
+func (m *AnalysisSectionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareddeep_analysis_result {
+		edges = append(edges, analysissection.EdgeDeepAnalysisResult)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *DeepAnalysisResultMutation) EdgeCleared(name string) bool {
+func (m *AnalysisSectionMutation) EdgeCleared(name string) bool {
 	switch name {
-	case deepanalysisresult.EdgeReportRun:
-		return m.clearedreport_run
-	case deepanalysisresult.EdgeActionGuides:
-		return m.clearedaction_guides
+	case analysissection.EdgeDeepAnalysisResult:
+		return m.cleareddeep_analysis_result
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *DeepAnalysisResultMutation) ClearEdge(name string) error {
+func (m *AnalysisSectionMutation) ClearEdge(name string) error {
 	switch name {
-	case deepanalysisresult.EdgeReportRun:
-		m.ClearReportRun()
+	case analysissection.EdgeDeepAnalysisResult:
+		m.ClearDeepAnalysisResult()
 		return nil
 	}
-	return fmt.Errorf("unknown DeepAnalysisResult unique edge %s", name)
+	return fmt.Errorf("unknown AnalysisSection unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *DeepAnalysisResultMutation) ResetEdge(name string) error {
+func (m *AnalysisSectionMutation) ResetEdge(name string) error {
 	switch name {
-	case deepanalysisresult.EdgeReportRun:
-		m.ResetReportRun()
-		return nil
-	case deepanalysisresult.EdgeActionGuides:
-		m.ResetActionGuides()
+	case analysissection.EdgeDeepAnalysisResult:
+		m.ResetDeepAnalysisResult()
 		return nil
 	}
-	return fmt.Errorf("unknown DeepAnalysisResult edge %s", name)
+	return fmt.Errorf("unknown AnalysisSection edge %s", name)
 }
 
-// DomainReportMutation represents an operation that mutates the DomainReport nodes in the graph.
-type DomainReportMutation struct {
+// ArticleMutation represents an operation that mutates the Article nodes in the graph.
+type ArticleMutation struct {
 	config
-	op                Op
-	typ               string
-	id                *int
-	domain_name       *string
-	overview          *string
-	trends            *string
-	score             *int
-	addscore          *int
-	created_at        *time.Time
-	clearedFields     map[string]struct{}
-	report_run        *int
-	clearedreport_run bool
-	articles          map[int]struct{}
-	removedarticles   map[int]struct{}
-	clearedarticles   bool
-	key_events        map[int]struct{}
-	removedkey_events map[int]struct{}
-	clearedkey_events bool
-	done              bool
-	oldValue          func(context.Context) (*DomainReport, error)
-	predicates        []predicate.DomainReport
+	op                      Op
+	typ                     string
+	id                      *int
+	title                   *string
+	link                    *string
+	source                  *string
+	pub_date                *string
+	content                 *string
+	ref_index               *int
+	addref_index            *int
+	clearedFields           map[string]struct{}
+	domain_report           *int
+	cleareddomain_report    bool
+	key_events              map[int]struct{}
+	removedkey_events       map[int]struct{}
+	clearedkey_events       bool
+	article_entities        map[int]struct{}
+	removedarticle_entities map[int]struct{}
+	clearedarticle_entities bool
+	done                    bool
+	oldValue                func(context.Context) (*Article, error)
+	predicates              []predicate.Article
 }
 
-var _ ent.Mutation = (*DomainReportMutation)(nil)
+var _ ent.Mutation = (*ArticleMutation)(nil)
 
-// domainreportOption allows management of the mutation configuration using functional options.
-type domainreportOption func(*DomainReportMutation)
+// articleOption allows management of the mutation configuration using functional options.
+type articleOption func(*ArticleMutation)
 
-// newDomainReportMutation creates new mutation for the DomainReport entity.
-func newDomainReportMutation(c config, op Op, opts ...domainreportOption) *DomainReportMutation {
-	m := &DomainReportMutation{
+// newArticleMutation creates new mutation for the Article entity.
+func newArticleMutation(c config, op Op, opts ...articleOption) *ArticleMutation {
+	m := &ArticleMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeDomainReport,
+		typ:           TypeArticle,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2246,20 +1879,20 @@ func newDomainReportMutation(c config, op Op, opts ...domainreportOption) *Domai
 	return m
 }
 
-// withDomainReportID sets the ID field of the mutation.
-func withDomainReportID(id int) domainreportOption {
-	return func(m *DomainReportMutation) {
+// withArticleID sets the ID field of the mutation.
+func withArticleID(id int) articleOption {
+	return func(m *ArticleMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *DomainReport
+			value *Article
 		)
-		m.oldValue = func(ctx context.Context) (*DomainReport, error) {
+		m.oldValue = func(ctx context.Context) (*Article, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().DomainReport.Get(ctx, id)
+					value, err = m.Client().Article.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2268,10 +1901,10 @@ func withDomainReportID(id int) domainreportOption {
 	}
 }
 
-// withDomainReport sets the old DomainReport of the mutation.
-func withDomainReport(node *DomainReport) domainreportOption {
-	return func(m *DomainReportMutation) {
-		m.oldValue = func(context.Context) (*DomainReport, error) {
+// withArticle sets the old Article of the mutation.
+func withArticle(node *Article) articleOption {
+	return func(m *ArticleMutation) {
+		m.oldValue = func(context.Context) (*Article, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2280,7 +1913,7 @@ func withDomainReport(node *DomainReport) domainreportOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m DomainReportMutation) Client() *Client {
+func (m ArticleMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2288,7 +1921,7 @@ func (m DomainReportMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m DomainReportMutation) Tx() (*Tx, error) {
+func (m ArticleMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2298,14 +1931,14 @@ func (m DomainReportMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of DomainReport entities.
-func (m *DomainReportMutation) SetID(id int) {
+// operation is only accepted on creation of Article entities.
+func (m *ArticleMutation) SetID(id int) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *DomainReportMutation) ID() (id int, exists bool) {
+func (m *ArticleMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2316,7 +1949,7 @@ func (m *DomainReportMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *DomainReportMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *ArticleMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2325,397 +1958,405 @@ func (m *DomainReportMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().DomainReport.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Article.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetRunID sets the "run_id" field.
-func (m *DomainReportMutation) SetRunID(i int) {
-	m.report_run = &i
+// SetDomainReportID sets the "domain_report_id" field.
+func (m *ArticleMutation) SetDomainReportID(i int) {
+	m.domain_report = &i
 }
 
-// RunID returns the value of the "run_id" field in the mutation.
-func (m *DomainReportMutation) RunID() (r int, exists bool) {
-	v := m.report_run
+// DomainReportID returns the value of the "domain_report_id" field in the mutation.
+func (m *ArticleMutation) DomainReportID() (r int, exists bool) {
+	v := m.domain_report
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRunID returns the old "run_id" field's value of the DomainReport entity.
-// If the DomainReport object wasn't provided to the builder, the object is fetched from the database.
+// OldDomainReportID returns the old "domain_report_id" field's value of the Article entity.
+// If the Article object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DomainReportMutation) OldRunID(ctx context.Context) (v int, err error) {
+func (m *ArticleMutation) OldDomainReportID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRunID is only allowed on UpdateOne operations")
+		return v, errors.New("OldDomainReportID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRunID requires an ID field in the mutation")
+		return v, errors.New("OldDomainReportID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRunID: %w", err)
+		return v, fmt.Errorf("querying old value for OldDomainReportID: %w", err)
 	}
-	return oldValue.RunID, nil
+	return oldValue.DomainReportID, nil
 }
 
-// ClearRunID clears the value of the "run_id" field.
-func (m *DomainReportMutation) ClearRunID() {
-	m.report_run = nil
-	m.clearedFields[domainreport.FieldRunID] = struct{}{}
+// ClearDomainReportID clears the value of the "domain_report_id" field.
+func (m *ArticleMutation) ClearDomainReportID() {
+	m.domain_report = nil
+	m.clearedFields[article.FieldDomainReportID] = struct{}{}
 }
 
-// RunIDCleared returns if the "run_id" field was cleared in this mutation.
-func (m *DomainReportMutation) RunIDCleared() bool {
-	_, ok := m.clearedFields[domainreport.FieldRunID]
+// DomainReportIDCleared returns if the "domain_report_id" field was cleared in this mutation.
+func (m *ArticleMutation) DomainReportIDCleared() bool {
+	_, ok := m.clearedFields[article.FieldDomainReportID]
 	return ok
 }
 
-// ResetRunID resets all changes to the "run_id" field.
-func (m *DomainReportMutation) ResetRunID() {
-	m.report_run = nil
-	delete(m.clearedFields, domainreport.FieldRunID)
+// ResetDomainReportID resets all changes to the "domain_report_id" field.
+func (m *ArticleMutation) ResetDomainReportID() {
+	m.domain_report = nil
+	delete(m.clearedFields, article.FieldDomainReportID)
 }
 
-// SetDomainName sets the "domain_name" field.
-func (m *DomainReportMutation) SetDomainName(s string) {
-	m.domain_name = &s
+// SetTitle sets the "title" field.
+func (m *ArticleMutation) SetTitle(s string) {
+	m.title = &s
 }
 
-// DomainName returns the value of the "domain_name" field in the mutation.
-func (m *DomainReportMutation) DomainName() (r string, exists bool) {
-	v := m.domain_name
+// Title returns the value of the "title" field in the mutation.
+func (m *ArticleMutation) Title() (r string, exists bool) {
+	v := m.title
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDomainName returns the old "domain_name" field's value of the DomainReport entity.
-// If the DomainReport object wasn't provided to the builder, the object is fetched from the database.
+// OldTitle returns the old "title" field's value of the Article entity.
+// If the Article object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DomainReportMutation) OldDomainName(ctx context.Context) (v string, err error) {
+func (m *ArticleMutation) OldTitle(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDomainName is only allowed on UpdateOne operations")
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDomainName requires an ID field in the mutation")
+		return v, errors.New("OldTitle requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDomainName: %w", err)
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
 	}
-	return oldValue.DomainName, nil
+	return oldValue.Title, nil
 }
 
-// ResetDomainName resets all changes to the "domain_name" field.
-func (m *DomainReportMutation) ResetDomainName() {
-	m.domain_name = nil
+// ClearTitle clears the value of the "title" field.
+func (m *ArticleMutation) ClearTitle() {
+	m.title = nil
+	m.clearedFields[article.FieldTitle] = struct{}{}
 }
 
-// SetOverview sets the "overview" field.
-func (m *DomainReportMutation) SetOverview(s string) {
-	m.overview = &s
+// TitleCleared returns if the "title" field was cleared in this mutation.
+func (m *ArticleMutation) TitleCleared() bool {
+	_, ok := m.clearedFields[article.FieldTitle]
+	return ok
 }
 
-// Overview returns the value of the "overview" field in the mutation.
-func (m *DomainReportMutation) Overview() (r string, exists bool) {
-	v := m.overview
+// ResetTitle resets all changes to the "title" field.
+func (m *ArticleMutation) ResetTitle() {
+	m.title = nil
+	delete(m.clearedFields, article.FieldTitle)
+}
+
+// SetLink sets the "link" field.
+func (m *ArticleMutation) SetLink(s string) {
+	m.link = &s
+}
+
+// Link returns the value of the "link" field in the mutation.
+func (m *ArticleMutation) Link() (r string, exists bool) {
+	v := m.link
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOverview returns the old "overview" field's value of the DomainReport entity.
-// If the DomainReport object wasn't provided to the builder, the object is fetched from the database.
+// OldLink returns the old "link" field's value of the Article entity.
+// If the Article object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DomainReportMutation) OldOverview(ctx context.Context) (v string, err error) {
+func (m *ArticleMutation) OldLink(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOverview is only allowed on UpdateOne operations")
+		return v, errors.New("OldLink is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOverview requires an ID field in the mutation")
+		return v, errors.New("OldLink requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOverview: %w", err)
+		return v, fmt.Errorf("querying old value for OldLink: %w", err)
 	}
-	return oldValue.Overview, nil
+	return oldValue.Link, nil
 }
 
-// ClearOverview clears the value of the "overview" field.
-func (m *DomainReportMutation) ClearOverview() {
-	m.overview = nil
-	m.clearedFields[domainreport.FieldOverview] = struct{}{}
+// ClearLink clears the value of the "link" field.
+func (m *ArticleMutation) ClearLink() {
+	m.link = nil
+	m.clearedFields[article.FieldLink] = struct{}{}
 }
 
-// OverviewCleared returns if the "overview" field was cleared in this mutation.
-func (m *DomainReportMutation) OverviewCleared() bool {
-	_, ok := m.clearedFields[domainreport.FieldOverview]
+// LinkCleared returns if the "link" field was cleared in this mutation.
+func (m *ArticleMutation) LinkCleared() bool {
+	_, ok := m.clearedFields[article.FieldLink]
 	return ok
 }
 
-// ResetOverview resets all changes to the "overview" field.
-func (m *DomainReportMutation) ResetOverview() {
-	m.overview = nil
-	delete(m.clearedFields, domainreport.FieldOverview)
+// ResetLink resets all changes to the "link" field.
+func (m *ArticleMutation) ResetLink() {
+	m.link = nil
+	delete(m.clearedFields, article.FieldLink)
 }
 
-// SetTrends sets the "trends" field.
-func (m *DomainReportMutation) SetTrends(s string) {
-	m.trends = &s
+// SetSource sets the "source" field.
+func (m *ArticleMutation) SetSource(s string) {
+	m.source = &s
 }
 
-// Trends returns the value of the "trends" field in the mutation.
-func (m *DomainReportMutation) Trends() (r string, exists bool) {
-	v := m.trends
+// Source returns the value of the "source" field in the mutation.
+func (m *ArticleMutation) Source() (r string, exists bool) {
+	v := m.source
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTrends returns the old "trends" field's value of the DomainReport entity.
-// If the DomainReport object wasn't provided to the builder, the object is fetched from the database.
+// OldSource returns the old "source" field's value of the Article entity.
+// If the Article object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DomainReportMutation) OldTrends(ctx context.Context) (v string, err error) {
+func (m *ArticleMutation) OldSource(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTrends is only allowed on UpdateOne operations")
+		return v, errors.New("OldSource is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTrends requires an ID field in the mutation")
+		return v, errors.New("OldSource requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTrends: %w", err)
+		return v, fmt.Errorf("querying old value for OldSource: %w", err)
 	}
-	return oldValue.Trends, nil
+	return oldValue.Source, nil
 }
 
-// ClearTrends clears the value of the "trends" field.
-func (m *DomainReportMutation) ClearTrends() {
-	m.trends = nil
-	m.clearedFields[domainreport.FieldTrends] = struct{}{}
+// ClearSource clears the value of the "source" field.
+func (m *ArticleMutation) ClearSource() {
+	m.source = nil
+	m.clearedFields[article.FieldSource] = struct{}{}
 }
 
-// TrendsCleared returns if the "trends" field was cleared in this mutation.
-func (m *DomainReportMutation) TrendsCleared() bool {
-	_, ok := m.clearedFields[domainreport.FieldTrends]
+// SourceCleared returns if the "source" field was cleared in this mutation.
+func (m *ArticleMutation) SourceCleared() bool {
+	_, ok := m.clearedFields[article.FieldSource]
 	return ok
 }
 
-// ResetTrends resets all changes to the "trends" field.
-func (m *DomainReportMutation) ResetTrends() {
-	m.trends = nil
-	delete(m.clearedFields, domainreport.FieldTrends)
+// ResetSource resets all changes to the "source" field.
+func (m *ArticleMutation) ResetSource() {
+	m.source = nil
+	delete(m.clearedFields, article.FieldSource)
 }
 
-// SetScore sets the "score" field.
-func (m *DomainReportMutation) SetScore(i int) {
-	m.score = &i
-	m.addscore = nil
+// SetPubDate sets the "pub_date" field.
+func (m *ArticleMutation) SetPubDate(s string) {
+	m.pub_date = &s
 }
 
-// Score returns the value of the "score" field in the mutation.
-func (m *DomainReportMutation) Score() (r int, exists bool) {
-	v := m.score
+// PubDate returns the value of the "pub_date" field in the mutation.
+func (m *ArticleMutation) PubDate() (r string, exists bool) {
+	v := m.pub_date
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldScore returns the old "score" field's value of the DomainReport entity.
-// If the DomainReport object wasn't provided to the builder, the object is fetched from the database.
+// OldPubDate returns the old "pub_date" field's value of the Article entity.
+// If the Article object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DomainReportMutation) OldScore(ctx context.Context) (v int, err error) {
+func (m *ArticleMutation) OldPubDate(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldScore is only allowed on UpdateOne operations")
+		return v, errors.New("OldPubDate is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldScore requires an ID field in the mutation")
+		return v, errors.New("OldPubDate requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldScore: %w", err)
-	}
-	return oldValue.Score, nil
-}
-
-// AddScore adds i to the "score" field.
-func (m *DomainReportMutation) AddScore(i int) {
-	if m.addscore != nil {
-		*m.addscore += i
-	} else {
-		m.addscore = &i
-	}
-}
-
-// AddedScore returns the value that was added to the "score" field in this mutation.
-func (m *DomainReportMutation) AddedScore() (r int, exists bool) {
-	v := m.addscore
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldPubDate: %w", err)
 	}
-	return *v, true
+	return oldValue.PubDate, nil
 }
 
-// ClearScore clears the value of the "score" field.
-func (m *DomainReportMutation) ClearScore() {
-	m.score = nil
-	m.addscore = nil
-	m.clearedFields[domainreport.FieldScore] = struct{}{}
+// ClearPubDate clears the value of the "pub_date" field.
+func (m *ArticleMutation) ClearPubDate() {
+	m.pub_date = nil
+	m.clearedFields[article.FieldPubDate] = struct{}{}
 }
 
-// ScoreCleared returns if the "score" field was cleared in this mutation.
-func (m *DomainReportMutation) ScoreCleared() bool {
-	_, ok := m.clearedFields[domainreport.FieldScore]
+// PubDateCleared returns if the "pub_date" field was cleared in this mutation.
+func (m *ArticleMutation) PubDateCleared() bool {
+	_, ok := m.clearedFields[article.FieldPubDate]
 	return ok
 }
 
-// ResetScore resets all changes to the "score" field.
-func (m *DomainReportMutation) ResetScore() {
-	m.score = nil
-	m.addscore = nil
-	delete(m.clearedFields, domainreport.FieldScore)
+// ResetPubDate resets all changes to the "pub_date" field.
+func (m *ArticleMutation) ResetPubDate() {
+	m.pub_date = nil
+	delete(m.clearedFields, article.FieldPubDate)
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *DomainReportMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetContent sets the "content" field.
+func (m *ArticleMutation) SetContent(s string) {
+	m.content = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *DomainReportMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// Content returns the value of the "content" field in the mutation.
+func (m *ArticleMutation) Content() (r string, exists bool) {
+	v := m.content
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the DomainReport entity.
-// If the DomainReport object wasn't provided to the builder, the object is fetched from the database.
+// OldContent returns the old "content" field's value of the Article entity.
+// If the Article object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DomainReportMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ArticleMutation) OldContent(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldContent requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Content, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *DomainReportMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearContent clears the value of the "content" field.
+func (m *ArticleMutation) ClearContent() {
+	m.content = nil
+	m.clearedFields[article.FieldContent] = struct{}{}
 }
 
-// SetReportRunID sets the "report_run" edge to the ReportRun entity by id.
-func (m *DomainReportMutation) SetReportRunID(id int) {
-	m.report_run = &id
+// ContentCleared returns if the "content" field was cleared in this mutation.
+func (m *ArticleMutation) ContentCleared() bool {
+	_, ok := m.clearedFields[article.FieldContent]
+	return ok
 }
 
-// ClearReportRun clears the "report_run" edge to the ReportRun entity.
-func (m *DomainReportMutation) ClearReportRun() {
-	m.clearedreport_run = true
-	m.clearedFields[domainreport.FieldRunID] = struct{}{}
+// ResetContent resets all changes to the "content" field.
+func (m *ArticleMutation) ResetContent() {
+	m.content = nil
+	delete(m.clearedFields, article.FieldContent)
 }
 
-// ReportRunCleared reports if the "report_run" edge to the ReportRun entity was cleared.
-func (m *DomainReportMutation) ReportRunCleared() bool {
-	return m.RunIDCleared() || m.clearedreport_run
+// SetRefIndex sets the "ref_index" field.
+func (m *ArticleMutation) SetRefIndex(i int) {
+	m.ref_index = &i
+	m.addref_index = nil
 }
 
-// ReportRunID returns the "report_run" edge ID in the mutation.
-func (m *DomainReportMutation) ReportRunID() (id int, exists bool) {
-	if m.report_run != nil {
-		return *m.report_run, true
+// RefIndex returns the value of the "ref_index" field in the mutation.
+func (m *ArticleMutation) RefIndex() (r int, exists bool) {
+	v := m.ref_index
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ReportRunIDs returns the "report_run" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ReportRunID instead. It exists only for internal usage by the builders.
-func (m *DomainReportMutation) ReportRunIDs() (ids []int) {
-	if id := m.report_run; id != nil {
-		ids = append(ids, *id)
+// OldRefIndex returns the old "ref_index" field's value of the Article entity.
+// If the Article object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ArticleMutation) OldRefIndex(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRefIndex is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRefIndex requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRefIndex: %w", err)
+	}
+	return oldValue.RefIndex, nil
 }
 
-// ResetReportRun resets all changes to the "report_run" edge.
-func (m *DomainReportMutation) ResetReportRun() {
-	m.report_run = nil
-	m.clearedreport_run = false
+// AddRefIndex adds i to the "ref_index" field.
+func (m *ArticleMutation) AddRefIndex(i int) {
+	if m.addref_index != nil {
+		*m.addref_index += i
+	} else {
+		m.addref_index = &i
+	}
 }
 
-// AddArticleIDs adds the "articles" edge to the Article entity by ids.
-func (m *DomainReportMutation) AddArticleIDs(ids ...int) {
-	if m.articles == nil {
-		m.articles = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.articles[ids[i]] = struct{}{}
+// AddedRefIndex returns the value that was added to the "ref_index" field in this mutation.
+func (m *ArticleMutation) AddedRefIndex() (r int, exists bool) {
+	v := m.addref_index
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearArticles clears the "articles" edge to the Article entity.
-func (m *DomainReportMutation) ClearArticles() {
-	m.clearedarticles = true
+// ClearRefIndex clears the value of the "ref_index" field.
+func (m *ArticleMutation) ClearRefIndex() {
+	m.ref_index = nil
+	m.addref_index = nil
+	m.clearedFields[article.FieldRefIndex] = struct{}{}
 }
 
-// ArticlesCleared reports if the "articles" edge to the Article entity was cleared.
-func (m *DomainReportMutation) ArticlesCleared() bool {
-	return m.clearedarticles
+// RefIndexCleared returns if the "ref_index" field was cleared in this mutation.
+func (m *ArticleMutation) RefIndexCleared() bool {
+	_, ok := m.clearedFields[article.FieldRefIndex]
+	return ok
 }
 
-// RemoveArticleIDs removes the "articles" edge to the Article entity by IDs.
-func (m *DomainReportMutation) RemoveArticleIDs(ids ...int) {
-	if m.removedarticles == nil {
-		m.removedarticles = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.articles, ids[i])
-		m.removedarticles[ids[i]] = struct{}{}
-	}
+// ResetRefIndex resets all changes to the "ref_index" field.
+func (m *ArticleMutation) ResetRefIndex() {
+	m.ref_index = nil
+	m.addref_index = nil
+	delete(m.clearedFields, article.FieldRefIndex)
 }
 
-// RemovedArticles returns the removed IDs of the "articles" edge to the Article entity.
-func (m *DomainReportMutation) RemovedArticlesIDs() (ids []int) {
-	for id := range m.removedarticles {
-		ids = append(ids, id)
-	}
-	return
+// ClearDomainReport clears the "domain_report" edge to the DomainReport entity.
+func (m *ArticleMutation) ClearDomainReport() {
+	m.cleareddomain_report = true
+	m.clearedFields[article.FieldDomainReportID] = struct{}{}
 }
 
-// ArticlesIDs returns the "articles" edge IDs in the mutation.
-func (m *DomainReportMutation) ArticlesIDs() (ids []int) {
-	for id := range m.articles {
-		ids = append(ids, id)
+// DomainReportCleared reports if the "domain_report" edge to the DomainReport entity was cleared.
+func (m *ArticleMutation) DomainReportCleared() bool {
+	return m.DomainReportIDCleared() || m.cleareddomain_report
+}
+
+// DomainReportIDs returns the "domain_report" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// DomainReportID instead. It exists only for internal usage by the builders.
+func (m *ArticleMutation) DomainReportIDs() (ids []int) {
+	if id := m.domain_report; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetArticles resets all changes to the "articles" edge.
-func (m *DomainReportMutation) ResetArticles() {
-	m.articles = nil
-	m.clearedarticles = false
-	m.removedarticles = nil
+// ResetDomainReport resets all changes to the "domain_report" edge.
+func (m *ArticleMutation) ResetDomainReport() {
+	m.domain_report = nil
+	m.cleareddomain_report = false
 }
 
 // AddKeyEventIDs adds the "key_events" edge to the KeyEvent entity by ids.
-func (m *DomainReportMutation) AddKeyEventIDs(ids ...int) {
+func (m *ArticleMutation) AddKeyEventIDs(ids ...int) {
 	if m.key_events == nil {
 		m.key_events = make(map[int]struct{})
 	}
@@ -2725,17 +2366,17 @@ func (m *DomainReportMutation) AddKeyEventIDs(ids ...int) {
 }
 
 // ClearKeyEvents clears the "key_events" edge to the KeyEvent entity.
-func (m *DomainReportMutation) ClearKeyEvents() {
+func (m *ArticleMutation) ClearKeyEvents() {
 	m.clearedkey_events = true
 }
 
 // KeyEventsCleared reports if the "key_events" edge to the KeyEvent entity was cleared.
-func (m *DomainReportMutation) KeyEventsCleared() bool {
+func (m *ArticleMutation) KeyEventsCleared() bool {
 	return m.clearedkey_events
 }
 
 // RemoveKeyEventIDs removes the "key_events" edge to the KeyEvent entity by IDs.
-func (m *DomainReportMutation) RemoveKeyEventIDs(ids ...int) {
+func (m *ArticleMutation) RemoveKeyEventIDs(ids ...int) {
 	if m.removedkey_events == nil {
 		m.removedkey_events = make(map[int]struct{})
 	}
@@ -2746,7 +2387,7 @@ func (m *DomainReportMutation) RemoveKeyEventIDs(ids ...int) {
 }
 
 // RemovedKeyEvents returns the removed IDs of the "key_events" edge to the KeyEvent entity.
-func (m *DomainReportMutation) RemovedKeyEventsIDs() (ids []int) {
+func (m *ArticleMutation) RemovedKeyEventsIDs() (ids []int) {
 	for id := range m.removedkey_events {
 		ids = append(ids, id)
 	}
@@ -2754,7 +2395,7 @@ func (m *DomainReportMutation) RemovedKeyEventsIDs() (ids []int) {
 }
 
 // KeyEventsIDs returns the "key_events" edge IDs in the mutation.
-func (m *DomainReportMutation) KeyEventsIDs() (ids []int) {
+func (m *ArticleMutation) KeyEventsIDs() (ids []int) {
 	for id := range m.key_events {
 		ids = append(ids, id)
 	}
@@ -2762,21 +2403,75 @@ func (m *DomainReportMutation) KeyEventsIDs() (ids []int) {
 }
 
 // ResetKeyEvents resets all changes to the "key_events" edge.
-func (m *DomainReportMutation) ResetKeyEvents() {
+func (m *ArticleMutation) ResetKeyEvents() {
 	m.key_events = nil
 	m.clearedkey_events = false
 	m.removedkey_events = nil
 }
 
-// Where appends a list predicates to the DomainReportMutation builder.
-func (m *DomainReportMutation) Where(ps ...predicate.DomainReport) {
-	m.predicates = append(m.predicates, ps...)
+// AddArticleEntityIDs adds the "article_entities" edge to the ArticleEntity entity by ids.
+func (m *ArticleMutation) AddArticleEntityIDs(ids ...int) {
+	if m.article_entities == nil {
+		m.article_entities = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.article_entities[ids[i]] = struct{}{}
+	}
 }
 
-// WhereP appends storage-level predicates to the DomainReportMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *DomainReportMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.DomainReport, len(ps))
+// ClearArticleEntities clears the "article_entities" edge to the ArticleEntity entity.
+func (m *ArticleMutation) ClearArticleEntities() {
+	m.clearedarticle_entities = true
+}
+
+// ArticleEntitiesCleared reports if the "article_entities" edge to the ArticleEntity entity was cleared.
+func (m *ArticleMutation) ArticleEntitiesCleared() bool {
+	return m.clearedarticle_entities
+}
+
+// RemoveArticleEntityIDs removes the "article_entities" edge to the ArticleEntity entity by IDs.
+func (m *ArticleMutation) RemoveArticleEntityIDs(ids ...int) {
+	if m.removedarticle_entities == nil {
+		m.removedarticle_entities = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.article_entities, ids[i])
+		m.removedarticle_entities[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedArticleEntities returns the removed IDs of the "article_entities" edge to the ArticleEntity entity.
+func (m *ArticleMutation) RemovedArticleEntitiesIDs() (ids []int) {
+	for id := range m.removedarticle_entities {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ArticleEntitiesIDs returns the "article_entities" edge IDs in the mutation.
+func (m *ArticleMutation) ArticleEntitiesIDs() (ids []int) {
+	for id := range m.article_entities {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetArticleEntities resets all changes to the "article_entities" edge.
+func (m *ArticleMutation) ResetArticleEntities() {
+	m.article_entities = nil
+	m.clearedarticle_entities = false
+	m.removedarticle_entities = nil
+}
+
+// Where appends a list predicates to the ArticleMutation builder.
+func (m *ArticleMutation) Where(ps ...predicate.Article) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ArticleMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ArticleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Article, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2784,42 +2479,45 @@ func (m *DomainReportMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *DomainReportMutation) Op() Op {
+func (m *ArticleMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *DomainReportMutation) SetOp(op Op) {
+func (m *ArticleMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (DomainReport).
-func (m *DomainReportMutation) Type() string {
+// Type returns the node type of this mutation (Article).
+func (m *ArticleMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *DomainReportMutation) Fields() []string {
-	fields := make([]string, 0, 6)
-	if m.report_run != nil {
-		fields = append(fields, domainreport.FieldRunID)
+func (m *ArticleMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.domain_report != nil {
+		fields = append(fields, article.FieldDomainReportID)
 	}
-	if m.domain_name != nil {
-		fields = append(fields, domainreport.FieldDomainName)
+	if m.title != nil {
+		fields = append(fields, article.FieldTitle)
 	}
-	if m.overview != nil {
-		fields = append(fields, domainreport.FieldOverview)
+	if m.link != nil {
+		fields = append(fields, article.FieldLink)
 	}
-	if m.trends != nil {
-		fields = append(fields, domainreport.FieldTrends)
+	if m.source != nil {
+		fields = append(fields, article.FieldSource)
 	}
-	if m.score != nil {
-		fields = append(fields, domainreport.FieldScore)
+	if m.pub_date != nil {
+		fields = append(fields, article.FieldPubDate)
 	}
-	if m.created_at != nil {
-		fields = append(fields, domainreport.FieldCreatedAt)
+	if m.content != nil {
+		fields = append(fields, article.FieldContent)
+	}
+	if m.ref_index != nil {
+		fields = append(fields, article.FieldRefIndex)
 	}
 	return fields
 }
@@ -2827,20 +2525,22 @@ func (m *DomainReportMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *DomainReportMutation) Field(name string) (ent.Value, bool) {
+func (m *ArticleMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case domainreport.FieldRunID:
-		return m.RunID()
-	case domainreport.FieldDomainName:
-		return m.DomainName()
-	case domainreport.FieldOverview:
-		return m.Overview()
-	case domainreport.FieldTrends:
-		return m.Trends()
-	case domainreport.FieldScore:
-		return m.Score()
-	case domainreport.FieldCreatedAt:
-		return m.CreatedAt()
+	case article.FieldDomainReportID:
+		return m.DomainReportID()
+	case article.FieldTitle:
+		return m.Title()
+	case article.FieldLink:
+		return m.Link()
+	case article.FieldSource:
+		return m.Source()
+	case article.FieldPubDate:
+		return m.PubDate()
+	case article.FieldContent:
+		return m.Content()
+	case article.FieldRefIndex:
+		return m.RefIndex()
 	}
 	return nil, false
 }
@@ -2848,81 +2548,90 @@ func (m *DomainReportMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *DomainReportMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ArticleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case domainreport.FieldRunID:
-		return m.OldRunID(ctx)
-	case domainreport.FieldDomainName:
-		return m.OldDomainName(ctx)
-	case domainreport.FieldOverview:
-		return m.OldOverview(ctx)
-	case domainreport.FieldTrends:
-		return m.OldTrends(ctx)
-	case domainreport.FieldScore:
-		return m.OldScore(ctx)
-	case domainreport.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
+	case article.FieldDomainReportID:
+		return m.OldDomainReportID(ctx)
+	case article.FieldTitle:
+		return m.OldTitle(ctx)
+	case article.FieldLink:
+		return m.OldLink(ctx)
+	case article.FieldSource:
+		return m.OldSource(ctx)
+	case article.FieldPubDate:
+		return m.OldPubDate(ctx)
+	case article.FieldContent:
+		return m.OldContent(ctx)
+	case article.FieldRefIndex:
+		return m.OldRefIndex(ctx)
 	}
-	return nil, fmt.Errorf("unknown DomainReport field %s", name)
+	return nil, fmt.Errorf("unknown Article field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DomainReportMutation) SetField(name string, value ent.Value) error {
+func (m *ArticleMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case domainreport.FieldRunID:
+	case article.FieldDomainReportID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRunID(v)
+		m.SetDomainReportID(v)
 		return nil
-	case domainreport.FieldDomainName:
+	case article.FieldTitle:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDomainName(v)
+		m.SetTitle(v)
 		return nil
-	case domainreport.FieldOverview:
+	case article.FieldLink:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetOverview(v)
+		m.SetLink(v)
 		return nil
-	case domainreport.FieldTrends:
+	case article.FieldSource:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTrends(v)
+		m.SetSource(v)
 		return nil
-	case domainreport.FieldScore:
-		v, ok := value.(int)
+	case article.FieldPubDate:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetScore(v)
+		m.SetPubDate(v)
 		return nil
-	case domainreport.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case article.FieldContent:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetContent(v)
+		return nil
+	case article.FieldRefIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRefIndex(v)
 		return nil
 	}
-	return fmt.Errorf("unknown DomainReport field %s", name)
+	return fmt.Errorf("unknown Article field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *DomainReportMutation) AddedFields() []string {
+func (m *ArticleMutation) AddedFields() []string {
 	var fields []string
-	if m.addscore != nil {
-		fields = append(fields, domainreport.FieldScore)
+	if m.addref_index != nil {
+		fields = append(fields, article.FieldRefIndex)
 	}
 	return fields
 }
@@ -2930,10 +2639,10 @@ func (m *DomainReportMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *DomainReportMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ArticleMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case domainreport.FieldScore:
-		return m.AddedScore()
+	case article.FieldRefIndex:
+		return m.AddedRefIndex()
 	}
 	return nil, false
 }
@@ -2941,123 +2650,144 @@ func (m *DomainReportMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DomainReportMutation) AddField(name string, value ent.Value) error {
+func (m *ArticleMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case domainreport.FieldScore:
+	case article.FieldRefIndex:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddScore(v)
+		m.AddRefIndex(v)
 		return nil
 	}
-	return fmt.Errorf("unknown DomainReport numeric field %s", name)
+	return fmt.Errorf("unknown Article numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *DomainReportMutation) ClearedFields() []string {
+func (m *ArticleMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(domainreport.FieldRunID) {
-		fields = append(fields, domainreport.FieldRunID)
+	if m.FieldCleared(article.FieldDomainReportID) {
+		fields = append(fields, article.FieldDomainReportID)
 	}
-	if m.FieldCleared(domainreport.FieldOverview) {
-		fields = append(fields, domainreport.FieldOverview)
+	if m.FieldCleared(article.FieldTitle) {
+		fields = append(fields, article.FieldTitle)
 	}
-	if m.FieldCleared(domainreport.FieldTrends) {
-		fields = append(fields, domainreport.FieldTrends)
+	if m.FieldCleared(article.FieldLink) {
+		fields = append(fields, article.FieldLink)
 	}
-	if m.FieldCleared(domainreport.FieldScore) {
-		fields = append(fields, domainreport.FieldScore)
+	if m.FieldCleared(article.FieldSource) {
+		fields = append(fields, article.FieldSource)
+	}
+	if m.FieldCleared(article.FieldPubDate) {
+		fields = append(fields, article.FieldPubDate)
+	}
+	if m.FieldCleared(article.FieldContent) {
+		fields = append(fields, article.FieldContent)
+	}
+	if m.FieldCleared(article.FieldRefIndex) {
+		fields = append(fields, article.FieldRefIndex)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *DomainReportMutation) FieldCleared(name string) bool {
+func (m *ArticleMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *DomainReportMutation) ClearField(name string) error {
+func (m *ArticleMutation) ClearField(name string) error {
 	switch name {
-	case domainreport.FieldRunID:
-		m.ClearRunID()
+	case article.FieldDomainReportID:
+		m.ClearDomainReportID()
 		return nil
-	case domainreport.FieldOverview:
-		m.ClearOverview()
+	case article.FieldTitle:
+		m.ClearTitle()
 		return nil
-	case domainreport.FieldTrends:
-		m.ClearTrends()
+	case article.FieldLink:
+		m.ClearLink()
 		return nil
-	case domainreport.FieldScore:
-		m.ClearScore()
+	case article.FieldSource:
+		m.ClearSource()
+		return nil
+	case article.FieldPubDate:
+		m.ClearPubDate()
+		return nil
+	case article.FieldContent:
+		m.ClearContent()
+		return nil
+	case article.FieldRefIndex:
+		m.ClearRefIndex()
 		return nil
 	}
-	return fmt.Errorf("unknown DomainReport nullable field %s", name)
+	return fmt.Errorf("unknown Article nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *DomainReportMutation) ResetField(name string) error {
+func (m *ArticleMutation) ResetField(name string) error {
 	switch name {
-	case domainreport.FieldRunID:
-		m.ResetRunID()
+	case article.FieldDomainReportID:
+		m.ResetDomainReportID()
 		return nil
-	case domainreport.FieldDomainName:
-		m.ResetDomainName()
+	case article.FieldTitle:
+		m.ResetTitle()
 		return nil
-	case domainreport.FieldOverview:
-		m.ResetOverview()
+	case article.FieldLink:
+		m.ResetLink()
 		return nil
-	case domainreport.FieldTrends:
-		m.ResetTrends()
+	case article.FieldSource:
+		m.ResetSource()
 		return nil
-	case domainreport.FieldScore:
-		m.ResetScore()
+	case article.FieldPubDate:
+		m.ResetPubDate()
 		return nil
-	case domainreport.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case article.FieldContent:
+		m.ResetContent()
+		return nil
+	case article.FieldRefIndex:
+		m.ResetRefIndex()
 		return nil
 	}
-	return fmt.Errorf("unknown DomainReport field %s", name)
+	return fmt.Errorf("unknown Article field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *DomainReportMutation) AddedEdges() []string {
+func (m *ArticleMutation) AddedEdges() []string {
 	edges := make([]string, 0, 3)
-	if m.report_run != nil {
-		edges = append(edges, domainreport.EdgeReportRun)
-	}
-	if m.articles != nil {
-		edges = append(edges, domainreport.EdgeArticles)
+	if m.domain_report != nil {
+		edges = append(edges, article.EdgeDomainReport)
 	}
 	if m.key_events != nil {
-		edges = append(edges, domainreport.EdgeKeyEvents)
+		edges = append(edges, article.EdgeKeyEvents)
+	}
+	if m.article_entities != nil {
+		edges = append(edges, article.EdgeArticleEntities)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *DomainReportMutation) AddedIDs(name string) []ent.Value {
+func (m *ArticleMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case domainreport.EdgeReportRun:
-		if id := m.report_run; id != nil {
+	case article.EdgeDomainReport:
+		if id := m.domain_report; id != nil {
 			return []ent.Value{*id}
 		}
-	case domainreport.EdgeArticles:
-		ids := make([]ent.Value, 0, len(m.articles))
-		for id := range m.articles {
+	case article.EdgeKeyEvents:
+		ids := make([]ent.Value, 0, len(m.key_events))
+		for id := range m.key_events {
 			ids = append(ids, id)
 		}
 		return ids
-	case domainreport.EdgeKeyEvents:
-		ids := make([]ent.Value, 0, len(m.key_events))
-		for id := range m.key_events {
+	case article.EdgeArticleEntities:
+		ids := make([]ent.Value, 0, len(m.article_entities))
+		for id := range m.article_entities {
 			ids = append(ids, id)
 		}
 		return ids
@@ -3066,30 +2796,30 @@ func (m *DomainReportMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *DomainReportMutation) RemovedEdges() []string {
+func (m *ArticleMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 3)
-	if m.removedarticles != nil {
-		edges = append(edges, domainreport.EdgeArticles)
-	}
 	if m.removedkey_events != nil {
-		edges = append(edges, domainreport.EdgeKeyEvents)
+		edges = append(edges, article.EdgeKeyEvents)
+	}
+	if m.removedarticle_entities != nil {
+		edges = append(edges, article.EdgeArticleEntities)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *DomainReportMutation) RemovedIDs(name string) []ent.Value {
+func (m *ArticleMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case domainreport.EdgeArticles:
-		ids := make([]ent.Value, 0, len(m.removedarticles))
-		for id := range m.removedarticles {
+	case article.EdgeKeyEvents:
+		ids := make([]ent.Value, 0, len(m.removedkey_events))
+		for id := range m.removedkey_events {
 			ids = append(ids, id)
 		}
 		return ids
-	case domainreport.EdgeKeyEvents:
-		ids := make([]ent.Value, 0, len(m.removedkey_events))
-		for id := range m.removedkey_events {
+	case article.EdgeArticleEntities:
+		ids := make([]ent.Value, 0, len(m.removedarticle_entities))
+		for id := range m.removedarticle_entities {
 			ids = append(ids, id)
 		}
 		return ids
@@ -3098,88 +2828,90 @@ func (m *DomainReportMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *DomainReportMutation) ClearedEdges() []string {
+func (m *ArticleMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 3)
-	if m.clearedreport_run {
-		edges = append(edges, domainreport.EdgeReportRun)
-	}
-	if m.clearedarticles {
-		edges = append(edges, domainreport.EdgeArticles)
+	if m.cleareddomain_report {
+		edges = append(edges, article.EdgeDomainReport)
 	}
 	if m.clearedkey_events {
-		edges = append(edges, domainreport.EdgeKeyEvents)
+		edges = append(edges, article.EdgeKeyEvents)
+	}
+	if m.clearedarticle_entities {
+		edges = append(edges, article.EdgeArticleEntities)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *DomainReportMutation) EdgeCleared(name string) bool {
+func (m *ArticleMutation) EdgeCleared(name string) bool {
 	switch name {
-	case domainreport.EdgeReportRun:
-		return m.clearedreport_run
-	case domainreport.EdgeArticles:
-		return m.clearedarticles
-	case domainreport.EdgeKeyEvents:
+	case article.EdgeDomainReport:
+		return m.cleareddomain_report
+	case article.EdgeKeyEvents:
 		return m.clearedkey_events
+	case article.EdgeArticleEntities:
+		return m.clearedarticle_entities
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *DomainReportMutation) ClearEdge(name string) error {
+func (m *ArticleMutation) ClearEdge(name string) error {
 	switch name {
-	case domainreport.EdgeReportRun:
-		m.ClearReportRun()
+	case article.EdgeDomainReport:
+		m.ClearDomainReport()
 		return nil
 	}
-	return fmt.Errorf("unknown DomainReport unique edge %s", name)
+	return fmt.Errorf("unknown Article unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *DomainReportMutation) ResetEdge(name string) error {
+func (m *ArticleMutation) ResetEdge(name string) error {
 	switch name {
-	case domainreport.EdgeReportRun:
-		m.ResetReportRun()
-		return nil
-	case domainreport.EdgeArticles:
-		m.ResetArticles()
+	case article.EdgeDomainReport:
+		m.ResetDomainReport()
 		return nil
-	case domainreport.EdgeKeyEvents:
+	case article.EdgeKeyEvents:
 		m.ResetKeyEvents()
 		return nil
+	case article.EdgeArticleEntities:
+		m.ResetArticleEntities()
+		return nil
 	}
-	return fmt.Errorf("unknown DomainReport edge %s", name)
+	return fmt.Errorf("unknown Article edge %s", name)
 }
 
-// KeyEventMutation represents an operation that mutates the KeyEvent nodes in the graph.
-type KeyEventMutation struct {
+// ArticleEntityMutation represents an operation that mutates the ArticleEntity nodes in the graph.
+type ArticleEntityMutation struct {
 	config
-	op                   Op
-	typ                  string
-	id                   *int
-	event_content        *string
-	clearedFields        map[string]struct{}
-	domain_report        *int
-	cleareddomain_report bool
-	done                 bool
-	oldValue             func(context.Context) (*KeyEvent, error)
-	predicates           []predicate.KeyEvent
-}
-
-var _ ent.Mutation = (*KeyEventMutation)(nil)
-
-// keyeventOption allows management of the mutation configuration using functional options.
-type keyeventOption func(*KeyEventMutation)
-
-// newKeyEventMutation creates new mutation for the KeyEvent entity.
-func newKeyEventMutation(c config, op Op, opts ...keyeventOption) *KeyEventMutation {
-	m := &KeyEventMutation{
+	op             Op
+	typ            string
+	id             *int
+	mention        *string
+	clearedFields  map[string]struct{}
+	article        *int
+	clearedarticle bool
+	entity         *int
+	clearedentity  bool
+	done           bool
+	oldValue       func(context.Context) (*ArticleEntity, error)
+	predicates     []predicate.ArticleEntity
+}
+
+var _ ent.Mutation = (*ArticleEntityMutation)(nil)
+
+// articleentityOption allows management of the mutation configuration using functional options.
+type articleentityOption func(*ArticleEntityMutation)
+
+// newArticleEntityMutation creates new mutation for the ArticleEntity entity.
+func newArticleEntityMutation(c config, op Op, opts ...articleentityOption) *ArticleEntityMutation {
+	m := &ArticleEntityMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeKeyEvent,
+		typ:           TypeArticleEntity,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3188,20 +2920,20 @@ func newKeyEventMutation(c config, op Op, opts ...keyeventOption) *KeyEventMutat
 	return m
 }
 
-// withKeyEventID sets the ID field of the mutation.
-func withKeyEventID(id int) keyeventOption {
-	return func(m *KeyEventMutation) {
+// withArticleEntityID sets the ID field of the mutation.
+func withArticleEntityID(id int) articleentityOption {
+	return func(m *ArticleEntityMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *KeyEvent
+			value *ArticleEntity
 		)
-		m.oldValue = func(ctx context.Context) (*KeyEvent, error) {
+		m.oldValue = func(ctx context.Context) (*ArticleEntity, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().KeyEvent.Get(ctx, id)
+					value, err = m.Client().ArticleEntity.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3210,10 +2942,10 @@ func withKeyEventID(id int) keyeventOption {
 	}
 }
 
-// withKeyEvent sets the old KeyEvent of the mutation.
-func withKeyEvent(node *KeyEvent) keyeventOption {
-	return func(m *KeyEventMutation) {
-		m.oldValue = func(context.Context) (*KeyEvent, error) {
+// withArticleEntity sets the old ArticleEntity of the mutation.
+func withArticleEntity(node *ArticleEntity) articleentityOption {
+	return func(m *ArticleEntityMutation) {
+		m.oldValue = func(context.Context) (*ArticleEntity, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3222,7 +2954,7 @@ func withKeyEvent(node *KeyEvent) keyeventOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m KeyEventMutation) Client() *Client {
+func (m ArticleEntityMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3230,7 +2962,7 @@ func (m KeyEventMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m KeyEventMutation) Tx() (*Tx, error) {
+func (m ArticleEntityMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -3240,14 +2972,14 @@ func (m KeyEventMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of KeyEvent entities.
-func (m *KeyEventMutation) SetID(id int) {
+// operation is only accepted on creation of ArticleEntity entities.
+func (m *ArticleEntityMutation) SetID(id int) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *KeyEventMutation) ID() (id int, exists bool) {
+func (m *ArticleEntityMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3258,7 +2990,7 @@ func (m *KeyEventMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *KeyEventMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *ArticleEntityMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -3267,146 +2999,10841 @@ func (m *KeyEventMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().KeyEvent.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ArticleEntity.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetDomainReportID sets the "domain_report_id" field.
-func (m *KeyEventMutation) SetDomainReportID(i int) {
-	m.domain_report = &i
+// SetArticleID sets the "article_id" field.
+func (m *ArticleEntityMutation) SetArticleID(i int) {
+	m.article = &i
 }
 
-// DomainReportID returns the value of the "domain_report_id" field in the mutation.
-func (m *KeyEventMutation) DomainReportID() (r int, exists bool) {
-	v := m.domain_report
+// ArticleID returns the value of the "article_id" field in the mutation.
+func (m *ArticleEntityMutation) ArticleID() (r int, exists bool) {
+	v := m.article
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDomainReportID returns the old "domain_report_id" field's value of the KeyEvent entity.
-// If the KeyEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldArticleID returns the old "article_id" field's value of the ArticleEntity entity.
+// If the ArticleEntity object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *KeyEventMutation) OldDomainReportID(ctx context.Context) (v int, err error) {
+func (m *ArticleEntityMutation) OldArticleID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDomainReportID is only allowed on UpdateOne operations")
+		return v, errors.New("OldArticleID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDomainReportID requires an ID field in the mutation")
+		return v, errors.New("OldArticleID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDomainReportID: %w", err)
+		return v, fmt.Errorf("querying old value for OldArticleID: %w", err)
 	}
-	return oldValue.DomainReportID, nil
+	return oldValue.ArticleID, nil
 }
 
-// ClearDomainReportID clears the value of the "domain_report_id" field.
-func (m *KeyEventMutation) ClearDomainReportID() {
-	m.domain_report = nil
-	m.clearedFields[keyevent.FieldDomainReportID] = struct{}{}
+// ResetArticleID resets all changes to the "article_id" field.
+func (m *ArticleEntityMutation) ResetArticleID() {
+	m.article = nil
 }
 
-// DomainReportIDCleared returns if the "domain_report_id" field was cleared in this mutation.
-func (m *KeyEventMutation) DomainReportIDCleared() bool {
-	_, ok := m.clearedFields[keyevent.FieldDomainReportID]
+// SetEntityID sets the "entity_id" field.
+func (m *ArticleEntityMutation) SetEntityID(i int) {
+	m.entity = &i
+}
+
+// EntityID returns the value of the "entity_id" field in the mutation.
+func (m *ArticleEntityMutation) EntityID() (r int, exists bool) {
+	v := m.entity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEntityID returns the old "entity_id" field's value of the ArticleEntity entity.
+// If the ArticleEntity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ArticleEntityMutation) OldEntityID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEntityID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEntityID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEntityID: %w", err)
+	}
+	return oldValue.EntityID, nil
+}
+
+// ResetEntityID resets all changes to the "entity_id" field.
+func (m *ArticleEntityMutation) ResetEntityID() {
+	m.entity = nil
+}
+
+// SetMention sets the "mention" field.
+func (m *ArticleEntityMutation) SetMention(s string) {
+	m.mention = &s
+}
+
+// Mention returns the value of the "mention" field in the mutation.
+func (m *ArticleEntityMutation) Mention() (r string, exists bool) {
+	v := m.mention
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMention returns the old "mention" field's value of the ArticleEntity entity.
+// If the ArticleEntity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ArticleEntityMutation) OldMention(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMention is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMention requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMention: %w", err)
+	}
+	return oldValue.Mention, nil
+}
+
+// ClearMention clears the value of the "mention" field.
+func (m *ArticleEntityMutation) ClearMention() {
+	m.mention = nil
+	m.clearedFields[articleentity.FieldMention] = struct{}{}
+}
+
+// MentionCleared returns if the "mention" field was cleared in this mutation.
+func (m *ArticleEntityMutation) MentionCleared() bool {
+	_, ok := m.clearedFields[articleentity.FieldMention]
 	return ok
 }
 
-// ResetDomainReportID resets all changes to the "domain_report_id" field.
-func (m *KeyEventMutation) ResetDomainReportID() {
-	m.domain_report = nil
-	delete(m.clearedFields, keyevent.FieldDomainReportID)
+// ResetMention resets all changes to the "mention" field.
+func (m *ArticleEntityMutation) ResetMention() {
+	m.mention = nil
+	delete(m.clearedFields, articleentity.FieldMention)
+}
+
+// ClearArticle clears the "article" edge to the Article entity.
+func (m *ArticleEntityMutation) ClearArticle() {
+	m.clearedarticle = true
+	m.clearedFields[articleentity.FieldArticleID] = struct{}{}
+}
+
+// ArticleCleared reports if the "article" edge to the Article entity was cleared.
+func (m *ArticleEntityMutation) ArticleCleared() bool {
+	return m.clearedarticle
+}
+
+// ArticleIDs returns the "article" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ArticleID instead. It exists only for internal usage by the builders.
+func (m *ArticleEntityMutation) ArticleIDs() (ids []int) {
+	if id := m.article; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetArticle resets all changes to the "article" edge.
+func (m *ArticleEntityMutation) ResetArticle() {
+	m.article = nil
+	m.clearedarticle = false
+}
+
+// ClearEntity clears the "entity" edge to the Entity entity.
+func (m *ArticleEntityMutation) ClearEntity() {
+	m.clearedentity = true
+	m.clearedFields[articleentity.FieldEntityID] = struct{}{}
+}
+
+// EntityCleared reports if the "entity" edge to the Entity entity was cleared.
+func (m *ArticleEntityMutation) EntityCleared() bool {
+	return m.clearedentity
+}
+
+// EntityIDs returns the "entity" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// EntityID instead. It exists only for internal usage by the builders.
+func (m *ArticleEntityMutation) EntityIDs() (ids []int) {
+	if id := m.entity; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetEntity resets all changes to the "entity" edge.
+func (m *ArticleEntityMutation) ResetEntity() {
+	m.entity = nil
+	m.clearedentity = false
+}
+
+// Where appends a list predicates to the ArticleEntityMutation builder.
+func (m *ArticleEntityMutation) Where(ps ...predicate.ArticleEntity) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ArticleEntityMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ArticleEntityMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ArticleEntity, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ArticleEntityMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ArticleEntityMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ArticleEntity).
+func (m *ArticleEntityMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ArticleEntityMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.article != nil {
+		fields = append(fields, articleentity.FieldArticleID)
+	}
+	if m.entity != nil {
+		fields = append(fields, articleentity.FieldEntityID)
+	}
+	if m.mention != nil {
+		fields = append(fields, articleentity.FieldMention)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ArticleEntityMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case articleentity.FieldArticleID:
+		return m.ArticleID()
+	case articleentity.FieldEntityID:
+		return m.EntityID()
+	case articleentity.FieldMention:
+		return m.Mention()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ArticleEntityMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case articleentity.FieldArticleID:
+		return m.OldArticleID(ctx)
+	case articleentity.FieldEntityID:
+		return m.OldEntityID(ctx)
+	case articleentity.FieldMention:
+		return m.OldMention(ctx)
+	}
+	return nil, fmt.Errorf("unknown ArticleEntity field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ArticleEntityMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case articleentity.FieldArticleID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetArticleID(v)
+		return nil
+	case articleentity.FieldEntityID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEntityID(v)
+		return nil
+	case articleentity.FieldMention:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMention(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ArticleEntity field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ArticleEntityMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ArticleEntityMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ArticleEntityMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ArticleEntity numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ArticleEntityMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(articleentity.FieldMention) {
+		fields = append(fields, articleentity.FieldMention)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ArticleEntityMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ArticleEntityMutation) ClearField(name string) error {
+	switch name {
+	case articleentity.FieldMention:
+		m.ClearMention()
+		return nil
+	}
+	return fmt.Errorf("unknown ArticleEntity nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ArticleEntityMutation) ResetField(name string) error {
+	switch name {
+	case articleentity.FieldArticleID:
+		m.ResetArticleID()
+		return nil
+	case articleentity.FieldEntityID:
+		m.ResetEntityID()
+		return nil
+	case articleentity.FieldMention:
+		m.ResetMention()
+		return nil
+	}
+	return fmt.Errorf("unknown ArticleEntity field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ArticleEntityMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.article != nil {
+		edges = append(edges, articleentity.EdgeArticle)
+	}
+	if m.entity != nil {
+		edges = append(edges, articleentity.EdgeEntity)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ArticleEntityMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case articleentity.EdgeArticle:
+		if id := m.article; id != nil {
+			return []ent.Value{*id}
+		}
+	case articleentity.EdgeEntity:
+		if id := m.entity; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ArticleEntityMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ArticleEntityMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ArticleEntityMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedarticle {
+		edges = append(edges, articleentity.EdgeArticle)
+	}
+	if m.clearedentity {
+		edges = append(edges, articleentity.EdgeEntity)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ArticleEntityMutation) EdgeCleared(name string) bool {
+	switch name {
+	case articleentity.EdgeArticle:
+		return m.clearedarticle
+	case articleentity.EdgeEntity:
+		return m.clearedentity
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ArticleEntityMutation) ClearEdge(name string) error {
+	switch name {
+	case articleentity.EdgeArticle:
+		m.ClearArticle()
+		return nil
+	case articleentity.EdgeEntity:
+		m.ClearEntity()
+		return nil
+	}
+	return fmt.Errorf("unknown ArticleEntity unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ArticleEntityMutation) ResetEdge(name string) error {
+	switch name {
+	case articleentity.EdgeArticle:
+		m.ResetArticle()
+		return nil
+	case articleentity.EdgeEntity:
+		m.ResetEntity()
+		return nil
+	}
+	return fmt.Errorf("unknown ArticleEntity edge %s", name)
+}
+
+// ClaimVerificationMutation represents an operation that mutates the ClaimVerification nodes in the graph.
+type ClaimVerificationMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *int
+	claim_type           *string
+	claim                *string
+	verdict              *string
+	reason               *string
+	dropped              *bool
+	created_at           *time.Time
+	clearedFields        map[string]struct{}
+	domain_report        *int
+	cleareddomain_report bool
+	done                 bool
+	oldValue             func(context.Context) (*ClaimVerification, error)
+	predicates           []predicate.ClaimVerification
+}
+
+var _ ent.Mutation = (*ClaimVerificationMutation)(nil)
+
+// claimverificationOption allows management of the mutation configuration using functional options.
+type claimverificationOption func(*ClaimVerificationMutation)
+
+// newClaimVerificationMutation creates new mutation for the ClaimVerification entity.
+func newClaimVerificationMutation(c config, op Op, opts ...claimverificationOption) *ClaimVerificationMutation {
+	m := &ClaimVerificationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeClaimVerification,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withClaimVerificationID sets the ID field of the mutation.
+func withClaimVerificationID(id int) claimverificationOption {
+	return func(m *ClaimVerificationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ClaimVerification
+		)
+		m.oldValue = func(ctx context.Context) (*ClaimVerification, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ClaimVerification.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withClaimVerification sets the old ClaimVerification of the mutation.
+func withClaimVerification(node *ClaimVerification) claimverificationOption {
+	return func(m *ClaimVerificationMutation) {
+		m.oldValue = func(context.Context) (*ClaimVerification, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ClaimVerificationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ClaimVerificationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ClaimVerification entities.
+func (m *ClaimVerificationMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ClaimVerificationMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ClaimVerificationMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ClaimVerification.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetDomainReportID sets the "domain_report_id" field.
+func (m *ClaimVerificationMutation) SetDomainReportID(i int) {
+	m.domain_report = &i
+}
+
+// DomainReportID returns the value of the "domain_report_id" field in the mutation.
+func (m *ClaimVerificationMutation) DomainReportID() (r int, exists bool) {
+	v := m.domain_report
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDomainReportID returns the old "domain_report_id" field's value of the ClaimVerification entity.
+// If the ClaimVerification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ClaimVerificationMutation) OldDomainReportID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDomainReportID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDomainReportID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDomainReportID: %w", err)
+	}
+	return oldValue.DomainReportID, nil
+}
+
+// ClearDomainReportID clears the value of the "domain_report_id" field.
+func (m *ClaimVerificationMutation) ClearDomainReportID() {
+	m.domain_report = nil
+	m.clearedFields[claimverification.FieldDomainReportID] = struct{}{}
+}
+
+// DomainReportIDCleared returns if the "domain_report_id" field was cleared in this mutation.
+func (m *ClaimVerificationMutation) DomainReportIDCleared() bool {
+	_, ok := m.clearedFields[claimverification.FieldDomainReportID]
+	return ok
+}
+
+// ResetDomainReportID resets all changes to the "domain_report_id" field.
+func (m *ClaimVerificationMutation) ResetDomainReportID() {
+	m.domain_report = nil
+	delete(m.clearedFields, claimverification.FieldDomainReportID)
+}
+
+// SetClaimType sets the "claim_type" field.
+func (m *ClaimVerificationMutation) SetClaimType(s string) {
+	m.claim_type = &s
+}
+
+// ClaimType returns the value of the "claim_type" field in the mutation.
+func (m *ClaimVerificationMutation) ClaimType() (r string, exists bool) {
+	v := m.claim_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClaimType returns the old "claim_type" field's value of the ClaimVerification entity.
+// If the ClaimVerification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ClaimVerificationMutation) OldClaimType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClaimType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClaimType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClaimType: %w", err)
+	}
+	return oldValue.ClaimType, nil
+}
+
+// ResetClaimType resets all changes to the "claim_type" field.
+func (m *ClaimVerificationMutation) ResetClaimType() {
+	m.claim_type = nil
+}
+
+// SetClaim sets the "claim" field.
+func (m *ClaimVerificationMutation) SetClaim(s string) {
+	m.claim = &s
+}
+
+// Claim returns the value of the "claim" field in the mutation.
+func (m *ClaimVerificationMutation) Claim() (r string, exists bool) {
+	v := m.claim
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClaim returns the old "claim" field's value of the ClaimVerification entity.
+// If the ClaimVerification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ClaimVerificationMutation) OldClaim(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClaim is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClaim requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClaim: %w", err)
+	}
+	return oldValue.Claim, nil
+}
+
+// ClearClaim clears the value of the "claim" field.
+func (m *ClaimVerificationMutation) ClearClaim() {
+	m.claim = nil
+	m.clearedFields[claimverification.FieldClaim] = struct{}{}
+}
+
+// ClaimCleared returns if the "claim" field was cleared in this mutation.
+func (m *ClaimVerificationMutation) ClaimCleared() bool {
+	_, ok := m.clearedFields[claimverification.FieldClaim]
+	return ok
+}
+
+// ResetClaim resets all changes to the "claim" field.
+func (m *ClaimVerificationMutation) ResetClaim() {
+	m.claim = nil
+	delete(m.clearedFields, claimverification.FieldClaim)
+}
+
+// SetVerdict sets the "verdict" field.
+func (m *ClaimVerificationMutation) SetVerdict(s string) {
+	m.verdict = &s
+}
+
+// Verdict returns the value of the "verdict" field in the mutation.
+func (m *ClaimVerificationMutation) Verdict() (r string, exists bool) {
+	v := m.verdict
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVerdict returns the old "verdict" field's value of the ClaimVerification entity.
+// If the ClaimVerification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ClaimVerificationMutation) OldVerdict(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVerdict is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVerdict requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVerdict: %w", err)
+	}
+	return oldValue.Verdict, nil
+}
+
+// ResetVerdict resets all changes to the "verdict" field.
+func (m *ClaimVerificationMutation) ResetVerdict() {
+	m.verdict = nil
+}
+
+// SetReason sets the "reason" field.
+func (m *ClaimVerificationMutation) SetReason(s string) {
+	m.reason = &s
+}
+
+// Reason returns the value of the "reason" field in the mutation.
+func (m *ClaimVerificationMutation) Reason() (r string, exists bool) {
+	v := m.reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReason returns the old "reason" field's value of the ClaimVerification entity.
+// If the ClaimVerification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ClaimVerificationMutation) OldReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
+	}
+	return oldValue.Reason, nil
+}
+
+// ClearReason clears the value of the "reason" field.
+func (m *ClaimVerificationMutation) ClearReason() {
+	m.reason = nil
+	m.clearedFields[claimverification.FieldReason] = struct{}{}
+}
+
+// ReasonCleared returns if the "reason" field was cleared in this mutation.
+func (m *ClaimVerificationMutation) ReasonCleared() bool {
+	_, ok := m.clearedFields[claimverification.FieldReason]
+	return ok
+}
+
+// ResetReason resets all changes to the "reason" field.
+func (m *ClaimVerificationMutation) ResetReason() {
+	m.reason = nil
+	delete(m.clearedFields, claimverification.FieldReason)
+}
+
+// SetDropped sets the "dropped" field.
+func (m *ClaimVerificationMutation) SetDropped(b bool) {
+	m.dropped = &b
+}
+
+// Dropped returns the value of the "dropped" field in the mutation.
+func (m *ClaimVerificationMutation) Dropped() (r bool, exists bool) {
+	v := m.dropped
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDropped returns the old "dropped" field's value of the ClaimVerification entity.
+// If the ClaimVerification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ClaimVerificationMutation) OldDropped(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDropped is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDropped requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDropped: %w", err)
+	}
+	return oldValue.Dropped, nil
+}
+
+// ResetDropped resets all changes to the "dropped" field.
+func (m *ClaimVerificationMutation) ResetDropped() {
+	m.dropped = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ClaimVerificationMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ClaimVerificationMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ClaimVerification entity.
+// If the ClaimVerification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ClaimVerificationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ClaimVerificationMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearDomainReport clears the "domain_report" edge to the DomainReport entity.
+func (m *ClaimVerificationMutation) ClearDomainReport() {
+	m.cleareddomain_report = true
+	m.clearedFields[claimverification.FieldDomainReportID] = struct{}{}
+}
+
+// DomainReportCleared reports if the "domain_report" edge to the DomainReport entity was cleared.
+func (m *ClaimVerificationMutation) DomainReportCleared() bool {
+	return m.DomainReportIDCleared() || m.cleareddomain_report
+}
+
+// DomainReportIDs returns the "domain_report" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// DomainReportID instead. It exists only for internal usage by the builders.
+func (m *ClaimVerificationMutation) DomainReportIDs() (ids []int) {
+	if id := m.domain_report; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetDomainReport resets all changes to the "domain_report" edge.
+func (m *ClaimVerificationMutation) ResetDomainReport() {
+	m.domain_report = nil
+	m.cleareddomain_report = false
+}
+
+// Where appends a list predicates to the ClaimVerificationMutation builder.
+func (m *ClaimVerificationMutation) Where(ps ...predicate.ClaimVerification) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ClaimVerificationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ClaimVerificationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ClaimVerification, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ClaimVerificationMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ClaimVerificationMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ClaimVerification).
+func (m *ClaimVerificationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ClaimVerificationMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.domain_report != nil {
+		fields = append(fields, claimverification.FieldDomainReportID)
+	}
+	if m.claim_type != nil {
+		fields = append(fields, claimverification.FieldClaimType)
+	}
+	if m.claim != nil {
+		fields = append(fields, claimverification.FieldClaim)
+	}
+	if m.verdict != nil {
+		fields = append(fields, claimverification.FieldVerdict)
+	}
+	if m.reason != nil {
+		fields = append(fields, claimverification.FieldReason)
+	}
+	if m.dropped != nil {
+		fields = append(fields, claimverification.FieldDropped)
+	}
+	if m.created_at != nil {
+		fields = append(fields, claimverification.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ClaimVerificationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case claimverification.FieldDomainReportID:
+		return m.DomainReportID()
+	case claimverification.FieldClaimType:
+		return m.ClaimType()
+	case claimverification.FieldClaim:
+		return m.Claim()
+	case claimverification.FieldVerdict:
+		return m.Verdict()
+	case claimverification.FieldReason:
+		return m.Reason()
+	case claimverification.FieldDropped:
+		return m.Dropped()
+	case claimverification.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ClaimVerificationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case claimverification.FieldDomainReportID:
+		return m.OldDomainReportID(ctx)
+	case claimverification.FieldClaimType:
+		return m.OldClaimType(ctx)
+	case claimverification.FieldClaim:
+		return m.OldClaim(ctx)
+	case claimverification.FieldVerdict:
+		return m.OldVerdict(ctx)
+	case claimverification.FieldReason:
+		return m.OldReason(ctx)
+	case claimverification.FieldDropped:
+		return m.OldDropped(ctx)
+	case claimverification.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ClaimVerification field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ClaimVerificationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case claimverification.FieldDomainReportID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDomainReportID(v)
+		return nil
+	case claimverification.FieldClaimType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClaimType(v)
+		return nil
+	case claimverification.FieldClaim:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClaim(v)
+		return nil
+	case claimverification.FieldVerdict:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVerdict(v)
+		return nil
+	case claimverification.FieldReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReason(v)
+		return nil
+	case claimverification.FieldDropped:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDropped(v)
+		return nil
+	case claimverification.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ClaimVerification field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ClaimVerificationMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ClaimVerificationMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ClaimVerificationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ClaimVerification numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ClaimVerificationMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(claimverification.FieldDomainReportID) {
+		fields = append(fields, claimverification.FieldDomainReportID)
+	}
+	if m.FieldCleared(claimverification.FieldClaim) {
+		fields = append(fields, claimverification.FieldClaim)
+	}
+	if m.FieldCleared(claimverification.FieldReason) {
+		fields = append(fields, claimverification.FieldReason)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ClaimVerificationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ClaimVerificationMutation) ClearField(name string) error {
+	switch name {
+	case claimverification.FieldDomainReportID:
+		m.ClearDomainReportID()
+		return nil
+	case claimverification.FieldClaim:
+		m.ClearClaim()
+		return nil
+	case claimverification.FieldReason:
+		m.ClearReason()
+		return nil
+	}
+	return fmt.Errorf("unknown ClaimVerification nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ClaimVerificationMutation) ResetField(name string) error {
+	switch name {
+	case claimverification.FieldDomainReportID:
+		m.ResetDomainReportID()
+		return nil
+	case claimverification.FieldClaimType:
+		m.ResetClaimType()
+		return nil
+	case claimverification.FieldClaim:
+		m.ResetClaim()
+		return nil
+	case claimverification.FieldVerdict:
+		m.ResetVerdict()
+		return nil
+	case claimverification.FieldReason:
+		m.ResetReason()
+		return nil
+	case claimverification.FieldDropped:
+		m.ResetDropped()
+		return nil
+	case claimverification.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ClaimVerification field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ClaimVerificationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.domain_report != nil {
+		edges = append(edges, claimverification.EdgeDomainReport)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ClaimVerificationMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case claimverification.EdgeDomainReport:
+		if id := m.domain_report; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ClaimVerificationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ClaimVerificationMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ClaimVerificationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareddomain_report {
+		edges = append(edges, claimverification.EdgeDomainReport)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ClaimVerificationMutation) EdgeCleared(name string) bool {
+	switch name {
+	case claimverification.EdgeDomainReport:
+		return m.cleareddomain_report
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ClaimVerificationMutation) ClearEdge(name string) error {
+	switch name {
+	case claimverification.EdgeDomainReport:
+		m.ClearDomainReport()
+		return nil
+	}
+	return fmt.Errorf("unknown ClaimVerification unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ClaimVerificationMutation) ResetEdge(name string) error {
+	switch name {
+	case claimverification.EdgeDomainReport:
+		m.ResetDomainReport()
+		return nil
+	}
+	return fmt.Errorf("unknown ClaimVerification edge %s", name)
+}
+
+// DeepAnalysisResultMutation represents an operation that mutates the DeepAnalysisResult nodes in the graph.
+type DeepAnalysisResultMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *int
+	user_id              *int
+	adduser_id           *int
+	persona_name         *string
+	macro_trends         *string
+	opportunities        *string
+	risks                *string
+	created_at           *time.Time
+	clearedFields        map[string]struct{}
+	report_run           *int
+	clearedreport_run    bool
+	action_guides        map[int]struct{}
+	removedaction_guides map[int]struct{}
+	clearedaction_guides bool
+	sections             map[int]struct{}
+	removedsections      map[int]struct{}
+	clearedsections      bool
+	persona              *int
+	clearedpersona       bool
+	done                 bool
+	oldValue             func(context.Context) (*DeepAnalysisResult, error)
+	predicates           []predicate.DeepAnalysisResult
+}
+
+var _ ent.Mutation = (*DeepAnalysisResultMutation)(nil)
+
+// deepanalysisresultOption allows management of the mutation configuration using functional options.
+type deepanalysisresultOption func(*DeepAnalysisResultMutation)
+
+// newDeepAnalysisResultMutation creates new mutation for the DeepAnalysisResult entity.
+func newDeepAnalysisResultMutation(c config, op Op, opts ...deepanalysisresultOption) *DeepAnalysisResultMutation {
+	m := &DeepAnalysisResultMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDeepAnalysisResult,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDeepAnalysisResultID sets the ID field of the mutation.
+func withDeepAnalysisResultID(id int) deepanalysisresultOption {
+	return func(m *DeepAnalysisResultMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *DeepAnalysisResult
+		)
+		m.oldValue = func(ctx context.Context) (*DeepAnalysisResult, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().DeepAnalysisResult.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDeepAnalysisResult sets the old DeepAnalysisResult of the mutation.
+func withDeepAnalysisResult(node *DeepAnalysisResult) deepanalysisresultOption {
+	return func(m *DeepAnalysisResultMutation) {
+		m.oldValue = func(context.Context) (*DeepAnalysisResult, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DeepAnalysisResultMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DeepAnalysisResultMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of DeepAnalysisResult entities.
+func (m *DeepAnalysisResultMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DeepAnalysisResultMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DeepAnalysisResultMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().DeepAnalysisResult.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetRunID sets the "run_id" field.
+func (m *DeepAnalysisResultMutation) SetRunID(i int) {
+	m.report_run = &i
+}
+
+// RunID returns the value of the "run_id" field in the mutation.
+func (m *DeepAnalysisResultMutation) RunID() (r int, exists bool) {
+	v := m.report_run
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRunID returns the old "run_id" field's value of the DeepAnalysisResult entity.
+// If the DeepAnalysisResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeepAnalysisResultMutation) OldRunID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRunID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRunID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRunID: %w", err)
+	}
+	return oldValue.RunID, nil
+}
+
+// ClearRunID clears the value of the "run_id" field.
+func (m *DeepAnalysisResultMutation) ClearRunID() {
+	m.report_run = nil
+	m.clearedFields[deepanalysisresult.FieldRunID] = struct{}{}
+}
+
+// RunIDCleared returns if the "run_id" field was cleared in this mutation.
+func (m *DeepAnalysisResultMutation) RunIDCleared() bool {
+	_, ok := m.clearedFields[deepanalysisresult.FieldRunID]
+	return ok
+}
+
+// ResetRunID resets all changes to the "run_id" field.
+func (m *DeepAnalysisResultMutation) ResetRunID() {
+	m.report_run = nil
+	delete(m.clearedFields, deepanalysisresult.FieldRunID)
+}
+
+// SetUserID sets the "user_id" field.
+func (m *DeepAnalysisResultMutation) SetUserID(i int) {
+	m.user_id = &i
+	m.adduser_id = nil
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *DeepAnalysisResultMutation) UserID() (r int, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the DeepAnalysisResult entity.
+// If the DeepAnalysisResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeepAnalysisResultMutation) OldUserID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// AddUserID adds i to the "user_id" field.
+func (m *DeepAnalysisResultMutation) AddUserID(i int) {
+	if m.adduser_id != nil {
+		*m.adduser_id += i
+	} else {
+		m.adduser_id = &i
+	}
+}
+
+// AddedUserID returns the value that was added to the "user_id" field in this mutation.
+func (m *DeepAnalysisResultMutation) AddedUserID() (r int, exists bool) {
+	v := m.adduser_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (m *DeepAnalysisResultMutation) ClearUserID() {
+	m.user_id = nil
+	m.adduser_id = nil
+	m.clearedFields[deepanalysisresult.FieldUserID] = struct{}{}
+}
+
+// UserIDCleared returns if the "user_id" field was cleared in this mutation.
+func (m *DeepAnalysisResultMutation) UserIDCleared() bool {
+	_, ok := m.clearedFields[deepanalysisresult.FieldUserID]
+	return ok
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *DeepAnalysisResultMutation) ResetUserID() {
+	m.user_id = nil
+	m.adduser_id = nil
+	delete(m.clearedFields, deepanalysisresult.FieldUserID)
+}
+
+// SetPersonaID sets the "persona_id" field.
+func (m *DeepAnalysisResultMutation) SetPersonaID(i int) {
+	m.persona = &i
+}
+
+// PersonaID returns the value of the "persona_id" field in the mutation.
+func (m *DeepAnalysisResultMutation) PersonaID() (r int, exists bool) {
+	v := m.persona
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPersonaID returns the old "persona_id" field's value of the DeepAnalysisResult entity.
+// If the DeepAnalysisResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeepAnalysisResultMutation) OldPersonaID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPersonaID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPersonaID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPersonaID: %w", err)
+	}
+	return oldValue.PersonaID, nil
+}
+
+// ClearPersonaID clears the value of the "persona_id" field.
+func (m *DeepAnalysisResultMutation) ClearPersonaID() {
+	m.persona = nil
+	m.clearedFields[deepanalysisresult.FieldPersonaID] = struct{}{}
+}
+
+// PersonaIDCleared returns if the "persona_id" field was cleared in this mutation.
+func (m *DeepAnalysisResultMutation) PersonaIDCleared() bool {
+	_, ok := m.clearedFields[deepanalysisresult.FieldPersonaID]
+	return ok
+}
+
+// ResetPersonaID resets all changes to the "persona_id" field.
+func (m *DeepAnalysisResultMutation) ResetPersonaID() {
+	m.persona = nil
+	delete(m.clearedFields, deepanalysisresult.FieldPersonaID)
+}
+
+// SetPersonaName sets the "persona_name" field.
+func (m *DeepAnalysisResultMutation) SetPersonaName(s string) {
+	m.persona_name = &s
+}
+
+// PersonaName returns the value of the "persona_name" field in the mutation.
+func (m *DeepAnalysisResultMutation) PersonaName() (r string, exists bool) {
+	v := m.persona_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPersonaName returns the old "persona_name" field's value of the DeepAnalysisResult entity.
+// If the DeepAnalysisResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeepAnalysisResultMutation) OldPersonaName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPersonaName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPersonaName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPersonaName: %w", err)
+	}
+	return oldValue.PersonaName, nil
+}
+
+// ClearPersonaName clears the value of the "persona_name" field.
+func (m *DeepAnalysisResultMutation) ClearPersonaName() {
+	m.persona_name = nil
+	m.clearedFields[deepanalysisresult.FieldPersonaName] = struct{}{}
+}
+
+// PersonaNameCleared returns if the "persona_name" field was cleared in this mutation.
+func (m *DeepAnalysisResultMutation) PersonaNameCleared() bool {
+	_, ok := m.clearedFields[deepanalysisresult.FieldPersonaName]
+	return ok
+}
+
+// ResetPersonaName resets all changes to the "persona_name" field.
+func (m *DeepAnalysisResultMutation) ResetPersonaName() {
+	m.persona_name = nil
+	delete(m.clearedFields, deepanalysisresult.FieldPersonaName)
+}
+
+// SetMacroTrends sets the "macro_trends" field.
+func (m *DeepAnalysisResultMutation) SetMacroTrends(s string) {
+	m.macro_trends = &s
+}
+
+// MacroTrends returns the value of the "macro_trends" field in the mutation.
+func (m *DeepAnalysisResultMutation) MacroTrends() (r string, exists bool) {
+	v := m.macro_trends
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMacroTrends returns the old "macro_trends" field's value of the DeepAnalysisResult entity.
+// If the DeepAnalysisResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeepAnalysisResultMutation) OldMacroTrends(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMacroTrends is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMacroTrends requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMacroTrends: %w", err)
+	}
+	return oldValue.MacroTrends, nil
+}
+
+// ClearMacroTrends clears the value of the "macro_trends" field.
+func (m *DeepAnalysisResultMutation) ClearMacroTrends() {
+	m.macro_trends = nil
+	m.clearedFields[deepanalysisresult.FieldMacroTrends] = struct{}{}
+}
+
+// MacroTrendsCleared returns if the "macro_trends" field was cleared in this mutation.
+func (m *DeepAnalysisResultMutation) MacroTrendsCleared() bool {
+	_, ok := m.clearedFields[deepanalysisresult.FieldMacroTrends]
+	return ok
+}
+
+// ResetMacroTrends resets all changes to the "macro_trends" field.
+func (m *DeepAnalysisResultMutation) ResetMacroTrends() {
+	m.macro_trends = nil
+	delete(m.clearedFields, deepanalysisresult.FieldMacroTrends)
+}
+
+// SetOpportunities sets the "opportunities" field.
+func (m *DeepAnalysisResultMutation) SetOpportunities(s string) {
+	m.opportunities = &s
+}
+
+// Opportunities returns the value of the "opportunities" field in the mutation.
+func (m *DeepAnalysisResultMutation) Opportunities() (r string, exists bool) {
+	v := m.opportunities
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOpportunities returns the old "opportunities" field's value of the DeepAnalysisResult entity.
+// If the DeepAnalysisResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeepAnalysisResultMutation) OldOpportunities(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOpportunities is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOpportunities requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOpportunities: %w", err)
+	}
+	return oldValue.Opportunities, nil
+}
+
+// ClearOpportunities clears the value of the "opportunities" field.
+func (m *DeepAnalysisResultMutation) ClearOpportunities() {
+	m.opportunities = nil
+	m.clearedFields[deepanalysisresult.FieldOpportunities] = struct{}{}
+}
+
+// OpportunitiesCleared returns if the "opportunities" field was cleared in this mutation.
+func (m *DeepAnalysisResultMutation) OpportunitiesCleared() bool {
+	_, ok := m.clearedFields[deepanalysisresult.FieldOpportunities]
+	return ok
+}
+
+// ResetOpportunities resets all changes to the "opportunities" field.
+func (m *DeepAnalysisResultMutation) ResetOpportunities() {
+	m.opportunities = nil
+	delete(m.clearedFields, deepanalysisresult.FieldOpportunities)
+}
+
+// SetRisks sets the "risks" field.
+func (m *DeepAnalysisResultMutation) SetRisks(s string) {
+	m.risks = &s
+}
+
+// Risks returns the value of the "risks" field in the mutation.
+func (m *DeepAnalysisResultMutation) Risks() (r string, exists bool) {
+	v := m.risks
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRisks returns the old "risks" field's value of the DeepAnalysisResult entity.
+// If the DeepAnalysisResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeepAnalysisResultMutation) OldRisks(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRisks is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRisks requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRisks: %w", err)
+	}
+	return oldValue.Risks, nil
+}
+
+// ClearRisks clears the value of the "risks" field.
+func (m *DeepAnalysisResultMutation) ClearRisks() {
+	m.risks = nil
+	m.clearedFields[deepanalysisresult.FieldRisks] = struct{}{}
+}
+
+// RisksCleared returns if the "risks" field was cleared in this mutation.
+func (m *DeepAnalysisResultMutation) RisksCleared() bool {
+	_, ok := m.clearedFields[deepanalysisresult.FieldRisks]
+	return ok
+}
+
+// ResetRisks resets all changes to the "risks" field.
+func (m *DeepAnalysisResultMutation) ResetRisks() {
+	m.risks = nil
+	delete(m.clearedFields, deepanalysisresult.FieldRisks)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *DeepAnalysisResultMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *DeepAnalysisResultMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the DeepAnalysisResult entity.
+// If the DeepAnalysisResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeepAnalysisResultMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *DeepAnalysisResultMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetReportRunID sets the "report_run" edge to the ReportRun entity by id.
+func (m *DeepAnalysisResultMutation) SetReportRunID(id int) {
+	m.report_run = &id
+}
+
+// ClearReportRun clears the "report_run" edge to the ReportRun entity.
+func (m *DeepAnalysisResultMutation) ClearReportRun() {
+	m.clearedreport_run = true
+	m.clearedFields[deepanalysisresult.FieldRunID] = struct{}{}
+}
+
+// ReportRunCleared reports if the "report_run" edge to the ReportRun entity was cleared.
+func (m *DeepAnalysisResultMutation) ReportRunCleared() bool {
+	return m.RunIDCleared() || m.clearedreport_run
+}
+
+// ReportRunID returns the "report_run" edge ID in the mutation.
+func (m *DeepAnalysisResultMutation) ReportRunID() (id int, exists bool) {
+	if m.report_run != nil {
+		return *m.report_run, true
+	}
+	return
+}
+
+// ReportRunIDs returns the "report_run" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ReportRunID instead. It exists only for internal usage by the builders.
+func (m *DeepAnalysisResultMutation) ReportRunIDs() (ids []int) {
+	if id := m.report_run; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetReportRun resets all changes to the "report_run" edge.
+func (m *DeepAnalysisResultMutation) ResetReportRun() {
+	m.report_run = nil
+	m.clearedreport_run = false
+}
+
+// AddActionGuideIDs adds the "action_guides" edge to the ActionGuide entity by ids.
+func (m *DeepAnalysisResultMutation) AddActionGuideIDs(ids ...int) {
+	if m.action_guides == nil {
+		m.action_guides = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.action_guides[ids[i]] = struct{}{}
+	}
+}
+
+// ClearActionGuides clears the "action_guides" edge to the ActionGuide entity.
+func (m *DeepAnalysisResultMutation) ClearActionGuides() {
+	m.clearedaction_guides = true
+}
+
+// ActionGuidesCleared reports if the "action_guides" edge to the ActionGuide entity was cleared.
+func (m *DeepAnalysisResultMutation) ActionGuidesCleared() bool {
+	return m.clearedaction_guides
+}
+
+// RemoveActionGuideIDs removes the "action_guides" edge to the ActionGuide entity by IDs.
+func (m *DeepAnalysisResultMutation) RemoveActionGuideIDs(ids ...int) {
+	if m.removedaction_guides == nil {
+		m.removedaction_guides = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.action_guides, ids[i])
+		m.removedaction_guides[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedActionGuides returns the removed IDs of the "action_guides" edge to the ActionGuide entity.
+func (m *DeepAnalysisResultMutation) RemovedActionGuidesIDs() (ids []int) {
+	for id := range m.removedaction_guides {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ActionGuidesIDs returns the "action_guides" edge IDs in the mutation.
+func (m *DeepAnalysisResultMutation) ActionGuidesIDs() (ids []int) {
+	for id := range m.action_guides {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetActionGuides resets all changes to the "action_guides" edge.
+func (m *DeepAnalysisResultMutation) ResetActionGuides() {
+	m.action_guides = nil
+	m.clearedaction_guides = false
+	m.removedaction_guides = nil
+}
+
+// AddSectionIDs adds the "sections" edge to the AnalysisSection entity by ids.
+func (m *DeepAnalysisResultMutation) AddSectionIDs(ids ...int) {
+	if m.sections == nil {
+		m.sections = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.sections[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSections clears the "sections" edge to the AnalysisSection entity.
+func (m *DeepAnalysisResultMutation) ClearSections() {
+	m.clearedsections = true
+}
+
+// SectionsCleared reports if the "sections" edge to the AnalysisSection entity was cleared.
+func (m *DeepAnalysisResultMutation) SectionsCleared() bool {
+	return m.clearedsections
+}
+
+// RemoveSectionIDs removes the "sections" edge to the AnalysisSection entity by IDs.
+func (m *DeepAnalysisResultMutation) RemoveSectionIDs(ids ...int) {
+	if m.removedsections == nil {
+		m.removedsections = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.sections, ids[i])
+		m.removedsections[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSections returns the removed IDs of the "sections" edge to the AnalysisSection entity.
+func (m *DeepAnalysisResultMutation) RemovedSectionsIDs() (ids []int) {
+	for id := range m.removedsections {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SectionsIDs returns the "sections" edge IDs in the mutation.
+func (m *DeepAnalysisResultMutation) SectionsIDs() (ids []int) {
+	for id := range m.sections {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSections resets all changes to the "sections" edge.
+func (m *DeepAnalysisResultMutation) ResetSections() {
+	m.sections = nil
+	m.clearedsections = false
+	m.removedsections = nil
+}
+
+// ClearPersona clears the "persona" edge to the Persona entity.
+func (m *DeepAnalysisResultMutation) ClearPersona() {
+	m.clearedpersona = true
+	m.clearedFields[deepanalysisresult.FieldPersonaID] = struct{}{}
+}
+
+// PersonaCleared reports if the "persona" edge to the Persona entity was cleared.
+func (m *DeepAnalysisResultMutation) PersonaCleared() bool {
+	return m.PersonaIDCleared() || m.clearedpersona
+}
+
+// PersonaIDs returns the "persona" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PersonaID instead. It exists only for internal usage by the builders.
+func (m *DeepAnalysisResultMutation) PersonaIDs() (ids []int) {
+	if id := m.persona; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPersona resets all changes to the "persona" edge.
+func (m *DeepAnalysisResultMutation) ResetPersona() {
+	m.persona = nil
+	m.clearedpersona = false
+}
+
+// Where appends a list predicates to the DeepAnalysisResultMutation builder.
+func (m *DeepAnalysisResultMutation) Where(ps ...predicate.DeepAnalysisResult) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DeepAnalysisResultMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DeepAnalysisResultMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DeepAnalysisResult, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DeepAnalysisResultMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DeepAnalysisResultMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (DeepAnalysisResult).
+func (m *DeepAnalysisResultMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DeepAnalysisResultMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.report_run != nil {
+		fields = append(fields, deepanalysisresult.FieldRunID)
+	}
+	if m.user_id != nil {
+		fields = append(fields, deepanalysisresult.FieldUserID)
+	}
+	if m.persona != nil {
+		fields = append(fields, deepanalysisresult.FieldPersonaID)
+	}
+	if m.persona_name != nil {
+		fields = append(fields, deepanalysisresult.FieldPersonaName)
+	}
+	if m.macro_trends != nil {
+		fields = append(fields, deepanalysisresult.FieldMacroTrends)
+	}
+	if m.opportunities != nil {
+		fields = append(fields, deepanalysisresult.FieldOpportunities)
+	}
+	if m.risks != nil {
+		fields = append(fields, deepanalysisresult.FieldRisks)
+	}
+	if m.created_at != nil {
+		fields = append(fields, deepanalysisresult.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DeepAnalysisResultMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case deepanalysisresult.FieldRunID:
+		return m.RunID()
+	case deepanalysisresult.FieldUserID:
+		return m.UserID()
+	case deepanalysisresult.FieldPersonaID:
+		return m.PersonaID()
+	case deepanalysisresult.FieldPersonaName:
+		return m.PersonaName()
+	case deepanalysisresult.FieldMacroTrends:
+		return m.MacroTrends()
+	case deepanalysisresult.FieldOpportunities:
+		return m.Opportunities()
+	case deepanalysisresult.FieldRisks:
+		return m.Risks()
+	case deepanalysisresult.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DeepAnalysisResultMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case deepanalysisresult.FieldRunID:
+		return m.OldRunID(ctx)
+	case deepanalysisresult.FieldUserID:
+		return m.OldUserID(ctx)
+	case deepanalysisresult.FieldPersonaID:
+		return m.OldPersonaID(ctx)
+	case deepanalysisresult.FieldPersonaName:
+		return m.OldPersonaName(ctx)
+	case deepanalysisresult.FieldMacroTrends:
+		return m.OldMacroTrends(ctx)
+	case deepanalysisresult.FieldOpportunities:
+		return m.OldOpportunities(ctx)
+	case deepanalysisresult.FieldRisks:
+		return m.OldRisks(ctx)
+	case deepanalysisresult.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown DeepAnalysisResult field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DeepAnalysisResultMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case deepanalysisresult.FieldRunID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRunID(v)
+		return nil
+	case deepanalysisresult.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case deepanalysisresult.FieldPersonaID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPersonaID(v)
+		return nil
+	case deepanalysisresult.FieldPersonaName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPersonaName(v)
+		return nil
+	case deepanalysisresult.FieldMacroTrends:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMacroTrends(v)
+		return nil
+	case deepanalysisresult.FieldOpportunities:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOpportunities(v)
+		return nil
+	case deepanalysisresult.FieldRisks:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRisks(v)
+		return nil
+	case deepanalysisresult.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DeepAnalysisResult field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DeepAnalysisResultMutation) AddedFields() []string {
+	var fields []string
+	if m.adduser_id != nil {
+		fields = append(fields, deepanalysisresult.FieldUserID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DeepAnalysisResultMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case deepanalysisresult.FieldUserID:
+		return m.AddedUserID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DeepAnalysisResultMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case deepanalysisresult.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUserID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DeepAnalysisResult numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DeepAnalysisResultMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(deepanalysisresult.FieldRunID) {
+		fields = append(fields, deepanalysisresult.FieldRunID)
+	}
+	if m.FieldCleared(deepanalysisresult.FieldUserID) {
+		fields = append(fields, deepanalysisresult.FieldUserID)
+	}
+	if m.FieldCleared(deepanalysisresult.FieldPersonaID) {
+		fields = append(fields, deepanalysisresult.FieldPersonaID)
+	}
+	if m.FieldCleared(deepanalysisresult.FieldPersonaName) {
+		fields = append(fields, deepanalysisresult.FieldPersonaName)
+	}
+	if m.FieldCleared(deepanalysisresult.FieldMacroTrends) {
+		fields = append(fields, deepanalysisresult.FieldMacroTrends)
+	}
+	if m.FieldCleared(deepanalysisresult.FieldOpportunities) {
+		fields = append(fields, deepanalysisresult.FieldOpportunities)
+	}
+	if m.FieldCleared(deepanalysisresult.FieldRisks) {
+		fields = append(fields, deepanalysisresult.FieldRisks)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DeepAnalysisResultMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DeepAnalysisResultMutation) ClearField(name string) error {
+	switch name {
+	case deepanalysisresult.FieldRunID:
+		m.ClearRunID()
+		return nil
+	case deepanalysisresult.FieldUserID:
+		m.ClearUserID()
+		return nil
+	case deepanalysisresult.FieldPersonaID:
+		m.ClearPersonaID()
+		return nil
+	case deepanalysisresult.FieldPersonaName:
+		m.ClearPersonaName()
+		return nil
+	case deepanalysisresult.FieldMacroTrends:
+		m.ClearMacroTrends()
+		return nil
+	case deepanalysisresult.FieldOpportunities:
+		m.ClearOpportunities()
+		return nil
+	case deepanalysisresult.FieldRisks:
+		m.ClearRisks()
+		return nil
+	}
+	return fmt.Errorf("unknown DeepAnalysisResult nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DeepAnalysisResultMutation) ResetField(name string) error {
+	switch name {
+	case deepanalysisresult.FieldRunID:
+		m.ResetRunID()
+		return nil
+	case deepanalysisresult.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case deepanalysisresult.FieldPersonaID:
+		m.ResetPersonaID()
+		return nil
+	case deepanalysisresult.FieldPersonaName:
+		m.ResetPersonaName()
+		return nil
+	case deepanalysisresult.FieldMacroTrends:
+		m.ResetMacroTrends()
+		return nil
+	case deepanalysisresult.FieldOpportunities:
+		m.ResetOpportunities()
+		return nil
+	case deepanalysisresult.FieldRisks:
+		m.ResetRisks()
+		return nil
+	case deepanalysisresult.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown DeepAnalysisResult field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DeepAnalysisResultMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.report_run != nil {
+		edges = append(edges, deepanalysisresult.EdgeReportRun)
+	}
+	if m.action_guides != nil {
+		edges = append(edges, deepanalysisresult.EdgeActionGuides)
+	}
+	if m.sections != nil {
+		edges = append(edges, deepanalysisresult.EdgeSections)
+	}
+	if m.persona != nil {
+		edges = append(edges, deepanalysisresult.EdgePersona)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DeepAnalysisResultMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case deepanalysisresult.EdgeReportRun:
+		if id := m.report_run; id != nil {
+			return []ent.Value{*id}
+		}
+	case deepanalysisresult.EdgeActionGuides:
+		ids := make([]ent.Value, 0, len(m.action_guides))
+		for id := range m.action_guides {
+			ids = append(ids, id)
+		}
+		return ids
+	case deepanalysisresult.EdgeSections:
+		ids := make([]ent.Value, 0, len(m.sections))
+		for id := range m.sections {
+			ids = append(ids, id)
+		}
+		return ids
+	case deepanalysisresult.EdgePersona:
+		if id := m.persona; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DeepAnalysisResultMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedaction_guides != nil {
+		edges = append(edges, deepanalysisresult.EdgeActionGuides)
+	}
+	if m.removedsections != nil {
+		edges = append(edges, deepanalysisresult.EdgeSections)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DeepAnalysisResultMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case deepanalysisresult.EdgeActionGuides:
+		ids := make([]ent.Value, 0, len(m.removedaction_guides))
+		for id := range m.removedaction_guides {
+			ids = append(ids, id)
+		}
+		return ids
+	case deepanalysisresult.EdgeSections:
+		ids := make([]ent.Value, 0, len(m.removedsections))
+		for id := range m.removedsections {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DeepAnalysisResultMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedreport_run {
+		edges = append(edges, deepanalysisresult.EdgeReportRun)
+	}
+	if m.clearedaction_guides {
+		edges = append(edges, deepanalysisresult.EdgeActionGuides)
+	}
+	if m.clearedsections {
+		edges = append(edges, deepanalysisresult.EdgeSections)
+	}
+	if m.clearedpersona {
+		edges = append(edges, deepanalysisresult.EdgePersona)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DeepAnalysisResultMutation) EdgeCleared(name string) bool {
+	switch name {
+	case deepanalysisresult.EdgeReportRun:
+		return m.clearedreport_run
+	case deepanalysisresult.EdgeActionGuides:
+		return m.clearedaction_guides
+	case deepanalysisresult.EdgeSections:
+		return m.clearedsections
+	case deepanalysisresult.EdgePersona:
+		return m.clearedpersona
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DeepAnalysisResultMutation) ClearEdge(name string) error {
+	switch name {
+	case deepanalysisresult.EdgeReportRun:
+		m.ClearReportRun()
+		return nil
+	case deepanalysisresult.EdgePersona:
+		m.ClearPersona()
+		return nil
+	}
+	return fmt.Errorf("unknown DeepAnalysisResult unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DeepAnalysisResultMutation) ResetEdge(name string) error {
+	switch name {
+	case deepanalysisresult.EdgeReportRun:
+		m.ResetReportRun()
+		return nil
+	case deepanalysisresult.EdgeActionGuides:
+		m.ResetActionGuides()
+		return nil
+	case deepanalysisresult.EdgeSections:
+		m.ResetSections()
+		return nil
+	case deepanalysisresult.EdgePersona:
+		m.ResetPersona()
+		return nil
+	}
+	return fmt.Errorf("unknown DeepAnalysisResult edge %s", name)
+}
+
+// DomainReportMutation represents an operation that mutates the DomainReport nodes in the graph.
+type DomainReportMutation struct {
+	config
+	op                         Op
+	typ                        string
+	id                         *int
+	share_key                  *string
+	domain_name                *string
+	overview                   *string
+	trends                     *string
+	score                      *int
+	addscore                   *int
+	llm_score                  *int
+	addllm_score               *int
+	result_count               *int
+	addresult_count            *int
+	heat_baseline              *float64
+	addheat_baseline           *float64
+	heat_volume                *float64
+	addheat_volume             *float64
+	heat_diversity             *float64
+	addheat_diversity          *float64
+	heat_recency               *float64
+	addheat_recency            *float64
+	heat_engagement            *float64
+	addheat_engagement         *float64
+	heat_significance          *float64
+	addheat_significance       *float64
+	heat_raw                   *float64
+	addheat_raw                *float64
+	created_at                 *time.Time
+	clearedFields              map[string]struct{}
+	source_run                 *int
+	clearedsource_run          bool
+	report_runs                map[int]struct{}
+	removedreport_runs         map[int]struct{}
+	clearedreport_runs         bool
+	articles                   map[int]struct{}
+	removedarticles            map[int]struct{}
+	clearedarticles            bool
+	key_events                 map[int]struct{}
+	removedkey_events          map[int]struct{}
+	clearedkey_events          bool
+	claim_verifications        map[int]struct{}
+	removedclaim_verifications map[int]struct{}
+	clearedclaim_verifications bool
+	done                       bool
+	oldValue                   func(context.Context) (*DomainReport, error)
+	predicates                 []predicate.DomainReport
+}
+
+var _ ent.Mutation = (*DomainReportMutation)(nil)
+
+// domainreportOption allows management of the mutation configuration using functional options.
+type domainreportOption func(*DomainReportMutation)
+
+// newDomainReportMutation creates new mutation for the DomainReport entity.
+func newDomainReportMutation(c config, op Op, opts ...domainreportOption) *DomainReportMutation {
+	m := &DomainReportMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDomainReport,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDomainReportID sets the ID field of the mutation.
+func withDomainReportID(id int) domainreportOption {
+	return func(m *DomainReportMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *DomainReport
+		)
+		m.oldValue = func(ctx context.Context) (*DomainReport, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().DomainReport.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDomainReport sets the old DomainReport of the mutation.
+func withDomainReport(node *DomainReport) domainreportOption {
+	return func(m *DomainReportMutation) {
+		m.oldValue = func(context.Context) (*DomainReport, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DomainReportMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DomainReportMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of DomainReport entities.
+func (m *DomainReportMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DomainReportMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DomainReportMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().DomainReport.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetRunID sets the "run_id" field.
+func (m *DomainReportMutation) SetRunID(i int) {
+	m.source_run = &i
+}
+
+// RunID returns the value of the "run_id" field in the mutation.
+func (m *DomainReportMutation) RunID() (r int, exists bool) {
+	v := m.source_run
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRunID returns the old "run_id" field's value of the DomainReport entity.
+// If the DomainReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DomainReportMutation) OldRunID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRunID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRunID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRunID: %w", err)
+	}
+	return oldValue.RunID, nil
+}
+
+// ClearRunID clears the value of the "run_id" field.
+func (m *DomainReportMutation) ClearRunID() {
+	m.source_run = nil
+	m.clearedFields[domainreport.FieldRunID] = struct{}{}
+}
+
+// RunIDCleared returns if the "run_id" field was cleared in this mutation.
+func (m *DomainReportMutation) RunIDCleared() bool {
+	_, ok := m.clearedFields[domainreport.FieldRunID]
+	return ok
+}
+
+// ResetRunID resets all changes to the "run_id" field.
+func (m *DomainReportMutation) ResetRunID() {
+	m.source_run = nil
+	delete(m.clearedFields, domainreport.FieldRunID)
+}
+
+// SetShareKey sets the "share_key" field.
+func (m *DomainReportMutation) SetShareKey(s string) {
+	m.share_key = &s
+}
+
+// ShareKey returns the value of the "share_key" field in the mutation.
+func (m *DomainReportMutation) ShareKey() (r string, exists bool) {
+	v := m.share_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldShareKey returns the old "share_key" field's value of the DomainReport entity.
+// If the DomainReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DomainReportMutation) OldShareKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldShareKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldShareKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldShareKey: %w", err)
+	}
+	return oldValue.ShareKey, nil
+}
+
+// ClearShareKey clears the value of the "share_key" field.
+func (m *DomainReportMutation) ClearShareKey() {
+	m.share_key = nil
+	m.clearedFields[domainreport.FieldShareKey] = struct{}{}
+}
+
+// ShareKeyCleared returns if the "share_key" field was cleared in this mutation.
+func (m *DomainReportMutation) ShareKeyCleared() bool {
+	_, ok := m.clearedFields[domainreport.FieldShareKey]
+	return ok
+}
+
+// ResetShareKey resets all changes to the "share_key" field.
+func (m *DomainReportMutation) ResetShareKey() {
+	m.share_key = nil
+	delete(m.clearedFields, domainreport.FieldShareKey)
+}
+
+// SetDomainName sets the "domain_name" field.
+func (m *DomainReportMutation) SetDomainName(s string) {
+	m.domain_name = &s
+}
+
+// DomainName returns the value of the "domain_name" field in the mutation.
+func (m *DomainReportMutation) DomainName() (r string, exists bool) {
+	v := m.domain_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDomainName returns the old "domain_name" field's value of the DomainReport entity.
+// If the DomainReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DomainReportMutation) OldDomainName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDomainName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDomainName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDomainName: %w", err)
+	}
+	return oldValue.DomainName, nil
+}
+
+// ResetDomainName resets all changes to the "domain_name" field.
+func (m *DomainReportMutation) ResetDomainName() {
+	m.domain_name = nil
+}
+
+// SetOverview sets the "overview" field.
+func (m *DomainReportMutation) SetOverview(s string) {
+	m.overview = &s
+}
+
+// Overview returns the value of the "overview" field in the mutation.
+func (m *DomainReportMutation) Overview() (r string, exists bool) {
+	v := m.overview
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOverview returns the old "overview" field's value of the DomainReport entity.
+// If the DomainReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DomainReportMutation) OldOverview(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOverview is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOverview requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOverview: %w", err)
+	}
+	return oldValue.Overview, nil
+}
+
+// ClearOverview clears the value of the "overview" field.
+func (m *DomainReportMutation) ClearOverview() {
+	m.overview = nil
+	m.clearedFields[domainreport.FieldOverview] = struct{}{}
+}
+
+// OverviewCleared returns if the "overview" field was cleared in this mutation.
+func (m *DomainReportMutation) OverviewCleared() bool {
+	_, ok := m.clearedFields[domainreport.FieldOverview]
+	return ok
+}
+
+// ResetOverview resets all changes to the "overview" field.
+func (m *DomainReportMutation) ResetOverview() {
+	m.overview = nil
+	delete(m.clearedFields, domainreport.FieldOverview)
+}
+
+// SetTrends sets the "trends" field.
+func (m *DomainReportMutation) SetTrends(s string) {
+	m.trends = &s
+}
+
+// Trends returns the value of the "trends" field in the mutation.
+func (m *DomainReportMutation) Trends() (r string, exists bool) {
+	v := m.trends
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTrends returns the old "trends" field's value of the DomainReport entity.
+// If the DomainReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DomainReportMutation) OldTrends(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTrends is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTrends requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTrends: %w", err)
+	}
+	return oldValue.Trends, nil
+}
+
+// ClearTrends clears the value of the "trends" field.
+func (m *DomainReportMutation) ClearTrends() {
+	m.trends = nil
+	m.clearedFields[domainreport.FieldTrends] = struct{}{}
+}
+
+// TrendsCleared returns if the "trends" field was cleared in this mutation.
+func (m *DomainReportMutation) TrendsCleared() bool {
+	_, ok := m.clearedFields[domainreport.FieldTrends]
+	return ok
+}
+
+// ResetTrends resets all changes to the "trends" field.
+func (m *DomainReportMutation) ResetTrends() {
+	m.trends = nil
+	delete(m.clearedFields, domainreport.FieldTrends)
+}
+
+// SetScore sets the "score" field.
+func (m *DomainReportMutation) SetScore(i int) {
+	m.score = &i
+	m.addscore = nil
+}
+
+// Score returns the value of the "score" field in the mutation.
+func (m *DomainReportMutation) Score() (r int, exists bool) {
+	v := m.score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScore returns the old "score" field's value of the DomainReport entity.
+// If the DomainReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DomainReportMutation) OldScore(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScore: %w", err)
+	}
+	return oldValue.Score, nil
+}
+
+// AddScore adds i to the "score" field.
+func (m *DomainReportMutation) AddScore(i int) {
+	if m.addscore != nil {
+		*m.addscore += i
+	} else {
+		m.addscore = &i
+	}
+}
+
+// AddedScore returns the value that was added to the "score" field in this mutation.
+func (m *DomainReportMutation) AddedScore() (r int, exists bool) {
+	v := m.addscore
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearScore clears the value of the "score" field.
+func (m *DomainReportMutation) ClearScore() {
+	m.score = nil
+	m.addscore = nil
+	m.clearedFields[domainreport.FieldScore] = struct{}{}
+}
+
+// ScoreCleared returns if the "score" field was cleared in this mutation.
+func (m *DomainReportMutation) ScoreCleared() bool {
+	_, ok := m.clearedFields[domainreport.FieldScore]
+	return ok
+}
+
+// ResetScore resets all changes to the "score" field.
+func (m *DomainReportMutation) ResetScore() {
+	m.score = nil
+	m.addscore = nil
+	delete(m.clearedFields, domainreport.FieldScore)
+}
+
+// SetLlmScore sets the "llm_score" field.
+func (m *DomainReportMutation) SetLlmScore(i int) {
+	m.llm_score = &i
+	m.addllm_score = nil
+}
+
+// LlmScore returns the value of the "llm_score" field in the mutation.
+func (m *DomainReportMutation) LlmScore() (r int, exists bool) {
+	v := m.llm_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLlmScore returns the old "llm_score" field's value of the DomainReport entity.
+// If the DomainReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DomainReportMutation) OldLlmScore(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLlmScore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLlmScore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLlmScore: %w", err)
+	}
+	return oldValue.LlmScore, nil
+}
+
+// AddLlmScore adds i to the "llm_score" field.
+func (m *DomainReportMutation) AddLlmScore(i int) {
+	if m.addllm_score != nil {
+		*m.addllm_score += i
+	} else {
+		m.addllm_score = &i
+	}
+}
+
+// AddedLlmScore returns the value that was added to the "llm_score" field in this mutation.
+func (m *DomainReportMutation) AddedLlmScore() (r int, exists bool) {
+	v := m.addllm_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearLlmScore clears the value of the "llm_score" field.
+func (m *DomainReportMutation) ClearLlmScore() {
+	m.llm_score = nil
+	m.addllm_score = nil
+	m.clearedFields[domainreport.FieldLlmScore] = struct{}{}
+}
+
+// LlmScoreCleared returns if the "llm_score" field was cleared in this mutation.
+func (m *DomainReportMutation) LlmScoreCleared() bool {
+	_, ok := m.clearedFields[domainreport.FieldLlmScore]
+	return ok
+}
+
+// ResetLlmScore resets all changes to the "llm_score" field.
+func (m *DomainReportMutation) ResetLlmScore() {
+	m.llm_score = nil
+	m.addllm_score = nil
+	delete(m.clearedFields, domainreport.FieldLlmScore)
+}
+
+// SetResultCount sets the "result_count" field.
+func (m *DomainReportMutation) SetResultCount(i int) {
+	m.result_count = &i
+	m.addresult_count = nil
+}
+
+// ResultCount returns the value of the "result_count" field in the mutation.
+func (m *DomainReportMutation) ResultCount() (r int, exists bool) {
+	v := m.result_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResultCount returns the old "result_count" field's value of the DomainReport entity.
+// If the DomainReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DomainReportMutation) OldResultCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResultCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResultCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResultCount: %w", err)
+	}
+	return oldValue.ResultCount, nil
+}
+
+// AddResultCount adds i to the "result_count" field.
+func (m *DomainReportMutation) AddResultCount(i int) {
+	if m.addresult_count != nil {
+		*m.addresult_count += i
+	} else {
+		m.addresult_count = &i
+	}
+}
+
+// AddedResultCount returns the value that was added to the "result_count" field in this mutation.
+func (m *DomainReportMutation) AddedResultCount() (r int, exists bool) {
+	v := m.addresult_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearResultCount clears the value of the "result_count" field.
+func (m *DomainReportMutation) ClearResultCount() {
+	m.result_count = nil
+	m.addresult_count = nil
+	m.clearedFields[domainreport.FieldResultCount] = struct{}{}
+}
+
+// ResultCountCleared returns if the "result_count" field was cleared in this mutation.
+func (m *DomainReportMutation) ResultCountCleared() bool {
+	_, ok := m.clearedFields[domainreport.FieldResultCount]
+	return ok
+}
+
+// ResetResultCount resets all changes to the "result_count" field.
+func (m *DomainReportMutation) ResetResultCount() {
+	m.result_count = nil
+	m.addresult_count = nil
+	delete(m.clearedFields, domainreport.FieldResultCount)
+}
+
+// SetHeatBaseline sets the "heat_baseline" field.
+func (m *DomainReportMutation) SetHeatBaseline(f float64) {
+	m.heat_baseline = &f
+	m.addheat_baseline = nil
+}
+
+// HeatBaseline returns the value of the "heat_baseline" field in the mutation.
+func (m *DomainReportMutation) HeatBaseline() (r float64, exists bool) {
+	v := m.heat_baseline
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHeatBaseline returns the old "heat_baseline" field's value of the DomainReport entity.
+// If the DomainReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DomainReportMutation) OldHeatBaseline(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHeatBaseline is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHeatBaseline requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHeatBaseline: %w", err)
+	}
+	return oldValue.HeatBaseline, nil
+}
+
+// AddHeatBaseline adds f to the "heat_baseline" field.
+func (m *DomainReportMutation) AddHeatBaseline(f float64) {
+	if m.addheat_baseline != nil {
+		*m.addheat_baseline += f
+	} else {
+		m.addheat_baseline = &f
+	}
+}
+
+// AddedHeatBaseline returns the value that was added to the "heat_baseline" field in this mutation.
+func (m *DomainReportMutation) AddedHeatBaseline() (r float64, exists bool) {
+	v := m.addheat_baseline
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearHeatBaseline clears the value of the "heat_baseline" field.
+func (m *DomainReportMutation) ClearHeatBaseline() {
+	m.heat_baseline = nil
+	m.addheat_baseline = nil
+	m.clearedFields[domainreport.FieldHeatBaseline] = struct{}{}
+}
+
+// HeatBaselineCleared returns if the "heat_baseline" field was cleared in this mutation.
+func (m *DomainReportMutation) HeatBaselineCleared() bool {
+	_, ok := m.clearedFields[domainreport.FieldHeatBaseline]
+	return ok
+}
+
+// ResetHeatBaseline resets all changes to the "heat_baseline" field.
+func (m *DomainReportMutation) ResetHeatBaseline() {
+	m.heat_baseline = nil
+	m.addheat_baseline = nil
+	delete(m.clearedFields, domainreport.FieldHeatBaseline)
+}
+
+// SetHeatVolume sets the "heat_volume" field.
+func (m *DomainReportMutation) SetHeatVolume(f float64) {
+	m.heat_volume = &f
+	m.addheat_volume = nil
+}
+
+// HeatVolume returns the value of the "heat_volume" field in the mutation.
+func (m *DomainReportMutation) HeatVolume() (r float64, exists bool) {
+	v := m.heat_volume
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHeatVolume returns the old "heat_volume" field's value of the DomainReport entity.
+// If the DomainReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DomainReportMutation) OldHeatVolume(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHeatVolume is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHeatVolume requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHeatVolume: %w", err)
+	}
+	return oldValue.HeatVolume, nil
+}
+
+// AddHeatVolume adds f to the "heat_volume" field.
+func (m *DomainReportMutation) AddHeatVolume(f float64) {
+	if m.addheat_volume != nil {
+		*m.addheat_volume += f
+	} else {
+		m.addheat_volume = &f
+	}
+}
+
+// AddedHeatVolume returns the value that was added to the "heat_volume" field in this mutation.
+func (m *DomainReportMutation) AddedHeatVolume() (r float64, exists bool) {
+	v := m.addheat_volume
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearHeatVolume clears the value of the "heat_volume" field.
+func (m *DomainReportMutation) ClearHeatVolume() {
+	m.heat_volume = nil
+	m.addheat_volume = nil
+	m.clearedFields[domainreport.FieldHeatVolume] = struct{}{}
+}
+
+// HeatVolumeCleared returns if the "heat_volume" field was cleared in this mutation.
+func (m *DomainReportMutation) HeatVolumeCleared() bool {
+	_, ok := m.clearedFields[domainreport.FieldHeatVolume]
+	return ok
+}
+
+// ResetHeatVolume resets all changes to the "heat_volume" field.
+func (m *DomainReportMutation) ResetHeatVolume() {
+	m.heat_volume = nil
+	m.addheat_volume = nil
+	delete(m.clearedFields, domainreport.FieldHeatVolume)
+}
+
+// SetHeatDiversity sets the "heat_diversity" field.
+func (m *DomainReportMutation) SetHeatDiversity(f float64) {
+	m.heat_diversity = &f
+	m.addheat_diversity = nil
+}
+
+// HeatDiversity returns the value of the "heat_diversity" field in the mutation.
+func (m *DomainReportMutation) HeatDiversity() (r float64, exists bool) {
+	v := m.heat_diversity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHeatDiversity returns the old "heat_diversity" field's value of the DomainReport entity.
+// If the DomainReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DomainReportMutation) OldHeatDiversity(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHeatDiversity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHeatDiversity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHeatDiversity: %w", err)
+	}
+	return oldValue.HeatDiversity, nil
+}
+
+// AddHeatDiversity adds f to the "heat_diversity" field.
+func (m *DomainReportMutation) AddHeatDiversity(f float64) {
+	if m.addheat_diversity != nil {
+		*m.addheat_diversity += f
+	} else {
+		m.addheat_diversity = &f
+	}
+}
+
+// AddedHeatDiversity returns the value that was added to the "heat_diversity" field in this mutation.
+func (m *DomainReportMutation) AddedHeatDiversity() (r float64, exists bool) {
+	v := m.addheat_diversity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearHeatDiversity clears the value of the "heat_diversity" field.
+func (m *DomainReportMutation) ClearHeatDiversity() {
+	m.heat_diversity = nil
+	m.addheat_diversity = nil
+	m.clearedFields[domainreport.FieldHeatDiversity] = struct{}{}
+}
+
+// HeatDiversityCleared returns if the "heat_diversity" field was cleared in this mutation.
+func (m *DomainReportMutation) HeatDiversityCleared() bool {
+	_, ok := m.clearedFields[domainreport.FieldHeatDiversity]
+	return ok
+}
+
+// ResetHeatDiversity resets all changes to the "heat_diversity" field.
+func (m *DomainReportMutation) ResetHeatDiversity() {
+	m.heat_diversity = nil
+	m.addheat_diversity = nil
+	delete(m.clearedFields, domainreport.FieldHeatDiversity)
+}
+
+// SetHeatRecency sets the "heat_recency" field.
+func (m *DomainReportMutation) SetHeatRecency(f float64) {
+	m.heat_recency = &f
+	m.addheat_recency = nil
+}
+
+// HeatRecency returns the value of the "heat_recency" field in the mutation.
+func (m *DomainReportMutation) HeatRecency() (r float64, exists bool) {
+	v := m.heat_recency
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHeatRecency returns the old "heat_recency" field's value of the DomainReport entity.
+// If the DomainReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DomainReportMutation) OldHeatRecency(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHeatRecency is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHeatRecency requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHeatRecency: %w", err)
+	}
+	return oldValue.HeatRecency, nil
+}
+
+// AddHeatRecency adds f to the "heat_recency" field.
+func (m *DomainReportMutation) AddHeatRecency(f float64) {
+	if m.addheat_recency != nil {
+		*m.addheat_recency += f
+	} else {
+		m.addheat_recency = &f
+	}
+}
+
+// AddedHeatRecency returns the value that was added to the "heat_recency" field in this mutation.
+func (m *DomainReportMutation) AddedHeatRecency() (r float64, exists bool) {
+	v := m.addheat_recency
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearHeatRecency clears the value of the "heat_recency" field.
+func (m *DomainReportMutation) ClearHeatRecency() {
+	m.heat_recency = nil
+	m.addheat_recency = nil
+	m.clearedFields[domainreport.FieldHeatRecency] = struct{}{}
+}
+
+// HeatRecencyCleared returns if the "heat_recency" field was cleared in this mutation.
+func (m *DomainReportMutation) HeatRecencyCleared() bool {
+	_, ok := m.clearedFields[domainreport.FieldHeatRecency]
+	return ok
+}
+
+// ResetHeatRecency resets all changes to the "heat_recency" field.
+func (m *DomainReportMutation) ResetHeatRecency() {
+	m.heat_recency = nil
+	m.addheat_recency = nil
+	delete(m.clearedFields, domainreport.FieldHeatRecency)
+}
+
+// SetHeatEngagement sets the "heat_engagement" field.
+func (m *DomainReportMutation) SetHeatEngagement(f float64) {
+	m.heat_engagement = &f
+	m.addheat_engagement = nil
+}
+
+// HeatEngagement returns the value of the "heat_engagement" field in the mutation.
+func (m *DomainReportMutation) HeatEngagement() (r float64, exists bool) {
+	v := m.heat_engagement
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHeatEngagement returns the old "heat_engagement" field's value of the DomainReport entity.
+// If the DomainReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DomainReportMutation) OldHeatEngagement(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHeatEngagement is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHeatEngagement requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHeatEngagement: %w", err)
+	}
+	return oldValue.HeatEngagement, nil
+}
+
+// AddHeatEngagement adds f to the "heat_engagement" field.
+func (m *DomainReportMutation) AddHeatEngagement(f float64) {
+	if m.addheat_engagement != nil {
+		*m.addheat_engagement += f
+	} else {
+		m.addheat_engagement = &f
+	}
+}
+
+// AddedHeatEngagement returns the value that was added to the "heat_engagement" field in this mutation.
+func (m *DomainReportMutation) AddedHeatEngagement() (r float64, exists bool) {
+	v := m.addheat_engagement
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearHeatEngagement clears the value of the "heat_engagement" field.
+func (m *DomainReportMutation) ClearHeatEngagement() {
+	m.heat_engagement = nil
+	m.addheat_engagement = nil
+	m.clearedFields[domainreport.FieldHeatEngagement] = struct{}{}
+}
+
+// HeatEngagementCleared returns if the "heat_engagement" field was cleared in this mutation.
+func (m *DomainReportMutation) HeatEngagementCleared() bool {
+	_, ok := m.clearedFields[domainreport.FieldHeatEngagement]
+	return ok
+}
+
+// ResetHeatEngagement resets all changes to the "heat_engagement" field.
+func (m *DomainReportMutation) ResetHeatEngagement() {
+	m.heat_engagement = nil
+	m.addheat_engagement = nil
+	delete(m.clearedFields, domainreport.FieldHeatEngagement)
+}
+
+// SetHeatSignificance sets the "heat_significance" field.
+func (m *DomainReportMutation) SetHeatSignificance(f float64) {
+	m.heat_significance = &f
+	m.addheat_significance = nil
+}
+
+// HeatSignificance returns the value of the "heat_significance" field in the mutation.
+func (m *DomainReportMutation) HeatSignificance() (r float64, exists bool) {
+	v := m.heat_significance
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHeatSignificance returns the old "heat_significance" field's value of the DomainReport entity.
+// If the DomainReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DomainReportMutation) OldHeatSignificance(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHeatSignificance is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHeatSignificance requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHeatSignificance: %w", err)
+	}
+	return oldValue.HeatSignificance, nil
+}
+
+// AddHeatSignificance adds f to the "heat_significance" field.
+func (m *DomainReportMutation) AddHeatSignificance(f float64) {
+	if m.addheat_significance != nil {
+		*m.addheat_significance += f
+	} else {
+		m.addheat_significance = &f
+	}
+}
+
+// AddedHeatSignificance returns the value that was added to the "heat_significance" field in this mutation.
+func (m *DomainReportMutation) AddedHeatSignificance() (r float64, exists bool) {
+	v := m.addheat_significance
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearHeatSignificance clears the value of the "heat_significance" field.
+func (m *DomainReportMutation) ClearHeatSignificance() {
+	m.heat_significance = nil
+	m.addheat_significance = nil
+	m.clearedFields[domainreport.FieldHeatSignificance] = struct{}{}
+}
+
+// HeatSignificanceCleared returns if the "heat_significance" field was cleared in this mutation.
+func (m *DomainReportMutation) HeatSignificanceCleared() bool {
+	_, ok := m.clearedFields[domainreport.FieldHeatSignificance]
+	return ok
+}
+
+// ResetHeatSignificance resets all changes to the "heat_significance" field.
+func (m *DomainReportMutation) ResetHeatSignificance() {
+	m.heat_significance = nil
+	m.addheat_significance = nil
+	delete(m.clearedFields, domainreport.FieldHeatSignificance)
+}
+
+// SetHeatRaw sets the "heat_raw" field.
+func (m *DomainReportMutation) SetHeatRaw(f float64) {
+	m.heat_raw = &f
+	m.addheat_raw = nil
+}
+
+// HeatRaw returns the value of the "heat_raw" field in the mutation.
+func (m *DomainReportMutation) HeatRaw() (r float64, exists bool) {
+	v := m.heat_raw
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHeatRaw returns the old "heat_raw" field's value of the DomainReport entity.
+// If the DomainReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DomainReportMutation) OldHeatRaw(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHeatRaw is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHeatRaw requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHeatRaw: %w", err)
+	}
+	return oldValue.HeatRaw, nil
+}
+
+// AddHeatRaw adds f to the "heat_raw" field.
+func (m *DomainReportMutation) AddHeatRaw(f float64) {
+	if m.addheat_raw != nil {
+		*m.addheat_raw += f
+	} else {
+		m.addheat_raw = &f
+	}
+}
+
+// AddedHeatRaw returns the value that was added to the "heat_raw" field in this mutation.
+func (m *DomainReportMutation) AddedHeatRaw() (r float64, exists bool) {
+	v := m.addheat_raw
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearHeatRaw clears the value of the "heat_raw" field.
+func (m *DomainReportMutation) ClearHeatRaw() {
+	m.heat_raw = nil
+	m.addheat_raw = nil
+	m.clearedFields[domainreport.FieldHeatRaw] = struct{}{}
+}
+
+// HeatRawCleared returns if the "heat_raw" field was cleared in this mutation.
+func (m *DomainReportMutation) HeatRawCleared() bool {
+	_, ok := m.clearedFields[domainreport.FieldHeatRaw]
+	return ok
+}
+
+// ResetHeatRaw resets all changes to the "heat_raw" field.
+func (m *DomainReportMutation) ResetHeatRaw() {
+	m.heat_raw = nil
+	m.addheat_raw = nil
+	delete(m.clearedFields, domainreport.FieldHeatRaw)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *DomainReportMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *DomainReportMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the DomainReport entity.
+// If the DomainReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DomainReportMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *DomainReportMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetSourceRunID sets the "source_run" edge to the ReportRun entity by id.
+func (m *DomainReportMutation) SetSourceRunID(id int) {
+	m.source_run = &id
+}
+
+// ClearSourceRun clears the "source_run" edge to the ReportRun entity.
+func (m *DomainReportMutation) ClearSourceRun() {
+	m.clearedsource_run = true
+	m.clearedFields[domainreport.FieldRunID] = struct{}{}
+}
+
+// SourceRunCleared reports if the "source_run" edge to the ReportRun entity was cleared.
+func (m *DomainReportMutation) SourceRunCleared() bool {
+	return m.RunIDCleared() || m.clearedsource_run
+}
+
+// SourceRunID returns the "source_run" edge ID in the mutation.
+func (m *DomainReportMutation) SourceRunID() (id int, exists bool) {
+	if m.source_run != nil {
+		return *m.source_run, true
+	}
+	return
+}
+
+// SourceRunIDs returns the "source_run" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SourceRunID instead. It exists only for internal usage by the builders.
+func (m *DomainReportMutation) SourceRunIDs() (ids []int) {
+	if id := m.source_run; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSourceRun resets all changes to the "source_run" edge.
+func (m *DomainReportMutation) ResetSourceRun() {
+	m.source_run = nil
+	m.clearedsource_run = false
+}
+
+// AddReportRunIDs adds the "report_runs" edge to the ReportRun entity by ids.
+func (m *DomainReportMutation) AddReportRunIDs(ids ...int) {
+	if m.report_runs == nil {
+		m.report_runs = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.report_runs[ids[i]] = struct{}{}
+	}
+}
+
+// ClearReportRuns clears the "report_runs" edge to the ReportRun entity.
+func (m *DomainReportMutation) ClearReportRuns() {
+	m.clearedreport_runs = true
+}
+
+// ReportRunsCleared reports if the "report_runs" edge to the ReportRun entity was cleared.
+func (m *DomainReportMutation) ReportRunsCleared() bool {
+	return m.clearedreport_runs
+}
+
+// RemoveReportRunIDs removes the "report_runs" edge to the ReportRun entity by IDs.
+func (m *DomainReportMutation) RemoveReportRunIDs(ids ...int) {
+	if m.removedreport_runs == nil {
+		m.removedreport_runs = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.report_runs, ids[i])
+		m.removedreport_runs[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedReportRuns returns the removed IDs of the "report_runs" edge to the ReportRun entity.
+func (m *DomainReportMutation) RemovedReportRunsIDs() (ids []int) {
+	for id := range m.removedreport_runs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ReportRunsIDs returns the "report_runs" edge IDs in the mutation.
+func (m *DomainReportMutation) ReportRunsIDs() (ids []int) {
+	for id := range m.report_runs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetReportRuns resets all changes to the "report_runs" edge.
+func (m *DomainReportMutation) ResetReportRuns() {
+	m.report_runs = nil
+	m.clearedreport_runs = false
+	m.removedreport_runs = nil
+}
+
+// AddArticleIDs adds the "articles" edge to the Article entity by ids.
+func (m *DomainReportMutation) AddArticleIDs(ids ...int) {
+	if m.articles == nil {
+		m.articles = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.articles[ids[i]] = struct{}{}
+	}
+}
+
+// ClearArticles clears the "articles" edge to the Article entity.
+func (m *DomainReportMutation) ClearArticles() {
+	m.clearedarticles = true
+}
+
+// ArticlesCleared reports if the "articles" edge to the Article entity was cleared.
+func (m *DomainReportMutation) ArticlesCleared() bool {
+	return m.clearedarticles
+}
+
+// RemoveArticleIDs removes the "articles" edge to the Article entity by IDs.
+func (m *DomainReportMutation) RemoveArticleIDs(ids ...int) {
+	if m.removedarticles == nil {
+		m.removedarticles = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.articles, ids[i])
+		m.removedarticles[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedArticles returns the removed IDs of the "articles" edge to the Article entity.
+func (m *DomainReportMutation) RemovedArticlesIDs() (ids []int) {
+	for id := range m.removedarticles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ArticlesIDs returns the "articles" edge IDs in the mutation.
+func (m *DomainReportMutation) ArticlesIDs() (ids []int) {
+	for id := range m.articles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetArticles resets all changes to the "articles" edge.
+func (m *DomainReportMutation) ResetArticles() {
+	m.articles = nil
+	m.clearedarticles = false
+	m.removedarticles = nil
+}
+
+// AddKeyEventIDs adds the "key_events" edge to the KeyEvent entity by ids.
+func (m *DomainReportMutation) AddKeyEventIDs(ids ...int) {
+	if m.key_events == nil {
+		m.key_events = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.key_events[ids[i]] = struct{}{}
+	}
+}
+
+// ClearKeyEvents clears the "key_events" edge to the KeyEvent entity.
+func (m *DomainReportMutation) ClearKeyEvents() {
+	m.clearedkey_events = true
+}
+
+// KeyEventsCleared reports if the "key_events" edge to the KeyEvent entity was cleared.
+func (m *DomainReportMutation) KeyEventsCleared() bool {
+	return m.clearedkey_events
+}
+
+// RemoveKeyEventIDs removes the "key_events" edge to the KeyEvent entity by IDs.
+func (m *DomainReportMutation) RemoveKeyEventIDs(ids ...int) {
+	if m.removedkey_events == nil {
+		m.removedkey_events = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.key_events, ids[i])
+		m.removedkey_events[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedKeyEvents returns the removed IDs of the "key_events" edge to the KeyEvent entity.
+func (m *DomainReportMutation) RemovedKeyEventsIDs() (ids []int) {
+	for id := range m.removedkey_events {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// KeyEventsIDs returns the "key_events" edge IDs in the mutation.
+func (m *DomainReportMutation) KeyEventsIDs() (ids []int) {
+	for id := range m.key_events {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetKeyEvents resets all changes to the "key_events" edge.
+func (m *DomainReportMutation) ResetKeyEvents() {
+	m.key_events = nil
+	m.clearedkey_events = false
+	m.removedkey_events = nil
+}
+
+// AddClaimVerificationIDs adds the "claim_verifications" edge to the ClaimVerification entity by ids.
+func (m *DomainReportMutation) AddClaimVerificationIDs(ids ...int) {
+	if m.claim_verifications == nil {
+		m.claim_verifications = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.claim_verifications[ids[i]] = struct{}{}
+	}
+}
+
+// ClearClaimVerifications clears the "claim_verifications" edge to the ClaimVerification entity.
+func (m *DomainReportMutation) ClearClaimVerifications() {
+	m.clearedclaim_verifications = true
+}
+
+// ClaimVerificationsCleared reports if the "claim_verifications" edge to the ClaimVerification entity was cleared.
+func (m *DomainReportMutation) ClaimVerificationsCleared() bool {
+	return m.clearedclaim_verifications
+}
+
+// RemoveClaimVerificationIDs removes the "claim_verifications" edge to the ClaimVerification entity by IDs.
+func (m *DomainReportMutation) RemoveClaimVerificationIDs(ids ...int) {
+	if m.removedclaim_verifications == nil {
+		m.removedclaim_verifications = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.claim_verifications, ids[i])
+		m.removedclaim_verifications[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedClaimVerifications returns the removed IDs of the "claim_verifications" edge to the ClaimVerification entity.
+func (m *DomainReportMutation) RemovedClaimVerificationsIDs() (ids []int) {
+	for id := range m.removedclaim_verifications {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ClaimVerificationsIDs returns the "claim_verifications" edge IDs in the mutation.
+func (m *DomainReportMutation) ClaimVerificationsIDs() (ids []int) {
+	for id := range m.claim_verifications {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetClaimVerifications resets all changes to the "claim_verifications" edge.
+func (m *DomainReportMutation) ResetClaimVerifications() {
+	m.claim_verifications = nil
+	m.clearedclaim_verifications = false
+	m.removedclaim_verifications = nil
+}
+
+// Where appends a list predicates to the DomainReportMutation builder.
+func (m *DomainReportMutation) Where(ps ...predicate.DomainReport) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DomainReportMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DomainReportMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DomainReport, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DomainReportMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DomainReportMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (DomainReport).
+func (m *DomainReportMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DomainReportMutation) Fields() []string {
+	fields := make([]string, 0, 16)
+	if m.source_run != nil {
+		fields = append(fields, domainreport.FieldRunID)
+	}
+	if m.share_key != nil {
+		fields = append(fields, domainreport.FieldShareKey)
+	}
+	if m.domain_name != nil {
+		fields = append(fields, domainreport.FieldDomainName)
+	}
+	if m.overview != nil {
+		fields = append(fields, domainreport.FieldOverview)
+	}
+	if m.trends != nil {
+		fields = append(fields, domainreport.FieldTrends)
+	}
+	if m.score != nil {
+		fields = append(fields, domainreport.FieldScore)
+	}
+	if m.llm_score != nil {
+		fields = append(fields, domainreport.FieldLlmScore)
+	}
+	if m.result_count != nil {
+		fields = append(fields, domainreport.FieldResultCount)
+	}
+	if m.heat_baseline != nil {
+		fields = append(fields, domainreport.FieldHeatBaseline)
+	}
+	if m.heat_volume != nil {
+		fields = append(fields, domainreport.FieldHeatVolume)
+	}
+	if m.heat_diversity != nil {
+		fields = append(fields, domainreport.FieldHeatDiversity)
+	}
+	if m.heat_recency != nil {
+		fields = append(fields, domainreport.FieldHeatRecency)
+	}
+	if m.heat_engagement != nil {
+		fields = append(fields, domainreport.FieldHeatEngagement)
+	}
+	if m.heat_significance != nil {
+		fields = append(fields, domainreport.FieldHeatSignificance)
+	}
+	if m.heat_raw != nil {
+		fields = append(fields, domainreport.FieldHeatRaw)
+	}
+	if m.created_at != nil {
+		fields = append(fields, domainreport.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DomainReportMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case domainreport.FieldRunID:
+		return m.RunID()
+	case domainreport.FieldShareKey:
+		return m.ShareKey()
+	case domainreport.FieldDomainName:
+		return m.DomainName()
+	case domainreport.FieldOverview:
+		return m.Overview()
+	case domainreport.FieldTrends:
+		return m.Trends()
+	case domainreport.FieldScore:
+		return m.Score()
+	case domainreport.FieldLlmScore:
+		return m.LlmScore()
+	case domainreport.FieldResultCount:
+		return m.ResultCount()
+	case domainreport.FieldHeatBaseline:
+		return m.HeatBaseline()
+	case domainreport.FieldHeatVolume:
+		return m.HeatVolume()
+	case domainreport.FieldHeatDiversity:
+		return m.HeatDiversity()
+	case domainreport.FieldHeatRecency:
+		return m.HeatRecency()
+	case domainreport.FieldHeatEngagement:
+		return m.HeatEngagement()
+	case domainreport.FieldHeatSignificance:
+		return m.HeatSignificance()
+	case domainreport.FieldHeatRaw:
+		return m.HeatRaw()
+	case domainreport.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DomainReportMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case domainreport.FieldRunID:
+		return m.OldRunID(ctx)
+	case domainreport.FieldShareKey:
+		return m.OldShareKey(ctx)
+	case domainreport.FieldDomainName:
+		return m.OldDomainName(ctx)
+	case domainreport.FieldOverview:
+		return m.OldOverview(ctx)
+	case domainreport.FieldTrends:
+		return m.OldTrends(ctx)
+	case domainreport.FieldScore:
+		return m.OldScore(ctx)
+	case domainreport.FieldLlmScore:
+		return m.OldLlmScore(ctx)
+	case domainreport.FieldResultCount:
+		return m.OldResultCount(ctx)
+	case domainreport.FieldHeatBaseline:
+		return m.OldHeatBaseline(ctx)
+	case domainreport.FieldHeatVolume:
+		return m.OldHeatVolume(ctx)
+	case domainreport.FieldHeatDiversity:
+		return m.OldHeatDiversity(ctx)
+	case domainreport.FieldHeatRecency:
+		return m.OldHeatRecency(ctx)
+	case domainreport.FieldHeatEngagement:
+		return m.OldHeatEngagement(ctx)
+	case domainreport.FieldHeatSignificance:
+		return m.OldHeatSignificance(ctx)
+	case domainreport.FieldHeatRaw:
+		return m.OldHeatRaw(ctx)
+	case domainreport.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown DomainReport field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DomainReportMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case domainreport.FieldRunID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRunID(v)
+		return nil
+	case domainreport.FieldShareKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetShareKey(v)
+		return nil
+	case domainreport.FieldDomainName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDomainName(v)
+		return nil
+	case domainreport.FieldOverview:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOverview(v)
+		return nil
+	case domainreport.FieldTrends:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTrends(v)
+		return nil
+	case domainreport.FieldScore:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScore(v)
+		return nil
+	case domainreport.FieldLlmScore:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLlmScore(v)
+		return nil
+	case domainreport.FieldResultCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResultCount(v)
+		return nil
+	case domainreport.FieldHeatBaseline:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHeatBaseline(v)
+		return nil
+	case domainreport.FieldHeatVolume:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHeatVolume(v)
+		return nil
+	case domainreport.FieldHeatDiversity:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHeatDiversity(v)
+		return nil
+	case domainreport.FieldHeatRecency:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHeatRecency(v)
+		return nil
+	case domainreport.FieldHeatEngagement:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHeatEngagement(v)
+		return nil
+	case domainreport.FieldHeatSignificance:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHeatSignificance(v)
+		return nil
+	case domainreport.FieldHeatRaw:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHeatRaw(v)
+		return nil
+	case domainreport.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DomainReport field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DomainReportMutation) AddedFields() []string {
+	var fields []string
+	if m.addscore != nil {
+		fields = append(fields, domainreport.FieldScore)
+	}
+	if m.addllm_score != nil {
+		fields = append(fields, domainreport.FieldLlmScore)
+	}
+	if m.addresult_count != nil {
+		fields = append(fields, domainreport.FieldResultCount)
+	}
+	if m.addheat_baseline != nil {
+		fields = append(fields, domainreport.FieldHeatBaseline)
+	}
+	if m.addheat_volume != nil {
+		fields = append(fields, domainreport.FieldHeatVolume)
+	}
+	if m.addheat_diversity != nil {
+		fields = append(fields, domainreport.FieldHeatDiversity)
+	}
+	if m.addheat_recency != nil {
+		fields = append(fields, domainreport.FieldHeatRecency)
+	}
+	if m.addheat_engagement != nil {
+		fields = append(fields, domainreport.FieldHeatEngagement)
+	}
+	if m.addheat_significance != nil {
+		fields = append(fields, domainreport.FieldHeatSignificance)
+	}
+	if m.addheat_raw != nil {
+		fields = append(fields, domainreport.FieldHeatRaw)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DomainReportMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case domainreport.FieldScore:
+		return m.AddedScore()
+	case domainreport.FieldLlmScore:
+		return m.AddedLlmScore()
+	case domainreport.FieldResultCount:
+		return m.AddedResultCount()
+	case domainreport.FieldHeatBaseline:
+		return m.AddedHeatBaseline()
+	case domainreport.FieldHeatVolume:
+		return m.AddedHeatVolume()
+	case domainreport.FieldHeatDiversity:
+		return m.AddedHeatDiversity()
+	case domainreport.FieldHeatRecency:
+		return m.AddedHeatRecency()
+	case domainreport.FieldHeatEngagement:
+		return m.AddedHeatEngagement()
+	case domainreport.FieldHeatSignificance:
+		return m.AddedHeatSignificance()
+	case domainreport.FieldHeatRaw:
+		return m.AddedHeatRaw()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DomainReportMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case domainreport.FieldScore:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddScore(v)
+		return nil
+	case domainreport.FieldLlmScore:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLlmScore(v)
+		return nil
+	case domainreport.FieldResultCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddResultCount(v)
+		return nil
+	case domainreport.FieldHeatBaseline:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddHeatBaseline(v)
+		return nil
+	case domainreport.FieldHeatVolume:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddHeatVolume(v)
+		return nil
+	case domainreport.FieldHeatDiversity:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddHeatDiversity(v)
+		return nil
+	case domainreport.FieldHeatRecency:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddHeatRecency(v)
+		return nil
+	case domainreport.FieldHeatEngagement:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddHeatEngagement(v)
+		return nil
+	case domainreport.FieldHeatSignificance:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddHeatSignificance(v)
+		return nil
+	case domainreport.FieldHeatRaw:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddHeatRaw(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DomainReport numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DomainReportMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(domainreport.FieldRunID) {
+		fields = append(fields, domainreport.FieldRunID)
+	}
+	if m.FieldCleared(domainreport.FieldShareKey) {
+		fields = append(fields, domainreport.FieldShareKey)
+	}
+	if m.FieldCleared(domainreport.FieldOverview) {
+		fields = append(fields, domainreport.FieldOverview)
+	}
+	if m.FieldCleared(domainreport.FieldTrends) {
+		fields = append(fields, domainreport.FieldTrends)
+	}
+	if m.FieldCleared(domainreport.FieldScore) {
+		fields = append(fields, domainreport.FieldScore)
+	}
+	if m.FieldCleared(domainreport.FieldLlmScore) {
+		fields = append(fields, domainreport.FieldLlmScore)
+	}
+	if m.FieldCleared(domainreport.FieldResultCount) {
+		fields = append(fields, domainreport.FieldResultCount)
+	}
+	if m.FieldCleared(domainreport.FieldHeatBaseline) {
+		fields = append(fields, domainreport.FieldHeatBaseline)
+	}
+	if m.FieldCleared(domainreport.FieldHeatVolume) {
+		fields = append(fields, domainreport.FieldHeatVolume)
+	}
+	if m.FieldCleared(domainreport.FieldHeatDiversity) {
+		fields = append(fields, domainreport.FieldHeatDiversity)
+	}
+	if m.FieldCleared(domainreport.FieldHeatRecency) {
+		fields = append(fields, domainreport.FieldHeatRecency)
+	}
+	if m.FieldCleared(domainreport.FieldHeatEngagement) {
+		fields = append(fields, domainreport.FieldHeatEngagement)
+	}
+	if m.FieldCleared(domainreport.FieldHeatSignificance) {
+		fields = append(fields, domainreport.FieldHeatSignificance)
+	}
+	if m.FieldCleared(domainreport.FieldHeatRaw) {
+		fields = append(fields, domainreport.FieldHeatRaw)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DomainReportMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DomainReportMutation) ClearField(name string) error {
+	switch name {
+	case domainreport.FieldRunID:
+		m.ClearRunID()
+		return nil
+	case domainreport.FieldShareKey:
+		m.ClearShareKey()
+		return nil
+	case domainreport.FieldOverview:
+		m.ClearOverview()
+		return nil
+	case domainreport.FieldTrends:
+		m.ClearTrends()
+		return nil
+	case domainreport.FieldScore:
+		m.ClearScore()
+		return nil
+	case domainreport.FieldLlmScore:
+		m.ClearLlmScore()
+		return nil
+	case domainreport.FieldResultCount:
+		m.ClearResultCount()
+		return nil
+	case domainreport.FieldHeatBaseline:
+		m.ClearHeatBaseline()
+		return nil
+	case domainreport.FieldHeatVolume:
+		m.ClearHeatVolume()
+		return nil
+	case domainreport.FieldHeatDiversity:
+		m.ClearHeatDiversity()
+		return nil
+	case domainreport.FieldHeatRecency:
+		m.ClearHeatRecency()
+		return nil
+	case domainreport.FieldHeatEngagement:
+		m.ClearHeatEngagement()
+		return nil
+	case domainreport.FieldHeatSignificance:
+		m.ClearHeatSignificance()
+		return nil
+	case domainreport.FieldHeatRaw:
+		m.ClearHeatRaw()
+		return nil
+	}
+	return fmt.Errorf("unknown DomainReport nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DomainReportMutation) ResetField(name string) error {
+	switch name {
+	case domainreport.FieldRunID:
+		m.ResetRunID()
+		return nil
+	case domainreport.FieldShareKey:
+		m.ResetShareKey()
+		return nil
+	case domainreport.FieldDomainName:
+		m.ResetDomainName()
+		return nil
+	case domainreport.FieldOverview:
+		m.ResetOverview()
+		return nil
+	case domainreport.FieldTrends:
+		m.ResetTrends()
+		return nil
+	case domainreport.FieldScore:
+		m.ResetScore()
+		return nil
+	case domainreport.FieldLlmScore:
+		m.ResetLlmScore()
+		return nil
+	case domainreport.FieldResultCount:
+		m.ResetResultCount()
+		return nil
+	case domainreport.FieldHeatBaseline:
+		m.ResetHeatBaseline()
+		return nil
+	case domainreport.FieldHeatVolume:
+		m.ResetHeatVolume()
+		return nil
+	case domainreport.FieldHeatDiversity:
+		m.ResetHeatDiversity()
+		return nil
+	case domainreport.FieldHeatRecency:
+		m.ResetHeatRecency()
+		return nil
+	case domainreport.FieldHeatEngagement:
+		m.ResetHeatEngagement()
+		return nil
+	case domainreport.FieldHeatSignificance:
+		m.ResetHeatSignificance()
+		return nil
+	case domainreport.FieldHeatRaw:
+		m.ResetHeatRaw()
+		return nil
+	case domainreport.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown DomainReport field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DomainReportMutation) AddedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.source_run != nil {
+		edges = append(edges, domainreport.EdgeSourceRun)
+	}
+	if m.report_runs != nil {
+		edges = append(edges, domainreport.EdgeReportRuns)
+	}
+	if m.articles != nil {
+		edges = append(edges, domainreport.EdgeArticles)
+	}
+	if m.key_events != nil {
+		edges = append(edges, domainreport.EdgeKeyEvents)
+	}
+	if m.claim_verifications != nil {
+		edges = append(edges, domainreport.EdgeClaimVerifications)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DomainReportMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case domainreport.EdgeSourceRun:
+		if id := m.source_run; id != nil {
+			return []ent.Value{*id}
+		}
+	case domainreport.EdgeReportRuns:
+		ids := make([]ent.Value, 0, len(m.report_runs))
+		for id := range m.report_runs {
+			ids = append(ids, id)
+		}
+		return ids
+	case domainreport.EdgeArticles:
+		ids := make([]ent.Value, 0, len(m.articles))
+		for id := range m.articles {
+			ids = append(ids, id)
+		}
+		return ids
+	case domainreport.EdgeKeyEvents:
+		ids := make([]ent.Value, 0, len(m.key_events))
+		for id := range m.key_events {
+			ids = append(ids, id)
+		}
+		return ids
+	case domainreport.EdgeClaimVerifications:
+		ids := make([]ent.Value, 0, len(m.claim_verifications))
+		for id := range m.claim_verifications {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DomainReportMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.removedreport_runs != nil {
+		edges = append(edges, domainreport.EdgeReportRuns)
+	}
+	if m.removedarticles != nil {
+		edges = append(edges, domainreport.EdgeArticles)
+	}
+	if m.removedkey_events != nil {
+		edges = append(edges, domainreport.EdgeKeyEvents)
+	}
+	if m.removedclaim_verifications != nil {
+		edges = append(edges, domainreport.EdgeClaimVerifications)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DomainReportMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case domainreport.EdgeReportRuns:
+		ids := make([]ent.Value, 0, len(m.removedreport_runs))
+		for id := range m.removedreport_runs {
+			ids = append(ids, id)
+		}
+		return ids
+	case domainreport.EdgeArticles:
+		ids := make([]ent.Value, 0, len(m.removedarticles))
+		for id := range m.removedarticles {
+			ids = append(ids, id)
+		}
+		return ids
+	case domainreport.EdgeKeyEvents:
+		ids := make([]ent.Value, 0, len(m.removedkey_events))
+		for id := range m.removedkey_events {
+			ids = append(ids, id)
+		}
+		return ids
+	case domainreport.EdgeClaimVerifications:
+		ids := make([]ent.Value, 0, len(m.removedclaim_verifications))
+		for id := range m.removedclaim_verifications {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DomainReportMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.clearedsource_run {
+		edges = append(edges, domainreport.EdgeSourceRun)
+	}
+	if m.clearedreport_runs {
+		edges = append(edges, domainreport.EdgeReportRuns)
+	}
+	if m.clearedarticles {
+		edges = append(edges, domainreport.EdgeArticles)
+	}
+	if m.clearedkey_events {
+		edges = append(edges, domainreport.EdgeKeyEvents)
+	}
+	if m.clearedclaim_verifications {
+		edges = append(edges, domainreport.EdgeClaimVerifications)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DomainReportMutation) EdgeCleared(name string) bool {
+	switch name {
+	case domainreport.EdgeSourceRun:
+		return m.clearedsource_run
+	case domainreport.EdgeReportRuns:
+		return m.clearedreport_runs
+	case domainreport.EdgeArticles:
+		return m.clearedarticles
+	case domainreport.EdgeKeyEvents:
+		return m.clearedkey_events
+	case domainreport.EdgeClaimVerifications:
+		return m.clearedclaim_verifications
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DomainReportMutation) ClearEdge(name string) error {
+	switch name {
+	case domainreport.EdgeSourceRun:
+		m.ClearSourceRun()
+		return nil
+	}
+	return fmt.Errorf("unknown DomainReport unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DomainReportMutation) ResetEdge(name string) error {
+	switch name {
+	case domainreport.EdgeSourceRun:
+		m.ResetSourceRun()
+		return nil
+	case domainreport.EdgeReportRuns:
+		m.ResetReportRuns()
+		return nil
+	case domainreport.EdgeArticles:
+		m.ResetArticles()
+		return nil
+	case domainreport.EdgeKeyEvents:
+		m.ResetKeyEvents()
+		return nil
+	case domainreport.EdgeClaimVerifications:
+		m.ResetClaimVerifications()
+		return nil
+	}
+	return fmt.Errorf("unknown DomainReport edge %s", name)
+}
+
+// DomainRunMutation represents an operation that mutates the DomainRun nodes in the graph.
+type DomainRunMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	domain_name       *string
+	status            *string
+	error             *string
+	article_count     *int
+	addarticle_count  *int
+	created_at        *time.Time
+	clearedFields     map[string]struct{}
+	report_run        *int
+	clearedreport_run bool
+	done              bool
+	oldValue          func(context.Context) (*DomainRun, error)
+	predicates        []predicate.DomainRun
+}
+
+var _ ent.Mutation = (*DomainRunMutation)(nil)
+
+// domainrunOption allows management of the mutation configuration using functional options.
+type domainrunOption func(*DomainRunMutation)
+
+// newDomainRunMutation creates new mutation for the DomainRun entity.
+func newDomainRunMutation(c config, op Op, opts ...domainrunOption) *DomainRunMutation {
+	m := &DomainRunMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDomainRun,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDomainRunID sets the ID field of the mutation.
+func withDomainRunID(id int) domainrunOption {
+	return func(m *DomainRunMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *DomainRun
+		)
+		m.oldValue = func(ctx context.Context) (*DomainRun, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().DomainRun.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDomainRun sets the old DomainRun of the mutation.
+func withDomainRun(node *DomainRun) domainrunOption {
+	return func(m *DomainRunMutation) {
+		m.oldValue = func(context.Context) (*DomainRun, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DomainRunMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DomainRunMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of DomainRun entities.
+func (m *DomainRunMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DomainRunMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DomainRunMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().DomainRun.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetRunID sets the "run_id" field.
+func (m *DomainRunMutation) SetRunID(i int) {
+	m.report_run = &i
+}
+
+// RunID returns the value of the "run_id" field in the mutation.
+func (m *DomainRunMutation) RunID() (r int, exists bool) {
+	v := m.report_run
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRunID returns the old "run_id" field's value of the DomainRun entity.
+// If the DomainRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DomainRunMutation) OldRunID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRunID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRunID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRunID: %w", err)
+	}
+	return oldValue.RunID, nil
+}
+
+// ClearRunID clears the value of the "run_id" field.
+func (m *DomainRunMutation) ClearRunID() {
+	m.report_run = nil
+	m.clearedFields[domainrun.FieldRunID] = struct{}{}
+}
+
+// RunIDCleared returns if the "run_id" field was cleared in this mutation.
+func (m *DomainRunMutation) RunIDCleared() bool {
+	_, ok := m.clearedFields[domainrun.FieldRunID]
+	return ok
+}
+
+// ResetRunID resets all changes to the "run_id" field.
+func (m *DomainRunMutation) ResetRunID() {
+	m.report_run = nil
+	delete(m.clearedFields, domainrun.FieldRunID)
+}
+
+// SetDomainName sets the "domain_name" field.
+func (m *DomainRunMutation) SetDomainName(s string) {
+	m.domain_name = &s
+}
+
+// DomainName returns the value of the "domain_name" field in the mutation.
+func (m *DomainRunMutation) DomainName() (r string, exists bool) {
+	v := m.domain_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDomainName returns the old "domain_name" field's value of the DomainRun entity.
+// If the DomainRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DomainRunMutation) OldDomainName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDomainName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDomainName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDomainName: %w", err)
+	}
+	return oldValue.DomainName, nil
+}
+
+// ResetDomainName resets all changes to the "domain_name" field.
+func (m *DomainRunMutation) ResetDomainName() {
+	m.domain_name = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *DomainRunMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *DomainRunMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the DomainRun entity.
+// If the DomainRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DomainRunMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *DomainRunMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetError sets the "error" field.
+func (m *DomainRunMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *DomainRunMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldError returns the old "error" field's value of the DomainRun entity.
+// If the DomainRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DomainRunMutation) OldError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
+}
+
+// ClearError clears the value of the "error" field.
+func (m *DomainRunMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[domainrun.FieldError] = struct{}{}
+}
+
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *DomainRunMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[domainrun.FieldError]
+	return ok
+}
+
+// ResetError resets all changes to the "error" field.
+func (m *DomainRunMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, domainrun.FieldError)
+}
+
+// SetArticleCount sets the "article_count" field.
+func (m *DomainRunMutation) SetArticleCount(i int) {
+	m.article_count = &i
+	m.addarticle_count = nil
+}
+
+// ArticleCount returns the value of the "article_count" field in the mutation.
+func (m *DomainRunMutation) ArticleCount() (r int, exists bool) {
+	v := m.article_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldArticleCount returns the old "article_count" field's value of the DomainRun entity.
+// If the DomainRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DomainRunMutation) OldArticleCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldArticleCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldArticleCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldArticleCount: %w", err)
+	}
+	return oldValue.ArticleCount, nil
+}
+
+// AddArticleCount adds i to the "article_count" field.
+func (m *DomainRunMutation) AddArticleCount(i int) {
+	if m.addarticle_count != nil {
+		*m.addarticle_count += i
+	} else {
+		m.addarticle_count = &i
+	}
+}
+
+// AddedArticleCount returns the value that was added to the "article_count" field in this mutation.
+func (m *DomainRunMutation) AddedArticleCount() (r int, exists bool) {
+	v := m.addarticle_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetArticleCount resets all changes to the "article_count" field.
+func (m *DomainRunMutation) ResetArticleCount() {
+	m.article_count = nil
+	m.addarticle_count = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *DomainRunMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *DomainRunMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the DomainRun entity.
+// If the DomainRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DomainRunMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *DomainRunMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetReportRunID sets the "report_run" edge to the ReportRun entity by id.
+func (m *DomainRunMutation) SetReportRunID(id int) {
+	m.report_run = &id
+}
+
+// ClearReportRun clears the "report_run" edge to the ReportRun entity.
+func (m *DomainRunMutation) ClearReportRun() {
+	m.clearedreport_run = true
+	m.clearedFields[domainrun.FieldRunID] = struct{}{}
+}
+
+// ReportRunCleared reports if the "report_run" edge to the ReportRun entity was cleared.
+func (m *DomainRunMutation) ReportRunCleared() bool {
+	return m.RunIDCleared() || m.clearedreport_run
+}
+
+// ReportRunID returns the "report_run" edge ID in the mutation.
+func (m *DomainRunMutation) ReportRunID() (id int, exists bool) {
+	if m.report_run != nil {
+		return *m.report_run, true
+	}
+	return
+}
+
+// ReportRunIDs returns the "report_run" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ReportRunID instead. It exists only for internal usage by the builders.
+func (m *DomainRunMutation) ReportRunIDs() (ids []int) {
+	if id := m.report_run; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetReportRun resets all changes to the "report_run" edge.
+func (m *DomainRunMutation) ResetReportRun() {
+	m.report_run = nil
+	m.clearedreport_run = false
+}
+
+// Where appends a list predicates to the DomainRunMutation builder.
+func (m *DomainRunMutation) Where(ps ...predicate.DomainRun) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DomainRunMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DomainRunMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DomainRun, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DomainRunMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DomainRunMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (DomainRun).
+func (m *DomainRunMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DomainRunMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.report_run != nil {
+		fields = append(fields, domainrun.FieldRunID)
+	}
+	if m.domain_name != nil {
+		fields = append(fields, domainrun.FieldDomainName)
+	}
+	if m.status != nil {
+		fields = append(fields, domainrun.FieldStatus)
+	}
+	if m.error != nil {
+		fields = append(fields, domainrun.FieldError)
+	}
+	if m.article_count != nil {
+		fields = append(fields, domainrun.FieldArticleCount)
+	}
+	if m.created_at != nil {
+		fields = append(fields, domainrun.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DomainRunMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case domainrun.FieldRunID:
+		return m.RunID()
+	case domainrun.FieldDomainName:
+		return m.DomainName()
+	case domainrun.FieldStatus:
+		return m.Status()
+	case domainrun.FieldError:
+		return m.Error()
+	case domainrun.FieldArticleCount:
+		return m.ArticleCount()
+	case domainrun.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DomainRunMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case domainrun.FieldRunID:
+		return m.OldRunID(ctx)
+	case domainrun.FieldDomainName:
+		return m.OldDomainName(ctx)
+	case domainrun.FieldStatus:
+		return m.OldStatus(ctx)
+	case domainrun.FieldError:
+		return m.OldError(ctx)
+	case domainrun.FieldArticleCount:
+		return m.OldArticleCount(ctx)
+	case domainrun.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown DomainRun field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DomainRunMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case domainrun.FieldRunID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRunID(v)
+		return nil
+	case domainrun.FieldDomainName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDomainName(v)
+		return nil
+	case domainrun.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case domainrun.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	case domainrun.FieldArticleCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetArticleCount(v)
+		return nil
+	case domainrun.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DomainRun field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DomainRunMutation) AddedFields() []string {
+	var fields []string
+	if m.addarticle_count != nil {
+		fields = append(fields, domainrun.FieldArticleCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DomainRunMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case domainrun.FieldArticleCount:
+		return m.AddedArticleCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DomainRunMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case domainrun.FieldArticleCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddArticleCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DomainRun numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DomainRunMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(domainrun.FieldRunID) {
+		fields = append(fields, domainrun.FieldRunID)
+	}
+	if m.FieldCleared(domainrun.FieldError) {
+		fields = append(fields, domainrun.FieldError)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DomainRunMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DomainRunMutation) ClearField(name string) error {
+	switch name {
+	case domainrun.FieldRunID:
+		m.ClearRunID()
+		return nil
+	case domainrun.FieldError:
+		m.ClearError()
+		return nil
+	}
+	return fmt.Errorf("unknown DomainRun nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DomainRunMutation) ResetField(name string) error {
+	switch name {
+	case domainrun.FieldRunID:
+		m.ResetRunID()
+		return nil
+	case domainrun.FieldDomainName:
+		m.ResetDomainName()
+		return nil
+	case domainrun.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case domainrun.FieldError:
+		m.ResetError()
+		return nil
+	case domainrun.FieldArticleCount:
+		m.ResetArticleCount()
+		return nil
+	case domainrun.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown DomainRun field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DomainRunMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.report_run != nil {
+		edges = append(edges, domainrun.EdgeReportRun)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DomainRunMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case domainrun.EdgeReportRun:
+		if id := m.report_run; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DomainRunMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DomainRunMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DomainRunMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedreport_run {
+		edges = append(edges, domainrun.EdgeReportRun)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DomainRunMutation) EdgeCleared(name string) bool {
+	switch name {
+	case domainrun.EdgeReportRun:
+		return m.clearedreport_run
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DomainRunMutation) ClearEdge(name string) error {
+	switch name {
+	case domainrun.EdgeReportRun:
+		m.ClearReportRun()
+		return nil
+	}
+	return fmt.Errorf("unknown DomainRun unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DomainRunMutation) ResetEdge(name string) error {
+	switch name {
+	case domainrun.EdgeReportRun:
+		m.ResetReportRun()
+		return nil
+	}
+	return fmt.Errorf("unknown DomainRun edge %s", name)
+}
+
+// EntityMutation represents an operation that mutates the Entity nodes in the graph.
+type EntityMutation struct {
+	config
+	op                      Op
+	typ                     string
+	id                      *int
+	name                    *string
+	_type                   *string
+	key                     *string
+	created_at              *time.Time
+	clearedFields           map[string]struct{}
+	article_entities        map[int]struct{}
+	removedarticle_entities map[int]struct{}
+	clearedarticle_entities bool
+	done                    bool
+	oldValue                func(context.Context) (*Entity, error)
+	predicates              []predicate.Entity
+}
+
+var _ ent.Mutation = (*EntityMutation)(nil)
+
+// entityOption allows management of the mutation configuration using functional options.
+type entityOption func(*EntityMutation)
+
+// newEntityMutation creates new mutation for the Entity entity.
+func newEntityMutation(c config, op Op, opts ...entityOption) *EntityMutation {
+	m := &EntityMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeEntity,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withEntityID sets the ID field of the mutation.
+func withEntityID(id int) entityOption {
+	return func(m *EntityMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Entity
+		)
+		m.oldValue = func(ctx context.Context) (*Entity, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Entity.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withEntity sets the old Entity of the mutation.
+func withEntity(node *Entity) entityOption {
+	return func(m *EntityMutation) {
+		m.oldValue = func(context.Context) (*Entity, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m EntityMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m EntityMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Entity entities.
+func (m *EntityMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *EntityMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *EntityMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Entity.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *EntityMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *EntityMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Entity entity.
+// If the Entity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EntityMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *EntityMutation) ResetName() {
+	m.name = nil
+}
+
+// SetType sets the "type" field.
+func (m *EntityMutation) SetType(s string) {
+	m._type = &s
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *EntityMutation) GetType() (r string, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the Entity entity.
+// If the Entity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EntityMutation) OldType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *EntityMutation) ResetType() {
+	m._type = nil
+}
+
+// SetKey sets the "key" field.
+func (m *EntityMutation) SetKey(s string) {
+	m.key = &s
+}
+
+// Key returns the value of the "key" field in the mutation.
+func (m *EntityMutation) Key() (r string, exists bool) {
+	v := m.key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKey returns the old "key" field's value of the Entity entity.
+// If the Entity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EntityMutation) OldKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
+	}
+	return oldValue.Key, nil
+}
+
+// ResetKey resets all changes to the "key" field.
+func (m *EntityMutation) ResetKey() {
+	m.key = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *EntityMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *EntityMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Entity entity.
+// If the Entity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EntityMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *EntityMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// AddArticleEntityIDs adds the "article_entities" edge to the ArticleEntity entity by ids.
+func (m *EntityMutation) AddArticleEntityIDs(ids ...int) {
+	if m.article_entities == nil {
+		m.article_entities = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.article_entities[ids[i]] = struct{}{}
+	}
+}
+
+// ClearArticleEntities clears the "article_entities" edge to the ArticleEntity entity.
+func (m *EntityMutation) ClearArticleEntities() {
+	m.clearedarticle_entities = true
+}
+
+// ArticleEntitiesCleared reports if the "article_entities" edge to the ArticleEntity entity was cleared.
+func (m *EntityMutation) ArticleEntitiesCleared() bool {
+	return m.clearedarticle_entities
+}
+
+// RemoveArticleEntityIDs removes the "article_entities" edge to the ArticleEntity entity by IDs.
+func (m *EntityMutation) RemoveArticleEntityIDs(ids ...int) {
+	if m.removedarticle_entities == nil {
+		m.removedarticle_entities = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.article_entities, ids[i])
+		m.removedarticle_entities[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedArticleEntities returns the removed IDs of the "article_entities" edge to the ArticleEntity entity.
+func (m *EntityMutation) RemovedArticleEntitiesIDs() (ids []int) {
+	for id := range m.removedarticle_entities {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ArticleEntitiesIDs returns the "article_entities" edge IDs in the mutation.
+func (m *EntityMutation) ArticleEntitiesIDs() (ids []int) {
+	for id := range m.article_entities {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetArticleEntities resets all changes to the "article_entities" edge.
+func (m *EntityMutation) ResetArticleEntities() {
+	m.article_entities = nil
+	m.clearedarticle_entities = false
+	m.removedarticle_entities = nil
+}
+
+// Where appends a list predicates to the EntityMutation builder.
+func (m *EntityMutation) Where(ps ...predicate.Entity) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the EntityMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EntityMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Entity, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *EntityMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *EntityMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Entity).
+func (m *EntityMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EntityMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.name != nil {
+		fields = append(fields, entity.FieldName)
+	}
+	if m._type != nil {
+		fields = append(fields, entity.FieldType)
+	}
+	if m.key != nil {
+		fields = append(fields, entity.FieldKey)
+	}
+	if m.created_at != nil {
+		fields = append(fields, entity.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EntityMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case entity.FieldName:
+		return m.Name()
+	case entity.FieldType:
+		return m.GetType()
+	case entity.FieldKey:
+		return m.Key()
+	case entity.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *EntityMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case entity.FieldName:
+		return m.OldName(ctx)
+	case entity.FieldType:
+		return m.OldType(ctx)
+	case entity.FieldKey:
+		return m.OldKey(ctx)
+	case entity.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Entity field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EntityMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case entity.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case entity.FieldType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case entity.FieldKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKey(v)
+		return nil
+	case entity.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Entity field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *EntityMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *EntityMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EntityMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Entity numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *EntityMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *EntityMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EntityMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Entity nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *EntityMutation) ResetField(name string) error {
+	switch name {
+	case entity.FieldName:
+		m.ResetName()
+		return nil
+	case entity.FieldType:
+		m.ResetType()
+		return nil
+	case entity.FieldKey:
+		m.ResetKey()
+		return nil
+	case entity.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Entity field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *EntityMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.article_entities != nil {
+		edges = append(edges, entity.EdgeArticleEntities)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EntityMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case entity.EdgeArticleEntities:
+		ids := make([]ent.Value, 0, len(m.article_entities))
+		for id := range m.article_entities {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *EntityMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedarticle_entities != nil {
+		edges = append(edges, entity.EdgeArticleEntities)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EntityMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case entity.EdgeArticleEntities:
+		ids := make([]ent.Value, 0, len(m.removedarticle_entities))
+		for id := range m.removedarticle_entities {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EntityMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedarticle_entities {
+		edges = append(edges, entity.EdgeArticleEntities)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EntityMutation) EdgeCleared(name string) bool {
+	switch name {
+	case entity.EdgeArticleEntities:
+		return m.clearedarticle_entities
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EntityMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Entity unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EntityMutation) ResetEdge(name string) error {
+	switch name {
+	case entity.EdgeArticleEntities:
+		m.ResetArticleEntities()
+		return nil
+	}
+	return fmt.Errorf("unknown Entity edge %s", name)
+}
+
+// KeyEventMutation represents an operation that mutates the KeyEvent nodes in the graph.
+type KeyEventMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *int
+	event_content        *string
+	verdict              *string
+	clearedFields        map[string]struct{}
+	domain_report        *int
+	cleareddomain_report bool
+	articles             map[int]struct{}
+	removedarticles      map[int]struct{}
+	clearedarticles      bool
+	done                 bool
+	oldValue             func(context.Context) (*KeyEvent, error)
+	predicates           []predicate.KeyEvent
+}
+
+var _ ent.Mutation = (*KeyEventMutation)(nil)
+
+// keyeventOption allows management of the mutation configuration using functional options.
+type keyeventOption func(*KeyEventMutation)
+
+// newKeyEventMutation creates new mutation for the KeyEvent entity.
+func newKeyEventMutation(c config, op Op, opts ...keyeventOption) *KeyEventMutation {
+	m := &KeyEventMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeKeyEvent,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withKeyEventID sets the ID field of the mutation.
+func withKeyEventID(id int) keyeventOption {
+	return func(m *KeyEventMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *KeyEvent
+		)
+		m.oldValue = func(ctx context.Context) (*KeyEvent, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().KeyEvent.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withKeyEvent sets the old KeyEvent of the mutation.
+func withKeyEvent(node *KeyEvent) keyeventOption {
+	return func(m *KeyEventMutation) {
+		m.oldValue = func(context.Context) (*KeyEvent, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m KeyEventMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m KeyEventMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of KeyEvent entities.
+func (m *KeyEventMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *KeyEventMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *KeyEventMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().KeyEvent.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetDomainReportID sets the "domain_report_id" field.
+func (m *KeyEventMutation) SetDomainReportID(i int) {
+	m.domain_report = &i
+}
+
+// DomainReportID returns the value of the "domain_report_id" field in the mutation.
+func (m *KeyEventMutation) DomainReportID() (r int, exists bool) {
+	v := m.domain_report
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDomainReportID returns the old "domain_report_id" field's value of the KeyEvent entity.
+// If the KeyEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeyEventMutation) OldDomainReportID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDomainReportID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDomainReportID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDomainReportID: %w", err)
+	}
+	return oldValue.DomainReportID, nil
+}
+
+// ClearDomainReportID clears the value of the "domain_report_id" field.
+func (m *KeyEventMutation) ClearDomainReportID() {
+	m.domain_report = nil
+	m.clearedFields[keyevent.FieldDomainReportID] = struct{}{}
+}
+
+// DomainReportIDCleared returns if the "domain_report_id" field was cleared in this mutation.
+func (m *KeyEventMutation) DomainReportIDCleared() bool {
+	_, ok := m.clearedFields[keyevent.FieldDomainReportID]
+	return ok
+}
+
+// ResetDomainReportID resets all changes to the "domain_report_id" field.
+func (m *KeyEventMutation) ResetDomainReportID() {
+	m.domain_report = nil
+	delete(m.clearedFields, keyevent.FieldDomainReportID)
+}
+
+// SetEventContent sets the "event_content" field.
+func (m *KeyEventMutation) SetEventContent(s string) {
+	m.event_content = &s
+}
+
+// EventContent returns the value of the "event_content" field in the mutation.
+func (m *KeyEventMutation) EventContent() (r string, exists bool) {
+	v := m.event_content
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEventContent returns the old "event_content" field's value of the KeyEvent entity.
+// If the KeyEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeyEventMutation) OldEventContent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEventContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEventContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEventContent: %w", err)
+	}
+	return oldValue.EventContent, nil
+}
+
+// ClearEventContent clears the value of the "event_content" field.
+func (m *KeyEventMutation) ClearEventContent() {
+	m.event_content = nil
+	m.clearedFields[keyevent.FieldEventContent] = struct{}{}
+}
+
+// EventContentCleared returns if the "event_content" field was cleared in this mutation.
+func (m *KeyEventMutation) EventContentCleared() bool {
+	_, ok := m.clearedFields[keyevent.FieldEventContent]
+	return ok
+}
+
+// ResetEventContent resets all changes to the "event_content" field.
+func (m *KeyEventMutation) ResetEventContent() {
+	m.event_content = nil
+	delete(m.clearedFields, keyevent.FieldEventContent)
+}
+
+// SetVerdict sets the "verdict" field.
+func (m *KeyEventMutation) SetVerdict(s string) {
+	m.verdict = &s
+}
+
+// Verdict returns the value of the "verdict" field in the mutation.
+func (m *KeyEventMutation) Verdict() (r string, exists bool) {
+	v := m.verdict
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVerdict returns the old "verdict" field's value of the KeyEvent entity.
+// If the KeyEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeyEventMutation) OldVerdict(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVerdict is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVerdict requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVerdict: %w", err)
+	}
+	return oldValue.Verdict, nil
+}
+
+// ClearVerdict clears the value of the "verdict" field.
+func (m *KeyEventMutation) ClearVerdict() {
+	m.verdict = nil
+	m.clearedFields[keyevent.FieldVerdict] = struct{}{}
+}
+
+// VerdictCleared returns if the "verdict" field was cleared in this mutation.
+func (m *KeyEventMutation) VerdictCleared() bool {
+	_, ok := m.clearedFields[keyevent.FieldVerdict]
+	return ok
+}
+
+// ResetVerdict resets all changes to the "verdict" field.
+func (m *KeyEventMutation) ResetVerdict() {
+	m.verdict = nil
+	delete(m.clearedFields, keyevent.FieldVerdict)
+}
+
+// ClearDomainReport clears the "domain_report" edge to the DomainReport entity.
+func (m *KeyEventMutation) ClearDomainReport() {
+	m.cleareddomain_report = true
+	m.clearedFields[keyevent.FieldDomainReportID] = struct{}{}
+}
+
+// DomainReportCleared reports if the "domain_report" edge to the DomainReport entity was cleared.
+func (m *KeyEventMutation) DomainReportCleared() bool {
+	return m.DomainReportIDCleared() || m.cleareddomain_report
+}
+
+// DomainReportIDs returns the "domain_report" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// DomainReportID instead. It exists only for internal usage by the builders.
+func (m *KeyEventMutation) DomainReportIDs() (ids []int) {
+	if id := m.domain_report; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetDomainReport resets all changes to the "domain_report" edge.
+func (m *KeyEventMutation) ResetDomainReport() {
+	m.domain_report = nil
+	m.cleareddomain_report = false
+}
+
+// AddArticleIDs adds the "articles" edge to the Article entity by ids.
+func (m *KeyEventMutation) AddArticleIDs(ids ...int) {
+	if m.articles == nil {
+		m.articles = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.articles[ids[i]] = struct{}{}
+	}
+}
+
+// ClearArticles clears the "articles" edge to the Article entity.
+func (m *KeyEventMutation) ClearArticles() {
+	m.clearedarticles = true
+}
+
+// ArticlesCleared reports if the "articles" edge to the Article entity was cleared.
+func (m *KeyEventMutation) ArticlesCleared() bool {
+	return m.clearedarticles
+}
+
+// RemoveArticleIDs removes the "articles" edge to the Article entity by IDs.
+func (m *KeyEventMutation) RemoveArticleIDs(ids ...int) {
+	if m.removedarticles == nil {
+		m.removedarticles = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.articles, ids[i])
+		m.removedarticles[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedArticles returns the removed IDs of the "articles" edge to the Article entity.
+func (m *KeyEventMutation) RemovedArticlesIDs() (ids []int) {
+	for id := range m.removedarticles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ArticlesIDs returns the "articles" edge IDs in the mutation.
+func (m *KeyEventMutation) ArticlesIDs() (ids []int) {
+	for id := range m.articles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetArticles resets all changes to the "articles" edge.
+func (m *KeyEventMutation) ResetArticles() {
+	m.articles = nil
+	m.clearedarticles = false
+	m.removedarticles = nil
+}
+
+// Where appends a list predicates to the KeyEventMutation builder.
+func (m *KeyEventMutation) Where(ps ...predicate.KeyEvent) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the KeyEventMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *KeyEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.KeyEvent, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *KeyEventMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *KeyEventMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (KeyEvent).
+func (m *KeyEventMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *KeyEventMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.domain_report != nil {
+		fields = append(fields, keyevent.FieldDomainReportID)
+	}
+	if m.event_content != nil {
+		fields = append(fields, keyevent.FieldEventContent)
+	}
+	if m.verdict != nil {
+		fields = append(fields, keyevent.FieldVerdict)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *KeyEventMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case keyevent.FieldDomainReportID:
+		return m.DomainReportID()
+	case keyevent.FieldEventContent:
+		return m.EventContent()
+	case keyevent.FieldVerdict:
+		return m.Verdict()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *KeyEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case keyevent.FieldDomainReportID:
+		return m.OldDomainReportID(ctx)
+	case keyevent.FieldEventContent:
+		return m.OldEventContent(ctx)
+	case keyevent.FieldVerdict:
+		return m.OldVerdict(ctx)
+	}
+	return nil, fmt.Errorf("unknown KeyEvent field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *KeyEventMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case keyevent.FieldDomainReportID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDomainReportID(v)
+		return nil
+	case keyevent.FieldEventContent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEventContent(v)
+		return nil
+	case keyevent.FieldVerdict:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVerdict(v)
+		return nil
+	}
+	return fmt.Errorf("unknown KeyEvent field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *KeyEventMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *KeyEventMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *KeyEventMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown KeyEvent numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *KeyEventMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(keyevent.FieldDomainReportID) {
+		fields = append(fields, keyevent.FieldDomainReportID)
+	}
+	if m.FieldCleared(keyevent.FieldEventContent) {
+		fields = append(fields, keyevent.FieldEventContent)
+	}
+	if m.FieldCleared(keyevent.FieldVerdict) {
+		fields = append(fields, keyevent.FieldVerdict)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *KeyEventMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *KeyEventMutation) ClearField(name string) error {
+	switch name {
+	case keyevent.FieldDomainReportID:
+		m.ClearDomainReportID()
+		return nil
+	case keyevent.FieldEventContent:
+		m.ClearEventContent()
+		return nil
+	case keyevent.FieldVerdict:
+		m.ClearVerdict()
+		return nil
+	}
+	return fmt.Errorf("unknown KeyEvent nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *KeyEventMutation) ResetField(name string) error {
+	switch name {
+	case keyevent.FieldDomainReportID:
+		m.ResetDomainReportID()
+		return nil
+	case keyevent.FieldEventContent:
+		m.ResetEventContent()
+		return nil
+	case keyevent.FieldVerdict:
+		m.ResetVerdict()
+		return nil
+	}
+	return fmt.Errorf("unknown KeyEvent field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *KeyEventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.domain_report != nil {
+		edges = append(edges, keyevent.EdgeDomainReport)
+	}
+	if m.articles != nil {
+		edges = append(edges, keyevent.EdgeArticles)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *KeyEventMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case keyevent.EdgeDomainReport:
+		if id := m.domain_report; id != nil {
+			return []ent.Value{*id}
+		}
+	case keyevent.EdgeArticles:
+		ids := make([]ent.Value, 0, len(m.articles))
+		for id := range m.articles {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *KeyEventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedarticles != nil {
+		edges = append(edges, keyevent.EdgeArticles)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *KeyEventMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case keyevent.EdgeArticles:
+		ids := make([]ent.Value, 0, len(m.removedarticles))
+		for id := range m.removedarticles {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *KeyEventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareddomain_report {
+		edges = append(edges, keyevent.EdgeDomainReport)
+	}
+	if m.clearedarticles {
+		edges = append(edges, keyevent.EdgeArticles)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *KeyEventMutation) EdgeCleared(name string) bool {
+	switch name {
+	case keyevent.EdgeDomainReport:
+		return m.cleareddomain_report
+	case keyevent.EdgeArticles:
+		return m.clearedarticles
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *KeyEventMutation) ClearEdge(name string) error {
+	switch name {
+	case keyevent.EdgeDomainReport:
+		m.ClearDomainReport()
+		return nil
+	}
+	return fmt.Errorf("unknown KeyEvent unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *KeyEventMutation) ResetEdge(name string) error {
+	switch name {
+	case keyevent.EdgeDomainReport:
+		m.ResetDomainReport()
+		return nil
+	case keyevent.EdgeArticles:
+		m.ResetArticles()
+		return nil
+	}
+	return fmt.Errorf("unknown KeyEvent edge %s", name)
+}
+
+// LLMCacheMutation represents an operation that mutates the LLMCache nodes in the graph.
+type LLMCacheMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *int
+	key                  *string
+	model                *string
+	template_version     *string
+	content              *string
+	prompt_tokens        *int
+	addprompt_tokens     *int
+	completion_tokens    *int
+	addcompletion_tokens *int
+	hits                 *int
+	addhits              *int
+	expires_at           *time.Time
+	created_at           *time.Time
+	clearedFields        map[string]struct{}
+	done                 bool
+	oldValue             func(context.Context) (*LLMCache, error)
+	predicates           []predicate.LLMCache
+}
+
+var _ ent.Mutation = (*LLMCacheMutation)(nil)
+
+// llmcacheOption allows management of the mutation configuration using functional options.
+type llmcacheOption func(*LLMCacheMutation)
+
+// newLLMCacheMutation creates new mutation for the LLMCache entity.
+func newLLMCacheMutation(c config, op Op, opts ...llmcacheOption) *LLMCacheMutation {
+	m := &LLMCacheMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeLLMCache,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withLLMCacheID sets the ID field of the mutation.
+func withLLMCacheID(id int) llmcacheOption {
+	return func(m *LLMCacheMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *LLMCache
+		)
+		m.oldValue = func(ctx context.Context) (*LLMCache, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().LLMCache.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withLLMCache sets the old LLMCache of the mutation.
+func withLLMCache(node *LLMCache) llmcacheOption {
+	return func(m *LLMCacheMutation) {
+		m.oldValue = func(context.Context) (*LLMCache, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m LLMCacheMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m LLMCacheMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of LLMCache entities.
+func (m *LLMCacheMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *LLMCacheMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *LLMCacheMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().LLMCache.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetKey sets the "key" field.
+func (m *LLMCacheMutation) SetKey(s string) {
+	m.key = &s
+}
+
+// Key returns the value of the "key" field in the mutation.
+func (m *LLMCacheMutation) Key() (r string, exists bool) {
+	v := m.key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKey returns the old "key" field's value of the LLMCache entity.
+// If the LLMCache object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LLMCacheMutation) OldKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
+	}
+	return oldValue.Key, nil
+}
+
+// ResetKey resets all changes to the "key" field.
+func (m *LLMCacheMutation) ResetKey() {
+	m.key = nil
+}
+
+// SetModel sets the "model" field.
+func (m *LLMCacheMutation) SetModel(s string) {
+	m.model = &s
+}
+
+// Model returns the value of the "model" field in the mutation.
+func (m *LLMCacheMutation) Model() (r string, exists bool) {
+	v := m.model
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModel returns the old "model" field's value of the LLMCache entity.
+// If the LLMCache object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LLMCacheMutation) OldModel(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModel: %w", err)
+	}
+	return oldValue.Model, nil
+}
+
+// ResetModel resets all changes to the "model" field.
+func (m *LLMCacheMutation) ResetModel() {
+	m.model = nil
+}
+
+// SetTemplateVersion sets the "template_version" field.
+func (m *LLMCacheMutation) SetTemplateVersion(s string) {
+	m.template_version = &s
+}
+
+// TemplateVersion returns the value of the "template_version" field in the mutation.
+func (m *LLMCacheMutation) TemplateVersion() (r string, exists bool) {
+	v := m.template_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTemplateVersion returns the old "template_version" field's value of the LLMCache entity.
+// If the LLMCache object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LLMCacheMutation) OldTemplateVersion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTemplateVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTemplateVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTemplateVersion: %w", err)
+	}
+	return oldValue.TemplateVersion, nil
+}
+
+// ResetTemplateVersion resets all changes to the "template_version" field.
+func (m *LLMCacheMutation) ResetTemplateVersion() {
+	m.template_version = nil
+}
+
+// SetContent sets the "content" field.
+func (m *LLMCacheMutation) SetContent(s string) {
+	m.content = &s
+}
+
+// Content returns the value of the "content" field in the mutation.
+func (m *LLMCacheMutation) Content() (r string, exists bool) {
+	v := m.content
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContent returns the old "content" field's value of the LLMCache entity.
+// If the LLMCache object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LLMCacheMutation) OldContent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+	}
+	return oldValue.Content, nil
+}
+
+// ResetContent resets all changes to the "content" field.
+func (m *LLMCacheMutation) ResetContent() {
+	m.content = nil
+}
+
+// SetPromptTokens sets the "prompt_tokens" field.
+func (m *LLMCacheMutation) SetPromptTokens(i int) {
+	m.prompt_tokens = &i
+	m.addprompt_tokens = nil
+}
+
+// PromptTokens returns the value of the "prompt_tokens" field in the mutation.
+func (m *LLMCacheMutation) PromptTokens() (r int, exists bool) {
+	v := m.prompt_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPromptTokens returns the old "prompt_tokens" field's value of the LLMCache entity.
+// If the LLMCache object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LLMCacheMutation) OldPromptTokens(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPromptTokens is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPromptTokens requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPromptTokens: %w", err)
+	}
+	return oldValue.PromptTokens, nil
+}
+
+// AddPromptTokens adds i to the "prompt_tokens" field.
+func (m *LLMCacheMutation) AddPromptTokens(i int) {
+	if m.addprompt_tokens != nil {
+		*m.addprompt_tokens += i
+	} else {
+		m.addprompt_tokens = &i
+	}
+}
+
+// AddedPromptTokens returns the value that was added to the "prompt_tokens" field in this mutation.
+func (m *LLMCacheMutation) AddedPromptTokens() (r int, exists bool) {
+	v := m.addprompt_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPromptTokens resets all changes to the "prompt_tokens" field.
+func (m *LLMCacheMutation) ResetPromptTokens() {
+	m.prompt_tokens = nil
+	m.addprompt_tokens = nil
+}
+
+// SetCompletionTokens sets the "completion_tokens" field.
+func (m *LLMCacheMutation) SetCompletionTokens(i int) {
+	m.completion_tokens = &i
+	m.addcompletion_tokens = nil
+}
+
+// CompletionTokens returns the value of the "completion_tokens" field in the mutation.
+func (m *LLMCacheMutation) CompletionTokens() (r int, exists bool) {
+	v := m.completion_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCompletionTokens returns the old "completion_tokens" field's value of the LLMCache entity.
+// If the LLMCache object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LLMCacheMutation) OldCompletionTokens(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompletionTokens is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompletionTokens requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompletionTokens: %w", err)
+	}
+	return oldValue.CompletionTokens, nil
+}
+
+// AddCompletionTokens adds i to the "completion_tokens" field.
+func (m *LLMCacheMutation) AddCompletionTokens(i int) {
+	if m.addcompletion_tokens != nil {
+		*m.addcompletion_tokens += i
+	} else {
+		m.addcompletion_tokens = &i
+	}
+}
+
+// AddedCompletionTokens returns the value that was added to the "completion_tokens" field in this mutation.
+func (m *LLMCacheMutation) AddedCompletionTokens() (r int, exists bool) {
+	v := m.addcompletion_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCompletionTokens resets all changes to the "completion_tokens" field.
+func (m *LLMCacheMutation) ResetCompletionTokens() {
+	m.completion_tokens = nil
+	m.addcompletion_tokens = nil
+}
+
+// SetHits sets the "hits" field.
+func (m *LLMCacheMutation) SetHits(i int) {
+	m.hits = &i
+	m.addhits = nil
+}
+
+// Hits returns the value of the "hits" field in the mutation.
+func (m *LLMCacheMutation) Hits() (r int, exists bool) {
+	v := m.hits
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHits returns the old "hits" field's value of the LLMCache entity.
+// If the LLMCache object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LLMCacheMutation) OldHits(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHits is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHits requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHits: %w", err)
+	}
+	return oldValue.Hits, nil
+}
+
+// AddHits adds i to the "hits" field.
+func (m *LLMCacheMutation) AddHits(i int) {
+	if m.addhits != nil {
+		*m.addhits += i
+	} else {
+		m.addhits = &i
+	}
+}
+
+// AddedHits returns the value that was added to the "hits" field in this mutation.
+func (m *LLMCacheMutation) AddedHits() (r int, exists bool) {
+	v := m.addhits
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetHits resets all changes to the "hits" field.
+func (m *LLMCacheMutation) ResetHits() {
+	m.hits = nil
+	m.addhits = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *LLMCacheMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *LLMCacheMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the LLMCache entity.
+// If the LLMCache object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LLMCacheMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *LLMCacheMutation) ResetExpiresAt() {
+	m.expires_at = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *LLMCacheMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *LLMCacheMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the LLMCache entity.
+// If the LLMCache object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LLMCacheMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *LLMCacheMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the LLMCacheMutation builder.
+func (m *LLMCacheMutation) Where(ps ...predicate.LLMCache) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the LLMCacheMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *LLMCacheMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.LLMCache, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *LLMCacheMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *LLMCacheMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (LLMCache).
+func (m *LLMCacheMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LLMCacheMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.key != nil {
+		fields = append(fields, llmcache.FieldKey)
+	}
+	if m.model != nil {
+		fields = append(fields, llmcache.FieldModel)
+	}
+	if m.template_version != nil {
+		fields = append(fields, llmcache.FieldTemplateVersion)
+	}
+	if m.content != nil {
+		fields = append(fields, llmcache.FieldContent)
+	}
+	if m.prompt_tokens != nil {
+		fields = append(fields, llmcache.FieldPromptTokens)
+	}
+	if m.completion_tokens != nil {
+		fields = append(fields, llmcache.FieldCompletionTokens)
+	}
+	if m.hits != nil {
+		fields = append(fields, llmcache.FieldHits)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, llmcache.FieldExpiresAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, llmcache.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LLMCacheMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case llmcache.FieldKey:
+		return m.Key()
+	case llmcache.FieldModel:
+		return m.Model()
+	case llmcache.FieldTemplateVersion:
+		return m.TemplateVersion()
+	case llmcache.FieldContent:
+		return m.Content()
+	case llmcache.FieldPromptTokens:
+		return m.PromptTokens()
+	case llmcache.FieldCompletionTokens:
+		return m.CompletionTokens()
+	case llmcache.FieldHits:
+		return m.Hits()
+	case llmcache.FieldExpiresAt:
+		return m.ExpiresAt()
+	case llmcache.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LLMCacheMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case llmcache.FieldKey:
+		return m.OldKey(ctx)
+	case llmcache.FieldModel:
+		return m.OldModel(ctx)
+	case llmcache.FieldTemplateVersion:
+		return m.OldTemplateVersion(ctx)
+	case llmcache.FieldContent:
+		return m.OldContent(ctx)
+	case llmcache.FieldPromptTokens:
+		return m.OldPromptTokens(ctx)
+	case llmcache.FieldCompletionTokens:
+		return m.OldCompletionTokens(ctx)
+	case llmcache.FieldHits:
+		return m.OldHits(ctx)
+	case llmcache.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case llmcache.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown LLMCache field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LLMCacheMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case llmcache.FieldKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKey(v)
+		return nil
+	case llmcache.FieldModel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModel(v)
+		return nil
+	case llmcache.FieldTemplateVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTemplateVersion(v)
+		return nil
+	case llmcache.FieldContent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContent(v)
+		return nil
+	case llmcache.FieldPromptTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPromptTokens(v)
+		return nil
+	case llmcache.FieldCompletionTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompletionTokens(v)
+		return nil
+	case llmcache.FieldHits:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHits(v)
+		return nil
+	case llmcache.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case llmcache.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LLMCache field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LLMCacheMutation) AddedFields() []string {
+	var fields []string
+	if m.addprompt_tokens != nil {
+		fields = append(fields, llmcache.FieldPromptTokens)
+	}
+	if m.addcompletion_tokens != nil {
+		fields = append(fields, llmcache.FieldCompletionTokens)
+	}
+	if m.addhits != nil {
+		fields = append(fields, llmcache.FieldHits)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LLMCacheMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case llmcache.FieldPromptTokens:
+		return m.AddedPromptTokens()
+	case llmcache.FieldCompletionTokens:
+		return m.AddedCompletionTokens()
+	case llmcache.FieldHits:
+		return m.AddedHits()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LLMCacheMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case llmcache.FieldPromptTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPromptTokens(v)
+		return nil
+	case llmcache.FieldCompletionTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCompletionTokens(v)
+		return nil
+	case llmcache.FieldHits:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddHits(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LLMCache numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LLMCacheMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LLMCacheMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LLMCacheMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown LLMCache nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LLMCacheMutation) ResetField(name string) error {
+	switch name {
+	case llmcache.FieldKey:
+		m.ResetKey()
+		return nil
+	case llmcache.FieldModel:
+		m.ResetModel()
+		return nil
+	case llmcache.FieldTemplateVersion:
+		m.ResetTemplateVersion()
+		return nil
+	case llmcache.FieldContent:
+		m.ResetContent()
+		return nil
+	case llmcache.FieldPromptTokens:
+		m.ResetPromptTokens()
+		return nil
+	case llmcache.FieldCompletionTokens:
+		m.ResetCompletionTokens()
+		return nil
+	case llmcache.FieldHits:
+		m.ResetHits()
+		return nil
+	case llmcache.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case llmcache.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown LLMCache field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LLMCacheMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LLMCacheMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LLMCacheMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LLMCacheMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LLMCacheMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LLMCacheMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LLMCacheMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown LLMCache unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LLMCacheMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown LLMCache edge %s", name)
+}
+
+// LLMCallMutation represents an operation that mutates the LLMCall nodes in the graph.
+type LLMCallMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *int
+	user_id              *int
+	adduser_id           *int
+	domain               *string
+	stage                *string
+	model                *string
+	prompt_tokens        *int
+	addprompt_tokens     *int
+	completion_tokens    *int
+	addcompletion_tokens *int
+	total_tokens         *int
+	addtotal_tokens      *int
+	cost                 *float64
+	addcost              *float64
+	latency_ms           *int64
+	addlatency_ms        *int64
+	success              *bool
+	error                *string
+	created_at           *time.Time
+	clearedFields        map[string]struct{}
+	report_run           *int
+	clearedreport_run    bool
+	done                 bool
+	oldValue             func(context.Context) (*LLMCall, error)
+	predicates           []predicate.LLMCall
+}
+
+var _ ent.Mutation = (*LLMCallMutation)(nil)
+
+// llmcallOption allows management of the mutation configuration using functional options.
+type llmcallOption func(*LLMCallMutation)
+
+// newLLMCallMutation creates new mutation for the LLMCall entity.
+func newLLMCallMutation(c config, op Op, opts ...llmcallOption) *LLMCallMutation {
+	m := &LLMCallMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeLLMCall,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withLLMCallID sets the ID field of the mutation.
+func withLLMCallID(id int) llmcallOption {
+	return func(m *LLMCallMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *LLMCall
+		)
+		m.oldValue = func(ctx context.Context) (*LLMCall, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().LLMCall.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withLLMCall sets the old LLMCall of the mutation.
+func withLLMCall(node *LLMCall) llmcallOption {
+	return func(m *LLMCallMutation) {
+		m.oldValue = func(context.Context) (*LLMCall, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m LLMCallMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m LLMCallMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of LLMCall entities.
+func (m *LLMCallMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *LLMCallMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *LLMCallMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().LLMCall.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetRunID sets the "run_id" field.
+func (m *LLMCallMutation) SetRunID(i int) {
+	m.report_run = &i
+}
+
+// RunID returns the value of the "run_id" field in the mutation.
+func (m *LLMCallMutation) RunID() (r int, exists bool) {
+	v := m.report_run
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRunID returns the old "run_id" field's value of the LLMCall entity.
+// If the LLMCall object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LLMCallMutation) OldRunID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRunID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRunID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRunID: %w", err)
+	}
+	return oldValue.RunID, nil
+}
+
+// ClearRunID clears the value of the "run_id" field.
+func (m *LLMCallMutation) ClearRunID() {
+	m.report_run = nil
+	m.clearedFields[llmcall.FieldRunID] = struct{}{}
+}
+
+// RunIDCleared returns if the "run_id" field was cleared in this mutation.
+func (m *LLMCallMutation) RunIDCleared() bool {
+	_, ok := m.clearedFields[llmcall.FieldRunID]
+	return ok
+}
+
+// ResetRunID resets all changes to the "run_id" field.
+func (m *LLMCallMutation) ResetRunID() {
+	m.report_run = nil
+	delete(m.clearedFields, llmcall.FieldRunID)
+}
+
+// SetUserID sets the "user_id" field.
+func (m *LLMCallMutation) SetUserID(i int) {
+	m.user_id = &i
+	m.adduser_id = nil
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *LLMCallMutation) UserID() (r int, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the LLMCall entity.
+// If the LLMCall object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LLMCallMutation) OldUserID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// AddUserID adds i to the "user_id" field.
+func (m *LLMCallMutation) AddUserID(i int) {
+	if m.adduser_id != nil {
+		*m.adduser_id += i
+	} else {
+		m.adduser_id = &i
+	}
+}
+
+// AddedUserID returns the value that was added to the "user_id" field in this mutation.
+func (m *LLMCallMutation) AddedUserID() (r int, exists bool) {
+	v := m.adduser_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (m *LLMCallMutation) ClearUserID() {
+	m.user_id = nil
+	m.adduser_id = nil
+	m.clearedFields[llmcall.FieldUserID] = struct{}{}
+}
+
+// UserIDCleared returns if the "user_id" field was cleared in this mutation.
+func (m *LLMCallMutation) UserIDCleared() bool {
+	_, ok := m.clearedFields[llmcall.FieldUserID]
+	return ok
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *LLMCallMutation) ResetUserID() {
+	m.user_id = nil
+	m.adduser_id = nil
+	delete(m.clearedFields, llmcall.FieldUserID)
+}
+
+// SetDomain sets the "domain" field.
+func (m *LLMCallMutation) SetDomain(s string) {
+	m.domain = &s
+}
+
+// Domain returns the value of the "domain" field in the mutation.
+func (m *LLMCallMutation) Domain() (r string, exists bool) {
+	v := m.domain
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDomain returns the old "domain" field's value of the LLMCall entity.
+// If the LLMCall object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LLMCallMutation) OldDomain(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDomain is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDomain requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDomain: %w", err)
+	}
+	return oldValue.Domain, nil
+}
+
+// ClearDomain clears the value of the "domain" field.
+func (m *LLMCallMutation) ClearDomain() {
+	m.domain = nil
+	m.clearedFields[llmcall.FieldDomain] = struct{}{}
+}
+
+// DomainCleared returns if the "domain" field was cleared in this mutation.
+func (m *LLMCallMutation) DomainCleared() bool {
+	_, ok := m.clearedFields[llmcall.FieldDomain]
+	return ok
+}
+
+// ResetDomain resets all changes to the "domain" field.
+func (m *LLMCallMutation) ResetDomain() {
+	m.domain = nil
+	delete(m.clearedFields, llmcall.FieldDomain)
+}
+
+// SetStage sets the "stage" field.
+func (m *LLMCallMutation) SetStage(s string) {
+	m.stage = &s
+}
+
+// Stage returns the value of the "stage" field in the mutation.
+func (m *LLMCallMutation) Stage() (r string, exists bool) {
+	v := m.stage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStage returns the old "stage" field's value of the LLMCall entity.
+// If the LLMCall object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LLMCallMutation) OldStage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStage: %w", err)
+	}
+	return oldValue.Stage, nil
+}
+
+// ClearStage clears the value of the "stage" field.
+func (m *LLMCallMutation) ClearStage() {
+	m.stage = nil
+	m.clearedFields[llmcall.FieldStage] = struct{}{}
+}
+
+// StageCleared returns if the "stage" field was cleared in this mutation.
+func (m *LLMCallMutation) StageCleared() bool {
+	_, ok := m.clearedFields[llmcall.FieldStage]
+	return ok
+}
+
+// ResetStage resets all changes to the "stage" field.
+func (m *LLMCallMutation) ResetStage() {
+	m.stage = nil
+	delete(m.clearedFields, llmcall.FieldStage)
+}
+
+// SetModel sets the "model" field.
+func (m *LLMCallMutation) SetModel(s string) {
+	m.model = &s
+}
+
+// Model returns the value of the "model" field in the mutation.
+func (m *LLMCallMutation) Model() (r string, exists bool) {
+	v := m.model
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModel returns the old "model" field's value of the LLMCall entity.
+// If the LLMCall object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LLMCallMutation) OldModel(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModel: %w", err)
+	}
+	return oldValue.Model, nil
+}
+
+// ResetModel resets all changes to the "model" field.
+func (m *LLMCallMutation) ResetModel() {
+	m.model = nil
+}
+
+// SetPromptTokens sets the "prompt_tokens" field.
+func (m *LLMCallMutation) SetPromptTokens(i int) {
+	m.prompt_tokens = &i
+	m.addprompt_tokens = nil
+}
+
+// PromptTokens returns the value of the "prompt_tokens" field in the mutation.
+func (m *LLMCallMutation) PromptTokens() (r int, exists bool) {
+	v := m.prompt_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPromptTokens returns the old "prompt_tokens" field's value of the LLMCall entity.
+// If the LLMCall object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LLMCallMutation) OldPromptTokens(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPromptTokens is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPromptTokens requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPromptTokens: %w", err)
+	}
+	return oldValue.PromptTokens, nil
+}
+
+// AddPromptTokens adds i to the "prompt_tokens" field.
+func (m *LLMCallMutation) AddPromptTokens(i int) {
+	if m.addprompt_tokens != nil {
+		*m.addprompt_tokens += i
+	} else {
+		m.addprompt_tokens = &i
+	}
+}
+
+// AddedPromptTokens returns the value that was added to the "prompt_tokens" field in this mutation.
+func (m *LLMCallMutation) AddedPromptTokens() (r int, exists bool) {
+	v := m.addprompt_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPromptTokens resets all changes to the "prompt_tokens" field.
+func (m *LLMCallMutation) ResetPromptTokens() {
+	m.prompt_tokens = nil
+	m.addprompt_tokens = nil
+}
+
+// SetCompletionTokens sets the "completion_tokens" field.
+func (m *LLMCallMutation) SetCompletionTokens(i int) {
+	m.completion_tokens = &i
+	m.addcompletion_tokens = nil
+}
+
+// CompletionTokens returns the value of the "completion_tokens" field in the mutation.
+func (m *LLMCallMutation) CompletionTokens() (r int, exists bool) {
+	v := m.completion_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCompletionTokens returns the old "completion_tokens" field's value of the LLMCall entity.
+// If the LLMCall object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LLMCallMutation) OldCompletionTokens(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompletionTokens is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompletionTokens requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompletionTokens: %w", err)
+	}
+	return oldValue.CompletionTokens, nil
+}
+
+// AddCompletionTokens adds i to the "completion_tokens" field.
+func (m *LLMCallMutation) AddCompletionTokens(i int) {
+	if m.addcompletion_tokens != nil {
+		*m.addcompletion_tokens += i
+	} else {
+		m.addcompletion_tokens = &i
+	}
+}
+
+// AddedCompletionTokens returns the value that was added to the "completion_tokens" field in this mutation.
+func (m *LLMCallMutation) AddedCompletionTokens() (r int, exists bool) {
+	v := m.addcompletion_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCompletionTokens resets all changes to the "completion_tokens" field.
+func (m *LLMCallMutation) ResetCompletionTokens() {
+	m.completion_tokens = nil
+	m.addcompletion_tokens = nil
+}
+
+// SetTotalTokens sets the "total_tokens" field.
+func (m *LLMCallMutation) SetTotalTokens(i int) {
+	m.total_tokens = &i
+	m.addtotal_tokens = nil
+}
+
+// TotalTokens returns the value of the "total_tokens" field in the mutation.
+func (m *LLMCallMutation) TotalTokens() (r int, exists bool) {
+	v := m.total_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotalTokens returns the old "total_tokens" field's value of the LLMCall entity.
+// If the LLMCall object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LLMCallMutation) OldTotalTokens(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotalTokens is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotalTokens requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotalTokens: %w", err)
+	}
+	return oldValue.TotalTokens, nil
+}
+
+// AddTotalTokens adds i to the "total_tokens" field.
+func (m *LLMCallMutation) AddTotalTokens(i int) {
+	if m.addtotal_tokens != nil {
+		*m.addtotal_tokens += i
+	} else {
+		m.addtotal_tokens = &i
+	}
+}
+
+// AddedTotalTokens returns the value that was added to the "total_tokens" field in this mutation.
+func (m *LLMCallMutation) AddedTotalTokens() (r int, exists bool) {
+	v := m.addtotal_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTotalTokens resets all changes to the "total_tokens" field.
+func (m *LLMCallMutation) ResetTotalTokens() {
+	m.total_tokens = nil
+	m.addtotal_tokens = nil
+}
+
+// SetCost sets the "cost" field.
+func (m *LLMCallMutation) SetCost(f float64) {
+	m.cost = &f
+	m.addcost = nil
+}
+
+// Cost returns the value of the "cost" field in the mutation.
+func (m *LLMCallMutation) Cost() (r float64, exists bool) {
+	v := m.cost
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCost returns the old "cost" field's value of the LLMCall entity.
+// If the LLMCall object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LLMCallMutation) OldCost(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCost is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCost requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCost: %w", err)
+	}
+	return oldValue.Cost, nil
+}
+
+// AddCost adds f to the "cost" field.
+func (m *LLMCallMutation) AddCost(f float64) {
+	if m.addcost != nil {
+		*m.addcost += f
+	} else {
+		m.addcost = &f
+	}
+}
+
+// AddedCost returns the value that was added to the "cost" field in this mutation.
+func (m *LLMCallMutation) AddedCost() (r float64, exists bool) {
+	v := m.addcost
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCost resets all changes to the "cost" field.
+func (m *LLMCallMutation) ResetCost() {
+	m.cost = nil
+	m.addcost = nil
+}
+
+// SetLatencyMs sets the "latency_ms" field.
+func (m *LLMCallMutation) SetLatencyMs(i int64) {
+	m.latency_ms = &i
+	m.addlatency_ms = nil
+}
+
+// LatencyMs returns the value of the "latency_ms" field in the mutation.
+func (m *LLMCallMutation) LatencyMs() (r int64, exists bool) {
+	v := m.latency_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLatencyMs returns the old "latency_ms" field's value of the LLMCall entity.
+// If the LLMCall object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LLMCallMutation) OldLatencyMs(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLatencyMs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLatencyMs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLatencyMs: %w", err)
+	}
+	return oldValue.LatencyMs, nil
+}
+
+// AddLatencyMs adds i to the "latency_ms" field.
+func (m *LLMCallMutation) AddLatencyMs(i int64) {
+	if m.addlatency_ms != nil {
+		*m.addlatency_ms += i
+	} else {
+		m.addlatency_ms = &i
+	}
+}
+
+// AddedLatencyMs returns the value that was added to the "latency_ms" field in this mutation.
+func (m *LLMCallMutation) AddedLatencyMs() (r int64, exists bool) {
+	v := m.addlatency_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLatencyMs resets all changes to the "latency_ms" field.
+func (m *LLMCallMutation) ResetLatencyMs() {
+	m.latency_ms = nil
+	m.addlatency_ms = nil
+}
+
+// SetSuccess sets the "success" field.
+func (m *LLMCallMutation) SetSuccess(b bool) {
+	m.success = &b
+}
+
+// Success returns the value of the "success" field in the mutation.
+func (m *LLMCallMutation) Success() (r bool, exists bool) {
+	v := m.success
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSuccess returns the old "success" field's value of the LLMCall entity.
+// If the LLMCall object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LLMCallMutation) OldSuccess(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSuccess is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSuccess requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSuccess: %w", err)
+	}
+	return oldValue.Success, nil
+}
+
+// ResetSuccess resets all changes to the "success" field.
+func (m *LLMCallMutation) ResetSuccess() {
+	m.success = nil
+}
+
+// SetError sets the "error" field.
+func (m *LLMCallMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *LLMCallMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldError returns the old "error" field's value of the LLMCall entity.
+// If the LLMCall object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LLMCallMutation) OldError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
+}
+
+// ClearError clears the value of the "error" field.
+func (m *LLMCallMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[llmcall.FieldError] = struct{}{}
+}
+
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *LLMCallMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[llmcall.FieldError]
+	return ok
+}
+
+// ResetError resets all changes to the "error" field.
+func (m *LLMCallMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, llmcall.FieldError)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *LLMCallMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *LLMCallMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the LLMCall entity.
+// If the LLMCall object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LLMCallMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *LLMCallMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetReportRunID sets the "report_run" edge to the ReportRun entity by id.
+func (m *LLMCallMutation) SetReportRunID(id int) {
+	m.report_run = &id
+}
+
+// ClearReportRun clears the "report_run" edge to the ReportRun entity.
+func (m *LLMCallMutation) ClearReportRun() {
+	m.clearedreport_run = true
+	m.clearedFields[llmcall.FieldRunID] = struct{}{}
+}
+
+// ReportRunCleared reports if the "report_run" edge to the ReportRun entity was cleared.
+func (m *LLMCallMutation) ReportRunCleared() bool {
+	return m.RunIDCleared() || m.clearedreport_run
+}
+
+// ReportRunID returns the "report_run" edge ID in the mutation.
+func (m *LLMCallMutation) ReportRunID() (id int, exists bool) {
+	if m.report_run != nil {
+		return *m.report_run, true
+	}
+	return
+}
+
+// ReportRunIDs returns the "report_run" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ReportRunID instead. It exists only for internal usage by the builders.
+func (m *LLMCallMutation) ReportRunIDs() (ids []int) {
+	if id := m.report_run; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetReportRun resets all changes to the "report_run" edge.
+func (m *LLMCallMutation) ResetReportRun() {
+	m.report_run = nil
+	m.clearedreport_run = false
+}
+
+// Where appends a list predicates to the LLMCallMutation builder.
+func (m *LLMCallMutation) Where(ps ...predicate.LLMCall) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the LLMCallMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *LLMCallMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.LLMCall, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *LLMCallMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *LLMCallMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (LLMCall).
+func (m *LLMCallMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LLMCallMutation) Fields() []string {
+	fields := make([]string, 0, 13)
+	if m.report_run != nil {
+		fields = append(fields, llmcall.FieldRunID)
+	}
+	if m.user_id != nil {
+		fields = append(fields, llmcall.FieldUserID)
+	}
+	if m.domain != nil {
+		fields = append(fields, llmcall.FieldDomain)
+	}
+	if m.stage != nil {
+		fields = append(fields, llmcall.FieldStage)
+	}
+	if m.model != nil {
+		fields = append(fields, llmcall.FieldModel)
+	}
+	if m.prompt_tokens != nil {
+		fields = append(fields, llmcall.FieldPromptTokens)
+	}
+	if m.completion_tokens != nil {
+		fields = append(fields, llmcall.FieldCompletionTokens)
+	}
+	if m.total_tokens != nil {
+		fields = append(fields, llmcall.FieldTotalTokens)
+	}
+	if m.cost != nil {
+		fields = append(fields, llmcall.FieldCost)
+	}
+	if m.latency_ms != nil {
+		fields = append(fields, llmcall.FieldLatencyMs)
+	}
+	if m.success != nil {
+		fields = append(fields, llmcall.FieldSuccess)
+	}
+	if m.error != nil {
+		fields = append(fields, llmcall.FieldError)
+	}
+	if m.created_at != nil {
+		fields = append(fields, llmcall.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LLMCallMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case llmcall.FieldRunID:
+		return m.RunID()
+	case llmcall.FieldUserID:
+		return m.UserID()
+	case llmcall.FieldDomain:
+		return m.Domain()
+	case llmcall.FieldStage:
+		return m.Stage()
+	case llmcall.FieldModel:
+		return m.Model()
+	case llmcall.FieldPromptTokens:
+		return m.PromptTokens()
+	case llmcall.FieldCompletionTokens:
+		return m.CompletionTokens()
+	case llmcall.FieldTotalTokens:
+		return m.TotalTokens()
+	case llmcall.FieldCost:
+		return m.Cost()
+	case llmcall.FieldLatencyMs:
+		return m.LatencyMs()
+	case llmcall.FieldSuccess:
+		return m.Success()
+	case llmcall.FieldError:
+		return m.Error()
+	case llmcall.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LLMCallMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case llmcall.FieldRunID:
+		return m.OldRunID(ctx)
+	case llmcall.FieldUserID:
+		return m.OldUserID(ctx)
+	case llmcall.FieldDomain:
+		return m.OldDomain(ctx)
+	case llmcall.FieldStage:
+		return m.OldStage(ctx)
+	case llmcall.FieldModel:
+		return m.OldModel(ctx)
+	case llmcall.FieldPromptTokens:
+		return m.OldPromptTokens(ctx)
+	case llmcall.FieldCompletionTokens:
+		return m.OldCompletionTokens(ctx)
+	case llmcall.FieldTotalTokens:
+		return m.OldTotalTokens(ctx)
+	case llmcall.FieldCost:
+		return m.OldCost(ctx)
+	case llmcall.FieldLatencyMs:
+		return m.OldLatencyMs(ctx)
+	case llmcall.FieldSuccess:
+		return m.OldSuccess(ctx)
+	case llmcall.FieldError:
+		return m.OldError(ctx)
+	case llmcall.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown LLMCall field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LLMCallMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case llmcall.FieldRunID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRunID(v)
+		return nil
+	case llmcall.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case llmcall.FieldDomain:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDomain(v)
+		return nil
+	case llmcall.FieldStage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStage(v)
+		return nil
+	case llmcall.FieldModel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModel(v)
+		return nil
+	case llmcall.FieldPromptTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPromptTokens(v)
+		return nil
+	case llmcall.FieldCompletionTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompletionTokens(v)
+		return nil
+	case llmcall.FieldTotalTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotalTokens(v)
+		return nil
+	case llmcall.FieldCost:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCost(v)
+		return nil
+	case llmcall.FieldLatencyMs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLatencyMs(v)
+		return nil
+	case llmcall.FieldSuccess:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSuccess(v)
+		return nil
+	case llmcall.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	case llmcall.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LLMCall field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LLMCallMutation) AddedFields() []string {
+	var fields []string
+	if m.adduser_id != nil {
+		fields = append(fields, llmcall.FieldUserID)
+	}
+	if m.addprompt_tokens != nil {
+		fields = append(fields, llmcall.FieldPromptTokens)
+	}
+	if m.addcompletion_tokens != nil {
+		fields = append(fields, llmcall.FieldCompletionTokens)
+	}
+	if m.addtotal_tokens != nil {
+		fields = append(fields, llmcall.FieldTotalTokens)
+	}
+	if m.addcost != nil {
+		fields = append(fields, llmcall.FieldCost)
+	}
+	if m.addlatency_ms != nil {
+		fields = append(fields, llmcall.FieldLatencyMs)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LLMCallMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case llmcall.FieldUserID:
+		return m.AddedUserID()
+	case llmcall.FieldPromptTokens:
+		return m.AddedPromptTokens()
+	case llmcall.FieldCompletionTokens:
+		return m.AddedCompletionTokens()
+	case llmcall.FieldTotalTokens:
+		return m.AddedTotalTokens()
+	case llmcall.FieldCost:
+		return m.AddedCost()
+	case llmcall.FieldLatencyMs:
+		return m.AddedLatencyMs()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LLMCallMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case llmcall.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUserID(v)
+		return nil
+	case llmcall.FieldPromptTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPromptTokens(v)
+		return nil
+	case llmcall.FieldCompletionTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCompletionTokens(v)
+		return nil
+	case llmcall.FieldTotalTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotalTokens(v)
+		return nil
+	case llmcall.FieldCost:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCost(v)
+		return nil
+	case llmcall.FieldLatencyMs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLatencyMs(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LLMCall numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LLMCallMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(llmcall.FieldRunID) {
+		fields = append(fields, llmcall.FieldRunID)
+	}
+	if m.FieldCleared(llmcall.FieldUserID) {
+		fields = append(fields, llmcall.FieldUserID)
+	}
+	if m.FieldCleared(llmcall.FieldDomain) {
+		fields = append(fields, llmcall.FieldDomain)
+	}
+	if m.FieldCleared(llmcall.FieldStage) {
+		fields = append(fields, llmcall.FieldStage)
+	}
+	if m.FieldCleared(llmcall.FieldError) {
+		fields = append(fields, llmcall.FieldError)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LLMCallMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LLMCallMutation) ClearField(name string) error {
+	switch name {
+	case llmcall.FieldRunID:
+		m.ClearRunID()
+		return nil
+	case llmcall.FieldUserID:
+		m.ClearUserID()
+		return nil
+	case llmcall.FieldDomain:
+		m.ClearDomain()
+		return nil
+	case llmcall.FieldStage:
+		m.ClearStage()
+		return nil
+	case llmcall.FieldError:
+		m.ClearError()
+		return nil
+	}
+	return fmt.Errorf("unknown LLMCall nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LLMCallMutation) ResetField(name string) error {
+	switch name {
+	case llmcall.FieldRunID:
+		m.ResetRunID()
+		return nil
+	case llmcall.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case llmcall.FieldDomain:
+		m.ResetDomain()
+		return nil
+	case llmcall.FieldStage:
+		m.ResetStage()
+		return nil
+	case llmcall.FieldModel:
+		m.ResetModel()
+		return nil
+	case llmcall.FieldPromptTokens:
+		m.ResetPromptTokens()
+		return nil
+	case llmcall.FieldCompletionTokens:
+		m.ResetCompletionTokens()
+		return nil
+	case llmcall.FieldTotalTokens:
+		m.ResetTotalTokens()
+		return nil
+	case llmcall.FieldCost:
+		m.ResetCost()
+		return nil
+	case llmcall.FieldLatencyMs:
+		m.ResetLatencyMs()
+		return nil
+	case llmcall.FieldSuccess:
+		m.ResetSuccess()
+		return nil
+	case llmcall.FieldError:
+		m.ResetError()
+		return nil
+	case llmcall.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown LLMCall field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LLMCallMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.report_run != nil {
+		edges = append(edges, llmcall.EdgeReportRun)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LLMCallMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case llmcall.EdgeReportRun:
+		if id := m.report_run; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LLMCallMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LLMCallMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LLMCallMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedreport_run {
+		edges = append(edges, llmcall.EdgeReportRun)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LLMCallMutation) EdgeCleared(name string) bool {
+	switch name {
+	case llmcall.EdgeReportRun:
+		return m.clearedreport_run
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LLMCallMutation) ClearEdge(name string) error {
+	switch name {
+	case llmcall.EdgeReportRun:
+		m.ClearReportRun()
+		return nil
+	}
+	return fmt.Errorf("unknown LLMCall unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LLMCallMutation) ResetEdge(name string) error {
+	switch name {
+	case llmcall.EdgeReportRun:
+		m.ResetReportRun()
+		return nil
+	}
+	return fmt.Errorf("unknown LLMCall edge %s", name)
+}
+
+// PersonaMutation represents an operation that mutates the Persona nodes in the graph.
+type PersonaMutation struct {
+	config
+	op                           Op
+	typ                          string
+	id                           *int
+	name                         *string
+	version                      *int
+	addversion                   *int
+	role                         *string
+	seniority                    *string
+	tech_stack                   *[]string
+	appendtech_stack             []string
+	goals                        *[]string
+	appendgoals                  []string
+	risk_appetite                *string
+	time_horizon                 *string
+	constraints                  *[]string
+	appendconstraints            []string
+	source                       *string
+	archived                     *bool
+	created_at                   *time.Time
+	clearedFields                map[string]struct{}
+	user                         *int
+	cleareduser                  bool
+	deep_analysis_results        map[int]struct{}
+	removeddeep_analysis_results map[int]struct{}
+	cleareddeep_analysis_results bool
+	done                         bool
+	oldValue                     func(context.Context) (*Persona, error)
+	predicates                   []predicate.Persona
+}
+
+var _ ent.Mutation = (*PersonaMutation)(nil)
+
+// personaOption allows management of the mutation configuration using functional options.
+type personaOption func(*PersonaMutation)
+
+// newPersonaMutation creates new mutation for the Persona entity.
+func newPersonaMutation(c config, op Op, opts ...personaOption) *PersonaMutation {
+	m := &PersonaMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePersona,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPersonaID sets the ID field of the mutation.
+func withPersonaID(id int) personaOption {
+	return func(m *PersonaMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Persona
+		)
+		m.oldValue = func(ctx context.Context) (*Persona, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Persona.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPersona sets the old Persona of the mutation.
+func withPersona(node *Persona) personaOption {
+	return func(m *PersonaMutation) {
+		m.oldValue = func(context.Context) (*Persona, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PersonaMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PersonaMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Persona entities.
+func (m *PersonaMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PersonaMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PersonaMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Persona.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *PersonaMutation) SetUserID(i int) {
+	m.user = &i
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *PersonaMutation) UserID() (r int, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the Persona entity.
+// If the Persona object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PersonaMutation) OldUserID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *PersonaMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetName sets the "name" field.
+func (m *PersonaMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *PersonaMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Persona entity.
+// If the Persona object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PersonaMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *PersonaMutation) ResetName() {
+	m.name = nil
+}
+
+// SetVersion sets the "version" field.
+func (m *PersonaMutation) SetVersion(i int) {
+	m.version = &i
+	m.addversion = nil
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *PersonaMutation) Version() (r int, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersion returns the old "version" field's value of the Persona entity.
+// If the Persona object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PersonaMutation) OldVersion(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
+}
+
+// AddVersion adds i to the "version" field.
+func (m *PersonaMutation) AddVersion(i int) {
+	if m.addversion != nil {
+		*m.addversion += i
+	} else {
+		m.addversion = &i
+	}
+}
+
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *PersonaMutation) AddedVersion() (r int, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVersion resets all changes to the "version" field.
+func (m *PersonaMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
+}
+
+// SetRole sets the "role" field.
+func (m *PersonaMutation) SetRole(s string) {
+	m.role = &s
+}
+
+// Role returns the value of the "role" field in the mutation.
+func (m *PersonaMutation) Role() (r string, exists bool) {
+	v := m.role
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRole returns the old "role" field's value of the Persona entity.
+// If the Persona object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PersonaMutation) OldRole(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRole is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRole requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRole: %w", err)
+	}
+	return oldValue.Role, nil
+}
+
+// ClearRole clears the value of the "role" field.
+func (m *PersonaMutation) ClearRole() {
+	m.role = nil
+	m.clearedFields[persona.FieldRole] = struct{}{}
+}
+
+// RoleCleared returns if the "role" field was cleared in this mutation.
+func (m *PersonaMutation) RoleCleared() bool {
+	_, ok := m.clearedFields[persona.FieldRole]
+	return ok
+}
+
+// ResetRole resets all changes to the "role" field.
+func (m *PersonaMutation) ResetRole() {
+	m.role = nil
+	delete(m.clearedFields, persona.FieldRole)
+}
+
+// SetSeniority sets the "seniority" field.
+func (m *PersonaMutation) SetSeniority(s string) {
+	m.seniority = &s
+}
+
+// Seniority returns the value of the "seniority" field in the mutation.
+func (m *PersonaMutation) Seniority() (r string, exists bool) {
+	v := m.seniority
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSeniority returns the old "seniority" field's value of the Persona entity.
+// If the Persona object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PersonaMutation) OldSeniority(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSeniority is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSeniority requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSeniority: %w", err)
+	}
+	return oldValue.Seniority, nil
+}
+
+// ClearSeniority clears the value of the "seniority" field.
+func (m *PersonaMutation) ClearSeniority() {
+	m.seniority = nil
+	m.clearedFields[persona.FieldSeniority] = struct{}{}
+}
+
+// SeniorityCleared returns if the "seniority" field was cleared in this mutation.
+func (m *PersonaMutation) SeniorityCleared() bool {
+	_, ok := m.clearedFields[persona.FieldSeniority]
+	return ok
+}
+
+// ResetSeniority resets all changes to the "seniority" field.
+func (m *PersonaMutation) ResetSeniority() {
+	m.seniority = nil
+	delete(m.clearedFields, persona.FieldSeniority)
+}
+
+// SetTechStack sets the "tech_stack" field.
+func (m *PersonaMutation) SetTechStack(s []string) {
+	m.tech_stack = &s
+	m.appendtech_stack = nil
+}
+
+// TechStack returns the value of the "tech_stack" field in the mutation.
+func (m *PersonaMutation) TechStack() (r []string, exists bool) {
+	v := m.tech_stack
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTechStack returns the old "tech_stack" field's value of the Persona entity.
+// If the Persona object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PersonaMutation) OldTechStack(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTechStack is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTechStack requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTechStack: %w", err)
+	}
+	return oldValue.TechStack, nil
+}
+
+// AppendTechStack adds s to the "tech_stack" field.
+func (m *PersonaMutation) AppendTechStack(s []string) {
+	m.appendtech_stack = append(m.appendtech_stack, s...)
+}
+
+// AppendedTechStack returns the list of values that were appended to the "tech_stack" field in this mutation.
+func (m *PersonaMutation) AppendedTechStack() ([]string, bool) {
+	if len(m.appendtech_stack) == 0 {
+		return nil, false
+	}
+	return m.appendtech_stack, true
+}
+
+// ClearTechStack clears the value of the "tech_stack" field.
+func (m *PersonaMutation) ClearTechStack() {
+	m.tech_stack = nil
+	m.appendtech_stack = nil
+	m.clearedFields[persona.FieldTechStack] = struct{}{}
+}
+
+// TechStackCleared returns if the "tech_stack" field was cleared in this mutation.
+func (m *PersonaMutation) TechStackCleared() bool {
+	_, ok := m.clearedFields[persona.FieldTechStack]
+	return ok
+}
+
+// ResetTechStack resets all changes to the "tech_stack" field.
+func (m *PersonaMutation) ResetTechStack() {
+	m.tech_stack = nil
+	m.appendtech_stack = nil
+	delete(m.clearedFields, persona.FieldTechStack)
+}
+
+// SetGoals sets the "goals" field.
+func (m *PersonaMutation) SetGoals(s []string) {
+	m.goals = &s
+	m.appendgoals = nil
+}
+
+// Goals returns the value of the "goals" field in the mutation.
+func (m *PersonaMutation) Goals() (r []string, exists bool) {
+	v := m.goals
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGoals returns the old "goals" field's value of the Persona entity.
+// If the Persona object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PersonaMutation) OldGoals(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGoals is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGoals requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGoals: %w", err)
+	}
+	return oldValue.Goals, nil
+}
+
+// AppendGoals adds s to the "goals" field.
+func (m *PersonaMutation) AppendGoals(s []string) {
+	m.appendgoals = append(m.appendgoals, s...)
+}
+
+// AppendedGoals returns the list of values that were appended to the "goals" field in this mutation.
+func (m *PersonaMutation) AppendedGoals() ([]string, bool) {
+	if len(m.appendgoals) == 0 {
+		return nil, false
+	}
+	return m.appendgoals, true
+}
+
+// ClearGoals clears the value of the "goals" field.
+func (m *PersonaMutation) ClearGoals() {
+	m.goals = nil
+	m.appendgoals = nil
+	m.clearedFields[persona.FieldGoals] = struct{}{}
+}
+
+// GoalsCleared returns if the "goals" field was cleared in this mutation.
+func (m *PersonaMutation) GoalsCleared() bool {
+	_, ok := m.clearedFields[persona.FieldGoals]
+	return ok
+}
+
+// ResetGoals resets all changes to the "goals" field.
+func (m *PersonaMutation) ResetGoals() {
+	m.goals = nil
+	m.appendgoals = nil
+	delete(m.clearedFields, persona.FieldGoals)
+}
+
+// SetRiskAppetite sets the "risk_appetite" field.
+func (m *PersonaMutation) SetRiskAppetite(s string) {
+	m.risk_appetite = &s
+}
+
+// RiskAppetite returns the value of the "risk_appetite" field in the mutation.
+func (m *PersonaMutation) RiskAppetite() (r string, exists bool) {
+	v := m.risk_appetite
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRiskAppetite returns the old "risk_appetite" field's value of the Persona entity.
+// If the Persona object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PersonaMutation) OldRiskAppetite(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRiskAppetite is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRiskAppetite requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRiskAppetite: %w", err)
+	}
+	return oldValue.RiskAppetite, nil
+}
+
+// ClearRiskAppetite clears the value of the "risk_appetite" field.
+func (m *PersonaMutation) ClearRiskAppetite() {
+	m.risk_appetite = nil
+	m.clearedFields[persona.FieldRiskAppetite] = struct{}{}
+}
+
+// RiskAppetiteCleared returns if the "risk_appetite" field was cleared in this mutation.
+func (m *PersonaMutation) RiskAppetiteCleared() bool {
+	_, ok := m.clearedFields[persona.FieldRiskAppetite]
+	return ok
+}
+
+// ResetRiskAppetite resets all changes to the "risk_appetite" field.
+func (m *PersonaMutation) ResetRiskAppetite() {
+	m.risk_appetite = nil
+	delete(m.clearedFields, persona.FieldRiskAppetite)
+}
+
+// SetTimeHorizon sets the "time_horizon" field.
+func (m *PersonaMutation) SetTimeHorizon(s string) {
+	m.time_horizon = &s
+}
+
+// TimeHorizon returns the value of the "time_horizon" field in the mutation.
+func (m *PersonaMutation) TimeHorizon() (r string, exists bool) {
+	v := m.time_horizon
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTimeHorizon returns the old "time_horizon" field's value of the Persona entity.
+// If the Persona object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PersonaMutation) OldTimeHorizon(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTimeHorizon is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTimeHorizon requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimeHorizon: %w", err)
+	}
+	return oldValue.TimeHorizon, nil
+}
+
+// ClearTimeHorizon clears the value of the "time_horizon" field.
+func (m *PersonaMutation) ClearTimeHorizon() {
+	m.time_horizon = nil
+	m.clearedFields[persona.FieldTimeHorizon] = struct{}{}
+}
+
+// TimeHorizonCleared returns if the "time_horizon" field was cleared in this mutation.
+func (m *PersonaMutation) TimeHorizonCleared() bool {
+	_, ok := m.clearedFields[persona.FieldTimeHorizon]
+	return ok
+}
+
+// ResetTimeHorizon resets all changes to the "time_horizon" field.
+func (m *PersonaMutation) ResetTimeHorizon() {
+	m.time_horizon = nil
+	delete(m.clearedFields, persona.FieldTimeHorizon)
+}
+
+// SetConstraints sets the "constraints" field.
+func (m *PersonaMutation) SetConstraints(s []string) {
+	m.constraints = &s
+	m.appendconstraints = nil
+}
+
+// Constraints returns the value of the "constraints" field in the mutation.
+func (m *PersonaMutation) Constraints() (r []string, exists bool) {
+	v := m.constraints
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldConstraints returns the old "constraints" field's value of the Persona entity.
+// If the Persona object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PersonaMutation) OldConstraints(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConstraints is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConstraints requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConstraints: %w", err)
+	}
+	return oldValue.Constraints, nil
+}
+
+// AppendConstraints adds s to the "constraints" field.
+func (m *PersonaMutation) AppendConstraints(s []string) {
+	m.appendconstraints = append(m.appendconstraints, s...)
+}
+
+// AppendedConstraints returns the list of values that were appended to the "constraints" field in this mutation.
+func (m *PersonaMutation) AppendedConstraints() ([]string, bool) {
+	if len(m.appendconstraints) == 0 {
+		return nil, false
+	}
+	return m.appendconstraints, true
+}
+
+// ClearConstraints clears the value of the "constraints" field.
+func (m *PersonaMutation) ClearConstraints() {
+	m.constraints = nil
+	m.appendconstraints = nil
+	m.clearedFields[persona.FieldConstraints] = struct{}{}
+}
+
+// ConstraintsCleared returns if the "constraints" field was cleared in this mutation.
+func (m *PersonaMutation) ConstraintsCleared() bool {
+	_, ok := m.clearedFields[persona.FieldConstraints]
+	return ok
+}
+
+// ResetConstraints resets all changes to the "constraints" field.
+func (m *PersonaMutation) ResetConstraints() {
+	m.constraints = nil
+	m.appendconstraints = nil
+	delete(m.clearedFields, persona.FieldConstraints)
+}
+
+// SetSource sets the "source" field.
+func (m *PersonaMutation) SetSource(s string) {
+	m.source = &s
+}
+
+// Source returns the value of the "source" field in the mutation.
+func (m *PersonaMutation) Source() (r string, exists bool) {
+	v := m.source
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSource returns the old "source" field's value of the Persona entity.
+// If the Persona object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PersonaMutation) OldSource(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSource is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSource requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSource: %w", err)
+	}
+	return oldValue.Source, nil
+}
+
+// ResetSource resets all changes to the "source" field.
+func (m *PersonaMutation) ResetSource() {
+	m.source = nil
+}
+
+// SetArchived sets the "archived" field.
+func (m *PersonaMutation) SetArchived(b bool) {
+	m.archived = &b
+}
+
+// Archived returns the value of the "archived" field in the mutation.
+func (m *PersonaMutation) Archived() (r bool, exists bool) {
+	v := m.archived
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldArchived returns the old "archived" field's value of the Persona entity.
+// If the Persona object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PersonaMutation) OldArchived(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldArchived is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldArchived requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldArchived: %w", err)
+	}
+	return oldValue.Archived, nil
+}
+
+// ResetArchived resets all changes to the "archived" field.
+func (m *PersonaMutation) ResetArchived() {
+	m.archived = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PersonaMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PersonaMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Persona entity.
+// If the Persona object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PersonaMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PersonaMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *PersonaMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[persona.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *PersonaMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *PersonaMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *PersonaMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// AddDeepAnalysisResultIDs adds the "deep_analysis_results" edge to the DeepAnalysisResult entity by ids.
+func (m *PersonaMutation) AddDeepAnalysisResultIDs(ids ...int) {
+	if m.deep_analysis_results == nil {
+		m.deep_analysis_results = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.deep_analysis_results[ids[i]] = struct{}{}
+	}
+}
+
+// ClearDeepAnalysisResults clears the "deep_analysis_results" edge to the DeepAnalysisResult entity.
+func (m *PersonaMutation) ClearDeepAnalysisResults() {
+	m.cleareddeep_analysis_results = true
+}
+
+// DeepAnalysisResultsCleared reports if the "deep_analysis_results" edge to the DeepAnalysisResult entity was cleared.
+func (m *PersonaMutation) DeepAnalysisResultsCleared() bool {
+	return m.cleareddeep_analysis_results
+}
+
+// RemoveDeepAnalysisResultIDs removes the "deep_analysis_results" edge to the DeepAnalysisResult entity by IDs.
+func (m *PersonaMutation) RemoveDeepAnalysisResultIDs(ids ...int) {
+	if m.removeddeep_analysis_results == nil {
+		m.removeddeep_analysis_results = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.deep_analysis_results, ids[i])
+		m.removeddeep_analysis_results[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDeepAnalysisResults returns the removed IDs of the "deep_analysis_results" edge to the DeepAnalysisResult entity.
+func (m *PersonaMutation) RemovedDeepAnalysisResultsIDs() (ids []int) {
+	for id := range m.removeddeep_analysis_results {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// DeepAnalysisResultsIDs returns the "deep_analysis_results" edge IDs in the mutation.
+func (m *PersonaMutation) DeepAnalysisResultsIDs() (ids []int) {
+	for id := range m.deep_analysis_results {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetDeepAnalysisResults resets all changes to the "deep_analysis_results" edge.
+func (m *PersonaMutation) ResetDeepAnalysisResults() {
+	m.deep_analysis_results = nil
+	m.cleareddeep_analysis_results = false
+	m.removeddeep_analysis_results = nil
+}
+
+// Where appends a list predicates to the PersonaMutation builder.
+func (m *PersonaMutation) Where(ps ...predicate.Persona) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PersonaMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PersonaMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Persona, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PersonaMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PersonaMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Persona).
+func (m *PersonaMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PersonaMutation) Fields() []string {
+	fields := make([]string, 0, 13)
+	if m.user != nil {
+		fields = append(fields, persona.FieldUserID)
+	}
+	if m.name != nil {
+		fields = append(fields, persona.FieldName)
+	}
+	if m.version != nil {
+		fields = append(fields, persona.FieldVersion)
+	}
+	if m.role != nil {
+		fields = append(fields, persona.FieldRole)
+	}
+	if m.seniority != nil {
+		fields = append(fields, persona.FieldSeniority)
+	}
+	if m.tech_stack != nil {
+		fields = append(fields, persona.FieldTechStack)
+	}
+	if m.goals != nil {
+		fields = append(fields, persona.FieldGoals)
+	}
+	if m.risk_appetite != nil {
+		fields = append(fields, persona.FieldRiskAppetite)
+	}
+	if m.time_horizon != nil {
+		fields = append(fields, persona.FieldTimeHorizon)
+	}
+	if m.constraints != nil {
+		fields = append(fields, persona.FieldConstraints)
+	}
+	if m.source != nil {
+		fields = append(fields, persona.FieldSource)
+	}
+	if m.archived != nil {
+		fields = append(fields, persona.FieldArchived)
+	}
+	if m.created_at != nil {
+		fields = append(fields, persona.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PersonaMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case persona.FieldUserID:
+		return m.UserID()
+	case persona.FieldName:
+		return m.Name()
+	case persona.FieldVersion:
+		return m.Version()
+	case persona.FieldRole:
+		return m.Role()
+	case persona.FieldSeniority:
+		return m.Seniority()
+	case persona.FieldTechStack:
+		return m.TechStack()
+	case persona.FieldGoals:
+		return m.Goals()
+	case persona.FieldRiskAppetite:
+		return m.RiskAppetite()
+	case persona.FieldTimeHorizon:
+		return m.TimeHorizon()
+	case persona.FieldConstraints:
+		return m.Constraints()
+	case persona.FieldSource:
+		return m.Source()
+	case persona.FieldArchived:
+		return m.Archived()
+	case persona.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PersonaMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case persona.FieldUserID:
+		return m.OldUserID(ctx)
+	case persona.FieldName:
+		return m.OldName(ctx)
+	case persona.FieldVersion:
+		return m.OldVersion(ctx)
+	case persona.FieldRole:
+		return m.OldRole(ctx)
+	case persona.FieldSeniority:
+		return m.OldSeniority(ctx)
+	case persona.FieldTechStack:
+		return m.OldTechStack(ctx)
+	case persona.FieldGoals:
+		return m.OldGoals(ctx)
+	case persona.FieldRiskAppetite:
+		return m.OldRiskAppetite(ctx)
+	case persona.FieldTimeHorizon:
+		return m.OldTimeHorizon(ctx)
+	case persona.FieldConstraints:
+		return m.OldConstraints(ctx)
+	case persona.FieldSource:
+		return m.OldSource(ctx)
+	case persona.FieldArchived:
+		return m.OldArchived(ctx)
+	case persona.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Persona field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PersonaMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case persona.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case persona.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case persona.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	case persona.FieldRole:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRole(v)
+		return nil
+	case persona.FieldSeniority:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeniority(v)
+		return nil
+	case persona.FieldTechStack:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTechStack(v)
+		return nil
+	case persona.FieldGoals:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGoals(v)
+		return nil
+	case persona.FieldRiskAppetite:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRiskAppetite(v)
+		return nil
+	case persona.FieldTimeHorizon:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimeHorizon(v)
+		return nil
+	case persona.FieldConstraints:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConstraints(v)
+		return nil
+	case persona.FieldSource:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSource(v)
+		return nil
+	case persona.FieldArchived:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetArchived(v)
+		return nil
+	case persona.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Persona field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PersonaMutation) AddedFields() []string {
+	var fields []string
+	if m.addversion != nil {
+		fields = append(fields, persona.FieldVersion)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PersonaMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case persona.FieldVersion:
+		return m.AddedVersion()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PersonaMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case persona.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVersion(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Persona numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PersonaMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(persona.FieldRole) {
+		fields = append(fields, persona.FieldRole)
+	}
+	if m.FieldCleared(persona.FieldSeniority) {
+		fields = append(fields, persona.FieldSeniority)
+	}
+	if m.FieldCleared(persona.FieldTechStack) {
+		fields = append(fields, persona.FieldTechStack)
+	}
+	if m.FieldCleared(persona.FieldGoals) {
+		fields = append(fields, persona.FieldGoals)
+	}
+	if m.FieldCleared(persona.FieldRiskAppetite) {
+		fields = append(fields, persona.FieldRiskAppetite)
+	}
+	if m.FieldCleared(persona.FieldTimeHorizon) {
+		fields = append(fields, persona.FieldTimeHorizon)
+	}
+	if m.FieldCleared(persona.FieldConstraints) {
+		fields = append(fields, persona.FieldConstraints)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PersonaMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PersonaMutation) ClearField(name string) error {
+	switch name {
+	case persona.FieldRole:
+		m.ClearRole()
+		return nil
+	case persona.FieldSeniority:
+		m.ClearSeniority()
+		return nil
+	case persona.FieldTechStack:
+		m.ClearTechStack()
+		return nil
+	case persona.FieldGoals:
+		m.ClearGoals()
+		return nil
+	case persona.FieldRiskAppetite:
+		m.ClearRiskAppetite()
+		return nil
+	case persona.FieldTimeHorizon:
+		m.ClearTimeHorizon()
+		return nil
+	case persona.FieldConstraints:
+		m.ClearConstraints()
+		return nil
+	}
+	return fmt.Errorf("unknown Persona nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PersonaMutation) ResetField(name string) error {
+	switch name {
+	case persona.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case persona.FieldName:
+		m.ResetName()
+		return nil
+	case persona.FieldVersion:
+		m.ResetVersion()
+		return nil
+	case persona.FieldRole:
+		m.ResetRole()
+		return nil
+	case persona.FieldSeniority:
+		m.ResetSeniority()
+		return nil
+	case persona.FieldTechStack:
+		m.ResetTechStack()
+		return nil
+	case persona.FieldGoals:
+		m.ResetGoals()
+		return nil
+	case persona.FieldRiskAppetite:
+		m.ResetRiskAppetite()
+		return nil
+	case persona.FieldTimeHorizon:
+		m.ResetTimeHorizon()
+		return nil
+	case persona.FieldConstraints:
+		m.ResetConstraints()
+		return nil
+	case persona.FieldSource:
+		m.ResetSource()
+		return nil
+	case persona.FieldArchived:
+		m.ResetArchived()
+		return nil
+	case persona.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Persona field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PersonaMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.user != nil {
+		edges = append(edges, persona.EdgeUser)
+	}
+	if m.deep_analysis_results != nil {
+		edges = append(edges, persona.EdgeDeepAnalysisResults)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PersonaMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case persona.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case persona.EdgeDeepAnalysisResults:
+		ids := make([]ent.Value, 0, len(m.deep_analysis_results))
+		for id := range m.deep_analysis_results {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PersonaMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removeddeep_analysis_results != nil {
+		edges = append(edges, persona.EdgeDeepAnalysisResults)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PersonaMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case persona.EdgeDeepAnalysisResults:
+		ids := make([]ent.Value, 0, len(m.removeddeep_analysis_results))
+		for id := range m.removeddeep_analysis_results {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PersonaMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareduser {
+		edges = append(edges, persona.EdgeUser)
+	}
+	if m.cleareddeep_analysis_results {
+		edges = append(edges, persona.EdgeDeepAnalysisResults)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PersonaMutation) EdgeCleared(name string) bool {
+	switch name {
+	case persona.EdgeUser:
+		return m.cleareduser
+	case persona.EdgeDeepAnalysisResults:
+		return m.cleareddeep_analysis_results
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PersonaMutation) ClearEdge(name string) error {
+	switch name {
+	case persona.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Persona unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PersonaMutation) ResetEdge(name string) error {
+	switch name {
+	case persona.EdgeUser:
+		m.ResetUser()
+		return nil
+	case persona.EdgeDeepAnalysisResults:
+		m.ResetDeepAnalysisResults()
+		return nil
+	}
+	return fmt.Errorf("unknown Persona edge %s", name)
+}
+
+// ReportRunMutation represents an operation that mutates the ReportRun nodes in the graph.
+type ReportRunMutation struct {
+	config
+	op                              Op
+	typ                             string
+	id                              *int
+	created_at                      *time.Time
+	title                           *string
+	user_id                         *int
+	adduser_id                      *int
+	window_start                    *time.Time
+	window_end                      *time.Time
+	status                          *string
+	clearedFields                   map[string]struct{}
+	domain_reports                  map[int]struct{}
+	removeddomain_reports           map[int]struct{}
+	cleareddomain_reports           bool
+	generated_domain_reports        map[int]struct{}
+	removedgenerated_domain_reports map[int]struct{}
+	clearedgenerated_domain_reports bool
+	deep_analysis_results           map[int]struct{}
+	removeddeep_analysis_results    map[int]struct{}
+	cleareddeep_analysis_results    bool
+	llm_calls                       map[int]struct{}
+	removedllm_calls                map[int]struct{}
+	clearedllm_calls                bool
+	domain_runs                     map[int]struct{}
+	removeddomain_runs              map[int]struct{}
+	cleareddomain_runs              bool
+	events                          map[int]struct{}
+	removedevents                   map[int]struct{}
+	clearedevents                   bool
+	done                            bool
+	oldValue                        func(context.Context) (*ReportRun, error)
+	predicates                      []predicate.ReportRun
+}
+
+var _ ent.Mutation = (*ReportRunMutation)(nil)
+
+// reportrunOption allows management of the mutation configuration using functional options.
+type reportrunOption func(*ReportRunMutation)
+
+// newReportRunMutation creates new mutation for the ReportRun entity.
+func newReportRunMutation(c config, op Op, opts ...reportrunOption) *ReportRunMutation {
+	m := &ReportRunMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeReportRun,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withReportRunID sets the ID field of the mutation.
+func withReportRunID(id int) reportrunOption {
+	return func(m *ReportRunMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ReportRun
+		)
+		m.oldValue = func(ctx context.Context) (*ReportRun, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ReportRun.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withReportRun sets the old ReportRun of the mutation.
+func withReportRun(node *ReportRun) reportrunOption {
+	return func(m *ReportRunMutation) {
+		m.oldValue = func(context.Context) (*ReportRun, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ReportRunMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ReportRunMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ReportRun entities.
+func (m *ReportRunMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ReportRunMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ReportRunMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ReportRun.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ReportRunMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ReportRunMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ReportRun entity.
+// If the ReportRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportRunMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ReportRunMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetTitle sets the "title" field.
+func (m *ReportRunMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *ReportRunMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the ReportRun entity.
+// If the ReportRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportRunMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ClearTitle clears the value of the "title" field.
+func (m *ReportRunMutation) ClearTitle() {
+	m.title = nil
+	m.clearedFields[reportrun.FieldTitle] = struct{}{}
+}
+
+// TitleCleared returns if the "title" field was cleared in this mutation.
+func (m *ReportRunMutation) TitleCleared() bool {
+	_, ok := m.clearedFields[reportrun.FieldTitle]
+	return ok
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *ReportRunMutation) ResetTitle() {
+	m.title = nil
+	delete(m.clearedFields, reportrun.FieldTitle)
+}
+
+// SetUserID sets the "user_id" field.
+func (m *ReportRunMutation) SetUserID(i int) {
+	m.user_id = &i
+	m.adduser_id = nil
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *ReportRunMutation) UserID() (r int, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the ReportRun entity.
+// If the ReportRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportRunMutation) OldUserID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// AddUserID adds i to the "user_id" field.
+func (m *ReportRunMutation) AddUserID(i int) {
+	if m.adduser_id != nil {
+		*m.adduser_id += i
+	} else {
+		m.adduser_id = &i
+	}
+}
+
+// AddedUserID returns the value that was added to the "user_id" field in this mutation.
+func (m *ReportRunMutation) AddedUserID() (r int, exists bool) {
+	v := m.adduser_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (m *ReportRunMutation) ClearUserID() {
+	m.user_id = nil
+	m.adduser_id = nil
+	m.clearedFields[reportrun.FieldUserID] = struct{}{}
+}
+
+// UserIDCleared returns if the "user_id" field was cleared in this mutation.
+func (m *ReportRunMutation) UserIDCleared() bool {
+	_, ok := m.clearedFields[reportrun.FieldUserID]
+	return ok
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *ReportRunMutation) ResetUserID() {
+	m.user_id = nil
+	m.adduser_id = nil
+	delete(m.clearedFields, reportrun.FieldUserID)
+}
+
+// SetWindowStart sets the "window_start" field.
+func (m *ReportRunMutation) SetWindowStart(t time.Time) {
+	m.window_start = &t
+}
+
+// WindowStart returns the value of the "window_start" field in the mutation.
+func (m *ReportRunMutation) WindowStart() (r time.Time, exists bool) {
+	v := m.window_start
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWindowStart returns the old "window_start" field's value of the ReportRun entity.
+// If the ReportRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportRunMutation) OldWindowStart(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWindowStart is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWindowStart requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWindowStart: %w", err)
+	}
+	return oldValue.WindowStart, nil
+}
+
+// ClearWindowStart clears the value of the "window_start" field.
+func (m *ReportRunMutation) ClearWindowStart() {
+	m.window_start = nil
+	m.clearedFields[reportrun.FieldWindowStart] = struct{}{}
+}
+
+// WindowStartCleared returns if the "window_start" field was cleared in this mutation.
+func (m *ReportRunMutation) WindowStartCleared() bool {
+	_, ok := m.clearedFields[reportrun.FieldWindowStart]
+	return ok
+}
+
+// ResetWindowStart resets all changes to the "window_start" field.
+func (m *ReportRunMutation) ResetWindowStart() {
+	m.window_start = nil
+	delete(m.clearedFields, reportrun.FieldWindowStart)
+}
+
+// SetWindowEnd sets the "window_end" field.
+func (m *ReportRunMutation) SetWindowEnd(t time.Time) {
+	m.window_end = &t
+}
+
+// WindowEnd returns the value of the "window_end" field in the mutation.
+func (m *ReportRunMutation) WindowEnd() (r time.Time, exists bool) {
+	v := m.window_end
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWindowEnd returns the old "window_end" field's value of the ReportRun entity.
+// If the ReportRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportRunMutation) OldWindowEnd(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWindowEnd is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWindowEnd requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWindowEnd: %w", err)
+	}
+	return oldValue.WindowEnd, nil
+}
+
+// ClearWindowEnd clears the value of the "window_end" field.
+func (m *ReportRunMutation) ClearWindowEnd() {
+	m.window_end = nil
+	m.clearedFields[reportrun.FieldWindowEnd] = struct{}{}
+}
+
+// WindowEndCleared returns if the "window_end" field was cleared in this mutation.
+func (m *ReportRunMutation) WindowEndCleared() bool {
+	_, ok := m.clearedFields[reportrun.FieldWindowEnd]
+	return ok
+}
+
+// ResetWindowEnd resets all changes to the "window_end" field.
+func (m *ReportRunMutation) ResetWindowEnd() {
+	m.window_end = nil
+	delete(m.clearedFields, reportrun.FieldWindowEnd)
+}
+
+// SetStatus sets the "status" field.
+func (m *ReportRunMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *ReportRunMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the ReportRun entity.
+// If the ReportRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportRunMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *ReportRunMutation) ResetStatus() {
+	m.status = nil
+}
+
+// AddDomainReportIDs adds the "domain_reports" edge to the DomainReport entity by ids.
+func (m *ReportRunMutation) AddDomainReportIDs(ids ...int) {
+	if m.domain_reports == nil {
+		m.domain_reports = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.domain_reports[ids[i]] = struct{}{}
+	}
+}
+
+// ClearDomainReports clears the "domain_reports" edge to the DomainReport entity.
+func (m *ReportRunMutation) ClearDomainReports() {
+	m.cleareddomain_reports = true
+}
+
+// DomainReportsCleared reports if the "domain_reports" edge to the DomainReport entity was cleared.
+func (m *ReportRunMutation) DomainReportsCleared() bool {
+	return m.cleareddomain_reports
+}
+
+// RemoveDomainReportIDs removes the "domain_reports" edge to the DomainReport entity by IDs.
+func (m *ReportRunMutation) RemoveDomainReportIDs(ids ...int) {
+	if m.removeddomain_reports == nil {
+		m.removeddomain_reports = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.domain_reports, ids[i])
+		m.removeddomain_reports[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDomainReports returns the removed IDs of the "domain_reports" edge to the DomainReport entity.
+func (m *ReportRunMutation) RemovedDomainReportsIDs() (ids []int) {
+	for id := range m.removeddomain_reports {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// DomainReportsIDs returns the "domain_reports" edge IDs in the mutation.
+func (m *ReportRunMutation) DomainReportsIDs() (ids []int) {
+	for id := range m.domain_reports {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetDomainReports resets all changes to the "domain_reports" edge.
+func (m *ReportRunMutation) ResetDomainReports() {
+	m.domain_reports = nil
+	m.cleareddomain_reports = false
+	m.removeddomain_reports = nil
+}
+
+// AddGeneratedDomainReportIDs adds the "generated_domain_reports" edge to the DomainReport entity by ids.
+func (m *ReportRunMutation) AddGeneratedDomainReportIDs(ids ...int) {
+	if m.generated_domain_reports == nil {
+		m.generated_domain_reports = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.generated_domain_reports[ids[i]] = struct{}{}
+	}
+}
+
+// ClearGeneratedDomainReports clears the "generated_domain_reports" edge to the DomainReport entity.
+func (m *ReportRunMutation) ClearGeneratedDomainReports() {
+	m.clearedgenerated_domain_reports = true
+}
+
+// GeneratedDomainReportsCleared reports if the "generated_domain_reports" edge to the DomainReport entity was cleared.
+func (m *ReportRunMutation) GeneratedDomainReportsCleared() bool {
+	return m.clearedgenerated_domain_reports
+}
+
+// RemoveGeneratedDomainReportIDs removes the "generated_domain_reports" edge to the DomainReport entity by IDs.
+func (m *ReportRunMutation) RemoveGeneratedDomainReportIDs(ids ...int) {
+	if m.removedgenerated_domain_reports == nil {
+		m.removedgenerated_domain_reports = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.generated_domain_reports, ids[i])
+		m.removedgenerated_domain_reports[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedGeneratedDomainReports returns the removed IDs of the "generated_domain_reports" edge to the DomainReport entity.
+func (m *ReportRunMutation) RemovedGeneratedDomainReportsIDs() (ids []int) {
+	for id := range m.removedgenerated_domain_reports {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// GeneratedDomainReportsIDs returns the "generated_domain_reports" edge IDs in the mutation.
+func (m *ReportRunMutation) GeneratedDomainReportsIDs() (ids []int) {
+	for id := range m.generated_domain_reports {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetGeneratedDomainReports resets all changes to the "generated_domain_reports" edge.
+func (m *ReportRunMutation) ResetGeneratedDomainReports() {
+	m.generated_domain_reports = nil
+	m.clearedgenerated_domain_reports = false
+	m.removedgenerated_domain_reports = nil
+}
+
+// AddDeepAnalysisResultIDs adds the "deep_analysis_results" edge to the DeepAnalysisResult entity by ids.
+func (m *ReportRunMutation) AddDeepAnalysisResultIDs(ids ...int) {
+	if m.deep_analysis_results == nil {
+		m.deep_analysis_results = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.deep_analysis_results[ids[i]] = struct{}{}
+	}
+}
+
+// ClearDeepAnalysisResults clears the "deep_analysis_results" edge to the DeepAnalysisResult entity.
+func (m *ReportRunMutation) ClearDeepAnalysisResults() {
+	m.cleareddeep_analysis_results = true
+}
+
+// DeepAnalysisResultsCleared reports if the "deep_analysis_results" edge to the DeepAnalysisResult entity was cleared.
+func (m *ReportRunMutation) DeepAnalysisResultsCleared() bool {
+	return m.cleareddeep_analysis_results
+}
+
+// RemoveDeepAnalysisResultIDs removes the "deep_analysis_results" edge to the DeepAnalysisResult entity by IDs.
+func (m *ReportRunMutation) RemoveDeepAnalysisResultIDs(ids ...int) {
+	if m.removeddeep_analysis_results == nil {
+		m.removeddeep_analysis_results = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.deep_analysis_results, ids[i])
+		m.removeddeep_analysis_results[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDeepAnalysisResults returns the removed IDs of the "deep_analysis_results" edge to the DeepAnalysisResult entity.
+func (m *ReportRunMutation) RemovedDeepAnalysisResultsIDs() (ids []int) {
+	for id := range m.removeddeep_analysis_results {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// DeepAnalysisResultsIDs returns the "deep_analysis_results" edge IDs in the mutation.
+func (m *ReportRunMutation) DeepAnalysisResultsIDs() (ids []int) {
+	for id := range m.deep_analysis_results {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetDeepAnalysisResults resets all changes to the "deep_analysis_results" edge.
+func (m *ReportRunMutation) ResetDeepAnalysisResults() {
+	m.deep_analysis_results = nil
+	m.cleareddeep_analysis_results = false
+	m.removeddeep_analysis_results = nil
+}
+
+// AddLlmCallIDs adds the "llm_calls" edge to the LLMCall entity by ids.
+func (m *ReportRunMutation) AddLlmCallIDs(ids ...int) {
+	if m.llm_calls == nil {
+		m.llm_calls = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.llm_calls[ids[i]] = struct{}{}
+	}
+}
+
+// ClearLlmCalls clears the "llm_calls" edge to the LLMCall entity.
+func (m *ReportRunMutation) ClearLlmCalls() {
+	m.clearedllm_calls = true
+}
+
+// LlmCallsCleared reports if the "llm_calls" edge to the LLMCall entity was cleared.
+func (m *ReportRunMutation) LlmCallsCleared() bool {
+	return m.clearedllm_calls
+}
+
+// RemoveLlmCallIDs removes the "llm_calls" edge to the LLMCall entity by IDs.
+func (m *ReportRunMutation) RemoveLlmCallIDs(ids ...int) {
+	if m.removedllm_calls == nil {
+		m.removedllm_calls = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.llm_calls, ids[i])
+		m.removedllm_calls[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedLlmCalls returns the removed IDs of the "llm_calls" edge to the LLMCall entity.
+func (m *ReportRunMutation) RemovedLlmCallsIDs() (ids []int) {
+	for id := range m.removedllm_calls {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// LlmCallsIDs returns the "llm_calls" edge IDs in the mutation.
+func (m *ReportRunMutation) LlmCallsIDs() (ids []int) {
+	for id := range m.llm_calls {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetLlmCalls resets all changes to the "llm_calls" edge.
+func (m *ReportRunMutation) ResetLlmCalls() {
+	m.llm_calls = nil
+	m.clearedllm_calls = false
+	m.removedllm_calls = nil
+}
+
+// AddDomainRunIDs adds the "domain_runs" edge to the DomainRun entity by ids.
+func (m *ReportRunMutation) AddDomainRunIDs(ids ...int) {
+	if m.domain_runs == nil {
+		m.domain_runs = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.domain_runs[ids[i]] = struct{}{}
+	}
 }
 
-// SetEventContent sets the "event_content" field.
-func (m *KeyEventMutation) SetEventContent(s string) {
-	m.event_content = &s
+// ClearDomainRuns clears the "domain_runs" edge to the DomainRun entity.
+func (m *ReportRunMutation) ClearDomainRuns() {
+	m.cleareddomain_runs = true
 }
 
-// EventContent returns the value of the "event_content" field in the mutation.
-func (m *KeyEventMutation) EventContent() (r string, exists bool) {
-	v := m.event_content
-	if v == nil {
-		return
-	}
-	return *v, true
+// DomainRunsCleared reports if the "domain_runs" edge to the DomainRun entity was cleared.
+func (m *ReportRunMutation) DomainRunsCleared() bool {
+	return m.cleareddomain_runs
 }
 
-// OldEventContent returns the old "event_content" field's value of the KeyEvent entity.
-// If the KeyEvent object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *KeyEventMutation) OldEventContent(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEventContent is only allowed on UpdateOne operations")
+// RemoveDomainRunIDs removes the "domain_runs" edge to the DomainRun entity by IDs.
+func (m *ReportRunMutation) RemoveDomainRunIDs(ids ...int) {
+	if m.removeddomain_runs == nil {
+		m.removeddomain_runs = make(map[int]struct{})
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEventContent requires an ID field in the mutation")
+	for i := range ids {
+		delete(m.domain_runs, ids[i])
+		m.removeddomain_runs[ids[i]] = struct{}{}
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEventContent: %w", err)
+}
+
+// RemovedDomainRuns returns the removed IDs of the "domain_runs" edge to the DomainRun entity.
+func (m *ReportRunMutation) RemovedDomainRunsIDs() (ids []int) {
+	for id := range m.removeddomain_runs {
+		ids = append(ids, id)
 	}
-	return oldValue.EventContent, nil
+	return
 }
 
-// ClearEventContent clears the value of the "event_content" field.
-func (m *KeyEventMutation) ClearEventContent() {
-	m.event_content = nil
-	m.clearedFields[keyevent.FieldEventContent] = struct{}{}
+// DomainRunsIDs returns the "domain_runs" edge IDs in the mutation.
+func (m *ReportRunMutation) DomainRunsIDs() (ids []int) {
+	for id := range m.domain_runs {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// EventContentCleared returns if the "event_content" field was cleared in this mutation.
-func (m *KeyEventMutation) EventContentCleared() bool {
-	_, ok := m.clearedFields[keyevent.FieldEventContent]
-	return ok
+// ResetDomainRuns resets all changes to the "domain_runs" edge.
+func (m *ReportRunMutation) ResetDomainRuns() {
+	m.domain_runs = nil
+	m.cleareddomain_runs = false
+	m.removeddomain_runs = nil
 }
 
-// ResetEventContent resets all changes to the "event_content" field.
-func (m *KeyEventMutation) ResetEventContent() {
-	m.event_content = nil
-	delete(m.clearedFields, keyevent.FieldEventContent)
+// AddEventIDs adds the "events" edge to the ReportRunEvent entity by ids.
+func (m *ReportRunMutation) AddEventIDs(ids ...int) {
+	if m.events == nil {
+		m.events = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.events[ids[i]] = struct{}{}
+	}
 }
 
-// ClearDomainReport clears the "domain_report" edge to the DomainReport entity.
-func (m *KeyEventMutation) ClearDomainReport() {
-	m.cleareddomain_report = true
-	m.clearedFields[keyevent.FieldDomainReportID] = struct{}{}
+// ClearEvents clears the "events" edge to the ReportRunEvent entity.
+func (m *ReportRunMutation) ClearEvents() {
+	m.clearedevents = true
 }
 
-// DomainReportCleared reports if the "domain_report" edge to the DomainReport entity was cleared.
-func (m *KeyEventMutation) DomainReportCleared() bool {
-	return m.DomainReportIDCleared() || m.cleareddomain_report
+// EventsCleared reports if the "events" edge to the ReportRunEvent entity was cleared.
+func (m *ReportRunMutation) EventsCleared() bool {
+	return m.clearedevents
 }
 
-// DomainReportIDs returns the "domain_report" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// DomainReportID instead. It exists only for internal usage by the builders.
-func (m *KeyEventMutation) DomainReportIDs() (ids []int) {
-	if id := m.domain_report; id != nil {
-		ids = append(ids, *id)
+// RemoveEventIDs removes the "events" edge to the ReportRunEvent entity by IDs.
+func (m *ReportRunMutation) RemoveEventIDs(ids ...int) {
+	if m.removedevents == nil {
+		m.removedevents = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.events, ids[i])
+		m.removedevents[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedEvents returns the removed IDs of the "events" edge to the ReportRunEvent entity.
+func (m *ReportRunMutation) RemovedEventsIDs() (ids []int) {
+	for id := range m.removedevents {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetDomainReport resets all changes to the "domain_report" edge.
-func (m *KeyEventMutation) ResetDomainReport() {
-	m.domain_report = nil
-	m.cleareddomain_report = false
+// EventsIDs returns the "events" edge IDs in the mutation.
+func (m *ReportRunMutation) EventsIDs() (ids []int) {
+	for id := range m.events {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// Where appends a list predicates to the KeyEventMutation builder.
-func (m *KeyEventMutation) Where(ps ...predicate.KeyEvent) {
+// ResetEvents resets all changes to the "events" edge.
+func (m *ReportRunMutation) ResetEvents() {
+	m.events = nil
+	m.clearedevents = false
+	m.removedevents = nil
+}
+
+// Where appends a list predicates to the ReportRunMutation builder.
+func (m *ReportRunMutation) Where(ps ...predicate.ReportRun) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the KeyEventMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ReportRunMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *KeyEventMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.KeyEvent, len(ps))
+func (m *ReportRunMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ReportRun, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3414,30 +13841,42 @@ func (m *KeyEventMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *KeyEventMutation) Op() Op {
+func (m *ReportRunMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *KeyEventMutation) SetOp(op Op) {
+func (m *ReportRunMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (KeyEvent).
-func (m *KeyEventMutation) Type() string {
+// Type returns the node type of this mutation (ReportRun).
+func (m *ReportRunMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *KeyEventMutation) Fields() []string {
-	fields := make([]string, 0, 2)
-	if m.domain_report != nil {
-		fields = append(fields, keyevent.FieldDomainReportID)
+func (m *ReportRunMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.created_at != nil {
+		fields = append(fields, reportrun.FieldCreatedAt)
 	}
-	if m.event_content != nil {
-		fields = append(fields, keyevent.FieldEventContent)
+	if m.title != nil {
+		fields = append(fields, reportrun.FieldTitle)
+	}
+	if m.user_id != nil {
+		fields = append(fields, reportrun.FieldUserID)
+	}
+	if m.window_start != nil {
+		fields = append(fields, reportrun.FieldWindowStart)
+	}
+	if m.window_end != nil {
+		fields = append(fields, reportrun.FieldWindowEnd)
+	}
+	if m.status != nil {
+		fields = append(fields, reportrun.FieldStatus)
 	}
 	return fields
 }
@@ -3445,12 +13884,20 @@ func (m *KeyEventMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *KeyEventMutation) Field(name string) (ent.Value, bool) {
+func (m *ReportRunMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case keyevent.FieldDomainReportID:
-		return m.DomainReportID()
-	case keyevent.FieldEventContent:
-		return m.EventContent()
+	case reportrun.FieldCreatedAt:
+		return m.CreatedAt()
+	case reportrun.FieldTitle:
+		return m.Title()
+	case reportrun.FieldUserID:
+		return m.UserID()
+	case reportrun.FieldWindowStart:
+		return m.WindowStart()
+	case reportrun.FieldWindowEnd:
+		return m.WindowEnd()
+	case reportrun.FieldStatus:
+		return m.Status()
 	}
 	return nil, false
 }
@@ -3458,51 +13905,92 @@ func (m *KeyEventMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *KeyEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ReportRunMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case keyevent.FieldDomainReportID:
-		return m.OldDomainReportID(ctx)
-	case keyevent.FieldEventContent:
-		return m.OldEventContent(ctx)
+	case reportrun.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case reportrun.FieldTitle:
+		return m.OldTitle(ctx)
+	case reportrun.FieldUserID:
+		return m.OldUserID(ctx)
+	case reportrun.FieldWindowStart:
+		return m.OldWindowStart(ctx)
+	case reportrun.FieldWindowEnd:
+		return m.OldWindowEnd(ctx)
+	case reportrun.FieldStatus:
+		return m.OldStatus(ctx)
 	}
-	return nil, fmt.Errorf("unknown KeyEvent field %s", name)
+	return nil, fmt.Errorf("unknown ReportRun field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *KeyEventMutation) SetField(name string, value ent.Value) error {
+func (m *ReportRunMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case keyevent.FieldDomainReportID:
+	case reportrun.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case reportrun.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case reportrun.FieldUserID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDomainReportID(v)
+		m.SetUserID(v)
 		return nil
-	case keyevent.FieldEventContent:
+	case reportrun.FieldWindowStart:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWindowStart(v)
+		return nil
+	case reportrun.FieldWindowEnd:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWindowEnd(v)
+		return nil
+	case reportrun.FieldStatus:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEventContent(v)
+		m.SetStatus(v)
 		return nil
 	}
-	return fmt.Errorf("unknown KeyEvent field %s", name)
+	return fmt.Errorf("unknown ReportRun field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *KeyEventMutation) AddedFields() []string {
+func (m *ReportRunMutation) AddedFields() []string {
 	var fields []string
+	if m.adduser_id != nil {
+		fields = append(fields, reportrun.FieldUserID)
+	}
 	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *KeyEventMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ReportRunMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
+	case reportrun.FieldUserID:
+		return m.AddedUserID()
 	}
 	return nil, false
 }
@@ -3510,165 +13998,335 @@ func (m *KeyEventMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *KeyEventMutation) AddField(name string, value ent.Value) error {
+func (m *ReportRunMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case reportrun.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUserID(v)
+		return nil
 	}
-	return fmt.Errorf("unknown KeyEvent numeric field %s", name)
+	return fmt.Errorf("unknown ReportRun numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *KeyEventMutation) ClearedFields() []string {
+func (m *ReportRunMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(keyevent.FieldDomainReportID) {
-		fields = append(fields, keyevent.FieldDomainReportID)
+	if m.FieldCleared(reportrun.FieldTitle) {
+		fields = append(fields, reportrun.FieldTitle)
 	}
-	if m.FieldCleared(keyevent.FieldEventContent) {
-		fields = append(fields, keyevent.FieldEventContent)
+	if m.FieldCleared(reportrun.FieldUserID) {
+		fields = append(fields, reportrun.FieldUserID)
+	}
+	if m.FieldCleared(reportrun.FieldWindowStart) {
+		fields = append(fields, reportrun.FieldWindowStart)
+	}
+	if m.FieldCleared(reportrun.FieldWindowEnd) {
+		fields = append(fields, reportrun.FieldWindowEnd)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *KeyEventMutation) FieldCleared(name string) bool {
+func (m *ReportRunMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *KeyEventMutation) ClearField(name string) error {
+func (m *ReportRunMutation) ClearField(name string) error {
 	switch name {
-	case keyevent.FieldDomainReportID:
-		m.ClearDomainReportID()
+	case reportrun.FieldTitle:
+		m.ClearTitle()
 		return nil
-	case keyevent.FieldEventContent:
-		m.ClearEventContent()
+	case reportrun.FieldUserID:
+		m.ClearUserID()
+		return nil
+	case reportrun.FieldWindowStart:
+		m.ClearWindowStart()
+		return nil
+	case reportrun.FieldWindowEnd:
+		m.ClearWindowEnd()
 		return nil
 	}
-	return fmt.Errorf("unknown KeyEvent nullable field %s", name)
+	return fmt.Errorf("unknown ReportRun nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *KeyEventMutation) ResetField(name string) error {
+func (m *ReportRunMutation) ResetField(name string) error {
 	switch name {
-	case keyevent.FieldDomainReportID:
-		m.ResetDomainReportID()
+	case reportrun.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
-	case keyevent.FieldEventContent:
-		m.ResetEventContent()
+	case reportrun.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case reportrun.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case reportrun.FieldWindowStart:
+		m.ResetWindowStart()
+		return nil
+	case reportrun.FieldWindowEnd:
+		m.ResetWindowEnd()
+		return nil
+	case reportrun.FieldStatus:
+		m.ResetStatus()
 		return nil
 	}
-	return fmt.Errorf("unknown KeyEvent field %s", name)
+	return fmt.Errorf("unknown ReportRun field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *KeyEventMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.domain_report != nil {
-		edges = append(edges, keyevent.EdgeDomainReport)
+func (m *ReportRunMutation) AddedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.domain_reports != nil {
+		edges = append(edges, reportrun.EdgeDomainReports)
+	}
+	if m.generated_domain_reports != nil {
+		edges = append(edges, reportrun.EdgeGeneratedDomainReports)
+	}
+	if m.deep_analysis_results != nil {
+		edges = append(edges, reportrun.EdgeDeepAnalysisResults)
+	}
+	if m.llm_calls != nil {
+		edges = append(edges, reportrun.EdgeLlmCalls)
+	}
+	if m.domain_runs != nil {
+		edges = append(edges, reportrun.EdgeDomainRuns)
+	}
+	if m.events != nil {
+		edges = append(edges, reportrun.EdgeEvents)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *KeyEventMutation) AddedIDs(name string) []ent.Value {
+func (m *ReportRunMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case keyevent.EdgeDomainReport:
-		if id := m.domain_report; id != nil {
-			return []ent.Value{*id}
+	case reportrun.EdgeDomainReports:
+		ids := make([]ent.Value, 0, len(m.domain_reports))
+		for id := range m.domain_reports {
+			ids = append(ids, id)
+		}
+		return ids
+	case reportrun.EdgeGeneratedDomainReports:
+		ids := make([]ent.Value, 0, len(m.generated_domain_reports))
+		for id := range m.generated_domain_reports {
+			ids = append(ids, id)
+		}
+		return ids
+	case reportrun.EdgeDeepAnalysisResults:
+		ids := make([]ent.Value, 0, len(m.deep_analysis_results))
+		for id := range m.deep_analysis_results {
+			ids = append(ids, id)
 		}
+		return ids
+	case reportrun.EdgeLlmCalls:
+		ids := make([]ent.Value, 0, len(m.llm_calls))
+		for id := range m.llm_calls {
+			ids = append(ids, id)
+		}
+		return ids
+	case reportrun.EdgeDomainRuns:
+		ids := make([]ent.Value, 0, len(m.domain_runs))
+		for id := range m.domain_runs {
+			ids = append(ids, id)
+		}
+		return ids
+	case reportrun.EdgeEvents:
+		ids := make([]ent.Value, 0, len(m.events))
+		for id := range m.events {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *KeyEventMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *ReportRunMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.removeddomain_reports != nil {
+		edges = append(edges, reportrun.EdgeDomainReports)
+	}
+	if m.removedgenerated_domain_reports != nil {
+		edges = append(edges, reportrun.EdgeGeneratedDomainReports)
+	}
+	if m.removeddeep_analysis_results != nil {
+		edges = append(edges, reportrun.EdgeDeepAnalysisResults)
+	}
+	if m.removedllm_calls != nil {
+		edges = append(edges, reportrun.EdgeLlmCalls)
+	}
+	if m.removeddomain_runs != nil {
+		edges = append(edges, reportrun.EdgeDomainRuns)
+	}
+	if m.removedevents != nil {
+		edges = append(edges, reportrun.EdgeEvents)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *KeyEventMutation) RemovedIDs(name string) []ent.Value {
+func (m *ReportRunMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case reportrun.EdgeDomainReports:
+		ids := make([]ent.Value, 0, len(m.removeddomain_reports))
+		for id := range m.removeddomain_reports {
+			ids = append(ids, id)
+		}
+		return ids
+	case reportrun.EdgeGeneratedDomainReports:
+		ids := make([]ent.Value, 0, len(m.removedgenerated_domain_reports))
+		for id := range m.removedgenerated_domain_reports {
+			ids = append(ids, id)
+		}
+		return ids
+	case reportrun.EdgeDeepAnalysisResults:
+		ids := make([]ent.Value, 0, len(m.removeddeep_analysis_results))
+		for id := range m.removeddeep_analysis_results {
+			ids = append(ids, id)
+		}
+		return ids
+	case reportrun.EdgeLlmCalls:
+		ids := make([]ent.Value, 0, len(m.removedllm_calls))
+		for id := range m.removedllm_calls {
+			ids = append(ids, id)
+		}
+		return ids
+	case reportrun.EdgeDomainRuns:
+		ids := make([]ent.Value, 0, len(m.removeddomain_runs))
+		for id := range m.removeddomain_runs {
+			ids = append(ids, id)
+		}
+		return ids
+	case reportrun.EdgeEvents:
+		ids := make([]ent.Value, 0, len(m.removedevents))
+		for id := range m.removedevents {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *KeyEventMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.cleareddomain_report {
-		edges = append(edges, keyevent.EdgeDomainReport)
+func (m *ReportRunMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.cleareddomain_reports {
+		edges = append(edges, reportrun.EdgeDomainReports)
+	}
+	if m.clearedgenerated_domain_reports {
+		edges = append(edges, reportrun.EdgeGeneratedDomainReports)
+	}
+	if m.cleareddeep_analysis_results {
+		edges = append(edges, reportrun.EdgeDeepAnalysisResults)
+	}
+	if m.clearedllm_calls {
+		edges = append(edges, reportrun.EdgeLlmCalls)
+	}
+	if m.cleareddomain_runs {
+		edges = append(edges, reportrun.EdgeDomainRuns)
+	}
+	if m.clearedevents {
+		edges = append(edges, reportrun.EdgeEvents)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *KeyEventMutation) EdgeCleared(name string) bool {
+func (m *ReportRunMutation) EdgeCleared(name string) bool {
 	switch name {
-	case keyevent.EdgeDomainReport:
-		return m.cleareddomain_report
+	case reportrun.EdgeDomainReports:
+		return m.cleareddomain_reports
+	case reportrun.EdgeGeneratedDomainReports:
+		return m.clearedgenerated_domain_reports
+	case reportrun.EdgeDeepAnalysisResults:
+		return m.cleareddeep_analysis_results
+	case reportrun.EdgeLlmCalls:
+		return m.clearedllm_calls
+	case reportrun.EdgeDomainRuns:
+		return m.cleareddomain_runs
+	case reportrun.EdgeEvents:
+		return m.clearedevents
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *KeyEventMutation) ClearEdge(name string) error {
+func (m *ReportRunMutation) ClearEdge(name string) error {
 	switch name {
-	case keyevent.EdgeDomainReport:
-		m.ClearDomainReport()
-		return nil
 	}
-	return fmt.Errorf("unknown KeyEvent unique edge %s", name)
+	return fmt.Errorf("unknown ReportRun unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *KeyEventMutation) ResetEdge(name string) error {
+func (m *ReportRunMutation) ResetEdge(name string) error {
 	switch name {
-	case keyevent.EdgeDomainReport:
-		m.ResetDomainReport()
+	case reportrun.EdgeDomainReports:
+		m.ResetDomainReports()
+		return nil
+	case reportrun.EdgeGeneratedDomainReports:
+		m.ResetGeneratedDomainReports()
+		return nil
+	case reportrun.EdgeDeepAnalysisResults:
+		m.ResetDeepAnalysisResults()
+		return nil
+	case reportrun.EdgeLlmCalls:
+		m.ResetLlmCalls()
+		return nil
+	case reportrun.EdgeDomainRuns:
+		m.ResetDomainRuns()
+		return nil
+	case reportrun.EdgeEvents:
+		m.ResetEvents()
 		return nil
 	}
-	return fmt.Errorf("unknown KeyEvent edge %s", name)
+	return fmt.Errorf("unknown ReportRun edge %s", name)
 }
 
-// ReportRunMutation represents an operation that mutates the ReportRun nodes in the graph.
-type ReportRunMutation struct {
+// ReportRunEventMutation represents an operation that mutates the ReportRunEvent nodes in the graph.
+type ReportRunEventMutation struct {
 	config
-	op                           Op
-	typ                          string
-	id                           *int
-	created_at                   *time.Time
-	title                        *string
-	clearedFields                map[string]struct{}
-	domain_reports               map[int]struct{}
-	removeddomain_reports        map[int]struct{}
-	cleareddomain_reports        bool
-	deep_analysis_results        map[int]struct{}
-	removeddeep_analysis_results map[int]struct{}
-	cleareddeep_analysis_results bool
-	done                         bool
-	oldValue                     func(context.Context) (*ReportRun, error)
-	predicates                   []predicate.ReportRun
+	op                Op
+	typ               string
+	id                *int
+	domain            *string
+	_type             *string
+	message           *string
+	data              *map[string]interface{}
+	created_at        *time.Time
+	clearedFields     map[string]struct{}
+	report_run        *int
+	clearedreport_run bool
+	done              bool
+	oldValue          func(context.Context) (*ReportRunEvent, error)
+	predicates        []predicate.ReportRunEvent
 }
 
-var _ ent.Mutation = (*ReportRunMutation)(nil)
+var _ ent.Mutation = (*ReportRunEventMutation)(nil)
 
-// reportrunOption allows management of the mutation configuration using functional options.
-type reportrunOption func(*ReportRunMutation)
+// reportruneventOption allows management of the mutation configuration using functional options.
+type reportruneventOption func(*ReportRunEventMutation)
 
-// newReportRunMutation creates new mutation for the ReportRun entity.
-func newReportRunMutation(c config, op Op, opts ...reportrunOption) *ReportRunMutation {
-	m := &ReportRunMutation{
+// newReportRunEventMutation creates new mutation for the ReportRunEvent entity.
+func newReportRunEventMutation(c config, op Op, opts ...reportruneventOption) *ReportRunEventMutation {
+	m := &ReportRunEventMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeReportRun,
+		typ:           TypeReportRunEvent,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3677,20 +14335,20 @@ func newReportRunMutation(c config, op Op, opts ...reportrunOption) *ReportRunMu
 	return m
 }
 
-// withReportRunID sets the ID field of the mutation.
-func withReportRunID(id int) reportrunOption {
-	return func(m *ReportRunMutation) {
+// withReportRunEventID sets the ID field of the mutation.
+func withReportRunEventID(id int) reportruneventOption {
+	return func(m *ReportRunEventMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *ReportRun
+			value *ReportRunEvent
 		)
-		m.oldValue = func(ctx context.Context) (*ReportRun, error) {
+		m.oldValue = func(ctx context.Context) (*ReportRunEvent, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().ReportRun.Get(ctx, id)
+					value, err = m.Client().ReportRunEvent.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3699,10 +14357,10 @@ func withReportRunID(id int) reportrunOption {
 	}
 }
 
-// withReportRun sets the old ReportRun of the mutation.
-func withReportRun(node *ReportRun) reportrunOption {
-	return func(m *ReportRunMutation) {
-		m.oldValue = func(context.Context) (*ReportRun, error) {
+// withReportRunEvent sets the old ReportRunEvent of the mutation.
+func withReportRunEvent(node *ReportRunEvent) reportruneventOption {
+	return func(m *ReportRunEventMutation) {
+		m.oldValue = func(context.Context) (*ReportRunEvent, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3711,7 +14369,7 @@ func withReportRun(node *ReportRun) reportrunOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ReportRunMutation) Client() *Client {
+func (m ReportRunEventMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3719,7 +14377,7 @@ func (m ReportRunMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ReportRunMutation) Tx() (*Tx, error) {
+func (m ReportRunEventMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -3729,14 +14387,14 @@ func (m ReportRunMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of ReportRun entities.
-func (m *ReportRunMutation) SetID(id int) {
+// operation is only accepted on creation of ReportRunEvent entities.
+func (m *ReportRunEventMutation) SetID(id int) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ReportRunMutation) ID() (id int, exists bool) {
+func (m *ReportRunEventMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3747,7 +14405,7 @@ func (m *ReportRunMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ReportRunMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *ReportRunEventMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -3756,214 +14414,329 @@ func (m *ReportRunMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().ReportRun.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ReportRunEvent.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *ReportRunMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetRunID sets the "run_id" field.
+func (m *ReportRunEventMutation) SetRunID(i int) {
+	m.report_run = &i
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ReportRunMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// RunID returns the value of the "run_id" field in the mutation.
+func (m *ReportRunEventMutation) RunID() (r int, exists bool) {
+	v := m.report_run
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the ReportRun entity.
-// If the ReportRun object wasn't provided to the builder, the object is fetched from the database.
+// OldRunID returns the old "run_id" field's value of the ReportRunEvent entity.
+// If the ReportRunEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ReportRunMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ReportRunEventMutation) OldRunID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldRunID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldRunID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldRunID: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.RunID, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ReportRunMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearRunID clears the value of the "run_id" field.
+func (m *ReportRunEventMutation) ClearRunID() {
+	m.report_run = nil
+	m.clearedFields[reportrunevent.FieldRunID] = struct{}{}
 }
 
-// SetTitle sets the "title" field.
-func (m *ReportRunMutation) SetTitle(s string) {
-	m.title = &s
+// RunIDCleared returns if the "run_id" field was cleared in this mutation.
+func (m *ReportRunEventMutation) RunIDCleared() bool {
+	_, ok := m.clearedFields[reportrunevent.FieldRunID]
+	return ok
 }
 
-// Title returns the value of the "title" field in the mutation.
-func (m *ReportRunMutation) Title() (r string, exists bool) {
-	v := m.title
+// ResetRunID resets all changes to the "run_id" field.
+func (m *ReportRunEventMutation) ResetRunID() {
+	m.report_run = nil
+	delete(m.clearedFields, reportrunevent.FieldRunID)
+}
+
+// SetDomain sets the "domain" field.
+func (m *ReportRunEventMutation) SetDomain(s string) {
+	m.domain = &s
+}
+
+// Domain returns the value of the "domain" field in the mutation.
+func (m *ReportRunEventMutation) Domain() (r string, exists bool) {
+	v := m.domain
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTitle returns the old "title" field's value of the ReportRun entity.
-// If the ReportRun object wasn't provided to the builder, the object is fetched from the database.
+// OldDomain returns the old "domain" field's value of the ReportRunEvent entity.
+// If the ReportRunEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ReportRunMutation) OldTitle(ctx context.Context) (v string, err error) {
+func (m *ReportRunEventMutation) OldDomain(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+		return v, errors.New("OldDomain is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTitle requires an ID field in the mutation")
+		return v, errors.New("OldDomain requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+		return v, fmt.Errorf("querying old value for OldDomain: %w", err)
 	}
-	return oldValue.Title, nil
+	return oldValue.Domain, nil
 }
 
-// ClearTitle clears the value of the "title" field.
-func (m *ReportRunMutation) ClearTitle() {
-	m.title = nil
-	m.clearedFields[reportrun.FieldTitle] = struct{}{}
+// ClearDomain clears the value of the "domain" field.
+func (m *ReportRunEventMutation) ClearDomain() {
+	m.domain = nil
+	m.clearedFields[reportrunevent.FieldDomain] = struct{}{}
 }
 
-// TitleCleared returns if the "title" field was cleared in this mutation.
-func (m *ReportRunMutation) TitleCleared() bool {
-	_, ok := m.clearedFields[reportrun.FieldTitle]
+// DomainCleared returns if the "domain" field was cleared in this mutation.
+func (m *ReportRunEventMutation) DomainCleared() bool {
+	_, ok := m.clearedFields[reportrunevent.FieldDomain]
 	return ok
 }
 
-// ResetTitle resets all changes to the "title" field.
-func (m *ReportRunMutation) ResetTitle() {
-	m.title = nil
-	delete(m.clearedFields, reportrun.FieldTitle)
+// ResetDomain resets all changes to the "domain" field.
+func (m *ReportRunEventMutation) ResetDomain() {
+	m.domain = nil
+	delete(m.clearedFields, reportrunevent.FieldDomain)
 }
 
-// AddDomainReportIDs adds the "domain_reports" edge to the DomainReport entity by ids.
-func (m *ReportRunMutation) AddDomainReportIDs(ids ...int) {
-	if m.domain_reports == nil {
-		m.domain_reports = make(map[int]struct{})
+// SetType sets the "type" field.
+func (m *ReportRunEventMutation) SetType(s string) {
+	m._type = &s
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *ReportRunEventMutation) GetType() (r string, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the ReportRunEvent entity.
+// If the ReportRunEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportRunEventMutation) OldType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *ReportRunEventMutation) ResetType() {
+	m._type = nil
+}
+
+// SetMessage sets the "message" field.
+func (m *ReportRunEventMutation) SetMessage(s string) {
+	m.message = &s
+}
+
+// Message returns the value of the "message" field in the mutation.
+func (m *ReportRunEventMutation) Message() (r string, exists bool) {
+	v := m.message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessage returns the old "message" field's value of the ReportRunEvent entity.
+// If the ReportRunEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportRunEventMutation) OldMessage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessage: %w", err)
+	}
+	return oldValue.Message, nil
+}
+
+// ClearMessage clears the value of the "message" field.
+func (m *ReportRunEventMutation) ClearMessage() {
+	m.message = nil
+	m.clearedFields[reportrunevent.FieldMessage] = struct{}{}
+}
+
+// MessageCleared returns if the "message" field was cleared in this mutation.
+func (m *ReportRunEventMutation) MessageCleared() bool {
+	_, ok := m.clearedFields[reportrunevent.FieldMessage]
+	return ok
+}
+
+// ResetMessage resets all changes to the "message" field.
+func (m *ReportRunEventMutation) ResetMessage() {
+	m.message = nil
+	delete(m.clearedFields, reportrunevent.FieldMessage)
+}
+
+// SetData sets the "data" field.
+func (m *ReportRunEventMutation) SetData(value map[string]interface{}) {
+	m.data = &value
+}
+
+// Data returns the value of the "data" field in the mutation.
+func (m *ReportRunEventMutation) Data() (r map[string]interface{}, exists bool) {
+	v := m.data
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldData returns the old "data" field's value of the ReportRunEvent entity.
+// If the ReportRunEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportRunEventMutation) OldData(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldData is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldData requires an ID field in the mutation")
 	}
-	for i := range ids {
-		m.domain_reports[ids[i]] = struct{}{}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldData: %w", err)
 	}
+	return oldValue.Data, nil
 }
 
-// ClearDomainReports clears the "domain_reports" edge to the DomainReport entity.
-func (m *ReportRunMutation) ClearDomainReports() {
-	m.cleareddomain_reports = true
+// ClearData clears the value of the "data" field.
+func (m *ReportRunEventMutation) ClearData() {
+	m.data = nil
+	m.clearedFields[reportrunevent.FieldData] = struct{}{}
 }
 
-// DomainReportsCleared reports if the "domain_reports" edge to the DomainReport entity was cleared.
-func (m *ReportRunMutation) DomainReportsCleared() bool {
-	return m.cleareddomain_reports
+// DataCleared returns if the "data" field was cleared in this mutation.
+func (m *ReportRunEventMutation) DataCleared() bool {
+	_, ok := m.clearedFields[reportrunevent.FieldData]
+	return ok
 }
 
-// RemoveDomainReportIDs removes the "domain_reports" edge to the DomainReport entity by IDs.
-func (m *ReportRunMutation) RemoveDomainReportIDs(ids ...int) {
-	if m.removeddomain_reports == nil {
-		m.removeddomain_reports = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.domain_reports, ids[i])
-		m.removeddomain_reports[ids[i]] = struct{}{}
-	}
+// ResetData resets all changes to the "data" field.
+func (m *ReportRunEventMutation) ResetData() {
+	m.data = nil
+	delete(m.clearedFields, reportrunevent.FieldData)
 }
 
-// RemovedDomainReports returns the removed IDs of the "domain_reports" edge to the DomainReport entity.
-func (m *ReportRunMutation) RemovedDomainReportsIDs() (ids []int) {
-	for id := range m.removeddomain_reports {
-		ids = append(ids, id)
-	}
-	return
+// SetCreatedAt sets the "created_at" field.
+func (m *ReportRunEventMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// DomainReportsIDs returns the "domain_reports" edge IDs in the mutation.
-func (m *ReportRunMutation) DomainReportsIDs() (ids []int) {
-	for id := range m.domain_reports {
-		ids = append(ids, id)
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ReportRunEventMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
-	return
-}
-
-// ResetDomainReports resets all changes to the "domain_reports" edge.
-func (m *ReportRunMutation) ResetDomainReports() {
-	m.domain_reports = nil
-	m.cleareddomain_reports = false
-	m.removeddomain_reports = nil
+	return *v, true
 }
 
-// AddDeepAnalysisResultIDs adds the "deep_analysis_results" edge to the DeepAnalysisResult entity by ids.
-func (m *ReportRunMutation) AddDeepAnalysisResultIDs(ids ...int) {
-	if m.deep_analysis_results == nil {
-		m.deep_analysis_results = make(map[int]struct{})
+// OldCreatedAt returns the old "created_at" field's value of the ReportRunEvent entity.
+// If the ReportRunEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportRunEventMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.deep_analysis_results[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
+	return oldValue.CreatedAt, nil
 }
 
-// ClearDeepAnalysisResults clears the "deep_analysis_results" edge to the DeepAnalysisResult entity.
-func (m *ReportRunMutation) ClearDeepAnalysisResults() {
-	m.cleareddeep_analysis_results = true
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ReportRunEventMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// DeepAnalysisResultsCleared reports if the "deep_analysis_results" edge to the DeepAnalysisResult entity was cleared.
-func (m *ReportRunMutation) DeepAnalysisResultsCleared() bool {
-	return m.cleareddeep_analysis_results
+// SetReportRunID sets the "report_run" edge to the ReportRun entity by id.
+func (m *ReportRunEventMutation) SetReportRunID(id int) {
+	m.report_run = &id
 }
 
-// RemoveDeepAnalysisResultIDs removes the "deep_analysis_results" edge to the DeepAnalysisResult entity by IDs.
-func (m *ReportRunMutation) RemoveDeepAnalysisResultIDs(ids ...int) {
-	if m.removeddeep_analysis_results == nil {
-		m.removeddeep_analysis_results = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.deep_analysis_results, ids[i])
-		m.removeddeep_analysis_results[ids[i]] = struct{}{}
-	}
+// ClearReportRun clears the "report_run" edge to the ReportRun entity.
+func (m *ReportRunEventMutation) ClearReportRun() {
+	m.clearedreport_run = true
+	m.clearedFields[reportrunevent.FieldRunID] = struct{}{}
 }
 
-// RemovedDeepAnalysisResults returns the removed IDs of the "deep_analysis_results" edge to the DeepAnalysisResult entity.
-func (m *ReportRunMutation) RemovedDeepAnalysisResultsIDs() (ids []int) {
-	for id := range m.removeddeep_analysis_results {
-		ids = append(ids, id)
+// ReportRunCleared reports if the "report_run" edge to the ReportRun entity was cleared.
+func (m *ReportRunEventMutation) ReportRunCleared() bool {
+	return m.RunIDCleared() || m.clearedreport_run
+}
+
+// ReportRunID returns the "report_run" edge ID in the mutation.
+func (m *ReportRunEventMutation) ReportRunID() (id int, exists bool) {
+	if m.report_run != nil {
+		return *m.report_run, true
 	}
 	return
 }
 
-// DeepAnalysisResultsIDs returns the "deep_analysis_results" edge IDs in the mutation.
-func (m *ReportRunMutation) DeepAnalysisResultsIDs() (ids []int) {
-	for id := range m.deep_analysis_results {
-		ids = append(ids, id)
+// ReportRunIDs returns the "report_run" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ReportRunID instead. It exists only for internal usage by the builders.
+func (m *ReportRunEventMutation) ReportRunIDs() (ids []int) {
+	if id := m.report_run; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetDeepAnalysisResults resets all changes to the "deep_analysis_results" edge.
-func (m *ReportRunMutation) ResetDeepAnalysisResults() {
-	m.deep_analysis_results = nil
-	m.cleareddeep_analysis_results = false
-	m.removeddeep_analysis_results = nil
+// ResetReportRun resets all changes to the "report_run" edge.
+func (m *ReportRunEventMutation) ResetReportRun() {
+	m.report_run = nil
+	m.clearedreport_run = false
 }
 
-// Where appends a list predicates to the ReportRunMutation builder.
-func (m *ReportRunMutation) Where(ps ...predicate.ReportRun) {
+// Where appends a list predicates to the ReportRunEventMutation builder.
+func (m *ReportRunEventMutation) Where(ps ...predicate.ReportRunEvent) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ReportRunMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ReportRunEventMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ReportRunMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.ReportRun, len(ps))
+func (m *ReportRunEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ReportRunEvent, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3971,30 +14744,42 @@ func (m *ReportRunMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ReportRunMutation) Op() Op {
+func (m *ReportRunEventMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ReportRunMutation) SetOp(op Op) {
+func (m *ReportRunEventMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (ReportRun).
-func (m *ReportRunMutation) Type() string {
+// Type returns the node type of this mutation (ReportRunEvent).
+func (m *ReportRunEventMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ReportRunMutation) Fields() []string {
-	fields := make([]string, 0, 2)
-	if m.created_at != nil {
-		fields = append(fields, reportrun.FieldCreatedAt)
+func (m *ReportRunEventMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.report_run != nil {
+		fields = append(fields, reportrunevent.FieldRunID)
 	}
-	if m.title != nil {
-		fields = append(fields, reportrun.FieldTitle)
+	if m.domain != nil {
+		fields = append(fields, reportrunevent.FieldDomain)
+	}
+	if m._type != nil {
+		fields = append(fields, reportrunevent.FieldType)
+	}
+	if m.message != nil {
+		fields = append(fields, reportrunevent.FieldMessage)
+	}
+	if m.data != nil {
+		fields = append(fields, reportrunevent.FieldData)
+	}
+	if m.created_at != nil {
+		fields = append(fields, reportrunevent.FieldCreatedAt)
 	}
 	return fields
 }
@@ -4002,12 +14787,20 @@ func (m *ReportRunMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ReportRunMutation) Field(name string) (ent.Value, bool) {
+func (m *ReportRunEventMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case reportrun.FieldCreatedAt:
+	case reportrunevent.FieldRunID:
+		return m.RunID()
+	case reportrunevent.FieldDomain:
+		return m.Domain()
+	case reportrunevent.FieldType:
+		return m.GetType()
+	case reportrunevent.FieldMessage:
+		return m.Message()
+	case reportrunevent.FieldData:
+		return m.Data()
+	case reportrunevent.FieldCreatedAt:
 		return m.CreatedAt()
-	case reportrun.FieldTitle:
-		return m.Title()
 	}
 	return nil, false
 }
@@ -4015,229 +14808,273 @@ func (m *ReportRunMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ReportRunMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ReportRunEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case reportrun.FieldCreatedAt:
+	case reportrunevent.FieldRunID:
+		return m.OldRunID(ctx)
+	case reportrunevent.FieldDomain:
+		return m.OldDomain(ctx)
+	case reportrunevent.FieldType:
+		return m.OldType(ctx)
+	case reportrunevent.FieldMessage:
+		return m.OldMessage(ctx)
+	case reportrunevent.FieldData:
+		return m.OldData(ctx)
+	case reportrunevent.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case reportrun.FieldTitle:
-		return m.OldTitle(ctx)
 	}
-	return nil, fmt.Errorf("unknown ReportRun field %s", name)
+	return nil, fmt.Errorf("unknown ReportRunEvent field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ReportRunMutation) SetField(name string, value ent.Value) error {
+func (m *ReportRunEventMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case reportrun.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case reportrunevent.FieldRunID:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetRunID(v)
 		return nil
-	case reportrun.FieldTitle:
+	case reportrunevent.FieldDomain:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTitle(v)
+		m.SetDomain(v)
+		return nil
+	case reportrunevent.FieldType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case reportrunevent.FieldMessage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessage(v)
+		return nil
+	case reportrunevent.FieldData:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetData(v)
+		return nil
+	case reportrunevent.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown ReportRun field %s", name)
+	return fmt.Errorf("unknown ReportRunEvent field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ReportRunMutation) AddedFields() []string {
-	return nil
+func (m *ReportRunEventMutation) AddedFields() []string {
+	var fields []string
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ReportRunMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ReportRunEventMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ReportRunMutation) AddField(name string, value ent.Value) error {
+func (m *ReportRunEventMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown ReportRun numeric field %s", name)
+	return fmt.Errorf("unknown ReportRunEvent numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ReportRunMutation) ClearedFields() []string {
+func (m *ReportRunEventMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(reportrun.FieldTitle) {
-		fields = append(fields, reportrun.FieldTitle)
+	if m.FieldCleared(reportrunevent.FieldRunID) {
+		fields = append(fields, reportrunevent.FieldRunID)
+	}
+	if m.FieldCleared(reportrunevent.FieldDomain) {
+		fields = append(fields, reportrunevent.FieldDomain)
+	}
+	if m.FieldCleared(reportrunevent.FieldMessage) {
+		fields = append(fields, reportrunevent.FieldMessage)
+	}
+	if m.FieldCleared(reportrunevent.FieldData) {
+		fields = append(fields, reportrunevent.FieldData)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ReportRunMutation) FieldCleared(name string) bool {
+func (m *ReportRunEventMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ReportRunMutation) ClearField(name string) error {
+func (m *ReportRunEventMutation) ClearField(name string) error {
 	switch name {
-	case reportrun.FieldTitle:
-		m.ClearTitle()
+	case reportrunevent.FieldRunID:
+		m.ClearRunID()
+		return nil
+	case reportrunevent.FieldDomain:
+		m.ClearDomain()
+		return nil
+	case reportrunevent.FieldMessage:
+		m.ClearMessage()
+		return nil
+	case reportrunevent.FieldData:
+		m.ClearData()
 		return nil
 	}
-	return fmt.Errorf("unknown ReportRun nullable field %s", name)
+	return fmt.Errorf("unknown ReportRunEvent nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ReportRunMutation) ResetField(name string) error {
+func (m *ReportRunEventMutation) ResetField(name string) error {
 	switch name {
-	case reportrun.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case reportrunevent.FieldRunID:
+		m.ResetRunID()
 		return nil
-	case reportrun.FieldTitle:
-		m.ResetTitle()
+	case reportrunevent.FieldDomain:
+		m.ResetDomain()
+		return nil
+	case reportrunevent.FieldType:
+		m.ResetType()
+		return nil
+	case reportrunevent.FieldMessage:
+		m.ResetMessage()
+		return nil
+	case reportrunevent.FieldData:
+		m.ResetData()
+		return nil
+	case reportrunevent.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown ReportRun field %s", name)
+	return fmt.Errorf("unknown ReportRunEvent field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ReportRunMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.domain_reports != nil {
-		edges = append(edges, reportrun.EdgeDomainReports)
-	}
-	if m.deep_analysis_results != nil {
-		edges = append(edges, reportrun.EdgeDeepAnalysisResults)
+func (m *ReportRunEventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.report_run != nil {
+		edges = append(edges, reportrunevent.EdgeReportRun)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ReportRunMutation) AddedIDs(name string) []ent.Value {
+func (m *ReportRunEventMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case reportrun.EdgeDomainReports:
-		ids := make([]ent.Value, 0, len(m.domain_reports))
-		for id := range m.domain_reports {
-			ids = append(ids, id)
-		}
-		return ids
-	case reportrun.EdgeDeepAnalysisResults:
-		ids := make([]ent.Value, 0, len(m.deep_analysis_results))
-		for id := range m.deep_analysis_results {
-			ids = append(ids, id)
+	case reportrunevent.EdgeReportRun:
+		if id := m.report_run; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ReportRunMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removeddomain_reports != nil {
-		edges = append(edges, reportrun.EdgeDomainReports)
-	}
-	if m.removeddeep_analysis_results != nil {
-		edges = append(edges, reportrun.EdgeDeepAnalysisResults)
-	}
+func (m *ReportRunEventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ReportRunMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case reportrun.EdgeDomainReports:
-		ids := make([]ent.Value, 0, len(m.removeddomain_reports))
-		for id := range m.removeddomain_reports {
-			ids = append(ids, id)
-		}
-		return ids
-	case reportrun.EdgeDeepAnalysisResults:
-		ids := make([]ent.Value, 0, len(m.removeddeep_analysis_results))
-		for id := range m.removeddeep_analysis_results {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *ReportRunEventMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ReportRunMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.cleareddomain_reports {
-		edges = append(edges, reportrun.EdgeDomainReports)
-	}
-	if m.cleareddeep_analysis_results {
-		edges = append(edges, reportrun.EdgeDeepAnalysisResults)
+func (m *ReportRunEventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedreport_run {
+		edges = append(edges, reportrunevent.EdgeReportRun)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ReportRunMutation) EdgeCleared(name string) bool {
+func (m *ReportRunEventMutation) EdgeCleared(name string) bool {
 	switch name {
-	case reportrun.EdgeDomainReports:
-		return m.cleareddomain_reports
-	case reportrun.EdgeDeepAnalysisResults:
-		return m.cleareddeep_analysis_results
+	case reportrunevent.EdgeReportRun:
+		return m.clearedreport_run
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ReportRunMutation) ClearEdge(name string) error {
+func (m *ReportRunEventMutation) ClearEdge(name string) error {
 	switch name {
+	case reportrunevent.EdgeReportRun:
+		m.ClearReportRun()
+		return nil
 	}
-	return fmt.Errorf("unknown ReportRun unique edge %s", name)
+	return fmt.Errorf("unknown ReportRunEvent unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ReportRunMutation) ResetEdge(name string) error {
+func (m *ReportRunEventMutation) ResetEdge(name string) error {
 	switch name {
-	case reportrun.EdgeDomainReports:
-		m.ResetDomainReports()
-		return nil
-	case reportrun.EdgeDeepAnalysisResults:
-		m.ResetDeepAnalysisResults()
+	case reportrunevent.EdgeReportRun:
+		m.ResetReportRun()
 		return nil
 	}
-	return fmt.Errorf("unknown ReportRun edge %s", name)
+	return fmt.Errorf("unknown ReportRunEvent edge %s", name)
 }
 
 // UserMutation represents an operation that mutates the User nodes in the graph.
 type UserMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	username      *string
-	password_hash *string
-	persona       *string
-	domains       *[]string
-	appenddomains []string
-	created_at    *time.Time
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*User, error)
-	predicates    []predicate.User
+	op                     Op
+	typ                    string
+	id                     *int
+	username               *string
+	password_hash          *string
+	persona                *string
+	domains                *[]model.DomainConfig
+	appenddomains          []model.DomainConfig
+	max_tokens_per_run     *int
+	addmax_tokens_per_run  *int
+	max_cost_per_run       *float64
+	addmax_cost_per_run    *float64
+	report_language        *string
+	created_at             *time.Time
+	clearedFields          map[string]struct{}
+	personas               map[int]struct{}
+	removedpersonas        map[int]struct{}
+	clearedpersonas        bool
+	analysis_lenses        map[int]struct{}
+	removedanalysis_lenses map[int]struct{}
+	clearedanalysis_lenses bool
+	done                   bool
+	oldValue               func(context.Context) (*User, error)
+	predicates             []predicate.User
 }
 
 var _ ent.Mutation = (*UserMutation)(nil)
@@ -4466,13 +15303,13 @@ func (m *UserMutation) ResetPersona() {
 }
 
 // SetDomains sets the "domains" field.
-func (m *UserMutation) SetDomains(s []string) {
-	m.domains = &s
+func (m *UserMutation) SetDomains(mc []model.DomainConfig) {
+	m.domains = &mc
 	m.appenddomains = nil
 }
 
 // Domains returns the value of the "domains" field in the mutation.
-func (m *UserMutation) Domains() (r []string, exists bool) {
+func (m *UserMutation) Domains() (r []model.DomainConfig, exists bool) {
 	v := m.domains
 	if v == nil {
 		return
@@ -4483,7 +15320,7 @@ func (m *UserMutation) Domains() (r []string, exists bool) {
 // OldDomains returns the old "domains" field's value of the User entity.
 // If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldDomains(ctx context.Context) (v []string, err error) {
+func (m *UserMutation) OldDomains(ctx context.Context) (v []model.DomainConfig, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldDomains is only allowed on UpdateOne operations")
 	}
@@ -4497,13 +15334,13 @@ func (m *UserMutation) OldDomains(ctx context.Context) (v []string, err error) {
 	return oldValue.Domains, nil
 }
 
-// AppendDomains adds s to the "domains" field.
-func (m *UserMutation) AppendDomains(s []string) {
-	m.appenddomains = append(m.appenddomains, s...)
+// AppendDomains adds mc to the "domains" field.
+func (m *UserMutation) AppendDomains(mc []model.DomainConfig) {
+	m.appenddomains = append(m.appenddomains, mc...)
 }
 
 // AppendedDomains returns the list of values that were appended to the "domains" field in this mutation.
-func (m *UserMutation) AppendedDomains() ([]string, bool) {
+func (m *UserMutation) AppendedDomains() ([]model.DomainConfig, bool) {
 	if len(m.appenddomains) == 0 {
 		return nil, false
 	}
@@ -4530,6 +15367,182 @@ func (m *UserMutation) ResetDomains() {
 	delete(m.clearedFields, user.FieldDomains)
 }
 
+// SetMaxTokensPerRun sets the "max_tokens_per_run" field.
+func (m *UserMutation) SetMaxTokensPerRun(i int) {
+	m.max_tokens_per_run = &i
+	m.addmax_tokens_per_run = nil
+}
+
+// MaxTokensPerRun returns the value of the "max_tokens_per_run" field in the mutation.
+func (m *UserMutation) MaxTokensPerRun() (r int, exists bool) {
+	v := m.max_tokens_per_run
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxTokensPerRun returns the old "max_tokens_per_run" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldMaxTokensPerRun(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxTokensPerRun is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxTokensPerRun requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxTokensPerRun: %w", err)
+	}
+	return oldValue.MaxTokensPerRun, nil
+}
+
+// AddMaxTokensPerRun adds i to the "max_tokens_per_run" field.
+func (m *UserMutation) AddMaxTokensPerRun(i int) {
+	if m.addmax_tokens_per_run != nil {
+		*m.addmax_tokens_per_run += i
+	} else {
+		m.addmax_tokens_per_run = &i
+	}
+}
+
+// AddedMaxTokensPerRun returns the value that was added to the "max_tokens_per_run" field in this mutation.
+func (m *UserMutation) AddedMaxTokensPerRun() (r int, exists bool) {
+	v := m.addmax_tokens_per_run
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearMaxTokensPerRun clears the value of the "max_tokens_per_run" field.
+func (m *UserMutation) ClearMaxTokensPerRun() {
+	m.max_tokens_per_run = nil
+	m.addmax_tokens_per_run = nil
+	m.clearedFields[user.FieldMaxTokensPerRun] = struct{}{}
+}
+
+// MaxTokensPerRunCleared returns if the "max_tokens_per_run" field was cleared in this mutation.
+func (m *UserMutation) MaxTokensPerRunCleared() bool {
+	_, ok := m.clearedFields[user.FieldMaxTokensPerRun]
+	return ok
+}
+
+// ResetMaxTokensPerRun resets all changes to the "max_tokens_per_run" field.
+func (m *UserMutation) ResetMaxTokensPerRun() {
+	m.max_tokens_per_run = nil
+	m.addmax_tokens_per_run = nil
+	delete(m.clearedFields, user.FieldMaxTokensPerRun)
+}
+
+// SetMaxCostPerRun sets the "max_cost_per_run" field.
+func (m *UserMutation) SetMaxCostPerRun(f float64) {
+	m.max_cost_per_run = &f
+	m.addmax_cost_per_run = nil
+}
+
+// MaxCostPerRun returns the value of the "max_cost_per_run" field in the mutation.
+func (m *UserMutation) MaxCostPerRun() (r float64, exists bool) {
+	v := m.max_cost_per_run
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxCostPerRun returns the old "max_cost_per_run" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldMaxCostPerRun(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxCostPerRun is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxCostPerRun requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxCostPerRun: %w", err)
+	}
+	return oldValue.MaxCostPerRun, nil
+}
+
+// AddMaxCostPerRun adds f to the "max_cost_per_run" field.
+func (m *UserMutation) AddMaxCostPerRun(f float64) {
+	if m.addmax_cost_per_run != nil {
+		*m.addmax_cost_per_run += f
+	} else {
+		m.addmax_cost_per_run = &f
+	}
+}
+
+// AddedMaxCostPerRun returns the value that was added to the "max_cost_per_run" field in this mutation.
+func (m *UserMutation) AddedMaxCostPerRun() (r float64, exists bool) {
+	v := m.addmax_cost_per_run
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearMaxCostPerRun clears the value of the "max_cost_per_run" field.
+func (m *UserMutation) ClearMaxCostPerRun() {
+	m.max_cost_per_run = nil
+	m.addmax_cost_per_run = nil
+	m.clearedFields[user.FieldMaxCostPerRun] = struct{}{}
+}
+
+// MaxCostPerRunCleared returns if the "max_cost_per_run" field was cleared in this mutation.
+func (m *UserMutation) MaxCostPerRunCleared() bool {
+	_, ok := m.clearedFields[user.FieldMaxCostPerRun]
+	return ok
+}
+
+// ResetMaxCostPerRun resets all changes to the "max_cost_per_run" field.
+func (m *UserMutation) ResetMaxCostPerRun() {
+	m.max_cost_per_run = nil
+	m.addmax_cost_per_run = nil
+	delete(m.clearedFields, user.FieldMaxCostPerRun)
+}
+
+// SetReportLanguage sets the "report_language" field.
+func (m *UserMutation) SetReportLanguage(s string) {
+	m.report_language = &s
+}
+
+// ReportLanguage returns the value of the "report_language" field in the mutation.
+func (m *UserMutation) ReportLanguage() (r string, exists bool) {
+	v := m.report_language
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReportLanguage returns the old "report_language" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldReportLanguage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReportLanguage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReportLanguage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReportLanguage: %w", err)
+	}
+	return oldValue.ReportLanguage, nil
+}
+
+// ResetReportLanguage resets all changes to the "report_language" field.
+func (m *UserMutation) ResetReportLanguage() {
+	m.report_language = nil
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (m *UserMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
@@ -4566,6 +15579,114 @@ func (m *UserMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
+// AddPersonaIDs adds the "personas" edge to the Persona entity by ids.
+func (m *UserMutation) AddPersonaIDs(ids ...int) {
+	if m.personas == nil {
+		m.personas = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.personas[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPersonas clears the "personas" edge to the Persona entity.
+func (m *UserMutation) ClearPersonas() {
+	m.clearedpersonas = true
+}
+
+// PersonasCleared reports if the "personas" edge to the Persona entity was cleared.
+func (m *UserMutation) PersonasCleared() bool {
+	return m.clearedpersonas
+}
+
+// RemovePersonaIDs removes the "personas" edge to the Persona entity by IDs.
+func (m *UserMutation) RemovePersonaIDs(ids ...int) {
+	if m.removedpersonas == nil {
+		m.removedpersonas = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.personas, ids[i])
+		m.removedpersonas[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPersonas returns the removed IDs of the "personas" edge to the Persona entity.
+func (m *UserMutation) RemovedPersonasIDs() (ids []int) {
+	for id := range m.removedpersonas {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PersonasIDs returns the "personas" edge IDs in the mutation.
+func (m *UserMutation) PersonasIDs() (ids []int) {
+	for id := range m.personas {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPersonas resets all changes to the "personas" edge.
+func (m *UserMutation) ResetPersonas() {
+	m.personas = nil
+	m.clearedpersonas = false
+	m.removedpersonas = nil
+}
+
+// AddAnalysisLenseIDs adds the "analysis_lenses" edge to the AnalysisLens entity by ids.
+func (m *UserMutation) AddAnalysisLenseIDs(ids ...int) {
+	if m.analysis_lenses == nil {
+		m.analysis_lenses = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.analysis_lenses[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAnalysisLenses clears the "analysis_lenses" edge to the AnalysisLens entity.
+func (m *UserMutation) ClearAnalysisLenses() {
+	m.clearedanalysis_lenses = true
+}
+
+// AnalysisLensesCleared reports if the "analysis_lenses" edge to the AnalysisLens entity was cleared.
+func (m *UserMutation) AnalysisLensesCleared() bool {
+	return m.clearedanalysis_lenses
+}
+
+// RemoveAnalysisLenseIDs removes the "analysis_lenses" edge to the AnalysisLens entity by IDs.
+func (m *UserMutation) RemoveAnalysisLenseIDs(ids ...int) {
+	if m.removedanalysis_lenses == nil {
+		m.removedanalysis_lenses = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.analysis_lenses, ids[i])
+		m.removedanalysis_lenses[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAnalysisLenses returns the removed IDs of the "analysis_lenses" edge to the AnalysisLens entity.
+func (m *UserMutation) RemovedAnalysisLensesIDs() (ids []int) {
+	for id := range m.removedanalysis_lenses {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AnalysisLensesIDs returns the "analysis_lenses" edge IDs in the mutation.
+func (m *UserMutation) AnalysisLensesIDs() (ids []int) {
+	for id := range m.analysis_lenses {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAnalysisLenses resets all changes to the "analysis_lenses" edge.
+func (m *UserMutation) ResetAnalysisLenses() {
+	m.analysis_lenses = nil
+	m.clearedanalysis_lenses = false
+	m.removedanalysis_lenses = nil
+}
+
 // Where appends a list predicates to the UserMutation builder.
 func (m *UserMutation) Where(ps ...predicate.User) {
 	m.predicates = append(m.predicates, ps...)
@@ -4600,7 +15721,7 @@ func (m *UserMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 5)
+	fields := make([]string, 0, 8)
 	if m.username != nil {
 		fields = append(fields, user.FieldUsername)
 	}
@@ -4613,6 +15734,15 @@ func (m *UserMutation) Fields() []string {
 	if m.domains != nil {
 		fields = append(fields, user.FieldDomains)
 	}
+	if m.max_tokens_per_run != nil {
+		fields = append(fields, user.FieldMaxTokensPerRun)
+	}
+	if m.max_cost_per_run != nil {
+		fields = append(fields, user.FieldMaxCostPerRun)
+	}
+	if m.report_language != nil {
+		fields = append(fields, user.FieldReportLanguage)
+	}
 	if m.created_at != nil {
 		fields = append(fields, user.FieldCreatedAt)
 	}
@@ -4632,6 +15762,12 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 		return m.Persona()
 	case user.FieldDomains:
 		return m.Domains()
+	case user.FieldMaxTokensPerRun:
+		return m.MaxTokensPerRun()
+	case user.FieldMaxCostPerRun:
+		return m.MaxCostPerRun()
+	case user.FieldReportLanguage:
+		return m.ReportLanguage()
 	case user.FieldCreatedAt:
 		return m.CreatedAt()
 	}
@@ -4651,6 +15787,12 @@ func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, er
 		return m.OldPersona(ctx)
 	case user.FieldDomains:
 		return m.OldDomains(ctx)
+	case user.FieldMaxTokensPerRun:
+		return m.OldMaxTokensPerRun(ctx)
+	case user.FieldMaxCostPerRun:
+		return m.OldMaxCostPerRun(ctx)
+	case user.FieldReportLanguage:
+		return m.OldReportLanguage(ctx)
 	case user.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	}
@@ -4684,12 +15826,33 @@ func (m *UserMutation) SetField(name string, value ent.Value) error {
 		m.SetPersona(v)
 		return nil
 	case user.FieldDomains:
-		v, ok := value.([]string)
+		v, ok := value.([]model.DomainConfig)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetDomains(v)
 		return nil
+	case user.FieldMaxTokensPerRun:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxTokensPerRun(v)
+		return nil
+	case user.FieldMaxCostPerRun:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxCostPerRun(v)
+		return nil
+	case user.FieldReportLanguage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReportLanguage(v)
+		return nil
 	case user.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
@@ -4704,13 +15867,26 @@ func (m *UserMutation) SetField(name string, value ent.Value) error {
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
 func (m *UserMutation) AddedFields() []string {
-	return nil
+	var fields []string
+	if m.addmax_tokens_per_run != nil {
+		fields = append(fields, user.FieldMaxTokensPerRun)
+	}
+	if m.addmax_cost_per_run != nil {
+		fields = append(fields, user.FieldMaxCostPerRun)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
 func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case user.FieldMaxTokensPerRun:
+		return m.AddedMaxTokensPerRun()
+	case user.FieldMaxCostPerRun:
+		return m.AddedMaxCostPerRun()
+	}
 	return nil, false
 }
 
@@ -4719,6 +15895,20 @@ func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
 // type.
 func (m *UserMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case user.FieldMaxTokensPerRun:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxTokensPerRun(v)
+		return nil
+	case user.FieldMaxCostPerRun:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxCostPerRun(v)
+		return nil
 	}
 	return fmt.Errorf("unknown User numeric field %s", name)
 }
@@ -4733,6 +15923,12 @@ func (m *UserMutation) ClearedFields() []string {
 	if m.FieldCleared(user.FieldDomains) {
 		fields = append(fields, user.FieldDomains)
 	}
+	if m.FieldCleared(user.FieldMaxTokensPerRun) {
+		fields = append(fields, user.FieldMaxTokensPerRun)
+	}
+	if m.FieldCleared(user.FieldMaxCostPerRun) {
+		fields = append(fields, user.FieldMaxCostPerRun)
+	}
 	return fields
 }
 
@@ -4753,6 +15949,12 @@ func (m *UserMutation) ClearField(name string) error {
 	case user.FieldDomains:
 		m.ClearDomains()
 		return nil
+	case user.FieldMaxTokensPerRun:
+		m.ClearMaxTokensPerRun()
+		return nil
+	case user.FieldMaxCostPerRun:
+		m.ClearMaxCostPerRun()
+		return nil
 	}
 	return fmt.Errorf("unknown User nullable field %s", name)
 }
@@ -4773,6 +15975,15 @@ func (m *UserMutation) ResetField(name string) error {
 	case user.FieldDomains:
 		m.ResetDomains()
 		return nil
+	case user.FieldMaxTokensPerRun:
+		m.ResetMaxTokensPerRun()
+		return nil
+	case user.FieldMaxCostPerRun:
+		m.ResetMaxCostPerRun()
+		return nil
+	case user.FieldReportLanguage:
+		m.ResetReportLanguage()
+		return nil
 	case user.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
@@ -4782,48 +15993,110 @@ func (m *UserMutation) ResetField(name string) error {
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *UserMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+	edges := make([]string, 0, 2)
+	if m.personas != nil {
+		edges = append(edges, user.EdgePersonas)
+	}
+	if m.analysis_lenses != nil {
+		edges = append(edges, user.EdgeAnalysisLenses)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
 func (m *UserMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case user.EdgePersonas:
+		ids := make([]ent.Value, 0, len(m.personas))
+		for id := range m.personas {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeAnalysisLenses:
+		ids := make([]ent.Value, 0, len(m.analysis_lenses))
+		for id := range m.analysis_lenses {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *UserMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+	edges := make([]string, 0, 2)
+	if m.removedpersonas != nil {
+		edges = append(edges, user.EdgePersonas)
+	}
+	if m.removedanalysis_lenses != nil {
+		edges = append(edges, user.EdgeAnalysisLenses)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
 func (m *UserMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case user.EdgePersonas:
+		ids := make([]ent.Value, 0, len(m.removedpersonas))
+		for id := range m.removedpersonas {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeAnalysisLenses:
+		ids := make([]ent.Value, 0, len(m.removedanalysis_lenses))
+		for id := range m.removedanalysis_lenses {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *UserMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+	edges := make([]string, 0, 2)
+	if m.clearedpersonas {
+		edges = append(edges, user.EdgePersonas)
+	}
+	if m.clearedanalysis_lenses {
+		edges = append(edges, user.EdgeAnalysisLenses)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
 func (m *UserMutation) EdgeCleared(name string) bool {
+	switch name {
+	case user.EdgePersonas:
+		return m.clearedpersonas
+	case user.EdgeAnalysisLenses:
+		return m.clearedanalysis_lenses
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
 func (m *UserMutation) ClearEdge(name string) error {
+	switch name {
+	}
 	return fmt.Errorf("unknown User unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
 func (m *UserMutation) ResetEdge(name string) error {
+	switch name {
+	case user.EdgePersonas:
+		m.ResetPersonas()
+		return nil
+	case user.EdgeAnalysisLenses:
+		m.ResetAnalysisLenses()
+		return nil
+	}
 	return fmt.Errorf("unknown User edge %s", name)
 }
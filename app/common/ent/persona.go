@@ -0,0 +1,294 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/iWorld-y/domain_radar/app/common/ent/persona"
+	"github.com/iWorld-y/domain_radar/app/common/ent/user"
+)
+
+// Persona is the model entity for the Persona schema.
+type Persona struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// Persona name, unique per user, e.g. tech lead or angel investor
+	Name string `json:"name,omitempty"`
+	// Version number per user and name, starting from 1; the highest version is current
+	Version int `json:"version,omitempty"`
+	// Role holds the value of the "role" field.
+	Role string `json:"role,omitempty"`
+	// Seniority holds the value of the "seniority" field.
+	Seniority string `json:"seniority,omitempty"`
+	// TechStack holds the value of the "tech_stack" field.
+	TechStack []string `json:"tech_stack,omitempty"`
+	// Goals holds the value of the "goals" field.
+	Goals []string `json:"goals,omitempty"`
+	// Risk appetite: low, medium or high
+	RiskAppetite string `json:"risk_appetite,omitempty"`
+	// TimeHorizon holds the value of the "time_horizon" field.
+	TimeHorizon string `json:"time_horizon,omitempty"`
+	// Constraints holds the value of the "constraints" field.
+	Constraints []string `json:"constraints,omitempty"`
+	// How the persona was produced: manual or interview
+	Source string `json:"source,omitempty"`
+	// Archived personas are hidden but kept for past deep analyses
+	Archived bool `json:"archived,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PersonaQuery when eager-loading is set.
+	Edges        PersonaEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PersonaEdges holds the relations/edges for other nodes in the graph.
+type PersonaEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// DeepAnalysisResults holds the value of the deep_analysis_results edge.
+	DeepAnalysisResults []*DeepAnalysisResult `json:"deep_analysis_results,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PersonaEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// DeepAnalysisResultsOrErr returns the DeepAnalysisResults value or an error if the edge
+// was not loaded in eager-loading.
+func (e PersonaEdges) DeepAnalysisResultsOrErr() ([]*DeepAnalysisResult, error) {
+	if e.loadedTypes[1] {
+		return e.DeepAnalysisResults, nil
+	}
+	return nil, &NotLoadedError{edge: "deep_analysis_results"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Persona) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case persona.FieldTechStack, persona.FieldGoals, persona.FieldConstraints:
+			values[i] = new([]byte)
+		case persona.FieldArchived:
+			values[i] = new(sql.NullBool)
+		case persona.FieldID, persona.FieldUserID, persona.FieldVersion:
+			values[i] = new(sql.NullInt64)
+		case persona.FieldName, persona.FieldRole, persona.FieldSeniority, persona.FieldRiskAppetite, persona.FieldTimeHorizon, persona.FieldSource:
+			values[i] = new(sql.NullString)
+		case persona.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Persona fields.
+func (_m *Persona) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case persona.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case persona.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = int(value.Int64)
+			}
+		case persona.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case persona.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				_m.Version = int(value.Int64)
+			}
+		case persona.FieldRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field role", values[i])
+			} else if value.Valid {
+				_m.Role = value.String
+			}
+		case persona.FieldSeniority:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field seniority", values[i])
+			} else if value.Valid {
+				_m.Seniority = value.String
+			}
+		case persona.FieldTechStack:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field tech_stack", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.TechStack); err != nil {
+					return fmt.Errorf("unmarshal field tech_stack: %w", err)
+				}
+			}
+		case persona.FieldGoals:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field goals", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Goals); err != nil {
+					return fmt.Errorf("unmarshal field goals: %w", err)
+				}
+			}
+		case persona.FieldRiskAppetite:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field risk_appetite", values[i])
+			} else if value.Valid {
+				_m.RiskAppetite = value.String
+			}
+		case persona.FieldTimeHorizon:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field time_horizon", values[i])
+			} else if value.Valid {
+				_m.TimeHorizon = value.String
+			}
+		case persona.FieldConstraints:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field constraints", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Constraints); err != nil {
+					return fmt.Errorf("unmarshal field constraints: %w", err)
+				}
+			}
+		case persona.FieldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value.Valid {
+				_m.Source = value.String
+			}
+		case persona.FieldArchived:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field archived", values[i])
+			} else if value.Valid {
+				_m.Archived = value.Bool
+			}
+		case persona.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Persona.
+// This includes values selected through modifiers, order, etc.
+func (_m *Persona) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the Persona entity.
+func (_m *Persona) QueryUser() *UserQuery {
+	return NewPersonaClient(_m.config).QueryUser(_m)
+}
+
+// QueryDeepAnalysisResults queries the "deep_analysis_results" edge of the Persona entity.
+func (_m *Persona) QueryDeepAnalysisResults() *DeepAnalysisResultQuery {
+	return NewPersonaClient(_m.config).QueryDeepAnalysisResults(_m)
+}
+
+// Update returns a builder for updating this Persona.
+// Note that you need to call Persona.Unwrap() before calling this method if this Persona
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Persona) Update() *PersonaUpdateOne {
+	return NewPersonaClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Persona entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Persona) Unwrap() *Persona {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Persona is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Persona) String() string {
+	var builder strings.Builder
+	builder.WriteString("Persona(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Version))
+	builder.WriteString(", ")
+	builder.WriteString("role=")
+	builder.WriteString(_m.Role)
+	builder.WriteString(", ")
+	builder.WriteString("seniority=")
+	builder.WriteString(_m.Seniority)
+	builder.WriteString(", ")
+	builder.WriteString("tech_stack=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TechStack))
+	builder.WriteString(", ")
+	builder.WriteString("goals=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Goals))
+	builder.WriteString(", ")
+	builder.WriteString("risk_appetite=")
+	builder.WriteString(_m.RiskAppetite)
+	builder.WriteString(", ")
+	builder.WriteString("time_horizon=")
+	builder.WriteString(_m.TimeHorizon)
+	builder.WriteString(", ")
+	builder.WriteString("constraints=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Constraints))
+	builder.WriteString(", ")
+	builder.WriteString("source=")
+	builder.WriteString(_m.Source)
+	builder.WriteString(", ")
+	builder.WriteString("archived=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Archived))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Personas is a parsable slice of Persona.
+type Personas []*Persona
@@ -0,0 +1,195 @@
+// Code generated by ent, DO NOT EDIT.
+
+package persona
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the persona type in the database.
+	Label = "persona"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
+	// FieldRole holds the string denoting the role field in the database.
+	FieldRole = "role"
+	// FieldSeniority holds the string denoting the seniority field in the database.
+	FieldSeniority = "seniority"
+	// FieldTechStack holds the string denoting the tech_stack field in the database.
+	FieldTechStack = "tech_stack"
+	// FieldGoals holds the string denoting the goals field in the database.
+	FieldGoals = "goals"
+	// FieldRiskAppetite holds the string denoting the risk_appetite field in the database.
+	FieldRiskAppetite = "risk_appetite"
+	// FieldTimeHorizon holds the string denoting the time_horizon field in the database.
+	FieldTimeHorizon = "time_horizon"
+	// FieldConstraints holds the string denoting the constraints field in the database.
+	FieldConstraints = "constraints"
+	// FieldSource holds the string denoting the source field in the database.
+	FieldSource = "source"
+	// FieldArchived holds the string denoting the archived field in the database.
+	FieldArchived = "archived"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeUser holds the string denoting the user edge name in mutations.
+	EdgeUser = "user"
+	// EdgeDeepAnalysisResults holds the string denoting the deep_analysis_results edge name in mutations.
+	EdgeDeepAnalysisResults = "deep_analysis_results"
+	// Table holds the table name of the persona in the database.
+	Table = "personas"
+	// UserTable is the table that holds the user relation/edge.
+	UserTable = "personas"
+	// UserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	UserInverseTable = "users"
+	// UserColumn is the table column denoting the user relation/edge.
+	UserColumn = "user_id"
+	// DeepAnalysisResultsTable is the table that holds the deep_analysis_results relation/edge.
+	DeepAnalysisResultsTable = "deep_analysis_results"
+	// DeepAnalysisResultsInverseTable is the table name for the DeepAnalysisResult entity.
+	// It exists in this package in order to avoid circular dependency with the "deepanalysisresult" package.
+	DeepAnalysisResultsInverseTable = "deep_analysis_results"
+	// DeepAnalysisResultsColumn is the table column denoting the deep_analysis_results relation/edge.
+	DeepAnalysisResultsColumn = "persona_id"
+)
+
+// Columns holds all SQL columns for persona fields.
+var Columns = []string{
+	FieldID,
+	FieldUserID,
+	FieldName,
+	FieldVersion,
+	FieldRole,
+	FieldSeniority,
+	FieldTechStack,
+	FieldGoals,
+	FieldRiskAppetite,
+	FieldTimeHorizon,
+	FieldConstraints,
+	FieldSource,
+	FieldArchived,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultName holds the default value on creation for the "name" field.
+	DefaultName string
+	// DefaultSource holds the default value on creation for the "source" field.
+	DefaultSource string
+	// DefaultArchived holds the default value on creation for the "archived" field.
+	DefaultArchived bool
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the Persona queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByVersion orders the results by the version field.
+func ByVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVersion, opts...).ToFunc()
+}
+
+// ByRole orders the results by the role field.
+func ByRole(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRole, opts...).ToFunc()
+}
+
+// BySeniority orders the results by the seniority field.
+func BySeniority(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSeniority, opts...).ToFunc()
+}
+
+// ByRiskAppetite orders the results by the risk_appetite field.
+func ByRiskAppetite(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRiskAppetite, opts...).ToFunc()
+}
+
+// ByTimeHorizon orders the results by the time_horizon field.
+func ByTimeHorizon(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimeHorizon, opts...).ToFunc()
+}
+
+// BySource orders the results by the source field.
+func BySource(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSource, opts...).ToFunc()
+}
+
+// ByArchived orders the results by the archived field.
+func ByArchived(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldArchived, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUserField orders the results by user field.
+func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByDeepAnalysisResultsCount orders the results by deep_analysis_results count.
+func ByDeepAnalysisResultsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newDeepAnalysisResultsStep(), opts...)
+	}
+}
+
+// ByDeepAnalysisResults orders the results by deep_analysis_results terms.
+func ByDeepAnalysisResults(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDeepAnalysisResultsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newUserStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UserInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+	)
+}
+func newDeepAnalysisResultsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DeepAnalysisResultsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, DeepAnalysisResultsTable, DeepAnalysisResultsColumn),
+	)
+}
@@ -0,0 +1,737 @@
+// Code generated by ent, DO NOT EDIT.
+
+package persona
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/iWorld-y/domain_radar/app/common/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Persona {
+	return predicate.Persona(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Persona {
+	return predicate.Persona(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Persona {
+	return predicate.Persona(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Persona {
+	return predicate.Persona(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Persona {
+	return predicate.Persona(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Persona {
+	return predicate.Persona(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Persona {
+	return predicate.Persona(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Persona {
+	return predicate.Persona(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Persona {
+	return predicate.Persona(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.Persona {
+	return predicate.Persona(sql.FieldEQ(FieldUserID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Persona {
+	return predicate.Persona(sql.FieldEQ(FieldName, v))
+}
+
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int) predicate.Persona {
+	return predicate.Persona(sql.FieldEQ(FieldVersion, v))
+}
+
+// Role applies equality check predicate on the "role" field. It's identical to RoleEQ.
+func Role(v string) predicate.Persona {
+	return predicate.Persona(sql.FieldEQ(FieldRole, v))
+}
+
+// Seniority applies equality check predicate on the "seniority" field. It's identical to SeniorityEQ.
+func Seniority(v string) predicate.Persona {
+	return predicate.Persona(sql.FieldEQ(FieldSeniority, v))
+}
+
+// RiskAppetite applies equality check predicate on the "risk_appetite" field. It's identical to RiskAppetiteEQ.
+func RiskAppetite(v string) predicate.Persona {
+	return predicate.Persona(sql.FieldEQ(FieldRiskAppetite, v))
+}
+
+// TimeHorizon applies equality check predicate on the "time_horizon" field. It's identical to TimeHorizonEQ.
+func TimeHorizon(v string) predicate.Persona {
+	return predicate.Persona(sql.FieldEQ(FieldTimeHorizon, v))
+}
+
+// Source applies equality check predicate on the "source" field. It's identical to SourceEQ.
+func Source(v string) predicate.Persona {
+	return predicate.Persona(sql.FieldEQ(FieldSource, v))
+}
+
+// Archived applies equality check predicate on the "archived" field. It's identical to ArchivedEQ.
+func Archived(v bool) predicate.Persona {
+	return predicate.Persona(sql.FieldEQ(FieldArchived, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Persona {
+	return predicate.Persona(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.Persona {
+	return predicate.Persona(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.Persona {
+	return predicate.Persona(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.Persona {
+	return predicate.Persona(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.Persona {
+	return predicate.Persona(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Persona {
+	return predicate.Persona(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Persona {
+	return predicate.Persona(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Persona {
+	return predicate.Persona(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Persona {
+	return predicate.Persona(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Persona {
+	return predicate.Persona(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Persona {
+	return predicate.Persona(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Persona {
+	return predicate.Persona(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Persona {
+	return predicate.Persona(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Persona {
+	return predicate.Persona(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Persona {
+	return predicate.Persona(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Persona {
+	return predicate.Persona(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Persona {
+	return predicate.Persona(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Persona {
+	return predicate.Persona(sql.FieldContainsFold(FieldName, v))
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int) predicate.Persona {
+	return predicate.Persona(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int) predicate.Persona {
+	return predicate.Persona(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int) predicate.Persona {
+	return predicate.Persona(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int) predicate.Persona {
+	return predicate.Persona(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int) predicate.Persona {
+	return predicate.Persona(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int) predicate.Persona {
+	return predicate.Persona(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int) predicate.Persona {
+	return predicate.Persona(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int) predicate.Persona {
+	return predicate.Persona(sql.FieldLTE(FieldVersion, v))
+}
+
+// RoleEQ applies the EQ predicate on the "role" field.
+func RoleEQ(v string) predicate.Persona {
+	return predicate.Persona(sql.FieldEQ(FieldRole, v))
+}
+
+// RoleNEQ applies the NEQ predicate on the "role" field.
+func RoleNEQ(v string) predicate.Persona {
+	return predicate.Persona(sql.FieldNEQ(FieldRole, v))
+}
+
+// RoleIn applies the In predicate on the "role" field.
+func RoleIn(vs ...string) predicate.Persona {
+	return predicate.Persona(sql.FieldIn(FieldRole, vs...))
+}
+
+// RoleNotIn applies the NotIn predicate on the "role" field.
+func RoleNotIn(vs ...string) predicate.Persona {
+	return predicate.Persona(sql.FieldNotIn(FieldRole, vs...))
+}
+
+// RoleGT applies the GT predicate on the "role" field.
+func RoleGT(v string) predicate.Persona {
+	return predicate.Persona(sql.FieldGT(FieldRole, v))
+}
+
+// RoleGTE applies the GTE predicate on the "role" field.
+func RoleGTE(v string) predicate.Persona {
+	return predicate.Persona(sql.FieldGTE(FieldRole, v))
+}
+
+// RoleLT applies the LT predicate on the "role" field.
+func RoleLT(v string) predicate.Persona {
+	return predicate.Persona(sql.FieldLT(FieldRole, v))
+}
+
+// RoleLTE applies the LTE predicate on the "role" field.
+func RoleLTE(v string) predicate.Persona {
+	return predicate.Persona(sql.FieldLTE(FieldRole, v))
+}
+
+// RoleContains applies the Contains predicate on the "role" field.
+func RoleContains(v string) predicate.Persona {
+	return predicate.Persona(sql.FieldContains(FieldRole, v))
+}
+
+// RoleHasPrefix applies the HasPrefix predicate on the "role" field.
+func RoleHasPrefix(v string) predicate.Persona {
+	return predicate.Persona(sql.FieldHasPrefix(FieldRole, v))
+}
+
+// RoleHasSuffix applies the HasSuffix predicate on the "role" field.
+func RoleHasSuffix(v string) predicate.Persona {
+	return predicate.Persona(sql.FieldHasSuffix(FieldRole, v))
+}
+
+// RoleIsNil applies the IsNil predicate on the "role" field.
+func RoleIsNil() predicate.Persona {
+	return predicate.Persona(sql.FieldIsNull(FieldRole))
+}
+
+// RoleNotNil applies the NotNil predicate on the "role" field.
+func RoleNotNil() predicate.Persona {
+	return predicate.Persona(sql.FieldNotNull(FieldRole))
+}
+
+// RoleEqualFold applies the EqualFold predicate on the "role" field.
+func RoleEqualFold(v string) predicate.Persona {
+	return predicate.Persona(sql.FieldEqualFold(FieldRole, v))
+}
+
+// RoleContainsFold applies the ContainsFold predicate on the "role" field.
+func RoleContainsFold(v string) predicate.Persona {
+	return predicate.Persona(sql.FieldContainsFold(FieldRole, v))
+}
+
+// SeniorityEQ applies the EQ predicate on the "seniority" field.
+func SeniorityEQ(v string) predicate.Persona {
+	return predicate.Persona(sql.FieldEQ(FieldSeniority, v))
+}
+
+// SeniorityNEQ applies the NEQ predicate on the "seniority" field.
+func SeniorityNEQ(v string) predicate.Persona {
+	return predicate.Persona(sql.FieldNEQ(FieldSeniority, v))
+}
+
+// SeniorityIn applies the In predicate on the "seniority" field.
+func SeniorityIn(vs ...string) predicate.Persona {
+	return predicate.Persona(sql.FieldIn(FieldSeniority, vs...))
+}
+
+// SeniorityNotIn applies the NotIn predicate on the "seniority" field.
+func SeniorityNotIn(vs ...string) predicate.Persona {
+	return predicate.Persona(sql.FieldNotIn(FieldSeniority, vs...))
+}
+
+// SeniorityGT applies the GT predicate on the "seniority" field.
+func SeniorityGT(v string) predicate.Persona {
+	return predicate.Persona(sql.FieldGT(FieldSeniority, v))
+}
+
+// SeniorityGTE applies the GTE predicate on the "seniority" field.
+func SeniorityGTE(v string) predicate.Persona {
+	return predicate.Persona(sql.FieldGTE(FieldSeniority, v))
+}
+
+// SeniorityLT applies the LT predicate on the "seniority" field.
+func SeniorityLT(v string) predicate.Persona {
+	return predicate.Persona(sql.FieldLT(FieldSeniority, v))
+}
+
+// SeniorityLTE applies the LTE predicate on the "seniority" field.
+func SeniorityLTE(v string) predicate.Persona {
+	return predicate.Persona(sql.FieldLTE(FieldSeniority, v))
+}
+
+// SeniorityContains applies the Contains predicate on the "seniority" field.
+func SeniorityContains(v string) predicate.Persona {
+	return predicate.Persona(sql.FieldContains(FieldSeniority, v))
+}
+
+// SeniorityHasPrefix applies the HasPrefix predicate on the "seniority" field.
+func SeniorityHasPrefix(v string) predicate.Persona {
+	return predicate.Persona(sql.FieldHasPrefix(FieldSeniority, v))
+}
+
+// SeniorityHasSuffix applies the HasSuffix predicate on the "seniority" field.
+func SeniorityHasSuffix(v string) predicate.Persona {
+	return predicate.Persona(sql.FieldHasSuffix(FieldSeniority, v))
+}
+
+// SeniorityIsNil applies the IsNil predicate on the "seniority" field.
+func SeniorityIsNil() predicate.Persona {
+	return predicate.Persona(sql.FieldIsNull(FieldSeniority))
+}
+
+// SeniorityNotNil applies the NotNil predicate on the "seniority" field.
+func SeniorityNotNil() predicate.Persona {
+	return predicate.Persona(sql.FieldNotNull(FieldSeniority))
+}
+
+// SeniorityEqualFold applies the EqualFold predicate on the "seniority" field.
+func SeniorityEqualFold(v string) predicate.Persona {
+	return predicate.Persona(sql.FieldEqualFold(FieldSeniority, v))
+}
+
+// SeniorityContainsFold applies the ContainsFold predicate on the "seniority" field.
+func SeniorityContainsFold(v string) predicate.Persona {
+	return predicate.Persona(sql.FieldContainsFold(FieldSeniority, v))
+}
+
+// TechStackIsNil applies the IsNil predicate on the "tech_stack" field.
+func TechStackIsNil() predicate.Persona {
+	return predicate.Persona(sql.FieldIsNull(FieldTechStack))
+}
+
+// TechStackNotNil applies the NotNil predicate on the "tech_stack" field.
+func TechStackNotNil() predicate.Persona {
+	return predicate.Persona(sql.FieldNotNull(FieldTechStack))
+}
+
+// GoalsIsNil applies the IsNil predicate on the "goals" field.
+func GoalsIsNil() predicate.Persona {
+	return predicate.Persona(sql.FieldIsNull(FieldGoals))
+}
+
+// GoalsNotNil applies the NotNil predicate on the "goals" field.
+func GoalsNotNil() predicate.Persona {
+	return predicate.Persona(sql.FieldNotNull(FieldGoals))
+}
+
+// RiskAppetiteEQ applies the EQ predicate on the "risk_appetite" field.
+func RiskAppetiteEQ(v string) predicate.Persona {
+	return predicate.Persona(sql.FieldEQ(FieldRiskAppetite, v))
+}
+
+// RiskAppetiteNEQ applies the NEQ predicate on the "risk_appetite" field.
+func RiskAppetiteNEQ(v string) predicate.Persona {
+	return predicate.Persona(sql.FieldNEQ(FieldRiskAppetite, v))
+}
+
+// RiskAppetiteIn applies the In predicate on the "risk_appetite" field.
+func RiskAppetiteIn(vs ...string) predicate.Persona {
+	return predicate.Persona(sql.FieldIn(FieldRiskAppetite, vs...))
+}
+
+// RiskAppetiteNotIn applies the NotIn predicate on the "risk_appetite" field.
+func RiskAppetiteNotIn(vs ...string) predicate.Persona {
+	return predicate.Persona(sql.FieldNotIn(FieldRiskAppetite, vs...))
+}
+
+// RiskAppetiteGT applies the GT predicate on the "risk_appetite" field.
+func RiskAppetiteGT(v string) predicate.Persona {
+	return predicate.Persona(sql.FieldGT(FieldRiskAppetite, v))
+}
+
+// RiskAppetiteGTE applies the GTE predicate on the "risk_appetite" field.
+func RiskAppetiteGTE(v string) predicate.Persona {
+	return predicate.Persona(sql.FieldGTE(FieldRiskAppetite, v))
+}
+
+// RiskAppetiteLT applies the LT predicate on the "risk_appetite" field.
+func RiskAppetiteLT(v string) predicate.Persona {
+	return predicate.Persona(sql.FieldLT(FieldRiskAppetite, v))
+}
+
+// RiskAppetiteLTE applies the LTE predicate on the "risk_appetite" field.
+func RiskAppetiteLTE(v string) predicate.Persona {
+	return predicate.Persona(sql.FieldLTE(FieldRiskAppetite, v))
+}
+
+// RiskAppetiteContains applies the Contains predicate on the "risk_appetite" field.
+func RiskAppetiteContains(v string) predicate.Persona {
+	return predicate.Persona(sql.FieldContains(FieldRiskAppetite, v))
+}
+
+// RiskAppetiteHasPrefix applies the HasPrefix predicate on the "risk_appetite" field.
+func RiskAppetiteHasPrefix(v string) predicate.Persona {
+	return predicate.Persona(sql.FieldHasPrefix(FieldRiskAppetite, v))
+}
+
+// RiskAppetiteHasSuffix applies the HasSuffix predicate on the "risk_appetite" field.
+func RiskAppetiteHasSuffix(v string) predicate.Persona {
+	return predicate.Persona(sql.FieldHasSuffix(FieldRiskAppetite, v))
+}
+
+// RiskAppetiteIsNil applies the IsNil predicate on the "risk_appetite" field.
+func RiskAppetiteIsNil() predicate.Persona {
+	return predicate.Persona(sql.FieldIsNull(FieldRiskAppetite))
+}
+
+// RiskAppetiteNotNil applies the NotNil predicate on the "risk_appetite" field.
+func RiskAppetiteNotNil() predicate.Persona {
+	return predicate.Persona(sql.FieldNotNull(FieldRiskAppetite))
+}
+
+// RiskAppetiteEqualFold applies the EqualFold predicate on the "risk_appetite" field.
+func RiskAppetiteEqualFold(v string) predicate.Persona {
+	return predicate.Persona(sql.FieldEqualFold(FieldRiskAppetite, v))
+}
+
+// RiskAppetiteContainsFold applies the ContainsFold predicate on the "risk_appetite" field.
+func RiskAppetiteContainsFold(v string) predicate.Persona {
+	return predicate.Persona(sql.FieldContainsFold(FieldRiskAppetite, v))
+}
+
+// TimeHorizonEQ applies the EQ predicate on the "time_horizon" field.
+func TimeHorizonEQ(v string) predicate.Persona {
+	return predicate.Persona(sql.FieldEQ(FieldTimeHorizon, v))
+}
+
+// TimeHorizonNEQ applies the NEQ predicate on the "time_horizon" field.
+func TimeHorizonNEQ(v string) predicate.Persona {
+	return predicate.Persona(sql.FieldNEQ(FieldTimeHorizon, v))
+}
+
+// TimeHorizonIn applies the In predicate on the "time_horizon" field.
+func TimeHorizonIn(vs ...string) predicate.Persona {
+	return predicate.Persona(sql.FieldIn(FieldTimeHorizon, vs...))
+}
+
+// TimeHorizonNotIn applies the NotIn predicate on the "time_horizon" field.
+func TimeHorizonNotIn(vs ...string) predicate.Persona {
+	return predicate.Persona(sql.FieldNotIn(FieldTimeHorizon, vs...))
+}
+
+// TimeHorizonGT applies the GT predicate on the "time_horizon" field.
+func TimeHorizonGT(v string) predicate.Persona {
+	return predicate.Persona(sql.FieldGT(FieldTimeHorizon, v))
+}
+
+// TimeHorizonGTE applies the GTE predicate on the "time_horizon" field.
+func TimeHorizonGTE(v string) predicate.Persona {
+	return predicate.Persona(sql.FieldGTE(FieldTimeHorizon, v))
+}
+
+// TimeHorizonLT applies the LT predicate on the "time_horizon" field.
+func TimeHorizonLT(v string) predicate.Persona {
+	return predicate.Persona(sql.FieldLT(FieldTimeHorizon, v))
+}
+
+// TimeHorizonLTE applies the LTE predicate on the "time_horizon" field.
+func TimeHorizonLTE(v string) predicate.Persona {
+	return predicate.Persona(sql.FieldLTE(FieldTimeHorizon, v))
+}
+
+// TimeHorizonContains applies the Contains predicate on the "time_horizon" field.
+func TimeHorizonContains(v string) predicate.Persona {
+	return predicate.Persona(sql.FieldContains(FieldTimeHorizon, v))
+}
+
+// TimeHorizonHasPrefix applies the HasPrefix predicate on the "time_horizon" field.
+func TimeHorizonHasPrefix(v string) predicate.Persona {
+	return predicate.Persona(sql.FieldHasPrefix(FieldTimeHorizon, v))
+}
+
+// TimeHorizonHasSuffix applies the HasSuffix predicate on the "time_horizon" field.
+func TimeHorizonHasSuffix(v string) predicate.Persona {
+	return predicate.Persona(sql.FieldHasSuffix(FieldTimeHorizon, v))
+}
+
+// TimeHorizonIsNil applies the IsNil predicate on the "time_horizon" field.
+func TimeHorizonIsNil() predicate.Persona {
+	return predicate.Persona(sql.FieldIsNull(FieldTimeHorizon))
+}
+
+// TimeHorizonNotNil applies the NotNil predicate on the "time_horizon" field.
+func TimeHorizonNotNil() predicate.Persona {
+	return predicate.Persona(sql.FieldNotNull(FieldTimeHorizon))
+}
+
+// TimeHorizonEqualFold applies the EqualFold predicate on the "time_horizon" field.
+func TimeHorizonEqualFold(v string) predicate.Persona {
+	return predicate.Persona(sql.FieldEqualFold(FieldTimeHorizon, v))
+}
+
+// TimeHorizonContainsFold applies the ContainsFold predicate on the "time_horizon" field.
+func TimeHorizonContainsFold(v string) predicate.Persona {
+	return predicate.Persona(sql.FieldContainsFold(FieldTimeHorizon, v))
+}
+
+// ConstraintsIsNil applies the IsNil predicate on the "constraints" field.
+func ConstraintsIsNil() predicate.Persona {
+	return predicate.Persona(sql.FieldIsNull(FieldConstraints))
+}
+
+// ConstraintsNotNil applies the NotNil predicate on the "constraints" field.
+func ConstraintsNotNil() predicate.Persona {
+	return predicate.Persona(sql.FieldNotNull(FieldConstraints))
+}
+
+// SourceEQ applies the EQ predicate on the "source" field.
+func SourceEQ(v string) predicate.Persona {
+	return predicate.Persona(sql.FieldEQ(FieldSource, v))
+}
+
+// SourceNEQ applies the NEQ predicate on the "source" field.
+func SourceNEQ(v string) predicate.Persona {
+	return predicate.Persona(sql.FieldNEQ(FieldSource, v))
+}
+
+// SourceIn applies the In predicate on the "source" field.
+func SourceIn(vs ...string) predicate.Persona {
+	return predicate.Persona(sql.FieldIn(FieldSource, vs...))
+}
+
+// SourceNotIn applies the NotIn predicate on the "source" field.
+func SourceNotIn(vs ...string) predicate.Persona {
+	return predicate.Persona(sql.FieldNotIn(FieldSource, vs...))
+}
+
+// SourceGT applies the GT predicate on the "source" field.
+func SourceGT(v string) predicate.Persona {
+	return predicate.Persona(sql.FieldGT(FieldSource, v))
+}
+
+// SourceGTE applies the GTE predicate on the "source" field.
+func SourceGTE(v string) predicate.Persona {
+	return predicate.Persona(sql.FieldGTE(FieldSource, v))
+}
+
+// SourceLT applies the LT predicate on the "source" field.
+func SourceLT(v string) predicate.Persona {
+	return predicate.Persona(sql.FieldLT(FieldSource, v))
+}
+
+// SourceLTE applies the LTE predicate on the "source" field.
+func SourceLTE(v string) predicate.Persona {
+	return predicate.Persona(sql.FieldLTE(FieldSource, v))
+}
+
+// SourceContains applies the Contains predicate on the "source" field.
+func SourceContains(v string) predicate.Persona {
+	return predicate.Persona(sql.FieldContains(FieldSource, v))
+}
+
+// SourceHasPrefix applies the HasPrefix predicate on the "source" field.
+func SourceHasPrefix(v string) predicate.Persona {
+	return predicate.Persona(sql.FieldHasPrefix(FieldSource, v))
+}
+
+// SourceHasSuffix applies the HasSuffix predicate on the "source" field.
+func SourceHasSuffix(v string) predicate.Persona {
+	return predicate.Persona(sql.FieldHasSuffix(FieldSource, v))
+}
+
+// SourceEqualFold applies the EqualFold predicate on the "source" field.
+func SourceEqualFold(v string) predicate.Persona {
+	return predicate.Persona(sql.FieldEqualFold(FieldSource, v))
+}
+
+// SourceContainsFold applies the ContainsFold predicate on the "source" field.
+func SourceContainsFold(v string) predicate.Persona {
+	return predicate.Persona(sql.FieldContainsFold(FieldSource, v))
+}
+
+// ArchivedEQ applies the EQ predicate on the "archived" field.
+func ArchivedEQ(v bool) predicate.Persona {
+	return predicate.Persona(sql.FieldEQ(FieldArchived, v))
+}
+
+// ArchivedNEQ applies the NEQ predicate on the "archived" field.
+func ArchivedNEQ(v bool) predicate.Persona {
+	return predicate.Persona(sql.FieldNEQ(FieldArchived, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Persona {
+	return predicate.Persona(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Persona {
+	return predicate.Persona(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Persona {
+	return predicate.Persona(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Persona {
+	return predicate.Persona(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Persona {
+	return predicate.Persona(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Persona {
+	return predicate.Persona(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Persona {
+	return predicate.Persona(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Persona {
+	return predicate.Persona(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.Persona {
+	return predicate.Persona(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.Persona {
+	return predicate.Persona(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDeepAnalysisResults applies the HasEdge predicate on the "deep_analysis_results" edge.
+func HasDeepAnalysisResults() predicate.Persona {
+	return predicate.Persona(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, DeepAnalysisResultsTable, DeepAnalysisResultsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDeepAnalysisResultsWith applies the HasEdge predicate on the "deep_analysis_results" edge with a given conditions (other predicates).
+func HasDeepAnalysisResultsWith(preds ...predicate.DeepAnalysisResult) predicate.Persona {
+	return predicate.Persona(func(s *sql.Selector) {
+		step := newDeepAnalysisResultsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Persona) predicate.Persona {
+	return predicate.Persona(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Persona) predicate.Persona {
+	return predicate.Persona(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Persona) predicate.Persona {
+	return predicate.Persona(sql.NotPredicates(p))
+}
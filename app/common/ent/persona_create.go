@@ -0,0 +1,1400 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/iWorld-y/domain_radar/app/common/ent/deepanalysisresult"
+	"github.com/iWorld-y/domain_radar/app/common/ent/persona"
+	"github.com/iWorld-y/domain_radar/app/common/ent/user"
+)
+
+// PersonaCreate is the builder for creating a Persona entity.
+type PersonaCreate struct {
+	config
+	mutation *PersonaMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *PersonaCreate) SetUserID(v int) *PersonaCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *PersonaCreate) SetName(v string) *PersonaCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_c *PersonaCreate) SetNillableName(v *string) *PersonaCreate {
+	if v != nil {
+		_c.SetName(*v)
+	}
+	return _c
+}
+
+// SetVersion sets the "version" field.
+func (_c *PersonaCreate) SetVersion(v int) *PersonaCreate {
+	_c.mutation.SetVersion(v)
+	return _c
+}
+
+// SetRole sets the "role" field.
+func (_c *PersonaCreate) SetRole(v string) *PersonaCreate {
+	_c.mutation.SetRole(v)
+	return _c
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_c *PersonaCreate) SetNillableRole(v *string) *PersonaCreate {
+	if v != nil {
+		_c.SetRole(*v)
+	}
+	return _c
+}
+
+// SetSeniority sets the "seniority" field.
+func (_c *PersonaCreate) SetSeniority(v string) *PersonaCreate {
+	_c.mutation.SetSeniority(v)
+	return _c
+}
+
+// SetNillableSeniority sets the "seniority" field if the given value is not nil.
+func (_c *PersonaCreate) SetNillableSeniority(v *string) *PersonaCreate {
+	if v != nil {
+		_c.SetSeniority(*v)
+	}
+	return _c
+}
+
+// SetTechStack sets the "tech_stack" field.
+func (_c *PersonaCreate) SetTechStack(v []string) *PersonaCreate {
+	_c.mutation.SetTechStack(v)
+	return _c
+}
+
+// SetGoals sets the "goals" field.
+func (_c *PersonaCreate) SetGoals(v []string) *PersonaCreate {
+	_c.mutation.SetGoals(v)
+	return _c
+}
+
+// SetRiskAppetite sets the "risk_appetite" field.
+func (_c *PersonaCreate) SetRiskAppetite(v string) *PersonaCreate {
+	_c.mutation.SetRiskAppetite(v)
+	return _c
+}
+
+// SetNillableRiskAppetite sets the "risk_appetite" field if the given value is not nil.
+func (_c *PersonaCreate) SetNillableRiskAppetite(v *string) *PersonaCreate {
+	if v != nil {
+		_c.SetRiskAppetite(*v)
+	}
+	return _c
+}
+
+// SetTimeHorizon sets the "time_horizon" field.
+func (_c *PersonaCreate) SetTimeHorizon(v string) *PersonaCreate {
+	_c.mutation.SetTimeHorizon(v)
+	return _c
+}
+
+// SetNillableTimeHorizon sets the "time_horizon" field if the given value is not nil.
+func (_c *PersonaCreate) SetNillableTimeHorizon(v *string) *PersonaCreate {
+	if v != nil {
+		_c.SetTimeHorizon(*v)
+	}
+	return _c
+}
+
+// SetConstraints sets the "constraints" field.
+func (_c *PersonaCreate) SetConstraints(v []string) *PersonaCreate {
+	_c.mutation.SetConstraints(v)
+	return _c
+}
+
+// SetSource sets the "source" field.
+func (_c *PersonaCreate) SetSource(v string) *PersonaCreate {
+	_c.mutation.SetSource(v)
+	return _c
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_c *PersonaCreate) SetNillableSource(v *string) *PersonaCreate {
+	if v != nil {
+		_c.SetSource(*v)
+	}
+	return _c
+}
+
+// SetArchived sets the "archived" field.
+func (_c *PersonaCreate) SetArchived(v bool) *PersonaCreate {
+	_c.mutation.SetArchived(v)
+	return _c
+}
+
+// SetNillableArchived sets the "archived" field if the given value is not nil.
+func (_c *PersonaCreate) SetNillableArchived(v *bool) *PersonaCreate {
+	if v != nil {
+		_c.SetArchived(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PersonaCreate) SetCreatedAt(v time.Time) *PersonaCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *PersonaCreate) SetNillableCreatedAt(v *time.Time) *PersonaCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PersonaCreate) SetID(v int) *PersonaCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *PersonaCreate) SetUser(v *User) *PersonaCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// AddDeepAnalysisResultIDs adds the "deep_analysis_results" edge to the DeepAnalysisResult entity by IDs.
+func (_c *PersonaCreate) AddDeepAnalysisResultIDs(ids ...int) *PersonaCreate {
+	_c.mutation.AddDeepAnalysisResultIDs(ids...)
+	return _c
+}
+
+// AddDeepAnalysisResults adds the "deep_analysis_results" edges to the DeepAnalysisResult entity.
+func (_c *PersonaCreate) AddDeepAnalysisResults(v ...*DeepAnalysisResult) *PersonaCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddDeepAnalysisResultIDs(ids...)
+}
+
+// Mutation returns the PersonaMutation object of the builder.
+func (_c *PersonaCreate) Mutation() *PersonaMutation {
+	return _c.mutation
+}
+
+// Save creates the Persona in the database.
+func (_c *PersonaCreate) Save(ctx context.Context) (*Persona, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PersonaCreate) SaveX(ctx context.Context) *Persona {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PersonaCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PersonaCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PersonaCreate) defaults() {
+	if _, ok := _c.mutation.Name(); !ok {
+		v := persona.DefaultName
+		_c.mutation.SetName(v)
+	}
+	if _, ok := _c.mutation.Source(); !ok {
+		v := persona.DefaultSource
+		_c.mutation.SetSource(v)
+	}
+	if _, ok := _c.mutation.Archived(); !ok {
+		v := persona.DefaultArchived
+		_c.mutation.SetArchived(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := persona.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PersonaCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Persona.user_id"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Persona.name"`)}
+	}
+	if _, ok := _c.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "Persona.version"`)}
+	}
+	if _, ok := _c.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New(`ent: missing required field "Persona.source"`)}
+	}
+	if _, ok := _c.mutation.Archived(); !ok {
+		return &ValidationError{Name: "archived", err: errors.New(`ent: missing required field "Persona.archived"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Persona.created_at"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "Persona.user"`)}
+	}
+	return nil
+}
+
+func (_c *PersonaCreate) sqlSave(ctx context.Context) (*Persona, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PersonaCreate) createSpec() (*Persona, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Persona{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(persona.Table, sqlgraph.NewFieldSpec(persona.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(persona.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Version(); ok {
+		_spec.SetField(persona.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
+	if value, ok := _c.mutation.Role(); ok {
+		_spec.SetField(persona.FieldRole, field.TypeString, value)
+		_node.Role = value
+	}
+	if value, ok := _c.mutation.Seniority(); ok {
+		_spec.SetField(persona.FieldSeniority, field.TypeString, value)
+		_node.Seniority = value
+	}
+	if value, ok := _c.mutation.TechStack(); ok {
+		_spec.SetField(persona.FieldTechStack, field.TypeJSON, value)
+		_node.TechStack = value
+	}
+	if value, ok := _c.mutation.Goals(); ok {
+		_spec.SetField(persona.FieldGoals, field.TypeJSON, value)
+		_node.Goals = value
+	}
+	if value, ok := _c.mutation.RiskAppetite(); ok {
+		_spec.SetField(persona.FieldRiskAppetite, field.TypeString, value)
+		_node.RiskAppetite = value
+	}
+	if value, ok := _c.mutation.TimeHorizon(); ok {
+		_spec.SetField(persona.FieldTimeHorizon, field.TypeString, value)
+		_node.TimeHorizon = value
+	}
+	if value, ok := _c.mutation.Constraints(); ok {
+		_spec.SetField(persona.FieldConstraints, field.TypeJSON, value)
+		_node.Constraints = value
+	}
+	if value, ok := _c.mutation.Source(); ok {
+		_spec.SetField(persona.FieldSource, field.TypeString, value)
+		_node.Source = value
+	}
+	if value, ok := _c.mutation.Archived(); ok {
+		_spec.SetField(persona.FieldArchived, field.TypeBool, value)
+		_node.Archived = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(persona.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   persona.UserTable,
+			Columns: []string{persona.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.DeepAnalysisResultsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   persona.DeepAnalysisResultsTable,
+			Columns: []string{persona.DeepAnalysisResultsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deepanalysisresult.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Persona.Create().
+//		SetUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PersonaUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PersonaCreate) OnConflict(opts ...sql.ConflictOption) *PersonaUpsertOne {
+	_c.conflict = opts
+	return &PersonaUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Persona.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PersonaCreate) OnConflictColumns(columns ...string) *PersonaUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PersonaUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// PersonaUpsertOne is the builder for "upsert"-ing
+	//  one Persona node.
+	PersonaUpsertOne struct {
+		create *PersonaCreate
+	}
+
+	// PersonaUpsert is the "OnConflict" setter.
+	PersonaUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUserID sets the "user_id" field.
+func (u *PersonaUpsert) SetUserID(v int) *PersonaUpsert {
+	u.Set(persona.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *PersonaUpsert) UpdateUserID() *PersonaUpsert {
+	u.SetExcluded(persona.FieldUserID)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *PersonaUpsert) SetName(v string) *PersonaUpsert {
+	u.Set(persona.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *PersonaUpsert) UpdateName() *PersonaUpsert {
+	u.SetExcluded(persona.FieldName)
+	return u
+}
+
+// SetVersion sets the "version" field.
+func (u *PersonaUpsert) SetVersion(v int) *PersonaUpsert {
+	u.Set(persona.FieldVersion, v)
+	return u
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *PersonaUpsert) UpdateVersion() *PersonaUpsert {
+	u.SetExcluded(persona.FieldVersion)
+	return u
+}
+
+// AddVersion adds v to the "version" field.
+func (u *PersonaUpsert) AddVersion(v int) *PersonaUpsert {
+	u.Add(persona.FieldVersion, v)
+	return u
+}
+
+// SetRole sets the "role" field.
+func (u *PersonaUpsert) SetRole(v string) *PersonaUpsert {
+	u.Set(persona.FieldRole, v)
+	return u
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *PersonaUpsert) UpdateRole() *PersonaUpsert {
+	u.SetExcluded(persona.FieldRole)
+	return u
+}
+
+// ClearRole clears the value of the "role" field.
+func (u *PersonaUpsert) ClearRole() *PersonaUpsert {
+	u.SetNull(persona.FieldRole)
+	return u
+}
+
+// SetSeniority sets the "seniority" field.
+func (u *PersonaUpsert) SetSeniority(v string) *PersonaUpsert {
+	u.Set(persona.FieldSeniority, v)
+	return u
+}
+
+// UpdateSeniority sets the "seniority" field to the value that was provided on create.
+func (u *PersonaUpsert) UpdateSeniority() *PersonaUpsert {
+	u.SetExcluded(persona.FieldSeniority)
+	return u
+}
+
+// ClearSeniority clears the value of the "seniority" field.
+func (u *PersonaUpsert) ClearSeniority() *PersonaUpsert {
+	u.SetNull(persona.FieldSeniority)
+	return u
+}
+
+// SetTechStack sets the "tech_stack" field.
+func (u *PersonaUpsert) SetTechStack(v []string) *PersonaUpsert {
+	u.Set(persona.FieldTechStack, v)
+	return u
+}
+
+// UpdateTechStack sets the "tech_stack" field to the value that was provided on create.
+func (u *PersonaUpsert) UpdateTechStack() *PersonaUpsert {
+	u.SetExcluded(persona.FieldTechStack)
+	return u
+}
+
+// ClearTechStack clears the value of the "tech_stack" field.
+func (u *PersonaUpsert) ClearTechStack() *PersonaUpsert {
+	u.SetNull(persona.FieldTechStack)
+	return u
+}
+
+// SetGoals sets the "goals" field.
+func (u *PersonaUpsert) SetGoals(v []string) *PersonaUpsert {
+	u.Set(persona.FieldGoals, v)
+	return u
+}
+
+// UpdateGoals sets the "goals" field to the value that was provided on create.
+func (u *PersonaUpsert) UpdateGoals() *PersonaUpsert {
+	u.SetExcluded(persona.FieldGoals)
+	return u
+}
+
+// ClearGoals clears the value of the "goals" field.
+func (u *PersonaUpsert) ClearGoals() *PersonaUpsert {
+	u.SetNull(persona.FieldGoals)
+	return u
+}
+
+// SetRiskAppetite sets the "risk_appetite" field.
+func (u *PersonaUpsert) SetRiskAppetite(v string) *PersonaUpsert {
+	u.Set(persona.FieldRiskAppetite, v)
+	return u
+}
+
+// UpdateRiskAppetite sets the "risk_appetite" field to the value that was provided on create.
+func (u *PersonaUpsert) UpdateRiskAppetite() *PersonaUpsert {
+	u.SetExcluded(persona.FieldRiskAppetite)
+	return u
+}
+
+// ClearRiskAppetite clears the value of the "risk_appetite" field.
+func (u *PersonaUpsert) ClearRiskAppetite() *PersonaUpsert {
+	u.SetNull(persona.FieldRiskAppetite)
+	return u
+}
+
+// SetTimeHorizon sets the "time_horizon" field.
+func (u *PersonaUpsert) SetTimeHorizon(v string) *PersonaUpsert {
+	u.Set(persona.FieldTimeHorizon, v)
+	return u
+}
+
+// UpdateTimeHorizon sets the "time_horizon" field to the value that was provided on create.
+func (u *PersonaUpsert) UpdateTimeHorizon() *PersonaUpsert {
+	u.SetExcluded(persona.FieldTimeHorizon)
+	return u
+}
+
+// ClearTimeHorizon clears the value of the "time_horizon" field.
+func (u *PersonaUpsert) ClearTimeHorizon() *PersonaUpsert {
+	u.SetNull(persona.FieldTimeHorizon)
+	return u
+}
+
+// SetConstraints sets the "constraints" field.
+func (u *PersonaUpsert) SetConstraints(v []string) *PersonaUpsert {
+	u.Set(persona.FieldConstraints, v)
+	return u
+}
+
+// UpdateConstraints sets the "constraints" field to the value that was provided on create.
+func (u *PersonaUpsert) UpdateConstraints() *PersonaUpsert {
+	u.SetExcluded(persona.FieldConstraints)
+	return u
+}
+
+// ClearConstraints clears the value of the "constraints" field.
+func (u *PersonaUpsert) ClearConstraints() *PersonaUpsert {
+	u.SetNull(persona.FieldConstraints)
+	return u
+}
+
+// SetSource sets the "source" field.
+func (u *PersonaUpsert) SetSource(v string) *PersonaUpsert {
+	u.Set(persona.FieldSource, v)
+	return u
+}
+
+// UpdateSource sets the "source" field to the value that was provided on create.
+func (u *PersonaUpsert) UpdateSource() *PersonaUpsert {
+	u.SetExcluded(persona.FieldSource)
+	return u
+}
+
+// SetArchived sets the "archived" field.
+func (u *PersonaUpsert) SetArchived(v bool) *PersonaUpsert {
+	u.Set(persona.FieldArchived, v)
+	return u
+}
+
+// UpdateArchived sets the "archived" field to the value that was provided on create.
+func (u *PersonaUpsert) UpdateArchived() *PersonaUpsert {
+	u.SetExcluded(persona.FieldArchived)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *PersonaUpsert) SetCreatedAt(v time.Time) *PersonaUpsert {
+	u.Set(persona.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *PersonaUpsert) UpdateCreatedAt() *PersonaUpsert {
+	u.SetExcluded(persona.FieldCreatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Persona.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(persona.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PersonaUpsertOne) UpdateNewValues() *PersonaUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(persona.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Persona.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PersonaUpsertOne) Ignore() *PersonaUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PersonaUpsertOne) DoNothing() *PersonaUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PersonaCreate.OnConflict
+// documentation for more info.
+func (u *PersonaUpsertOne) Update(set func(*PersonaUpsert)) *PersonaUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PersonaUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *PersonaUpsertOne) SetUserID(v int) *PersonaUpsertOne {
+	return u.Update(func(s *PersonaUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *PersonaUpsertOne) UpdateUserID() *PersonaUpsertOne {
+	return u.Update(func(s *PersonaUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *PersonaUpsertOne) SetName(v string) *PersonaUpsertOne {
+	return u.Update(func(s *PersonaUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *PersonaUpsertOne) UpdateName() *PersonaUpsertOne {
+	return u.Update(func(s *PersonaUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetVersion sets the "version" field.
+func (u *PersonaUpsertOne) SetVersion(v int) *PersonaUpsertOne {
+	return u.Update(func(s *PersonaUpsert) {
+		s.SetVersion(v)
+	})
+}
+
+// AddVersion adds v to the "version" field.
+func (u *PersonaUpsertOne) AddVersion(v int) *PersonaUpsertOne {
+	return u.Update(func(s *PersonaUpsert) {
+		s.AddVersion(v)
+	})
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *PersonaUpsertOne) UpdateVersion() *PersonaUpsertOne {
+	return u.Update(func(s *PersonaUpsert) {
+		s.UpdateVersion()
+	})
+}
+
+// SetRole sets the "role" field.
+func (u *PersonaUpsertOne) SetRole(v string) *PersonaUpsertOne {
+	return u.Update(func(s *PersonaUpsert) {
+		s.SetRole(v)
+	})
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *PersonaUpsertOne) UpdateRole() *PersonaUpsertOne {
+	return u.Update(func(s *PersonaUpsert) {
+		s.UpdateRole()
+	})
+}
+
+// ClearRole clears the value of the "role" field.
+func (u *PersonaUpsertOne) ClearRole() *PersonaUpsertOne {
+	return u.Update(func(s *PersonaUpsert) {
+		s.ClearRole()
+	})
+}
+
+// SetSeniority sets the "seniority" field.
+func (u *PersonaUpsertOne) SetSeniority(v string) *PersonaUpsertOne {
+	return u.Update(func(s *PersonaUpsert) {
+		s.SetSeniority(v)
+	})
+}
+
+// UpdateSeniority sets the "seniority" field to the value that was provided on create.
+func (u *PersonaUpsertOne) UpdateSeniority() *PersonaUpsertOne {
+	return u.Update(func(s *PersonaUpsert) {
+		s.UpdateSeniority()
+	})
+}
+
+// ClearSeniority clears the value of the "seniority" field.
+func (u *PersonaUpsertOne) ClearSeniority() *PersonaUpsertOne {
+	return u.Update(func(s *PersonaUpsert) {
+		s.ClearSeniority()
+	})
+}
+
+// SetTechStack sets the "tech_stack" field.
+func (u *PersonaUpsertOne) SetTechStack(v []string) *PersonaUpsertOne {
+	return u.Update(func(s *PersonaUpsert) {
+		s.SetTechStack(v)
+	})
+}
+
+// UpdateTechStack sets the "tech_stack" field to the value that was provided on create.
+func (u *PersonaUpsertOne) UpdateTechStack() *PersonaUpsertOne {
+	return u.Update(func(s *PersonaUpsert) {
+		s.UpdateTechStack()
+	})
+}
+
+// ClearTechStack clears the value of the "tech_stack" field.
+func (u *PersonaUpsertOne) ClearTechStack() *PersonaUpsertOne {
+	return u.Update(func(s *PersonaUpsert) {
+		s.ClearTechStack()
+	})
+}
+
+// SetGoals sets the "goals" field.
+func (u *PersonaUpsertOne) SetGoals(v []string) *PersonaUpsertOne {
+	return u.Update(func(s *PersonaUpsert) {
+		s.SetGoals(v)
+	})
+}
+
+// UpdateGoals sets the "goals" field to the value that was provided on create.
+func (u *PersonaUpsertOne) UpdateGoals() *PersonaUpsertOne {
+	return u.Update(func(s *PersonaUpsert) {
+		s.UpdateGoals()
+	})
+}
+
+// ClearGoals clears the value of the "goals" field.
+func (u *PersonaUpsertOne) ClearGoals() *PersonaUpsertOne {
+	return u.Update(func(s *PersonaUpsert) {
+		s.ClearGoals()
+	})
+}
+
+// SetRiskAppetite sets the "risk_appetite" field.
+func (u *PersonaUpsertOne) SetRiskAppetite(v string) *PersonaUpsertOne {
+	return u.Update(func(s *PersonaUpsert) {
+		s.SetRiskAppetite(v)
+	})
+}
+
+// UpdateRiskAppetite sets the "risk_appetite" field to the value that was provided on create.
+func (u *PersonaUpsertOne) UpdateRiskAppetite() *PersonaUpsertOne {
+	return u.Update(func(s *PersonaUpsert) {
+		s.UpdateRiskAppetite()
+	})
+}
+
+// ClearRiskAppetite clears the value of the "risk_appetite" field.
+func (u *PersonaUpsertOne) ClearRiskAppetite() *PersonaUpsertOne {
+	return u.Update(func(s *PersonaUpsert) {
+		s.ClearRiskAppetite()
+	})
+}
+
+// SetTimeHorizon sets the "time_horizon" field.
+func (u *PersonaUpsertOne) SetTimeHorizon(v string) *PersonaUpsertOne {
+	return u.Update(func(s *PersonaUpsert) {
+		s.SetTimeHorizon(v)
+	})
+}
+
+// UpdateTimeHorizon sets the "time_horizon" field to the value that was provided on create.
+func (u *PersonaUpsertOne) UpdateTimeHorizon() *PersonaUpsertOne {
+	return u.Update(func(s *PersonaUpsert) {
+		s.UpdateTimeHorizon()
+	})
+}
+
+// ClearTimeHorizon clears the value of the "time_horizon" field.
+func (u *PersonaUpsertOne) ClearTimeHorizon() *PersonaUpsertOne {
+	return u.Update(func(s *PersonaUpsert) {
+		s.ClearTimeHorizon()
+	})
+}
+
+// SetConstraints sets the "constraints" field.
+func (u *PersonaUpsertOne) SetConstraints(v []string) *PersonaUpsertOne {
+	return u.Update(func(s *PersonaUpsert) {
+		s.SetConstraints(v)
+	})
+}
+
+// UpdateConstraints sets the "constraints" field to the value that was provided on create.
+func (u *PersonaUpsertOne) UpdateConstraints() *PersonaUpsertOne {
+	return u.Update(func(s *PersonaUpsert) {
+		s.UpdateConstraints()
+	})
+}
+
+// ClearConstraints clears the value of the "constraints" field.
+func (u *PersonaUpsertOne) ClearConstraints() *PersonaUpsertOne {
+	return u.Update(func(s *PersonaUpsert) {
+		s.ClearConstraints()
+	})
+}
+
+// SetSource sets the "source" field.
+func (u *PersonaUpsertOne) SetSource(v string) *PersonaUpsertOne {
+	return u.Update(func(s *PersonaUpsert) {
+		s.SetSource(v)
+	})
+}
+
+// UpdateSource sets the "source" field to the value that was provided on create.
+func (u *PersonaUpsertOne) UpdateSource() *PersonaUpsertOne {
+	return u.Update(func(s *PersonaUpsert) {
+		s.UpdateSource()
+	})
+}
+
+// SetArchived sets the "archived" field.
+func (u *PersonaUpsertOne) SetArchived(v bool) *PersonaUpsertOne {
+	return u.Update(func(s *PersonaUpsert) {
+		s.SetArchived(v)
+	})
+}
+
+// UpdateArchived sets the "archived" field to the value that was provided on create.
+func (u *PersonaUpsertOne) UpdateArchived() *PersonaUpsertOne {
+	return u.Update(func(s *PersonaUpsert) {
+		s.UpdateArchived()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *PersonaUpsertOne) SetCreatedAt(v time.Time) *PersonaUpsertOne {
+	return u.Update(func(s *PersonaUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *PersonaUpsertOne) UpdateCreatedAt() *PersonaUpsertOne {
+	return u.Update(func(s *PersonaUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PersonaUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PersonaCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PersonaUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PersonaUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PersonaUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PersonaCreateBulk is the builder for creating many Persona entities in bulk.
+type PersonaCreateBulk struct {
+	config
+	err      error
+	builders []*PersonaCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Persona entities in the database.
+func (_c *PersonaCreateBulk) Save(ctx context.Context) ([]*Persona, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Persona, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PersonaMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PersonaCreateBulk) SaveX(ctx context.Context) []*Persona {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PersonaCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PersonaCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Persona.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PersonaUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PersonaCreateBulk) OnConflict(opts ...sql.ConflictOption) *PersonaUpsertBulk {
+	_c.conflict = opts
+	return &PersonaUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Persona.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PersonaCreateBulk) OnConflictColumns(columns ...string) *PersonaUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PersonaUpsertBulk{
+		create: _c,
+	}
+}
+
+// PersonaUpsertBulk is the builder for "upsert"-ing
+// a bulk of Persona nodes.
+type PersonaUpsertBulk struct {
+	create *PersonaCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Persona.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(persona.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PersonaUpsertBulk) UpdateNewValues() *PersonaUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(persona.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Persona.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PersonaUpsertBulk) Ignore() *PersonaUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PersonaUpsertBulk) DoNothing() *PersonaUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PersonaCreateBulk.OnConflict
+// documentation for more info.
+func (u *PersonaUpsertBulk) Update(set func(*PersonaUpsert)) *PersonaUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PersonaUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *PersonaUpsertBulk) SetUserID(v int) *PersonaUpsertBulk {
+	return u.Update(func(s *PersonaUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *PersonaUpsertBulk) UpdateUserID() *PersonaUpsertBulk {
+	return u.Update(func(s *PersonaUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *PersonaUpsertBulk) SetName(v string) *PersonaUpsertBulk {
+	return u.Update(func(s *PersonaUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *PersonaUpsertBulk) UpdateName() *PersonaUpsertBulk {
+	return u.Update(func(s *PersonaUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetVersion sets the "version" field.
+func (u *PersonaUpsertBulk) SetVersion(v int) *PersonaUpsertBulk {
+	return u.Update(func(s *PersonaUpsert) {
+		s.SetVersion(v)
+	})
+}
+
+// AddVersion adds v to the "version" field.
+func (u *PersonaUpsertBulk) AddVersion(v int) *PersonaUpsertBulk {
+	return u.Update(func(s *PersonaUpsert) {
+		s.AddVersion(v)
+	})
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *PersonaUpsertBulk) UpdateVersion() *PersonaUpsertBulk {
+	return u.Update(func(s *PersonaUpsert) {
+		s.UpdateVersion()
+	})
+}
+
+// SetRole sets the "role" field.
+func (u *PersonaUpsertBulk) SetRole(v string) *PersonaUpsertBulk {
+	return u.Update(func(s *PersonaUpsert) {
+		s.SetRole(v)
+	})
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *PersonaUpsertBulk) UpdateRole() *PersonaUpsertBulk {
+	return u.Update(func(s *PersonaUpsert) {
+		s.UpdateRole()
+	})
+}
+
+// ClearRole clears the value of the "role" field.
+func (u *PersonaUpsertBulk) ClearRole() *PersonaUpsertBulk {
+	return u.Update(func(s *PersonaUpsert) {
+		s.ClearRole()
+	})
+}
+
+// SetSeniority sets the "seniority" field.
+func (u *PersonaUpsertBulk) SetSeniority(v string) *PersonaUpsertBulk {
+	return u.Update(func(s *PersonaUpsert) {
+		s.SetSeniority(v)
+	})
+}
+
+// UpdateSeniority sets the "seniority" field to the value that was provided on create.
+func (u *PersonaUpsertBulk) UpdateSeniority() *PersonaUpsertBulk {
+	return u.Update(func(s *PersonaUpsert) {
+		s.UpdateSeniority()
+	})
+}
+
+// ClearSeniority clears the value of the "seniority" field.
+func (u *PersonaUpsertBulk) ClearSeniority() *PersonaUpsertBulk {
+	return u.Update(func(s *PersonaUpsert) {
+		s.ClearSeniority()
+	})
+}
+
+// SetTechStack sets the "tech_stack" field.
+func (u *PersonaUpsertBulk) SetTechStack(v []string) *PersonaUpsertBulk {
+	return u.Update(func(s *PersonaUpsert) {
+		s.SetTechStack(v)
+	})
+}
+
+// UpdateTechStack sets the "tech_stack" field to the value that was provided on create.
+func (u *PersonaUpsertBulk) UpdateTechStack() *PersonaUpsertBulk {
+	return u.Update(func(s *PersonaUpsert) {
+		s.UpdateTechStack()
+	})
+}
+
+// ClearTechStack clears the value of the "tech_stack" field.
+func (u *PersonaUpsertBulk) ClearTechStack() *PersonaUpsertBulk {
+	return u.Update(func(s *PersonaUpsert) {
+		s.ClearTechStack()
+	})
+}
+
+// SetGoals sets the "goals" field.
+func (u *PersonaUpsertBulk) SetGoals(v []string) *PersonaUpsertBulk {
+	return u.Update(func(s *PersonaUpsert) {
+		s.SetGoals(v)
+	})
+}
+
+// UpdateGoals sets the "goals" field to the value that was provided on create.
+func (u *PersonaUpsertBulk) UpdateGoals() *PersonaUpsertBulk {
+	return u.Update(func(s *PersonaUpsert) {
+		s.UpdateGoals()
+	})
+}
+
+// ClearGoals clears the value of the "goals" field.
+func (u *PersonaUpsertBulk) ClearGoals() *PersonaUpsertBulk {
+	return u.Update(func(s *PersonaUpsert) {
+		s.ClearGoals()
+	})
+}
+
+// SetRiskAppetite sets the "risk_appetite" field.
+func (u *PersonaUpsertBulk) SetRiskAppetite(v string) *PersonaUpsertBulk {
+	return u.Update(func(s *PersonaUpsert) {
+		s.SetRiskAppetite(v)
+	})
+}
+
+// UpdateRiskAppetite sets the "risk_appetite" field to the value that was provided on create.
+func (u *PersonaUpsertBulk) UpdateRiskAppetite() *PersonaUpsertBulk {
+	return u.Update(func(s *PersonaUpsert) {
+		s.UpdateRiskAppetite()
+	})
+}
+
+// ClearRiskAppetite clears the value of the "risk_appetite" field.
+func (u *PersonaUpsertBulk) ClearRiskAppetite() *PersonaUpsertBulk {
+	return u.Update(func(s *PersonaUpsert) {
+		s.ClearRiskAppetite()
+	})
+}
+
+// SetTimeHorizon sets the "time_horizon" field.
+func (u *PersonaUpsertBulk) SetTimeHorizon(v string) *PersonaUpsertBulk {
+	return u.Update(func(s *PersonaUpsert) {
+		s.SetTimeHorizon(v)
+	})
+}
+
+// UpdateTimeHorizon sets the "time_horizon" field to the value that was provided on create.
+func (u *PersonaUpsertBulk) UpdateTimeHorizon() *PersonaUpsertBulk {
+	return u.Update(func(s *PersonaUpsert) {
+		s.UpdateTimeHorizon()
+	})
+}
+
+// ClearTimeHorizon clears the value of the "time_horizon" field.
+func (u *PersonaUpsertBulk) ClearTimeHorizon() *PersonaUpsertBulk {
+	return u.Update(func(s *PersonaUpsert) {
+		s.ClearTimeHorizon()
+	})
+}
+
+// SetConstraints sets the "constraints" field.
+func (u *PersonaUpsertBulk) SetConstraints(v []string) *PersonaUpsertBulk {
+	return u.Update(func(s *PersonaUpsert) {
+		s.SetConstraints(v)
+	})
+}
+
+// UpdateConstraints sets the "constraints" field to the value that was provided on create.
+func (u *PersonaUpsertBulk) UpdateConstraints() *PersonaUpsertBulk {
+	return u.Update(func(s *PersonaUpsert) {
+		s.UpdateConstraints()
+	})
+}
+
+// ClearConstraints clears the value of the "constraints" field.
+func (u *PersonaUpsertBulk) ClearConstraints() *PersonaUpsertBulk {
+	return u.Update(func(s *PersonaUpsert) {
+		s.ClearConstraints()
+	})
+}
+
+// SetSource sets the "source" field.
+func (u *PersonaUpsertBulk) SetSource(v string) *PersonaUpsertBulk {
+	return u.Update(func(s *PersonaUpsert) {
+		s.SetSource(v)
+	})
+}
+
+// UpdateSource sets the "source" field to the value that was provided on create.
+func (u *PersonaUpsertBulk) UpdateSource() *PersonaUpsertBulk {
+	return u.Update(func(s *PersonaUpsert) {
+		s.UpdateSource()
+	})
+}
+
+// SetArchived sets the "archived" field.
+func (u *PersonaUpsertBulk) SetArchived(v bool) *PersonaUpsertBulk {
+	return u.Update(func(s *PersonaUpsert) {
+		s.SetArchived(v)
+	})
+}
+
+// UpdateArchived sets the "archived" field to the value that was provided on create.
+func (u *PersonaUpsertBulk) UpdateArchived() *PersonaUpsertBulk {
+	return u.Update(func(s *PersonaUpsert) {
+		s.UpdateArchived()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *PersonaUpsertBulk) SetCreatedAt(v time.Time) *PersonaUpsertBulk {
+	return u.Update(func(s *PersonaUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *PersonaUpsertBulk) UpdateCreatedAt() *PersonaUpsertBulk {
+	return u.Update(func(s *PersonaUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PersonaUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PersonaCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PersonaCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PersonaUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1059 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/iWorld-y/domain_radar/app/common/ent/deepanalysisresult"
+	"github.com/iWorld-y/domain_radar/app/common/ent/persona"
+	"github.com/iWorld-y/domain_radar/app/common/ent/predicate"
+	"github.com/iWorld-y/domain_radar/app/common/ent/user"
+)
+
+// PersonaUpdate is the builder for updating Persona entities.
+type PersonaUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *PersonaMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the PersonaUpdate builder.
+func (_u *PersonaUpdate) Where(ps ...predicate.Persona) *PersonaUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *PersonaUpdate) SetUserID(v int) *PersonaUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *PersonaUpdate) SetNillableUserID(v *int) *PersonaUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *PersonaUpdate) SetName(v string) *PersonaUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *PersonaUpdate) SetNillableName(v *string) *PersonaUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetVersion sets the "version" field.
+func (_u *PersonaUpdate) SetVersion(v int) *PersonaUpdate {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *PersonaUpdate) SetNillableVersion(v *int) *PersonaUpdate {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *PersonaUpdate) AddVersion(v int) *PersonaUpdate {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
+// SetRole sets the "role" field.
+func (_u *PersonaUpdate) SetRole(v string) *PersonaUpdate {
+	_u.mutation.SetRole(v)
+	return _u
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_u *PersonaUpdate) SetNillableRole(v *string) *PersonaUpdate {
+	if v != nil {
+		_u.SetRole(*v)
+	}
+	return _u
+}
+
+// ClearRole clears the value of the "role" field.
+func (_u *PersonaUpdate) ClearRole() *PersonaUpdate {
+	_u.mutation.ClearRole()
+	return _u
+}
+
+// SetSeniority sets the "seniority" field.
+func (_u *PersonaUpdate) SetSeniority(v string) *PersonaUpdate {
+	_u.mutation.SetSeniority(v)
+	return _u
+}
+
+// SetNillableSeniority sets the "seniority" field if the given value is not nil.
+func (_u *PersonaUpdate) SetNillableSeniority(v *string) *PersonaUpdate {
+	if v != nil {
+		_u.SetSeniority(*v)
+	}
+	return _u
+}
+
+// ClearSeniority clears the value of the "seniority" field.
+func (_u *PersonaUpdate) ClearSeniority() *PersonaUpdate {
+	_u.mutation.ClearSeniority()
+	return _u
+}
+
+// SetTechStack sets the "tech_stack" field.
+func (_u *PersonaUpdate) SetTechStack(v []string) *PersonaUpdate {
+	_u.mutation.SetTechStack(v)
+	return _u
+}
+
+// AppendTechStack appends value to the "tech_stack" field.
+func (_u *PersonaUpdate) AppendTechStack(v []string) *PersonaUpdate {
+	_u.mutation.AppendTechStack(v)
+	return _u
+}
+
+// ClearTechStack clears the value of the "tech_stack" field.
+func (_u *PersonaUpdate) ClearTechStack() *PersonaUpdate {
+	_u.mutation.ClearTechStack()
+	return _u
+}
+
+// SetGoals sets the "goals" field.
+func (_u *PersonaUpdate) SetGoals(v []string) *PersonaUpdate {
+	_u.mutation.SetGoals(v)
+	return _u
+}
+
+// AppendGoals appends value to the "goals" field.
+func (_u *PersonaUpdate) AppendGoals(v []string) *PersonaUpdate {
+	_u.mutation.AppendGoals(v)
+	return _u
+}
+
+// ClearGoals clears the value of the "goals" field.
+func (_u *PersonaUpdate) ClearGoals() *PersonaUpdate {
+	_u.mutation.ClearGoals()
+	return _u
+}
+
+// SetRiskAppetite sets the "risk_appetite" field.
+func (_u *PersonaUpdate) SetRiskAppetite(v string) *PersonaUpdate {
+	_u.mutation.SetRiskAppetite(v)
+	return _u
+}
+
+// SetNillableRiskAppetite sets the "risk_appetite" field if the given value is not nil.
+func (_u *PersonaUpdate) SetNillableRiskAppetite(v *string) *PersonaUpdate {
+	if v != nil {
+		_u.SetRiskAppetite(*v)
+	}
+	return _u
+}
+
+// ClearRiskAppetite clears the value of the "risk_appetite" field.
+func (_u *PersonaUpdate) ClearRiskAppetite() *PersonaUpdate {
+	_u.mutation.ClearRiskAppetite()
+	return _u
+}
+
+// SetTimeHorizon sets the "time_horizon" field.
+func (_u *PersonaUpdate) SetTimeHorizon(v string) *PersonaUpdate {
+	_u.mutation.SetTimeHorizon(v)
+	return _u
+}
+
+// SetNillableTimeHorizon sets the "time_horizon" field if the given value is not nil.
+func (_u *PersonaUpdate) SetNillableTimeHorizon(v *string) *PersonaUpdate {
+	if v != nil {
+		_u.SetTimeHorizon(*v)
+	}
+	return _u
+}
+
+// ClearTimeHorizon clears the value of the "time_horizon" field.
+func (_u *PersonaUpdate) ClearTimeHorizon() *PersonaUpdate {
+	_u.mutation.ClearTimeHorizon()
+	return _u
+}
+
+// SetConstraints sets the "constraints" field.
+func (_u *PersonaUpdate) SetConstraints(v []string) *PersonaUpdate {
+	_u.mutation.SetConstraints(v)
+	return _u
+}
+
+// AppendConstraints appends value to the "constraints" field.
+func (_u *PersonaUpdate) AppendConstraints(v []string) *PersonaUpdate {
+	_u.mutation.AppendConstraints(v)
+	return _u
+}
+
+// ClearConstraints clears the value of the "constraints" field.
+func (_u *PersonaUpdate) ClearConstraints() *PersonaUpdate {
+	_u.mutation.ClearConstraints()
+	return _u
+}
+
+// SetSource sets the "source" field.
+func (_u *PersonaUpdate) SetSource(v string) *PersonaUpdate {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *PersonaUpdate) SetNillableSource(v *string) *PersonaUpdate {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// SetArchived sets the "archived" field.
+func (_u *PersonaUpdate) SetArchived(v bool) *PersonaUpdate {
+	_u.mutation.SetArchived(v)
+	return _u
+}
+
+// SetNillableArchived sets the "archived" field if the given value is not nil.
+func (_u *PersonaUpdate) SetNillableArchived(v *bool) *PersonaUpdate {
+	if v != nil {
+		_u.SetArchived(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *PersonaUpdate) SetCreatedAt(v time.Time) *PersonaUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *PersonaUpdate) SetNillableCreatedAt(v *time.Time) *PersonaUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *PersonaUpdate) SetUser(v *User) *PersonaUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// AddDeepAnalysisResultIDs adds the "deep_analysis_results" edge to the DeepAnalysisResult entity by IDs.
+func (_u *PersonaUpdate) AddDeepAnalysisResultIDs(ids ...int) *PersonaUpdate {
+	_u.mutation.AddDeepAnalysisResultIDs(ids...)
+	return _u
+}
+
+// AddDeepAnalysisResults adds the "deep_analysis_results" edges to the DeepAnalysisResult entity.
+func (_u *PersonaUpdate) AddDeepAnalysisResults(v ...*DeepAnalysisResult) *PersonaUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDeepAnalysisResultIDs(ids...)
+}
+
+// Mutation returns the PersonaMutation object of the builder.
+func (_u *PersonaUpdate) Mutation() *PersonaMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *PersonaUpdate) ClearUser() *PersonaUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// ClearDeepAnalysisResults clears all "deep_analysis_results" edges to the DeepAnalysisResult entity.
+func (_u *PersonaUpdate) ClearDeepAnalysisResults() *PersonaUpdate {
+	_u.mutation.ClearDeepAnalysisResults()
+	return _u
+}
+
+// RemoveDeepAnalysisResultIDs removes the "deep_analysis_results" edge to DeepAnalysisResult entities by IDs.
+func (_u *PersonaUpdate) RemoveDeepAnalysisResultIDs(ids ...int) *PersonaUpdate {
+	_u.mutation.RemoveDeepAnalysisResultIDs(ids...)
+	return _u
+}
+
+// RemoveDeepAnalysisResults removes "deep_analysis_results" edges to DeepAnalysisResult entities.
+func (_u *PersonaUpdate) RemoveDeepAnalysisResults(v ...*DeepAnalysisResult) *PersonaUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDeepAnalysisResultIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PersonaUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PersonaUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PersonaUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PersonaUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PersonaUpdate) check() error {
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Persona.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *PersonaUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PersonaUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *PersonaUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(persona.Table, persona.Columns, sqlgraph.NewFieldSpec(persona.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(persona.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(persona.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(persona.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Role(); ok {
+		_spec.SetField(persona.FieldRole, field.TypeString, value)
+	}
+	if _u.mutation.RoleCleared() {
+		_spec.ClearField(persona.FieldRole, field.TypeString)
+	}
+	if value, ok := _u.mutation.Seniority(); ok {
+		_spec.SetField(persona.FieldSeniority, field.TypeString, value)
+	}
+	if _u.mutation.SeniorityCleared() {
+		_spec.ClearField(persona.FieldSeniority, field.TypeString)
+	}
+	if value, ok := _u.mutation.TechStack(); ok {
+		_spec.SetField(persona.FieldTechStack, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTechStack(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, persona.FieldTechStack, value)
+		})
+	}
+	if _u.mutation.TechStackCleared() {
+		_spec.ClearField(persona.FieldTechStack, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Goals(); ok {
+		_spec.SetField(persona.FieldGoals, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedGoals(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, persona.FieldGoals, value)
+		})
+	}
+	if _u.mutation.GoalsCleared() {
+		_spec.ClearField(persona.FieldGoals, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.RiskAppetite(); ok {
+		_spec.SetField(persona.FieldRiskAppetite, field.TypeString, value)
+	}
+	if _u.mutation.RiskAppetiteCleared() {
+		_spec.ClearField(persona.FieldRiskAppetite, field.TypeString)
+	}
+	if value, ok := _u.mutation.TimeHorizon(); ok {
+		_spec.SetField(persona.FieldTimeHorizon, field.TypeString, value)
+	}
+	if _u.mutation.TimeHorizonCleared() {
+		_spec.ClearField(persona.FieldTimeHorizon, field.TypeString)
+	}
+	if value, ok := _u.mutation.Constraints(); ok {
+		_spec.SetField(persona.FieldConstraints, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedConstraints(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, persona.FieldConstraints, value)
+		})
+	}
+	if _u.mutation.ConstraintsCleared() {
+		_spec.ClearField(persona.FieldConstraints, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(persona.FieldSource, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Archived(); ok {
+		_spec.SetField(persona.FieldArchived, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(persona.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   persona.UserTable,
+			Columns: []string{persona.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   persona.UserTable,
+			Columns: []string{persona.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.DeepAnalysisResultsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   persona.DeepAnalysisResultsTable,
+			Columns: []string{persona.DeepAnalysisResultsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deepanalysisresult.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDeepAnalysisResultsIDs(); len(nodes) > 0 && !_u.mutation.DeepAnalysisResultsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   persona.DeepAnalysisResultsTable,
+			Columns: []string{persona.DeepAnalysisResultsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deepanalysisresult.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DeepAnalysisResultsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   persona.DeepAnalysisResultsTable,
+			Columns: []string{persona.DeepAnalysisResultsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deepanalysisresult.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{persona.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PersonaUpdateOne is the builder for updating a single Persona entity.
+type PersonaUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *PersonaMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *PersonaUpdateOne) SetUserID(v int) *PersonaUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *PersonaUpdateOne) SetNillableUserID(v *int) *PersonaUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *PersonaUpdateOne) SetName(v string) *PersonaUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *PersonaUpdateOne) SetNillableName(v *string) *PersonaUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetVersion sets the "version" field.
+func (_u *PersonaUpdateOne) SetVersion(v int) *PersonaUpdateOne {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *PersonaUpdateOne) SetNillableVersion(v *int) *PersonaUpdateOne {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *PersonaUpdateOne) AddVersion(v int) *PersonaUpdateOne {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
+// SetRole sets the "role" field.
+func (_u *PersonaUpdateOne) SetRole(v string) *PersonaUpdateOne {
+	_u.mutation.SetRole(v)
+	return _u
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_u *PersonaUpdateOne) SetNillableRole(v *string) *PersonaUpdateOne {
+	if v != nil {
+		_u.SetRole(*v)
+	}
+	return _u
+}
+
+// ClearRole clears the value of the "role" field.
+func (_u *PersonaUpdateOne) ClearRole() *PersonaUpdateOne {
+	_u.mutation.ClearRole()
+	return _u
+}
+
+// SetSeniority sets the "seniority" field.
+func (_u *PersonaUpdateOne) SetSeniority(v string) *PersonaUpdateOne {
+	_u.mutation.SetSeniority(v)
+	return _u
+}
+
+// SetNillableSeniority sets the "seniority" field if the given value is not nil.
+func (_u *PersonaUpdateOne) SetNillableSeniority(v *string) *PersonaUpdateOne {
+	if v != nil {
+		_u.SetSeniority(*v)
+	}
+	return _u
+}
+
+// ClearSeniority clears the value of the "seniority" field.
+func (_u *PersonaUpdateOne) ClearSeniority() *PersonaUpdateOne {
+	_u.mutation.ClearSeniority()
+	return _u
+}
+
+// SetTechStack sets the "tech_stack" field.
+func (_u *PersonaUpdateOne) SetTechStack(v []string) *PersonaUpdateOne {
+	_u.mutation.SetTechStack(v)
+	return _u
+}
+
+// AppendTechStack appends value to the "tech_stack" field.
+func (_u *PersonaUpdateOne) AppendTechStack(v []string) *PersonaUpdateOne {
+	_u.mutation.AppendTechStack(v)
+	return _u
+}
+
+// ClearTechStack clears the value of the "tech_stack" field.
+func (_u *PersonaUpdateOne) ClearTechStack() *PersonaUpdateOne {
+	_u.mutation.ClearTechStack()
+	return _u
+}
+
+// SetGoals sets the "goals" field.
+func (_u *PersonaUpdateOne) SetGoals(v []string) *PersonaUpdateOne {
+	_u.mutation.SetGoals(v)
+	return _u
+}
+
+// AppendGoals appends value to the "goals" field.
+func (_u *PersonaUpdateOne) AppendGoals(v []string) *PersonaUpdateOne {
+	_u.mutation.AppendGoals(v)
+	return _u
+}
+
+// ClearGoals clears the value of the "goals" field.
+func (_u *PersonaUpdateOne) ClearGoals() *PersonaUpdateOne {
+	_u.mutation.ClearGoals()
+	return _u
+}
+
+// SetRiskAppetite sets the "risk_appetite" field.
+func (_u *PersonaUpdateOne) SetRiskAppetite(v string) *PersonaUpdateOne {
+	_u.mutation.SetRiskAppetite(v)
+	return _u
+}
+
+// SetNillableRiskAppetite sets the "risk_appetite" field if the given value is not nil.
+func (_u *PersonaUpdateOne) SetNillableRiskAppetite(v *string) *PersonaUpdateOne {
+	if v != nil {
+		_u.SetRiskAppetite(*v)
+	}
+	return _u
+}
+
+// ClearRiskAppetite clears the value of the "risk_appetite" field.
+func (_u *PersonaUpdateOne) ClearRiskAppetite() *PersonaUpdateOne {
+	_u.mutation.ClearRiskAppetite()
+	return _u
+}
+
+// SetTimeHorizon sets the "time_horizon" field.
+func (_u *PersonaUpdateOne) SetTimeHorizon(v string) *PersonaUpdateOne {
+	_u.mutation.SetTimeHorizon(v)
+	return _u
+}
+
+// SetNillableTimeHorizon sets the "time_horizon" field if the given value is not nil.
+func (_u *PersonaUpdateOne) SetNillableTimeHorizon(v *string) *PersonaUpdateOne {
+	if v != nil {
+		_u.SetTimeHorizon(*v)
+	}
+	return _u
+}
+
+// ClearTimeHorizon clears the value of the "time_horizon" field.
+func (_u *PersonaUpdateOne) ClearTimeHorizon() *PersonaUpdateOne {
+	_u.mutation.ClearTimeHorizon()
+	return _u
+}
+
+// SetConstraints sets the "constraints" field.
+func (_u *PersonaUpdateOne) SetConstraints(v []string) *PersonaUpdateOne {
+	_u.mutation.SetConstraints(v)
+	return _u
+}
+
+// AppendConstraints appends value to the "constraints" field.
+func (_u *PersonaUpdateOne) AppendConstraints(v []string) *PersonaUpdateOne {
+	_u.mutation.AppendConstraints(v)
+	return _u
+}
+
+// ClearConstraints clears the value of the "constraints" field.
+func (_u *PersonaUpdateOne) ClearConstraints() *PersonaUpdateOne {
+	_u.mutation.ClearConstraints()
+	return _u
+}
+
+// SetSource sets the "source" field.
+func (_u *PersonaUpdateOne) SetSource(v string) *PersonaUpdateOne {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *PersonaUpdateOne) SetNillableSource(v *string) *PersonaUpdateOne {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// SetArchived sets the "archived" field.
+func (_u *PersonaUpdateOne) SetArchived(v bool) *PersonaUpdateOne {
+	_u.mutation.SetArchived(v)
+	return _u
+}
+
+// SetNillableArchived sets the "archived" field if the given value is not nil.
+func (_u *PersonaUpdateOne) SetNillableArchived(v *bool) *PersonaUpdateOne {
+	if v != nil {
+		_u.SetArchived(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *PersonaUpdateOne) SetCreatedAt(v time.Time) *PersonaUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *PersonaUpdateOne) SetNillableCreatedAt(v *time.Time) *PersonaUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *PersonaUpdateOne) SetUser(v *User) *PersonaUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// AddDeepAnalysisResultIDs adds the "deep_analysis_results" edge to the DeepAnalysisResult entity by IDs.
+func (_u *PersonaUpdateOne) AddDeepAnalysisResultIDs(ids ...int) *PersonaUpdateOne {
+	_u.mutation.AddDeepAnalysisResultIDs(ids...)
+	return _u
+}
+
+// AddDeepAnalysisResults adds the "deep_analysis_results" edges to the DeepAnalysisResult entity.
+func (_u *PersonaUpdateOne) AddDeepAnalysisResults(v ...*DeepAnalysisResult) *PersonaUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDeepAnalysisResultIDs(ids...)
+}
+
+// Mutation returns the PersonaMutation object of the builder.
+func (_u *PersonaUpdateOne) Mutation() *PersonaMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *PersonaUpdateOne) ClearUser() *PersonaUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// ClearDeepAnalysisResults clears all "deep_analysis_results" edges to the DeepAnalysisResult entity.
+func (_u *PersonaUpdateOne) ClearDeepAnalysisResults() *PersonaUpdateOne {
+	_u.mutation.ClearDeepAnalysisResults()
+	return _u
+}
+
+// RemoveDeepAnalysisResultIDs removes the "deep_analysis_results" edge to DeepAnalysisResult entities by IDs.
+func (_u *PersonaUpdateOne) RemoveDeepAnalysisResultIDs(ids ...int) *PersonaUpdateOne {
+	_u.mutation.RemoveDeepAnalysisResultIDs(ids...)
+	return _u
+}
+
+// RemoveDeepAnalysisResults removes "deep_analysis_results" edges to DeepAnalysisResult entities.
+func (_u *PersonaUpdateOne) RemoveDeepAnalysisResults(v ...*DeepAnalysisResult) *PersonaUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDeepAnalysisResultIDs(ids...)
+}
+
+// Where appends a list predicates to the PersonaUpdate builder.
+func (_u *PersonaUpdateOne) Where(ps ...predicate.Persona) *PersonaUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PersonaUpdateOne) Select(field string, fields ...string) *PersonaUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Persona entity.
+func (_u *PersonaUpdateOne) Save(ctx context.Context) (*Persona, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PersonaUpdateOne) SaveX(ctx context.Context) *Persona {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PersonaUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PersonaUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PersonaUpdateOne) check() error {
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Persona.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *PersonaUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PersonaUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *PersonaUpdateOne) sqlSave(ctx context.Context) (_node *Persona, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(persona.Table, persona.Columns, sqlgraph.NewFieldSpec(persona.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Persona.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, persona.FieldID)
+		for _, f := range fields {
+			if !persona.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != persona.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(persona.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(persona.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(persona.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Role(); ok {
+		_spec.SetField(persona.FieldRole, field.TypeString, value)
+	}
+	if _u.mutation.RoleCleared() {
+		_spec.ClearField(persona.FieldRole, field.TypeString)
+	}
+	if value, ok := _u.mutation.Seniority(); ok {
+		_spec.SetField(persona.FieldSeniority, field.TypeString, value)
+	}
+	if _u.mutation.SeniorityCleared() {
+		_spec.ClearField(persona.FieldSeniority, field.TypeString)
+	}
+	if value, ok := _u.mutation.TechStack(); ok {
+		_spec.SetField(persona.FieldTechStack, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTechStack(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, persona.FieldTechStack, value)
+		})
+	}
+	if _u.mutation.TechStackCleared() {
+		_spec.ClearField(persona.FieldTechStack, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Goals(); ok {
+		_spec.SetField(persona.FieldGoals, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedGoals(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, persona.FieldGoals, value)
+		})
+	}
+	if _u.mutation.GoalsCleared() {
+		_spec.ClearField(persona.FieldGoals, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.RiskAppetite(); ok {
+		_spec.SetField(persona.FieldRiskAppetite, field.TypeString, value)
+	}
+	if _u.mutation.RiskAppetiteCleared() {
+		_spec.ClearField(persona.FieldRiskAppetite, field.TypeString)
+	}
+	if value, ok := _u.mutation.TimeHorizon(); ok {
+		_spec.SetField(persona.FieldTimeHorizon, field.TypeString, value)
+	}
+	if _u.mutation.TimeHorizonCleared() {
+		_spec.ClearField(persona.FieldTimeHorizon, field.TypeString)
+	}
+	if value, ok := _u.mutation.Constraints(); ok {
+		_spec.SetField(persona.FieldConstraints, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedConstraints(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, persona.FieldConstraints, value)
+		})
+	}
+	if _u.mutation.ConstraintsCleared() {
+		_spec.ClearField(persona.FieldConstraints, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(persona.FieldSource, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Archived(); ok {
+		_spec.SetField(persona.FieldArchived, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(persona.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   persona.UserTable,
+			Columns: []string{persona.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   persona.UserTable,
+			Columns: []string{persona.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.DeepAnalysisResultsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   persona.DeepAnalysisResultsTable,
+			Columns: []string{persona.DeepAnalysisResultsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deepanalysisresult.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDeepAnalysisResultsIDs(); len(nodes) > 0 && !_u.mutation.DeepAnalysisResultsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   persona.DeepAnalysisResultsTable,
+			Columns: []string{persona.DeepAnalysisResultsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deepanalysisresult.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DeepAnalysisResultsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   persona.DeepAnalysisResultsTable,
+			Columns: []string{persona.DeepAnalysisResultsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deepanalysisresult.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &Persona{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{persona.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -9,20 +9,50 @@ import (
 // ActionGuide is the predicate function for actionguide builders.
 type ActionGuide func(*sql.Selector)
 
+// AnalysisLens is the predicate function for analysislens builders.
+type AnalysisLens func(*sql.Selector)
+
+// AnalysisSection is the predicate function for analysissection builders.
+type AnalysisSection func(*sql.Selector)
+
 // Article is the predicate function for article builders.
 type Article func(*sql.Selector)
 
+// ArticleEntity is the predicate function for articleentity builders.
+type ArticleEntity func(*sql.Selector)
+
+// ClaimVerification is the predicate function for claimverification builders.
+type ClaimVerification func(*sql.Selector)
+
 // DeepAnalysisResult is the predicate function for deepanalysisresult builders.
 type DeepAnalysisResult func(*sql.Selector)
 
 // DomainReport is the predicate function for domainreport builders.
 type DomainReport func(*sql.Selector)
 
+// DomainRun is the predicate function for domainrun builders.
+type DomainRun func(*sql.Selector)
+
+// Entity is the predicate function for entity builders.
+type Entity func(*sql.Selector)
+
 // KeyEvent is the predicate function for keyevent builders.
 type KeyEvent func(*sql.Selector)
 
+// LLMCache is the predicate function for llmcache builders.
+type LLMCache func(*sql.Selector)
+
+// LLMCall is the predicate function for llmcall builders.
+type LLMCall func(*sql.Selector)
+
+// Persona is the predicate function for persona builders.
+type Persona func(*sql.Selector)
+
 // ReportRun is the predicate function for reportrun builders.
 type ReportRun func(*sql.Selector)
 
+// ReportRunEvent is the predicate function for reportrunevent builders.
+type ReportRunEvent func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
@@ -21,6 +21,14 @@ type ReportRun struct {
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// Title holds the value of the "title" field.
 	Title string `json:"title,omitempty"`
+	// Owner of the run, empty for runs not triggered by a user
+	UserID int `json:"user_id,omitempty"`
+	// Start of the news search window, empty for runs created before windows were recorded
+	WindowStart *time.Time `json:"window_start,omitempty"`
+	// End of the news search window
+	WindowEnd *time.Time `json:"window_end,omitempty"`
+	// Run status: running, completed, failed, cancelled or budget_exceeded; runs created before statuses were tracked count as completed
+	Status string `json:"status,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ReportRunQuery when eager-loading is set.
 	Edges        ReportRunEdges `json:"edges"`
@@ -29,13 +37,21 @@ type ReportRun struct {
 
 // ReportRunEdges holds the relations/edges for other nodes in the graph.
 type ReportRunEdges struct {
-	// DomainReports holds the value of the domain_reports edge.
+	// Domain reports included in the run, either generated by it or shared from another run
 	DomainReports []*DomainReport `json:"domain_reports,omitempty"`
+	// Domain reports generated by the run
+	GeneratedDomainReports []*DomainReport `json:"generated_domain_reports,omitempty"`
 	// DeepAnalysisResults holds the value of the deep_analysis_results edge.
 	DeepAnalysisResults []*DeepAnalysisResult `json:"deep_analysis_results,omitempty"`
+	// LlmCalls holds the value of the llm_calls edge.
+	LlmCalls []*LLMCall `json:"llm_calls,omitempty"`
+	// DomainRuns holds the value of the domain_runs edge.
+	DomainRuns []*DomainRun `json:"domain_runs,omitempty"`
+	// Pipeline events of the run in chronological order
+	Events []*ReportRunEvent `json:"events,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [6]bool
 }
 
 // DomainReportsOrErr returns the DomainReports value or an error if the edge
@@ -47,25 +63,61 @@ func (e ReportRunEdges) DomainReportsOrErr() ([]*DomainReport, error) {
 	return nil, &NotLoadedError{edge: "domain_reports"}
 }
 
+// GeneratedDomainReportsOrErr returns the GeneratedDomainReports value or an error if the edge
+// was not loaded in eager-loading.
+func (e ReportRunEdges) GeneratedDomainReportsOrErr() ([]*DomainReport, error) {
+	if e.loadedTypes[1] {
+		return e.GeneratedDomainReports, nil
+	}
+	return nil, &NotLoadedError{edge: "generated_domain_reports"}
+}
+
 // DeepAnalysisResultsOrErr returns the DeepAnalysisResults value or an error if the edge
 // was not loaded in eager-loading.
 func (e ReportRunEdges) DeepAnalysisResultsOrErr() ([]*DeepAnalysisResult, error) {
-	if e.loadedTypes[1] {
+	if e.loadedTypes[2] {
 		return e.DeepAnalysisResults, nil
 	}
 	return nil, &NotLoadedError{edge: "deep_analysis_results"}
 }
 
+// LlmCallsOrErr returns the LlmCalls value or an error if the edge
+// was not loaded in eager-loading.
+func (e ReportRunEdges) LlmCallsOrErr() ([]*LLMCall, error) {
+	if e.loadedTypes[3] {
+		return e.LlmCalls, nil
+	}
+	return nil, &NotLoadedError{edge: "llm_calls"}
+}
+
+// DomainRunsOrErr returns the DomainRuns value or an error if the edge
+// was not loaded in eager-loading.
+func (e ReportRunEdges) DomainRunsOrErr() ([]*DomainRun, error) {
+	if e.loadedTypes[4] {
+		return e.DomainRuns, nil
+	}
+	return nil, &NotLoadedError{edge: "domain_runs"}
+}
+
+// EventsOrErr returns the Events value or an error if the edge
+// was not loaded in eager-loading.
+func (e ReportRunEdges) EventsOrErr() ([]*ReportRunEvent, error) {
+	if e.loadedTypes[5] {
+		return e.Events, nil
+	}
+	return nil, &NotLoadedError{edge: "events"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*ReportRun) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case reportrun.FieldID:
+		case reportrun.FieldID, reportrun.FieldUserID:
 			values[i] = new(sql.NullInt64)
-		case reportrun.FieldTitle:
+		case reportrun.FieldTitle, reportrun.FieldStatus:
 			values[i] = new(sql.NullString)
-		case reportrun.FieldCreatedAt:
+		case reportrun.FieldCreatedAt, reportrun.FieldWindowStart, reportrun.FieldWindowEnd:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -100,6 +152,32 @@ func (_m *ReportRun) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Title = value.String
 			}
+		case reportrun.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = int(value.Int64)
+			}
+		case reportrun.FieldWindowStart:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field window_start", values[i])
+			} else if value.Valid {
+				_m.WindowStart = new(time.Time)
+				*_m.WindowStart = value.Time
+			}
+		case reportrun.FieldWindowEnd:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field window_end", values[i])
+			} else if value.Valid {
+				_m.WindowEnd = new(time.Time)
+				*_m.WindowEnd = value.Time
+			}
+		case reportrun.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = value.String
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -118,11 +196,31 @@ func (_m *ReportRun) QueryDomainReports() *DomainReportQuery {
 	return NewReportRunClient(_m.config).QueryDomainReports(_m)
 }
 
+// QueryGeneratedDomainReports queries the "generated_domain_reports" edge of the ReportRun entity.
+func (_m *ReportRun) QueryGeneratedDomainReports() *DomainReportQuery {
+	return NewReportRunClient(_m.config).QueryGeneratedDomainReports(_m)
+}
+
 // QueryDeepAnalysisResults queries the "deep_analysis_results" edge of the ReportRun entity.
 func (_m *ReportRun) QueryDeepAnalysisResults() *DeepAnalysisResultQuery {
 	return NewReportRunClient(_m.config).QueryDeepAnalysisResults(_m)
 }
 
+// QueryLlmCalls queries the "llm_calls" edge of the ReportRun entity.
+func (_m *ReportRun) QueryLlmCalls() *LLMCallQuery {
+	return NewReportRunClient(_m.config).QueryLlmCalls(_m)
+}
+
+// QueryDomainRuns queries the "domain_runs" edge of the ReportRun entity.
+func (_m *ReportRun) QueryDomainRuns() *DomainRunQuery {
+	return NewReportRunClient(_m.config).QueryDomainRuns(_m)
+}
+
+// QueryEvents queries the "events" edge of the ReportRun entity.
+func (_m *ReportRun) QueryEvents() *ReportRunEventQuery {
+	return NewReportRunClient(_m.config).QueryEvents(_m)
+}
+
 // Update returns a builder for updating this ReportRun.
 // Note that you need to call ReportRun.Unwrap() before calling this method if this ReportRun
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -151,6 +249,22 @@ func (_m *ReportRun) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("title=")
 	builder.WriteString(_m.Title)
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	if v := _m.WindowStart; v != nil {
+		builder.WriteString("window_start=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.WindowEnd; v != nil {
+		builder.WriteString("window_end=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(_m.Status)
 	builder.WriteByte(')')
 	return builder.String()
 }
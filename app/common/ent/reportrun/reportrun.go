@@ -18,19 +18,40 @@ const (
 	FieldCreatedAt = "created_at"
 	// FieldTitle holds the string denoting the title field in the database.
 	FieldTitle = "title"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldWindowStart holds the string denoting the window_start field in the database.
+	FieldWindowStart = "window_start"
+	// FieldWindowEnd holds the string denoting the window_end field in the database.
+	FieldWindowEnd = "window_end"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
 	// EdgeDomainReports holds the string denoting the domain_reports edge name in mutations.
 	EdgeDomainReports = "domain_reports"
+	// EdgeGeneratedDomainReports holds the string denoting the generated_domain_reports edge name in mutations.
+	EdgeGeneratedDomainReports = "generated_domain_reports"
 	// EdgeDeepAnalysisResults holds the string denoting the deep_analysis_results edge name in mutations.
 	EdgeDeepAnalysisResults = "deep_analysis_results"
+	// EdgeLlmCalls holds the string denoting the llm_calls edge name in mutations.
+	EdgeLlmCalls = "llm_calls"
+	// EdgeDomainRuns holds the string denoting the domain_runs edge name in mutations.
+	EdgeDomainRuns = "domain_runs"
+	// EdgeEvents holds the string denoting the events edge name in mutations.
+	EdgeEvents = "events"
 	// Table holds the table name of the reportrun in the database.
 	Table = "report_runs"
-	// DomainReportsTable is the table that holds the domain_reports relation/edge.
-	DomainReportsTable = "domain_reports"
+	// DomainReportsTable is the table that holds the domain_reports relation/edge. The primary key declared below.
+	DomainReportsTable = "report_run_domain_reports"
 	// DomainReportsInverseTable is the table name for the DomainReport entity.
 	// It exists in this package in order to avoid circular dependency with the "domainreport" package.
 	DomainReportsInverseTable = "domain_reports"
-	// DomainReportsColumn is the table column denoting the domain_reports relation/edge.
-	DomainReportsColumn = "run_id"
+	// GeneratedDomainReportsTable is the table that holds the generated_domain_reports relation/edge.
+	GeneratedDomainReportsTable = "domain_reports"
+	// GeneratedDomainReportsInverseTable is the table name for the DomainReport entity.
+	// It exists in this package in order to avoid circular dependency with the "domainreport" package.
+	GeneratedDomainReportsInverseTable = "domain_reports"
+	// GeneratedDomainReportsColumn is the table column denoting the generated_domain_reports relation/edge.
+	GeneratedDomainReportsColumn = "run_id"
 	// DeepAnalysisResultsTable is the table that holds the deep_analysis_results relation/edge.
 	DeepAnalysisResultsTable = "deep_analysis_results"
 	// DeepAnalysisResultsInverseTable is the table name for the DeepAnalysisResult entity.
@@ -38,6 +59,27 @@ const (
 	DeepAnalysisResultsInverseTable = "deep_analysis_results"
 	// DeepAnalysisResultsColumn is the table column denoting the deep_analysis_results relation/edge.
 	DeepAnalysisResultsColumn = "run_id"
+	// LlmCallsTable is the table that holds the llm_calls relation/edge.
+	LlmCallsTable = "llm_calls"
+	// LlmCallsInverseTable is the table name for the LLMCall entity.
+	// It exists in this package in order to avoid circular dependency with the "llmcall" package.
+	LlmCallsInverseTable = "llm_calls"
+	// LlmCallsColumn is the table column denoting the llm_calls relation/edge.
+	LlmCallsColumn = "run_id"
+	// DomainRunsTable is the table that holds the domain_runs relation/edge.
+	DomainRunsTable = "domain_runs"
+	// DomainRunsInverseTable is the table name for the DomainRun entity.
+	// It exists in this package in order to avoid circular dependency with the "domainrun" package.
+	DomainRunsInverseTable = "domain_runs"
+	// DomainRunsColumn is the table column denoting the domain_runs relation/edge.
+	DomainRunsColumn = "run_id"
+	// EventsTable is the table that holds the events relation/edge.
+	EventsTable = "report_run_events"
+	// EventsInverseTable is the table name for the ReportRunEvent entity.
+	// It exists in this package in order to avoid circular dependency with the "reportrunevent" package.
+	EventsInverseTable = "report_run_events"
+	// EventsColumn is the table column denoting the events relation/edge.
+	EventsColumn = "run_id"
 )
 
 // Columns holds all SQL columns for reportrun fields.
@@ -45,8 +87,18 @@ var Columns = []string{
 	FieldID,
 	FieldCreatedAt,
 	FieldTitle,
+	FieldUserID,
+	FieldWindowStart,
+	FieldWindowEnd,
+	FieldStatus,
 }
 
+var (
+	// DomainReportsPrimaryKey and DomainReportsColumn2 are the table columns denoting the
+	// primary key for the domain_reports relation (M2M).
+	DomainReportsPrimaryKey = []string{"report_run_id", "domain_report_id"}
+)
+
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -62,6 +114,8 @@ var (
 	DefaultCreatedAt func() time.Time
 	// DefaultTitle holds the default value on creation for the "title" field.
 	DefaultTitle string
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
 )
 
 // OrderOption defines the ordering options for the ReportRun queries.
@@ -82,6 +136,26 @@ func ByTitle(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTitle, opts...).ToFunc()
 }
 
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByWindowStart orders the results by the window_start field.
+func ByWindowStart(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWindowStart, opts...).ToFunc()
+}
+
+// ByWindowEnd orders the results by the window_end field.
+func ByWindowEnd(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWindowEnd, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
 // ByDomainReportsCount orders the results by domain_reports count.
 func ByDomainReportsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -96,6 +170,20 @@ func ByDomainReports(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	}
 }
 
+// ByGeneratedDomainReportsCount orders the results by generated_domain_reports count.
+func ByGeneratedDomainReportsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newGeneratedDomainReportsStep(), opts...)
+	}
+}
+
+// ByGeneratedDomainReports orders the results by generated_domain_reports terms.
+func ByGeneratedDomainReports(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newGeneratedDomainReportsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByDeepAnalysisResultsCount orders the results by deep_analysis_results count.
 func ByDeepAnalysisResultsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -109,11 +197,60 @@ func ByDeepAnalysisResults(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOpti
 		sqlgraph.OrderByNeighborTerms(s, newDeepAnalysisResultsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByLlmCallsCount orders the results by llm_calls count.
+func ByLlmCallsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newLlmCallsStep(), opts...)
+	}
+}
+
+// ByLlmCalls orders the results by llm_calls terms.
+func ByLlmCalls(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLlmCallsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByDomainRunsCount orders the results by domain_runs count.
+func ByDomainRunsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newDomainRunsStep(), opts...)
+	}
+}
+
+// ByDomainRuns orders the results by domain_runs terms.
+func ByDomainRuns(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDomainRunsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByEventsCount orders the results by events count.
+func ByEventsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newEventsStep(), opts...)
+	}
+}
+
+// ByEvents orders the results by events terms.
+func ByEvents(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEventsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newDomainReportsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
 		sqlgraph.To(DomainReportsInverseTable, FieldID),
-		sqlgraph.Edge(sqlgraph.O2M, false, DomainReportsTable, DomainReportsColumn),
+		sqlgraph.Edge(sqlgraph.M2M, false, DomainReportsTable, DomainReportsPrimaryKey...),
+	)
+}
+func newGeneratedDomainReportsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(GeneratedDomainReportsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, GeneratedDomainReportsTable, GeneratedDomainReportsColumn),
 	)
 }
 func newDeepAnalysisResultsStep() *sqlgraph.Step {
@@ -123,3 +260,24 @@ func newDeepAnalysisResultsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, DeepAnalysisResultsTable, DeepAnalysisResultsColumn),
 	)
 }
+func newLlmCallsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LlmCallsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, LlmCallsTable, LlmCallsColumn),
+	)
+}
+func newDomainRunsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DomainRunsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, DomainRunsTable, DomainRunsColumn),
+	)
+}
+func newEventsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(EventsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, EventsTable, EventsColumn),
+	)
+}
@@ -65,6 +65,26 @@ func Title(v string) predicate.ReportRun {
 	return predicate.ReportRun(sql.FieldEQ(FieldTitle, v))
 }
 
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.ReportRun {
+	return predicate.ReportRun(sql.FieldEQ(FieldUserID, v))
+}
+
+// WindowStart applies equality check predicate on the "window_start" field. It's identical to WindowStartEQ.
+func WindowStart(v time.Time) predicate.ReportRun {
+	return predicate.ReportRun(sql.FieldEQ(FieldWindowStart, v))
+}
+
+// WindowEnd applies equality check predicate on the "window_end" field. It's identical to WindowEndEQ.
+func WindowEnd(v time.Time) predicate.ReportRun {
+	return predicate.ReportRun(sql.FieldEQ(FieldWindowEnd, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.ReportRun {
+	return predicate.ReportRun(sql.FieldEQ(FieldStatus, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.ReportRun {
 	return predicate.ReportRun(sql.FieldEQ(FieldCreatedAt, v))
@@ -180,12 +200,227 @@ func TitleContainsFold(v string) predicate.ReportRun {
 	return predicate.ReportRun(sql.FieldContainsFold(FieldTitle, v))
 }
 
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.ReportRun {
+	return predicate.ReportRun(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.ReportRun {
+	return predicate.ReportRun(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.ReportRun {
+	return predicate.ReportRun(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.ReportRun {
+	return predicate.ReportRun(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v int) predicate.ReportRun {
+	return predicate.ReportRun(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v int) predicate.ReportRun {
+	return predicate.ReportRun(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v int) predicate.ReportRun {
+	return predicate.ReportRun(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v int) predicate.ReportRun {
+	return predicate.ReportRun(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDIsNil applies the IsNil predicate on the "user_id" field.
+func UserIDIsNil() predicate.ReportRun {
+	return predicate.ReportRun(sql.FieldIsNull(FieldUserID))
+}
+
+// UserIDNotNil applies the NotNil predicate on the "user_id" field.
+func UserIDNotNil() predicate.ReportRun {
+	return predicate.ReportRun(sql.FieldNotNull(FieldUserID))
+}
+
+// WindowStartEQ applies the EQ predicate on the "window_start" field.
+func WindowStartEQ(v time.Time) predicate.ReportRun {
+	return predicate.ReportRun(sql.FieldEQ(FieldWindowStart, v))
+}
+
+// WindowStartNEQ applies the NEQ predicate on the "window_start" field.
+func WindowStartNEQ(v time.Time) predicate.ReportRun {
+	return predicate.ReportRun(sql.FieldNEQ(FieldWindowStart, v))
+}
+
+// WindowStartIn applies the In predicate on the "window_start" field.
+func WindowStartIn(vs ...time.Time) predicate.ReportRun {
+	return predicate.ReportRun(sql.FieldIn(FieldWindowStart, vs...))
+}
+
+// WindowStartNotIn applies the NotIn predicate on the "window_start" field.
+func WindowStartNotIn(vs ...time.Time) predicate.ReportRun {
+	return predicate.ReportRun(sql.FieldNotIn(FieldWindowStart, vs...))
+}
+
+// WindowStartGT applies the GT predicate on the "window_start" field.
+func WindowStartGT(v time.Time) predicate.ReportRun {
+	return predicate.ReportRun(sql.FieldGT(FieldWindowStart, v))
+}
+
+// WindowStartGTE applies the GTE predicate on the "window_start" field.
+func WindowStartGTE(v time.Time) predicate.ReportRun {
+	return predicate.ReportRun(sql.FieldGTE(FieldWindowStart, v))
+}
+
+// WindowStartLT applies the LT predicate on the "window_start" field.
+func WindowStartLT(v time.Time) predicate.ReportRun {
+	return predicate.ReportRun(sql.FieldLT(FieldWindowStart, v))
+}
+
+// WindowStartLTE applies the LTE predicate on the "window_start" field.
+func WindowStartLTE(v time.Time) predicate.ReportRun {
+	return predicate.ReportRun(sql.FieldLTE(FieldWindowStart, v))
+}
+
+// WindowStartIsNil applies the IsNil predicate on the "window_start" field.
+func WindowStartIsNil() predicate.ReportRun {
+	return predicate.ReportRun(sql.FieldIsNull(FieldWindowStart))
+}
+
+// WindowStartNotNil applies the NotNil predicate on the "window_start" field.
+func WindowStartNotNil() predicate.ReportRun {
+	return predicate.ReportRun(sql.FieldNotNull(FieldWindowStart))
+}
+
+// WindowEndEQ applies the EQ predicate on the "window_end" field.
+func WindowEndEQ(v time.Time) predicate.ReportRun {
+	return predicate.ReportRun(sql.FieldEQ(FieldWindowEnd, v))
+}
+
+// WindowEndNEQ applies the NEQ predicate on the "window_end" field.
+func WindowEndNEQ(v time.Time) predicate.ReportRun {
+	return predicate.ReportRun(sql.FieldNEQ(FieldWindowEnd, v))
+}
+
+// WindowEndIn applies the In predicate on the "window_end" field.
+func WindowEndIn(vs ...time.Time) predicate.ReportRun {
+	return predicate.ReportRun(sql.FieldIn(FieldWindowEnd, vs...))
+}
+
+// WindowEndNotIn applies the NotIn predicate on the "window_end" field.
+func WindowEndNotIn(vs ...time.Time) predicate.ReportRun {
+	return predicate.ReportRun(sql.FieldNotIn(FieldWindowEnd, vs...))
+}
+
+// WindowEndGT applies the GT predicate on the "window_end" field.
+func WindowEndGT(v time.Time) predicate.ReportRun {
+	return predicate.ReportRun(sql.FieldGT(FieldWindowEnd, v))
+}
+
+// WindowEndGTE applies the GTE predicate on the "window_end" field.
+func WindowEndGTE(v time.Time) predicate.ReportRun {
+	return predicate.ReportRun(sql.FieldGTE(FieldWindowEnd, v))
+}
+
+// WindowEndLT applies the LT predicate on the "window_end" field.
+func WindowEndLT(v time.Time) predicate.ReportRun {
+	return predicate.ReportRun(sql.FieldLT(FieldWindowEnd, v))
+}
+
+// WindowEndLTE applies the LTE predicate on the "window_end" field.
+func WindowEndLTE(v time.Time) predicate.ReportRun {
+	return predicate.ReportRun(sql.FieldLTE(FieldWindowEnd, v))
+}
+
+// WindowEndIsNil applies the IsNil predicate on the "window_end" field.
+func WindowEndIsNil() predicate.ReportRun {
+	return predicate.ReportRun(sql.FieldIsNull(FieldWindowEnd))
+}
+
+// WindowEndNotNil applies the NotNil predicate on the "window_end" field.
+func WindowEndNotNil() predicate.ReportRun {
+	return predicate.ReportRun(sql.FieldNotNull(FieldWindowEnd))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.ReportRun {
+	return predicate.ReportRun(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.ReportRun {
+	return predicate.ReportRun(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.ReportRun {
+	return predicate.ReportRun(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.ReportRun {
+	return predicate.ReportRun(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.ReportRun {
+	return predicate.ReportRun(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.ReportRun {
+	return predicate.ReportRun(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.ReportRun {
+	return predicate.ReportRun(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.ReportRun {
+	return predicate.ReportRun(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.ReportRun {
+	return predicate.ReportRun(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.ReportRun {
+	return predicate.ReportRun(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.ReportRun {
+	return predicate.ReportRun(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.ReportRun {
+	return predicate.ReportRun(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.ReportRun {
+	return predicate.ReportRun(sql.FieldContainsFold(FieldStatus, v))
+}
+
 // HasDomainReports applies the HasEdge predicate on the "domain_reports" edge.
 func HasDomainReports() predicate.ReportRun {
 	return predicate.ReportRun(func(s *sql.Selector) {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, DomainReportsTable, DomainReportsColumn),
+			sqlgraph.Edge(sqlgraph.M2M, false, DomainReportsTable, DomainReportsPrimaryKey...),
 		)
 		sqlgraph.HasNeighbors(s, step)
 	})
@@ -203,6 +438,29 @@ func HasDomainReportsWith(preds ...predicate.DomainReport) predicate.ReportRun {
 	})
 }
 
+// HasGeneratedDomainReports applies the HasEdge predicate on the "generated_domain_reports" edge.
+func HasGeneratedDomainReports() predicate.ReportRun {
+	return predicate.ReportRun(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, GeneratedDomainReportsTable, GeneratedDomainReportsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasGeneratedDomainReportsWith applies the HasEdge predicate on the "generated_domain_reports" edge with a given conditions (other predicates).
+func HasGeneratedDomainReportsWith(preds ...predicate.DomainReport) predicate.ReportRun {
+	return predicate.ReportRun(func(s *sql.Selector) {
+		step := newGeneratedDomainReportsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasDeepAnalysisResults applies the HasEdge predicate on the "deep_analysis_results" edge.
 func HasDeepAnalysisResults() predicate.ReportRun {
 	return predicate.ReportRun(func(s *sql.Selector) {
@@ -226,6 +484,75 @@ func HasDeepAnalysisResultsWith(preds ...predicate.DeepAnalysisResult) predicate
 	})
 }
 
+// HasLlmCalls applies the HasEdge predicate on the "llm_calls" edge.
+func HasLlmCalls() predicate.ReportRun {
+	return predicate.ReportRun(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, LlmCallsTable, LlmCallsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLlmCallsWith applies the HasEdge predicate on the "llm_calls" edge with a given conditions (other predicates).
+func HasLlmCallsWith(preds ...predicate.LLMCall) predicate.ReportRun {
+	return predicate.ReportRun(func(s *sql.Selector) {
+		step := newLlmCallsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDomainRuns applies the HasEdge predicate on the "domain_runs" edge.
+func HasDomainRuns() predicate.ReportRun {
+	return predicate.ReportRun(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, DomainRunsTable, DomainRunsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDomainRunsWith applies the HasEdge predicate on the "domain_runs" edge with a given conditions (other predicates).
+func HasDomainRunsWith(preds ...predicate.DomainRun) predicate.ReportRun {
+	return predicate.ReportRun(func(s *sql.Selector) {
+		step := newDomainRunsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasEvents applies the HasEdge predicate on the "events" edge.
+func HasEvents() predicate.ReportRun {
+	return predicate.ReportRun(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, EventsTable, EventsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEventsWith applies the HasEdge predicate on the "events" edge with a given conditions (other predicates).
+func HasEventsWith(preds ...predicate.ReportRunEvent) predicate.ReportRun {
+	return predicate.ReportRun(func(s *sql.Selector) {
+		step := newEventsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.ReportRun) predicate.ReportRun {
 	return predicate.ReportRun(sql.AndPredicates(predicates...))
@@ -8,11 +8,15 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/iWorld-y/domain_radar/app/common/ent/deepanalysisresult"
 	"github.com/iWorld-y/domain_radar/app/common/ent/domainreport"
+	"github.com/iWorld-y/domain_radar/app/common/ent/domainrun"
+	"github.com/iWorld-y/domain_radar/app/common/ent/llmcall"
 	"github.com/iWorld-y/domain_radar/app/common/ent/reportrun"
+	"github.com/iWorld-y/domain_radar/app/common/ent/reportrunevent"
 )
 
 // ReportRunCreate is the builder for creating a ReportRun entity.
@@ -20,6 +24,7 @@ type ReportRunCreate struct {
 	config
 	mutation *ReportRunMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetCreatedAt sets the "created_at" field.
@@ -50,6 +55,62 @@ func (_c *ReportRunCreate) SetNillableTitle(v *string) *ReportRunCreate {
 	return _c
 }
 
+// SetUserID sets the "user_id" field.
+func (_c *ReportRunCreate) SetUserID(v int) *ReportRunCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_c *ReportRunCreate) SetNillableUserID(v *int) *ReportRunCreate {
+	if v != nil {
+		_c.SetUserID(*v)
+	}
+	return _c
+}
+
+// SetWindowStart sets the "window_start" field.
+func (_c *ReportRunCreate) SetWindowStart(v time.Time) *ReportRunCreate {
+	_c.mutation.SetWindowStart(v)
+	return _c
+}
+
+// SetNillableWindowStart sets the "window_start" field if the given value is not nil.
+func (_c *ReportRunCreate) SetNillableWindowStart(v *time.Time) *ReportRunCreate {
+	if v != nil {
+		_c.SetWindowStart(*v)
+	}
+	return _c
+}
+
+// SetWindowEnd sets the "window_end" field.
+func (_c *ReportRunCreate) SetWindowEnd(v time.Time) *ReportRunCreate {
+	_c.mutation.SetWindowEnd(v)
+	return _c
+}
+
+// SetNillableWindowEnd sets the "window_end" field if the given value is not nil.
+func (_c *ReportRunCreate) SetNillableWindowEnd(v *time.Time) *ReportRunCreate {
+	if v != nil {
+		_c.SetWindowEnd(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *ReportRunCreate) SetStatus(v string) *ReportRunCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *ReportRunCreate) SetNillableStatus(v *string) *ReportRunCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *ReportRunCreate) SetID(v int) *ReportRunCreate {
 	_c.mutation.SetID(v)
@@ -71,6 +132,21 @@ func (_c *ReportRunCreate) AddDomainReports(v ...*DomainReport) *ReportRunCreate
 	return _c.AddDomainReportIDs(ids...)
 }
 
+// AddGeneratedDomainReportIDs adds the "generated_domain_reports" edge to the DomainReport entity by IDs.
+func (_c *ReportRunCreate) AddGeneratedDomainReportIDs(ids ...int) *ReportRunCreate {
+	_c.mutation.AddGeneratedDomainReportIDs(ids...)
+	return _c
+}
+
+// AddGeneratedDomainReports adds the "generated_domain_reports" edges to the DomainReport entity.
+func (_c *ReportRunCreate) AddGeneratedDomainReports(v ...*DomainReport) *ReportRunCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddGeneratedDomainReportIDs(ids...)
+}
+
 // AddDeepAnalysisResultIDs adds the "deep_analysis_results" edge to the DeepAnalysisResult entity by IDs.
 func (_c *ReportRunCreate) AddDeepAnalysisResultIDs(ids ...int) *ReportRunCreate {
 	_c.mutation.AddDeepAnalysisResultIDs(ids...)
@@ -86,6 +162,51 @@ func (_c *ReportRunCreate) AddDeepAnalysisResults(v ...*DeepAnalysisResult) *Rep
 	return _c.AddDeepAnalysisResultIDs(ids...)
 }
 
+// AddLlmCallIDs adds the "llm_calls" edge to the LLMCall entity by IDs.
+func (_c *ReportRunCreate) AddLlmCallIDs(ids ...int) *ReportRunCreate {
+	_c.mutation.AddLlmCallIDs(ids...)
+	return _c
+}
+
+// AddLlmCalls adds the "llm_calls" edges to the LLMCall entity.
+func (_c *ReportRunCreate) AddLlmCalls(v ...*LLMCall) *ReportRunCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddLlmCallIDs(ids...)
+}
+
+// AddDomainRunIDs adds the "domain_runs" edge to the DomainRun entity by IDs.
+func (_c *ReportRunCreate) AddDomainRunIDs(ids ...int) *ReportRunCreate {
+	_c.mutation.AddDomainRunIDs(ids...)
+	return _c
+}
+
+// AddDomainRuns adds the "domain_runs" edges to the DomainRun entity.
+func (_c *ReportRunCreate) AddDomainRuns(v ...*DomainRun) *ReportRunCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddDomainRunIDs(ids...)
+}
+
+// AddEventIDs adds the "events" edge to the ReportRunEvent entity by IDs.
+func (_c *ReportRunCreate) AddEventIDs(ids ...int) *ReportRunCreate {
+	_c.mutation.AddEventIDs(ids...)
+	return _c
+}
+
+// AddEvents adds the "events" edges to the ReportRunEvent entity.
+func (_c *ReportRunCreate) AddEvents(v ...*ReportRunEvent) *ReportRunCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddEventIDs(ids...)
+}
+
 // Mutation returns the ReportRunMutation object of the builder.
 func (_c *ReportRunCreate) Mutation() *ReportRunMutation {
 	return _c.mutation
@@ -129,6 +250,10 @@ func (_c *ReportRunCreate) defaults() {
 		v := reportrun.DefaultTitle
 		_c.mutation.SetTitle(v)
 	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := reportrun.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -136,6 +261,9 @@ func (_c *ReportRunCreate) check() error {
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ReportRun.created_at"`)}
 	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ReportRun.status"`)}
+	}
 	return nil
 }
 
@@ -164,6 +292,7 @@ func (_c *ReportRunCreate) createSpec() (*ReportRun, *sqlgraph.CreateSpec) {
 		_node = &ReportRun{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(reportrun.Table, sqlgraph.NewFieldSpec(reportrun.FieldID, field.TypeInt))
 	)
+	_spec.OnConflict = _c.conflict
 	if id, ok := _c.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = id
@@ -176,12 +305,44 @@ func (_c *ReportRunCreate) createSpec() (*ReportRun, *sqlgraph.CreateSpec) {
 		_spec.SetField(reportrun.FieldTitle, field.TypeString, value)
 		_node.Title = value
 	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(reportrun.FieldUserID, field.TypeInt, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.WindowStart(); ok {
+		_spec.SetField(reportrun.FieldWindowStart, field.TypeTime, value)
+		_node.WindowStart = &value
+	}
+	if value, ok := _c.mutation.WindowEnd(); ok {
+		_spec.SetField(reportrun.FieldWindowEnd, field.TypeTime, value)
+		_node.WindowEnd = &value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(reportrun.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
 	if nodes := _c.mutation.DomainReportsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2M,
 			Inverse: false,
 			Table:   reportrun.DomainReportsTable,
-			Columns: []string{reportrun.DomainReportsColumn},
+			Columns: reportrun.DomainReportsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(domainreport.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.GeneratedDomainReportsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reportrun.GeneratedDomainReportsTable,
+			Columns: []string{reportrun.GeneratedDomainReportsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(domainreport.FieldID, field.TypeInt),
@@ -208,14 +369,414 @@ func (_c *ReportRunCreate) createSpec() (*ReportRun, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.LlmCallsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reportrun.LlmCallsTable,
+			Columns: []string{reportrun.LlmCallsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(llmcall.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.DomainRunsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reportrun.DomainRunsTable,
+			Columns: []string{reportrun.DomainRunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(domainrun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.EventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reportrun.EventsTable,
+			Columns: []string{reportrun.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reportrunevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ReportRun.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReportRunUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ReportRunCreate) OnConflict(opts ...sql.ConflictOption) *ReportRunUpsertOne {
+	_c.conflict = opts
+	return &ReportRunUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ReportRun.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ReportRunCreate) OnConflictColumns(columns ...string) *ReportRunUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ReportRunUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ReportRunUpsertOne is the builder for "upsert"-ing
+	//  one ReportRun node.
+	ReportRunUpsertOne struct {
+		create *ReportRunCreate
+	}
+
+	// ReportRunUpsert is the "OnConflict" setter.
+	ReportRunUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ReportRunUpsert) SetCreatedAt(v time.Time) *ReportRunUpsert {
+	u.Set(reportrun.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ReportRunUpsert) UpdateCreatedAt() *ReportRunUpsert {
+	u.SetExcluded(reportrun.FieldCreatedAt)
+	return u
+}
+
+// SetTitle sets the "title" field.
+func (u *ReportRunUpsert) SetTitle(v string) *ReportRunUpsert {
+	u.Set(reportrun.FieldTitle, v)
+	return u
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *ReportRunUpsert) UpdateTitle() *ReportRunUpsert {
+	u.SetExcluded(reportrun.FieldTitle)
+	return u
+}
+
+// ClearTitle clears the value of the "title" field.
+func (u *ReportRunUpsert) ClearTitle() *ReportRunUpsert {
+	u.SetNull(reportrun.FieldTitle)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *ReportRunUpsert) SetUserID(v int) *ReportRunUpsert {
+	u.Set(reportrun.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *ReportRunUpsert) UpdateUserID() *ReportRunUpsert {
+	u.SetExcluded(reportrun.FieldUserID)
+	return u
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *ReportRunUpsert) AddUserID(v int) *ReportRunUpsert {
+	u.Add(reportrun.FieldUserID, v)
+	return u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (u *ReportRunUpsert) ClearUserID() *ReportRunUpsert {
+	u.SetNull(reportrun.FieldUserID)
+	return u
+}
+
+// SetWindowStart sets the "window_start" field.
+func (u *ReportRunUpsert) SetWindowStart(v time.Time) *ReportRunUpsert {
+	u.Set(reportrun.FieldWindowStart, v)
+	return u
+}
+
+// UpdateWindowStart sets the "window_start" field to the value that was provided on create.
+func (u *ReportRunUpsert) UpdateWindowStart() *ReportRunUpsert {
+	u.SetExcluded(reportrun.FieldWindowStart)
+	return u
+}
+
+// ClearWindowStart clears the value of the "window_start" field.
+func (u *ReportRunUpsert) ClearWindowStart() *ReportRunUpsert {
+	u.SetNull(reportrun.FieldWindowStart)
+	return u
+}
+
+// SetWindowEnd sets the "window_end" field.
+func (u *ReportRunUpsert) SetWindowEnd(v time.Time) *ReportRunUpsert {
+	u.Set(reportrun.FieldWindowEnd, v)
+	return u
+}
+
+// UpdateWindowEnd sets the "window_end" field to the value that was provided on create.
+func (u *ReportRunUpsert) UpdateWindowEnd() *ReportRunUpsert {
+	u.SetExcluded(reportrun.FieldWindowEnd)
+	return u
+}
+
+// ClearWindowEnd clears the value of the "window_end" field.
+func (u *ReportRunUpsert) ClearWindowEnd() *ReportRunUpsert {
+	u.SetNull(reportrun.FieldWindowEnd)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *ReportRunUpsert) SetStatus(v string) *ReportRunUpsert {
+	u.Set(reportrun.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ReportRunUpsert) UpdateStatus() *ReportRunUpsert {
+	u.SetExcluded(reportrun.FieldStatus)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ReportRun.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(reportrun.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ReportRunUpsertOne) UpdateNewValues() *ReportRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(reportrun.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ReportRun.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ReportRunUpsertOne) Ignore() *ReportRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReportRunUpsertOne) DoNothing() *ReportRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReportRunCreate.OnConflict
+// documentation for more info.
+func (u *ReportRunUpsertOne) Update(set func(*ReportRunUpsert)) *ReportRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReportRunUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ReportRunUpsertOne) SetCreatedAt(v time.Time) *ReportRunUpsertOne {
+	return u.Update(func(s *ReportRunUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ReportRunUpsertOne) UpdateCreatedAt() *ReportRunUpsertOne {
+	return u.Update(func(s *ReportRunUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *ReportRunUpsertOne) SetTitle(v string) *ReportRunUpsertOne {
+	return u.Update(func(s *ReportRunUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *ReportRunUpsertOne) UpdateTitle() *ReportRunUpsertOne {
+	return u.Update(func(s *ReportRunUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// ClearTitle clears the value of the "title" field.
+func (u *ReportRunUpsertOne) ClearTitle() *ReportRunUpsertOne {
+	return u.Update(func(s *ReportRunUpsert) {
+		s.ClearTitle()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *ReportRunUpsertOne) SetUserID(v int) *ReportRunUpsertOne {
+	return u.Update(func(s *ReportRunUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *ReportRunUpsertOne) AddUserID(v int) *ReportRunUpsertOne {
+	return u.Update(func(s *ReportRunUpsert) {
+		s.AddUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *ReportRunUpsertOne) UpdateUserID() *ReportRunUpsertOne {
+	return u.Update(func(s *ReportRunUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (u *ReportRunUpsertOne) ClearUserID() *ReportRunUpsertOne {
+	return u.Update(func(s *ReportRunUpsert) {
+		s.ClearUserID()
+	})
+}
+
+// SetWindowStart sets the "window_start" field.
+func (u *ReportRunUpsertOne) SetWindowStart(v time.Time) *ReportRunUpsertOne {
+	return u.Update(func(s *ReportRunUpsert) {
+		s.SetWindowStart(v)
+	})
+}
+
+// UpdateWindowStart sets the "window_start" field to the value that was provided on create.
+func (u *ReportRunUpsertOne) UpdateWindowStart() *ReportRunUpsertOne {
+	return u.Update(func(s *ReportRunUpsert) {
+		s.UpdateWindowStart()
+	})
+}
+
+// ClearWindowStart clears the value of the "window_start" field.
+func (u *ReportRunUpsertOne) ClearWindowStart() *ReportRunUpsertOne {
+	return u.Update(func(s *ReportRunUpsert) {
+		s.ClearWindowStart()
+	})
+}
+
+// SetWindowEnd sets the "window_end" field.
+func (u *ReportRunUpsertOne) SetWindowEnd(v time.Time) *ReportRunUpsertOne {
+	return u.Update(func(s *ReportRunUpsert) {
+		s.SetWindowEnd(v)
+	})
+}
+
+// UpdateWindowEnd sets the "window_end" field to the value that was provided on create.
+func (u *ReportRunUpsertOne) UpdateWindowEnd() *ReportRunUpsertOne {
+	return u.Update(func(s *ReportRunUpsert) {
+		s.UpdateWindowEnd()
+	})
+}
+
+// ClearWindowEnd clears the value of the "window_end" field.
+func (u *ReportRunUpsertOne) ClearWindowEnd() *ReportRunUpsertOne {
+	return u.Update(func(s *ReportRunUpsert) {
+		s.ClearWindowEnd()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ReportRunUpsertOne) SetStatus(v string) *ReportRunUpsertOne {
+	return u.Update(func(s *ReportRunUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ReportRunUpsertOne) UpdateStatus() *ReportRunUpsertOne {
+	return u.Update(func(s *ReportRunUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// Exec executes the query.
+func (u *ReportRunUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReportRunCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReportRunUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ReportRunUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ReportRunUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // ReportRunCreateBulk is the builder for creating many ReportRun entities in bulk.
 type ReportRunCreateBulk struct {
 	config
 	err      error
 	builders []*ReportRunCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the ReportRun entities in the database.
@@ -245,6 +806,7 @@ func (_c *ReportRunCreateBulk) Save(ctx context.Context) ([]*ReportRun, error) {
 					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -298,3 +860,236 @@ func (_c *ReportRunCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ReportRun.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReportRunUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ReportRunCreateBulk) OnConflict(opts ...sql.ConflictOption) *ReportRunUpsertBulk {
+	_c.conflict = opts
+	return &ReportRunUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ReportRun.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ReportRunCreateBulk) OnConflictColumns(columns ...string) *ReportRunUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ReportRunUpsertBulk{
+		create: _c,
+	}
+}
+
+// ReportRunUpsertBulk is the builder for "upsert"-ing
+// a bulk of ReportRun nodes.
+type ReportRunUpsertBulk struct {
+	create *ReportRunCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ReportRun.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(reportrun.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ReportRunUpsertBulk) UpdateNewValues() *ReportRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(reportrun.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ReportRun.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ReportRunUpsertBulk) Ignore() *ReportRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReportRunUpsertBulk) DoNothing() *ReportRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReportRunCreateBulk.OnConflict
+// documentation for more info.
+func (u *ReportRunUpsertBulk) Update(set func(*ReportRunUpsert)) *ReportRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReportRunUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ReportRunUpsertBulk) SetCreatedAt(v time.Time) *ReportRunUpsertBulk {
+	return u.Update(func(s *ReportRunUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ReportRunUpsertBulk) UpdateCreatedAt() *ReportRunUpsertBulk {
+	return u.Update(func(s *ReportRunUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *ReportRunUpsertBulk) SetTitle(v string) *ReportRunUpsertBulk {
+	return u.Update(func(s *ReportRunUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *ReportRunUpsertBulk) UpdateTitle() *ReportRunUpsertBulk {
+	return u.Update(func(s *ReportRunUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// ClearTitle clears the value of the "title" field.
+func (u *ReportRunUpsertBulk) ClearTitle() *ReportRunUpsertBulk {
+	return u.Update(func(s *ReportRunUpsert) {
+		s.ClearTitle()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *ReportRunUpsertBulk) SetUserID(v int) *ReportRunUpsertBulk {
+	return u.Update(func(s *ReportRunUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *ReportRunUpsertBulk) AddUserID(v int) *ReportRunUpsertBulk {
+	return u.Update(func(s *ReportRunUpsert) {
+		s.AddUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *ReportRunUpsertBulk) UpdateUserID() *ReportRunUpsertBulk {
+	return u.Update(func(s *ReportRunUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (u *ReportRunUpsertBulk) ClearUserID() *ReportRunUpsertBulk {
+	return u.Update(func(s *ReportRunUpsert) {
+		s.ClearUserID()
+	})
+}
+
+// SetWindowStart sets the "window_start" field.
+func (u *ReportRunUpsertBulk) SetWindowStart(v time.Time) *ReportRunUpsertBulk {
+	return u.Update(func(s *ReportRunUpsert) {
+		s.SetWindowStart(v)
+	})
+}
+
+// UpdateWindowStart sets the "window_start" field to the value that was provided on create.
+func (u *ReportRunUpsertBulk) UpdateWindowStart() *ReportRunUpsertBulk {
+	return u.Update(func(s *ReportRunUpsert) {
+		s.UpdateWindowStart()
+	})
+}
+
+// ClearWindowStart clears the value of the "window_start" field.
+func (u *ReportRunUpsertBulk) ClearWindowStart() *ReportRunUpsertBulk {
+	return u.Update(func(s *ReportRunUpsert) {
+		s.ClearWindowStart()
+	})
+}
+
+// SetWindowEnd sets the "window_end" field.
+func (u *ReportRunUpsertBulk) SetWindowEnd(v time.Time) *ReportRunUpsertBulk {
+	return u.Update(func(s *ReportRunUpsert) {
+		s.SetWindowEnd(v)
+	})
+}
+
+// UpdateWindowEnd sets the "window_end" field to the value that was provided on create.
+func (u *ReportRunUpsertBulk) UpdateWindowEnd() *ReportRunUpsertBulk {
+	return u.Update(func(s *ReportRunUpsert) {
+		s.UpdateWindowEnd()
+	})
+}
+
+// ClearWindowEnd clears the value of the "window_end" field.
+func (u *ReportRunUpsertBulk) ClearWindowEnd() *ReportRunUpsertBulk {
+	return u.Update(func(s *ReportRunUpsert) {
+		s.ClearWindowEnd()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ReportRunUpsertBulk) SetStatus(v string) *ReportRunUpsertBulk {
+	return u.Update(func(s *ReportRunUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ReportRunUpsertBulk) UpdateStatus() *ReportRunUpsertBulk {
+	return u.Update(func(s *ReportRunUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// Exec executes the query.
+func (u *ReportRunUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ReportRunCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReportRunCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReportRunUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
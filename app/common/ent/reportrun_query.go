@@ -14,20 +14,27 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/iWorld-y/domain_radar/app/common/ent/deepanalysisresult"
 	"github.com/iWorld-y/domain_radar/app/common/ent/domainreport"
+	"github.com/iWorld-y/domain_radar/app/common/ent/domainrun"
+	"github.com/iWorld-y/domain_radar/app/common/ent/llmcall"
 	"github.com/iWorld-y/domain_radar/app/common/ent/predicate"
 	"github.com/iWorld-y/domain_radar/app/common/ent/reportrun"
+	"github.com/iWorld-y/domain_radar/app/common/ent/reportrunevent"
 )
 
 // ReportRunQuery is the builder for querying ReportRun entities.
 type ReportRunQuery struct {
 	config
-	ctx                     *QueryContext
-	order                   []reportrun.OrderOption
-	inters                  []Interceptor
-	predicates              []predicate.ReportRun
-	withDomainReports       *DomainReportQuery
-	withDeepAnalysisResults *DeepAnalysisResultQuery
-	modifiers               []func(*sql.Selector)
+	ctx                        *QueryContext
+	order                      []reportrun.OrderOption
+	inters                     []Interceptor
+	predicates                 []predicate.ReportRun
+	withDomainReports          *DomainReportQuery
+	withGeneratedDomainReports *DomainReportQuery
+	withDeepAnalysisResults    *DeepAnalysisResultQuery
+	withLlmCalls               *LLMCallQuery
+	withDomainRuns             *DomainRunQuery
+	withEvents                 *ReportRunEventQuery
+	modifiers                  []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -78,7 +85,29 @@ func (_q *ReportRunQuery) QueryDomainReports() *DomainReportQuery {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(reportrun.Table, reportrun.FieldID, selector),
 			sqlgraph.To(domainreport.Table, domainreport.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, reportrun.DomainReportsTable, reportrun.DomainReportsColumn),
+			sqlgraph.Edge(sqlgraph.M2M, false, reportrun.DomainReportsTable, reportrun.DomainReportsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryGeneratedDomainReports chains the current query on the "generated_domain_reports" edge.
+func (_q *ReportRunQuery) QueryGeneratedDomainReports() *DomainReportQuery {
+	query := (&DomainReportClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(reportrun.Table, reportrun.FieldID, selector),
+			sqlgraph.To(domainreport.Table, domainreport.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, reportrun.GeneratedDomainReportsTable, reportrun.GeneratedDomainReportsColumn),
 		)
 		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
 		return fromU, nil
@@ -108,6 +137,72 @@ func (_q *ReportRunQuery) QueryDeepAnalysisResults() *DeepAnalysisResultQuery {
 	return query
 }
 
+// QueryLlmCalls chains the current query on the "llm_calls" edge.
+func (_q *ReportRunQuery) QueryLlmCalls() *LLMCallQuery {
+	query := (&LLMCallClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(reportrun.Table, reportrun.FieldID, selector),
+			sqlgraph.To(llmcall.Table, llmcall.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, reportrun.LlmCallsTable, reportrun.LlmCallsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryDomainRuns chains the current query on the "domain_runs" edge.
+func (_q *ReportRunQuery) QueryDomainRuns() *DomainRunQuery {
+	query := (&DomainRunClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(reportrun.Table, reportrun.FieldID, selector),
+			sqlgraph.To(domainrun.Table, domainrun.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, reportrun.DomainRunsTable, reportrun.DomainRunsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryEvents chains the current query on the "events" edge.
+func (_q *ReportRunQuery) QueryEvents() *ReportRunEventQuery {
+	query := (&ReportRunEventClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(reportrun.Table, reportrun.FieldID, selector),
+			sqlgraph.To(reportrunevent.Table, reportrunevent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, reportrun.EventsTable, reportrun.EventsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first ReportRun entity from the query.
 // Returns a *NotFoundError when no ReportRun was found.
 func (_q *ReportRunQuery) First(ctx context.Context) (*ReportRun, error) {
@@ -295,13 +390,17 @@ func (_q *ReportRunQuery) Clone() *ReportRunQuery {
 		return nil
 	}
 	return &ReportRunQuery{
-		config:                  _q.config,
-		ctx:                     _q.ctx.Clone(),
-		order:                   append([]reportrun.OrderOption{}, _q.order...),
-		inters:                  append([]Interceptor{}, _q.inters...),
-		predicates:              append([]predicate.ReportRun{}, _q.predicates...),
-		withDomainReports:       _q.withDomainReports.Clone(),
-		withDeepAnalysisResults: _q.withDeepAnalysisResults.Clone(),
+		config:                     _q.config,
+		ctx:                        _q.ctx.Clone(),
+		order:                      append([]reportrun.OrderOption{}, _q.order...),
+		inters:                     append([]Interceptor{}, _q.inters...),
+		predicates:                 append([]predicate.ReportRun{}, _q.predicates...),
+		withDomainReports:          _q.withDomainReports.Clone(),
+		withGeneratedDomainReports: _q.withGeneratedDomainReports.Clone(),
+		withDeepAnalysisResults:    _q.withDeepAnalysisResults.Clone(),
+		withLlmCalls:               _q.withLlmCalls.Clone(),
+		withDomainRuns:             _q.withDomainRuns.Clone(),
+		withEvents:                 _q.withEvents.Clone(),
 		// clone intermediate query.
 		sql:       _q.sql.Clone(),
 		path:      _q.path,
@@ -320,6 +419,17 @@ func (_q *ReportRunQuery) WithDomainReports(opts ...func(*DomainReportQuery)) *R
 	return _q
 }
 
+// WithGeneratedDomainReports tells the query-builder to eager-load the nodes that are connected to
+// the "generated_domain_reports" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ReportRunQuery) WithGeneratedDomainReports(opts ...func(*DomainReportQuery)) *ReportRunQuery {
+	query := (&DomainReportClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withGeneratedDomainReports = query
+	return _q
+}
+
 // WithDeepAnalysisResults tells the query-builder to eager-load the nodes that are connected to
 // the "deep_analysis_results" edge. The optional arguments are used to configure the query builder of the edge.
 func (_q *ReportRunQuery) WithDeepAnalysisResults(opts ...func(*DeepAnalysisResultQuery)) *ReportRunQuery {
@@ -331,6 +441,39 @@ func (_q *ReportRunQuery) WithDeepAnalysisResults(opts ...func(*DeepAnalysisResu
 	return _q
 }
 
+// WithLlmCalls tells the query-builder to eager-load the nodes that are connected to
+// the "llm_calls" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ReportRunQuery) WithLlmCalls(opts ...func(*LLMCallQuery)) *ReportRunQuery {
+	query := (&LLMCallClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withLlmCalls = query
+	return _q
+}
+
+// WithDomainRuns tells the query-builder to eager-load the nodes that are connected to
+// the "domain_runs" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ReportRunQuery) WithDomainRuns(opts ...func(*DomainRunQuery)) *ReportRunQuery {
+	query := (&DomainRunClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withDomainRuns = query
+	return _q
+}
+
+// WithEvents tells the query-builder to eager-load the nodes that are connected to
+// the "events" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ReportRunQuery) WithEvents(opts ...func(*ReportRunEventQuery)) *ReportRunQuery {
+	query := (&ReportRunEventClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withEvents = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -409,9 +552,13 @@ func (_q *ReportRunQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Re
 	var (
 		nodes       = []*ReportRun{}
 		_spec       = _q.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [6]bool{
 			_q.withDomainReports != nil,
+			_q.withGeneratedDomainReports != nil,
 			_q.withDeepAnalysisResults != nil,
+			_q.withLlmCalls != nil,
+			_q.withDomainRuns != nil,
+			_q.withEvents != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -442,6 +589,15 @@ func (_q *ReportRunQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Re
 			return nil, err
 		}
 	}
+	if query := _q.withGeneratedDomainReports; query != nil {
+		if err := _q.loadGeneratedDomainReports(ctx, query, nodes,
+			func(n *ReportRun) { n.Edges.GeneratedDomainReports = []*DomainReport{} },
+			func(n *ReportRun, e *DomainReport) {
+				n.Edges.GeneratedDomainReports = append(n.Edges.GeneratedDomainReports, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
 	if query := _q.withDeepAnalysisResults; query != nil {
 		if err := _q.loadDeepAnalysisResults(ctx, query, nodes,
 			func(n *ReportRun) { n.Edges.DeepAnalysisResults = []*DeepAnalysisResult{} },
@@ -451,10 +607,92 @@ func (_q *ReportRunQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Re
 			return nil, err
 		}
 	}
+	if query := _q.withLlmCalls; query != nil {
+		if err := _q.loadLlmCalls(ctx, query, nodes,
+			func(n *ReportRun) { n.Edges.LlmCalls = []*LLMCall{} },
+			func(n *ReportRun, e *LLMCall) { n.Edges.LlmCalls = append(n.Edges.LlmCalls, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withDomainRuns; query != nil {
+		if err := _q.loadDomainRuns(ctx, query, nodes,
+			func(n *ReportRun) { n.Edges.DomainRuns = []*DomainRun{} },
+			func(n *ReportRun, e *DomainRun) { n.Edges.DomainRuns = append(n.Edges.DomainRuns, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withEvents; query != nil {
+		if err := _q.loadEvents(ctx, query, nodes,
+			func(n *ReportRun) { n.Edges.Events = []*ReportRunEvent{} },
+			func(n *ReportRun, e *ReportRunEvent) { n.Edges.Events = append(n.Edges.Events, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
 func (_q *ReportRunQuery) loadDomainReports(ctx context.Context, query *DomainReportQuery, nodes []*ReportRun, init func(*ReportRun), assign func(*ReportRun, *DomainReport)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[int]*ReportRun)
+	nids := make(map[int]map[*ReportRun]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(reportrun.DomainReportsTable)
+		s.Join(joinT).On(s.C(domainreport.FieldID), joinT.C(reportrun.DomainReportsPrimaryKey[1]))
+		s.Where(sql.InValues(joinT.C(reportrun.DomainReportsPrimaryKey[0]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(reportrun.DomainReportsPrimaryKey[0]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := int(values[0].(*sql.NullInt64).Int64)
+				inValue := int(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*ReportRun]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*DomainReport](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "domain_reports" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (_q *ReportRunQuery) loadGeneratedDomainReports(ctx context.Context, query *DomainReportQuery, nodes []*ReportRun, init func(*ReportRun), assign func(*ReportRun, *DomainReport)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[int]*ReportRun)
 	for i := range nodes {
@@ -468,7 +706,7 @@ func (_q *ReportRunQuery) loadDomainReports(ctx context.Context, query *DomainRe
 		query.ctx.AppendFieldOnce(domainreport.FieldRunID)
 	}
 	query.Where(predicate.DomainReport(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(reportrun.DomainReportsColumn), fks...))
+		s.Where(sql.InValues(s.C(reportrun.GeneratedDomainReportsColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
@@ -514,6 +752,96 @@ func (_q *ReportRunQuery) loadDeepAnalysisResults(ctx context.Context, query *De
 	}
 	return nil
 }
+func (_q *ReportRunQuery) loadLlmCalls(ctx context.Context, query *LLMCallQuery, nodes []*ReportRun, init func(*ReportRun), assign func(*ReportRun, *LLMCall)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*ReportRun)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(llmcall.FieldRunID)
+	}
+	query.Where(predicate.LLMCall(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(reportrun.LlmCallsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.RunID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "run_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *ReportRunQuery) loadDomainRuns(ctx context.Context, query *DomainRunQuery, nodes []*ReportRun, init func(*ReportRun), assign func(*ReportRun, *DomainRun)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*ReportRun)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(domainrun.FieldRunID)
+	}
+	query.Where(predicate.DomainRun(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(reportrun.DomainRunsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.RunID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "run_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *ReportRunQuery) loadEvents(ctx context.Context, query *ReportRunEventQuery, nodes []*ReportRun, init func(*ReportRun), assign func(*ReportRun, *ReportRunEvent)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*ReportRun)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(reportrunevent.FieldRunID)
+	}
+	query.Where(predicate.ReportRunEvent(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(reportrun.EventsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.RunID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "run_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *ReportRunQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -13,8 +13,11 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/iWorld-y/domain_radar/app/common/ent/deepanalysisresult"
 	"github.com/iWorld-y/domain_radar/app/common/ent/domainreport"
+	"github.com/iWorld-y/domain_radar/app/common/ent/domainrun"
+	"github.com/iWorld-y/domain_radar/app/common/ent/llmcall"
 	"github.com/iWorld-y/domain_radar/app/common/ent/predicate"
 	"github.com/iWorld-y/domain_radar/app/common/ent/reportrun"
+	"github.com/iWorld-y/domain_radar/app/common/ent/reportrunevent"
 )
 
 // ReportRunUpdate is the builder for updating ReportRun entities.
@@ -65,6 +68,87 @@ func (_u *ReportRunUpdate) ClearTitle() *ReportRunUpdate {
 	return _u
 }
 
+// SetUserID sets the "user_id" field.
+func (_u *ReportRunUpdate) SetUserID(v int) *ReportRunUpdate {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *ReportRunUpdate) SetNillableUserID(v *int) *ReportRunUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *ReportRunUpdate) AddUserID(v int) *ReportRunUpdate {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *ReportRunUpdate) ClearUserID() *ReportRunUpdate {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetWindowStart sets the "window_start" field.
+func (_u *ReportRunUpdate) SetWindowStart(v time.Time) *ReportRunUpdate {
+	_u.mutation.SetWindowStart(v)
+	return _u
+}
+
+// SetNillableWindowStart sets the "window_start" field if the given value is not nil.
+func (_u *ReportRunUpdate) SetNillableWindowStart(v *time.Time) *ReportRunUpdate {
+	if v != nil {
+		_u.SetWindowStart(*v)
+	}
+	return _u
+}
+
+// ClearWindowStart clears the value of the "window_start" field.
+func (_u *ReportRunUpdate) ClearWindowStart() *ReportRunUpdate {
+	_u.mutation.ClearWindowStart()
+	return _u
+}
+
+// SetWindowEnd sets the "window_end" field.
+func (_u *ReportRunUpdate) SetWindowEnd(v time.Time) *ReportRunUpdate {
+	_u.mutation.SetWindowEnd(v)
+	return _u
+}
+
+// SetNillableWindowEnd sets the "window_end" field if the given value is not nil.
+func (_u *ReportRunUpdate) SetNillableWindowEnd(v *time.Time) *ReportRunUpdate {
+	if v != nil {
+		_u.SetWindowEnd(*v)
+	}
+	return _u
+}
+
+// ClearWindowEnd clears the value of the "window_end" field.
+func (_u *ReportRunUpdate) ClearWindowEnd() *ReportRunUpdate {
+	_u.mutation.ClearWindowEnd()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ReportRunUpdate) SetStatus(v string) *ReportRunUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ReportRunUpdate) SetNillableStatus(v *string) *ReportRunUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
 // AddDomainReportIDs adds the "domain_reports" edge to the DomainReport entity by IDs.
 func (_u *ReportRunUpdate) AddDomainReportIDs(ids ...int) *ReportRunUpdate {
 	_u.mutation.AddDomainReportIDs(ids...)
@@ -80,6 +164,21 @@ func (_u *ReportRunUpdate) AddDomainReports(v ...*DomainReport) *ReportRunUpdate
 	return _u.AddDomainReportIDs(ids...)
 }
 
+// AddGeneratedDomainReportIDs adds the "generated_domain_reports" edge to the DomainReport entity by IDs.
+func (_u *ReportRunUpdate) AddGeneratedDomainReportIDs(ids ...int) *ReportRunUpdate {
+	_u.mutation.AddGeneratedDomainReportIDs(ids...)
+	return _u
+}
+
+// AddGeneratedDomainReports adds the "generated_domain_reports" edges to the DomainReport entity.
+func (_u *ReportRunUpdate) AddGeneratedDomainReports(v ...*DomainReport) *ReportRunUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddGeneratedDomainReportIDs(ids...)
+}
+
 // AddDeepAnalysisResultIDs adds the "deep_analysis_results" edge to the DeepAnalysisResult entity by IDs.
 func (_u *ReportRunUpdate) AddDeepAnalysisResultIDs(ids ...int) *ReportRunUpdate {
 	_u.mutation.AddDeepAnalysisResultIDs(ids...)
@@ -95,6 +194,51 @@ func (_u *ReportRunUpdate) AddDeepAnalysisResults(v ...*DeepAnalysisResult) *Rep
 	return _u.AddDeepAnalysisResultIDs(ids...)
 }
 
+// AddLlmCallIDs adds the "llm_calls" edge to the LLMCall entity by IDs.
+func (_u *ReportRunUpdate) AddLlmCallIDs(ids ...int) *ReportRunUpdate {
+	_u.mutation.AddLlmCallIDs(ids...)
+	return _u
+}
+
+// AddLlmCalls adds the "llm_calls" edges to the LLMCall entity.
+func (_u *ReportRunUpdate) AddLlmCalls(v ...*LLMCall) *ReportRunUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLlmCallIDs(ids...)
+}
+
+// AddDomainRunIDs adds the "domain_runs" edge to the DomainRun entity by IDs.
+func (_u *ReportRunUpdate) AddDomainRunIDs(ids ...int) *ReportRunUpdate {
+	_u.mutation.AddDomainRunIDs(ids...)
+	return _u
+}
+
+// AddDomainRuns adds the "domain_runs" edges to the DomainRun entity.
+func (_u *ReportRunUpdate) AddDomainRuns(v ...*DomainRun) *ReportRunUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDomainRunIDs(ids...)
+}
+
+// AddEventIDs adds the "events" edge to the ReportRunEvent entity by IDs.
+func (_u *ReportRunUpdate) AddEventIDs(ids ...int) *ReportRunUpdate {
+	_u.mutation.AddEventIDs(ids...)
+	return _u
+}
+
+// AddEvents adds the "events" edges to the ReportRunEvent entity.
+func (_u *ReportRunUpdate) AddEvents(v ...*ReportRunEvent) *ReportRunUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddEventIDs(ids...)
+}
+
 // Mutation returns the ReportRunMutation object of the builder.
 func (_u *ReportRunUpdate) Mutation() *ReportRunMutation {
 	return _u.mutation
@@ -121,6 +265,27 @@ func (_u *ReportRunUpdate) RemoveDomainReports(v ...*DomainReport) *ReportRunUpd
 	return _u.RemoveDomainReportIDs(ids...)
 }
 
+// ClearGeneratedDomainReports clears all "generated_domain_reports" edges to the DomainReport entity.
+func (_u *ReportRunUpdate) ClearGeneratedDomainReports() *ReportRunUpdate {
+	_u.mutation.ClearGeneratedDomainReports()
+	return _u
+}
+
+// RemoveGeneratedDomainReportIDs removes the "generated_domain_reports" edge to DomainReport entities by IDs.
+func (_u *ReportRunUpdate) RemoveGeneratedDomainReportIDs(ids ...int) *ReportRunUpdate {
+	_u.mutation.RemoveGeneratedDomainReportIDs(ids...)
+	return _u
+}
+
+// RemoveGeneratedDomainReports removes "generated_domain_reports" edges to DomainReport entities.
+func (_u *ReportRunUpdate) RemoveGeneratedDomainReports(v ...*DomainReport) *ReportRunUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveGeneratedDomainReportIDs(ids...)
+}
+
 // ClearDeepAnalysisResults clears all "deep_analysis_results" edges to the DeepAnalysisResult entity.
 func (_u *ReportRunUpdate) ClearDeepAnalysisResults() *ReportRunUpdate {
 	_u.mutation.ClearDeepAnalysisResults()
@@ -142,6 +307,69 @@ func (_u *ReportRunUpdate) RemoveDeepAnalysisResults(v ...*DeepAnalysisResult) *
 	return _u.RemoveDeepAnalysisResultIDs(ids...)
 }
 
+// ClearLlmCalls clears all "llm_calls" edges to the LLMCall entity.
+func (_u *ReportRunUpdate) ClearLlmCalls() *ReportRunUpdate {
+	_u.mutation.ClearLlmCalls()
+	return _u
+}
+
+// RemoveLlmCallIDs removes the "llm_calls" edge to LLMCall entities by IDs.
+func (_u *ReportRunUpdate) RemoveLlmCallIDs(ids ...int) *ReportRunUpdate {
+	_u.mutation.RemoveLlmCallIDs(ids...)
+	return _u
+}
+
+// RemoveLlmCalls removes "llm_calls" edges to LLMCall entities.
+func (_u *ReportRunUpdate) RemoveLlmCalls(v ...*LLMCall) *ReportRunUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLlmCallIDs(ids...)
+}
+
+// ClearDomainRuns clears all "domain_runs" edges to the DomainRun entity.
+func (_u *ReportRunUpdate) ClearDomainRuns() *ReportRunUpdate {
+	_u.mutation.ClearDomainRuns()
+	return _u
+}
+
+// RemoveDomainRunIDs removes the "domain_runs" edge to DomainRun entities by IDs.
+func (_u *ReportRunUpdate) RemoveDomainRunIDs(ids ...int) *ReportRunUpdate {
+	_u.mutation.RemoveDomainRunIDs(ids...)
+	return _u
+}
+
+// RemoveDomainRuns removes "domain_runs" edges to DomainRun entities.
+func (_u *ReportRunUpdate) RemoveDomainRuns(v ...*DomainRun) *ReportRunUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDomainRunIDs(ids...)
+}
+
+// ClearEvents clears all "events" edges to the ReportRunEvent entity.
+func (_u *ReportRunUpdate) ClearEvents() *ReportRunUpdate {
+	_u.mutation.ClearEvents()
+	return _u
+}
+
+// RemoveEventIDs removes the "events" edge to ReportRunEvent entities by IDs.
+func (_u *ReportRunUpdate) RemoveEventIDs(ids ...int) *ReportRunUpdate {
+	_u.mutation.RemoveEventIDs(ids...)
+	return _u
+}
+
+// RemoveEvents removes "events" edges to ReportRunEvent entities.
+func (_u *ReportRunUpdate) RemoveEvents(v ...*ReportRunEvent) *ReportRunUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveEventIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *ReportRunUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -193,12 +421,36 @@ func (_u *ReportRunUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.TitleCleared() {
 		_spec.ClearField(reportrun.FieldTitle, field.TypeString)
 	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(reportrun.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(reportrun.FieldUserID, field.TypeInt, value)
+	}
+	if _u.mutation.UserIDCleared() {
+		_spec.ClearField(reportrun.FieldUserID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.WindowStart(); ok {
+		_spec.SetField(reportrun.FieldWindowStart, field.TypeTime, value)
+	}
+	if _u.mutation.WindowStartCleared() {
+		_spec.ClearField(reportrun.FieldWindowStart, field.TypeTime)
+	}
+	if value, ok := _u.mutation.WindowEnd(); ok {
+		_spec.SetField(reportrun.FieldWindowEnd, field.TypeTime, value)
+	}
+	if _u.mutation.WindowEndCleared() {
+		_spec.ClearField(reportrun.FieldWindowEnd, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(reportrun.FieldStatus, field.TypeString, value)
+	}
 	if _u.mutation.DomainReportsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2M,
 			Inverse: false,
 			Table:   reportrun.DomainReportsTable,
-			Columns: []string{reportrun.DomainReportsColumn},
+			Columns: reportrun.DomainReportsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(domainreport.FieldID, field.TypeInt),
@@ -208,10 +460,10 @@ func (_u *ReportRunUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	}
 	if nodes := _u.mutation.RemovedDomainReportsIDs(); len(nodes) > 0 && !_u.mutation.DomainReportsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2M,
 			Inverse: false,
 			Table:   reportrun.DomainReportsTable,
-			Columns: []string{reportrun.DomainReportsColumn},
+			Columns: reportrun.DomainReportsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(domainreport.FieldID, field.TypeInt),
@@ -224,10 +476,55 @@ func (_u *ReportRunUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	}
 	if nodes := _u.mutation.DomainReportsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2M,
 			Inverse: false,
 			Table:   reportrun.DomainReportsTable,
-			Columns: []string{reportrun.DomainReportsColumn},
+			Columns: reportrun.DomainReportsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(domainreport.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.GeneratedDomainReportsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reportrun.GeneratedDomainReportsTable,
+			Columns: []string{reportrun.GeneratedDomainReportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(domainreport.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedGeneratedDomainReportsIDs(); len(nodes) > 0 && !_u.mutation.GeneratedDomainReportsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reportrun.GeneratedDomainReportsTable,
+			Columns: []string{reportrun.GeneratedDomainReportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(domainreport.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.GeneratedDomainReportsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reportrun.GeneratedDomainReportsTable,
+			Columns: []string{reportrun.GeneratedDomainReportsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(domainreport.FieldID, field.TypeInt),
@@ -283,6 +580,141 @@ func (_u *ReportRunUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.LlmCallsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reportrun.LlmCallsTable,
+			Columns: []string{reportrun.LlmCallsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(llmcall.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLlmCallsIDs(); len(nodes) > 0 && !_u.mutation.LlmCallsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reportrun.LlmCallsTable,
+			Columns: []string{reportrun.LlmCallsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(llmcall.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LlmCallsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reportrun.LlmCallsTable,
+			Columns: []string{reportrun.LlmCallsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(llmcall.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.DomainRunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reportrun.DomainRunsTable,
+			Columns: []string{reportrun.DomainRunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(domainrun.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDomainRunsIDs(); len(nodes) > 0 && !_u.mutation.DomainRunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reportrun.DomainRunsTable,
+			Columns: []string{reportrun.DomainRunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(domainrun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DomainRunsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reportrun.DomainRunsTable,
+			Columns: []string{reportrun.DomainRunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(domainrun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.EventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reportrun.EventsTable,
+			Columns: []string{reportrun.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reportrunevent.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedEventsIDs(); len(nodes) > 0 && !_u.mutation.EventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reportrun.EventsTable,
+			Columns: []string{reportrun.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reportrunevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reportrun.EventsTable,
+			Columns: []string{reportrun.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reportrunevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -305,37 +737,118 @@ type ReportRunUpdateOne struct {
 	modifiers []func(*sql.UpdateBuilder)
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (_u *ReportRunUpdateOne) SetCreatedAt(v time.Time) *ReportRunUpdateOne {
-	_u.mutation.SetCreatedAt(v)
+// SetCreatedAt sets the "created_at" field.
+func (_u *ReportRunUpdateOne) SetCreatedAt(v time.Time) *ReportRunUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *ReportRunUpdateOne) SetNillableCreatedAt(v *time.Time) *ReportRunUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *ReportRunUpdateOne) SetTitle(v string) *ReportRunUpdateOne {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *ReportRunUpdateOne) SetNillableTitle(v *string) *ReportRunUpdateOne {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// ClearTitle clears the value of the "title" field.
+func (_u *ReportRunUpdateOne) ClearTitle() *ReportRunUpdateOne {
+	_u.mutation.ClearTitle()
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *ReportRunUpdateOne) SetUserID(v int) *ReportRunUpdateOne {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *ReportRunUpdateOne) SetNillableUserID(v *int) *ReportRunUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *ReportRunUpdateOne) AddUserID(v int) *ReportRunUpdateOne {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *ReportRunUpdateOne) ClearUserID() *ReportRunUpdateOne {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetWindowStart sets the "window_start" field.
+func (_u *ReportRunUpdateOne) SetWindowStart(v time.Time) *ReportRunUpdateOne {
+	_u.mutation.SetWindowStart(v)
 	return _u
 }
 
-// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
-func (_u *ReportRunUpdateOne) SetNillableCreatedAt(v *time.Time) *ReportRunUpdateOne {
+// SetNillableWindowStart sets the "window_start" field if the given value is not nil.
+func (_u *ReportRunUpdateOne) SetNillableWindowStart(v *time.Time) *ReportRunUpdateOne {
 	if v != nil {
-		_u.SetCreatedAt(*v)
+		_u.SetWindowStart(*v)
 	}
 	return _u
 }
 
-// SetTitle sets the "title" field.
-func (_u *ReportRunUpdateOne) SetTitle(v string) *ReportRunUpdateOne {
-	_u.mutation.SetTitle(v)
+// ClearWindowStart clears the value of the "window_start" field.
+func (_u *ReportRunUpdateOne) ClearWindowStart() *ReportRunUpdateOne {
+	_u.mutation.ClearWindowStart()
 	return _u
 }
 
-// SetNillableTitle sets the "title" field if the given value is not nil.
-func (_u *ReportRunUpdateOne) SetNillableTitle(v *string) *ReportRunUpdateOne {
+// SetWindowEnd sets the "window_end" field.
+func (_u *ReportRunUpdateOne) SetWindowEnd(v time.Time) *ReportRunUpdateOne {
+	_u.mutation.SetWindowEnd(v)
+	return _u
+}
+
+// SetNillableWindowEnd sets the "window_end" field if the given value is not nil.
+func (_u *ReportRunUpdateOne) SetNillableWindowEnd(v *time.Time) *ReportRunUpdateOne {
 	if v != nil {
-		_u.SetTitle(*v)
+		_u.SetWindowEnd(*v)
 	}
 	return _u
 }
 
-// ClearTitle clears the value of the "title" field.
-func (_u *ReportRunUpdateOne) ClearTitle() *ReportRunUpdateOne {
-	_u.mutation.ClearTitle()
+// ClearWindowEnd clears the value of the "window_end" field.
+func (_u *ReportRunUpdateOne) ClearWindowEnd() *ReportRunUpdateOne {
+	_u.mutation.ClearWindowEnd()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ReportRunUpdateOne) SetStatus(v string) *ReportRunUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ReportRunUpdateOne) SetNillableStatus(v *string) *ReportRunUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
 	return _u
 }
 
@@ -354,6 +867,21 @@ func (_u *ReportRunUpdateOne) AddDomainReports(v ...*DomainReport) *ReportRunUpd
 	return _u.AddDomainReportIDs(ids...)
 }
 
+// AddGeneratedDomainReportIDs adds the "generated_domain_reports" edge to the DomainReport entity by IDs.
+func (_u *ReportRunUpdateOne) AddGeneratedDomainReportIDs(ids ...int) *ReportRunUpdateOne {
+	_u.mutation.AddGeneratedDomainReportIDs(ids...)
+	return _u
+}
+
+// AddGeneratedDomainReports adds the "generated_domain_reports" edges to the DomainReport entity.
+func (_u *ReportRunUpdateOne) AddGeneratedDomainReports(v ...*DomainReport) *ReportRunUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddGeneratedDomainReportIDs(ids...)
+}
+
 // AddDeepAnalysisResultIDs adds the "deep_analysis_results" edge to the DeepAnalysisResult entity by IDs.
 func (_u *ReportRunUpdateOne) AddDeepAnalysisResultIDs(ids ...int) *ReportRunUpdateOne {
 	_u.mutation.AddDeepAnalysisResultIDs(ids...)
@@ -369,6 +897,51 @@ func (_u *ReportRunUpdateOne) AddDeepAnalysisResults(v ...*DeepAnalysisResult) *
 	return _u.AddDeepAnalysisResultIDs(ids...)
 }
 
+// AddLlmCallIDs adds the "llm_calls" edge to the LLMCall entity by IDs.
+func (_u *ReportRunUpdateOne) AddLlmCallIDs(ids ...int) *ReportRunUpdateOne {
+	_u.mutation.AddLlmCallIDs(ids...)
+	return _u
+}
+
+// AddLlmCalls adds the "llm_calls" edges to the LLMCall entity.
+func (_u *ReportRunUpdateOne) AddLlmCalls(v ...*LLMCall) *ReportRunUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLlmCallIDs(ids...)
+}
+
+// AddDomainRunIDs adds the "domain_runs" edge to the DomainRun entity by IDs.
+func (_u *ReportRunUpdateOne) AddDomainRunIDs(ids ...int) *ReportRunUpdateOne {
+	_u.mutation.AddDomainRunIDs(ids...)
+	return _u
+}
+
+// AddDomainRuns adds the "domain_runs" edges to the DomainRun entity.
+func (_u *ReportRunUpdateOne) AddDomainRuns(v ...*DomainRun) *ReportRunUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDomainRunIDs(ids...)
+}
+
+// AddEventIDs adds the "events" edge to the ReportRunEvent entity by IDs.
+func (_u *ReportRunUpdateOne) AddEventIDs(ids ...int) *ReportRunUpdateOne {
+	_u.mutation.AddEventIDs(ids...)
+	return _u
+}
+
+// AddEvents adds the "events" edges to the ReportRunEvent entity.
+func (_u *ReportRunUpdateOne) AddEvents(v ...*ReportRunEvent) *ReportRunUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddEventIDs(ids...)
+}
+
 // Mutation returns the ReportRunMutation object of the builder.
 func (_u *ReportRunUpdateOne) Mutation() *ReportRunMutation {
 	return _u.mutation
@@ -395,6 +968,27 @@ func (_u *ReportRunUpdateOne) RemoveDomainReports(v ...*DomainReport) *ReportRun
 	return _u.RemoveDomainReportIDs(ids...)
 }
 
+// ClearGeneratedDomainReports clears all "generated_domain_reports" edges to the DomainReport entity.
+func (_u *ReportRunUpdateOne) ClearGeneratedDomainReports() *ReportRunUpdateOne {
+	_u.mutation.ClearGeneratedDomainReports()
+	return _u
+}
+
+// RemoveGeneratedDomainReportIDs removes the "generated_domain_reports" edge to DomainReport entities by IDs.
+func (_u *ReportRunUpdateOne) RemoveGeneratedDomainReportIDs(ids ...int) *ReportRunUpdateOne {
+	_u.mutation.RemoveGeneratedDomainReportIDs(ids...)
+	return _u
+}
+
+// RemoveGeneratedDomainReports removes "generated_domain_reports" edges to DomainReport entities.
+func (_u *ReportRunUpdateOne) RemoveGeneratedDomainReports(v ...*DomainReport) *ReportRunUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveGeneratedDomainReportIDs(ids...)
+}
+
 // ClearDeepAnalysisResults clears all "deep_analysis_results" edges to the DeepAnalysisResult entity.
 func (_u *ReportRunUpdateOne) ClearDeepAnalysisResults() *ReportRunUpdateOne {
 	_u.mutation.ClearDeepAnalysisResults()
@@ -416,6 +1010,69 @@ func (_u *ReportRunUpdateOne) RemoveDeepAnalysisResults(v ...*DeepAnalysisResult
 	return _u.RemoveDeepAnalysisResultIDs(ids...)
 }
 
+// ClearLlmCalls clears all "llm_calls" edges to the LLMCall entity.
+func (_u *ReportRunUpdateOne) ClearLlmCalls() *ReportRunUpdateOne {
+	_u.mutation.ClearLlmCalls()
+	return _u
+}
+
+// RemoveLlmCallIDs removes the "llm_calls" edge to LLMCall entities by IDs.
+func (_u *ReportRunUpdateOne) RemoveLlmCallIDs(ids ...int) *ReportRunUpdateOne {
+	_u.mutation.RemoveLlmCallIDs(ids...)
+	return _u
+}
+
+// RemoveLlmCalls removes "llm_calls" edges to LLMCall entities.
+func (_u *ReportRunUpdateOne) RemoveLlmCalls(v ...*LLMCall) *ReportRunUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLlmCallIDs(ids...)
+}
+
+// ClearDomainRuns clears all "domain_runs" edges to the DomainRun entity.
+func (_u *ReportRunUpdateOne) ClearDomainRuns() *ReportRunUpdateOne {
+	_u.mutation.ClearDomainRuns()
+	return _u
+}
+
+// RemoveDomainRunIDs removes the "domain_runs" edge to DomainRun entities by IDs.
+func (_u *ReportRunUpdateOne) RemoveDomainRunIDs(ids ...int) *ReportRunUpdateOne {
+	_u.mutation.RemoveDomainRunIDs(ids...)
+	return _u
+}
+
+// RemoveDomainRuns removes "domain_runs" edges to DomainRun entities.
+func (_u *ReportRunUpdateOne) RemoveDomainRuns(v ...*DomainRun) *ReportRunUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDomainRunIDs(ids...)
+}
+
+// ClearEvents clears all "events" edges to the ReportRunEvent entity.
+func (_u *ReportRunUpdateOne) ClearEvents() *ReportRunUpdateOne {
+	_u.mutation.ClearEvents()
+	return _u
+}
+
+// RemoveEventIDs removes the "events" edge to ReportRunEvent entities by IDs.
+func (_u *ReportRunUpdateOne) RemoveEventIDs(ids ...int) *ReportRunUpdateOne {
+	_u.mutation.RemoveEventIDs(ids...)
+	return _u
+}
+
+// RemoveEvents removes "events" edges to ReportRunEvent entities.
+func (_u *ReportRunUpdateOne) RemoveEvents(v ...*ReportRunEvent) *ReportRunUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveEventIDs(ids...)
+}
+
 // Where appends a list predicates to the ReportRunUpdate builder.
 func (_u *ReportRunUpdateOne) Where(ps ...predicate.ReportRun) *ReportRunUpdateOne {
 	_u.mutation.Where(ps...)
@@ -497,12 +1154,36 @@ func (_u *ReportRunUpdateOne) sqlSave(ctx context.Context) (_node *ReportRun, er
 	if _u.mutation.TitleCleared() {
 		_spec.ClearField(reportrun.FieldTitle, field.TypeString)
 	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(reportrun.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(reportrun.FieldUserID, field.TypeInt, value)
+	}
+	if _u.mutation.UserIDCleared() {
+		_spec.ClearField(reportrun.FieldUserID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.WindowStart(); ok {
+		_spec.SetField(reportrun.FieldWindowStart, field.TypeTime, value)
+	}
+	if _u.mutation.WindowStartCleared() {
+		_spec.ClearField(reportrun.FieldWindowStart, field.TypeTime)
+	}
+	if value, ok := _u.mutation.WindowEnd(); ok {
+		_spec.SetField(reportrun.FieldWindowEnd, field.TypeTime, value)
+	}
+	if _u.mutation.WindowEndCleared() {
+		_spec.ClearField(reportrun.FieldWindowEnd, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(reportrun.FieldStatus, field.TypeString, value)
+	}
 	if _u.mutation.DomainReportsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2M,
 			Inverse: false,
 			Table:   reportrun.DomainReportsTable,
-			Columns: []string{reportrun.DomainReportsColumn},
+			Columns: reportrun.DomainReportsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(domainreport.FieldID, field.TypeInt),
@@ -512,10 +1193,10 @@ func (_u *ReportRunUpdateOne) sqlSave(ctx context.Context) (_node *ReportRun, er
 	}
 	if nodes := _u.mutation.RemovedDomainReportsIDs(); len(nodes) > 0 && !_u.mutation.DomainReportsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2M,
 			Inverse: false,
 			Table:   reportrun.DomainReportsTable,
-			Columns: []string{reportrun.DomainReportsColumn},
+			Columns: reportrun.DomainReportsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(domainreport.FieldID, field.TypeInt),
@@ -528,10 +1209,55 @@ func (_u *ReportRunUpdateOne) sqlSave(ctx context.Context) (_node *ReportRun, er
 	}
 	if nodes := _u.mutation.DomainReportsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2M,
 			Inverse: false,
 			Table:   reportrun.DomainReportsTable,
-			Columns: []string{reportrun.DomainReportsColumn},
+			Columns: reportrun.DomainReportsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(domainreport.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.GeneratedDomainReportsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reportrun.GeneratedDomainReportsTable,
+			Columns: []string{reportrun.GeneratedDomainReportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(domainreport.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedGeneratedDomainReportsIDs(); len(nodes) > 0 && !_u.mutation.GeneratedDomainReportsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reportrun.GeneratedDomainReportsTable,
+			Columns: []string{reportrun.GeneratedDomainReportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(domainreport.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.GeneratedDomainReportsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reportrun.GeneratedDomainReportsTable,
+			Columns: []string{reportrun.GeneratedDomainReportsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(domainreport.FieldID, field.TypeInt),
@@ -587,6 +1313,141 @@ func (_u *ReportRunUpdateOne) sqlSave(ctx context.Context) (_node *ReportRun, er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.LlmCallsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reportrun.LlmCallsTable,
+			Columns: []string{reportrun.LlmCallsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(llmcall.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLlmCallsIDs(); len(nodes) > 0 && !_u.mutation.LlmCallsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reportrun.LlmCallsTable,
+			Columns: []string{reportrun.LlmCallsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(llmcall.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LlmCallsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reportrun.LlmCallsTable,
+			Columns: []string{reportrun.LlmCallsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(llmcall.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.DomainRunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reportrun.DomainRunsTable,
+			Columns: []string{reportrun.DomainRunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(domainrun.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDomainRunsIDs(); len(nodes) > 0 && !_u.mutation.DomainRunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reportrun.DomainRunsTable,
+			Columns: []string{reportrun.DomainRunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(domainrun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DomainRunsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reportrun.DomainRunsTable,
+			Columns: []string{reportrun.DomainRunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(domainrun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.EventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reportrun.EventsTable,
+			Columns: []string{reportrun.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reportrunevent.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedEventsIDs(); len(nodes) > 0 && !_u.mutation.EventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reportrun.EventsTable,
+			Columns: []string{reportrun.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reportrunevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reportrun.EventsTable,
+			Columns: []string{reportrun.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reportrunevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &ReportRun{config: _u.config}
 	_spec.Assign = _node.assignValues
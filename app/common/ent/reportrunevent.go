@@ -0,0 +1,195 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/iWorld-y/domain_radar/app/common/ent/reportrun"
+	"github.com/iWorld-y/domain_radar/app/common/ent/reportrunevent"
+)
+
+// ReportRunEvent is the model entity for the ReportRunEvent schema.
+type ReportRunEvent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// RunID holds the value of the "run_id" field.
+	RunID int `json:"run_id,omitempty"`
+	// Domain the event belongs to, empty for run-level events
+	Domain string `json:"domain,omitempty"`
+	// Event type, e.g. search_issued, article_rejected, llm_call_finished, report_saved
+	Type string `json:"type,omitempty"`
+	// Human readable details such as a query, URL or error
+	Message string `json:"message,omitempty"`
+	// Structured details such as result counts, token usage or latency
+	Data map[string]interface{} `json:"data,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ReportRunEventQuery when eager-loading is set.
+	Edges        ReportRunEventEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ReportRunEventEdges holds the relations/edges for other nodes in the graph.
+type ReportRunEventEdges struct {
+	// ReportRun holds the value of the report_run edge.
+	ReportRun *ReportRun `json:"report_run,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ReportRunOrErr returns the ReportRun value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ReportRunEventEdges) ReportRunOrErr() (*ReportRun, error) {
+	if e.ReportRun != nil {
+		return e.ReportRun, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: reportrun.Label}
+	}
+	return nil, &NotLoadedError{edge: "report_run"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ReportRunEvent) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case reportrunevent.FieldData:
+			values[i] = new([]byte)
+		case reportrunevent.FieldID, reportrunevent.FieldRunID:
+			values[i] = new(sql.NullInt64)
+		case reportrunevent.FieldDomain, reportrunevent.FieldType, reportrunevent.FieldMessage:
+			values[i] = new(sql.NullString)
+		case reportrunevent.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ReportRunEvent fields.
+func (_m *ReportRunEvent) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case reportrunevent.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case reportrunevent.FieldRunID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field run_id", values[i])
+			} else if value.Valid {
+				_m.RunID = int(value.Int64)
+			}
+		case reportrunevent.FieldDomain:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field domain", values[i])
+			} else if value.Valid {
+				_m.Domain = value.String
+			}
+		case reportrunevent.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				_m.Type = value.String
+			}
+		case reportrunevent.FieldMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field message", values[i])
+			} else if value.Valid {
+				_m.Message = value.String
+			}
+		case reportrunevent.FieldData:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field data", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Data); err != nil {
+					return fmt.Errorf("unmarshal field data: %w", err)
+				}
+			}
+		case reportrunevent.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ReportRunEvent.
+// This includes values selected through modifiers, order, etc.
+func (_m *ReportRunEvent) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryReportRun queries the "report_run" edge of the ReportRunEvent entity.
+func (_m *ReportRunEvent) QueryReportRun() *ReportRunQuery {
+	return NewReportRunEventClient(_m.config).QueryReportRun(_m)
+}
+
+// Update returns a builder for updating this ReportRunEvent.
+// Note that you need to call ReportRunEvent.Unwrap() before calling this method if this ReportRunEvent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ReportRunEvent) Update() *ReportRunEventUpdateOne {
+	return NewReportRunEventClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ReportRunEvent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ReportRunEvent) Unwrap() *ReportRunEvent {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ReportRunEvent is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ReportRunEvent) String() string {
+	var builder strings.Builder
+	builder.WriteString("ReportRunEvent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("run_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RunID))
+	builder.WriteString(", ")
+	builder.WriteString("domain=")
+	builder.WriteString(_m.Domain)
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(_m.Type)
+	builder.WriteString(", ")
+	builder.WriteString("message=")
+	builder.WriteString(_m.Message)
+	builder.WriteString(", ")
+	builder.WriteString("data=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Data))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ReportRunEvents is a parsable slice of ReportRunEvent.
+type ReportRunEvents []*ReportRunEvent
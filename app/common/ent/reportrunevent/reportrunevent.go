@@ -0,0 +1,113 @@
+// Code generated by ent, DO NOT EDIT.
+
+package reportrunevent
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the reportrunevent type in the database.
+	Label = "report_run_event"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldRunID holds the string denoting the run_id field in the database.
+	FieldRunID = "run_id"
+	// FieldDomain holds the string denoting the domain field in the database.
+	FieldDomain = "domain"
+	// FieldType holds the string denoting the type field in the database.
+	FieldType = "type"
+	// FieldMessage holds the string denoting the message field in the database.
+	FieldMessage = "message"
+	// FieldData holds the string denoting the data field in the database.
+	FieldData = "data"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeReportRun holds the string denoting the report_run edge name in mutations.
+	EdgeReportRun = "report_run"
+	// Table holds the table name of the reportrunevent in the database.
+	Table = "report_run_events"
+	// ReportRunTable is the table that holds the report_run relation/edge.
+	ReportRunTable = "report_run_events"
+	// ReportRunInverseTable is the table name for the ReportRun entity.
+	// It exists in this package in order to avoid circular dependency with the "reportrun" package.
+	ReportRunInverseTable = "report_runs"
+	// ReportRunColumn is the table column denoting the report_run relation/edge.
+	ReportRunColumn = "run_id"
+)
+
+// Columns holds all SQL columns for reportrunevent fields.
+var Columns = []string{
+	FieldID,
+	FieldRunID,
+	FieldDomain,
+	FieldType,
+	FieldMessage,
+	FieldData,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the ReportRunEvent queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByRunID orders the results by the run_id field.
+func ByRunID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRunID, opts...).ToFunc()
+}
+
+// ByDomain orders the results by the domain field.
+func ByDomain(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDomain, opts...).ToFunc()
+}
+
+// ByType orders the results by the type field.
+func ByType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldType, opts...).ToFunc()
+}
+
+// ByMessage orders the results by the message field.
+func ByMessage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMessage, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByReportRunField orders the results by report_run field.
+func ByReportRunField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReportRunStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newReportRunStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReportRunInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ReportRunTable, ReportRunColumn),
+	)
+}
@@ -0,0 +1,893 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/iWorld-y/domain_radar/app/common/ent/reportrun"
+	"github.com/iWorld-y/domain_radar/app/common/ent/reportrunevent"
+)
+
+// ReportRunEventCreate is the builder for creating a ReportRunEvent entity.
+type ReportRunEventCreate struct {
+	config
+	mutation *ReportRunEventMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetRunID sets the "run_id" field.
+func (_c *ReportRunEventCreate) SetRunID(v int) *ReportRunEventCreate {
+	_c.mutation.SetRunID(v)
+	return _c
+}
+
+// SetNillableRunID sets the "run_id" field if the given value is not nil.
+func (_c *ReportRunEventCreate) SetNillableRunID(v *int) *ReportRunEventCreate {
+	if v != nil {
+		_c.SetRunID(*v)
+	}
+	return _c
+}
+
+// SetDomain sets the "domain" field.
+func (_c *ReportRunEventCreate) SetDomain(v string) *ReportRunEventCreate {
+	_c.mutation.SetDomain(v)
+	return _c
+}
+
+// SetNillableDomain sets the "domain" field if the given value is not nil.
+func (_c *ReportRunEventCreate) SetNillableDomain(v *string) *ReportRunEventCreate {
+	if v != nil {
+		_c.SetDomain(*v)
+	}
+	return _c
+}
+
+// SetType sets the "type" field.
+func (_c *ReportRunEventCreate) SetType(v string) *ReportRunEventCreate {
+	_c.mutation.SetType(v)
+	return _c
+}
+
+// SetMessage sets the "message" field.
+func (_c *ReportRunEventCreate) SetMessage(v string) *ReportRunEventCreate {
+	_c.mutation.SetMessage(v)
+	return _c
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (_c *ReportRunEventCreate) SetNillableMessage(v *string) *ReportRunEventCreate {
+	if v != nil {
+		_c.SetMessage(*v)
+	}
+	return _c
+}
+
+// SetData sets the "data" field.
+func (_c *ReportRunEventCreate) SetData(v map[string]interface{}) *ReportRunEventCreate {
+	_c.mutation.SetData(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ReportRunEventCreate) SetCreatedAt(v time.Time) *ReportRunEventCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ReportRunEventCreate) SetNillableCreatedAt(v *time.Time) *ReportRunEventCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ReportRunEventCreate) SetID(v int) *ReportRunEventCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetReportRunID sets the "report_run" edge to the ReportRun entity by ID.
+func (_c *ReportRunEventCreate) SetReportRunID(id int) *ReportRunEventCreate {
+	_c.mutation.SetReportRunID(id)
+	return _c
+}
+
+// SetNillableReportRunID sets the "report_run" edge to the ReportRun entity by ID if the given value is not nil.
+func (_c *ReportRunEventCreate) SetNillableReportRunID(id *int) *ReportRunEventCreate {
+	if id != nil {
+		_c = _c.SetReportRunID(*id)
+	}
+	return _c
+}
+
+// SetReportRun sets the "report_run" edge to the ReportRun entity.
+func (_c *ReportRunEventCreate) SetReportRun(v *ReportRun) *ReportRunEventCreate {
+	return _c.SetReportRunID(v.ID)
+}
+
+// Mutation returns the ReportRunEventMutation object of the builder.
+func (_c *ReportRunEventCreate) Mutation() *ReportRunEventMutation {
+	return _c.mutation
+}
+
+// Save creates the ReportRunEvent in the database.
+func (_c *ReportRunEventCreate) Save(ctx context.Context) (*ReportRunEvent, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ReportRunEventCreate) SaveX(ctx context.Context) *ReportRunEvent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ReportRunEventCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ReportRunEventCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ReportRunEventCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := reportrunevent.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ReportRunEventCreate) check() error {
+	if _, ok := _c.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "ReportRunEvent.type"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ReportRunEvent.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *ReportRunEventCreate) sqlSave(ctx context.Context) (*ReportRunEvent, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ReportRunEventCreate) createSpec() (*ReportRunEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ReportRunEvent{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(reportrunevent.Table, sqlgraph.NewFieldSpec(reportrunevent.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.Domain(); ok {
+		_spec.SetField(reportrunevent.FieldDomain, field.TypeString, value)
+		_node.Domain = value
+	}
+	if value, ok := _c.mutation.GetType(); ok {
+		_spec.SetField(reportrunevent.FieldType, field.TypeString, value)
+		_node.Type = value
+	}
+	if value, ok := _c.mutation.Message(); ok {
+		_spec.SetField(reportrunevent.FieldMessage, field.TypeString, value)
+		_node.Message = value
+	}
+	if value, ok := _c.mutation.Data(); ok {
+		_spec.SetField(reportrunevent.FieldData, field.TypeJSON, value)
+		_node.Data = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(reportrunevent.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.ReportRunIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reportrunevent.ReportRunTable,
+			Columns: []string{reportrunevent.ReportRunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reportrun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.RunID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ReportRunEvent.Create().
+//		SetRunID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReportRunEventUpsert) {
+//			SetRunID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ReportRunEventCreate) OnConflict(opts ...sql.ConflictOption) *ReportRunEventUpsertOne {
+	_c.conflict = opts
+	return &ReportRunEventUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ReportRunEvent.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ReportRunEventCreate) OnConflictColumns(columns ...string) *ReportRunEventUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ReportRunEventUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ReportRunEventUpsertOne is the builder for "upsert"-ing
+	//  one ReportRunEvent node.
+	ReportRunEventUpsertOne struct {
+		create *ReportRunEventCreate
+	}
+
+	// ReportRunEventUpsert is the "OnConflict" setter.
+	ReportRunEventUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetRunID sets the "run_id" field.
+func (u *ReportRunEventUpsert) SetRunID(v int) *ReportRunEventUpsert {
+	u.Set(reportrunevent.FieldRunID, v)
+	return u
+}
+
+// UpdateRunID sets the "run_id" field to the value that was provided on create.
+func (u *ReportRunEventUpsert) UpdateRunID() *ReportRunEventUpsert {
+	u.SetExcluded(reportrunevent.FieldRunID)
+	return u
+}
+
+// ClearRunID clears the value of the "run_id" field.
+func (u *ReportRunEventUpsert) ClearRunID() *ReportRunEventUpsert {
+	u.SetNull(reportrunevent.FieldRunID)
+	return u
+}
+
+// SetDomain sets the "domain" field.
+func (u *ReportRunEventUpsert) SetDomain(v string) *ReportRunEventUpsert {
+	u.Set(reportrunevent.FieldDomain, v)
+	return u
+}
+
+// UpdateDomain sets the "domain" field to the value that was provided on create.
+func (u *ReportRunEventUpsert) UpdateDomain() *ReportRunEventUpsert {
+	u.SetExcluded(reportrunevent.FieldDomain)
+	return u
+}
+
+// ClearDomain clears the value of the "domain" field.
+func (u *ReportRunEventUpsert) ClearDomain() *ReportRunEventUpsert {
+	u.SetNull(reportrunevent.FieldDomain)
+	return u
+}
+
+// SetType sets the "type" field.
+func (u *ReportRunEventUpsert) SetType(v string) *ReportRunEventUpsert {
+	u.Set(reportrunevent.FieldType, v)
+	return u
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *ReportRunEventUpsert) UpdateType() *ReportRunEventUpsert {
+	u.SetExcluded(reportrunevent.FieldType)
+	return u
+}
+
+// SetMessage sets the "message" field.
+func (u *ReportRunEventUpsert) SetMessage(v string) *ReportRunEventUpsert {
+	u.Set(reportrunevent.FieldMessage, v)
+	return u
+}
+
+// UpdateMessage sets the "message" field to the value that was provided on create.
+func (u *ReportRunEventUpsert) UpdateMessage() *ReportRunEventUpsert {
+	u.SetExcluded(reportrunevent.FieldMessage)
+	return u
+}
+
+// ClearMessage clears the value of the "message" field.
+func (u *ReportRunEventUpsert) ClearMessage() *ReportRunEventUpsert {
+	u.SetNull(reportrunevent.FieldMessage)
+	return u
+}
+
+// SetData sets the "data" field.
+func (u *ReportRunEventUpsert) SetData(v map[string]interface{}) *ReportRunEventUpsert {
+	u.Set(reportrunevent.FieldData, v)
+	return u
+}
+
+// UpdateData sets the "data" field to the value that was provided on create.
+func (u *ReportRunEventUpsert) UpdateData() *ReportRunEventUpsert {
+	u.SetExcluded(reportrunevent.FieldData)
+	return u
+}
+
+// ClearData clears the value of the "data" field.
+func (u *ReportRunEventUpsert) ClearData() *ReportRunEventUpsert {
+	u.SetNull(reportrunevent.FieldData)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ReportRunEventUpsert) SetCreatedAt(v time.Time) *ReportRunEventUpsert {
+	u.Set(reportrunevent.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ReportRunEventUpsert) UpdateCreatedAt() *ReportRunEventUpsert {
+	u.SetExcluded(reportrunevent.FieldCreatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ReportRunEvent.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(reportrunevent.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ReportRunEventUpsertOne) UpdateNewValues() *ReportRunEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(reportrunevent.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ReportRunEvent.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ReportRunEventUpsertOne) Ignore() *ReportRunEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReportRunEventUpsertOne) DoNothing() *ReportRunEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReportRunEventCreate.OnConflict
+// documentation for more info.
+func (u *ReportRunEventUpsertOne) Update(set func(*ReportRunEventUpsert)) *ReportRunEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReportRunEventUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetRunID sets the "run_id" field.
+func (u *ReportRunEventUpsertOne) SetRunID(v int) *ReportRunEventUpsertOne {
+	return u.Update(func(s *ReportRunEventUpsert) {
+		s.SetRunID(v)
+	})
+}
+
+// UpdateRunID sets the "run_id" field to the value that was provided on create.
+func (u *ReportRunEventUpsertOne) UpdateRunID() *ReportRunEventUpsertOne {
+	return u.Update(func(s *ReportRunEventUpsert) {
+		s.UpdateRunID()
+	})
+}
+
+// ClearRunID clears the value of the "run_id" field.
+func (u *ReportRunEventUpsertOne) ClearRunID() *ReportRunEventUpsertOne {
+	return u.Update(func(s *ReportRunEventUpsert) {
+		s.ClearRunID()
+	})
+}
+
+// SetDomain sets the "domain" field.
+func (u *ReportRunEventUpsertOne) SetDomain(v string) *ReportRunEventUpsertOne {
+	return u.Update(func(s *ReportRunEventUpsert) {
+		s.SetDomain(v)
+	})
+}
+
+// UpdateDomain sets the "domain" field to the value that was provided on create.
+func (u *ReportRunEventUpsertOne) UpdateDomain() *ReportRunEventUpsertOne {
+	return u.Update(func(s *ReportRunEventUpsert) {
+		s.UpdateDomain()
+	})
+}
+
+// ClearDomain clears the value of the "domain" field.
+func (u *ReportRunEventUpsertOne) ClearDomain() *ReportRunEventUpsertOne {
+	return u.Update(func(s *ReportRunEventUpsert) {
+		s.ClearDomain()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *ReportRunEventUpsertOne) SetType(v string) *ReportRunEventUpsertOne {
+	return u.Update(func(s *ReportRunEventUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *ReportRunEventUpsertOne) UpdateType() *ReportRunEventUpsertOne {
+	return u.Update(func(s *ReportRunEventUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetMessage sets the "message" field.
+func (u *ReportRunEventUpsertOne) SetMessage(v string) *ReportRunEventUpsertOne {
+	return u.Update(func(s *ReportRunEventUpsert) {
+		s.SetMessage(v)
+	})
+}
+
+// UpdateMessage sets the "message" field to the value that was provided on create.
+func (u *ReportRunEventUpsertOne) UpdateMessage() *ReportRunEventUpsertOne {
+	return u.Update(func(s *ReportRunEventUpsert) {
+		s.UpdateMessage()
+	})
+}
+
+// ClearMessage clears the value of the "message" field.
+func (u *ReportRunEventUpsertOne) ClearMessage() *ReportRunEventUpsertOne {
+	return u.Update(func(s *ReportRunEventUpsert) {
+		s.ClearMessage()
+	})
+}
+
+// SetData sets the "data" field.
+func (u *ReportRunEventUpsertOne) SetData(v map[string]interface{}) *ReportRunEventUpsertOne {
+	return u.Update(func(s *ReportRunEventUpsert) {
+		s.SetData(v)
+	})
+}
+
+// UpdateData sets the "data" field to the value that was provided on create.
+func (u *ReportRunEventUpsertOne) UpdateData() *ReportRunEventUpsertOne {
+	return u.Update(func(s *ReportRunEventUpsert) {
+		s.UpdateData()
+	})
+}
+
+// ClearData clears the value of the "data" field.
+func (u *ReportRunEventUpsertOne) ClearData() *ReportRunEventUpsertOne {
+	return u.Update(func(s *ReportRunEventUpsert) {
+		s.ClearData()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ReportRunEventUpsertOne) SetCreatedAt(v time.Time) *ReportRunEventUpsertOne {
+	return u.Update(func(s *ReportRunEventUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ReportRunEventUpsertOne) UpdateCreatedAt() *ReportRunEventUpsertOne {
+	return u.Update(func(s *ReportRunEventUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ReportRunEventUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReportRunEventCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReportRunEventUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ReportRunEventUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ReportRunEventUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ReportRunEventCreateBulk is the builder for creating many ReportRunEvent entities in bulk.
+type ReportRunEventCreateBulk struct {
+	config
+	err      error
+	builders []*ReportRunEventCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ReportRunEvent entities in the database.
+func (_c *ReportRunEventCreateBulk) Save(ctx context.Context) ([]*ReportRunEvent, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ReportRunEvent, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ReportRunEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ReportRunEventCreateBulk) SaveX(ctx context.Context) []*ReportRunEvent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ReportRunEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ReportRunEventCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ReportRunEvent.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReportRunEventUpsert) {
+//			SetRunID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ReportRunEventCreateBulk) OnConflict(opts ...sql.ConflictOption) *ReportRunEventUpsertBulk {
+	_c.conflict = opts
+	return &ReportRunEventUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ReportRunEvent.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ReportRunEventCreateBulk) OnConflictColumns(columns ...string) *ReportRunEventUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ReportRunEventUpsertBulk{
+		create: _c,
+	}
+}
+
+// ReportRunEventUpsertBulk is the builder for "upsert"-ing
+// a bulk of ReportRunEvent nodes.
+type ReportRunEventUpsertBulk struct {
+	create *ReportRunEventCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ReportRunEvent.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(reportrunevent.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ReportRunEventUpsertBulk) UpdateNewValues() *ReportRunEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(reportrunevent.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ReportRunEvent.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ReportRunEventUpsertBulk) Ignore() *ReportRunEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReportRunEventUpsertBulk) DoNothing() *ReportRunEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReportRunEventCreateBulk.OnConflict
+// documentation for more info.
+func (u *ReportRunEventUpsertBulk) Update(set func(*ReportRunEventUpsert)) *ReportRunEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReportRunEventUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetRunID sets the "run_id" field.
+func (u *ReportRunEventUpsertBulk) SetRunID(v int) *ReportRunEventUpsertBulk {
+	return u.Update(func(s *ReportRunEventUpsert) {
+		s.SetRunID(v)
+	})
+}
+
+// UpdateRunID sets the "run_id" field to the value that was provided on create.
+func (u *ReportRunEventUpsertBulk) UpdateRunID() *ReportRunEventUpsertBulk {
+	return u.Update(func(s *ReportRunEventUpsert) {
+		s.UpdateRunID()
+	})
+}
+
+// ClearRunID clears the value of the "run_id" field.
+func (u *ReportRunEventUpsertBulk) ClearRunID() *ReportRunEventUpsertBulk {
+	return u.Update(func(s *ReportRunEventUpsert) {
+		s.ClearRunID()
+	})
+}
+
+// SetDomain sets the "domain" field.
+func (u *ReportRunEventUpsertBulk) SetDomain(v string) *ReportRunEventUpsertBulk {
+	return u.Update(func(s *ReportRunEventUpsert) {
+		s.SetDomain(v)
+	})
+}
+
+// UpdateDomain sets the "domain" field to the value that was provided on create.
+func (u *ReportRunEventUpsertBulk) UpdateDomain() *ReportRunEventUpsertBulk {
+	return u.Update(func(s *ReportRunEventUpsert) {
+		s.UpdateDomain()
+	})
+}
+
+// ClearDomain clears the value of the "domain" field.
+func (u *ReportRunEventUpsertBulk) ClearDomain() *ReportRunEventUpsertBulk {
+	return u.Update(func(s *ReportRunEventUpsert) {
+		s.ClearDomain()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *ReportRunEventUpsertBulk) SetType(v string) *ReportRunEventUpsertBulk {
+	return u.Update(func(s *ReportRunEventUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *ReportRunEventUpsertBulk) UpdateType() *ReportRunEventUpsertBulk {
+	return u.Update(func(s *ReportRunEventUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetMessage sets the "message" field.
+func (u *ReportRunEventUpsertBulk) SetMessage(v string) *ReportRunEventUpsertBulk {
+	return u.Update(func(s *ReportRunEventUpsert) {
+		s.SetMessage(v)
+	})
+}
+
+// UpdateMessage sets the "message" field to the value that was provided on create.
+func (u *ReportRunEventUpsertBulk) UpdateMessage() *ReportRunEventUpsertBulk {
+	return u.Update(func(s *ReportRunEventUpsert) {
+		s.UpdateMessage()
+	})
+}
+
+// ClearMessage clears the value of the "message" field.
+func (u *ReportRunEventUpsertBulk) ClearMessage() *ReportRunEventUpsertBulk {
+	return u.Update(func(s *ReportRunEventUpsert) {
+		s.ClearMessage()
+	})
+}
+
+// SetData sets the "data" field.
+func (u *ReportRunEventUpsertBulk) SetData(v map[string]interface{}) *ReportRunEventUpsertBulk {
+	return u.Update(func(s *ReportRunEventUpsert) {
+		s.SetData(v)
+	})
+}
+
+// UpdateData sets the "data" field to the value that was provided on create.
+func (u *ReportRunEventUpsertBulk) UpdateData() *ReportRunEventUpsertBulk {
+	return u.Update(func(s *ReportRunEventUpsert) {
+		s.UpdateData()
+	})
+}
+
+// ClearData clears the value of the "data" field.
+func (u *ReportRunEventUpsertBulk) ClearData() *ReportRunEventUpsertBulk {
+	return u.Update(func(s *ReportRunEventUpsert) {
+		s.ClearData()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ReportRunEventUpsertBulk) SetCreatedAt(v time.Time) *ReportRunEventUpsertBulk {
+	return u.Update(func(s *ReportRunEventUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ReportRunEventUpsertBulk) UpdateCreatedAt() *ReportRunEventUpsertBulk {
+	return u.Update(func(s *ReportRunEventUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ReportRunEventUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ReportRunEventCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReportRunEventCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReportRunEventUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
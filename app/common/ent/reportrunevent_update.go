@@ -0,0 +1,549 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/iWorld-y/domain_radar/app/common/ent/predicate"
+	"github.com/iWorld-y/domain_radar/app/common/ent/reportrun"
+	"github.com/iWorld-y/domain_radar/app/common/ent/reportrunevent"
+)
+
+// ReportRunEventUpdate is the builder for updating ReportRunEvent entities.
+type ReportRunEventUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ReportRunEventMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ReportRunEventUpdate builder.
+func (_u *ReportRunEventUpdate) Where(ps ...predicate.ReportRunEvent) *ReportRunEventUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetRunID sets the "run_id" field.
+func (_u *ReportRunEventUpdate) SetRunID(v int) *ReportRunEventUpdate {
+	_u.mutation.SetRunID(v)
+	return _u
+}
+
+// SetNillableRunID sets the "run_id" field if the given value is not nil.
+func (_u *ReportRunEventUpdate) SetNillableRunID(v *int) *ReportRunEventUpdate {
+	if v != nil {
+		_u.SetRunID(*v)
+	}
+	return _u
+}
+
+// ClearRunID clears the value of the "run_id" field.
+func (_u *ReportRunEventUpdate) ClearRunID() *ReportRunEventUpdate {
+	_u.mutation.ClearRunID()
+	return _u
+}
+
+// SetDomain sets the "domain" field.
+func (_u *ReportRunEventUpdate) SetDomain(v string) *ReportRunEventUpdate {
+	_u.mutation.SetDomain(v)
+	return _u
+}
+
+// SetNillableDomain sets the "domain" field if the given value is not nil.
+func (_u *ReportRunEventUpdate) SetNillableDomain(v *string) *ReportRunEventUpdate {
+	if v != nil {
+		_u.SetDomain(*v)
+	}
+	return _u
+}
+
+// ClearDomain clears the value of the "domain" field.
+func (_u *ReportRunEventUpdate) ClearDomain() *ReportRunEventUpdate {
+	_u.mutation.ClearDomain()
+	return _u
+}
+
+// SetType sets the "type" field.
+func (_u *ReportRunEventUpdate) SetType(v string) *ReportRunEventUpdate {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *ReportRunEventUpdate) SetNillableType(v *string) *ReportRunEventUpdate {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetMessage sets the "message" field.
+func (_u *ReportRunEventUpdate) SetMessage(v string) *ReportRunEventUpdate {
+	_u.mutation.SetMessage(v)
+	return _u
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (_u *ReportRunEventUpdate) SetNillableMessage(v *string) *ReportRunEventUpdate {
+	if v != nil {
+		_u.SetMessage(*v)
+	}
+	return _u
+}
+
+// ClearMessage clears the value of the "message" field.
+func (_u *ReportRunEventUpdate) ClearMessage() *ReportRunEventUpdate {
+	_u.mutation.ClearMessage()
+	return _u
+}
+
+// SetData sets the "data" field.
+func (_u *ReportRunEventUpdate) SetData(v map[string]interface{}) *ReportRunEventUpdate {
+	_u.mutation.SetData(v)
+	return _u
+}
+
+// ClearData clears the value of the "data" field.
+func (_u *ReportRunEventUpdate) ClearData() *ReportRunEventUpdate {
+	_u.mutation.ClearData()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *ReportRunEventUpdate) SetCreatedAt(v time.Time) *ReportRunEventUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *ReportRunEventUpdate) SetNillableCreatedAt(v *time.Time) *ReportRunEventUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetReportRunID sets the "report_run" edge to the ReportRun entity by ID.
+func (_u *ReportRunEventUpdate) SetReportRunID(id int) *ReportRunEventUpdate {
+	_u.mutation.SetReportRunID(id)
+	return _u
+}
+
+// SetNillableReportRunID sets the "report_run" edge to the ReportRun entity by ID if the given value is not nil.
+func (_u *ReportRunEventUpdate) SetNillableReportRunID(id *int) *ReportRunEventUpdate {
+	if id != nil {
+		_u = _u.SetReportRunID(*id)
+	}
+	return _u
+}
+
+// SetReportRun sets the "report_run" edge to the ReportRun entity.
+func (_u *ReportRunEventUpdate) SetReportRun(v *ReportRun) *ReportRunEventUpdate {
+	return _u.SetReportRunID(v.ID)
+}
+
+// Mutation returns the ReportRunEventMutation object of the builder.
+func (_u *ReportRunEventUpdate) Mutation() *ReportRunEventMutation {
+	return _u.mutation
+}
+
+// ClearReportRun clears the "report_run" edge to the ReportRun entity.
+func (_u *ReportRunEventUpdate) ClearReportRun() *ReportRunEventUpdate {
+	_u.mutation.ClearReportRun()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ReportRunEventUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ReportRunEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ReportRunEventUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ReportRunEventUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ReportRunEventUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ReportRunEventUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ReportRunEventUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(reportrunevent.Table, reportrunevent.Columns, sqlgraph.NewFieldSpec(reportrunevent.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Domain(); ok {
+		_spec.SetField(reportrunevent.FieldDomain, field.TypeString, value)
+	}
+	if _u.mutation.DomainCleared() {
+		_spec.ClearField(reportrunevent.FieldDomain, field.TypeString)
+	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(reportrunevent.FieldType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Message(); ok {
+		_spec.SetField(reportrunevent.FieldMessage, field.TypeString, value)
+	}
+	if _u.mutation.MessageCleared() {
+		_spec.ClearField(reportrunevent.FieldMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.Data(); ok {
+		_spec.SetField(reportrunevent.FieldData, field.TypeJSON, value)
+	}
+	if _u.mutation.DataCleared() {
+		_spec.ClearField(reportrunevent.FieldData, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(reportrunevent.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReportRunCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reportrunevent.ReportRunTable,
+			Columns: []string{reportrunevent.ReportRunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reportrun.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReportRunIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reportrunevent.ReportRunTable,
+			Columns: []string{reportrunevent.ReportRunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reportrun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reportrunevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ReportRunEventUpdateOne is the builder for updating a single ReportRunEvent entity.
+type ReportRunEventUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ReportRunEventMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetRunID sets the "run_id" field.
+func (_u *ReportRunEventUpdateOne) SetRunID(v int) *ReportRunEventUpdateOne {
+	_u.mutation.SetRunID(v)
+	return _u
+}
+
+// SetNillableRunID sets the "run_id" field if the given value is not nil.
+func (_u *ReportRunEventUpdateOne) SetNillableRunID(v *int) *ReportRunEventUpdateOne {
+	if v != nil {
+		_u.SetRunID(*v)
+	}
+	return _u
+}
+
+// ClearRunID clears the value of the "run_id" field.
+func (_u *ReportRunEventUpdateOne) ClearRunID() *ReportRunEventUpdateOne {
+	_u.mutation.ClearRunID()
+	return _u
+}
+
+// SetDomain sets the "domain" field.
+func (_u *ReportRunEventUpdateOne) SetDomain(v string) *ReportRunEventUpdateOne {
+	_u.mutation.SetDomain(v)
+	return _u
+}
+
+// SetNillableDomain sets the "domain" field if the given value is not nil.
+func (_u *ReportRunEventUpdateOne) SetNillableDomain(v *string) *ReportRunEventUpdateOne {
+	if v != nil {
+		_u.SetDomain(*v)
+	}
+	return _u
+}
+
+// ClearDomain clears the value of the "domain" field.
+func (_u *ReportRunEventUpdateOne) ClearDomain() *ReportRunEventUpdateOne {
+	_u.mutation.ClearDomain()
+	return _u
+}
+
+// SetType sets the "type" field.
+func (_u *ReportRunEventUpdateOne) SetType(v string) *ReportRunEventUpdateOne {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *ReportRunEventUpdateOne) SetNillableType(v *string) *ReportRunEventUpdateOne {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetMessage sets the "message" field.
+func (_u *ReportRunEventUpdateOne) SetMessage(v string) *ReportRunEventUpdateOne {
+	_u.mutation.SetMessage(v)
+	return _u
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (_u *ReportRunEventUpdateOne) SetNillableMessage(v *string) *ReportRunEventUpdateOne {
+	if v != nil {
+		_u.SetMessage(*v)
+	}
+	return _u
+}
+
+// ClearMessage clears the value of the "message" field.
+func (_u *ReportRunEventUpdateOne) ClearMessage() *ReportRunEventUpdateOne {
+	_u.mutation.ClearMessage()
+	return _u
+}
+
+// SetData sets the "data" field.
+func (_u *ReportRunEventUpdateOne) SetData(v map[string]interface{}) *ReportRunEventUpdateOne {
+	_u.mutation.SetData(v)
+	return _u
+}
+
+// ClearData clears the value of the "data" field.
+func (_u *ReportRunEventUpdateOne) ClearData() *ReportRunEventUpdateOne {
+	_u.mutation.ClearData()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *ReportRunEventUpdateOne) SetCreatedAt(v time.Time) *ReportRunEventUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *ReportRunEventUpdateOne) SetNillableCreatedAt(v *time.Time) *ReportRunEventUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetReportRunID sets the "report_run" edge to the ReportRun entity by ID.
+func (_u *ReportRunEventUpdateOne) SetReportRunID(id int) *ReportRunEventUpdateOne {
+	_u.mutation.SetReportRunID(id)
+	return _u
+}
+
+// SetNillableReportRunID sets the "report_run" edge to the ReportRun entity by ID if the given value is not nil.
+func (_u *ReportRunEventUpdateOne) SetNillableReportRunID(id *int) *ReportRunEventUpdateOne {
+	if id != nil {
+		_u = _u.SetReportRunID(*id)
+	}
+	return _u
+}
+
+// SetReportRun sets the "report_run" edge to the ReportRun entity.
+func (_u *ReportRunEventUpdateOne) SetReportRun(v *ReportRun) *ReportRunEventUpdateOne {
+	return _u.SetReportRunID(v.ID)
+}
+
+// Mutation returns the ReportRunEventMutation object of the builder.
+func (_u *ReportRunEventUpdateOne) Mutation() *ReportRunEventMutation {
+	return _u.mutation
+}
+
+// ClearReportRun clears the "report_run" edge to the ReportRun entity.
+func (_u *ReportRunEventUpdateOne) ClearReportRun() *ReportRunEventUpdateOne {
+	_u.mutation.ClearReportRun()
+	return _u
+}
+
+// Where appends a list predicates to the ReportRunEventUpdate builder.
+func (_u *ReportRunEventUpdateOne) Where(ps ...predicate.ReportRunEvent) *ReportRunEventUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ReportRunEventUpdateOne) Select(field string, fields ...string) *ReportRunEventUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ReportRunEvent entity.
+func (_u *ReportRunEventUpdateOne) Save(ctx context.Context) (*ReportRunEvent, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ReportRunEventUpdateOne) SaveX(ctx context.Context) *ReportRunEvent {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ReportRunEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ReportRunEventUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ReportRunEventUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ReportRunEventUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ReportRunEventUpdateOne) sqlSave(ctx context.Context) (_node *ReportRunEvent, err error) {
+	_spec := sqlgraph.NewUpdateSpec(reportrunevent.Table, reportrunevent.Columns, sqlgraph.NewFieldSpec(reportrunevent.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ReportRunEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, reportrunevent.FieldID)
+		for _, f := range fields {
+			if !reportrunevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != reportrunevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Domain(); ok {
+		_spec.SetField(reportrunevent.FieldDomain, field.TypeString, value)
+	}
+	if _u.mutation.DomainCleared() {
+		_spec.ClearField(reportrunevent.FieldDomain, field.TypeString)
+	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(reportrunevent.FieldType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Message(); ok {
+		_spec.SetField(reportrunevent.FieldMessage, field.TypeString, value)
+	}
+	if _u.mutation.MessageCleared() {
+		_spec.ClearField(reportrunevent.FieldMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.Data(); ok {
+		_spec.SetField(reportrunevent.FieldData, field.TypeJSON, value)
+	}
+	if _u.mutation.DataCleared() {
+		_spec.ClearField(reportrunevent.FieldData, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(reportrunevent.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReportRunCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reportrunevent.ReportRunTable,
+			Columns: []string{reportrunevent.ReportRunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reportrun.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReportRunIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reportrunevent.ReportRunTable,
+			Columns: []string{reportrunevent.ReportRunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reportrun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &ReportRunEvent{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reportrunevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -5,9 +5,18 @@ package ent
 import (
 	"time"
 
+	"github.com/iWorld-y/domain_radar/app/common/ent/analysislens"
+	"github.com/iWorld-y/domain_radar/app/common/ent/analysissection"
+	"github.com/iWorld-y/domain_radar/app/common/ent/claimverification"
 	"github.com/iWorld-y/domain_radar/app/common/ent/deepanalysisresult"
 	"github.com/iWorld-y/domain_radar/app/common/ent/domainreport"
+	"github.com/iWorld-y/domain_radar/app/common/ent/domainrun"
+	"github.com/iWorld-y/domain_radar/app/common/ent/entity"
+	"github.com/iWorld-y/domain_radar/app/common/ent/llmcache"
+	"github.com/iWorld-y/domain_radar/app/common/ent/llmcall"
+	"github.com/iWorld-y/domain_radar/app/common/ent/persona"
 	"github.com/iWorld-y/domain_radar/app/common/ent/reportrun"
+	"github.com/iWorld-y/domain_radar/app/common/ent/reportrunevent"
 	"github.com/iWorld-y/domain_radar/app/common/ent/schema"
 	"github.com/iWorld-y/domain_radar/app/common/ent/user"
 )
@@ -16,18 +25,126 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	analysislensFields := schema.AnalysisLens{}.Fields()
+	_ = analysislensFields
+	// analysislensDescPosition is the schema descriptor for position field.
+	analysislensDescPosition := analysislensFields[4].Descriptor()
+	// analysislens.DefaultPosition holds the default value on creation for the position field.
+	analysislens.DefaultPosition = analysislensDescPosition.Default.(int)
+	// analysislensDescCreatedAt is the schema descriptor for created_at field.
+	analysislensDescCreatedAt := analysislensFields[5].Descriptor()
+	// analysislens.DefaultCreatedAt holds the default value on creation for the created_at field.
+	analysislens.DefaultCreatedAt = analysislensDescCreatedAt.Default.(func() time.Time)
+	analysissectionFields := schema.AnalysisSection{}.Fields()
+	_ = analysissectionFields
+	// analysissectionDescPosition is the schema descriptor for position field.
+	analysissectionDescPosition := analysissectionFields[4].Descriptor()
+	// analysissection.DefaultPosition holds the default value on creation for the position field.
+	analysissection.DefaultPosition = analysissectionDescPosition.Default.(int)
+	claimverificationFields := schema.ClaimVerification{}.Fields()
+	_ = claimverificationFields
+	// claimverificationDescDropped is the schema descriptor for dropped field.
+	claimverificationDescDropped := claimverificationFields[6].Descriptor()
+	// claimverification.DefaultDropped holds the default value on creation for the dropped field.
+	claimverification.DefaultDropped = claimverificationDescDropped.Default.(bool)
+	// claimverificationDescCreatedAt is the schema descriptor for created_at field.
+	claimverificationDescCreatedAt := claimverificationFields[7].Descriptor()
+	// claimverification.DefaultCreatedAt holds the default value on creation for the created_at field.
+	claimverification.DefaultCreatedAt = claimverificationDescCreatedAt.Default.(func() time.Time)
 	deepanalysisresultFields := schema.DeepAnalysisResult{}.Fields()
 	_ = deepanalysisresultFields
 	// deepanalysisresultDescCreatedAt is the schema descriptor for created_at field.
-	deepanalysisresultDescCreatedAt := deepanalysisresultFields[6].Descriptor()
+	deepanalysisresultDescCreatedAt := deepanalysisresultFields[8].Descriptor()
 	// deepanalysisresult.DefaultCreatedAt holds the default value on creation for the created_at field.
 	deepanalysisresult.DefaultCreatedAt = deepanalysisresultDescCreatedAt.Default.(func() time.Time)
 	domainreportFields := schema.DomainReport{}.Fields()
 	_ = domainreportFields
 	// domainreportDescCreatedAt is the schema descriptor for created_at field.
-	domainreportDescCreatedAt := domainreportFields[6].Descriptor()
+	domainreportDescCreatedAt := domainreportFields[16].Descriptor()
 	// domainreport.DefaultCreatedAt holds the default value on creation for the created_at field.
 	domainreport.DefaultCreatedAt = domainreportDescCreatedAt.Default.(func() time.Time)
+	domainrunFields := schema.DomainRun{}.Fields()
+	_ = domainrunFields
+	// domainrunDescArticleCount is the schema descriptor for article_count field.
+	domainrunDescArticleCount := domainrunFields[5].Descriptor()
+	// domainrun.DefaultArticleCount holds the default value on creation for the article_count field.
+	domainrun.DefaultArticleCount = domainrunDescArticleCount.Default.(int)
+	// domainrunDescCreatedAt is the schema descriptor for created_at field.
+	domainrunDescCreatedAt := domainrunFields[6].Descriptor()
+	// domainrun.DefaultCreatedAt holds the default value on creation for the created_at field.
+	domainrun.DefaultCreatedAt = domainrunDescCreatedAt.Default.(func() time.Time)
+	entityFields := schema.Entity{}.Fields()
+	_ = entityFields
+	// entityDescCreatedAt is the schema descriptor for created_at field.
+	entityDescCreatedAt := entityFields[4].Descriptor()
+	// entity.DefaultCreatedAt holds the default value on creation for the created_at field.
+	entity.DefaultCreatedAt = entityDescCreatedAt.Default.(func() time.Time)
+	llmcacheFields := schema.LLMCache{}.Fields()
+	_ = llmcacheFields
+	// llmcacheDescPromptTokens is the schema descriptor for prompt_tokens field.
+	llmcacheDescPromptTokens := llmcacheFields[5].Descriptor()
+	// llmcache.DefaultPromptTokens holds the default value on creation for the prompt_tokens field.
+	llmcache.DefaultPromptTokens = llmcacheDescPromptTokens.Default.(int)
+	// llmcacheDescCompletionTokens is the schema descriptor for completion_tokens field.
+	llmcacheDescCompletionTokens := llmcacheFields[6].Descriptor()
+	// llmcache.DefaultCompletionTokens holds the default value on creation for the completion_tokens field.
+	llmcache.DefaultCompletionTokens = llmcacheDescCompletionTokens.Default.(int)
+	// llmcacheDescHits is the schema descriptor for hits field.
+	llmcacheDescHits := llmcacheFields[7].Descriptor()
+	// llmcache.DefaultHits holds the default value on creation for the hits field.
+	llmcache.DefaultHits = llmcacheDescHits.Default.(int)
+	// llmcacheDescCreatedAt is the schema descriptor for created_at field.
+	llmcacheDescCreatedAt := llmcacheFields[9].Descriptor()
+	// llmcache.DefaultCreatedAt holds the default value on creation for the created_at field.
+	llmcache.DefaultCreatedAt = llmcacheDescCreatedAt.Default.(func() time.Time)
+	llmcallFields := schema.LLMCall{}.Fields()
+	_ = llmcallFields
+	// llmcallDescPromptTokens is the schema descriptor for prompt_tokens field.
+	llmcallDescPromptTokens := llmcallFields[6].Descriptor()
+	// llmcall.DefaultPromptTokens holds the default value on creation for the prompt_tokens field.
+	llmcall.DefaultPromptTokens = llmcallDescPromptTokens.Default.(int)
+	// llmcallDescCompletionTokens is the schema descriptor for completion_tokens field.
+	llmcallDescCompletionTokens := llmcallFields[7].Descriptor()
+	// llmcall.DefaultCompletionTokens holds the default value on creation for the completion_tokens field.
+	llmcall.DefaultCompletionTokens = llmcallDescCompletionTokens.Default.(int)
+	// llmcallDescTotalTokens is the schema descriptor for total_tokens field.
+	llmcallDescTotalTokens := llmcallFields[8].Descriptor()
+	// llmcall.DefaultTotalTokens holds the default value on creation for the total_tokens field.
+	llmcall.DefaultTotalTokens = llmcallDescTotalTokens.Default.(int)
+	// llmcallDescCost is the schema descriptor for cost field.
+	llmcallDescCost := llmcallFields[9].Descriptor()
+	// llmcall.DefaultCost holds the default value on creation for the cost field.
+	llmcall.DefaultCost = llmcallDescCost.Default.(float64)
+	// llmcallDescLatencyMs is the schema descriptor for latency_ms field.
+	llmcallDescLatencyMs := llmcallFields[10].Descriptor()
+	// llmcall.DefaultLatencyMs holds the default value on creation for the latency_ms field.
+	llmcall.DefaultLatencyMs = llmcallDescLatencyMs.Default.(int64)
+	// llmcallDescSuccess is the schema descriptor for success field.
+	llmcallDescSuccess := llmcallFields[11].Descriptor()
+	// llmcall.DefaultSuccess holds the default value on creation for the success field.
+	llmcall.DefaultSuccess = llmcallDescSuccess.Default.(bool)
+	// llmcallDescCreatedAt is the schema descriptor for created_at field.
+	llmcallDescCreatedAt := llmcallFields[13].Descriptor()
+	// llmcall.DefaultCreatedAt holds the default value on creation for the created_at field.
+	llmcall.DefaultCreatedAt = llmcallDescCreatedAt.Default.(func() time.Time)
+	personaFields := schema.Persona{}.Fields()
+	_ = personaFields
+	// personaDescName is the schema descriptor for name field.
+	personaDescName := personaFields[2].Descriptor()
+	// persona.DefaultName holds the default value on creation for the name field.
+	persona.DefaultName = personaDescName.Default.(string)
+	// personaDescSource is the schema descriptor for source field.
+	personaDescSource := personaFields[11].Descriptor()
+	// persona.DefaultSource holds the default value on creation for the source field.
+	persona.DefaultSource = personaDescSource.Default.(string)
+	// personaDescArchived is the schema descriptor for archived field.
+	personaDescArchived := personaFields[12].Descriptor()
+	// persona.DefaultArchived holds the default value on creation for the archived field.
+	persona.DefaultArchived = personaDescArchived.Default.(bool)
+	// personaDescCreatedAt is the schema descriptor for created_at field.
+	personaDescCreatedAt := personaFields[13].Descriptor()
+	// persona.DefaultCreatedAt holds the default value on creation for the created_at field.
+	persona.DefaultCreatedAt = personaDescCreatedAt.Default.(func() time.Time)
 	reportrunFields := schema.ReportRun{}.Fields()
 	_ = reportrunFields
 	// reportrunDescCreatedAt is the schema descriptor for created_at field.
@@ -38,10 +155,24 @@ func init() {
 	reportrunDescTitle := reportrunFields[2].Descriptor()
 	// reportrun.DefaultTitle holds the default value on creation for the title field.
 	reportrun.DefaultTitle = reportrunDescTitle.Default.(string)
+	// reportrunDescStatus is the schema descriptor for status field.
+	reportrunDescStatus := reportrunFields[6].Descriptor()
+	// reportrun.DefaultStatus holds the default value on creation for the status field.
+	reportrun.DefaultStatus = reportrunDescStatus.Default.(string)
+	reportruneventFields := schema.ReportRunEvent{}.Fields()
+	_ = reportruneventFields
+	// reportruneventDescCreatedAt is the schema descriptor for created_at field.
+	reportruneventDescCreatedAt := reportruneventFields[6].Descriptor()
+	// reportrunevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	reportrunevent.DefaultCreatedAt = reportruneventDescCreatedAt.Default.(func() time.Time)
 	userFields := schema.User{}.Fields()
 	_ = userFields
+	// userDescReportLanguage is the schema descriptor for report_language field.
+	userDescReportLanguage := userFields[7].Descriptor()
+	// user.DefaultReportLanguage holds the default value on creation for the report_language field.
+	user.DefaultReportLanguage = userDescReportLanguage.Default.(string)
 	// userDescCreatedAt is the schema descriptor for created_at field.
-	userDescCreatedAt := userFields[5].Descriptor()
+	userDescCreatedAt := userFields[8].Descriptor()
 	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
 	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
 }
@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AnalysisLens holds the schema definition for the AnalysisLens entity.
+type AnalysisLens struct {
+	ent.Schema
+}
+
+// Fields of the AnalysisLens.
+func (AnalysisLens) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").SchemaType(map[string]string{
+			dialect.Postgres: "serial",
+		}),
+		field.Int("user_id"),
+		field.String("name").Comment("Section title shown in the report, e.g. competitor moves"),
+		field.Text("instruction").Comment("What the deep analysis should write in this section"),
+		field.Int("position").Default(0).Comment("Display order of the section, ascending"),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+// Edges of the AnalysisLens.
+func (AnalysisLens) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("analysis_lenses").
+			Field("user_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the AnalysisLens.
+func (AnalysisLens) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "name").Unique(),
+	}
+}
@@ -0,0 +1,36 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// AnalysisSection holds the schema definition for the AnalysisSection entity.
+type AnalysisSection struct {
+	ent.Schema
+}
+
+// Fields of the AnalysisSection.
+func (AnalysisSection) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").SchemaType(map[string]string{
+			dialect.Postgres: "serial",
+		}),
+		field.Int("deep_analysis_id").Optional(),
+		field.String("name").Comment("Name of the user-defined lens the section was written for"),
+		field.Text("content").Optional().Comment("Section body in Markdown"),
+		field.Int("position").Default(0).Comment("Display order within the deep analysis, ascending"),
+	}
+}
+
+// Edges of the AnalysisSection.
+func (AnalysisSection) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("deep_analysis_result", DeepAnalysisResult.Type).
+			Ref("sections").
+			Field("deep_analysis_id").
+			Unique(),
+	}
+}
@@ -24,6 +24,7 @@ func (Article) Fields() []ent.Field {
 		field.String("source").Optional(),
 		field.String("pub_date").Optional(),
 		field.String("content").Optional(),
+		field.Int("ref_index").Optional().Comment("Citation index within the domain report, starting from 1"),
 	}
 }
 
@@ -34,5 +35,8 @@ func (Article) Edges() []ent.Edge {
 			Ref("articles").
 			Field("domain_report_id").
 			Unique(),
+		edge.From("key_events", KeyEvent.Type).
+			Ref("articles"),
+		edge.To("article_entities", ArticleEntity.Type),
 	}
 }
@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ArticleEntity holds the schema definition for the ArticleEntity entity.
+type ArticleEntity struct {
+	ent.Schema
+}
+
+// Fields of the ArticleEntity.
+func (ArticleEntity) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").SchemaType(map[string]string{
+			dialect.Postgres: "serial",
+		}),
+		field.Int("article_id"),
+		field.Int("entity_id"),
+		field.String("mention").Optional().Comment("Semicolon separated surface forms of the entity before normalization"),
+	}
+}
+
+// Edges of the ArticleEntity.
+func (ArticleEntity) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("article", Article.Type).
+			Ref("article_entities").
+			Field("article_id").
+			Unique().
+			Required(),
+		edge.From("entity", Entity.Type).
+			Ref("article_entities").
+			Field("entity_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the ArticleEntity.
+func (ArticleEntity) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("article_id", "entity_id").Unique(),
+	}
+}
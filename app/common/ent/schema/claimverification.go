@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// ClaimVerification holds the schema definition for the ClaimVerification entity.
+type ClaimVerification struct {
+	ent.Schema
+}
+
+// Fields of the ClaimVerification.
+func (ClaimVerification) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").SchemaType(map[string]string{
+			dialect.Postgres: "serial",
+		}),
+		field.Int("domain_report_id").Optional(),
+		field.String("claim_type").Comment("key_event or trend"),
+		field.String("claim").Optional(),
+		field.String("verdict").Comment("supported, partially_supported, unsupported or unverified"),
+		field.String("reason").Optional(),
+		field.Bool("dropped").Default(false).Comment("Whether the claim was removed from the report"),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+// Edges of the ClaimVerification.
+func (ClaimVerification) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("domain_report", DomainReport.Type).
+			Ref("claim_verifications").
+			Field("domain_report_id").
+			Unique(),
+	}
+}
@@ -22,6 +22,8 @@ func (DeepAnalysisResult) Fields() []ent.Field {
 		}),
 		field.Int("run_id").Optional(),
 		field.Int("user_id").Optional(),
+		field.Int("persona_id").Optional().Comment("Persona version the analysis was written for, empty for the free-text persona"),
+		field.String("persona_name").Optional().Comment("Name of the persona at the time of the run"),
 		field.String("macro_trends").Optional(),
 		field.String("opportunities").Optional(),
 		field.String("risks").Optional(),
@@ -37,5 +39,10 @@ func (DeepAnalysisResult) Edges() []ent.Edge {
 			Field("run_id").
 			Unique(),
 		edge.To("action_guides", ActionGuide.Type),
+		edge.To("sections", AnalysisSection.Type),
+		edge.From("persona", Persona.Type).
+			Ref("deep_analysis_results").
+			Field("persona_id").
+			Unique(),
 	}
 }
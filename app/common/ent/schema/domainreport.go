@@ -7,6 +7,7 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 // DomainReport holds the schema definition for the DomainReport entity.
@@ -20,11 +21,21 @@ func (DomainReport) Fields() []ent.Field {
 		field.Int("id").SchemaType(map[string]string{
 			dialect.Postgres: "serial",
 		}),
-		field.Int("run_id").Optional(),
+		field.Int("run_id").Optional().Comment("Run that generated the report; runs sharing it are linked through report_runs"),
+		field.String("share_key").Optional().Comment("Hash of the normalized domain config, search window, report language and pipeline stages; reports with the same key can be shared across runs"),
 		field.String("domain_name"),
 		field.String("overview").Optional(),
 		field.String("trends").Optional(),
-		field.Int("score").Optional(),
+		field.Int("score").Optional().Comment("Heat score from 1 to 10, calibrated against the domain's history"),
+		field.Int("llm_score").Optional().Comment("Significance score from 1 to 10 judged by the LLM"),
+		field.Int("result_count").Optional().Comment("Number of search results found for the domain"),
+		field.Float("heat_baseline").Optional().Comment("Average result count of the domain's recent reports used as the volume baseline"),
+		field.Float("heat_volume").Optional().Comment("Article volume relative to the domain's baseline, from 0 to 1"),
+		field.Float("heat_diversity").Optional().Comment("Share of distinct sources among the results, from 0 to 1"),
+		field.Float("heat_recency").Optional().Nillable().Comment("Average freshness of the results, from 0 to 1, empty when no publish dates are known"),
+		field.Float("heat_engagement").Optional().Nillable().Comment("Community engagement of the results, from 0 to 1, empty when the search provider reports none"),
+		field.Float("heat_significance").Optional().Comment("LLM-judged significance, from 0 to 1"),
+		field.Float("heat_raw").Optional().Nillable().Comment("Weighted sum of the available components before calibration, from 0 to 1"),
 		field.Time("created_at").Default(time.Now),
 	}
 }
@@ -32,11 +43,21 @@ func (DomainReport) Fields() []ent.Field {
 // Edges of the DomainReport.
 func (DomainReport) Edges() []ent.Edge {
 	return []ent.Edge{
-		edge.From("report_run", ReportRun.Type).
-			Ref("domain_reports").
+		edge.From("source_run", ReportRun.Type).
+			Ref("generated_domain_reports").
 			Field("run_id").
 			Unique(),
+		edge.From("report_runs", ReportRun.Type).
+			Ref("domain_reports"),
 		edge.To("articles", Article.Type),
 		edge.To("key_events", KeyEvent.Type),
+		edge.To("claim_verifications", ClaimVerification.Type),
+	}
+}
+
+// Indexes of the DomainReport.
+func (DomainReport) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("share_key", "created_at"),
 	}
 }
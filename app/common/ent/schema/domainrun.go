@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// DomainRun holds the schema definition for the DomainRun entity.
+type DomainRun struct {
+	ent.Schema
+}
+
+// Fields of the DomainRun.
+func (DomainRun) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").SchemaType(map[string]string{
+			dialect.Postgres: "serial",
+		}),
+		field.Int("run_id").Optional(),
+		field.String("domain_name"),
+		field.String("status").Comment("Outcome of the domain: succeeded, no_articles, search_failed, llm_failed, failed or skipped"),
+		field.Text("error").Optional().Comment("Error details when the domain did not succeed"),
+		field.Int("article_count").Default(0).Comment("Number of articles collected for the domain"),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+// Edges of the DomainRun.
+func (DomainRun) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("report_run", ReportRun.Type).
+			Ref("domain_runs").
+			Field("run_id").
+			Unique(),
+	}
+}
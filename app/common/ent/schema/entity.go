@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Entity holds the schema definition for the Entity entity.
+type Entity struct {
+	ent.Schema
+}
+
+// Fields of the Entity.
+func (Entity) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").SchemaType(map[string]string{
+			dialect.Postgres: "serial",
+		}),
+		field.String("name").Comment("Canonical display name, e.g. OpenAI"),
+		field.String("type").Comment("Entity type: company, person, product, project or organization"),
+		field.String("key").Comment("Normalized name used for deduplication"),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+// Edges of the Entity.
+func (Entity) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("article_entities", ArticleEntity.Type),
+	}
+}
+
+// Indexes of the Entity.
+func (Entity) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("key", "type").Unique(),
+	}
+}
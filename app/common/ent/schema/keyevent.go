@@ -20,6 +20,7 @@ func (KeyEvent) Fields() []ent.Field {
 		}),
 		field.Int("domain_report_id").Optional(),
 		field.String("event_content").Optional(),
+		field.String("verdict").Optional().Comment("Faithfulness verdict, empty when not verified"),
 	}
 }
 
@@ -30,5 +31,6 @@ func (KeyEvent) Edges() []ent.Edge {
 			Ref("key_events").
 			Field("domain_report_id").
 			Unique(),
+		edge.To("articles", Article.Type),
 	}
 }
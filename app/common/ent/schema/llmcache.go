@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// LLMCache holds the schema definition for the LLMCache entity.
+type LLMCache struct {
+	ent.Schema
+}
+
+// Fields of the LLMCache.
+func (LLMCache) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").SchemaType(map[string]string{
+			dialect.Postgres: "serial",
+		}),
+		field.String("key").Unique().Comment("sha256 of model, prompt template version and rendered messages"),
+		field.String("model"),
+		field.String("template_version").Comment("Prompt template version, e.g. domain_report/v2"),
+		field.Text("content").Comment("Cached completion"),
+		field.Int("prompt_tokens").Default(0),
+		field.Int("completion_tokens").Default(0),
+		field.Int("hits").Default(0),
+		field.Time("expires_at"),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+// Indexes of the LLMCache.
+func (LLMCache) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("expires_at"),
+	}
+}
@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// LLMCall holds the schema definition for the LLMCall entity.
+type LLMCall struct {
+	ent.Schema
+}
+
+// Fields of the LLMCall.
+func (LLMCall) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").SchemaType(map[string]string{
+			dialect.Postgres: "serial",
+		}),
+		field.Int("run_id").Optional(),
+		field.Int("user_id").Optional(),
+		field.String("domain").Optional(),
+		field.String("stage").Optional().Comment("Pipeline stage, e.g. domain_report, verification, deep_analysis"),
+		field.String("model"),
+		field.Int("prompt_tokens").Default(0),
+		field.Int("completion_tokens").Default(0),
+		field.Int("total_tokens").Default(0),
+		field.Float("cost").Default(0).Comment("Cost computed from the configured price table"),
+		field.Int64("latency_ms").Default(0),
+		field.Bool("success").Default(true),
+		field.String("error").Optional(),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+// Edges of the LLMCall.
+func (LLMCall) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("report_run", ReportRun.Type).
+			Ref("llm_calls").
+			Field("run_id").
+			Unique(),
+	}
+}
+
+// Indexes of the LLMCall.
+func (LLMCall) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "created_at"),
+	}
+}
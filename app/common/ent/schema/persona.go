@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Persona holds the schema definition for the Persona entity.
+type Persona struct {
+	ent.Schema
+}
+
+// Fields of the Persona.
+func (Persona) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").SchemaType(map[string]string{
+			dialect.Postgres: "serial",
+		}),
+		field.Int("user_id"),
+		field.String("name").Default("default").Comment("Persona name, unique per user, e.g. tech lead or angel investor"),
+		field.Int("version").Comment("Version number per user and name, starting from 1; the highest version is current"),
+		field.String("role").Optional(),
+		field.String("seniority").Optional(),
+		field.JSON("tech_stack", []string{}).Optional(),
+		field.JSON("goals", []string{}).Optional(),
+		field.String("risk_appetite").Optional().Comment("Risk appetite: low, medium or high"),
+		field.String("time_horizon").Optional(),
+		field.JSON("constraints", []string{}).Optional(),
+		field.String("source").Default("manual").Comment("How the persona was produced: manual or interview"),
+		field.Bool("archived").Default(false).Comment("Archived personas are hidden but kept for past deep analyses"),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+// Edges of the Persona.
+func (Persona) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("personas").
+			Field("user_id").
+			Unique().
+			Required(),
+		edge.To("deep_analysis_results", DeepAnalysisResult.Type),
+	}
+}
+
+// Indexes of the Persona.
+func (Persona) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "name", "version").Unique(),
+	}
+}
@@ -22,13 +22,21 @@ func (ReportRun) Fields() []ent.Field {
 		}),
 		field.Time("created_at").Default(time.Now),
 		field.String("title").Default("Daily Report").Optional(),
+		field.Int("user_id").Optional().Comment("Owner of the run, empty for runs not triggered by a user"),
+		field.Time("window_start").Optional().Nillable().Comment("Start of the news search window, empty for runs created before windows were recorded"),
+		field.Time("window_end").Optional().Nillable().Comment("End of the news search window"),
+		field.String("status").Default("completed").Comment("Run status: running, completed, failed, cancelled or budget_exceeded; runs created before statuses were tracked count as completed"),
 	}
 }
 
 // Edges of the ReportRun.
 func (ReportRun) Edges() []ent.Edge {
 	return []ent.Edge{
-		edge.To("domain_reports", DomainReport.Type),
+		edge.To("domain_reports", DomainReport.Type).Comment("Domain reports included in the run, either generated by it or shared from another run"),
+		edge.To("generated_domain_reports", DomainReport.Type).Comment("Domain reports generated by the run"),
 		edge.To("deep_analysis_results", DeepAnalysisResult.Type),
+		edge.To("llm_calls", LLMCall.Type),
+		edge.To("domain_runs", DomainRun.Type),
+		edge.To("events", ReportRunEvent.Type).Comment("Pipeline events of the run in chronological order"),
 	}
 }
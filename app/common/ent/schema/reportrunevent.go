@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ReportRunEvent holds the schema definition for the ReportRunEvent entity.
+type ReportRunEvent struct {
+	ent.Schema
+}
+
+// Fields of the ReportRunEvent.
+func (ReportRunEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").SchemaType(map[string]string{
+			dialect.Postgres: "serial",
+		}),
+		field.Int("run_id").Optional(),
+		field.String("domain").Optional().Comment("Domain the event belongs to, empty for run-level events"),
+		field.String("type").Comment("Event type, e.g. search_issued, article_rejected, llm_call_finished, report_saved"),
+		field.Text("message").Optional().Comment("Human readable details such as a query, URL or error"),
+		field.JSON("data", map[string]any{}).Optional().Comment("Structured details such as result counts, token usage or latency"),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+// Edges of the ReportRunEvent.
+func (ReportRunEvent) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("report_run", ReportRun.Type).
+			Ref("events").
+			Field("run_id").
+			Unique(),
+	}
+}
+
+// Indexes of the ReportRunEvent.
+func (ReportRunEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("run_id", "created_at"),
+	}
+}
@@ -5,7 +5,10 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+
+	"github.com/iWorld-y/domain_radar/app/domain_radar/pkg/model"
 )
 
 // User holds the schema definition for the User entity.
@@ -21,13 +24,19 @@ func (User) Fields() []ent.Field {
 		}),
 		field.String("username").Unique(),
 		field.String("password_hash"),
-		field.String("persona").Optional().Comment("User persona for deep analysis"),
-		field.JSON("domains", []string{}).Optional().Comment("User interested domains"),
+		field.String("persona").Optional().Comment("User persona for deep analysis, rendered from the latest structured persona when one exists"),
+		field.JSON("domains", []model.DomainConfig{}).Optional().Comment("User interested domains; legacy rows store plain domain names"),
+		field.Int("max_tokens_per_run").Optional().Comment("Per-run token budget, 0 falls back to the deployment default"),
+		field.Float("max_cost_per_run").Optional().Comment("Per-run cost budget, 0 falls back to the deployment default"),
+		field.String("report_language").Default("zh").Comment("Language of generated reports: zh or en"),
 		field.Time("created_at").Default(time.Now),
 	}
 }
 
 // Edges of the User.
 func (User) Edges() []ent.Edge {
-	return nil
+	return []ent.Edge{
+		edge.To("personas", Persona.Type),
+		edge.To("analysis_lenses", AnalysisLens.Type),
+	}
 }
@@ -14,16 +14,36 @@ type Tx struct {
 	config
 	// ActionGuide is the client for interacting with the ActionGuide builders.
 	ActionGuide *ActionGuideClient
+	// AnalysisLens is the client for interacting with the AnalysisLens builders.
+	AnalysisLens *AnalysisLensClient
+	// AnalysisSection is the client for interacting with the AnalysisSection builders.
+	AnalysisSection *AnalysisSectionClient
 	// Article is the client for interacting with the Article builders.
 	Article *ArticleClient
+	// ArticleEntity is the client for interacting with the ArticleEntity builders.
+	ArticleEntity *ArticleEntityClient
+	// ClaimVerification is the client for interacting with the ClaimVerification builders.
+	ClaimVerification *ClaimVerificationClient
 	// DeepAnalysisResult is the client for interacting with the DeepAnalysisResult builders.
 	DeepAnalysisResult *DeepAnalysisResultClient
 	// DomainReport is the client for interacting with the DomainReport builders.
 	DomainReport *DomainReportClient
+	// DomainRun is the client for interacting with the DomainRun builders.
+	DomainRun *DomainRunClient
+	// Entity is the client for interacting with the Entity builders.
+	Entity *EntityClient
 	// KeyEvent is the client for interacting with the KeyEvent builders.
 	KeyEvent *KeyEventClient
+	// LLMCache is the client for interacting with the LLMCache builders.
+	LLMCache *LLMCacheClient
+	// LLMCall is the client for interacting with the LLMCall builders.
+	LLMCall *LLMCallClient
+	// Persona is the client for interacting with the Persona builders.
+	Persona *PersonaClient
 	// ReportRun is the client for interacting with the ReportRun builders.
 	ReportRun *ReportRunClient
+	// ReportRunEvent is the client for interacting with the ReportRunEvent builders.
+	ReportRunEvent *ReportRunEventClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 
@@ -158,11 +178,21 @@ func (tx *Tx) Client() *Client {
 
 func (tx *Tx) init() {
 	tx.ActionGuide = NewActionGuideClient(tx.config)
+	tx.AnalysisLens = NewAnalysisLensClient(tx.config)
+	tx.AnalysisSection = NewAnalysisSectionClient(tx.config)
 	tx.Article = NewArticleClient(tx.config)
+	tx.ArticleEntity = NewArticleEntityClient(tx.config)
+	tx.ClaimVerification = NewClaimVerificationClient(tx.config)
 	tx.DeepAnalysisResult = NewDeepAnalysisResultClient(tx.config)
 	tx.DomainReport = NewDomainReportClient(tx.config)
+	tx.DomainRun = NewDomainRunClient(tx.config)
+	tx.Entity = NewEntityClient(tx.config)
 	tx.KeyEvent = NewKeyEventClient(tx.config)
+	tx.LLMCache = NewLLMCacheClient(tx.config)
+	tx.LLMCall = NewLLMCallClient(tx.config)
+	tx.Persona = NewPersonaClient(tx.config)
 	tx.ReportRun = NewReportRunClient(tx.config)
+	tx.ReportRunEvent = NewReportRunEventClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 }
 
@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/iWorld-y/domain_radar/app/common/ent/user"
+	"github.com/iWorld-y/domain_radar/app/domain_radar/pkg/model"
 )
 
 // User is the model entity for the User schema.
@@ -22,15 +23,53 @@ type User struct {
 	Username string `json:"username,omitempty"`
 	// PasswordHash holds the value of the "password_hash" field.
 	PasswordHash string `json:"password_hash,omitempty"`
-	// User persona for deep analysis
+	// User persona for deep analysis, rendered from the latest structured persona when one exists
 	Persona string `json:"persona,omitempty"`
-	// User interested domains
-	Domains []string `json:"domains,omitempty"`
+	// User interested domains; legacy rows store plain domain names
+	Domains []model.DomainConfig `json:"domains,omitempty"`
+	// Per-run token budget, 0 falls back to the deployment default
+	MaxTokensPerRun int `json:"max_tokens_per_run,omitempty"`
+	// Per-run cost budget, 0 falls back to the deployment default
+	MaxCostPerRun float64 `json:"max_cost_per_run,omitempty"`
+	// Language of generated reports: zh or en
+	ReportLanguage string `json:"report_language,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
-	CreatedAt    time.Time `json:"created_at,omitempty"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the UserQuery when eager-loading is set.
+	Edges        UserEdges `json:"edges"`
 	selectValues sql.SelectValues
 }
 
+// UserEdges holds the relations/edges for other nodes in the graph.
+type UserEdges struct {
+	// Personas holds the value of the personas edge.
+	Personas []*Persona `json:"personas,omitempty"`
+	// AnalysisLenses holds the value of the analysis_lenses edge.
+	AnalysisLenses []*AnalysisLens `json:"analysis_lenses,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// PersonasOrErr returns the Personas value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) PersonasOrErr() ([]*Persona, error) {
+	if e.loadedTypes[0] {
+		return e.Personas, nil
+	}
+	return nil, &NotLoadedError{edge: "personas"}
+}
+
+// AnalysisLensesOrErr returns the AnalysisLenses value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) AnalysisLensesOrErr() ([]*AnalysisLens, error) {
+	if e.loadedTypes[1] {
+		return e.AnalysisLenses, nil
+	}
+	return nil, &NotLoadedError{edge: "analysis_lenses"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -38,9 +77,11 @@ func (*User) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case user.FieldDomains:
 			values[i] = new([]byte)
-		case user.FieldID:
+		case user.FieldMaxCostPerRun:
+			values[i] = new(sql.NullFloat64)
+		case user.FieldID, user.FieldMaxTokensPerRun:
 			values[i] = new(sql.NullInt64)
-		case user.FieldUsername, user.FieldPasswordHash, user.FieldPersona:
+		case user.FieldUsername, user.FieldPasswordHash, user.FieldPersona, user.FieldReportLanguage:
 			values[i] = new(sql.NullString)
 		case user.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
@@ -91,6 +132,24 @@ func (_m *User) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field domains: %w", err)
 				}
 			}
+		case user.FieldMaxTokensPerRun:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_tokens_per_run", values[i])
+			} else if value.Valid {
+				_m.MaxTokensPerRun = int(value.Int64)
+			}
+		case user.FieldMaxCostPerRun:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_cost_per_run", values[i])
+			} else if value.Valid {
+				_m.MaxCostPerRun = value.Float64
+			}
+		case user.FieldReportLanguage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field report_language", values[i])
+			} else if value.Valid {
+				_m.ReportLanguage = value.String
+			}
 		case user.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -110,6 +169,16 @@ func (_m *User) Value(name string) (ent.Value, error) {
 	return _m.selectValues.Get(name)
 }
 
+// QueryPersonas queries the "personas" edge of the User entity.
+func (_m *User) QueryPersonas() *PersonaQuery {
+	return NewUserClient(_m.config).QueryPersonas(_m)
+}
+
+// QueryAnalysisLenses queries the "analysis_lenses" edge of the User entity.
+func (_m *User) QueryAnalysisLenses() *AnalysisLensQuery {
+	return NewUserClient(_m.config).QueryAnalysisLenses(_m)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -145,6 +214,15 @@ func (_m *User) String() string {
 	builder.WriteString("domains=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Domains))
 	builder.WriteString(", ")
+	builder.WriteString("max_tokens_per_run=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MaxTokensPerRun))
+	builder.WriteString(", ")
+	builder.WriteString("max_cost_per_run=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MaxCostPerRun))
+	builder.WriteString(", ")
+	builder.WriteString("report_language=")
+	builder.WriteString(_m.ReportLanguage)
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
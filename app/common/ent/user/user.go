@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 )
 
 const (
@@ -21,10 +22,34 @@ const (
 	FieldPersona = "persona"
 	// FieldDomains holds the string denoting the domains field in the database.
 	FieldDomains = "domains"
+	// FieldMaxTokensPerRun holds the string denoting the max_tokens_per_run field in the database.
+	FieldMaxTokensPerRun = "max_tokens_per_run"
+	// FieldMaxCostPerRun holds the string denoting the max_cost_per_run field in the database.
+	FieldMaxCostPerRun = "max_cost_per_run"
+	// FieldReportLanguage holds the string denoting the report_language field in the database.
+	FieldReportLanguage = "report_language"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
+	// EdgePersonas holds the string denoting the personas edge name in mutations.
+	EdgePersonas = "personas"
+	// EdgeAnalysisLenses holds the string denoting the analysis_lenses edge name in mutations.
+	EdgeAnalysisLenses = "analysis_lenses"
 	// Table holds the table name of the user in the database.
 	Table = "users"
+	// PersonasTable is the table that holds the personas relation/edge.
+	PersonasTable = "personas"
+	// PersonasInverseTable is the table name for the Persona entity.
+	// It exists in this package in order to avoid circular dependency with the "persona" package.
+	PersonasInverseTable = "personas"
+	// PersonasColumn is the table column denoting the personas relation/edge.
+	PersonasColumn = "user_id"
+	// AnalysisLensesTable is the table that holds the analysis_lenses relation/edge.
+	AnalysisLensesTable = "analysis_lens"
+	// AnalysisLensesInverseTable is the table name for the AnalysisLens entity.
+	// It exists in this package in order to avoid circular dependency with the "analysislens" package.
+	AnalysisLensesInverseTable = "analysis_lens"
+	// AnalysisLensesColumn is the table column denoting the analysis_lenses relation/edge.
+	AnalysisLensesColumn = "user_id"
 )
 
 // Columns holds all SQL columns for user fields.
@@ -34,6 +59,9 @@ var Columns = []string{
 	FieldPasswordHash,
 	FieldPersona,
 	FieldDomains,
+	FieldMaxTokensPerRun,
+	FieldMaxCostPerRun,
+	FieldReportLanguage,
 	FieldCreatedAt,
 }
 
@@ -48,6 +76,8 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultReportLanguage holds the default value on creation for the "report_language" field.
+	DefaultReportLanguage string
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 )
@@ -75,7 +105,64 @@ func ByPersona(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPersona, opts...).ToFunc()
 }
 
+// ByMaxTokensPerRun orders the results by the max_tokens_per_run field.
+func ByMaxTokensPerRun(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxTokensPerRun, opts...).ToFunc()
+}
+
+// ByMaxCostPerRun orders the results by the max_cost_per_run field.
+func ByMaxCostPerRun(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxCostPerRun, opts...).ToFunc()
+}
+
+// ByReportLanguage orders the results by the report_language field.
+func ByReportLanguage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReportLanguage, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
 }
+
+// ByPersonasCount orders the results by personas count.
+func ByPersonasCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPersonasStep(), opts...)
+	}
+}
+
+// ByPersonas orders the results by personas terms.
+func ByPersonas(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPersonasStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAnalysisLensesCount orders the results by analysis_lenses count.
+func ByAnalysisLensesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAnalysisLensesStep(), opts...)
+	}
+}
+
+// ByAnalysisLenses orders the results by analysis_lenses terms.
+func ByAnalysisLenses(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAnalysisLensesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newPersonasStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PersonasInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PersonasTable, PersonasColumn),
+	)
+}
+func newAnalysisLensesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AnalysisLensesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AnalysisLensesTable, AnalysisLensesColumn),
+	)
+}
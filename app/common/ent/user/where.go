@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/iWorld-y/domain_radar/app/common/ent/predicate"
 )
 
@@ -69,6 +70,21 @@ func Persona(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldPersona, v))
 }
 
+// MaxTokensPerRun applies equality check predicate on the "max_tokens_per_run" field. It's identical to MaxTokensPerRunEQ.
+func MaxTokensPerRun(v int) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldMaxTokensPerRun, v))
+}
+
+// MaxCostPerRun applies equality check predicate on the "max_cost_per_run" field. It's identical to MaxCostPerRunEQ.
+func MaxCostPerRun(v float64) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldMaxCostPerRun, v))
+}
+
+// ReportLanguage applies equality check predicate on the "report_language" field. It's identical to ReportLanguageEQ.
+func ReportLanguage(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldReportLanguage, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldCreatedAt, v))
@@ -289,6 +305,171 @@ func DomainsNotNil() predicate.User {
 	return predicate.User(sql.FieldNotNull(FieldDomains))
 }
 
+// MaxTokensPerRunEQ applies the EQ predicate on the "max_tokens_per_run" field.
+func MaxTokensPerRunEQ(v int) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldMaxTokensPerRun, v))
+}
+
+// MaxTokensPerRunNEQ applies the NEQ predicate on the "max_tokens_per_run" field.
+func MaxTokensPerRunNEQ(v int) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldMaxTokensPerRun, v))
+}
+
+// MaxTokensPerRunIn applies the In predicate on the "max_tokens_per_run" field.
+func MaxTokensPerRunIn(vs ...int) predicate.User {
+	return predicate.User(sql.FieldIn(FieldMaxTokensPerRun, vs...))
+}
+
+// MaxTokensPerRunNotIn applies the NotIn predicate on the "max_tokens_per_run" field.
+func MaxTokensPerRunNotIn(vs ...int) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldMaxTokensPerRun, vs...))
+}
+
+// MaxTokensPerRunGT applies the GT predicate on the "max_tokens_per_run" field.
+func MaxTokensPerRunGT(v int) predicate.User {
+	return predicate.User(sql.FieldGT(FieldMaxTokensPerRun, v))
+}
+
+// MaxTokensPerRunGTE applies the GTE predicate on the "max_tokens_per_run" field.
+func MaxTokensPerRunGTE(v int) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldMaxTokensPerRun, v))
+}
+
+// MaxTokensPerRunLT applies the LT predicate on the "max_tokens_per_run" field.
+func MaxTokensPerRunLT(v int) predicate.User {
+	return predicate.User(sql.FieldLT(FieldMaxTokensPerRun, v))
+}
+
+// MaxTokensPerRunLTE applies the LTE predicate on the "max_tokens_per_run" field.
+func MaxTokensPerRunLTE(v int) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldMaxTokensPerRun, v))
+}
+
+// MaxTokensPerRunIsNil applies the IsNil predicate on the "max_tokens_per_run" field.
+func MaxTokensPerRunIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldMaxTokensPerRun))
+}
+
+// MaxTokensPerRunNotNil applies the NotNil predicate on the "max_tokens_per_run" field.
+func MaxTokensPerRunNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldMaxTokensPerRun))
+}
+
+// MaxCostPerRunEQ applies the EQ predicate on the "max_cost_per_run" field.
+func MaxCostPerRunEQ(v float64) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldMaxCostPerRun, v))
+}
+
+// MaxCostPerRunNEQ applies the NEQ predicate on the "max_cost_per_run" field.
+func MaxCostPerRunNEQ(v float64) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldMaxCostPerRun, v))
+}
+
+// MaxCostPerRunIn applies the In predicate on the "max_cost_per_run" field.
+func MaxCostPerRunIn(vs ...float64) predicate.User {
+	return predicate.User(sql.FieldIn(FieldMaxCostPerRun, vs...))
+}
+
+// MaxCostPerRunNotIn applies the NotIn predicate on the "max_cost_per_run" field.
+func MaxCostPerRunNotIn(vs ...float64) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldMaxCostPerRun, vs...))
+}
+
+// MaxCostPerRunGT applies the GT predicate on the "max_cost_per_run" field.
+func MaxCostPerRunGT(v float64) predicate.User {
+	return predicate.User(sql.FieldGT(FieldMaxCostPerRun, v))
+}
+
+// MaxCostPerRunGTE applies the GTE predicate on the "max_cost_per_run" field.
+func MaxCostPerRunGTE(v float64) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldMaxCostPerRun, v))
+}
+
+// MaxCostPerRunLT applies the LT predicate on the "max_cost_per_run" field.
+func MaxCostPerRunLT(v float64) predicate.User {
+	return predicate.User(sql.FieldLT(FieldMaxCostPerRun, v))
+}
+
+// MaxCostPerRunLTE applies the LTE predicate on the "max_cost_per_run" field.
+func MaxCostPerRunLTE(v float64) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldMaxCostPerRun, v))
+}
+
+// MaxCostPerRunIsNil applies the IsNil predicate on the "max_cost_per_run" field.
+func MaxCostPerRunIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldMaxCostPerRun))
+}
+
+// MaxCostPerRunNotNil applies the NotNil predicate on the "max_cost_per_run" field.
+func MaxCostPerRunNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldMaxCostPerRun))
+}
+
+// ReportLanguageEQ applies the EQ predicate on the "report_language" field.
+func ReportLanguageEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldReportLanguage, v))
+}
+
+// ReportLanguageNEQ applies the NEQ predicate on the "report_language" field.
+func ReportLanguageNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldReportLanguage, v))
+}
+
+// ReportLanguageIn applies the In predicate on the "report_language" field.
+func ReportLanguageIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldReportLanguage, vs...))
+}
+
+// ReportLanguageNotIn applies the NotIn predicate on the "report_language" field.
+func ReportLanguageNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldReportLanguage, vs...))
+}
+
+// ReportLanguageGT applies the GT predicate on the "report_language" field.
+func ReportLanguageGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldReportLanguage, v))
+}
+
+// ReportLanguageGTE applies the GTE predicate on the "report_language" field.
+func ReportLanguageGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldReportLanguage, v))
+}
+
+// ReportLanguageLT applies the LT predicate on the "report_language" field.
+func ReportLanguageLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldReportLanguage, v))
+}
+
+// ReportLanguageLTE applies the LTE predicate on the "report_language" field.
+func ReportLanguageLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldReportLanguage, v))
+}
+
+// ReportLanguageContains applies the Contains predicate on the "report_language" field.
+func ReportLanguageContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldReportLanguage, v))
+}
+
+// ReportLanguageHasPrefix applies the HasPrefix predicate on the "report_language" field.
+func ReportLanguageHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldReportLanguage, v))
+}
+
+// ReportLanguageHasSuffix applies the HasSuffix predicate on the "report_language" field.
+func ReportLanguageHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldReportLanguage, v))
+}
+
+// ReportLanguageEqualFold applies the EqualFold predicate on the "report_language" field.
+func ReportLanguageEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldReportLanguage, v))
+}
+
+// ReportLanguageContainsFold applies the ContainsFold predicate on the "report_language" field.
+func ReportLanguageContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldReportLanguage, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldCreatedAt, v))
@@ -329,6 +510,52 @@ func CreatedAtLTE(v time.Time) predicate.User {
 	return predicate.User(sql.FieldLTE(FieldCreatedAt, v))
 }
 
+// HasPersonas applies the HasEdge predicate on the "personas" edge.
+func HasPersonas() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PersonasTable, PersonasColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPersonasWith applies the HasEdge predicate on the "personas" edge with a given conditions (other predicates).
+func HasPersonasWith(preds ...predicate.Persona) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newPersonasStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAnalysisLenses applies the HasEdge predicate on the "analysis_lenses" edge.
+func HasAnalysisLenses() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AnalysisLensesTable, AnalysisLensesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAnalysisLensesWith applies the HasEdge predicate on the "analysis_lenses" edge with a given conditions (other predicates).
+func HasAnalysisLensesWith(preds ...predicate.AnalysisLens) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newAnalysisLensesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.User) predicate.User {
 	return predicate.User(sql.AndPredicates(predicates...))
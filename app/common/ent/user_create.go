@@ -8,9 +8,13 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/iWorld-y/domain_radar/app/common/ent/analysislens"
+	"github.com/iWorld-y/domain_radar/app/common/ent/persona"
 	"github.com/iWorld-y/domain_radar/app/common/ent/user"
+	"github.com/iWorld-y/domain_radar/app/domain_radar/pkg/model"
 )
 
 // UserCreate is the builder for creating a User entity.
@@ -18,6 +22,7 @@ type UserCreate struct {
 	config
 	mutation *UserMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetUsername sets the "username" field.
@@ -47,11 +52,53 @@ func (_c *UserCreate) SetNillablePersona(v *string) *UserCreate {
 }
 
 // SetDomains sets the "domains" field.
-func (_c *UserCreate) SetDomains(v []string) *UserCreate {
+func (_c *UserCreate) SetDomains(v []model.DomainConfig) *UserCreate {
 	_c.mutation.SetDomains(v)
 	return _c
 }
 
+// SetMaxTokensPerRun sets the "max_tokens_per_run" field.
+func (_c *UserCreate) SetMaxTokensPerRun(v int) *UserCreate {
+	_c.mutation.SetMaxTokensPerRun(v)
+	return _c
+}
+
+// SetNillableMaxTokensPerRun sets the "max_tokens_per_run" field if the given value is not nil.
+func (_c *UserCreate) SetNillableMaxTokensPerRun(v *int) *UserCreate {
+	if v != nil {
+		_c.SetMaxTokensPerRun(*v)
+	}
+	return _c
+}
+
+// SetMaxCostPerRun sets the "max_cost_per_run" field.
+func (_c *UserCreate) SetMaxCostPerRun(v float64) *UserCreate {
+	_c.mutation.SetMaxCostPerRun(v)
+	return _c
+}
+
+// SetNillableMaxCostPerRun sets the "max_cost_per_run" field if the given value is not nil.
+func (_c *UserCreate) SetNillableMaxCostPerRun(v *float64) *UserCreate {
+	if v != nil {
+		_c.SetMaxCostPerRun(*v)
+	}
+	return _c
+}
+
+// SetReportLanguage sets the "report_language" field.
+func (_c *UserCreate) SetReportLanguage(v string) *UserCreate {
+	_c.mutation.SetReportLanguage(v)
+	return _c
+}
+
+// SetNillableReportLanguage sets the "report_language" field if the given value is not nil.
+func (_c *UserCreate) SetNillableReportLanguage(v *string) *UserCreate {
+	if v != nil {
+		_c.SetReportLanguage(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *UserCreate) SetCreatedAt(v time.Time) *UserCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -72,6 +119,36 @@ func (_c *UserCreate) SetID(v int) *UserCreate {
 	return _c
 }
 
+// AddPersonaIDs adds the "personas" edge to the Persona entity by IDs.
+func (_c *UserCreate) AddPersonaIDs(ids ...int) *UserCreate {
+	_c.mutation.AddPersonaIDs(ids...)
+	return _c
+}
+
+// AddPersonas adds the "personas" edges to the Persona entity.
+func (_c *UserCreate) AddPersonas(v ...*Persona) *UserCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddPersonaIDs(ids...)
+}
+
+// AddAnalysisLenseIDs adds the "analysis_lenses" edge to the AnalysisLens entity by IDs.
+func (_c *UserCreate) AddAnalysisLenseIDs(ids ...int) *UserCreate {
+	_c.mutation.AddAnalysisLenseIDs(ids...)
+	return _c
+}
+
+// AddAnalysisLenses adds the "analysis_lenses" edges to the AnalysisLens entity.
+func (_c *UserCreate) AddAnalysisLenses(v ...*AnalysisLens) *UserCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAnalysisLenseIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_c *UserCreate) Mutation() *UserMutation {
 	return _c.mutation
@@ -107,6 +184,10 @@ func (_c *UserCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (_c *UserCreate) defaults() {
+	if _, ok := _c.mutation.ReportLanguage(); !ok {
+		v := user.DefaultReportLanguage
+		_c.mutation.SetReportLanguage(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := user.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -121,6 +202,9 @@ func (_c *UserCreate) check() error {
 	if _, ok := _c.mutation.PasswordHash(); !ok {
 		return &ValidationError{Name: "password_hash", err: errors.New(`ent: missing required field "User.password_hash"`)}
 	}
+	if _, ok := _c.mutation.ReportLanguage(); !ok {
+		return &ValidationError{Name: "report_language", err: errors.New(`ent: missing required field "User.report_language"`)}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "User.created_at"`)}
 	}
@@ -152,6 +236,7 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_node = &User{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(user.Table, sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt))
 	)
+	_spec.OnConflict = _c.conflict
 	if id, ok := _c.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = id
@@ -172,18 +257,479 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldDomains, field.TypeJSON, value)
 		_node.Domains = value
 	}
+	if value, ok := _c.mutation.MaxTokensPerRun(); ok {
+		_spec.SetField(user.FieldMaxTokensPerRun, field.TypeInt, value)
+		_node.MaxTokensPerRun = value
+	}
+	if value, ok := _c.mutation.MaxCostPerRun(); ok {
+		_spec.SetField(user.FieldMaxCostPerRun, field.TypeFloat64, value)
+		_node.MaxCostPerRun = value
+	}
+	if value, ok := _c.mutation.ReportLanguage(); ok {
+		_spec.SetField(user.FieldReportLanguage, field.TypeString, value)
+		_node.ReportLanguage = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(user.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
 	}
+	if nodes := _c.mutation.PersonasIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PersonasTable,
+			Columns: []string{user.PersonasColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(persona.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AnalysisLensesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AnalysisLensesTable,
+			Columns: []string{user.AnalysisLensesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(analysislens.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.User.Create().
+//		SetUsername(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UserUpsert) {
+//			SetUsername(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *UserCreate) OnConflict(opts ...sql.ConflictOption) *UserUpsertOne {
+	_c.conflict = opts
+	return &UserUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.User.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *UserCreate) OnConflictColumns(columns ...string) *UserUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &UserUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// UserUpsertOne is the builder for "upsert"-ing
+	//  one User node.
+	UserUpsertOne struct {
+		create *UserCreate
+	}
+
+	// UserUpsert is the "OnConflict" setter.
+	UserUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUsername sets the "username" field.
+func (u *UserUpsert) SetUsername(v string) *UserUpsert {
+	u.Set(user.FieldUsername, v)
+	return u
+}
+
+// UpdateUsername sets the "username" field to the value that was provided on create.
+func (u *UserUpsert) UpdateUsername() *UserUpsert {
+	u.SetExcluded(user.FieldUsername)
+	return u
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (u *UserUpsert) SetPasswordHash(v string) *UserUpsert {
+	u.Set(user.FieldPasswordHash, v)
+	return u
+}
+
+// UpdatePasswordHash sets the "password_hash" field to the value that was provided on create.
+func (u *UserUpsert) UpdatePasswordHash() *UserUpsert {
+	u.SetExcluded(user.FieldPasswordHash)
+	return u
+}
+
+// SetPersona sets the "persona" field.
+func (u *UserUpsert) SetPersona(v string) *UserUpsert {
+	u.Set(user.FieldPersona, v)
+	return u
+}
+
+// UpdatePersona sets the "persona" field to the value that was provided on create.
+func (u *UserUpsert) UpdatePersona() *UserUpsert {
+	u.SetExcluded(user.FieldPersona)
+	return u
+}
+
+// ClearPersona clears the value of the "persona" field.
+func (u *UserUpsert) ClearPersona() *UserUpsert {
+	u.SetNull(user.FieldPersona)
+	return u
+}
+
+// SetDomains sets the "domains" field.
+func (u *UserUpsert) SetDomains(v []model.DomainConfig) *UserUpsert {
+	u.Set(user.FieldDomains, v)
+	return u
+}
+
+// UpdateDomains sets the "domains" field to the value that was provided on create.
+func (u *UserUpsert) UpdateDomains() *UserUpsert {
+	u.SetExcluded(user.FieldDomains)
+	return u
+}
+
+// ClearDomains clears the value of the "domains" field.
+func (u *UserUpsert) ClearDomains() *UserUpsert {
+	u.SetNull(user.FieldDomains)
+	return u
+}
+
+// SetMaxTokensPerRun sets the "max_tokens_per_run" field.
+func (u *UserUpsert) SetMaxTokensPerRun(v int) *UserUpsert {
+	u.Set(user.FieldMaxTokensPerRun, v)
+	return u
+}
+
+// UpdateMaxTokensPerRun sets the "max_tokens_per_run" field to the value that was provided on create.
+func (u *UserUpsert) UpdateMaxTokensPerRun() *UserUpsert {
+	u.SetExcluded(user.FieldMaxTokensPerRun)
+	return u
+}
+
+// AddMaxTokensPerRun adds v to the "max_tokens_per_run" field.
+func (u *UserUpsert) AddMaxTokensPerRun(v int) *UserUpsert {
+	u.Add(user.FieldMaxTokensPerRun, v)
+	return u
+}
+
+// ClearMaxTokensPerRun clears the value of the "max_tokens_per_run" field.
+func (u *UserUpsert) ClearMaxTokensPerRun() *UserUpsert {
+	u.SetNull(user.FieldMaxTokensPerRun)
+	return u
+}
+
+// SetMaxCostPerRun sets the "max_cost_per_run" field.
+func (u *UserUpsert) SetMaxCostPerRun(v float64) *UserUpsert {
+	u.Set(user.FieldMaxCostPerRun, v)
+	return u
+}
+
+// UpdateMaxCostPerRun sets the "max_cost_per_run" field to the value that was provided on create.
+func (u *UserUpsert) UpdateMaxCostPerRun() *UserUpsert {
+	u.SetExcluded(user.FieldMaxCostPerRun)
+	return u
+}
+
+// AddMaxCostPerRun adds v to the "max_cost_per_run" field.
+func (u *UserUpsert) AddMaxCostPerRun(v float64) *UserUpsert {
+	u.Add(user.FieldMaxCostPerRun, v)
+	return u
+}
+
+// ClearMaxCostPerRun clears the value of the "max_cost_per_run" field.
+func (u *UserUpsert) ClearMaxCostPerRun() *UserUpsert {
+	u.SetNull(user.FieldMaxCostPerRun)
+	return u
+}
+
+// SetReportLanguage sets the "report_language" field.
+func (u *UserUpsert) SetReportLanguage(v string) *UserUpsert {
+	u.Set(user.FieldReportLanguage, v)
+	return u
+}
+
+// UpdateReportLanguage sets the "report_language" field to the value that was provided on create.
+func (u *UserUpsert) UpdateReportLanguage() *UserUpsert {
+	u.SetExcluded(user.FieldReportLanguage)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *UserUpsert) SetCreatedAt(v time.Time) *UserUpsert {
+	u.Set(user.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *UserUpsert) UpdateCreatedAt() *UserUpsert {
+	u.SetExcluded(user.FieldCreatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.User.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(user.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *UserUpsertOne) UpdateNewValues() *UserUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(user.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.User.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *UserUpsertOne) Ignore() *UserUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UserUpsertOne) DoNothing() *UserUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UserCreate.OnConflict
+// documentation for more info.
+func (u *UserUpsertOne) Update(set func(*UserUpsert)) *UserUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UserUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUsername sets the "username" field.
+func (u *UserUpsertOne) SetUsername(v string) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetUsername(v)
+	})
+}
+
+// UpdateUsername sets the "username" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateUsername() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateUsername()
+	})
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (u *UserUpsertOne) SetPasswordHash(v string) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetPasswordHash(v)
+	})
+}
+
+// UpdatePasswordHash sets the "password_hash" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdatePasswordHash() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdatePasswordHash()
+	})
+}
+
+// SetPersona sets the "persona" field.
+func (u *UserUpsertOne) SetPersona(v string) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetPersona(v)
+	})
+}
+
+// UpdatePersona sets the "persona" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdatePersona() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdatePersona()
+	})
+}
+
+// ClearPersona clears the value of the "persona" field.
+func (u *UserUpsertOne) ClearPersona() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearPersona()
+	})
+}
+
+// SetDomains sets the "domains" field.
+func (u *UserUpsertOne) SetDomains(v []model.DomainConfig) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetDomains(v)
+	})
+}
+
+// UpdateDomains sets the "domains" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateDomains() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateDomains()
+	})
+}
+
+// ClearDomains clears the value of the "domains" field.
+func (u *UserUpsertOne) ClearDomains() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearDomains()
+	})
+}
+
+// SetMaxTokensPerRun sets the "max_tokens_per_run" field.
+func (u *UserUpsertOne) SetMaxTokensPerRun(v int) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetMaxTokensPerRun(v)
+	})
+}
+
+// AddMaxTokensPerRun adds v to the "max_tokens_per_run" field.
+func (u *UserUpsertOne) AddMaxTokensPerRun(v int) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.AddMaxTokensPerRun(v)
+	})
+}
+
+// UpdateMaxTokensPerRun sets the "max_tokens_per_run" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateMaxTokensPerRun() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateMaxTokensPerRun()
+	})
+}
+
+// ClearMaxTokensPerRun clears the value of the "max_tokens_per_run" field.
+func (u *UserUpsertOne) ClearMaxTokensPerRun() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearMaxTokensPerRun()
+	})
+}
+
+// SetMaxCostPerRun sets the "max_cost_per_run" field.
+func (u *UserUpsertOne) SetMaxCostPerRun(v float64) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetMaxCostPerRun(v)
+	})
+}
+
+// AddMaxCostPerRun adds v to the "max_cost_per_run" field.
+func (u *UserUpsertOne) AddMaxCostPerRun(v float64) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.AddMaxCostPerRun(v)
+	})
+}
+
+// UpdateMaxCostPerRun sets the "max_cost_per_run" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateMaxCostPerRun() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateMaxCostPerRun()
+	})
+}
+
+// ClearMaxCostPerRun clears the value of the "max_cost_per_run" field.
+func (u *UserUpsertOne) ClearMaxCostPerRun() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearMaxCostPerRun()
+	})
+}
+
+// SetReportLanguage sets the "report_language" field.
+func (u *UserUpsertOne) SetReportLanguage(v string) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetReportLanguage(v)
+	})
+}
+
+// UpdateReportLanguage sets the "report_language" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateReportLanguage() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateReportLanguage()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *UserUpsertOne) SetCreatedAt(v time.Time) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateCreatedAt() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *UserUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for UserCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UserUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *UserUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *UserUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // UserCreateBulk is the builder for creating many User entities in bulk.
 type UserCreateBulk struct {
 	config
 	err      error
 	builders []*UserCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the User entities in the database.
@@ -213,6 +759,7 @@ func (_c *UserCreateBulk) Save(ctx context.Context) ([]*User, error) {
 					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -266,3 +813,271 @@ func (_c *UserCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.User.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UserUpsert) {
+//			SetUsername(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *UserCreateBulk) OnConflict(opts ...sql.ConflictOption) *UserUpsertBulk {
+	_c.conflict = opts
+	return &UserUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.User.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *UserCreateBulk) OnConflictColumns(columns ...string) *UserUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &UserUpsertBulk{
+		create: _c,
+	}
+}
+
+// UserUpsertBulk is the builder for "upsert"-ing
+// a bulk of User nodes.
+type UserUpsertBulk struct {
+	create *UserCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.User.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(user.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *UserUpsertBulk) UpdateNewValues() *UserUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(user.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.User.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *UserUpsertBulk) Ignore() *UserUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UserUpsertBulk) DoNothing() *UserUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UserCreateBulk.OnConflict
+// documentation for more info.
+func (u *UserUpsertBulk) Update(set func(*UserUpsert)) *UserUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UserUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUsername sets the "username" field.
+func (u *UserUpsertBulk) SetUsername(v string) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetUsername(v)
+	})
+}
+
+// UpdateUsername sets the "username" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateUsername() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateUsername()
+	})
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (u *UserUpsertBulk) SetPasswordHash(v string) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetPasswordHash(v)
+	})
+}
+
+// UpdatePasswordHash sets the "password_hash" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdatePasswordHash() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdatePasswordHash()
+	})
+}
+
+// SetPersona sets the "persona" field.
+func (u *UserUpsertBulk) SetPersona(v string) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetPersona(v)
+	})
+}
+
+// UpdatePersona sets the "persona" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdatePersona() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdatePersona()
+	})
+}
+
+// ClearPersona clears the value of the "persona" field.
+func (u *UserUpsertBulk) ClearPersona() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearPersona()
+	})
+}
+
+// SetDomains sets the "domains" field.
+func (u *UserUpsertBulk) SetDomains(v []model.DomainConfig) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetDomains(v)
+	})
+}
+
+// UpdateDomains sets the "domains" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateDomains() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateDomains()
+	})
+}
+
+// ClearDomains clears the value of the "domains" field.
+func (u *UserUpsertBulk) ClearDomains() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearDomains()
+	})
+}
+
+// SetMaxTokensPerRun sets the "max_tokens_per_run" field.
+func (u *UserUpsertBulk) SetMaxTokensPerRun(v int) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetMaxTokensPerRun(v)
+	})
+}
+
+// AddMaxTokensPerRun adds v to the "max_tokens_per_run" field.
+func (u *UserUpsertBulk) AddMaxTokensPerRun(v int) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.AddMaxTokensPerRun(v)
+	})
+}
+
+// UpdateMaxTokensPerRun sets the "max_tokens_per_run" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateMaxTokensPerRun() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateMaxTokensPerRun()
+	})
+}
+
+// ClearMaxTokensPerRun clears the value of the "max_tokens_per_run" field.
+func (u *UserUpsertBulk) ClearMaxTokensPerRun() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearMaxTokensPerRun()
+	})
+}
+
+// SetMaxCostPerRun sets the "max_cost_per_run" field.
+func (u *UserUpsertBulk) SetMaxCostPerRun(v float64) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetMaxCostPerRun(v)
+	})
+}
+
+// AddMaxCostPerRun adds v to the "max_cost_per_run" field.
+func (u *UserUpsertBulk) AddMaxCostPerRun(v float64) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.AddMaxCostPerRun(v)
+	})
+}
+
+// UpdateMaxCostPerRun sets the "max_cost_per_run" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateMaxCostPerRun() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateMaxCostPerRun()
+	})
+}
+
+// ClearMaxCostPerRun clears the value of the "max_cost_per_run" field.
+func (u *UserUpsertBulk) ClearMaxCostPerRun() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearMaxCostPerRun()
+	})
+}
+
+// SetReportLanguage sets the "report_language" field.
+func (u *UserUpsertBulk) SetReportLanguage(v string) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetReportLanguage(v)
+	})
+}
+
+// UpdateReportLanguage sets the "report_language" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateReportLanguage() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateReportLanguage()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *UserUpsertBulk) SetCreatedAt(v time.Time) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateCreatedAt() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *UserUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the UserCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for UserCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UserUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
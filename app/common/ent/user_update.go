@@ -12,8 +12,11 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
+	"github.com/iWorld-y/domain_radar/app/common/ent/analysislens"
+	"github.com/iWorld-y/domain_radar/app/common/ent/persona"
 	"github.com/iWorld-y/domain_radar/app/common/ent/predicate"
 	"github.com/iWorld-y/domain_radar/app/common/ent/user"
+	"github.com/iWorld-y/domain_radar/app/domain_radar/pkg/model"
 )
 
 // UserUpdate is the builder for updating User entities.
@@ -79,13 +82,13 @@ func (_u *UserUpdate) ClearPersona() *UserUpdate {
 }
 
 // SetDomains sets the "domains" field.
-func (_u *UserUpdate) SetDomains(v []string) *UserUpdate {
+func (_u *UserUpdate) SetDomains(v []model.DomainConfig) *UserUpdate {
 	_u.mutation.SetDomains(v)
 	return _u
 }
 
 // AppendDomains appends value to the "domains" field.
-func (_u *UserUpdate) AppendDomains(v []string) *UserUpdate {
+func (_u *UserUpdate) AppendDomains(v []model.DomainConfig) *UserUpdate {
 	_u.mutation.AppendDomains(v)
 	return _u
 }
@@ -96,6 +99,74 @@ func (_u *UserUpdate) ClearDomains() *UserUpdate {
 	return _u
 }
 
+// SetMaxTokensPerRun sets the "max_tokens_per_run" field.
+func (_u *UserUpdate) SetMaxTokensPerRun(v int) *UserUpdate {
+	_u.mutation.ResetMaxTokensPerRun()
+	_u.mutation.SetMaxTokensPerRun(v)
+	return _u
+}
+
+// SetNillableMaxTokensPerRun sets the "max_tokens_per_run" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableMaxTokensPerRun(v *int) *UserUpdate {
+	if v != nil {
+		_u.SetMaxTokensPerRun(*v)
+	}
+	return _u
+}
+
+// AddMaxTokensPerRun adds value to the "max_tokens_per_run" field.
+func (_u *UserUpdate) AddMaxTokensPerRun(v int) *UserUpdate {
+	_u.mutation.AddMaxTokensPerRun(v)
+	return _u
+}
+
+// ClearMaxTokensPerRun clears the value of the "max_tokens_per_run" field.
+func (_u *UserUpdate) ClearMaxTokensPerRun() *UserUpdate {
+	_u.mutation.ClearMaxTokensPerRun()
+	return _u
+}
+
+// SetMaxCostPerRun sets the "max_cost_per_run" field.
+func (_u *UserUpdate) SetMaxCostPerRun(v float64) *UserUpdate {
+	_u.mutation.ResetMaxCostPerRun()
+	_u.mutation.SetMaxCostPerRun(v)
+	return _u
+}
+
+// SetNillableMaxCostPerRun sets the "max_cost_per_run" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableMaxCostPerRun(v *float64) *UserUpdate {
+	if v != nil {
+		_u.SetMaxCostPerRun(*v)
+	}
+	return _u
+}
+
+// AddMaxCostPerRun adds value to the "max_cost_per_run" field.
+func (_u *UserUpdate) AddMaxCostPerRun(v float64) *UserUpdate {
+	_u.mutation.AddMaxCostPerRun(v)
+	return _u
+}
+
+// ClearMaxCostPerRun clears the value of the "max_cost_per_run" field.
+func (_u *UserUpdate) ClearMaxCostPerRun() *UserUpdate {
+	_u.mutation.ClearMaxCostPerRun()
+	return _u
+}
+
+// SetReportLanguage sets the "report_language" field.
+func (_u *UserUpdate) SetReportLanguage(v string) *UserUpdate {
+	_u.mutation.SetReportLanguage(v)
+	return _u
+}
+
+// SetNillableReportLanguage sets the "report_language" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableReportLanguage(v *string) *UserUpdate {
+	if v != nil {
+		_u.SetReportLanguage(*v)
+	}
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *UserUpdate) SetCreatedAt(v time.Time) *UserUpdate {
 	_u.mutation.SetCreatedAt(v)
@@ -110,11 +181,83 @@ func (_u *UserUpdate) SetNillableCreatedAt(v *time.Time) *UserUpdate {
 	return _u
 }
 
+// AddPersonaIDs adds the "personas" edge to the Persona entity by IDs.
+func (_u *UserUpdate) AddPersonaIDs(ids ...int) *UserUpdate {
+	_u.mutation.AddPersonaIDs(ids...)
+	return _u
+}
+
+// AddPersonas adds the "personas" edges to the Persona entity.
+func (_u *UserUpdate) AddPersonas(v ...*Persona) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPersonaIDs(ids...)
+}
+
+// AddAnalysisLenseIDs adds the "analysis_lenses" edge to the AnalysisLens entity by IDs.
+func (_u *UserUpdate) AddAnalysisLenseIDs(ids ...int) *UserUpdate {
+	_u.mutation.AddAnalysisLenseIDs(ids...)
+	return _u
+}
+
+// AddAnalysisLenses adds the "analysis_lenses" edges to the AnalysisLens entity.
+func (_u *UserUpdate) AddAnalysisLenses(v ...*AnalysisLens) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAnalysisLenseIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_u *UserUpdate) Mutation() *UserMutation {
 	return _u.mutation
 }
 
+// ClearPersonas clears all "personas" edges to the Persona entity.
+func (_u *UserUpdate) ClearPersonas() *UserUpdate {
+	_u.mutation.ClearPersonas()
+	return _u
+}
+
+// RemovePersonaIDs removes the "personas" edge to Persona entities by IDs.
+func (_u *UserUpdate) RemovePersonaIDs(ids ...int) *UserUpdate {
+	_u.mutation.RemovePersonaIDs(ids...)
+	return _u
+}
+
+// RemovePersonas removes "personas" edges to Persona entities.
+func (_u *UserUpdate) RemovePersonas(v ...*Persona) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePersonaIDs(ids...)
+}
+
+// ClearAnalysisLenses clears all "analysis_lenses" edges to the AnalysisLens entity.
+func (_u *UserUpdate) ClearAnalysisLenses() *UserUpdate {
+	_u.mutation.ClearAnalysisLenses()
+	return _u
+}
+
+// RemoveAnalysisLenseIDs removes the "analysis_lenses" edge to AnalysisLens entities by IDs.
+func (_u *UserUpdate) RemoveAnalysisLenseIDs(ids ...int) *UserUpdate {
+	_u.mutation.RemoveAnalysisLenseIDs(ids...)
+	return _u
+}
+
+// RemoveAnalysisLenses removes "analysis_lenses" edges to AnalysisLens entities.
+func (_u *UserUpdate) RemoveAnalysisLenses(v ...*AnalysisLens) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAnalysisLenseIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *UserUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -180,9 +323,120 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.DomainsCleared() {
 		_spec.ClearField(user.FieldDomains, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.MaxTokensPerRun(); ok {
+		_spec.SetField(user.FieldMaxTokensPerRun, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxTokensPerRun(); ok {
+		_spec.AddField(user.FieldMaxTokensPerRun, field.TypeInt, value)
+	}
+	if _u.mutation.MaxTokensPerRunCleared() {
+		_spec.ClearField(user.FieldMaxTokensPerRun, field.TypeInt)
+	}
+	if value, ok := _u.mutation.MaxCostPerRun(); ok {
+		_spec.SetField(user.FieldMaxCostPerRun, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedMaxCostPerRun(); ok {
+		_spec.AddField(user.FieldMaxCostPerRun, field.TypeFloat64, value)
+	}
+	if _u.mutation.MaxCostPerRunCleared() {
+		_spec.ClearField(user.FieldMaxCostPerRun, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.ReportLanguage(); ok {
+		_spec.SetField(user.FieldReportLanguage, field.TypeString, value)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(user.FieldCreatedAt, field.TypeTime, value)
 	}
+	if _u.mutation.PersonasCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PersonasTable,
+			Columns: []string{user.PersonasColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(persona.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPersonasIDs(); len(nodes) > 0 && !_u.mutation.PersonasCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PersonasTable,
+			Columns: []string{user.PersonasColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(persona.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PersonasIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PersonasTable,
+			Columns: []string{user.PersonasColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(persona.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AnalysisLensesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AnalysisLensesTable,
+			Columns: []string{user.AnalysisLensesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(analysislens.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAnalysisLensesIDs(); len(nodes) > 0 && !_u.mutation.AnalysisLensesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AnalysisLensesTable,
+			Columns: []string{user.AnalysisLensesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(analysislens.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AnalysisLensesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AnalysisLensesTable,
+			Columns: []string{user.AnalysisLensesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(analysislens.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -254,13 +508,13 @@ func (_u *UserUpdateOne) ClearPersona() *UserUpdateOne {
 }
 
 // SetDomains sets the "domains" field.
-func (_u *UserUpdateOne) SetDomains(v []string) *UserUpdateOne {
+func (_u *UserUpdateOne) SetDomains(v []model.DomainConfig) *UserUpdateOne {
 	_u.mutation.SetDomains(v)
 	return _u
 }
 
 // AppendDomains appends value to the "domains" field.
-func (_u *UserUpdateOne) AppendDomains(v []string) *UserUpdateOne {
+func (_u *UserUpdateOne) AppendDomains(v []model.DomainConfig) *UserUpdateOne {
 	_u.mutation.AppendDomains(v)
 	return _u
 }
@@ -271,6 +525,74 @@ func (_u *UserUpdateOne) ClearDomains() *UserUpdateOne {
 	return _u
 }
 
+// SetMaxTokensPerRun sets the "max_tokens_per_run" field.
+func (_u *UserUpdateOne) SetMaxTokensPerRun(v int) *UserUpdateOne {
+	_u.mutation.ResetMaxTokensPerRun()
+	_u.mutation.SetMaxTokensPerRun(v)
+	return _u
+}
+
+// SetNillableMaxTokensPerRun sets the "max_tokens_per_run" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableMaxTokensPerRun(v *int) *UserUpdateOne {
+	if v != nil {
+		_u.SetMaxTokensPerRun(*v)
+	}
+	return _u
+}
+
+// AddMaxTokensPerRun adds value to the "max_tokens_per_run" field.
+func (_u *UserUpdateOne) AddMaxTokensPerRun(v int) *UserUpdateOne {
+	_u.mutation.AddMaxTokensPerRun(v)
+	return _u
+}
+
+// ClearMaxTokensPerRun clears the value of the "max_tokens_per_run" field.
+func (_u *UserUpdateOne) ClearMaxTokensPerRun() *UserUpdateOne {
+	_u.mutation.ClearMaxTokensPerRun()
+	return _u
+}
+
+// SetMaxCostPerRun sets the "max_cost_per_run" field.
+func (_u *UserUpdateOne) SetMaxCostPerRun(v float64) *UserUpdateOne {
+	_u.mutation.ResetMaxCostPerRun()
+	_u.mutation.SetMaxCostPerRun(v)
+	return _u
+}
+
+// SetNillableMaxCostPerRun sets the "max_cost_per_run" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableMaxCostPerRun(v *float64) *UserUpdateOne {
+	if v != nil {
+		_u.SetMaxCostPerRun(*v)
+	}
+	return _u
+}
+
+// AddMaxCostPerRun adds value to the "max_cost_per_run" field.
+func (_u *UserUpdateOne) AddMaxCostPerRun(v float64) *UserUpdateOne {
+	_u.mutation.AddMaxCostPerRun(v)
+	return _u
+}
+
+// ClearMaxCostPerRun clears the value of the "max_cost_per_run" field.
+func (_u *UserUpdateOne) ClearMaxCostPerRun() *UserUpdateOne {
+	_u.mutation.ClearMaxCostPerRun()
+	return _u
+}
+
+// SetReportLanguage sets the "report_language" field.
+func (_u *UserUpdateOne) SetReportLanguage(v string) *UserUpdateOne {
+	_u.mutation.SetReportLanguage(v)
+	return _u
+}
+
+// SetNillableReportLanguage sets the "report_language" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableReportLanguage(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetReportLanguage(*v)
+	}
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *UserUpdateOne) SetCreatedAt(v time.Time) *UserUpdateOne {
 	_u.mutation.SetCreatedAt(v)
@@ -285,11 +607,83 @@ func (_u *UserUpdateOne) SetNillableCreatedAt(v *time.Time) *UserUpdateOne {
 	return _u
 }
 
+// AddPersonaIDs adds the "personas" edge to the Persona entity by IDs.
+func (_u *UserUpdateOne) AddPersonaIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddPersonaIDs(ids...)
+	return _u
+}
+
+// AddPersonas adds the "personas" edges to the Persona entity.
+func (_u *UserUpdateOne) AddPersonas(v ...*Persona) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPersonaIDs(ids...)
+}
+
+// AddAnalysisLenseIDs adds the "analysis_lenses" edge to the AnalysisLens entity by IDs.
+func (_u *UserUpdateOne) AddAnalysisLenseIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddAnalysisLenseIDs(ids...)
+	return _u
+}
+
+// AddAnalysisLenses adds the "analysis_lenses" edges to the AnalysisLens entity.
+func (_u *UserUpdateOne) AddAnalysisLenses(v ...*AnalysisLens) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAnalysisLenseIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_u *UserUpdateOne) Mutation() *UserMutation {
 	return _u.mutation
 }
 
+// ClearPersonas clears all "personas" edges to the Persona entity.
+func (_u *UserUpdateOne) ClearPersonas() *UserUpdateOne {
+	_u.mutation.ClearPersonas()
+	return _u
+}
+
+// RemovePersonaIDs removes the "personas" edge to Persona entities by IDs.
+func (_u *UserUpdateOne) RemovePersonaIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemovePersonaIDs(ids...)
+	return _u
+}
+
+// RemovePersonas removes "personas" edges to Persona entities.
+func (_u *UserUpdateOne) RemovePersonas(v ...*Persona) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePersonaIDs(ids...)
+}
+
+// ClearAnalysisLenses clears all "analysis_lenses" edges to the AnalysisLens entity.
+func (_u *UserUpdateOne) ClearAnalysisLenses() *UserUpdateOne {
+	_u.mutation.ClearAnalysisLenses()
+	return _u
+}
+
+// RemoveAnalysisLenseIDs removes the "analysis_lenses" edge to AnalysisLens entities by IDs.
+func (_u *UserUpdateOne) RemoveAnalysisLenseIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveAnalysisLenseIDs(ids...)
+	return _u
+}
+
+// RemoveAnalysisLenses removes "analysis_lenses" edges to AnalysisLens entities.
+func (_u *UserUpdateOne) RemoveAnalysisLenses(v ...*AnalysisLens) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAnalysisLenseIDs(ids...)
+}
+
 // Where appends a list predicates to the UserUpdate builder.
 func (_u *UserUpdateOne) Where(ps ...predicate.User) *UserUpdateOne {
 	_u.mutation.Where(ps...)
@@ -385,9 +779,120 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 	if _u.mutation.DomainsCleared() {
 		_spec.ClearField(user.FieldDomains, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.MaxTokensPerRun(); ok {
+		_spec.SetField(user.FieldMaxTokensPerRun, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxTokensPerRun(); ok {
+		_spec.AddField(user.FieldMaxTokensPerRun, field.TypeInt, value)
+	}
+	if _u.mutation.MaxTokensPerRunCleared() {
+		_spec.ClearField(user.FieldMaxTokensPerRun, field.TypeInt)
+	}
+	if value, ok := _u.mutation.MaxCostPerRun(); ok {
+		_spec.SetField(user.FieldMaxCostPerRun, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedMaxCostPerRun(); ok {
+		_spec.AddField(user.FieldMaxCostPerRun, field.TypeFloat64, value)
+	}
+	if _u.mutation.MaxCostPerRunCleared() {
+		_spec.ClearField(user.FieldMaxCostPerRun, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.ReportLanguage(); ok {
+		_spec.SetField(user.FieldReportLanguage, field.TypeString, value)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(user.FieldCreatedAt, field.TypeTime, value)
 	}
+	if _u.mutation.PersonasCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PersonasTable,
+			Columns: []string{user.PersonasColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(persona.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPersonasIDs(); len(nodes) > 0 && !_u.mutation.PersonasCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PersonasTable,
+			Columns: []string{user.PersonasColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(persona.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PersonasIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PersonasTable,
+			Columns: []string{user.PersonasColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(persona.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AnalysisLensesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AnalysisLensesTable,
+			Columns: []string{user.AnalysisLensesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(analysislens.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAnalysisLensesIDs(); len(nodes) > 0 && !_u.mutation.AnalysisLensesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AnalysisLensesTable,
+			Columns: []string{user.AnalysisLensesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(analysislens.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AnalysisLensesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AnalysisLensesTable,
+			Columns: []string{user.AnalysisLensesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(analysislens.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &User{config: _u.config}
 	_spec.Assign = _node.assignValues
@@ -22,13 +22,13 @@ func initApp(*conf.Server, *conf.Data, *conf.Auth, *conf.Radar, log.Logger) (*kr
 	))
 }
 
-func newApp(logger log.Logger, hs *http.Server) *kratos.App {
+func newApp(logger log.Logger, hs *http.Server, bs *server.BatchScheduler) *kratos.App {
 	return kratos.New(
 		kratos.ID(id),
 		kratos.Name(Name),
 		kratos.Version(Version),
 		kratos.Metadata(map[string]string{}),
 		kratos.Logger(logger),
-		kratos.Server(hs),
+		kratos.Server(hs, bs),
 	)
 }
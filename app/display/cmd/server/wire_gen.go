@@ -29,14 +29,30 @@ func initApp(confServer *conf.Server, confData *conf.Data, auth *conf.Auth, rada
 	userUseCase := usecase.NewUserUseCase(userRepo, auth, logger)
 	reportRepo := data.NewReportRepo(dataData, logger)
 	reportUseCase := usecase.NewReportUseCase(reportRepo, logger)
+	usageRepo := data.NewUsageRepo(dataData, logger)
+	usageUseCase := usecase.NewUsageUseCase(usageRepo, logger)
+	entityRepo := data.NewEntityRepo(dataData, logger)
+	entityUseCase := usecase.NewEntityUseCase(entityRepo, logger)
+	personaRepo := data.NewPersonaRepo(dataData, logger)
+	personaUseCase := usecase.NewPersonaUseCase(personaRepo, logger)
+	lensRepo := data.NewLensRepo(dataData, logger)
+	lensUseCase := usecase.NewLensUseCase(lensRepo, logger)
+	eventRepo := data.NewEventRepo(dataData, logger)
+	eventUseCase := usecase.NewEventUseCase(eventRepo, logger)
 	engine, cleanup2, err := server.NewRadarEngine(radar, logger)
 	if err != nil {
 		cleanup()
 		return nil, nil, err
 	}
-	displayService := service.NewDisplayService(userUseCase, reportUseCase, logger, engine)
+	displayService := service.NewDisplayService(userUseCase, reportUseCase, usageUseCase, entityUseCase, personaUseCase, lensUseCase, eventUseCase, logger, engine)
 	httpServer := server.NewHTTPServer(confServer, auth, displayService, logger)
-	app := newApp(logger, httpServer)
+	batchScheduler, err := server.NewBatchScheduler(radar, displayService, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	app := newApp(logger, httpServer, batchScheduler)
 	return app, func() {
 		cleanup2()
 		cleanup()
@@ -45,6 +61,6 @@ func initApp(confServer *conf.Server, confData *conf.Data, auth *conf.Auth, rada
 
 // wire.go:
 
-func newApp(logger log.Logger, hs *http.Server) *kratos.App {
-	return kratos.New(kratos.ID(id), kratos.Name(Name), kratos.Version(Version), kratos.Metadata(map[string]string{}), kratos.Logger(logger), kratos.Server(hs))
+func newApp(logger log.Logger, hs *http.Server, bs *server.BatchScheduler) *kratos.App {
+	return kratos.New(kratos.ID(id), kratos.Name(Name), kratos.Version(Version), kratos.Metadata(map[string]string{}), kratos.Logger(logger), kratos.Server(hs, bs))
 }
@@ -1,5 +1,7 @@
 package conf
 
+import dm "github.com/iWorld-y/domain_radar/app/domain_radar/pkg/model"
+
 type Bootstrap struct {
 	Server *Server
 	Data   *Data
@@ -30,25 +32,41 @@ type Database struct {
 }
 
 type Radar struct {
-	Llm         *LLM         `json:"llm"`
-	Search      *Search      `json:"search"`
-	UserPersona string       `json:"user_persona"`
-	Domains     []string     `json:"domains"`
-	Log         *Log         `json:"log"`
-	Concurrency *Concurrency `json:"concurrency"`
-	Db          *DB          `json:"db"`
+	Llm            *LLM              `json:"llm"`
+	Search         *Search           `json:"search"`
+	UserPersona    string            `json:"user_persona"`
+	Domains        []dm.DomainConfig `json:"domains"` // 可直接写领域名称或完整的领域配置
+	ReportLanguage string            `json:"report_language"`
+	Log            *Log              `json:"log"`
+	Concurrency    *Concurrency      `json:"concurrency"`
+	Db             *DB               `json:"db"`
+	Verification   *Verification     `json:"verification"`
+	Budget         *Budget           `json:"budget"`
+	Cache          *Cache            `json:"cache"`
+	Pipeline       *Pipeline         `json:"pipeline"`
+	Research       *Research         `json:"research"`
+	Sharing        *Sharing          `json:"sharing"`
+	Batch          *Batch            `json:"batch"`
 }
 
 type LLM struct {
-	BaseUrl string `json:"base_url"`
-	ApiKey  string `json:"api_key"`
-	Model   string `json:"model"`
+	BaseUrl    string            `json:"base_url"`
+	ApiKey     string            `json:"api_key"`
+	Model      string            `json:"model"`
+	CheapModel string            `json:"cheap_model"`
+	Prices     map[string]*Price `json:"prices"`
+}
+
+type Price struct {
+	Prompt     float64 `json:"prompt"`
+	Completion float64 `json:"completion"`
 }
 
 type Search struct {
-	Provider string   `json:"provider"`
-	Tavily   *Tavily  `json:"tavily"`
-	Searxng  *SearXNG `json:"searxng"`
+	Provider  string   `json:"provider"`
+	Tavily    *Tavily  `json:"tavily"`
+	Searxng   *SearXNG `json:"searxng"`
+	Languages []string `json:"languages"`
 }
 
 type Tavily struct {
@@ -66,8 +84,18 @@ type Log struct {
 }
 
 type Concurrency struct {
-	Qps int32 `json:"qps"`
-	Rpm int32 `json:"rpm"`
+	Qps      int32      `json:"qps"`
+	Rpm      int32      `json:"rpm"`
+	Domains  int32      `json:"domains"`
+	Fetches  int32      `json:"fetches"`
+	LlmCalls int32      `json:"llm_calls"`
+	Search   *RateLimit `json:"search"`
+	Fetch    *RateLimit `json:"fetch"`
+}
+
+type RateLimit struct {
+	Rpm   int32 `json:"rpm"`
+	Burst int32 `json:"burst"`
 }
 
 type DB struct {
@@ -77,3 +105,37 @@ type DB struct {
 	Password string `json:"password"`
 	Name     string `json:"name"`
 }
+
+type Verification struct {
+	Enabled         bool `json:"enabled"`
+	DropUnsupported bool `json:"drop_unsupported"`
+}
+
+type Budget struct {
+	MaxTokens int32   `json:"max_tokens"`
+	MaxCost   float64 `json:"max_cost"`
+}
+
+type Research struct {
+	MaxSteps int32 `json:"max_steps"`
+}
+
+type Pipeline struct {
+	DomainStages []string `json:"domain_stages"`
+}
+
+type Cache struct {
+	Enabled  bool  `json:"enabled"`
+	TtlHours int32 `json:"ttl_hours"`
+}
+
+type Sharing struct {
+	Enabled     bool  `json:"enabled"`
+	MaxAgeHours int32 `json:"max_age_hours"`
+}
+
+type Batch struct {
+	Enabled bool   `json:"enabled"`
+	DailyAt string `json:"daily_at"` // 每天执行的时间 HH:MM，服务器本地时区
+	Window  string `json:"window"`   // 搜索时间范围预设，为空时为最近 3 天
+}
@@ -0,0 +1,117 @@
+package data
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/iWorld-y/domain_radar/app/common/ent"
+	"github.com/iWorld-y/domain_radar/app/common/ent/article"
+	"github.com/iWorld-y/domain_radar/app/common/ent/articleentity"
+	"github.com/iWorld-y/domain_radar/app/common/ent/domainreport"
+	"github.com/iWorld-y/domain_radar/app/common/ent/entity"
+	"github.com/iWorld-y/domain_radar/app/common/ent/predicate"
+	"github.com/iWorld-y/domain_radar/app/common/ent/reportrun"
+	"github.com/iWorld-y/domain_radar/app/display/internal/domain"
+	"github.com/iWorld-y/domain_radar/app/display/internal/repo"
+)
+
+type entityRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewEntityRepo(data *Data, logger log.Logger) repo.EntityRepo {
+	return &entityRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *entityRepo) ListEntities(ctx context.Context, filter domain.EntityFilter) ([]*domain.DomainEntities, error) {
+	run, err := r.data.db.ReportRun.Get(ctx, filter.RunID)
+	if ent.IsNotFound(err) || (err == nil && run.UserID != 0 && run.UserID != filter.UserID) {
+		return nil, errors.NotFound("REPORT_NOT_FOUND", "report not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	reportPreds := []predicate.DomainReport{domainreport.HasReportRunsWith(reportrun.ID(filter.RunID))}
+	if filter.Domain != "" {
+		reportPreds = append(reportPreds, domainreport.DomainName(filter.Domain))
+	}
+	preds := []predicate.ArticleEntity{
+		articleentity.HasArticleWith(article.HasDomainReportWith(reportPreds...)),
+	}
+	if filter.Type != "" {
+		preds = append(preds, articleentity.HasEntityWith(entity.Type(filter.Type)))
+	}
+
+	rows, err := r.data.db.ArticleEntity.Query().
+		Where(preds...).
+		WithEntity().
+		WithArticle(func(q *ent.ArticleQuery) {
+			q.WithDomainReport()
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 按领域与实体聚合，同一实体在同一篇文章中只计一次
+	type statKey struct {
+		domain   string
+		entityID int
+	}
+	stats := make(map[statKey]*domain.EntityStat)
+	articles := make(map[statKey]map[int]bool)
+	byDomain := make(map[string]*domain.DomainEntities)
+	var domains []*domain.DomainEntities
+	for _, row := range rows {
+		e, art := row.Edges.Entity, row.Edges.Article
+		if e == nil || art == nil || art.Edges.DomainReport == nil {
+			continue
+		}
+		domainName := art.Edges.DomainReport.DomainName
+		key := statKey{domainName, e.ID}
+		stat, ok := stats[key]
+		if !ok {
+			stat = &domain.EntityStat{ID: e.ID, Name: e.Name, Type: e.Type}
+			stats[key] = stat
+			articles[key] = make(map[int]bool)
+			group, ok := byDomain[domainName]
+			if !ok {
+				group = &domain.DomainEntities{Domain: domainName}
+				byDomain[domainName] = group
+				domains = append(domains, group)
+			}
+			group.Entities = append(group.Entities, stat)
+		}
+		if !articles[key][art.ID] {
+			articles[key][art.ID] = true
+			stat.ArticleCount++
+		}
+		for _, m := range strings.Split(row.Mention, "; ") {
+			if m = strings.TrimSpace(m); m != "" && !slices.Contains(stat.Mentions, m) {
+				stat.Mentions = append(stat.Mentions, m)
+			}
+		}
+	}
+
+	for _, group := range domains {
+		sort.SliceStable(group.Entities, func(i, j int) bool {
+			if group.Entities[i].ArticleCount != group.Entities[j].ArticleCount {
+				return group.Entities[i].ArticleCount > group.Entities[j].ArticleCount
+			}
+			return group.Entities[i].Name < group.Entities[j].Name
+		})
+	}
+	sort.Slice(domains, func(i, j int) bool {
+		return domains[i].Domain < domains[j].Domain
+	})
+	return domains, nil
+}
@@ -0,0 +1,60 @@
+package data
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/iWorld-y/domain_radar/app/common/ent"
+	"github.com/iWorld-y/domain_radar/app/common/ent/reportrunevent"
+	"github.com/iWorld-y/domain_radar/app/display/internal/domain"
+	"github.com/iWorld-y/domain_radar/app/display/internal/repo"
+)
+
+type eventRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewEventRepo(data *Data, logger log.Logger) repo.EventRepo {
+	return &eventRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *eventRepo) ListRunEvents(ctx context.Context, filter domain.RunEventFilter) ([]*domain.RunEvent, error) {
+	run, err := r.data.db.ReportRun.Get(ctx, filter.RunID)
+	if ent.IsNotFound(err) || (err == nil && run.UserID != 0 && run.UserID != filter.UserID) {
+		return nil, errors.NotFound("REPORT_NOT_FOUND", "report not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	query := r.data.db.ReportRunEvent.Query().
+		Where(reportrunevent.RunID(filter.RunID)).
+		Order(ent.Asc(reportrunevent.FieldCreatedAt), ent.Asc(reportrunevent.FieldID))
+	if filter.Domain != "" {
+		query.Where(reportrunevent.Domain(filter.Domain))
+	}
+	if filter.Type != "" {
+		query.Where(reportrunevent.Type(filter.Type))
+	}
+	rows, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	events := make([]*domain.RunEvent, 0, len(rows))
+	for _, e := range rows {
+		events = append(events, &domain.RunEvent{
+			ID:        e.ID,
+			Domain:    e.Domain,
+			Type:      e.Type,
+			Message:   e.Message,
+			Data:      e.Data,
+			CreatedAt: e.CreatedAt.Format("2006-01-02 15:04:05.000"),
+		})
+	}
+	return events, nil
+}
@@ -0,0 +1,84 @@
+package data
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/iWorld-y/domain_radar/app/common/ent"
+	"github.com/iWorld-y/domain_radar/app/common/ent/analysislens"
+	"github.com/iWorld-y/domain_radar/app/display/internal/domain"
+	"github.com/iWorld-y/domain_radar/app/display/internal/repo"
+)
+
+type lensRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewLensRepo(data *Data, logger log.Logger) repo.LensRepo {
+	return &lensRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *lensRepo) ListLenses(ctx context.Context, userID int) ([]*domain.AnalysisLens, error) {
+	rows, err := r.data.db.AnalysisLens.Query().
+		Where(analysislens.UserID(userID)).
+		Order(ent.Asc(analysislens.FieldPosition), ent.Asc(analysislens.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lenses := make([]*domain.AnalysisLens, 0, len(rows))
+	for _, l := range rows {
+		lenses = append(lenses, &domain.AnalysisLens{
+			ID:          l.ID,
+			Name:        l.Name,
+			Instruction: l.Instruction,
+			Position:    l.Position,
+		})
+	}
+	return lenses, nil
+}
+
+func (r *lensRepo) SaveLens(ctx context.Context, userID int, l *domain.AnalysisLens) (int, error) {
+	existing, err := r.data.db.AnalysisLens.Query().
+		Where(analysislens.UserID(userID), analysislens.Name(l.Name)).
+		Only(ctx)
+	switch {
+	case err == nil:
+		err = existing.Update().
+			SetInstruction(l.Instruction).
+			SetPosition(l.Position).
+			Exec(ctx)
+		return existing.ID, err
+	case !ent.IsNotFound(err):
+		return 0, err
+	}
+
+	created, err := r.data.db.AnalysisLens.Create().
+		SetUserID(userID).
+		SetName(l.Name).
+		SetInstruction(l.Instruction).
+		SetPosition(l.Position).
+		Save(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return created.ID, nil
+}
+
+func (r *lensRepo) DeleteLens(ctx context.Context, userID int, name string) error {
+	n, err := r.data.db.AnalysisLens.Delete().
+		Where(analysislens.UserID(userID), analysislens.Name(name)).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return errors.NotFound("LENS_NOT_FOUND", "analysis lens not found")
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+package data
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/iWorld-y/domain_radar/app/common/ent"
+	"github.com/iWorld-y/domain_radar/app/common/ent/persona"
+	"github.com/iWorld-y/domain_radar/app/display/internal/domain"
+	"github.com/iWorld-y/domain_radar/app/display/internal/repo"
+)
+
+type personaRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewPersonaRepo(data *Data, logger log.Logger) repo.PersonaRepo {
+	return &personaRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *personaRepo) GetPersona(ctx context.Context, userID int, name string, version int) (*domain.Persona, error) {
+	query := r.data.db.Persona.Query().Where(persona.UserID(userID), persona.Name(name))
+	if version > 0 {
+		query.Where(persona.Version(version))
+	} else {
+		query.Where(persona.Archived(false)).Order(ent.Desc(persona.FieldVersion)).Limit(1)
+	}
+	p, err := query.First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.NotFound("PERSONA_NOT_FOUND", "persona not found")
+		}
+		return nil, err
+	}
+	return toPersona(p), nil
+}
+
+func (r *personaRepo) ListPersonas(ctx context.Context, userID int) ([]*domain.Persona, error) {
+	rows, err := r.data.db.Persona.Query().
+		Where(persona.UserID(userID), persona.Archived(false)).
+		Order(ent.Asc(persona.FieldName), ent.Desc(persona.FieldVersion)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result []*domain.Persona
+	for _, p := range rows {
+		if n := len(result); n > 0 && result[n-1].Name == p.Name {
+			continue
+		}
+		result = append(result, toPersona(p))
+	}
+	return result, nil
+}
+
+func (r *personaRepo) ArchivePersona(ctx context.Context, userID int, name string) error {
+	n, err := r.data.db.Persona.Update().
+		Where(persona.UserID(userID), persona.Name(name), persona.Archived(false)).
+		SetArchived(true).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return errors.NotFound("PERSONA_NOT_FOUND", "persona not found")
+	}
+	return nil
+}
+
+func toPersona(p *ent.Persona) *domain.Persona {
+	return &domain.Persona{
+		ID:           p.ID,
+		Name:         p.Name,
+		Version:      p.Version,
+		Role:         p.Role,
+		Seniority:    p.Seniority,
+		TechStack:    p.TechStack,
+		Goals:        p.Goals,
+		RiskAppetite: p.RiskAppetite,
+		TimeHorizon:  p.TimeHorizon,
+		Constraints:  p.Constraints,
+		Source:       p.Source,
+		CreatedAt:    p.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
+
+func (r *personaRepo) SavePersona(ctx context.Context, userID int, p *domain.Persona, rendered string) (int, int, error) {
+	tx, err := r.data.db.Tx(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+	rollback := func(err error) (int, int, error) {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: %v", err, rerr)
+		}
+		return 0, 0, err
+	}
+
+	version := 1
+	latest, err := tx.Persona.Query().
+		Where(persona.UserID(userID), persona.Name(p.Name)).
+		Order(ent.Desc(persona.FieldVersion)).
+		First(ctx)
+	switch {
+	case err == nil:
+		version = latest.Version + 1
+	case !ent.IsNotFound(err):
+		return rollback(err)
+	}
+
+	created, err := tx.Persona.Create().
+		SetUserID(userID).
+		SetName(p.Name).
+		SetVersion(version).
+		SetRole(p.Role).
+		SetSeniority(p.Seniority).
+		SetTechStack(nonNil(p.TechStack)).
+		SetGoals(nonNil(p.Goals)).
+		SetRiskAppetite(p.RiskAppetite).
+		SetTimeHorizon(p.TimeHorizon).
+		SetConstraints(nonNil(p.Constraints)).
+		SetSource(p.Source).
+		Save(ctx)
+	if err != nil {
+		return rollback(err)
+	}
+	if p.Name == domain.DefaultPersonaName {
+		if err := tx.User.UpdateOneID(userID).SetPersona(rendered).Exec(ctx); err != nil {
+			return rollback(err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, 0, err
+	}
+	return created.ID, version, nil
+}
+
+func nonNil(items []string) []string {
+	if items == nil {
+		return []string{}
+	}
+	return items
+}
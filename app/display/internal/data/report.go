@@ -2,14 +2,22 @@ package data
 
 import (
 	"context"
+	"math"
+	"sort"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/iWorld-y/domain_radar/app/common/ent"
+	"github.com/iWorld-y/domain_radar/app/common/ent/analysissection"
+	"github.com/iWorld-y/domain_radar/app/common/ent/article"
+	"github.com/iWorld-y/domain_radar/app/common/ent/claimverification"
 	"github.com/iWorld-y/domain_radar/app/common/ent/deepanalysisresult"
 	"github.com/iWorld-y/domain_radar/app/common/ent/domainreport"
+	"github.com/iWorld-y/domain_radar/app/common/ent/domainrun"
+	"github.com/iWorld-y/domain_radar/app/common/ent/keyevent"
+	"github.com/iWorld-y/domain_radar/app/common/ent/predicate"
 	"github.com/iWorld-y/domain_radar/app/common/ent/reportrun"
 	"github.com/iWorld-y/domain_radar/app/display/internal/domain"
 	"github.com/iWorld-y/domain_radar/app/display/internal/repo"
@@ -27,34 +35,49 @@ func NewReportRepo(data *Data, logger log.Logger) repo.ReportRepo {
 	}
 }
 
-func (r *reportRepo) ListReports(ctx context.Context, page, pageSize int) ([]*domain.ReportSummary, int, error) {
+// visibleRuns 用户可见的运行记录：本人发起的运行，以及不属于任何用户的运行
+func visibleRuns(userID int) predicate.ReportRun {
+	return reportrun.Or(reportrun.UserID(userID), reportrun.UserIDIsNil())
+}
+
+func (r *reportRepo) ListReports(ctx context.Context, userID, page, pageSize int) ([]*domain.ReportSummary, int, error) {
 	offset := (page - 1) * pageSize
 
 	var results []struct {
-		ID          int       `sql:"id"`
-		Title       string    `sql:"title"`
-		CreatedAt   time.Time `sql:"created_at"`
-		DomainCount int       `sql:"domain_count"`
-		AvgScore    float64   `sql:"avg_score"`
+		ID          int        `sql:"id"`
+		Title       string     `sql:"title"`
+		Status      string     `sql:"status"`
+		WindowStart *time.Time `sql:"window_start"`
+		WindowEnd   *time.Time `sql:"window_end"`
+		CreatedAt   time.Time  `sql:"created_at"`
+		DomainCount int        `sql:"domain_count"`
+		AvgScore    float64    `sql:"avg_score"`
 	}
 
 	// Using Modify to perform custom SQL aggregation
 	err := r.data.db.ReportRun.Query().
+		Where(visibleRuns(userID)).
 		Limit(pageSize).
 		Offset(offset).
 		Order(ent.Desc(reportrun.FieldCreatedAt)).
 		Modify(func(s *sql.Selector) {
 			t := sql.Table(reportrun.Table)
+			rel := sql.Table(reportrun.DomainReportsTable)
 			dr := sql.Table(domainreport.Table)
-			s.LeftJoin(dr).On(t.C(reportrun.FieldID), dr.C(domainreport.FieldRunID))
+			// 领域报告可由多次运行共享，通过关联表统计每次运行包含的报告
+			s.LeftJoin(rel).On(t.C(reportrun.FieldID), rel.C(reportrun.DomainReportsPrimaryKey[0])).
+				LeftJoin(dr).On(rel.C(reportrun.DomainReportsPrimaryKey[1]), dr.C(domainreport.FieldID))
 			s.Select(
 				t.C(reportrun.FieldID),
 				t.C(reportrun.FieldTitle),
+				t.C(reportrun.FieldStatus),
+				t.C(reportrun.FieldWindowStart),
+				t.C(reportrun.FieldWindowEnd),
 				t.C(reportrun.FieldCreatedAt),
 				sql.As(sql.Count(dr.C(domainreport.FieldID)), "domain_count"),
 				sql.As(sql.Avg(dr.C(domainreport.FieldScore)), "avg_score"),
 			)
-			s.GroupBy(t.C(reportrun.FieldID), t.C(reportrun.FieldCreatedAt), t.C(reportrun.FieldTitle))
+			s.GroupBy(t.C(reportrun.FieldID), t.C(reportrun.FieldCreatedAt), t.C(reportrun.FieldTitle), t.C(reportrun.FieldStatus), t.C(reportrun.FieldWindowStart), t.C(reportrun.FieldWindowEnd))
 		}).
 		Scan(ctx, &results)
 	if err != nil {
@@ -68,11 +91,13 @@ func (r *reportRepo) ListReports(ctx context.Context, page, pageSize int) ([]*do
 			Title:        res.Title,
 			Date:         res.CreatedAt.Format("2006-01-02 15:04:05"),
 			DomainCount:  res.DomainCount,
-			AverageScore: int(res.AvgScore),
+			AverageScore: int(math.Round(res.AvgScore)),
+			Status:       res.Status,
+			Window:       toRunWindow(res.WindowStart, res.WindowEnd),
 		})
 	}
 
-	total, err := r.data.db.ReportRun.Query().Count(ctx)
+	total, err := r.data.db.ReportRun.Query().Where(visibleRuns(userID)).Count(ctx)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -80,16 +105,31 @@ func (r *reportRepo) ListReports(ctx context.Context, page, pageSize int) ([]*do
 	return summaries, total, nil
 }
 
-func (r *reportRepo) GetReportByID(ctx context.Context, id int, userID int) (*domain.GroupedReport, error) {
+func (r *reportRepo) GetReportByID(ctx context.Context, id int, userID int, personaID int) (*domain.GroupedReport, error) {
 	run, err := r.data.db.ReportRun.Query().
-		Where(reportrun.ID(id)).
+		Where(reportrun.ID(id), visibleRuns(userID)).
 		WithDeepAnalysisResults(func(q *ent.DeepAnalysisResultQuery) {
 			q.Where(deepanalysisresult.UserID(userID))
+			q.Order(ent.Asc(deepanalysisresult.FieldID))
 			q.WithActionGuides()
+			q.WithSections(func(q *ent.AnalysisSectionQuery) {
+				q.Order(ent.Asc(analysissection.FieldPosition))
+			})
 		}).
 		WithDomainReports(func(q *ent.DomainReportQuery) {
-			q.WithArticles()
-			q.WithKeyEvents()
+			q.WithArticles(func(q *ent.ArticleQuery) {
+				q.Order(ent.Asc(article.FieldRefIndex), ent.Asc(article.FieldID))
+			})
+			q.WithKeyEvents(func(q *ent.KeyEventQuery) {
+				q.Order(ent.Asc(keyevent.FieldID))
+				q.WithArticles()
+			})
+			q.WithClaimVerifications(func(q *ent.ClaimVerificationQuery) {
+				q.Order(ent.Asc(claimverification.FieldID))
+			})
+		}).
+		WithDomainRuns(func(q *ent.DomainRunQuery) {
+			q.Order(ent.Asc(domainrun.FieldID))
 		}).
 		Only(ctx)
 	if err != nil {
@@ -100,14 +140,32 @@ func (r *reportRepo) GetReportByID(ctx context.Context, id int, userID int) (*do
 	}
 
 	grouped := &domain.GroupedReport{
-		ID:   run.ID,
-		Date: run.CreatedAt.Format("2006-01-02 15:04:05"),
+		ID:     run.ID,
+		Date:   run.CreatedAt.Format("2006-01-02 15:04:05"),
+		Window: toRunWindow(run.WindowStart, run.WindowEnd),
+	}
+
+	for _, dr := range run.Edges.DomainRuns {
+		grouped.DomainStatuses = append(grouped.DomainStatuses, domain.DomainStatus{
+			Domain:       dr.DomainName,
+			Status:       dr.Status,
+			Error:        dr.Error,
+			ArticleCount: dr.ArticleCount,
+		})
 	}
 
 	// Map DeepAnalysis
 	if len(run.Edges.DeepAnalysisResults) > 0 {
 		da := run.Edges.DeepAnalysisResults[0]
+		for _, result := range run.Edges.DeepAnalysisResults {
+			grouped.Personas = append(grouped.Personas, domain.PersonaRef{ID: result.PersonaID, Name: result.PersonaName})
+			if personaID > 0 && result.PersonaID == personaID {
+				da = result
+			}
+		}
 		grouped.DeepAnalysis = &domain.DeepAnalysisResult{
+			PersonaID:     da.PersonaID,
+			PersonaName:   da.PersonaName,
 			MacroTrends:   da.MacroTrends,
 			Opportunities: da.Opportunities,
 			Risks:         da.Risks,
@@ -115,6 +173,9 @@ func (r *reportRepo) GetReportByID(ctx context.Context, id int, userID int) (*do
 		for _, ag := range da.Edges.ActionGuides {
 			grouped.DeepAnalysis.ActionGuides = append(grouped.DeepAnalysis.ActionGuides, ag.GuideContent)
 		}
+		for _, section := range da.Edges.Sections {
+			grouped.DeepAnalysis.Sections = append(grouped.DeepAnalysis.Sections, domain.AnalysisSection{Name: section.Name, Content: section.Content})
+		}
 	}
 
 	// Map DomainReports
@@ -125,9 +186,28 @@ func (r *reportRepo) GetReportByID(ctx context.Context, id int, userID int) (*do
 			Overview:   dr.Overview,
 			Trends:     dr.Trends,
 			Score:      dr.Score,
+			LLMScore:   dr.LlmScore,
 		}
-		for _, art := range dr.Edges.Articles {
+		if dr.HeatRaw != nil {
+			rp.Heat = &domain.HeatScore{
+				ResultCount:  dr.ResultCount,
+				Baseline:     dr.HeatBaseline,
+				Volume:       dr.HeatVolume,
+				Diversity:    dr.HeatDiversity,
+				Recency:      dr.HeatRecency,
+				Engagement:   dr.HeatEngagement,
+				Significance: dr.HeatSignificance,
+				Raw:          *dr.HeatRaw,
+			}
+		}
+		for i, art := range dr.Edges.Articles {
+			index := art.RefIndex
+			if index == 0 {
+				// 兼容未记录引用序号的历史数据
+				index = i + 1
+			}
 			rp.Articles = append(rp.Articles, domain.Article{
+				Index:   index,
 				Title:   art.Title,
 				Link:    art.Link,
 				Source:  art.Source,
@@ -135,10 +215,32 @@ func (r *reportRepo) GetReportByID(ctx context.Context, id int, userID int) (*do
 			})
 		}
 		for _, ke := range dr.Edges.KeyEvents {
-			rp.KeyEvents = append(rp.KeyEvents, ke.EventContent)
+			event := domain.KeyEvent{Content: ke.EventContent, Verdict: ke.Verdict}
+			for _, art := range ke.Edges.Articles {
+				event.Sources = append(event.Sources, art.RefIndex)
+			}
+			sort.Ints(event.Sources)
+			rp.KeyEvents = append(rp.KeyEvents, event)
+		}
+		for _, cv := range dr.Edges.ClaimVerifications {
+			rp.Verifications = append(rp.Verifications, domain.ClaimVerification{
+				ClaimType: cv.ClaimType,
+				Claim:     cv.Claim,
+				Verdict:   cv.Verdict,
+				Reason:    cv.Reason,
+				Dropped:   cv.Dropped,
+			})
 		}
 		grouped.Domains = append(grouped.Domains, rp)
 	}
 
 	return grouped, nil
 }
+
+// toRunWindow 转换运行记录中的时间范围，未记录时返回空值
+func toRunWindow(start, end *time.Time) domain.RunWindow {
+	if start == nil || end == nil {
+		return domain.RunWindow{}
+	}
+	return domain.RunWindow{Start: start.Format(time.RFC3339), End: end.Format(time.RFC3339)}
+}
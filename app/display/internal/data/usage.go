@@ -0,0 +1,112 @@
+package data
+
+import (
+	"context"
+	"sort"
+	"strconv"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/iWorld-y/domain_radar/app/common/ent"
+	"github.com/iWorld-y/domain_radar/app/common/ent/llmcall"
+	"github.com/iWorld-y/domain_radar/app/common/ent/predicate"
+	"github.com/iWorld-y/domain_radar/app/display/internal/domain"
+	"github.com/iWorld-y/domain_radar/app/display/internal/repo"
+)
+
+type usageRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewUsageRepo(data *Data, logger log.Logger) repo.UsageRepo {
+	return &usageRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// usageRow 聚合查询结果，分组字段按需填充
+type usageRow struct {
+	Stage            string  `sql:"stage"`
+	Model            string  `sql:"model"`
+	Domain           string  `sql:"domain"`
+	RunID            int     `sql:"run_id"`
+	Calls            int     `sql:"calls"`
+	PromptTokens     int     `sql:"prompt_tokens"`
+	CompletionTokens int     `sql:"completion_tokens"`
+	TotalTokens      int     `sql:"total_tokens"`
+	Cost             float64 `sql:"cost"`
+	AvgLatency       float64 `sql:"avg_latency"`
+}
+
+func (row *usageRow) bucket(key string) *domain.UsageBucket {
+	return &domain.UsageBucket{
+		Key:              key,
+		Calls:            row.Calls,
+		PromptTokens:     row.PromptTokens,
+		CompletionTokens: row.CompletionTokens,
+		TotalTokens:      row.TotalTokens,
+		Cost:             row.Cost,
+		AvgLatencyMs:     int(row.AvgLatency),
+	}
+}
+
+func usageAggregates() []ent.AggregateFunc {
+	return []ent.AggregateFunc{
+		ent.As(ent.Count(), "calls"),
+		ent.As(ent.Sum(llmcall.FieldPromptTokens), "prompt_tokens"),
+		ent.As(ent.Sum(llmcall.FieldCompletionTokens), "completion_tokens"),
+		ent.As(ent.Sum(llmcall.FieldTotalTokens), "total_tokens"),
+		ent.As(ent.Sum(llmcall.FieldCost), "cost"),
+		ent.As(ent.Mean(llmcall.FieldLatencyMs), "avg_latency"),
+	}
+}
+
+func (r *usageRepo) GetUsageStats(ctx context.Context, filter domain.UsageFilter) (*domain.UsageStats, error) {
+	preds := []predicate.LLMCall{llmcall.UserID(filter.UserID)}
+	if filter.RunID > 0 {
+		preds = append(preds, llmcall.RunID(filter.RunID))
+	}
+	if !filter.Since.IsZero() {
+		preds = append(preds, llmcall.CreatedAtGTE(filter.Since))
+	}
+	query := func() *ent.LLMCallQuery {
+		return r.data.db.LLMCall.Query().Where(preds...)
+	}
+
+	stats := &domain.UsageStats{}
+
+	var total []usageRow
+	if err := query().Aggregate(usageAggregates()...).Scan(ctx, &total); err != nil {
+		return nil, err
+	}
+	if len(total) > 0 {
+		stats.Total = *total[0].bucket("total")
+	}
+
+	groups := []struct {
+		field  string
+		target *[]*domain.UsageBucket
+		key    func(row *usageRow) string
+	}{
+		{llmcall.FieldStage, &stats.ByStage, func(row *usageRow) string { return row.Stage }},
+		{llmcall.FieldModel, &stats.ByModel, func(row *usageRow) string { return row.Model }},
+		{llmcall.FieldDomain, &stats.ByDomain, func(row *usageRow) string { return row.Domain }},
+		{llmcall.FieldRunID, &stats.ByRun, func(row *usageRow) string { return strconv.Itoa(row.RunID) }},
+	}
+	for _, g := range groups {
+		var rows []usageRow
+		if err := query().GroupBy(g.field).Aggregate(usageAggregates()...).Scan(ctx, &rows); err != nil {
+			return nil, err
+		}
+		for i := range rows {
+			*g.target = append(*g.target, rows[i].bucket(g.key(&rows[i])))
+		}
+		// 费用高的维度排在前面
+		sort.Slice(*g.target, func(i, j int) bool {
+			return (*g.target)[i].Cost > (*g.target)[j].Cost
+		})
+	}
+
+	return stats, nil
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/iWorld-y/domain_radar/app/common/ent"
 	"github.com/iWorld-y/domain_radar/app/common/ent/user"
 	"github.com/iWorld-y/domain_radar/app/display/internal/usecase"
+	dm "github.com/iWorld-y/domain_radar/app/domain_radar/pkg/model"
 )
 
 type userRepo struct {
@@ -26,6 +27,7 @@ func (r *userRepo) CreateUser(ctx context.Context, u *usecase.User) error {
 	_, err := r.data.db.User.Create().
 		SetUsername(u.Username).
 		SetPasswordHash(u.PasswordHash).
+		SetReportLanguage(u.ReportLanguage).
 		Save(ctx)
 	return err
 }
@@ -40,25 +42,47 @@ func (r *userRepo) GetUserByUsername(ctx context.Context, username string) (*use
 		}
 		return nil, err
 	}
+	return toUser(u), nil
+}
+
+func (r *userRepo) ListUsers(ctx context.Context) ([]*usecase.User, error) {
+	users, err := r.data.db.User.Query().
+		Order(ent.Asc(user.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*usecase.User, 0, len(users))
+	for _, u := range users {
+		result = append(result, toUser(u))
+	}
+	return result, nil
+}
+
+func toUser(u *ent.User) *usecase.User {
 	domains := u.Domains
 	if domains == nil {
-		domains = []string{}
+		domains = []dm.DomainConfig{}
 	}
 	return &usecase.User{
-		ID:           u.ID,
-		Username:     u.Username,
-		PasswordHash: u.PasswordHash,
-		Persona:      u.Persona,
-		Domains:      domains,
-	}, nil
+		ID:              u.ID,
+		Username:        u.Username,
+		PasswordHash:    u.PasswordHash,
+		Persona:         u.Persona,
+		Domains:         domains,
+		MaxTokensPerRun: u.MaxTokensPerRun,
+		MaxCostPerRun:   u.MaxCostPerRun,
+		ReportLanguage:  u.ReportLanguage,
+	}
 }
 
-func (r *userRepo) UpdateUserProfile(ctx context.Context, id int, persona string, domains []string) error {
+func (r *userRepo) UpdateUserProfile(ctx context.Context, id int, persona string, domains []dm.DomainConfig, reportLanguage string) error {
 	if domains == nil {
-		domains = []string{}
+		domains = []dm.DomainConfig{}
 	}
 	return r.data.db.User.UpdateOneID(id).
 		SetPersona(persona).
 		SetDomains(domains).
+		SetReportLanguage(reportLanguage).
 		Exec(ctx)
 }
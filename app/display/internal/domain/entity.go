@@ -0,0 +1,24 @@
+package domain
+
+// EntityFilter 实体统计的筛选条件
+type EntityFilter struct {
+	RunID  int
+	UserID int    // 当前用户，只能查看自己或不属于任何用户的运行记录
+	Domain string // 为空时不限领域
+	Type   string // 为空时不限实体类型
+}
+
+// EntityStat 实体在某个领域中被提及的统计
+type EntityStat struct {
+	ID           int
+	Name         string
+	Type         string
+	ArticleCount int      // 提及该实体的文章数
+	Mentions     []string // 文章中出现的原始写法
+}
+
+// DomainEntities 某个领域的实体排行
+type DomainEntities struct {
+	Domain   string
+	Entities []*EntityStat
+}
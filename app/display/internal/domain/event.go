@@ -0,0 +1,19 @@
+package domain
+
+// RunEventFilter 运行事件的筛选条件
+type RunEventFilter struct {
+	RunID  int
+	UserID int    // 当前用户，只能查看自己或不属于任何用户的运行记录
+	Domain string // 为空时不限领域
+	Type   string // 为空时不限事件类型
+}
+
+// RunEvent 运行中一个流水线步骤的事件
+type RunEvent struct {
+	ID        int
+	Domain    string // 所属领域，运行级别的事件为空
+	Type      string
+	Message   string
+	Data      map[string]any // 结果数、token 用量、耗时等结构化详情
+	CreatedAt string         // 精确到毫秒
+}
@@ -0,0 +1,15 @@
+package domain
+
+// AnalysisLens 用户自定义的深度解读栏目
+type AnalysisLens struct {
+	ID          int
+	Name        string
+	Instruction string
+	Position    int // 展示顺序，升序
+}
+
+// AnalysisSection 深度解读中某个自定义栏目的内容
+type AnalysisSection struct {
+	Name    string
+	Content string
+}
@@ -0,0 +1,26 @@
+package domain
+
+// DefaultPersonaName 默认画像名称，该画像渲染后的文本同步为用户的画像文本
+const DefaultPersonaName = "default"
+
+// 画像来源
+const (
+	PersonaSourceManual    = "manual"
+	PersonaSourceInterview = "interview"
+)
+
+// Persona 结构化用户画像的某个版本
+type Persona struct {
+	ID           int
+	Name         string
+	Version      int
+	Role         string
+	Seniority    string
+	TechStack    []string
+	Goals        []string
+	RiskAppetite string // low / medium / high
+	TimeHorizon  string
+	Constraints  []string
+	Source       string // manual / interview
+	CreatedAt    string
+}
@@ -2,22 +2,55 @@ package domain
 
 // Article 关联文章信息
 type Article struct {
+	Index   int // 引用序号，从 1 开始
 	Title   string
 	Link    string
 	Source  string
 	PubDate string
 }
 
+// KeyEvent 关键事件及其引用来源
+type KeyEvent struct {
+	Content string
+	Sources []int  // 引用的文章序号，对应 Article.Index
+	Verdict string // 核验结论，未核验时为空
+}
+
+// ClaimVerification 论断核验结果
+type ClaimVerification struct {
+	ClaimType string
+	Claim     string
+	Verdict   string
+	Reason    string
+	Dropped   bool
+}
+
+// HeatScore 领域热度的各项信号，分项取值均为 0-1
+type HeatScore struct {
+	ResultCount  int
+	Baseline     float64
+	Volume       float64
+	Diversity    float64
+	Recency      *float64 // 无发布时间信息时为空
+	Engagement   *float64 // 搜索源未提供互动数据时为空
+	Significance float64
+	Raw          float64
+}
+
 // Report 报表领域对象
 type Report struct {
 	ID         int
 	DomainName string
-	Score      int
+	Score      int // 校准后的热度评分
+	LLMScore   int // LLM 判断的重要程度
+	Heat       *HeatScore
 	Overview   string
 	Trends     string
-	KeyEvents  []string
+	KeyEvents  []KeyEvent
 	Articles   []Article
-	CreatedAt  string
+	// Verifications 论断核验结果
+	Verifications []ClaimVerification
+	CreatedAt     string
 }
 
 // ReportSummary 报表摘要信息
@@ -27,14 +60,25 @@ type ReportSummary struct {
 	Date         string
 	DomainCount  int
 	AverageScore int
+	Status       string // 运行状态，取消或失败的运行只包含部分结果
+	Window       RunWindow
+}
+
+// RunWindow 运行检索新闻的时间范围，RFC 3339 格式，未记录时为空
+type RunWindow struct {
+	Start string
+	End   string
 }
 
 // DeepAnalysisResult 全局深度解读
 type DeepAnalysisResult struct {
+	PersonaID     int // 自由文本画像生成时为 0
+	PersonaName   string
 	MacroTrends   string
 	Opportunities string
 	Risks         string
 	ActionGuides  []string
+	Sections      []AnalysisSection // 用户自定义栏目的内容
 }
 
 // GroupedReport 报表详情
@@ -43,4 +87,22 @@ type GroupedReport struct {
 	Date         string
 	Domains      []*Report
 	DeepAnalysis *DeepAnalysisResult
+	Personas     []PersonaRef // 本报告中全部深度解读对应的画像
+	Window       RunWindow
+	// DomainStatuses 各领域的处理结果，包含未生成报告的领域
+	DomainStatuses []DomainStatus
+}
+
+// DomainStatus 领域在运行中的处理结果
+type DomainStatus struct {
+	Domain       string
+	Status       string // "succeeded", "no_articles", "search_failed", "llm_failed", "failed", "skipped"
+	Error        string
+	ArticleCount int
+}
+
+// PersonaRef 深度解读对应的画像
+type PersonaRef struct {
+	ID   int
+	Name string
 }
@@ -0,0 +1,30 @@
+package domain
+
+import "time"
+
+// UsageFilter LLM 用量统计的筛选条件
+type UsageFilter struct {
+	UserID int
+	RunID  int       // 为 0 时不限运行记录
+	Since  time.Time // 为零值时不限时间
+}
+
+// UsageBucket 某一维度下的 LLM 用量汇总
+type UsageBucket struct {
+	Key              string
+	Calls            int
+	PromptTokens     int
+	CompletionTokens int
+	TotalTokens      int
+	Cost             float64
+	AvgLatencyMs     int
+}
+
+// UsageStats LLM 用量统计
+type UsageStats struct {
+	Total    UsageBucket
+	ByStage  []*UsageBucket
+	ByModel  []*UsageBucket
+	ByDomain []*UsageBucket
+	ByRun    []*UsageBucket
+}
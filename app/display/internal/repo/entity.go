@@ -0,0 +1,14 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/iWorld-y/domain_radar/app/display/internal/domain"
+)
+
+// EntityRepo 实体仓库接口
+type EntityRepo interface {
+	// ListEntities 按领域统计运行记录中被提及的实体，各领域内按提及文章数降序排列；
+	// 运行记录不存在或不属于当前用户时返回 NotFound
+	ListEntities(ctx context.Context, filter domain.EntityFilter) ([]*domain.DomainEntities, error)
+}
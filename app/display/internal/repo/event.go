@@ -0,0 +1,13 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/iWorld-y/domain_radar/app/display/internal/domain"
+)
+
+// EventRepo 运行事件仓库接口
+type EventRepo interface {
+	// ListRunEvents 按时间顺序返回运行记录的事件，运行记录不存在或不属于当前用户时返回 NotFound
+	ListRunEvents(ctx context.Context, filter domain.RunEventFilter) ([]*domain.RunEvent, error)
+}
@@ -0,0 +1,17 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/iWorld-y/domain_radar/app/display/internal/domain"
+)
+
+// LensRepo 自定义解读栏目仓库接口
+type LensRepo interface {
+	// ListLenses 获取用户的全部自定义栏目，按展示顺序排列
+	ListLenses(ctx context.Context, userID int) ([]*domain.AnalysisLens, error)
+	// SaveLens 保存自定义栏目，同名栏目已存在时更新其撰写要求与顺序，返回栏目 ID
+	SaveLens(ctx context.Context, userID int, l *domain.AnalysisLens) (int, error)
+	// DeleteLens 删除用户的指定栏目，已生成的解读内容不受影响
+	DeleteLens(ctx context.Context, userID int, name string) error
+}
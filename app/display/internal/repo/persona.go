@@ -0,0 +1,20 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/iWorld-y/domain_radar/app/display/internal/domain"
+)
+
+// PersonaRepo 结构化画像仓库接口
+type PersonaRepo interface {
+	// GetPersona 获取用户指定名称画像的指定版本，version 为 0 时返回未删除画像的最新版本
+	GetPersona(ctx context.Context, userID int, name string, version int) (*domain.Persona, error)
+	// ListPersonas 获取用户全部未删除画像的最新版本
+	ListPersonas(ctx context.Context, userID int) ([]*domain.Persona, error)
+	// SavePersona 保存为用户画像 p.Name 的新版本，默认画像会同时将渲染后的文本同步为用户的画像文本，
+	// 返回新版本的 ID 与版本号
+	SavePersona(ctx context.Context, userID int, p *domain.Persona, rendered string) (int, int, error)
+	// ArchivePersona 删除用户的指定画像，历史版本保留供已生成的深度解读引用
+	ArchivePersona(ctx context.Context, userID int, name string) error
+}
@@ -8,8 +8,9 @@ import (
 
 // ReportRepo 报表仓库接口
 type ReportRepo interface {
-	// ListReports 分页获取报表摘要列表
-	ListReports(ctx context.Context, page, pageSize int) ([]*domain.ReportSummary, int, error)
-	// GetReportByID 根据ID获取报表详情
-	GetReportByID(ctx context.Context, id int, userID int) (*domain.GroupedReport, error)
+	// ListReports 分页获取用户可见的报表摘要列表：本人发起的运行与不属于任何用户的运行
+	ListReports(ctx context.Context, userID, page, pageSize int) ([]*domain.ReportSummary, int, error)
+	// GetReportByID 根据ID获取报表详情，深度解读取 personaID 对应的一份，为 0 或不存在时取第一份；
+	// 运行属于其他用户时返回 NotFound
+	GetReportByID(ctx context.Context, id int, userID int, personaID int) (*domain.GroupedReport, error)
 }
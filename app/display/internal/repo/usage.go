@@ -0,0 +1,13 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/iWorld-y/domain_radar/app/display/internal/domain"
+)
+
+// UsageRepo LLM 用量仓库接口
+type UsageRepo interface {
+	// GetUsageStats 按条件汇总 LLM 调用的 token 用量与费用
+	GetUsageStats(ctx context.Context, filter domain.UsageFilter) (*domain.UsageStats, error)
+}
@@ -41,8 +41,25 @@ const translations = {
         "key_events": "🔥 Key Events",
         "references": "🔗 References",
         "heat_score": "Heat: {score}/10",
+        "heat_volume": "Volume: {count} results (baseline {baseline})",
+        "heat_diversity": "Source diversity:",
+        "heat_recency": "Recency:",
+        "heat_engagement": "Engagement:",
+        "heat_significance": "LLM significance: {score}/10",
+        "dig_deeper": "Dig deeper",
+        "entity_type_company": "Company",
+        "entity_type_person": "Person",
+        "entity_type_product": "Product",
+        "entity_type_project": "Project",
+        "entity_type_organization": "Organization",
+        "research_failed": "Failed to start research",
         "switch_lang": "中文",
         "date_cover": "{date} • Covering {count} domains",
+        "window_range": "News from {start} to {end}",
+        "window_default": "Last 3 days",
+        "window_24h": "Last 24 hours",
+        "window_7d": "Last 7 days",
+        "window_since_last": "Since my last report",
         "profile_title": "Domain Radar - Profile",
         "profile_heading": "👤 User Profile",
         "persona_label": "User Persona",
@@ -50,14 +67,95 @@ const translations = {
         "save_btn": "Save",
         "back_dashboard": "Back to Dashboard",
         "msg_profile_saved": "Profile saved successfully!",
+        "structured_persona_heading": "🧭 Structured Persona",
+        "structured_persona_hint": "Saving a structured persona replaces the persona text above. Suggested domains from the interview are added to your domain list; remember to save your profile.",
+        "interview_start": "Build with an interview",
+        "interview_next": "Next",
+        "interview_thinking": "Thinking...",
+        "interview_failed": "Interview failed, please try again later.",
+        "persona_role": "Role",
+        "persona_seniority": "Seniority",
+        "persona_risk": "Risk Appetite",
+        "risk_low": "Low",
+        "risk_medium": "Medium",
+        "risk_high": "High",
+        "persona_horizon": "Time Horizon",
+        "persona_stack": "Tech Stack (one per line)",
+        "persona_goals": "Goals (one per line)",
+        "persona_constraints": "Constraints (one per line)",
+        "save_persona_btn": "Save Persona",
+        "msg_persona_saved": "Persona saved as version {version}",
+        "persona_names_hint": "You can keep several named personas; only the \"default\" persona replaces the persona text above. Pick the personas to apply when generating a report.",
+        "persona_select_label": "Persona",
+        "persona_name_label": "Persona Name",
+        "persona_new_option": "+ New persona",
+        "delete_persona_btn": "Delete Persona",
+        "confirm_delete_persona": "Delete persona \"{name}\"? Past deep analyses are kept.",
+        "msg_persona_delete_fail": "Failed to delete persona",
+        "persona_picker_label": "Deep analysis personas:",
+        "persona_freeform": "Profile persona",
+        "lenses_heading": "🔍 Custom Analysis Sections",
+        "lenses_hint": "Besides macro trends, opportunities and risks, the deep analysis writes one extra section for each entry below. Saving an existing name updates it.",
+        "lens_name_label": "Section Name",
+        "lens_name_placeholder": "e.g. Competitor moves",
+        "lens_instruction_label": "Instruction",
+        "lens_instruction_placeholder": "What should this section cover?",
+        "save_lens_btn": "Save Section",
+        "delete_lens_btn": "Delete",
+        "confirm_delete_lens": "Delete section \"{name}\"? Past reports keep it.",
+        "msg_lens_required": "Please enter a section name and instruction",
+        "msg_lens_saved": "Section saved",
+        "msg_lens_save_fail": "Failed to save section",
+        "msg_persona_save_fail": "Failed to save persona",
+        "msg_interview_done": "Interview finished. Review the persona and domains, then save.",
         "msg_profile_save_fail": "Failed to save profile",
         "msg_profile_load_fail": "Failed to load profile",
         "domains_label": "Interested Domains",
-        "domains_placeholder": "One domain per line (e.g. AI, Cloud Computing)",
+        "domain_add_btn": "+ Add domain",
+        "domain_name_placeholder": "Domain name",
+        "domain_display_name_placeholder": "Display name (optional)",
+        "domain_enabled": "Enabled",
+        "domain_remove_btn": "Remove",
+        "domain_advanced": "Advanced",
+        "domain_queries_label": "Search queries (one per line, empty = generated from the name)",
+        "domain_include_sites_label": "Only these sites (comma separated)",
+        "domain_exclude_sites_label": "Exclude sites (comma separated)",
+        "domain_languages_label": "Search languages (e.g. zh, en; empty = server default)",
+        "domain_max_articles_label": "Max articles (0 = default)",
+        "domain_weight_label": "Priority weight (default 1)",
+        "domain_window_label": "Time window",
+        "domain_window_inherit": "Same as the report",
+        "report_language_label": "Report Language",
         "generate_report_btn": "Generate Report",
         "generating": "Generating...",
         "task_failed": "Task failed: ",
-        "task_completed": "Report generated successfully!"
+        "task_budget_exceeded": "Run stopped, budget exceeded: ",
+        "task_cancelled": "Task cancelled. Reports generated so far have been kept.",
+        "cancel_task_btn": "Cancel",
+        "domain_status_title": "Some domains have no report in this run:",
+        "domain_status_succeeded": "Done",
+        "domain_status_no_articles": "No relevant news found",
+        "domain_status_search_failed": "Search failed",
+        "domain_status_llm_failed": "Report generation failed",
+        "domain_status_failed": "Failed",
+        "domain_status_skipped": "Skipped",
+        "domain_status_articles": "{count} articles",
+        "retry_failed_domains": "Retry these domains",
+        "retry_failed": "Failed to retry the report",
+        "run_status_running": "Running",
+        "run_status_failed": "Failed",
+        "run_status_cancelled": "Cancelled",
+        "run_status_budget_exceeded": "Budget exceeded",
+        "partial_deep_analysis": "Deep Analysis",
+        "partial_research": "Research notes",
+        "task_completed": "Report generated successfully!",
+        "verification_title": "🔎 Fact Check",
+        "verdict_supported": "Supported",
+        "verdict_partially_supported": "Partially supported",
+        "verdict_unsupported": "Unsupported",
+        "verdict_unverified": "Unverified",
+        "claim_dropped": "Removed",
+        "usage_summary": "Last 30 days: {calls} LLM calls · {tokens} tokens · cost {cost}"
     },
     zh: {
         "title": "领域雷达 - 登录",
@@ -101,8 +199,25 @@ const translations = {
         "key_events": "🔥 关键事件",
         "references": "🔗 参考来源",
         "heat_score": "热度: {score}/10",
+        "heat_volume": "文章量：{count} 条结果（基线 {baseline}）",
+        "heat_diversity": "来源多样性：",
+        "heat_recency": "时效性：",
+        "heat_engagement": "社区互动：",
+        "heat_significance": "LLM 重要程度：{score}/10",
+        "dig_deeper": "深度研究",
+        "entity_type_company": "公司",
+        "entity_type_person": "人物",
+        "entity_type_product": "产品",
+        "entity_type_project": "项目",
+        "entity_type_organization": "机构",
+        "research_failed": "深度研究启动失败",
         "switch_lang": "English",
         "date_cover": "{date} • 覆盖 {count} 个领域",
+        "window_range": "新闻范围：{start} 至 {end}",
+        "window_default": "最近 3 天",
+        "window_24h": "最近 24 小时",
+        "window_7d": "最近 7 天",
+        "window_since_last": "自上次报告以来",
         "profile_title": "领域雷达 - 个人中心",
         "profile_heading": "👤 个人中心",
         "persona_label": "用户画像",
@@ -110,14 +225,95 @@ const translations = {
         "save_btn": "保存",
         "back_dashboard": "返回仪表盘",
         "msg_profile_saved": "个人资料保存成功！",
+        "structured_persona_heading": "🧭 结构化画像",
+        "structured_persona_hint": "保存结构化画像会替换上方的画像文本。访谈推荐的领域会加入关注领域列表，请记得保存个人资料。",
+        "interview_start": "通过访谈生成",
+        "interview_next": "下一步",
+        "interview_thinking": "思考中...",
+        "interview_failed": "访谈失败，请稍后重试。",
+        "persona_role": "角色",
+        "persona_seniority": "资历",
+        "persona_risk": "风险偏好",
+        "risk_low": "低",
+        "risk_medium": "中",
+        "risk_high": "高",
+        "persona_horizon": "关注的时间跨度",
+        "persona_stack": "技术栈（每行一个）",
+        "persona_goals": "目标（每行一个）",
+        "persona_constraints": "约束条件（每行一个）",
+        "save_persona_btn": "保存画像",
+        "msg_persona_saved": "画像已保存为第 {version} 版",
+        "persona_names_hint": "可以保存多个命名画像，只有 \"default\" 画像会替换上方的画像文本。生成报告时可选择参与深度解读的画像。",
+        "persona_select_label": "画像",
+        "persona_name_label": "画像名称",
+        "persona_new_option": "+ 新建画像",
+        "delete_persona_btn": "删除画像",
+        "confirm_delete_persona": "确定删除画像 \"{name}\" 吗？已生成的深度解读会保留。",
+        "msg_persona_delete_fail": "删除画像失败",
+        "persona_picker_label": "深度解读画像：",
+        "persona_freeform": "资料中的画像",
+        "lenses_heading": "🔍 自定义解读栏目",
+        "lenses_hint": "除宏观趋势、机遇与风险外，深度解读会为下列每个栏目额外撰写一节。保存已有名称的栏目会更新它。",
+        "lens_name_label": "栏目名称",
+        "lens_name_placeholder": "例如：竞争对手动向",
+        "lens_instruction_label": "撰写要求",
+        "lens_instruction_placeholder": "这个栏目需要分析什么？",
+        "save_lens_btn": "保存栏目",
+        "delete_lens_btn": "删除",
+        "confirm_delete_lens": "确定删除栏目 \"{name}\" 吗？已生成的报告不受影响。",
+        "msg_lens_required": "请填写栏目名称与撰写要求",
+        "msg_lens_saved": "栏目已保存",
+        "msg_lens_save_fail": "保存栏目失败",
+        "msg_persona_save_fail": "画像保存失败",
+        "msg_interview_done": "访谈完成，请确认画像与领域后保存。",
         "msg_profile_save_fail": "保存失败",
         "msg_profile_load_fail": "加载个人资料失败",
         "domains_label": "感兴趣的领域",
-        "domains_placeholder": "每行一个领域（例如：人工智能、云计算）",
+        "domain_add_btn": "+ 添加领域",
+        "domain_name_placeholder": "领域名称",
+        "domain_display_name_placeholder": "展示名称（可选）",
+        "domain_enabled": "启用",
+        "domain_remove_btn": "删除",
+        "domain_advanced": "高级设置",
+        "domain_queries_label": "检索词（每行一个，留空则由领域名称生成）",
+        "domain_include_sites_label": "只收录这些站点（逗号分隔）",
+        "domain_exclude_sites_label": "排除这些站点（逗号分隔）",
+        "domain_languages_label": "检索语言（如 zh, en，留空使用服务端配置）",
+        "domain_max_articles_label": "文章数上限（0 为默认）",
+        "domain_weight_label": "优先级权重（默认 1）",
+        "domain_window_label": "时间范围",
+        "domain_window_inherit": "与报告一致",
+        "report_language_label": "报告语言",
         "generate_report_btn": "生成日报",
         "generating": "生成中...",
         "task_failed": "任务失败: ",
-        "task_completed": "日报生成成功！"
+        "task_budget_exceeded": "预算已耗尽，运行已中止: ",
+        "task_cancelled": "任务已取消，已生成的报告已保留。",
+        "cancel_task_btn": "取消",
+        "domain_status_title": "以下领域本次未生成报告：",
+        "domain_status_succeeded": "完成",
+        "domain_status_no_articles": "没有找到相关新闻",
+        "domain_status_search_failed": "搜索失败",
+        "domain_status_llm_failed": "报告生成失败",
+        "domain_status_failed": "处理失败",
+        "domain_status_skipped": "已跳过",
+        "domain_status_articles": "{count} 篇文章",
+        "retry_failed_domains": "重试这些领域",
+        "retry_failed": "重试报告失败",
+        "run_status_running": "生成中",
+        "run_status_failed": "失败",
+        "run_status_cancelled": "已取消",
+        "run_status_budget_exceeded": "预算耗尽",
+        "partial_deep_analysis": "深度解读",
+        "partial_research": "研究笔记",
+        "task_completed": "日报生成成功！",
+        "verification_title": "🔎 事实核验",
+        "verdict_supported": "有据可查",
+        "verdict_partially_supported": "部分有据",
+        "verdict_unsupported": "无依据",
+        "verdict_unverified": "未核验",
+        "claim_dropped": "已剔除",
+        "usage_summary": "最近 30 天：{calls} 次 LLM 调用 · {tokens} tokens · 费用 {cost}"
     }
 };
 
@@ -141,6 +337,12 @@ function t(key, params = {}) {
     return val;
 }
 
+// formatWindow 格式化报告的新闻时间范围（RFC 3339）
+function formatWindow(start, end) {
+    const fmt = v => new Date(v).toLocaleString(undefined, { month: 'short', day: 'numeric', hour: '2-digit', minute: '2-digit' });
+    return t("window_range", {start: fmt(start), end: fmt(end)});
+}
+
 function updatePage() {
     document.querySelectorAll('[data-i18n]').forEach(el => {
         const key = el.getAttribute('data-i18n');
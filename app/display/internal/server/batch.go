@@ -0,0 +1,84 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/iWorld-y/domain_radar/app/display/internal/conf"
+	"github.com/iWorld-y/domain_radar/app/display/internal/service"
+	"github.com/iWorld-y/domain_radar/app/domain_radar/pkg/engine"
+)
+
+// BatchScheduler 每天在固定时间为全部用户执行一次批量运行，作为 kratos 的 transport.Server 随服务启停
+type BatchScheduler struct {
+	enabled bool
+	at      time.Duration // 每天执行的时间，相对于当天零点（服务器本地时区）
+	window  string
+	svc     *service.DisplayService
+	log     *log.Helper
+
+	ctx    context.Context // Stop 时取消，用于中止执行中的批量运行
+	cancel context.CancelFunc
+}
+
+// NewBatchScheduler 按配置创建批量运行调度器，未配置或未开启时 Start 直接返回
+func NewBatchScheduler(c *conf.Radar, s *service.DisplayService, logger log.Logger) (*BatchScheduler, error) {
+	b := &BatchScheduler{svc: s, log: log.NewHelper(logger)}
+	b.ctx, b.cancel = context.WithCancel(context.Background())
+	if c == nil || c.Batch == nil || !c.Batch.Enabled {
+		return b, nil
+	}
+	at, err := time.Parse("15:04", c.Batch.DailyAt)
+	if err != nil {
+		return nil, fmt.Errorf("invalid batch.daily_at %q, want HH:MM: %w", c.Batch.DailyAt, err)
+	}
+	if !engine.ValidWindowPreset(c.Batch.Window) {
+		return nil, fmt.Errorf("unsupported batch.window: %s", c.Batch.Window)
+	}
+	b.enabled = true
+	b.at = time.Duration(at.Hour())*time.Hour + time.Duration(at.Minute())*time.Minute
+	b.window = c.Batch.Window
+	return b, nil
+}
+
+// Start 等待到每天的执行时间后执行批量运行，直到服务停止；上一次批量运行未结束时不会重复执行
+func (b *BatchScheduler) Start(ctx context.Context) error {
+	if !b.enabled {
+		return nil
+	}
+	for {
+		next := b.next(time.Now())
+		b.log.Infof("Next batch run at %s", next.Format(time.DateTime))
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil
+		case <-b.ctx.Done():
+			timer.Stop()
+			return nil
+		case <-timer.C:
+		}
+		if err := b.svc.RunBatch(b.ctx, b.window); err != nil {
+			b.log.Errorf("Batch run failed: %v", err)
+		}
+	}
+}
+
+// Stop 停止调度并取消执行中的批量运行，已生成的部分结果保留
+func (b *BatchScheduler) Stop(ctx context.Context) error {
+	b.cancel()
+	return nil
+}
+
+// next 返回 now 之后的下一个执行时间
+func (b *BatchScheduler) next(now time.Time) time.Time {
+	y, m, d := now.Date()
+	next := time.Date(y, m, d, 0, 0, 0, 0, now.Location()).Add(b.at)
+	if !next.After(now) {
+		next = next.AddDate(0, 0, 1)
+	}
+	return next
+}
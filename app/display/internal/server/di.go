@@ -12,15 +12,26 @@ var ProviderSet = wire.NewSet(
 	// Server providers
 	NewHTTPServer,
 	NewRadarEngine,
+	NewBatchScheduler,
 
 	// Data providers
 	data.NewData,
 	data.NewUserRepo,
 	data.NewReportRepo,
+	data.NewUsageRepo,
+	data.NewEntityRepo,
+	data.NewPersonaRepo,
+	data.NewLensRepo,
+	data.NewEventRepo,
 
 	// UseCase providers
 	usecase.NewUserUseCase,
 	usecase.NewReportUseCase,
+	usecase.NewUsageUseCase,
+	usecase.NewEntityUseCase,
+	usecase.NewPersonaUseCase,
+	usecase.NewLensUseCase,
+	usecase.NewEventUseCase,
 
 	// Service providers
 	service.NewDisplayService,
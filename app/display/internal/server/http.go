@@ -48,6 +48,8 @@ func NewHTTPServer(c *conf.Server, auth *conf.Auth, s *service.DisplayService, l
 				}, jwt.WithSigningMethod(jwtv5.SigningMethodHS256)),
 			).Match(NewWhiteListMatcher()).Build(),
 		),
+		// 任务进度与 LLM 流式输出 (SSE)，在路由之外处理以免受请求超时限制
+		http.Filter(taskStreamFilter(NewTaskStreamHandler(jwtKey, s, logger))),
 	}
 	if c.Http.Addr != "" {
 		opts = append(opts, http.Address(c.Http.Addr))
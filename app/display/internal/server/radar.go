@@ -18,9 +18,10 @@ func NewRadarEngine(c *conf.Radar, logger log.Logger) (*engine.Engine, func(), e
 	// 将 internal/conf.Radar 转换为 pkg/config.Config
 	drCfg := &config.Config{
 		LLM: config.LLMConfig{
-			BaseURL: c.Llm.BaseUrl,
-			APIKey:  c.Llm.ApiKey,
-			Model:   c.Llm.Model,
+			BaseURL:    c.Llm.BaseUrl,
+			APIKey:     c.Llm.ApiKey,
+			Model:      c.Llm.Model,
+			CheapModel: c.Llm.CheapModel,
 		},
 		Search: config.SearchConfig{
 			Provider: c.Search.Provider,
@@ -31,16 +32,23 @@ func NewRadarEngine(c *conf.Radar, logger log.Logger) (*engine.Engine, func(), e
 				BaseURL: c.Search.Searxng.BaseUrl,
 				Timeout: int(c.Search.Searxng.Timeout),
 			},
+			Languages: c.Search.Languages,
 		},
-		UserPersona: c.UserPersona,
-		Domains:     c.Domains,
+		UserPersona:    c.UserPersona,
+		Domains:        c.Domains,
+		ReportLanguage: c.ReportLanguage,
 		Log: config.LogConfig{
 			Level: c.Log.Level,
 			File:  c.Log.File,
 		},
 		Concurrency: config.ConcurrencyConfig{
-			QPS: int(c.Concurrency.Qps),
-			RPM: int(c.Concurrency.Rpm),
+			QPS:      int(c.Concurrency.Qps),
+			RPM:      int(c.Concurrency.Rpm),
+			Domains:  int(c.Concurrency.Domains),
+			Fetches:  int(c.Concurrency.Fetches),
+			LLMCalls: int(c.Concurrency.LlmCalls),
+			Search:   rateLimitConfig(c.Concurrency.Search),
+			Fetch:    rateLimitConfig(c.Concurrency.Fetch),
 		},
 		DB: config.DBConfig{
 			Host:     c.Db.Host,
@@ -51,6 +59,56 @@ func NewRadarEngine(c *conf.Radar, logger log.Logger) (*engine.Engine, func(), e
 		},
 	}
 
+	if len(c.Llm.Prices) > 0 {
+		drCfg.LLM.Prices = make(config.PriceTable, len(c.Llm.Prices))
+		for name, p := range c.Llm.Prices {
+			if p == nil {
+				continue
+			}
+			drCfg.LLM.Prices[name] = config.ModelPrice{Prompt: p.Prompt, Completion: p.Completion}
+		}
+	}
+
+	if c.Verification != nil {
+		drCfg.Verification = config.VerificationConfig{
+			Enabled:         c.Verification.Enabled,
+			DropUnsupported: c.Verification.DropUnsupported,
+		}
+	}
+
+	if c.Budget != nil {
+		drCfg.Budget = config.BudgetConfig{
+			MaxTokens: int(c.Budget.MaxTokens),
+			MaxCost:   c.Budget.MaxCost,
+		}
+	}
+
+	if c.Cache != nil {
+		drCfg.Cache = config.CacheConfig{
+			Enabled:  c.Cache.Enabled,
+			TTLHours: int(c.Cache.TtlHours),
+		}
+	}
+
+	if c.Pipeline != nil {
+		drCfg.Pipeline = config.PipelineConfig{
+			DomainStages: c.Pipeline.DomainStages,
+		}
+	}
+
+	if c.Research != nil {
+		drCfg.Research = config.ResearchConfig{
+			MaxSteps: int(c.Research.MaxSteps),
+		}
+	}
+
+	if c.Sharing != nil {
+		drCfg.Sharing = config.SharingConfig{
+			Enabled:     c.Sharing.Enabled,
+			MaxAgeHours: int(c.Sharing.MaxAgeHours),
+		}
+	}
+
 	// 初始化日志
 	if err := drLogger.InitLogger(drCfg.Log.Level, drCfg.Log.File); err != nil {
 		log.NewHelper(logger).Errorf("Failed to init domain_radar logger: %v", err)
@@ -78,3 +136,11 @@ func NewRadarEngine(c *conf.Radar, logger log.Logger) (*engine.Engine, func(), e
 
 	return eng, cleanup, nil
 }
+
+// rateLimitConfig 转换限流配置，未配置时不限流
+func rateLimitConfig(c *conf.RateLimit) config.RateLimitConfig {
+	if c == nil {
+		return config.RateLimitConfig{}
+	}
+	return config.RateLimitConfig{RPM: int(c.Rpm), Burst: int(c.Burst)}
+}
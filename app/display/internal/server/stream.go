@@ -0,0 +1,96 @@
+package server
+
+import (
+	"fmt"
+	nethttp "net/http"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/encoding"
+	"github.com/go-kratos/kratos/v2/encoding/json"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport/http"
+	jwtv5 "github.com/golang-jwt/jwt/v5"
+	"github.com/iWorld-y/domain_radar/app/display/internal/service"
+)
+
+// NewTaskStreamHandler 以 Server-Sent Events 推送任务进度与 LLM 流式输出，
+// 每次状态变化发送一条 GetTaskStatusReply，任务结束后关闭连接
+func NewTaskStreamHandler(jwtKey string, s *service.DisplayService, logger log.Logger) nethttp.HandlerFunc {
+	helper := log.NewHelper(logger)
+	codec := encoding.GetCodec(json.Name)
+
+	return func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		username, err := parseUsername(r, jwtKey)
+		if err != nil {
+			nethttp.Error(w, err.Error(), nethttp.StatusUnauthorized)
+			return
+		}
+		flusher, ok := w.(nethttp.Flusher)
+		if !ok {
+			nethttp.Error(w, "streaming unsupported", nethttp.StatusInternalServerError)
+			return
+		}
+		taskID := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/v1/task/"), "/stream")
+
+		started := false
+		err = s.WatchTask(r.Context(), taskID, username, func(status service.TaskStatus) error {
+			if !started {
+				w.Header().Set("Content-Type", "text/event-stream")
+				w.Header().Set("Cache-Control", "no-cache")
+				w.Header().Set("Connection", "keep-alive")
+				started = true
+			}
+			data, err := codec.Marshal(service.ToTaskStatusReply(status))
+			if err != nil {
+				return err
+			}
+			if _, err := fmt.Fprintf(w, "data: %s\n\n", data); err != nil {
+				return err
+			}
+			flusher.Flush()
+			return nil
+		})
+		if err != nil && !started {
+			nethttp.Error(w, err.Error(), nethttp.StatusNotFound)
+			return
+		}
+		if err != nil && r.Context().Err() == nil {
+			helper.Warnf("task stream %s closed: %v", taskID, err)
+		}
+	}
+}
+
+// taskStreamFilter 在路由之前处理任务流式输出的请求：路由会为每个请求附加 server.http.timeout 的超时，
+// SSE 长连接只应随任务结束或客户端断开而结束
+func taskStreamFilter(stream nethttp.Handler) http.FilterFunc {
+	return func(next nethttp.Handler) nethttp.Handler {
+		return nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+			if r.Method == nethttp.MethodGet && strings.HasPrefix(r.URL.Path, "/v1/task/") && strings.HasSuffix(r.URL.Path, "/stream") {
+				stream.ServeHTTP(w, r)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// parseUsername 校验请求头中的 Bearer token 并返回用户名
+func parseUsername(r *nethttp.Request, jwtKey string) (string, error) {
+	auth := r.Header.Get("Authorization")
+	tokenString, ok := strings.CutPrefix(auth, "Bearer ")
+	if !ok || tokenString == "" {
+		return "", fmt.Errorf("missing jwt token")
+	}
+	claims := jwtv5.MapClaims{}
+	_, err := jwtv5.ParseWithClaims(tokenString, claims, func(token *jwtv5.Token) (interface{}, error) {
+		return []byte(jwtKey), nil
+	}, jwtv5.WithValidMethods([]string{jwtv5.SigningMethodHS256.Alg()}))
+	if err != nil {
+		return "", fmt.Errorf("invalid jwt token")
+	}
+	username, ok := claims["username"].(string)
+	if !ok {
+		return "", fmt.Errorf("invalid username in token")
+	}
+	return username, nil
+}
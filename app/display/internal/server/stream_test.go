@@ -0,0 +1,44 @@
+package server
+
+import (
+	"fmt"
+	"io"
+	nethttp "net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/transport/http"
+)
+
+func TestTaskStreamOutlivesServerTimeout(t *testing.T) {
+	const events = 3
+	stream := nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		for i := 0; i < events; i++ {
+			select {
+			case <-r.Context().Done():
+				return
+			case <-time.After(50 * time.Millisecond):
+			}
+			fmt.Fprintf(w, "data: %d\n\n", i)
+			w.(nethttp.Flusher).Flush()
+		}
+	})
+	srv := http.NewServer(http.Timeout(20*time.Millisecond), http.Filter(taskStreamFilter(stream)))
+	ts := httptest.NewServer(srv.Handler)
+	defer ts.Close()
+
+	resp, err := nethttp.Get(ts.URL + "/v1/task/t1/stream")
+	if err != nil {
+		t.Fatalf("GET stream error = %v", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("read stream error = %v", err)
+	}
+	if got := strings.Count(string(body), "data:"); got != events {
+		t.Errorf("stream events = %d, want %d (body %q)", got, events, body)
+	}
+}
@@ -0,0 +1,66 @@
+package service
+
+import (
+	"context"
+	"slices"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/iWorld-y/domain_radar/app/domain_radar/pkg/engine"
+	dm "github.com/iWorld-y/domain_radar/app/domain_radar/pkg/model"
+)
+
+// RunBatch 为全部设置了领域的用户各生成一次报告，写入各自的运行记录；
+// 相同的领域报告只生成一次，深度解读按各用户的全部画像、自定义栏目与语言单独生成
+func (s *DisplayService) RunBatch(ctx context.Context, window string) error {
+	if s.engine == nil {
+		return errors.InternalServer("ENGINE_NOT_INIT", "domain radar engine not initialized")
+	}
+	users, err := s.ucUser.ListUsers(ctx)
+	if err != nil {
+		return err
+	}
+	var batch []engine.RunOptions
+	names := make(map[int]string, len(users))
+	for _, u := range users {
+		if !slices.ContainsFunc(u.Domains, func(d dm.DomainConfig) bool { return d.Enabled }) {
+			continue
+		}
+		personas, err := s.allPersonaLenses(ctx, u)
+		if err != nil {
+			return err
+		}
+		lenses, err := s.analysisLenses(ctx, u.ID)
+		if err != nil {
+			return err
+		}
+		batch = append(batch, engine.RunOptions{
+			UserID:   u.ID,
+			Domains:  u.Domains,
+			Persona:  u.Persona,
+			Personas: personas,
+			Lenses:   lenses,
+			Budget: engine.Budget{
+				MaxTokens: u.MaxTokensPerRun,
+				MaxCost:   u.MaxCostPerRun,
+			},
+			Language: u.ReportLanguage,
+			Window:   engine.Window{Preset: window},
+		})
+		names[u.ID] = u.Username
+	}
+	if len(batch) == 0 {
+		s.log.Info("RunBatch: no users with domains")
+		return nil
+	}
+
+	s.log.Infof("RunBatch: users=%d, window=%q", len(batch), window)
+	results, err := s.engine.RunBatch(ctx, batch)
+	for _, r := range results {
+		if r.Err != nil {
+			s.log.Errorf("RunBatch: username=%s, run_id=%d, error=%v", names[r.UserID], r.RunID, r.Err)
+		} else {
+			s.log.Infof("RunBatch: username=%s, run_id=%d completed", names[r.UserID], r.RunID)
+		}
+	}
+	return err
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"slices"
 	"sync"
 
 	"github.com/go-kratos/kratos/v2/errors"
@@ -10,26 +11,38 @@ import (
 	jwtv5 "github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 	v1 "github.com/iWorld-y/domain_radar/api/proto/display/v1"
+	"github.com/iWorld-y/domain_radar/app/display/internal/domain"
 	"github.com/iWorld-y/domain_radar/app/display/internal/usecase"
 	"github.com/iWorld-y/domain_radar/app/domain_radar/pkg/engine"
+	dm "github.com/iWorld-y/domain_radar/app/domain_radar/pkg/model"
 )
 
 // TaskStatus 表示后台任务的状态
 type TaskStatus struct {
-	Status   string // "pending", "running", "completed", "failed"
-	Progress int    // 进度 (0-100)
-	Message  string // 状态信息或错误详情
+	Status   string               // "pending", "running", "completed", "failed", "budget_exceeded", "cancelled"
+	Progress int                  // 进度 (0-100)
+	Message  string               // 状态信息或错误详情
+	Partials []TaskPartial        // LLM 流式输出的阶段性内容
+	Domains  []dm.DomainRunStatus // 已处理完的领域及其结果
 }
 
+// ErrTaskNotFound 任务不存在或不属于当前用户
+var ErrTaskNotFound = errors.NotFound("TASK_NOT_FOUND", "task not found")
+
 // DisplayService 实现了展示服务的 API 接口
 type DisplayService struct {
 	v1.UnimplementedDisplayServer
-	ucUser   *usecase.UserUseCase
-	ucReport *usecase.ReportUseCase
-	log      *log.Helper
+	ucUser    *usecase.UserUseCase
+	ucReport  *usecase.ReportUseCase
+	ucUsage   *usecase.UsageUseCase
+	ucEntity  *usecase.EntityUseCase
+	ucPersona *usecase.PersonaUseCase
+	ucLens    *usecase.LensUseCase
+	ucEvent   *usecase.EventUseCase
+	log       *log.Helper
 
 	// 任务管理相关
-	tasks  sync.Map // map[string]*TaskStatus
+	tasks  sync.Map // map[string]*taskState
 	engine *engine.Engine
 }
 
@@ -37,20 +50,30 @@ type DisplayService struct {
 func NewDisplayService(
 	ucUser *usecase.UserUseCase,
 	ucReport *usecase.ReportUseCase,
+	ucUsage *usecase.UsageUseCase,
+	ucEntity *usecase.EntityUseCase,
+	ucPersona *usecase.PersonaUseCase,
+	ucLens *usecase.LensUseCase,
+	ucEvent *usecase.EventUseCase,
 	logger log.Logger,
 	eng *engine.Engine,
 ) *DisplayService {
 	return &DisplayService{
-		ucUser:   ucUser,
-		ucReport: ucReport,
-		log:      log.NewHelper(logger),
-		engine:   eng,
+		ucUser:    ucUser,
+		ucReport:  ucReport,
+		ucUsage:   ucUsage,
+		ucEntity:  ucEntity,
+		ucPersona: ucPersona,
+		ucLens:    ucLens,
+		ucEvent:   ucEvent,
+		log:       log.NewHelper(logger),
+		engine:    eng,
 	}
 }
 
 // Register 用户注册
 func (s *DisplayService) Register(ctx context.Context, req *v1.RegisterReq) (*v1.RegisterReply, error) {
-	err := s.ucUser.Register(ctx, req.Username, req.Password)
+	err := s.ucUser.Register(ctx, req.Username, req.Password, req.ReportLanguage)
 	if err != nil {
 		return &v1.RegisterReply{Success: false, Message: err.Error()}, nil
 	}
@@ -76,8 +99,12 @@ func (s *DisplayService) ListReports(ctx context.Context, req *v1.ListReportsReq
 	if pageSize < 1 {
 		pageSize = 10
 	}
+	u, err := s.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-	summaries, total, err := s.ucReport.List(ctx, page, pageSize)
+	summaries, total, err := s.ucReport.List(ctx, u.ID, page, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -90,6 +117,9 @@ func (s *DisplayService) ListReports(ctx context.Context, req *v1.ListReportsReq
 			Date:         s.Date,
 			DomainCount:  int32(s.DomainCount),
 			AverageScore: int32(s.AverageScore),
+			Status:       s.Status,
+			WindowStart:  s.Window.Start,
+			WindowEnd:    s.Window.End,
 		})
 	}
 
@@ -120,7 +150,7 @@ func (s *DisplayService) GetReport(ctx context.Context, req *v1.GetReportReq) (*
 		return nil, err
 	}
 
-	r, err := s.ucReport.GetByID(ctx, int(req.Id), u.ID)
+	r, err := s.ucReport.GetByID(ctx, int(req.Id), u.ID, int(req.PersonaId))
 	if err != nil {
 		return nil, err
 	}
@@ -131,27 +161,54 @@ func (s *DisplayService) GetReport(ctx context.Context, req *v1.GetReportReq) (*
 		articles := make([]*v1.Article, 0, len(d.Articles))
 		for _, a := range d.Articles {
 			articles = append(articles, &v1.Article{
+				Index:   int32(a.Index),
 				Title:   a.Title,
 				Link:    a.Link,
 				Source:  a.Source,
 				PubDate: a.PubDate,
 			})
 		}
+		keyEvents := make([]string, 0, len(d.KeyEvents))
+		citedKeyEvents := make([]*v1.KeyEvent, 0, len(d.KeyEvents))
+		for _, e := range d.KeyEvents {
+			sources := make([]int32, 0, len(e.Sources))
+			for _, src := range e.Sources {
+				sources = append(sources, int32(src))
+			}
+			keyEvents = append(keyEvents, e.Content)
+			citedKeyEvents = append(citedKeyEvents, &v1.KeyEvent{Content: e.Content, Sources: sources, Verdict: e.Verdict})
+		}
+		verifications := make([]*v1.ClaimVerification, 0, len(d.Verifications))
+		for _, cv := range d.Verifications {
+			verifications = append(verifications, &v1.ClaimVerification{
+				ClaimType: cv.ClaimType,
+				Claim:     cv.Claim,
+				Verdict:   cv.Verdict,
+				Reason:    cv.Reason,
+				Dropped:   cv.Dropped,
+			})
+		}
 		domains = append(domains, &v1.DomainReport{
-			Id:         int32(d.ID),
-			DomainName: d.DomainName,
-			Overview:   d.Overview,
-			Trends:     d.Trends,
-			Score:      int32(d.Score),
-			KeyEvents:  d.KeyEvents,
-			Articles:   articles,
+			Id:             int32(d.ID),
+			DomainName:     d.DomainName,
+			Overview:       d.Overview,
+			Trends:         d.Trends,
+			Score:          int32(d.Score),
+			KeyEvents:      keyEvents,
+			Articles:       articles,
+			CitedKeyEvents: citedKeyEvents,
+			Verifications:  verifications,
+			LlmScore:       int32(d.LLMScore),
+			Heat:           toHeatScore(d.Heat),
 		})
 	}
 
 	reply := &v1.GetReportReply{
-		Id:      int32(r.ID),
-		Date:    r.Date,
-		Domains: domains,
+		Id:          int32(r.ID),
+		Date:        r.Date,
+		Domains:     domains,
+		WindowStart: r.Window.Start,
+		WindowEnd:   r.Window.End,
 	}
 
 	// 如果存在深度分析，则填充深度分析数据
@@ -161,8 +218,24 @@ func (s *DisplayService) GetReport(ctx context.Context, req *v1.GetReportReq) (*
 			Opportunities: r.DeepAnalysis.Opportunities,
 			Risks:         r.DeepAnalysis.Risks,
 			ActionGuides:  r.DeepAnalysis.ActionGuides,
+			PersonaId:     int32(r.DeepAnalysis.PersonaID),
+			PersonaName:   r.DeepAnalysis.PersonaName,
+		}
+		for _, section := range r.DeepAnalysis.Sections {
+			reply.DeepAnalysis.Sections = append(reply.DeepAnalysis.Sections, &v1.AnalysisSection{Name: section.Name, Content: section.Content})
 		}
 	}
+	for _, p := range r.Personas {
+		reply.Personas = append(reply.Personas, &v1.PersonaRef{Id: int32(p.ID), Name: p.Name})
+	}
+	for _, ds := range r.DomainStatuses {
+		reply.DomainStatuses = append(reply.DomainStatuses, &v1.DomainStatus{
+			Domain:       ds.Domain,
+			Status:       ds.Status,
+			Error:        ds.Error,
+			ArticleCount: int32(ds.ArticleCount),
+		})
+	}
 
 	return reply, nil
 }
@@ -186,7 +259,17 @@ func (s *DisplayService) GetProfile(ctx context.Context, req *v1.GetProfileReq)
 	if err != nil {
 		return nil, err
 	}
-	return &v1.GetProfileReply{Username: u.Username, Persona: u.Persona, Domains: u.Domains}, nil
+	names := make([]string, 0, len(u.Domains))
+	for _, d := range u.Domains {
+		names = append(names, d.Name)
+	}
+	return &v1.GetProfileReply{
+		Username:       u.Username,
+		Persona:        u.Persona,
+		Domains:        names,
+		ReportLanguage: u.ReportLanguage,
+		DomainConfigs:  toDomainConfigs(u.Domains),
+	}, nil
 }
 
 // UpdateProfile 更新用户个人资料（如关注领域、用户画像）
@@ -204,15 +287,82 @@ func (s *DisplayService) UpdateProfile(ctx context.Context, req *v1.UpdateProfil
 		return nil, errors.Unauthorized("UNAUTHORIZED", "invalid username in token")
 	}
 
-	s.log.Infof("UpdateProfile: username=%s, domains=%v", username, req.Domains)
+	s.log.Infof("UpdateProfile: username=%s, domains=%v, domain_configs=%d", username, req.Domains, len(req.DomainConfigs))
 
-	err := s.ucUser.UpdateProfile(ctx, username, req.Persona, req.Domains)
+	domains := fromDomainConfigs(req.DomainConfigs)
+	if len(req.DomainConfigs) == 0 {
+		u, err := s.ucUser.GetProfile(ctx, username)
+		if err != nil {
+			return nil, err
+		}
+		domains = mergeDomainNames(u.Domains, req.Domains)
+	}
+	domains, err := engine.NormalizeDomains(domains)
 	if err != nil {
+		return nil, errors.BadRequest("INVALID_DOMAINS", err.Error())
+	}
+
+	if err := s.ucUser.UpdateProfile(ctx, username, req.Persona, domains, req.ReportLanguage); err != nil {
 		return nil, err
 	}
 	return &v1.UpdateProfileReply{Success: true}, nil
 }
 
+// mergeDomainNames 按名称列表设置领域，已有同名领域保留其配置，新领域使用默认配置
+func mergeDomainNames(current []dm.DomainConfig, names []string) []dm.DomainConfig {
+	byName := make(map[string]dm.DomainConfig, len(current))
+	for _, d := range current {
+		byName[d.Name] = d
+	}
+	domains := make([]dm.DomainConfig, 0, len(names))
+	for _, name := range names {
+		d, ok := byName[name]
+		if !ok {
+			d = dm.DomainConfig{Name: name, Enabled: true}
+		}
+		domains = append(domains, d)
+	}
+	return domains
+}
+
+func toDomainConfigs(domains []dm.DomainConfig) []*v1.DomainConfig {
+	out := make([]*v1.DomainConfig, 0, len(domains))
+	for _, d := range domains {
+		out = append(out, &v1.DomainConfig{
+			Name:         d.Name,
+			DisplayName:  d.DisplayName,
+			Queries:      d.Queries,
+			IncludeSites: d.IncludeSites,
+			ExcludeSites: d.ExcludeSites,
+			Languages:    d.Languages,
+			MaxArticles:  int32(d.MaxArticles),
+			Window:       d.Window,
+			Weight:       d.Weight,
+			Enabled:      d.Enabled,
+		})
+	}
+	return out
+}
+
+func fromDomainConfigs(configs []*v1.DomainConfig) []dm.DomainConfig {
+	out := make([]dm.DomainConfig, 0, len(configs))
+	for _, c := range configs {
+		out = append(out, dm.DomainConfig{
+			Name:         c.Name,
+			DisplayName:  c.DisplayName,
+			Queries:      c.Queries,
+			IncludeSites: c.IncludeSites,
+			ExcludeSites: c.ExcludeSites,
+			Languages:    c.Languages,
+			MaxArticles:  int(c.MaxArticles),
+			Window:       c.Window,
+			Weight:       c.Weight,
+			Enabled:      c.Enabled,
+		})
+	}
+	return out
+}
+
 // TriggerReport 异步触发一次领域雷达报告生成任务
 func (s *DisplayService) TriggerReport(ctx context.Context, req *v1.TriggerReportReq) (*v1.TriggerReportReply, error) {
 	if s.engine == nil {
@@ -237,56 +387,318 @@ func (s *DisplayService) TriggerReport(ctx context.Context, req *v1.TriggerRepor
 		return nil, err
 	}
 
-	s.log.Infof("TriggerReport: username=%s, domains=%v, len=%d", username, u.Domains, len(u.Domains))
+	s.log.Infof("TriggerReport: username=%s, domains=%d", username, len(u.Domains))
 
-	if len(u.Domains) == 0 {
+	if !slices.ContainsFunc(u.Domains, func(d dm.DomainConfig) bool { return d.Enabled }) {
 		return nil, errors.BadRequest("NO_DOMAINS", "please configure interested domains in profile first")
 	}
 
+	if !engine.ValidWindowPreset(req.Window) {
+		return nil, errors.BadRequest("INVALID_WINDOW", "unsupported window: "+req.Window)
+	}
+
+	cachePolicy := engine.CacheDefault
+	if req.RefreshCache {
+		cachePolicy = engine.CacheRefresh
+	}
+
+	personas, err := s.personaLenses(ctx, u, req.Personas)
+	if err != nil {
+		return nil, err
+	}
+	lenses, err := s.analysisLenses(ctx, u.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	taskID := s.startTask(username, engine.RunOptions{
+		UserID:   u.ID,
+		Domains:  u.Domains,
+		Persona:  u.Persona,
+		Personas: personas,
+		Lenses:   lenses,
+		Budget: engine.Budget{
+			MaxTokens: u.MaxTokensPerRun,
+			MaxCost:   u.MaxCostPerRun,
+		},
+		Cache:    cachePolicy,
+		Language: u.ReportLanguage,
+		Window:   engine.Window{Preset: req.Window},
+	})
+	return &v1.TriggerReportReply{TaskId: taskID, Message: "Task started"}, nil
+}
+
+// TriggerResearch 异步触发单个领域的深度研究任务
+func (s *DisplayService) TriggerResearch(ctx context.Context, req *v1.TriggerResearchReq) (*v1.TriggerReportReply, error) {
+	if s.engine == nil {
+		return nil, errors.InternalServer("ENGINE_NOT_INIT", "domain radar engine not initialized")
+	}
+	if req.Domain == "" {
+		return nil, errors.BadRequest("INVALID_DOMAIN", "domain is required")
+	}
+
+	u, err := s.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	s.log.Infof("TriggerResearch: username=%s, domain=%s, max_steps=%d", u.Username, req.Domain, req.MaxSteps)
+
+	lenses, err := s.analysisLenses(ctx, u.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	taskID := s.startTask(u.Username, engine.RunOptions{
+		UserID:  u.ID,
+		Domains: []dm.DomainConfig{researchDomain(u.Domains, req.Domain)},
+		Persona: u.Persona,
+		Budget: engine.Budget{
+			MaxTokens: u.MaxTokensPerRun,
+			MaxCost:   u.MaxCostPerRun,
+		},
+		Lenses:   lenses,
+		Research: &engine.ResearchOptions{MaxSteps: int(req.MaxSteps)},
+		Language: u.ReportLanguage,
+	})
+	return &v1.TriggerReportReply{TaskId: taskID, Message: "Task started"}, nil
+}
+
+// researchDomain 返回深度研究使用的领域配置：用户关注的同名领域沿用其配置，否则使用默认配置
+func researchDomain(domains []dm.DomainConfig, name string) dm.DomainConfig {
+	for _, d := range domains {
+		if d.Name == name {
+			d.Enabled = true
+			return d
+		}
+	}
+	return dm.DomainConfig{Name: name, Enabled: true}
+}
+
+// RetryRun 在原运行记录中重新处理失败或缺失的领域，并重新生成深度解读
+func (s *DisplayService) RetryRun(ctx context.Context, req *v1.RetryRunReq) (*v1.TriggerReportReply, error) {
+	if s.engine == nil {
+		return nil, errors.InternalServer("ENGINE_NOT_INIT", "domain radar engine not initialized")
+	}
+	u, err := s.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 提前校验，避免任务启动后才发现运行记录不可重试
+	domains, err := s.engine.RetryDomains(ctx, int(req.Id), u.ID, u.Domains)
+	switch {
+	case errors.Is(err, engine.ErrRunNotFound):
+		return nil, errors.NotFound("REPORT_NOT_FOUND", "report not found")
+	case errors.Is(err, engine.ErrNothingToRetry):
+		return nil, errors.BadRequest("NOTHING_TO_RETRY", "all domains of this report have succeeded")
+	case err != nil:
+		return nil, err
+	}
+	names := make([]string, 0, len(domains))
+	for _, d := range domains {
+		names = append(names, d.Name)
+	}
+	s.log.Infof("RetryRun: username=%s, run=%d, domains=%v", u.Username, req.Id, names)
+
+	personas, err := s.personaLenses(ctx, u, req.Personas)
+	if err != nil {
+		return nil, err
+	}
+	lenses, err := s.analysisLenses(ctx, u.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	taskID := s.startTask(u.Username, engine.RunOptions{
+		UserID:   u.ID,
+		Domains:  u.Domains,
+		Persona:  u.Persona,
+		Personas: personas,
+		Lenses:   lenses,
+		Budget: engine.Budget{
+			MaxTokens: u.MaxTokensPerRun,
+			MaxCost:   u.MaxCostPerRun,
+		},
+		Language:   u.ReportLanguage,
+		RetryRunID: int(req.Id),
+	})
+	return &v1.TriggerReportReply{TaskId: taskID, Message: "Task started"}, nil
+}
+
+// startTask 在后台协程中执行引擎任务，并返回任务 ID
+func (s *DisplayService) startTask(username string, opts engine.RunOptions) string {
 	taskID := uuid.New().String()
-	s.tasks.Store(taskID, &TaskStatus{Status: "pending", Progress: 0, Message: "Initializing..."})
+	ctx, cancel := context.WithCancel(context.Background())
+	task := newTaskState(username, cancel)
+	s.tasks.Store(taskID, task)
+
+	opts.ProgressCallback = func(status string, progress int) {
+		task.setStatus("running", progress, status)
+	}
+	opts.StreamCallback = task.setPartial
+	opts.DomainStatusCallback = task.addDomainStatus
 
 	// 在后台协程中执行耗时的分析任务
 	go func() {
+		defer cancel()
 		defer func() {
 			if r := recover(); r != nil {
 				s.log.Errorf("Recovered from panic: %v", r)
-				s.tasks.Store(taskID, &TaskStatus{Status: "failed", Progress: 100, Message: "Internal Panic"})
+				task.setStatus("failed", 100, "Internal Panic")
 			}
 		}()
 
-		s.tasks.Store(taskID, &TaskStatus{Status: "running", Progress: 5, Message: "Starting..."})
+		task.setStatus("running", 5, "Starting...")
 
 		// 调用领域雷达引擎开始执行
-		err := s.engine.Run(context.Background(), engine.RunOptions{
-			UserID:  u.ID,
-			Domains: u.Domains,
-			Persona: u.Persona,
-			ProgressCallback: func(status string, progress int) {
-				s.tasks.Store(taskID, &TaskStatus{Status: "running", Progress: progress, Message: status})
-			},
-		})
-
-		if err != nil {
-			s.tasks.Store(taskID, &TaskStatus{Status: "failed", Progress: 100, Message: err.Error()})
+		// 取消后引擎会停止后续的搜索、抓取与 LLM 调用，已生成的部分结果保留
+		_, err := s.engine.Run(ctx, opts)
+
+		if errors.Is(err, context.Canceled) {
+			task.setStatus("cancelled", 100, "Cancelled")
+		} else if errors.Is(err, engine.ErrBudgetExceeded) {
+			task.setStatus("budget_exceeded", 100, err.Error())
+		} else if err != nil {
+			task.setStatus("failed", 100, err.Error())
 		} else {
-			s.tasks.Store(taskID, &TaskStatus{Status: "completed", Progress: 100, Message: "Completed"})
+			task.setStatus("completed", 100, "Completed")
 		}
 	}()
 
-	return &v1.TriggerReportReply{TaskId: taskID, Message: "Task started"}, nil
+	return taskID
 }
 
-// GetTaskStatus 查询后台任务的执行进度和状态
+// GetTaskStatus 查询当前用户发起的后台任务的执行进度和状态
 func (s *DisplayService) GetTaskStatus(ctx context.Context, req *v1.GetTaskStatusReq) (*v1.GetTaskStatusReply, error) {
-	val, ok := s.tasks.Load(req.TaskId)
-	if !ok {
-		return nil, errors.NotFound("TASK_NOT_FOUND", "task not found")
+	u, err := s.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	task, ok := s.loadTask(req.TaskId)
+	if !ok || task.owner != u.Username {
+		return nil, ErrTaskNotFound
 	}
-	status := val.(*TaskStatus)
-	return &v1.GetTaskStatusReply{
+	status, _ := task.snapshot()
+	return ToTaskStatusReply(status), nil
+}
+
+// ToTaskStatusReply 将任务状态转换为 API 响应
+func ToTaskStatusReply(status TaskStatus) *v1.GetTaskStatusReply {
+	reply := &v1.GetTaskStatusReply{
 		Status:   status.Status,
 		Progress: int32(status.Progress),
 		Message:  status.Message,
+	}
+	for _, p := range status.Partials {
+		reply.Partials = append(reply.Partials, &v1.PartialOutput{
+			Domain:  p.Domain,
+			Persona: p.Persona,
+			Stage:   p.Stage,
+			Text:    p.Text,
+		})
+	}
+	for _, d := range status.Domains {
+		reply.DomainStatuses = append(reply.DomainStatuses, &v1.DomainStatus{
+			Domain:       d.Domain,
+			Status:       d.Status,
+			Error:        d.Error,
+			ArticleCount: int32(d.ArticleCount),
+		})
+	}
+	return reply
+}
+
+// GetUsageStats 查询当前用户的 LLM 用量与费用统计
+func (s *DisplayService) GetUsageStats(ctx context.Context, req *v1.GetUsageStatsReq) (*v1.GetUsageStatsReply, error) {
+	u, err := s.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := s.ucUsage.GetStats(ctx, u.ID, int(req.RunId), int(req.Days))
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.GetUsageStatsReply{
+		Total:    toUsageBucket(&stats.Total),
+		ByStage:  toUsageBuckets(stats.ByStage),
+		ByModel:  toUsageBuckets(stats.ByModel),
+		ByDomain: toUsageBuckets(stats.ByDomain),
+		ByRun:    toUsageBuckets(stats.ByRun),
 	}, nil
 }
+
+// toHeatScore 转换热度分项，缺失的分项以 -1 表示
+func toHeatScore(h *domain.HeatScore) *v1.HeatScore {
+	if h == nil {
+		return nil
+	}
+	optional := func(v *float64) float64 {
+		if v == nil {
+			return -1
+		}
+		return *v
+	}
+	return &v1.HeatScore{
+		ResultCount:  int32(h.ResultCount),
+		Baseline:     h.Baseline,
+		Volume:       h.Volume,
+		Diversity:    h.Diversity,
+		Recency:      optional(h.Recency),
+		Engagement:   optional(h.Engagement),
+		Significance: h.Significance,
+		Raw:          h.Raw,
+	}
+}
+
+func toUsageBucket(b *domain.UsageBucket) *v1.UsageBucket {
+	return &v1.UsageBucket{
+		Key:              b.Key,
+		Calls:            int32(b.Calls),
+		PromptTokens:     int64(b.PromptTokens),
+		CompletionTokens: int64(b.CompletionTokens),
+		TotalTokens:      int64(b.TotalTokens),
+		Cost:             b.Cost,
+		AvgLatencyMs:     int32(b.AvgLatencyMs),
+	}
+}
+
+func toUsageBuckets(buckets []*domain.UsageBucket) []*v1.UsageBucket {
+	list := make([]*v1.UsageBucket, 0, len(buckets))
+	for _, b := range buckets {
+		list = append(list, toUsageBucket(b))
+	}
+	return list
+}
+
+// ListEntities 查询当前用户运行记录中各领域被提及最多的实体
+func (s *DisplayService) ListEntities(ctx context.Context, req *v1.ListEntitiesReq) (*v1.ListEntitiesReply, error) {
+	u, err := s.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := domain.EntityFilter{RunID: int(req.RunId), UserID: u.ID, Domain: req.Domain, Type: req.Type}
+	domains, err := s.ucEntity.TopEntities(ctx, filter, int(req.Limit))
+	if err != nil {
+		return nil, err
+	}
+
+	reply := &v1.ListEntitiesReply{Domains: make([]*v1.DomainEntities, 0, len(domains))}
+	for _, d := range domains {
+		de := &v1.DomainEntities{Domain: d.Domain}
+		for _, e := range d.Entities {
+			de.Entities = append(de.Entities, &v1.EntityStat{
+				Id:           int32(e.ID),
+				Name:         e.Name,
+				Type:         e.Type,
+				ArticleCount: int32(e.ArticleCount),
+				Mentions:     e.Mentions,
+			})
+		}
+		reply.Domains = append(reply.Domains, de)
+	}
+	return reply, nil
+}
@@ -0,0 +1,39 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+
+	v1 "github.com/iWorld-y/domain_radar/api/proto/display/v1"
+	"github.com/iWorld-y/domain_radar/app/display/internal/domain"
+)
+
+// ListRunEvents 按时间顺序返回运行记录的流水线事件，用于排查报告的生成过程
+func (s *DisplayService) ListRunEvents(ctx context.Context, req *v1.ListRunEventsReq) (*v1.ListRunEventsReply, error) {
+	u, err := s.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	events, err := s.ucEvent.Timeline(ctx, domain.RunEventFilter{RunID: int(req.RunId), UserID: u.ID, Domain: req.Domain, Type: req.Type})
+	if err != nil {
+		return nil, err
+	}
+
+	reply := &v1.ListRunEventsReply{Events: make([]*v1.RunEvent, 0, len(events))}
+	for _, e := range events {
+		event := &v1.RunEvent{
+			Id:        int32(e.ID),
+			Domain:    e.Domain,
+			Type:      e.Type,
+			Message:   e.Message,
+			CreatedAt: e.CreatedAt,
+		}
+		if len(e.Data) > 0 {
+			if data, err := json.Marshal(e.Data); err == nil {
+				event.Data = string(data)
+			}
+		}
+		reply.Events = append(reply.Events, event)
+	}
+	return reply, nil
+}
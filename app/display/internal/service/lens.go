@@ -0,0 +1,64 @@
+package service
+
+import (
+	"context"
+
+	v1 "github.com/iWorld-y/domain_radar/api/proto/display/v1"
+	"github.com/iWorld-y/domain_radar/app/display/internal/domain"
+	dm "github.com/iWorld-y/domain_radar/app/domain_radar/pkg/model"
+)
+
+// ListLenses 列出当前用户的自定义解读栏目
+func (s *DisplayService) ListLenses(ctx context.Context, req *v1.ListLensesReq) (*v1.ListLensesReply, error) {
+	u, err := s.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lenses, err := s.ucLens.List(ctx, u.ID)
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.ListLensesReply{}
+	for _, l := range lenses {
+		reply.Lenses = append(reply.Lenses, &v1.AnalysisLens{Id: int32(l.ID), Name: l.Name, Instruction: l.Instruction, Position: int32(l.Position)})
+	}
+	return reply, nil
+}
+
+// SaveLens 新增或更新当前用户的自定义解读栏目
+func (s *DisplayService) SaveLens(ctx context.Context, req *v1.SaveLensReq) (*v1.SaveLensReply, error) {
+	u, err := s.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := s.ucLens.Save(ctx, u.ID, &domain.AnalysisLens{Name: req.Name, Instruction: req.Instruction, Position: int(req.Position)})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.SaveLensReply{Id: int32(id)}, nil
+}
+
+// DeleteLens 删除当前用户的自定义解读栏目
+func (s *DisplayService) DeleteLens(ctx context.Context, req *v1.DeleteLensReq) (*v1.DeleteLensReply, error) {
+	u, err := s.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.ucLens.Delete(ctx, u.ID, req.Name); err != nil {
+		return nil, err
+	}
+	return &v1.DeleteLensReply{Success: true}, nil
+}
+
+// analysisLenses 获取用户的自定义栏目，作为本次运行深度解读的额外栏目
+func (s *DisplayService) analysisLenses(ctx context.Context, userID int) ([]dm.AnalysisLens, error) {
+	lenses, err := s.ucLens.List(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]dm.AnalysisLens, 0, len(lenses))
+	for _, l := range lenses {
+		result = append(result, dm.AnalysisLens{Name: l.Name, Instruction: l.Instruction})
+	}
+	return result, nil
+}
@@ -0,0 +1,166 @@
+package service
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware/auth/jwt"
+	jwtv5 "github.com/golang-jwt/jwt/v5"
+	v1 "github.com/iWorld-y/domain_radar/api/proto/display/v1"
+	"github.com/iWorld-y/domain_radar/app/display/internal/domain"
+	"github.com/iWorld-y/domain_radar/app/display/internal/usecase"
+	dm "github.com/iWorld-y/domain_radar/app/domain_radar/pkg/model"
+)
+
+// currentUser 从 JWT 上下文中获取当前登录用户
+func (s *DisplayService) currentUser(ctx context.Context) (*usecase.User, error) {
+	claims, ok := jwt.FromContext(ctx)
+	if !ok {
+		return nil, errors.Unauthorized("UNAUTHORIZED", "missing jwt token")
+	}
+	mapClaims, ok := claims.(jwtv5.MapClaims)
+	if !ok {
+		return nil, errors.Unauthorized("UNAUTHORIZED", "invalid jwt token")
+	}
+	username, ok := mapClaims["username"].(string)
+	if !ok {
+		return nil, errors.Unauthorized("UNAUTHORIZED", "invalid username in token")
+	}
+	return s.ucUser.GetProfile(ctx, username)
+}
+
+// GetPersona 获取当前用户的结构化画像
+func (s *DisplayService) GetPersona(ctx context.Context, req *v1.GetPersonaReq) (*v1.GetPersonaReply, error) {
+	u, err := s.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	p, err := s.ucPersona.Get(ctx, u.ID, req.Name, int(req.Version))
+	if err != nil {
+		return nil, err
+	}
+	return toPersonaReply(p), nil
+}
+
+// ListPersonas 列出当前用户的全部画像
+func (s *DisplayService) ListPersonas(ctx context.Context, req *v1.ListPersonasReq) (*v1.ListPersonasReply, error) {
+	u, err := s.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	personas, err := s.ucPersona.List(ctx, u.ID)
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.ListPersonasReply{}
+	for _, p := range personas {
+		reply.Personas = append(reply.Personas, toPersonaReply(p))
+	}
+	return reply, nil
+}
+
+// DeletePersona 删除当前用户的指定画像，已生成的深度解读不受影响
+func (s *DisplayService) DeletePersona(ctx context.Context, req *v1.DeletePersonaReq) (*v1.DeletePersonaReply, error) {
+	u, err := s.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.ucPersona.Archive(ctx, u.ID, req.Name); err != nil {
+		return nil, err
+	}
+	return &v1.DeletePersonaReply{Success: true}, nil
+}
+
+// UpdatePersona 保存当前用户的结构化画像为新版本
+func (s *DisplayService) UpdatePersona(ctx context.Context, req *v1.UpdatePersonaReq) (*v1.UpdatePersonaReply, error) {
+	u, err := s.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Persona == nil {
+		return nil, errors.BadRequest("EMPTY_PERSONA", "persona is required")
+	}
+	id, version, err := s.ucPersona.Save(ctx, u, &domain.Persona{
+		Name:         req.Name,
+		Role:         req.Persona.Role,
+		Seniority:    req.Persona.Seniority,
+		TechStack:    req.Persona.TechStack,
+		Goals:        req.Persona.Goals,
+		RiskAppetite: req.Persona.RiskAppetite,
+		TimeHorizon:  req.Persona.TimeHorizon,
+		Constraints:  req.Persona.Constraints,
+		Source:       req.Source,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.UpdatePersonaReply{Version: int32(version), Id: int32(id)}, nil
+}
+
+// InterviewPersona 画像访谈：返回下一个问题，或在访谈结束时返回生成的画像与推荐领域（不自动保存）
+func (s *DisplayService) InterviewPersona(ctx context.Context, req *v1.InterviewPersonaReq) (*v1.InterviewPersonaReply, error) {
+	if s.engine == nil {
+		return nil, errors.InternalServer("ENGINE_NOT_INIT", "domain radar engine not initialized")
+	}
+	u, err := s.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	turns := make([]dm.InterviewTurn, 0, len(req.Turns))
+	for _, t := range req.Turns {
+		turns = append(turns, dm.InterviewTurn{Question: t.Question, Answer: t.Answer})
+	}
+	step, err := s.engine.InterviewPersona(ctx, u.ID, u.ReportLanguage, turns)
+	if err != nil {
+		s.log.Errorf("persona interview failed for user %s: %v", u.Username, err)
+		return nil, errors.InternalServer("INTERVIEW_FAILED", "persona interview failed")
+	}
+
+	reply := &v1.InterviewPersonaReply{Done: step.Done, Question: step.Question, SuggestedDomains: step.SuggestedDomains}
+	if p := step.Persona; p != nil {
+		reply.Persona = &v1.Persona{Role: p.Role, Seniority: p.Seniority, TechStack: p.TechStack, Goals: p.Goals, RiskAppetite: p.RiskAppetite, TimeHorizon: p.TimeHorizon, Constraints: p.Constraints}
+	}
+	return reply, nil
+}
+
+// personaLenses 将指定名称的画像渲染为深度解读视角，未指定名称时返回空，由引擎使用用户的画像文本
+func (s *DisplayService) personaLenses(ctx context.Context, u *usecase.User, names []string) ([]dm.PersonaLens, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+	personas, err := s.ucPersona.Resolve(ctx, u.ID, names)
+	if err != nil {
+		return nil, err
+	}
+	return toPersonaLenses(personas, u.ReportLanguage), nil
+}
+
+// allPersonaLenses 将用户的全部画像渲染为深度解读视角，用户没有结构化画像时返回空，由引擎使用用户的画像文本
+func (s *DisplayService) allPersonaLenses(ctx context.Context, u *usecase.User) ([]dm.PersonaLens, error) {
+	personas, err := s.ucPersona.List(ctx, u.ID)
+	if err != nil {
+		return nil, err
+	}
+	return toPersonaLenses(personas, u.ReportLanguage), nil
+}
+
+// toPersonaLenses 按报告语言渲染画像
+func toPersonaLenses(personas []*domain.Persona, lang string) []dm.PersonaLens {
+	lenses := make([]dm.PersonaLens, 0, len(personas))
+	for _, p := range personas {
+		lenses = append(lenses, dm.PersonaLens{ID: p.ID, Name: p.Name, Text: usecase.RenderPersona(p, lang)})
+	}
+	return lenses
+}
+
+func toPersonaReply(p *domain.Persona) *v1.GetPersonaReply {
+	return &v1.GetPersonaReply{
+		Persona:   &v1.Persona{Role: p.Role, Seniority: p.Seniority, TechStack: p.TechStack, Goals: p.Goals, RiskAppetite: p.RiskAppetite, TimeHorizon: p.TimeHorizon, Constraints: p.Constraints},
+		Version:   int32(p.Version),
+		Source:    p.Source,
+		CreatedAt: p.CreatedAt,
+		Id:        int32(p.ID),
+		Name:      p.Name,
+	}
+}
@@ -0,0 +1,155 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	v1 "github.com/iWorld-y/domain_radar/api/proto/display/v1"
+	"github.com/iWorld-y/domain_radar/app/domain_radar/pkg/engine"
+	dm "github.com/iWorld-y/domain_radar/app/domain_radar/pkg/model"
+)
+
+// taskWatchInterval 两次推送任务状态的最小间隔；流式输出的每个分片都会触发状态变化，
+// 间隔内的变化合并到下一次推送中，避免每个分片都重发完整快照
+const taskWatchInterval = 300 * time.Millisecond
+
+// TaskPartial 任务执行中 LLM 流式输出的阶段性内容
+type TaskPartial struct {
+	Domain  string // 所属领域，深度解读时为空
+	Persona string // 所属画像名称，仅深度解读时设置
+	Stage   string // "domain_report", "deep_analysis"
+	Text    string // 目前已生成的文本
+}
+
+// taskState 单个后台任务的状态，支持订阅状态变化
+type taskState struct {
+	owner  string             // 发起任务的用户名
+	cancel context.CancelFunc // 取消任务的执行
+
+	mu       sync.Mutex
+	status   TaskStatus
+	partials []TaskPartial
+	domains  []dm.DomainRunStatus
+	changed  chan struct{} // 每次状态变化时关闭并替换，用于通知订阅者
+}
+
+func newTaskState(owner string, cancel context.CancelFunc) *taskState {
+	return &taskState{
+		owner:   owner,
+		cancel:  cancel,
+		status:  TaskStatus{Status: "pending", Progress: 0, Message: "Initializing..."},
+		changed: make(chan struct{}),
+	}
+}
+
+// setStatus 更新任务状态
+func (t *taskState) setStatus(status string, progress int, message string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.status.Status, t.status.Progress, t.status.Message = status, progress, message
+	t.notifyLocked()
+}
+
+// setPartial 更新某个领域、阶段或画像的流式输出内容
+func (t *taskState) setPartial(p engine.PartialOutput) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for i := range t.partials {
+		if t.partials[i].Domain == p.Domain && t.partials[i].Stage == p.Stage && t.partials[i].Persona == p.Persona {
+			t.partials[i].Text = p.Text
+			t.notifyLocked()
+			return
+		}
+	}
+	t.partials = append(t.partials, TaskPartial{Domain: p.Domain, Persona: p.Persona, Stage: p.Stage, Text: p.Text})
+	t.notifyLocked()
+}
+
+// addDomainStatus 记录处理完的领域结果
+func (t *taskState) addDomainStatus(status dm.DomainRunStatus) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.domains = append(t.domains, status)
+	t.notifyLocked()
+}
+
+func (t *taskState) notifyLocked() {
+	close(t.changed)
+	t.changed = make(chan struct{})
+}
+
+// snapshot 返回当前状态的副本，以及下一次状态变化时会被关闭的通道
+func (t *taskState) snapshot() (TaskStatus, <-chan struct{}) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	status := t.status
+	status.Partials = append([]TaskPartial(nil), t.partials...)
+	status.Domains = append([]dm.DomainRunStatus(nil), t.domains...)
+	return status, t.changed
+}
+
+// isFinished 判断任务是否已结束
+func (s TaskStatus) isFinished() bool {
+	switch s.Status {
+	case "completed", "failed", "budget_exceeded", "cancelled":
+		return true
+	}
+	return false
+}
+
+func (s *DisplayService) loadTask(taskID string) (*taskState, bool) {
+	val, ok := s.tasks.Load(taskID)
+	if !ok {
+		return nil, false
+	}
+	return val.(*taskState), true
+}
+
+// WatchTask 持续推送任务状态，直到任务结束或 ctx 被取消；只有任务的发起者可以订阅。
+// 两次推送至少间隔 taskWatchInterval，期间的变化合并为一次推送，任务结束时的最终状态总会被推送
+func (s *DisplayService) WatchTask(ctx context.Context, taskID, username string, send func(TaskStatus) error) error {
+	task, ok := s.loadTask(taskID)
+	if !ok || task.owner != username {
+		return ErrTaskNotFound
+	}
+	for {
+		status, changed := task.snapshot()
+		if err := send(status); err != nil {
+			return err
+		}
+		if status.isFinished() {
+			return nil
+		}
+		next := time.NewTimer(taskWatchInterval)
+		select {
+		case <-ctx.Done():
+			next.Stop()
+			return ctx.Err()
+		case <-changed:
+		}
+		select {
+		case <-ctx.Done():
+			next.Stop()
+			return ctx.Err()
+		case <-next.C:
+		}
+	}
+}
+
+// CancelTask 取消当前用户发起的后台任务；任务会在当前步骤结束后以 "cancelled" 状态结束
+func (s *DisplayService) CancelTask(ctx context.Context, req *v1.CancelTaskReq) (*v1.CancelTaskReply, error) {
+	u, err := s.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	task, ok := s.loadTask(req.TaskId)
+	if !ok || task.owner != u.Username {
+		return nil, ErrTaskNotFound
+	}
+	if status, _ := task.snapshot(); status.isFinished() {
+		return &v1.CancelTaskReply{Success: false}, nil
+	}
+	task.cancel()
+	return &v1.CancelTaskReply{Success: true}, nil
+}
@@ -0,0 +1,48 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/iWorld-y/domain_radar/app/domain_radar/pkg/engine"
+)
+
+func TestWatchTaskCoalescesPartials(t *testing.T) {
+	s := &DisplayService{}
+	task := newTaskState("alice", func() {})
+	s.tasks.Store("task-1", task)
+
+	// 模拟流式输出：短时间内产生大量分片，最后任务完成
+	go func() {
+		text := ""
+		for i := 0; i < 200; i++ {
+			text += "x"
+			task.setPartial(engine.PartialOutput{Domain: "AI", Stage: "domain_report", Text: text})
+			time.Sleep(time.Millisecond)
+		}
+		task.setStatus("completed", 100, "done")
+	}()
+
+	var sent []TaskStatus
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	err := s.WatchTask(ctx, "task-1", "alice", func(status TaskStatus) error {
+		sent = append(sent, status)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("WatchTask() error = %v", err)
+	}
+	if len(sent) > 10 {
+		t.Errorf("sent %d snapshots for 200 chunks, want them coalesced", len(sent))
+	}
+	last := sent[len(sent)-1]
+	if last.Status != "completed" {
+		t.Errorf("last status = %q, want completed", last.Status)
+	}
+	if len(last.Partials) != 1 || last.Partials[0].Text != strings.Repeat("x", 200) {
+		t.Errorf("last partials = %+v, want the full text", last.Partials)
+	}
+}
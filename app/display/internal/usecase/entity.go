@@ -0,0 +1,47 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/iWorld-y/domain_radar/app/display/internal/domain"
+	"github.com/iWorld-y/domain_radar/app/display/internal/repo"
+)
+
+const (
+	// defaultEntityLimit 未指定数量时每个领域返回的实体数
+	defaultEntityLimit = 10
+	// maxEntityLimit 每个领域最多返回的实体数
+	maxEntityLimit = 50
+)
+
+// EntityUseCase 实体统计业务逻辑
+type EntityUseCase struct {
+	repo repo.EntityRepo
+	log  *log.Helper
+}
+
+// NewEntityUseCase 创建实体统计业务逻辑实例
+func NewEntityUseCase(repo repo.EntityRepo, logger log.Logger) *EntityUseCase {
+	return &EntityUseCase{repo: repo, log: log.NewHelper(logger)}
+}
+
+// TopEntities 获取运行记录中各领域被提及最多的实体，limit 为每个领域返回的数量
+func (uc *EntityUseCase) TopEntities(ctx context.Context, filter domain.EntityFilter, limit int) ([]*domain.DomainEntities, error) {
+	if limit <= 0 {
+		limit = defaultEntityLimit
+	}
+	if limit > maxEntityLimit {
+		limit = maxEntityLimit
+	}
+	domains, err := uc.repo.ListEntities(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range domains {
+		if len(d.Entities) > limit {
+			d.Entities = d.Entities[:limit]
+		}
+	}
+	return domains, nil
+}
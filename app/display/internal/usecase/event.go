@@ -0,0 +1,25 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/iWorld-y/domain_radar/app/display/internal/domain"
+	"github.com/iWorld-y/domain_radar/app/display/internal/repo"
+)
+
+// EventUseCase 运行事件业务逻辑
+type EventUseCase struct {
+	repo repo.EventRepo
+	log  *log.Helper
+}
+
+// NewEventUseCase 创建运行事件业务逻辑实例
+func NewEventUseCase(repo repo.EventRepo, logger log.Logger) *EventUseCase {
+	return &EventUseCase{repo: repo, log: log.NewHelper(logger)}
+}
+
+// Timeline 按时间顺序获取运行记录中的流水线事件
+func (uc *EventUseCase) Timeline(ctx context.Context, filter domain.RunEventFilter) ([]*domain.RunEvent, error) {
+	return uc.repo.ListRunEvents(ctx, filter)
+}
@@ -0,0 +1,70 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/iWorld-y/domain_radar/app/display/internal/domain"
+	"github.com/iWorld-y/domain_radar/app/display/internal/repo"
+)
+
+const (
+	// maxLenses 每个用户最多定义的栏目数，避免深度解读输出过长
+	maxLenses = 8
+	// maxLensNameLen 栏目名称的最大长度（字符）
+	maxLensNameLen = 50
+	// maxLensInstructionLen 栏目撰写要求的最大长度（字符）
+	maxLensInstructionLen = 500
+)
+
+// LensUseCase 自定义解读栏目业务逻辑
+type LensUseCase struct {
+	repo repo.LensRepo
+	log  *log.Helper
+}
+
+// NewLensUseCase 创建自定义解读栏目业务逻辑实例
+func NewLensUseCase(repo repo.LensRepo, logger log.Logger) *LensUseCase {
+	return &LensUseCase{repo: repo, log: log.NewHelper(logger)}
+}
+
+// List 获取用户的全部自定义栏目
+func (uc *LensUseCase) List(ctx context.Context, userID int) ([]*domain.AnalysisLens, error) {
+	return uc.repo.ListLenses(ctx, userID)
+}
+
+// Save 校验并保存自定义栏目，同名栏目存在时覆盖，返回栏目 ID
+func (uc *LensUseCase) Save(ctx context.Context, userID int, l *domain.AnalysisLens) (int, error) {
+	l.Name = strings.TrimSpace(l.Name)
+	l.Instruction = strings.TrimSpace(l.Instruction)
+	if l.Name == "" || utf8.RuneCountInString(l.Name) > maxLensNameLen {
+		return 0, errors.BadRequest("INVALID_LENS_NAME", "lens name must be 1-50 characters")
+	}
+	if l.Instruction == "" || utf8.RuneCountInString(l.Instruction) > maxLensInstructionLen {
+		return 0, errors.BadRequest("INVALID_LENS_INSTRUCTION", "lens instruction must be 1-500 characters")
+	}
+
+	lenses, err := uc.repo.ListLenses(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+	exists := false
+	for _, existing := range lenses {
+		if existing.Name == l.Name {
+			exists = true
+			break
+		}
+	}
+	if !exists && len(lenses) >= maxLenses {
+		return 0, errors.BadRequest("TOO_MANY_LENSES", "at most 8 analysis lenses are allowed")
+	}
+	return uc.repo.SaveLens(ctx, userID, l)
+}
+
+// Delete 删除用户的指定栏目
+func (uc *LensUseCase) Delete(ctx context.Context, userID int, name string) error {
+	return uc.repo.DeleteLens(ctx, userID, strings.TrimSpace(name))
+}
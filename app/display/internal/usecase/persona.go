@@ -0,0 +1,109 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/iWorld-y/domain_radar/app/display/internal/domain"
+	"github.com/iWorld-y/domain_radar/app/display/internal/repo"
+	dm "github.com/iWorld-y/domain_radar/app/domain_radar/pkg/model"
+)
+
+// maxPersonaNameLen 画像名称的最大长度（字符）
+const maxPersonaNameLen = 50
+
+// riskAppetites 支持的风险偏好，空值表示未知
+var riskAppetites = map[string]struct{}{"": {}, "low": {}, "medium": {}, "high": {}}
+
+// PersonaUseCase 结构化画像业务逻辑
+type PersonaUseCase struct {
+	repo repo.PersonaRepo
+	log  *log.Helper
+}
+
+// NewPersonaUseCase 创建结构化画像业务逻辑实例
+func NewPersonaUseCase(repo repo.PersonaRepo, logger log.Logger) *PersonaUseCase {
+	return &PersonaUseCase{repo: repo, log: log.NewHelper(logger)}
+}
+
+// Get 获取用户指定名称画像的指定版本，name 为空时为默认画像，version 为 0 时返回最新版本
+func (uc *PersonaUseCase) Get(ctx context.Context, userID int, name string, version int) (*domain.Persona, error) {
+	return uc.repo.GetPersona(ctx, userID, personaName(name), version)
+}
+
+// List 获取用户全部画像的最新版本
+func (uc *PersonaUseCase) List(ctx context.Context, userID int) ([]*domain.Persona, error) {
+	return uc.repo.ListPersonas(ctx, userID)
+}
+
+// Save 校验并保存为画像的新版本，同时按用户的报告语言渲染为深度解读使用的画像文本，返回新版本的 ID 与版本号
+func (uc *PersonaUseCase) Save(ctx context.Context, u *User, p *domain.Persona) (int, int, error) {
+	p.Name = personaName(p.Name)
+	if utf8.RuneCountInString(p.Name) > maxPersonaNameLen {
+		return 0, 0, errors.BadRequest("INVALID_PERSONA_NAME", "persona name is too long")
+	}
+	p.RiskAppetite = strings.ToLower(strings.TrimSpace(p.RiskAppetite))
+	if _, ok := riskAppetites[p.RiskAppetite]; !ok {
+		return 0, 0, errors.BadRequest("INVALID_RISK_APPETITE", "risk appetite must be low, medium or high")
+	}
+	if p.Source != domain.PersonaSourceInterview {
+		p.Source = domain.PersonaSourceManual
+	}
+	rendered := RenderPersona(p, u.ReportLanguage)
+	if rendered == "" {
+		return 0, 0, errors.BadRequest("EMPTY_PERSONA", "persona is empty")
+	}
+	return uc.repo.SavePersona(ctx, u.ID, p, rendered)
+}
+
+// Archive 删除用户的指定画像，默认画像与用户的画像文本同步，不允许删除
+func (uc *PersonaUseCase) Archive(ctx context.Context, userID int, name string) error {
+	name = personaName(name)
+	if name == domain.DefaultPersonaName {
+		return errors.BadRequest("DEFAULT_PERSONA", "default persona cannot be deleted")
+	}
+	return uc.repo.ArchivePersona(ctx, userID, name)
+}
+
+// Resolve 按名称获取用户各画像的最新版本，用于指定一次运行的深度解读视角；重复的名称只保留一个
+func (uc *PersonaUseCase) Resolve(ctx context.Context, userID int, names []string) ([]*domain.Persona, error) {
+	var result []*domain.Persona
+	seen := make(map[string]bool)
+	for _, name := range names {
+		name = personaName(name)
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+		p, err := uc.repo.GetPersona(ctx, userID, name, 0)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, p)
+	}
+	return result, nil
+}
+
+// personaName 规范画像名称，为空时为默认画像
+func personaName(name string) string {
+	if name = strings.TrimSpace(name); name != "" {
+		return name
+	}
+	return domain.DefaultPersonaName
+}
+
+// RenderPersona 将结构化画像渲染为多行文本，空字段不输出
+func RenderPersona(p *domain.Persona, lang string) string {
+	return (&dm.Persona{
+		Role:         p.Role,
+		Seniority:    p.Seniority,
+		TechStack:    p.TechStack,
+		Goals:        p.Goals,
+		RiskAppetite: p.RiskAppetite,
+		TimeHorizon:  p.TimeHorizon,
+		Constraints:  p.Constraints,
+	}).Render(lang)
+}
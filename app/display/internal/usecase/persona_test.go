@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/iWorld-y/domain_radar/app/display/internal/domain"
+)
+
+// mockPersonaRepo 模拟画像仓库，记录最近一次保存的内容
+type mockPersonaRepo struct {
+	saved    *domain.Persona
+	rendered string
+}
+
+func (m *mockPersonaRepo) GetPersona(ctx context.Context, userID int, name string, version int) (*domain.Persona, error) {
+	return m.saved, nil
+}
+
+func (m *mockPersonaRepo) ListPersonas(ctx context.Context, userID int) ([]*domain.Persona, error) {
+	return []*domain.Persona{m.saved}, nil
+}
+
+func (m *mockPersonaRepo) SavePersona(ctx context.Context, userID int, p *domain.Persona, rendered string) (int, int, error) {
+	m.saved, m.rendered = p, rendered
+	return 1, 1, nil
+}
+
+func (m *mockPersonaRepo) ArchivePersona(ctx context.Context, userID int, name string) error {
+	return nil
+}
+
+func TestPersonaUseCase_Save(t *testing.T) {
+	repo := &mockPersonaRepo{}
+	uc := NewPersonaUseCase(repo, log.DefaultLogger)
+	u := &User{ID: 1, ReportLanguage: "en"}
+
+	_, _, err := uc.Save(context.Background(), u, &domain.Persona{
+		Role:         "Backend engineer",
+		TechStack:    []string{"Go", "PostgreSQL"},
+		RiskAppetite: "Medium",
+	})
+	if err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	want := "Role: Backend engineer\nTech stack: Go, PostgreSQL\nRisk appetite: medium"
+	if repo.rendered != want {
+		t.Errorf("rendered persona = %q, want %q", repo.rendered, want)
+	}
+	if repo.saved.Source != domain.PersonaSourceManual {
+		t.Errorf("Source = %q, want manual", repo.saved.Source)
+	}
+	if repo.saved.Name != domain.DefaultPersonaName {
+		t.Errorf("Name = %q, want %q", repo.saved.Name, domain.DefaultPersonaName)
+	}
+
+	if _, _, err := uc.Save(context.Background(), u, &domain.Persona{Role: "PM", RiskAppetite: "yolo"}); err == nil {
+		t.Error("Save() with invalid risk appetite should fail")
+	}
+	if _, _, err := uc.Save(context.Background(), u, &domain.Persona{}); err == nil {
+		t.Error("Save() with empty persona should fail")
+	}
+}
@@ -19,12 +19,12 @@ func NewReportUseCase(repo repo.ReportRepo, logger log.Logger) *ReportUseCase {
 	return &ReportUseCase{repo: repo, log: log.NewHelper(logger)}
 }
 
-// List 分页列出报表摘要
-func (uc *ReportUseCase) List(ctx context.Context, page, pageSize int) ([]*domain.ReportSummary, int, error) {
-	return uc.repo.ListReports(ctx, page, pageSize)
+// List 分页列出用户可见的报表摘要
+func (uc *ReportUseCase) List(ctx context.Context, userID, page, pageSize int) ([]*domain.ReportSummary, int, error) {
+	return uc.repo.ListReports(ctx, userID, page, pageSize)
 }
 
-// GetByID 根据ID获取报表详情
-func (uc *ReportUseCase) GetByID(ctx context.Context, id int, userID int) (*domain.GroupedReport, error) {
-	return uc.repo.GetReportByID(ctx, id, userID)
+// GetByID 根据ID获取报表详情，personaID 指定要查看的深度解读
+func (uc *ReportUseCase) GetByID(ctx context.Context, id int, userID int, personaID int) (*domain.GroupedReport, error) {
+	return uc.repo.GetReportByID(ctx, id, userID, personaID)
 }
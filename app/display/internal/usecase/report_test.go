@@ -11,11 +11,11 @@ import (
 // mockReportRepo 模拟报表仓库
 type mockReportRepo struct{}
 
-func (m *mockReportRepo) ListReports(ctx context.Context, page, pageSize int) ([]*domain.ReportSummary, int, error) {
+func (m *mockReportRepo) ListReports(ctx context.Context, userID, page, pageSize int) ([]*domain.ReportSummary, int, error) {
 	return []*domain.ReportSummary{{ID: 1, Title: "Test Report"}}, 1, nil
 }
 
-func (m *mockReportRepo) GetReportByID(ctx context.Context, id int, userID int) (*domain.GroupedReport, error) {
+func (m *mockReportRepo) GetReportByID(ctx context.Context, id int, userID int, personaID int) (*domain.GroupedReport, error) {
 	return &domain.GroupedReport{ID: id}, nil
 }
 
@@ -28,7 +28,7 @@ func TestReportUseCase_List(t *testing.T) {
 	logger := log.DefaultLogger
 	uc := NewReportUseCase(repo, logger)
 
-	reports, total, err := uc.List(context.Background(), 1, 10)
+	reports, total, err := uc.List(context.Background(), 1, 1, 10)
 	if err != nil {
 		t.Errorf("List() error = %v", err)
 		return
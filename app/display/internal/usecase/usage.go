@@ -0,0 +1,30 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/iWorld-y/domain_radar/app/display/internal/domain"
+	"github.com/iWorld-y/domain_radar/app/display/internal/repo"
+)
+
+// UsageUseCase LLM 用量统计业务逻辑
+type UsageUseCase struct {
+	repo repo.UsageRepo
+	log  *log.Helper
+}
+
+// NewUsageUseCase 创建用量统计业务逻辑实例
+func NewUsageUseCase(repo repo.UsageRepo, logger log.Logger) *UsageUseCase {
+	return &UsageUseCase{repo: repo, log: log.NewHelper(logger)}
+}
+
+// GetStats 获取用户的 LLM 用量统计，days 为 0 时不限时间范围
+func (uc *UsageUseCase) GetStats(ctx context.Context, userID, runID, days int) (*domain.UsageStats, error) {
+	filter := domain.UsageFilter{UserID: userID, RunID: runID}
+	if days > 0 {
+		filter.Since = time.Now().AddDate(0, 0, -days)
+	}
+	return uc.repo.GetUsageStats(ctx, filter)
+}
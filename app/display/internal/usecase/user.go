@@ -8,6 +8,7 @@ import (
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/iWorld-y/domain_radar/app/display/internal/conf"
+	dm "github.com/iWorld-y/domain_radar/app/domain_radar/pkg/model"
 	"golang.org/x/crypto/bcrypt"
 )
 
@@ -17,17 +18,26 @@ type User struct {
 	Username     string
 	PasswordHash string
 	Persona      string
-	Domains      []string
+	Domains      []dm.DomainConfig
+	// MaxTokensPerRun 与 MaxCostPerRun 为用户的单次运行预算，为 0 时使用部署默认预算
+	MaxTokensPerRun int
+	MaxCostPerRun   float64
+	ReportLanguage  string // 报告语言：zh / en
 }
 
+// reportLanguages 支持的报告语言
+var reportLanguages = map[string]struct{}{"zh": {}, "en": {}}
+
 // UserRepo 用户仓库接口
 type UserRepo interface {
 	// CreateUser 创建用户
 	CreateUser(ctx context.Context, u *User) error
 	// GetUserByUsername 根据用户名获取用户
 	GetUserByUsername(ctx context.Context, username string) (*User, error)
-	// UpdateUserPersona 更新用户画像和领域
-	UpdateUserProfile(ctx context.Context, id int, persona string, domains []string) error
+	// ListUsers 按 ID 顺序列出全部用户
+	ListUsers(ctx context.Context) ([]*User, error)
+	// UpdateUserProfile 更新用户画像、领域和报告语言
+	UpdateUserProfile(ctx context.Context, id int, persona string, domains []dm.DomainConfig, reportLanguage string) error
 }
 
 // UserUseCase 用户业务逻辑
@@ -51,15 +61,20 @@ func NewUserUseCase(repo UserRepo, auth *conf.Auth, logger log.Logger) *UserUseC
 }
 
 // Register 用户注册
-func (uc *UserUseCase) Register(ctx context.Context, username, password string) error {
+func (uc *UserUseCase) Register(ctx context.Context, username, password, reportLanguage string) error {
+	if _, ok := reportLanguages[reportLanguage]; !ok {
+		reportLanguage = "zh"
+	}
+
 	// 使用 bcrypt 对密码进行哈希处理
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
 	if err != nil {
 		return err
 	}
 	u := &User{
-		Username:     username,
-		PasswordHash: string(hashedPassword),
+		Username:       username,
+		PasswordHash:   string(hashedPassword),
+		ReportLanguage: reportLanguage,
 	}
 	return uc.repo.CreateUser(ctx, u)
 }
@@ -90,11 +105,22 @@ func (uc *UserUseCase) GetProfile(ctx context.Context, username string) (*User,
 	return uc.repo.GetUserByUsername(ctx, username)
 }
 
-// UpdateProfile 更新用户画像
-func (uc *UserUseCase) UpdateProfile(ctx context.Context, username, persona string, domains []string) error {
+// ListUsers 列出全部用户，用于批量运行
+func (uc *UserUseCase) ListUsers(ctx context.Context) ([]*User, error) {
+	return uc.repo.ListUsers(ctx)
+}
+
+// UpdateProfile 更新用户画像，reportLanguage 为空时保留原有报告语言
+func (uc *UserUseCase) UpdateProfile(ctx context.Context, username, persona string, domains []dm.DomainConfig, reportLanguage string) error {
 	u, err := uc.repo.GetUserByUsername(ctx, username)
 	if err != nil {
 		return err
 	}
-	return uc.repo.UpdateUserProfile(ctx, u.ID, persona, domains)
+	if reportLanguage == "" {
+		reportLanguage = u.ReportLanguage
+	}
+	if _, ok := reportLanguages[reportLanguage]; !ok {
+		return errors.BadRequest("INVALID_LANGUAGE", "unsupported report language: "+reportLanguage)
+	}
+	return uc.repo.UpdateUserProfile(ctx, u.ID, persona, domains, reportLanguage)
 }
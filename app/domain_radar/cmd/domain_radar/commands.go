@@ -0,0 +1,518 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/iWorld-y/domain_radar/app/common/ent"
+	"github.com/iWorld-y/domain_radar/app/domain_radar/pkg/config"
+	"github.com/iWorld-y/domain_radar/app/domain_radar/pkg/engine"
+	"github.com/iWorld-y/domain_radar/app/domain_radar/pkg/logger"
+	dm "github.com/iWorld-y/domain_radar/app/domain_radar/pkg/model"
+	"github.com/iWorld-y/domain_radar/app/domain_radar/pkg/storage"
+)
+
+// runCmd 生成一次报告：未指定用户时使用配置中的领域与画像，运行记录不属于任何用户
+func runCmd(ctx context.Context, cfg *config.Config, args []string) error {
+	fs := flag.NewFlagSet("run", flag.ExitOnError)
+	username := fs.String("user", "", "按该用户的领域、画像、语言与预算生成报告")
+	refresh := fs.Bool("refresh-cache", false, "忽略已缓存的 LLM 输出，重新生成并覆盖缓存")
+	verify := fs.Bool("verify", false, "核验领域报告中的论断")
+	window := fs.String("window", "", "搜索时间范围：24h、7d 或 since_last（自上次成功运行以来），默认最近 3 天")
+	if _, err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if err := engine.ValidateConfig(cfg); err != nil {
+		return fmt.Errorf("配置无效:\n%w", err)
+	}
+	if !engine.ValidWindowPreset(*window) {
+		return fmt.Errorf("不支持的时间范围: %s", *window)
+	}
+
+	store, err := openStorage(cfg, *username != "")
+	if err != nil {
+		return err
+	}
+	if store != nil {
+		defer store.Close()
+	} else {
+		logger.Log.Info("未配置数据库信息，报告不会被保存")
+	}
+
+	opts := engine.RunOptions{
+		Domains: cfg.Domains,
+		Persona: cfg.UserPersona,
+		Verify:  *verify,
+		Window:  engine.Window{Preset: *window},
+		ProgressCallback: func(status string, progress int) {
+			logger.Log.Infof("[%3d%%] %s", progress, status)
+		},
+	}
+	if *refresh {
+		opts.Cache = engine.CacheRefresh
+	}
+	if *username != "" {
+		u, err := store.GetUserByName(ctx, *username)
+		if err != nil {
+			return fmt.Errorf("查询用户 [%s] 失败: %w", *username, err)
+		}
+		if err := applyUser(ctx, store, &opts, u); err != nil {
+			return err
+		}
+	}
+	if len(opts.Domains) == 0 {
+		return fmt.Errorf("未设置感兴趣的领域 (domains)")
+	}
+
+	eng, err := engine.NewEngine(cfg, store)
+	if err != nil {
+		return err
+	}
+	runID, err := eng.Run(ctx, opts)
+	if errors.Is(err, context.Canceled) {
+		return fmt.Errorf("已取消，RunID %d 保留了已生成的部分结果", runID)
+	}
+	if err != nil {
+		return err
+	}
+	if runID > 0 {
+		fmt.Printf("✅ 报告生成完毕，RunID: %d\n", runID)
+	} else {
+		fmt.Println("✅ 报告生成完毕")
+	}
+	return nil
+}
+
+// retryCmd 在原运行记录中重新处理失败或缺失的领域，使用运行记录所属用户的设置
+func retryCmd(ctx context.Context, cfg *config.Config, args []string) error {
+	fs := flag.NewFlagSet("retry", flag.ExitOnError)
+	verify := fs.Bool("verify", false, "核验领域报告中的论断")
+	positional, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		return fmt.Errorf("用法: retry <run-id>")
+	}
+	runID, err := strconv.Atoi(positional[0])
+	if err != nil {
+		return fmt.Errorf("无效的 run-id: %s", positional[0])
+	}
+	if err := engine.ValidateConfig(cfg); err != nil {
+		return fmt.Errorf("配置无效:\n%w", err)
+	}
+
+	store, err := openStorage(cfg, true)
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+
+	run, err := store.GetRun(ctx, runID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return fmt.Errorf("运行记录 %d 不存在", runID)
+		}
+		return err
+	}
+	opts := engine.RunOptions{
+		Domains:    cfg.Domains,
+		Persona:    cfg.UserPersona,
+		Verify:     *verify,
+		RetryRunID: runID,
+		ProgressCallback: func(status string, progress int) {
+			logger.Log.Infof("[%3d%%] %s", progress, status)
+		},
+	}
+	if run.UserID > 0 {
+		users, err := store.ListUsers(ctx)
+		if err != nil {
+			return err
+		}
+		for _, u := range users {
+			if u.ID == run.UserID {
+				if err := applyUser(ctx, store, &opts, u); err != nil {
+					return err
+				}
+			}
+		}
+		if opts.UserID == 0 {
+			return fmt.Errorf("运行记录 %d 所属的用户不存在", runID)
+		}
+	}
+
+	eng, err := engine.NewEngine(cfg, store)
+	if err != nil {
+		return err
+	}
+	if _, err := eng.Run(ctx, opts); err != nil {
+		if errors.Is(err, engine.ErrNothingToRetry) {
+			fmt.Printf("运行记录 %d 的领域均已生成报告，无需重试\n", runID)
+			return nil
+		}
+		return err
+	}
+	fmt.Printf("✅ 重试完成，RunID: %d\n", runID)
+	return nil
+}
+
+// batchCmd 为全部设置了领域的用户各生成一次报告，写入各自的运行记录；
+// 相同的领域只生成一次领域报告，深度解读按用户单独生成
+func batchCmd(ctx context.Context, cfg *config.Config, args []string) error {
+	fs := flag.NewFlagSet("batch", flag.ExitOnError)
+	verify := fs.Bool("verify", false, "核验领域报告中的论断")
+	window := fs.String("window", "", "搜索时间范围：24h、7d 或 since_last（自各用户上次成功运行以来），默认最近 3 天")
+	if _, err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if err := engine.ValidateConfig(cfg); err != nil {
+		return fmt.Errorf("配置无效:\n%w", err)
+	}
+	if !engine.ValidWindowPreset(*window) {
+		return fmt.Errorf("不支持的时间范围: %s", *window)
+	}
+
+	store, err := openStorage(cfg, true)
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+
+	users, err := store.ListUsers(ctx)
+	if err != nil {
+		return err
+	}
+	var batch []engine.RunOptions
+	names := make(map[int]string, len(users))
+	for _, u := range users {
+		if !slices.ContainsFunc(u.Domains, func(d dm.DomainConfig) bool { return d.Enabled }) {
+			continue
+		}
+		opts := engine.RunOptions{Verify: *verify, Window: engine.Window{Preset: *window}}
+		if err := applyUser(ctx, store, &opts, u); err != nil {
+			return err
+		}
+		batch = append(batch, opts)
+		names[u.ID] = u.Username
+	}
+	if len(batch) == 0 {
+		return fmt.Errorf("没有设置了领域的用户")
+	}
+
+	eng, err := engine.NewEngine(cfg, store)
+	if err != nil {
+		return err
+	}
+	results, err := eng.RunBatch(ctx, batch)
+	failed := 0
+	for _, r := range results {
+		if r.Err != nil {
+			failed++
+			fmt.Printf("❌ %s: RunID %d, %v\n", names[r.UserID], r.RunID, r.Err)
+		} else {
+			fmt.Printf("✅ %s: RunID %d\n", names[r.UserID], r.RunID)
+		}
+	}
+	if err != nil {
+		return err
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d/%d 个用户的报告生成失败", failed, len(results))
+	}
+	return nil
+}
+
+// applyUser 使用用户的领域、画像、自定义栏目、语言与预算，与 display 服务的批量运行一致
+func applyUser(ctx context.Context, store *storage.Storage, opts *engine.RunOptions, u *ent.User) error {
+	personas, err := store.ListPersonas(ctx, u.ID)
+	if err != nil {
+		return fmt.Errorf("读取用户 %s 的画像失败: %w", u.Username, err)
+	}
+	lenses, err := store.ListAnalysisLenses(ctx, u.ID)
+	if err != nil {
+		return fmt.Errorf("读取用户 %s 的自定义栏目失败: %w", u.Username, err)
+	}
+	setUserOptions(opts, u, personas, lenses)
+	return nil
+}
+
+// setUserOptions 按用户的设置填充运行选项，用户的每个结构化画像各生成一份深度解读，
+// 没有结构化画像时使用用户的画像文本
+func setUserOptions(opts *engine.RunOptions, u *ent.User, personas []*ent.Persona, lenses []*ent.AnalysisLens) {
+	opts.UserID = u.ID
+	opts.Domains = u.Domains
+	opts.Persona = u.Persona
+	for _, p := range personas {
+		persona := dm.Persona{
+			Role:         p.Role,
+			Seniority:    p.Seniority,
+			TechStack:    p.TechStack,
+			Goals:        p.Goals,
+			RiskAppetite: p.RiskAppetite,
+			TimeHorizon:  p.TimeHorizon,
+			Constraints:  p.Constraints,
+		}
+		opts.Personas = append(opts.Personas, dm.PersonaLens{ID: p.ID, Name: p.Name, Text: persona.Render(u.ReportLanguage)})
+	}
+	for _, l := range lenses {
+		opts.Lenses = append(opts.Lenses, dm.AnalysisLens{Name: l.Name, Instruction: l.Instruction})
+	}
+	opts.Language = u.ReportLanguage
+	opts.Budget = engine.Budget{MaxTokens: u.MaxTokensPerRun, MaxCost: u.MaxCostPerRun}
+}
+
+// listCmd 列出最近的运行记录
+func listCmd(ctx context.Context, cfg *config.Config, args []string) error {
+	fs := flag.NewFlagSet("list", flag.ExitOnError)
+	limit := fs.Int("n", 20, "最多列出的记录数")
+	username := fs.String("user", "", "只列出该用户的记录")
+	if _, err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	store, err := openStorage(cfg, true)
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+
+	users, err := store.ListUsers(ctx)
+	if err != nil {
+		return err
+	}
+	names := make(map[int]string, len(users))
+	userID := 0
+	for _, u := range users {
+		names[u.ID] = u.Username
+		if u.Username == *username {
+			userID = u.ID
+		}
+	}
+	if *username != "" && userID == 0 {
+		return fmt.Errorf("用户 [%s] 不存在", *username)
+	}
+
+	runs, err := store.ListRuns(ctx, *limit, userID)
+	if err != nil {
+		return err
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\t时间\t时间范围\t用户\t状态\t领域数\t平均评分\t标题")
+	for _, r := range runs {
+		owner := names[r.UserID]
+		if owner == "" {
+			owner = "-"
+		}
+		total := 0
+		for _, dr := range r.Edges.DomainReports {
+			total += dr.Score
+		}
+		avg := "-"
+		if n := len(r.Edges.DomainReports); n > 0 {
+			avg = strconv.FormatFloat(float64(total)/float64(n), 'f', 1, 64)
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%d\t%s\t%s\n", r.ID, r.CreatedAt.Format("2006-01-02 15:04"), runWindow(r), owner, r.Status, len(r.Edges.DomainReports), avg, truncate(r.Title, 40))
+	}
+	return w.Flush()
+}
+
+// showCmd 以 Markdown 输出运行记录的领域报告与深度解读
+func showCmd(ctx context.Context, cfg *config.Config, args []string) error {
+	fs := flag.NewFlagSet("show", flag.ExitOnError)
+	positional, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		return fmt.Errorf("用法: show <run-id>")
+	}
+	runID, err := strconv.Atoi(positional[0])
+	if err != nil {
+		return fmt.Errorf("无效的 run-id: %s", positional[0])
+	}
+
+	store, err := openStorage(cfg, true)
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+
+	run, err := store.GetRun(ctx, runID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return fmt.Errorf("运行记录 %d 不存在", runID)
+		}
+		return err
+	}
+	printRun(run)
+	return nil
+}
+
+func printRun(run *ent.ReportRun) {
+	fmt.Printf("# %s\n\n", run.Title)
+	fmt.Printf("RunID: %d  时间: %s  时间范围: %s  状态: %s\n", run.ID, run.CreatedAt.Format("2006-01-02 15:04:05"), runWindow(run), run.Status)
+
+	if len(run.Edges.DomainRuns) > 0 {
+		fmt.Println("\n## 领域处理结果")
+		for _, dr := range run.Edges.DomainRuns {
+			line := fmt.Sprintf("- %s: %s（%d 篇文章）", dr.DomainName, dr.Status, dr.ArticleCount)
+			if dr.Error != "" {
+				line += " " + truncate(dr.Error, 120)
+			}
+			fmt.Println(line)
+		}
+	}
+
+	for _, da := range run.Edges.DeepAnalysisResults {
+		title := "深度解读"
+		if da.PersonaName != "" {
+			title += "（" + da.PersonaName + "）"
+		}
+		fmt.Printf("\n## %s\n\n### 宏观趋势\n%s\n\n### 机遇\n%s\n\n### 风险\n%s\n", title, da.MacroTrends, da.Opportunities, da.Risks)
+		for _, section := range da.Edges.Sections {
+			fmt.Printf("\n### %s\n%s\n", section.Name, section.Content)
+		}
+		if len(da.Edges.ActionGuides) > 0 {
+			fmt.Println("\n### 行动建议")
+			for _, ag := range da.Edges.ActionGuides {
+				fmt.Printf("- %s\n", ag.GuideContent)
+			}
+		}
+	}
+
+	for _, dr := range run.Edges.DomainReports {
+		fmt.Printf("\n## %s（评分: %d）\n\n### 综述\n%s\n\n### 趋势\n%s\n", dr.DomainName, dr.Score, dr.Overview, dr.Trends)
+		if len(dr.Edges.KeyEvents) > 0 {
+			fmt.Println("\n### 关键事件")
+			for _, ke := range dr.Edges.KeyEvents {
+				fmt.Printf("- %s\n", ke.EventContent)
+			}
+		}
+		if len(dr.Edges.Articles) > 0 {
+			fmt.Println("\n### 来源")
+			for _, a := range dr.Edges.Articles {
+				fmt.Printf("%d. [%s](%s)\n", a.RefIndex, a.Title, a.Link)
+			}
+		}
+	}
+}
+
+// runWindow 格式化运行记录的搜索时间范围，未记录时为 "-"
+func runWindow(r *ent.ReportRun) string {
+	if r.WindowStart == nil || r.WindowEnd == nil {
+		return "-"
+	}
+	return r.WindowStart.Format("01-02 15:04") + " ~ " + r.WindowEnd.Format("01-02 15:04")
+}
+
+// domainLabels 以展示名称列出领域，停用的领域加以标注
+func domainLabels(domains []dm.DomainConfig) string {
+	labels := make([]string, 0, len(domains))
+	for _, d := range domains {
+		label := d.Label()
+		if !d.Enabled {
+			label += "（停用）"
+		}
+		labels = append(labels, label)
+	}
+	return strings.Join(labels, ", ")
+}
+
+// eventsCmd 按时间顺序列出运行记录的流水线事件
+func eventsCmd(ctx context.Context, cfg *config.Config, args []string) error {
+	fs := flag.NewFlagSet("events", flag.ExitOnError)
+	domain := fs.String("domain", "", "只列出该领域的事件")
+	positional, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		return fmt.Errorf("用法: events <run-id>")
+	}
+	runID, err := strconv.Atoi(positional[0])
+	if err != nil {
+		return fmt.Errorf("无效的 run-id: %s", positional[0])
+	}
+
+	store, err := openStorage(cfg, true)
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+
+	events, err := store.ListRunEvents(ctx, runID, *domain)
+	if err != nil {
+		return err
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "时间\t领域\t事件\t详情\t数据")
+	for _, e := range events {
+		d := e.Domain
+		if d == "" {
+			d = "-"
+		}
+		data := ""
+		if len(e.Data) > 0 {
+			b, _ := json.Marshal(e.Data)
+			data = string(b)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", e.CreatedAt.Format("15:04:05.000"), d, e.Type, truncate(e.Message, 60), data)
+	}
+	return w.Flush()
+}
+
+// usersCmd 列出全部用户
+func usersCmd(ctx context.Context, cfg *config.Config, args []string) error {
+	fs := flag.NewFlagSet("users", flag.ExitOnError)
+	if _, err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	store, err := openStorage(cfg, true)
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+
+	users, err := store.ListUsers(ctx)
+	if err != nil {
+		return err
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\t用户名\t语言\t画像\t领域")
+	for _, u := range users {
+		persona := "否"
+		if u.Persona != "" {
+			persona = "是"
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\n", u.ID, u.Username, u.ReportLanguage, persona, truncate(domainLabels(u.Domains), 60))
+	}
+	return w.Flush()
+}
+
+// validateConfigCmd 检查配置文件
+func validateConfigCmd(_ context.Context, cfg *config.Config, args []string) error {
+	fs := flag.NewFlagSet("validate-config", flag.ExitOnError)
+	if _, err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if err := engine.ValidateConfig(cfg); err != nil {
+		return fmt.Errorf("配置无效:\n%w", err)
+	}
+	if len(cfg.Domains) == 0 {
+		fmt.Println("⚠️  未设置 domains，run 命令需要指定 --user")
+	}
+	if cfg.DB.Host == "" {
+		fmt.Println("⚠️  未配置数据库，报告不会被保存，list / show / users 命令不可用")
+	}
+	fmt.Println("✅ 配置有效")
+	return nil
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/iWorld-y/domain_radar/app/common/ent"
+	"github.com/iWorld-y/domain_radar/app/domain_radar/pkg/engine"
+	dm "github.com/iWorld-y/domain_radar/app/domain_radar/pkg/model"
+)
+
+func TestSetUserOptions(t *testing.T) {
+	u := &ent.User{
+		ID:              3,
+		Domains:         dm.DomainNames([]string{"AI"}),
+		Persona:         "backend engineer",
+		ReportLanguage:  "en",
+		MaxTokensPerRun: 1000,
+		MaxCostPerRun:   0.5,
+	}
+	personas := []*ent.Persona{{ID: 7, Name: "lead", Role: "Tech lead"}}
+	lenses := []*ent.AnalysisLens{
+		{Name: "Competitors", Instruction: "Track competitor moves"},
+		{Name: "Hiring", Instruction: "Hiring signals"},
+	}
+
+	opts := engine.RunOptions{Window: engine.Window{Preset: "24h"}}
+	setUserOptions(&opts, u, personas, lenses)
+
+	if opts.UserID != 3 || opts.Persona != "backend engineer" || opts.Language != "en" || opts.Window.Preset != "24h" {
+		t.Errorf("opts = %+v, want user 3 with persona, language and window kept", opts)
+	}
+	if len(opts.Personas) != 1 || opts.Personas[0].ID != 7 || opts.Personas[0].Name != "lead" || opts.Personas[0].Text == "" {
+		t.Errorf("Personas = %+v, want the rendered persona lead", opts.Personas)
+	}
+	wantLenses := []dm.AnalysisLens{
+		{Name: "Competitors", Instruction: "Track competitor moves"},
+		{Name: "Hiring", Instruction: "Hiring signals"},
+	}
+	if !reflect.DeepEqual(opts.Lenses, wantLenses) {
+		t.Errorf("Lenses = %+v, want %+v", opts.Lenses, wantLenses)
+	}
+	if opts.Budget != (engine.Budget{MaxTokens: 1000, MaxCost: 0.5}) {
+		t.Errorf("Budget = %+v, want the user's budget", opts.Budget)
+	}
+}
@@ -4,25 +4,54 @@ import (
 	"os"
 
 	"gopkg.in/yaml.v3"
+
+	"github.com/iWorld-y/domain_radar/app/domain_radar/pkg/model"
 )
 
 // Config 项目配置结构体
 type Config struct {
-	LLM          LLMConfig         `yaml:"llm"`
-	TavilyAPIKey string            `yaml:"tavily_api_key"` // Deprecated: use Search.Tavily.APIKey
-	Search       SearchConfig      `yaml:"search"`
-	UserPersona  string            `yaml:"user_persona"`
-	Domains      []string          `yaml:"domains"`
-	Log          LogConfig         `yaml:"log"`
-	Concurrency  ConcurrencyConfig `yaml:"concurrency"`
-	DB           DBConfig          `yaml:"db"`
+	LLM            LLMConfig            `yaml:"llm"`
+	TavilyAPIKey   string               `yaml:"tavily_api_key"` // Deprecated: use Search.Tavily.APIKey
+	Search         SearchConfig         `yaml:"search"`
+	UserPersona    string               `yaml:"user_persona"`
+	ReportLanguage string               `yaml:"report_language"` // 默认报告语言：zh / en，为空时为 zh
+	Domains        []model.DomainConfig `yaml:"domains"`         // 关注的领域，可直接写领域名称或完整的领域配置
+	Log            LogConfig            `yaml:"log"`
+	Concurrency    ConcurrencyConfig    `yaml:"concurrency"`
+	DB             DBConfig             `yaml:"db"`
+	Verification   VerificationConfig   `yaml:"verification"`
+	Budget         BudgetConfig         `yaml:"budget"`
+	Cache          CacheConfig          `yaml:"cache"`
+	Pipeline       PipelineConfig       `yaml:"pipeline"`
+	Research       ResearchConfig       `yaml:"research"`
+	Sharing        SharingConfig        `yaml:"sharing"`
 }
 
 // LLMConfig LLM 相关配置
 type LLMConfig struct {
-	BaseURL string `yaml:"base_url"`
-	APIKey  string `yaml:"api_key"`
-	Model   string `yaml:"model"`
+	BaseURL    string     `yaml:"base_url"`
+	APIKey     string     `yaml:"api_key"`
+	Model      string     `yaml:"model"`
+	CheapModel string     `yaml:"cheap_model"` // 预算紧张时降级使用的低价模型，为空时不降级
+	Prices     PriceTable `yaml:"prices"`      // 模型价格表，key 为模型名称
+}
+
+// ModelPrice 模型单价，单位为每百万 token 的费用
+type ModelPrice struct {
+	Prompt     float64 `yaml:"prompt"`
+	Completion float64 `yaml:"completion"`
+}
+
+// PriceTable 模型价格表
+type PriceTable map[string]ModelPrice
+
+// Cost 根据 token 用量计算费用，未配置价格的模型费用为 0
+func (t PriceTable) Cost(model string, promptTokens, completionTokens int) float64 {
+	p, ok := t[model]
+	if !ok {
+		return 0
+	}
+	return (float64(promptTokens)*p.Prompt + float64(completionTokens)*p.Completion) / 1e6
 }
 
 // DBConfig 数据库相关配置
@@ -39,6 +68,9 @@ type SearchConfig struct {
 	Provider string        `yaml:"provider"`
 	Tavily   TavilyConfig  `yaml:"tavily"`
 	SearXNG  SearXNGConfig `yaml:"searxng"`
+	// Languages 每个领域检索的语言，如 ["zh", "en"]，领域名称会被翻译为各语言的检索词，
+	// 合并去重后统一按报告语言总结；为空时仅按领域名称原文检索
+	Languages []string `yaml:"languages"`
 }
 
 // TavilyConfig Tavily 配置
@@ -58,10 +90,57 @@ type LogConfig struct {
 	File  string `yaml:"file"`
 }
 
-// ConcurrencyConfig 并发控制配置
+// ConcurrencyConfig 并发控制配置，各阶段并发数为 0 时使用默认值
 type ConcurrencyConfig struct {
-	QPS int `yaml:"qps"`
-	RPM int `yaml:"rpm"`
+	QPS      int             `yaml:"qps"`       // LLM 请求的突发上限
+	RPM      int             `yaml:"rpm"`       // LLM 每分钟请求数
+	Domains  int             `yaml:"domains"`   // 同时处理的领域数，0 时默认 4
+	Fetches  int             `yaml:"fetches"`   // 每个领域同时抓取的网页数，0 时默认 4
+	LLMCalls int             `yaml:"llm_calls"` // 同时进行的 LLM 调用数，0 时默认 8
+	Search   RateLimitConfig `yaml:"search"`    // 搜索请求限流
+	Fetch    RateLimitConfig `yaml:"fetch"`     // 网页抓取限流
+}
+
+// RateLimitConfig 限流配置，RPM 为 0 时不限流
+type RateLimitConfig struct {
+	RPM   int `yaml:"rpm"`   // 每分钟请求数
+	Burst int `yaml:"burst"` // 突发上限，0 时为 1
+}
+
+// VerificationConfig 事实核验配置
+type VerificationConfig struct {
+	Enabled         bool `yaml:"enabled"`          // 是否在生成领域报告后核验论断
+	DropUnsupported bool `yaml:"drop_unsupported"` // 是否剔除无依据的论断，否则仅标记
+}
+
+// BudgetConfig 单次运行的默认预算，字段为 0 时表示不限制
+type BudgetConfig struct {
+	MaxTokens int     `yaml:"max_tokens"`
+	MaxCost   float64 `yaml:"max_cost"`
+}
+
+// CacheConfig LLM 输出缓存配置
+type CacheConfig struct {
+	Enabled  bool `yaml:"enabled"`
+	TTLHours int  `yaml:"ttl_hours"` // 缓存有效期（小时），0 时默认 24 小时
+}
+
+// SharingConfig 领域报告共享配置：领域配置、时间范围、报告语言与流水线阶段均相同时，
+// 复用近期已生成的领域报告，只为各用户单独生成深度解读
+type SharingConfig struct {
+	Enabled     bool `yaml:"enabled"`
+	MaxAgeHours int  `yaml:"max_age_hours"` // 可复用报告的最长生成时间（小时），0 时默认 6 小时
+}
+
+// PipelineConfig 流水线配置
+type PipelineConfig struct {
+	// DomainStages 在生成领域报告与保存之间插入的可选阶段，按顺序执行，如 ["verification", "entities"]
+	DomainStages []string `yaml:"domain_stages"`
+}
+
+// ResearchConfig 单领域深度研究配置
+type ResearchConfig struct {
+	MaxSteps int `yaml:"max_steps"` // 工具调用的最大轮数，也是单次请求可设置的上限，0 时默认 8 轮
 }
 
 // LoadConfig 从指定路径加载配置
@@ -0,0 +1,190 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cloudwego/eino/compose"
+
+	"github.com/iWorld-y/domain_radar/app/domain_radar/pkg/logger"
+	dm "github.com/iWorld-y/domain_radar/app/domain_radar/pkg/model"
+)
+
+// BatchResult 批量运行中单个用户的结果
+type BatchResult struct {
+	UserID int
+	RunID  int   // 用户的运行记录 ID，未创建时为 0
+	Err    error // 运行失败的原因，成功时为 nil
+}
+
+// preparedReport 批量运行中预先生成的领域报告，生成失败时 err 非空
+type preparedReport struct {
+	report *dm.DomainReport
+	err    error
+}
+
+// RunBatch 为多个用户依次执行一次运行，每个用户的结果写入其自己的运行记录。
+// 先为各用户创建运行记录与预算统计，再将各用户的领域按共享键分组，每组只生成一次领域报告，
+// 生成的用量、事件与预算计入第一个用到该报告的运行，最后为各用户单独生成深度解读；
+// 各运行使用相同的时间范围终点，第一个用到报告的运行保存报告，其余运行直接复用。
+// 单个用户失败不影响其他用户，ctx 取消时停止剩余的用户并返回 ctx 的错误
+func (e *Engine) RunBatch(ctx context.Context, users []RunOptions) ([]BatchResult, error) {
+	end := time.Now()
+	for i := range users {
+		if users[i].Research != nil || users[i].RetryRunID > 0 {
+			return nil, fmt.Errorf("batch mode does not support research or retry runs")
+		}
+		users[i].Share = true
+		if users[i].Window.End.IsZero() {
+			users[i].Window.End = end
+		}
+	}
+	for i := range users {
+		users[i].runID, users[i].tracker = e.startBatchRun(ctx, users[i])
+	}
+	prepared := e.prepareReports(ctx, users)
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	results := make([]BatchResult, 0, len(users))
+	for _, opts := range users {
+		if err := ctx.Err(); err != nil {
+			return results, err
+		}
+		opts.prepared = prepared
+		runID, err := e.Run(ctx, opts)
+		if err != nil {
+			logger.Log.Errorf("批量运行中用户 [%d] 的运行失败: %v", opts.UserID, err)
+		}
+		results = append(results, BatchResult{UserID: opts.UserID, RunID: runID, Err: err})
+	}
+	return results, ctx.Err()
+}
+
+// startBatchRun 预先创建用户的运行记录与预算统计；领域配置或时间范围无效时不创建运行记录，由 Run 报告错误
+func (e *Engine) startBatchRun(ctx context.Context, opts RunOptions) (int, *budgetTracker) {
+	tracker := e.runBudget(opts.Budget)
+	domains, err := NormalizeDomains(opts.Domains)
+	if err != nil {
+		return 0, tracker
+	}
+	if opts.Domains = enabledDomains(domains); len(opts.Domains) == 0 {
+		return 0, tracker
+	}
+	start, end, err := e.resolveWindow(ctx, opts.UserID, opts.Window, time.Now())
+	if err != nil {
+		return 0, tracker
+	}
+	return e.createRun(ctx, opts, start, end), tracker
+}
+
+// prepareReports 按共享键对各用户的领域分组，并发地为每组生成一次领域报告，返回共享键到生成结果的映射；
+// 数据库中已有可复用的报告时直接使用。领域配置或时间范围无效的用户跳过，由其运行报告错误
+func (e *Engine) prepareReports(ctx context.Context, users []RunOptions) map[string]*preparedReport {
+	type group struct {
+		ctx   context.Context
+		state *domainState
+		opts  RunOptions
+	}
+	var groups []group
+	keys := make(map[string]bool)
+	for _, opts := range users {
+		domains, err := NormalizeDomains(opts.Domains)
+		if err != nil {
+			continue
+		}
+		start, end, err := e.resolveWindow(ctx, opts.UserID, opts.Window, time.Now())
+		if err != nil {
+			continue
+		}
+		// 报告的生成计入第一个用到它的用户的运行记录与预算
+		uctx := e.runContext(ctx, opts.runID, opts.UserID)
+		if opts.tracker != nil {
+			uctx = withBudget(uctx, opts.tracker)
+		}
+		uctx = withCachePolicy(uctx, opts.Cache)
+		uctx = withLanguage(uctx, NormalizeLanguage(opts.Language, NormalizeLanguage(e.cfg.ReportLanguage, LanguageZH)))
+		run := &runState{
+			opts:        opts,
+			runID:       opts.runID,
+			tracker:     opts.tracker,
+			windowStart: start,
+			windowEnd:   end,
+		}
+		for _, d := range enabledDomains(domains) {
+			ds := &domainState{run: run, domain: d.Name, config: d}
+			dstart, dend, err := e.domainWindow(uctx, run, d)
+			if err != nil {
+				continue
+			}
+			ds.setWindow(dstart, dend)
+			key := e.shareKey(uctx, ds)
+			if keys[key] {
+				continue
+			}
+			keys[key] = true
+			ds.shareKey = key
+			groups = append(groups, group{ctx: withDomain(uctx, d.Name), state: ds, opts: opts})
+		}
+	}
+	logger.Log.Infof("批量运行开始：%d 个用户，共 %d 份不同的领域报告", len(users), len(groups))
+
+	prepared := make(map[string]*preparedReport, len(groups))
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	slots := newSemaphore(workers(e.cfg.Concurrency.Domains, defaultDomainWorkers))
+	for _, g := range groups {
+		if err := slots.acquire(ctx); err != nil {
+			break
+		}
+		wg.Add(1)
+		go func(g group) {
+			defer wg.Done()
+			defer slots.release()
+			report, err := e.prepareReport(g.ctx, g.state, g.opts)
+			if ctx.Err() != nil {
+				return
+			}
+			if errors.Is(err, ErrBudgetExceeded) {
+				// 预算只属于第一个用户，其余用户在各自的运行中按各自的预算重新生成
+				logger.Log.Warnf("批量运行中生成领域报告超出用户 [%d] 的预算 [%s]", g.opts.UserID, g.state.domain)
+				return
+			}
+			if err != nil {
+				logger.Log.Errorf("批量运行中生成领域报告失败 [%s]: %v", g.state.domain, err)
+			}
+			mu.Lock()
+			prepared[g.state.shareKey] = &preparedReport{report: report, err: err}
+			mu.Unlock()
+		}(g)
+	}
+	wg.Wait()
+	return prepared
+}
+
+// prepareReport 生成单个分组的领域报告，数据库中已有可复用的报告时直接返回
+func (e *Engine) prepareReport(ctx context.Context, s *domainState, opts RunOptions) (*dm.DomainReport, error) {
+	if e.store != nil {
+		report, err := e.findSharedReport(ctx, s.shareKey)
+		if err != nil {
+			logger.Log.Warnf("查询领域 [%s] 可复用的报告失败，重新生成: %v", s.domain, err)
+		}
+		if report != nil {
+			logger.Log.Infof("领域 [%s] 复用已生成的报告 %d", s.domain, report.ID)
+			return report, nil
+		}
+	}
+	generate, err := e.buildGenerateChain(opts).Compile(ctx, compose.WithGraphName("domain_pipeline"))
+	if err != nil {
+		return nil, fmt.Errorf("build domain pipeline: %w", err)
+	}
+	out, err := generate.Invoke(ctx, s)
+	if err != nil {
+		return nil, unwrapNodeError(err)
+	}
+	return out.report, nil
+}
@@ -0,0 +1,107 @@
+package engine
+
+import (
+	"context"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/schema"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/time/rate"
+
+	"github.com/iWorld-y/domain_radar/app/domain_radar/pkg/config"
+	"github.com/iWorld-y/domain_radar/app/domain_radar/pkg/logger"
+	dm "github.com/iWorld-y/domain_radar/app/domain_radar/pkg/model"
+)
+
+// countingReportModel 按领域统计生成领域报告的次数
+type countingReportModel struct {
+	stubReportModel
+	mu    sync.Mutex
+	calls map[string]int
+}
+
+func (m *countingReportModel) Stream(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.StreamReader[*schema.Message], error) {
+	m.mu.Lock()
+	for _, domain := range []string{"AI", "Chips", "Robots"} {
+		if strings.Contains(input[len(input)-1].Content, "【"+domain+"】") {
+			m.calls[domain]++
+		}
+	}
+	m.mu.Unlock()
+	return m.stubReportModel.Stream(ctx, input, opts...)
+}
+
+func TestRunBatchGeneratesSharedReportOnce(t *testing.T) {
+	logger.Log = logrus.New()
+	logger.Log.SetOutput(io.Discard)
+
+	cm := &countingReportModel{calls: map[string]int{}}
+	e := &Engine{
+		cfg:       &config.Config{},
+		chatModel: cm,
+		searcher:  stubSearcher{},
+		limiter:   rate.NewLimiter(rate.Inf, 1),
+	}
+
+	results, err := e.RunBatch(context.Background(), []RunOptions{
+		{UserID: 1, Domains: dm.DomainNames([]string{"AI", "Chips"})},
+		{UserID: 2, Domains: dm.DomainNames([]string{"ai", "Robots"})},
+	})
+	if err != nil {
+		t.Fatalf("RunBatch() error = %v", err)
+	}
+	for _, r := range results {
+		if r.Err != nil {
+			t.Errorf("user %d error = %v", r.UserID, r.Err)
+		}
+	}
+	want := map[string]int{"AI": 1, "Chips": 1, "Robots": 1}
+	for domain, n := range want {
+		if cm.calls[domain] != n {
+			t.Errorf("domain report generations for %s = %d, want %d", domain, cm.calls[domain], n)
+		}
+	}
+}
+
+type usageLog struct {
+	mu    sync.Mutex
+	calls []*dm.LLMCall
+}
+
+func (l *usageLog) SaveLLMCall(ctx context.Context, call *dm.LLMCall) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.calls = append(l.calls, call)
+	return nil
+}
+
+func TestPrepareReportsAttributesUsageToRun(t *testing.T) {
+	logger.Log = logrus.New()
+	logger.Log.SetOutput(io.Discard)
+
+	usage := &usageLog{}
+	e := &Engine{
+		cfg:       &config.Config{},
+		chatModel: newMeteredChatModel(stubReportModel{}, config.LLMConfig{Model: "m"}, usage),
+		searcher:  stubSearcher{},
+		limiter:   rate.NewLimiter(rate.Inf, 1),
+	}
+
+	e.prepareReports(context.Background(), []RunOptions{
+		{UserID: 1, Domains: dm.DomainNames([]string{"AI"}), runID: 11},
+		{UserID: 2, Domains: dm.DomainNames([]string{"ai", "Robots"}), runID: 12},
+	})
+	want := map[string]int{"AI": 11, "Robots": 12}
+	if len(usage.calls) != len(want) {
+		t.Fatalf("llm calls = %d, want %d", len(usage.calls), len(want))
+	}
+	for _, call := range usage.calls {
+		if call.RunID == 0 || call.RunID != want[call.Domain] {
+			t.Errorf("llm call for %s has run_id %d, want %d", call.Domain, call.RunID, want[call.Domain])
+		}
+	}
+}
@@ -0,0 +1,159 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/cloudwego/eino/schema"
+)
+
+// ErrBudgetExceeded 本次运行的 token 或费用预算已耗尽
+var ErrBudgetExceeded = errors.New("budget exceeded")
+
+// 预算降级阈值（已用预算占比），越接近上限降级越多
+const (
+	degradeFewerArticles    = 0.5  // 减少每个领域送入 LLM 的文章数
+	degradeSkipVerification = 0.6  // 跳过事实核验
+	degradeCheapModel       = 0.75 // 切换到低价模型
+)
+
+const (
+	maxArticlesPerDomain      = 6
+	degradedArticlesPerDomain = 3
+	// estimatedCompletionTokens 单次调用预估的输出 token 数，用于调用前的预算检查
+	estimatedCompletionTokens = 1500
+)
+
+// Budget 单次运行的预算上限，字段为 0 时表示不限制
+type Budget struct {
+	MaxTokens int
+	MaxCost   float64
+}
+
+// IsZero 判断是否未设置任何预算
+func (b Budget) IsZero() bool {
+	return b.MaxTokens <= 0 && b.MaxCost <= 0
+}
+
+// budgetTracker 统计单次运行的累计用量并判断降级与超限
+type budgetTracker struct {
+	budget Budget
+
+	mu             sync.Mutex
+	tokens         int
+	cost           float64
+	reservedTokens int     // 进行中调用预先占用的 token 数，调用结束后按实际用量结算
+	reservedCost   float64 // 进行中调用预先占用的费用
+	exceeded       bool
+}
+
+// reservation 单次调用预先占用的预算
+type reservation struct {
+	tokens int
+	cost   float64
+}
+
+func newBudgetTracker(budget Budget) *budgetTracker {
+	return &budgetTracker{budget: budget}
+}
+
+// settle 调用结束后释放预先占用的预算并计入实际用量
+func (t *budgetTracker) settle(r reservation, tokens int, cost float64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.reservedTokens -= r.tokens
+	t.reservedCost -= r.cost
+	t.tokens += tokens
+	t.cost += cost
+}
+
+// usedRatio 返回已用与已占用预算的占比，token 与费用取较大者
+func (t *budgetTracker) usedRatio() float64 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.ratioLocked(0, 0)
+}
+
+func (t *budgetTracker) ratioLocked(extraTokens int, extraCost float64) float64 {
+	var ratio float64
+	if t.budget.MaxTokens > 0 {
+		ratio = float64(t.tokens+t.reservedTokens+extraTokens) / float64(t.budget.MaxTokens)
+	}
+	if t.budget.MaxCost > 0 {
+		if r := (t.cost + t.reservedCost + extraCost) / t.budget.MaxCost; r > ratio {
+			ratio = r
+		}
+	}
+	return ratio
+}
+
+// reserve 在调用前按预估用量占用预算，已用、已占用与本次预估的合计超出预算时拒绝并标记本次运行已超限；
+// 并发的调用因此不会同时通过检查而合计超出预算，占用的预算需在调用结束后由 settle 结算
+func (t *budgetTracker) reserve(estimatedTokens int, estimatedCost float64) (reservation, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.exceeded {
+		return reservation{}, ErrBudgetExceeded
+	}
+	if t.ratioLocked(estimatedTokens, estimatedCost) > 1 {
+		t.exceeded = true
+		return reservation{}, fmt.Errorf("%w: used %d tokens / %.4f cost, reserved %d tokens / %.4f cost, limit %d tokens / %.4f cost",
+			ErrBudgetExceeded, t.tokens, t.cost, t.reservedTokens, t.reservedCost, t.budget.MaxTokens, t.budget.MaxCost)
+	}
+	t.reservedTokens += estimatedTokens
+	t.reservedCost += estimatedCost
+	return reservation{tokens: estimatedTokens, cost: estimatedCost}, nil
+}
+
+// isExceeded 判断本次运行是否已经超出预算
+func (t *budgetTracker) isExceeded() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.exceeded
+}
+
+// articleLimit 根据预算使用情况返回每个领域的文章数上限，limit 为领域配置的上限，0 时使用默认值
+func (t *budgetTracker) articleLimit(limit int) int {
+	if limit <= 0 {
+		limit = maxArticlesPerDomain
+	}
+	if t != nil && t.usedRatio() >= degradeFewerArticles {
+		return min(limit, degradedArticlesPerDomain)
+	}
+	return limit
+}
+
+// allowVerification 判断预算是否允许执行事实核验
+func (t *budgetTracker) allowVerification() bool {
+	return t == nil || t.usedRatio() < degradeSkipVerification
+}
+
+// preferCheapModel 判断是否应切换到低价模型
+func (t *budgetTracker) preferCheapModel() bool {
+	return t != nil && t.usedRatio() >= degradeCheapModel
+}
+
+type budgetKey struct{}
+
+// withBudget 在 context 中挂载本次运行的预算统计
+func withBudget(ctx context.Context, t *budgetTracker) context.Context {
+	return context.WithValue(ctx, budgetKey{}, t)
+}
+
+// budgetFrom 返回 context 中的预算统计，未设置预算时返回 nil
+func budgetFrom(ctx context.Context) *budgetTracker {
+	t, _ := ctx.Value(budgetKey{}).(*budgetTracker)
+	return t
+}
+
+// estimatePromptTokens 粗略估算输入的 token 数，中文约每字 1 token，按字符数计偏保守
+func estimatePromptTokens(input []*schema.Message) int {
+	var n int
+	for _, m := range input {
+		n += utf8.RuneCountInString(m.Content)
+	}
+	return n
+}
@@ -0,0 +1,78 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestBudgetTracker(t *testing.T) {
+	tracker := newBudgetTracker(Budget{MaxTokens: 1000})
+
+	if got := tracker.articleLimit(0); got != maxArticlesPerDomain {
+		t.Errorf("articleLimit() = %d, want %d", got, maxArticlesPerDomain)
+	}
+
+	tracker.settle(reservation{}, 700, 0)
+	if got := tracker.articleLimit(0); got != degradedArticlesPerDomain {
+		t.Errorf("articleLimit() = %d, want %d", got, degradedArticlesPerDomain)
+	}
+	if tracker.allowVerification() {
+		t.Errorf("allowVerification() = true, want false")
+	}
+	if tracker.preferCheapModel() {
+		t.Errorf("preferCheapModel() = true, want false")
+	}
+
+	if _, err := tracker.reserve(200, 0); err != nil {
+		t.Errorf("reserve(200) error = %v", err)
+	}
+	if _, err := tracker.reserve(400, 0); !errors.Is(err, ErrBudgetExceeded) {
+		t.Errorf("reserve(400) error = %v, want ErrBudgetExceeded", err)
+	}
+	if !tracker.isExceeded() {
+		t.Errorf("isExceeded() = false, want true")
+	}
+}
+
+func TestBudgetTrackerConcurrentReservations(t *testing.T) {
+	tracker := newBudgetTracker(Budget{MaxTokens: 1000})
+
+	// 8 个并发调用各预估 300 token，合计不超过预算的只能有 3 个
+	var granted atomic.Int32
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := tracker.reserve(300, 0); err == nil {
+				granted.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+	if got := granted.Load(); got != 3 {
+		t.Errorf("granted reservations = %d, want 3", got)
+	}
+}
+
+func TestBudgetTrackerSettle(t *testing.T) {
+	tracker := newBudgetTracker(Budget{MaxTokens: 1000})
+
+	r, err := tracker.reserve(600, 0)
+	if err != nil {
+		t.Fatalf("reserve(600) error = %v", err)
+	}
+	if _, err := tracker.reserve(600, 0); err == nil {
+		t.Fatalf("reserve(600) with 600 reserved error = nil, want ErrBudgetExceeded")
+	}
+
+	// 实际用量少于预估时释放多占用的预算
+	tracker = newBudgetTracker(Budget{MaxTokens: 1000})
+	r, _ = tracker.reserve(600, 0)
+	tracker.settle(r, 100, 0)
+	if _, err := tracker.reserve(600, 0); err != nil {
+		t.Errorf("reserve(600) after settling 100 error = %v", err)
+	}
+}
@@ -0,0 +1,226 @@
+package engine
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/schema"
+
+	"github.com/iWorld-y/domain_radar/app/domain_radar/pkg/logger"
+	dm "github.com/iWorld-y/domain_radar/app/domain_radar/pkg/model"
+)
+
+// defaultCacheTTL 未配置缓存有效期时的默认值
+const defaultCacheTTL = 24 * time.Hour
+
+// promptVersions 各阶段提示词模板的版本，修改提示词时需递增对应版本，使旧缓存失效
+var promptVersions = map[string]string{
+	stageDomainReport:     "v3",
+	stageVerification:     "v1",
+	stageDeepAnalysis:     "v2",
+	stageResearch:         "v1",
+	stageQueryTranslation: "v2",
+	stageEntities:         "v2",
+	stagePersonaInterview: "v1",
+}
+
+// CachePolicy LLM 输出缓存的使用策略
+type CachePolicy int
+
+const (
+	// CacheDefault 优先读取缓存，未命中时调用 LLM 并写入缓存
+	CacheDefault CachePolicy = iota
+	// CacheRefresh 不读取缓存，调用 LLM 后覆盖缓存
+	CacheRefresh
+	// CacheBypass 完全不使用缓存
+	CacheBypass
+)
+
+// CacheStore LLM 输出缓存的存储
+type CacheStore interface {
+	GetLLMCache(ctx context.Context, key string) (*dm.LLMCacheEntry, error)
+	SaveLLMCache(ctx context.Context, entry *dm.LLMCacheEntry) error
+}
+
+type cachePolicyKey struct{}
+
+// withCachePolicy 在 context 中设置缓存策略
+func withCachePolicy(ctx context.Context, p CachePolicy) context.Context {
+	return context.WithValue(ctx, cachePolicyKey{}, p)
+}
+
+func cachePolicyFrom(ctx context.Context) CachePolicy {
+	p, _ := ctx.Value(cachePolicyKey{}).(CachePolicy)
+	return p
+}
+
+// refreshCache 输出不可用（如 JSON 解析失败）需要重试时，避免再次命中同一条缓存
+func refreshCache(ctx context.Context) context.Context {
+	if cachePolicyFrom(ctx) == CacheBypass {
+		return ctx
+	}
+	return withCachePolicy(ctx, CacheRefresh)
+}
+
+// cacheKey 根据模型、提示词模板版本与消息内容计算缓存 key
+func cacheKey(modelName, templateVersion string, input []*schema.Message) string {
+	h := sha256.New()
+	h.Write([]byte(modelName))
+	h.Write([]byte{0})
+	h.Write([]byte(templateVersion))
+	for _, m := range input {
+		h.Write([]byte{0})
+		h.Write([]byte(m.Role))
+		h.Write([]byte{0})
+		h.Write([]byte(m.Content))
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// cachedChatModel 在计量模型之前查询缓存，命中时直接返回已有输出，不占用预算也不产生费用
+type cachedChatModel struct {
+	*meteredChatModel
+	store CacheStore
+	ttl   time.Duration
+}
+
+func newCachedChatModel(m *meteredChatModel, store CacheStore, ttl time.Duration) *cachedChatModel {
+	if ttl <= 0 {
+		ttl = defaultCacheTTL
+	}
+	return &cachedChatModel{meteredChatModel: m, store: store, ttl: ttl}
+}
+
+// cacheRequest 单次调用的缓存信息
+type cacheRequest struct {
+	opts    []model.Option
+	key     string
+	model   string
+	version string // 提示词模板版本，如 domain_report/v2
+}
+
+// lookup 计算缓存 key 并在策略允许时查询缓存
+func (m *cachedChatModel) lookup(ctx context.Context, input []*schema.Message, opts []model.Option) (*cacheRequest, *schema.Message) {
+	opts = m.selectModel(ctx, opts)
+	stage := callInfoFrom(ctx).Stage
+	req := &cacheRequest{
+		opts:    opts,
+		model:   m.modelName(opts),
+		version: stage + "/" + promptVersions[stage],
+	}
+	req.key = cacheKey(req.model, req.version, input)
+	if cachePolicyFrom(ctx) != CacheDefault {
+		return req, nil
+	}
+	entry, err := m.store.GetLLMCache(ctx, req.key)
+	if err != nil {
+		logger.Log.Errorf("查询 LLM 缓存失败: %v", err)
+		return req, nil
+	}
+	if entry == nil {
+		return req, nil
+	}
+	recordEvent(ctx, dm.EventLLMCacheHit, "", map[string]any{"model": req.model, "stage": stage})
+	return req, &schema.Message{Role: schema.Assistant, Content: entry.Content}
+}
+
+func (m *cachedChatModel) save(ctx context.Context, req *cacheRequest, content string, usage *schema.TokenUsage) {
+	if content == "" {
+		return
+	}
+	entry := &dm.LLMCacheEntry{
+		Key:             req.key,
+		Model:           req.model,
+		TemplateVersion: req.version,
+		Content:         content,
+		ExpiresAt:       time.Now().Add(m.ttl),
+	}
+	if usage != nil {
+		entry.PromptTokens = usage.PromptTokens
+		entry.CompletionTokens = usage.CompletionTokens
+	}
+	if err := m.store.SaveLLMCache(ctx, entry); err != nil {
+		logger.Log.Errorf("写入 LLM 缓存失败: %v", err)
+	}
+}
+
+// skipCache 判断本次调用是否不使用缓存：策略要求跳过，或携带工具（工具结果来自实时数据）
+func skipCache(ctx context.Context, opts []model.Option) bool {
+	return cachePolicyFrom(ctx) == CacheBypass || len(model.GetCommonOptions(nil, opts...).Tools) > 0
+}
+
+// Generate implements model.ChatModel
+func (m *cachedChatModel) Generate(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.Message, error) {
+	if skipCache(ctx, opts) {
+		return m.meteredChatModel.Generate(ctx, input, opts...)
+	}
+	req, hit := m.lookup(ctx, input, opts)
+	if hit != nil {
+		return hit, nil
+	}
+	resp, err := m.meteredChatModel.Generate(ctx, input, req.opts...)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.ToolCalls) == 0 {
+		var usage *schema.TokenUsage
+		if resp.ResponseMeta != nil {
+			usage = resp.ResponseMeta.Usage
+		}
+		m.save(ctx, req, resp.Content, usage)
+	}
+	return resp, nil
+}
+
+// Stream implements model.ChatModel，命中缓存时以单个分片返回完整输出
+func (m *cachedChatModel) Stream(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.StreamReader[*schema.Message], error) {
+	if skipCache(ctx, opts) {
+		return m.meteredChatModel.Stream(ctx, input, opts...)
+	}
+	req, hit := m.lookup(ctx, input, opts)
+	if hit != nil {
+		return schema.StreamReaderFromArray([]*schema.Message{hit}), nil
+	}
+	sr, err := m.meteredChatModel.Stream(ctx, input, req.opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	out, writer := schema.Pipe[*schema.Message](1)
+	go func() {
+		defer sr.Close()
+		defer writer.Close()
+
+		var sb strings.Builder
+		var usage *schema.TokenUsage
+		hasToolCalls := false
+		for {
+			chunk, err := sr.Recv()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				writer.Send(nil, err)
+				return
+			}
+			sb.WriteString(chunk.Content)
+			hasToolCalls = hasToolCalls || len(chunk.ToolCalls) > 0
+			if chunk.ResponseMeta != nil && chunk.ResponseMeta.Usage != nil {
+				usage = chunk.ResponseMeta.Usage
+			}
+			if closed := writer.Send(chunk, nil); closed {
+				return
+			}
+		}
+		if !hasToolCalls {
+			m.save(ctx, req, sb.String(), usage)
+		}
+	}()
+	return out, nil
+}
@@ -0,0 +1,66 @@
+package engine
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/schema"
+
+	"github.com/iWorld-y/domain_radar/app/domain_radar/pkg/config"
+	dm "github.com/iWorld-y/domain_radar/app/domain_radar/pkg/model"
+)
+
+type countingChatModel struct {
+	model.ChatModel
+	calls int
+}
+
+func (m *countingChatModel) Generate(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.Message, error) {
+	m.calls++
+	return &schema.Message{Role: schema.Assistant, Content: "answer"}, nil
+}
+
+type memoryCacheStore map[string]*dm.LLMCacheEntry
+
+func (s memoryCacheStore) GetLLMCache(_ context.Context, key string) (*dm.LLMCacheEntry, error) {
+	return s[key], nil
+}
+
+func (s memoryCacheStore) SaveLLMCache(_ context.Context, entry *dm.LLMCacheEntry) error {
+	s[entry.Key] = entry
+	return nil
+}
+
+func TestCachedChatModel(t *testing.T) {
+	inner := &countingChatModel{}
+	cm := newCachedChatModel(newMeteredChatModel(inner, config.LLMConfig{Model: "m"}, nil), memoryCacheStore{}, 0)
+	input := []*schema.Message{{Role: schema.User, Content: "hello"}}
+	ctx := withStage(context.Background(), stageDomainReport)
+
+	for i := 0; i < 2; i++ {
+		resp, err := cm.Generate(ctx, input)
+		if err != nil || resp.Content != "answer" {
+			t.Fatalf("Generate() = %v, %v", resp, err)
+		}
+	}
+	if inner.calls != 1 {
+		t.Errorf("calls after repeated input = %d, want 1", inner.calls)
+	}
+
+	if _, err := cm.Generate(withStage(ctx, stageDeepAnalysis), input); err != nil {
+		t.Fatal(err)
+	}
+	if inner.calls != 2 {
+		t.Errorf("calls after template change = %d, want 2", inner.calls)
+	}
+
+	for _, p := range []CachePolicy{CacheRefresh, CacheBypass} {
+		if _, err := cm.Generate(withCachePolicy(ctx, p), input); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if inner.calls != 4 {
+		t.Errorf("calls with refresh and bypass = %d, want 4", inner.calls)
+	}
+}
@@ -0,0 +1,51 @@
+package engine
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+
+	dm "github.com/iWorld-y/domain_radar/app/domain_radar/pkg/model"
+)
+
+// citationPattern 匹配正文中的引用标记，如 [1]、[2]
+var citationPattern = regexp.MustCompile(`\[(\d+)\]`)
+
+// normalizeCitations 校验报告中的引用序号，剔除越界与重复的引用；
+// 过滤后没有任何有效来源的关键事件无法溯源，直接丢弃
+func normalizeCitations(report *dm.DomainReport, articleCount int) {
+	events := report.KeyEvents[:0]
+	for _, e := range report.KeyEvents {
+		e.Sources = filterSources(e.Sources, articleCount)
+		if len(e.Sources) == 0 {
+			continue
+		}
+		events = append(events, e)
+	}
+	report.KeyEvents = events
+	report.Trends = citationPattern.ReplaceAllStringFunc(report.Trends, func(m string) string {
+		n, err := strconv.Atoi(m[1 : len(m)-1])
+		if err != nil || n < 1 || n > articleCount {
+			return ""
+		}
+		return m
+	})
+}
+
+// filterSources 过滤越界的文章序号，去重并升序排列
+func filterSources(sources []int, articleCount int) []int {
+	seen := make(map[int]struct{}, len(sources))
+	var result []int
+	for _, s := range sources {
+		if s < 1 || s > articleCount {
+			continue
+		}
+		if _, ok := seen[s]; ok {
+			continue
+		}
+		seen[s] = struct{}{}
+		result = append(result, s)
+	}
+	sort.Ints(result)
+	return result
+}
@@ -0,0 +1,36 @@
+package engine
+
+import (
+	"reflect"
+	"testing"
+
+	dm "github.com/iWorld-y/domain_radar/app/domain_radar/pkg/model"
+)
+
+func TestNormalizeCitations(t *testing.T) {
+	report := &dm.DomainReport{
+		KeyEvents: []dm.KeyEvent{
+			{Content: "事件A", Sources: []int{3, 1, 3, 9}},
+			{Content: "事件B", Sources: []int{0}},
+			{Content: "事件C", Sources: []int{2}},
+			{Content: "事件D"},
+		},
+		Trends: "算力需求持续增长 [1][7]，开源模型加速追赶 [2]。",
+	}
+
+	normalizeCitations(report, 3)
+
+	// 没有有效来源的事件B、事件D 被丢弃
+	if len(report.KeyEvents) != 2 {
+		t.Fatalf("len(KeyEvents) = %d, want 2: %+v", len(report.KeyEvents), report.KeyEvents)
+	}
+	if got := report.KeyEvents[0].Sources; !reflect.DeepEqual(got, []int{1, 3}) {
+		t.Errorf("KeyEvents[0].Sources = %v, want [1 3]", got)
+	}
+	if got := report.KeyEvents[1]; got.Content != "事件C" || !reflect.DeepEqual(got.Sources, []int{2}) {
+		t.Errorf("KeyEvents[1] = %+v, want 事件C with sources [2]", got)
+	}
+	if want := "算力需求持续增长 [1]，开源模型加速追赶 [2]。"; report.Trends != want {
+		t.Errorf("Trends = %q, want %q", report.Trends, want)
+	}
+}
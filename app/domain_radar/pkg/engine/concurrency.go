@@ -0,0 +1,142 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/schema"
+	"golang.org/x/time/rate"
+
+	"github.com/iWorld-y/domain_radar/app/domain_radar/pkg/config"
+	"github.com/iWorld-y/domain_radar/app/domain_radar/pkg/search"
+)
+
+// 各阶段并发数的默认值，对应配置为 0 时使用
+const (
+	defaultDomainWorkers = 4
+	defaultFetchWorkers  = 4
+	defaultLLMCalls      = 8
+)
+
+// workers 返回配置的并发数，未配置时使用默认值
+func workers(n, def int) int {
+	if n <= 0 {
+		return def
+	}
+	return n
+}
+
+// semaphore 限制同时进行的操作数，为 nil 时不限制
+type semaphore chan struct{}
+
+func newSemaphore(n int) semaphore {
+	if n <= 0 {
+		return nil
+	}
+	return make(semaphore, n)
+}
+
+// acquire 占用一个名额，ctx 被取消时放弃等待
+func (s semaphore) acquire(ctx context.Context) error {
+	if s == nil {
+		return nil
+	}
+	select {
+	case s <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (s semaphore) release() {
+	if s != nil {
+		<-s
+	}
+}
+
+// newRateLimiter 按每分钟请求数创建限流器，rpm 为 0 时返回 nil 表示不限流
+func newRateLimiter(cfg config.RateLimitConfig) *rate.Limiter {
+	if cfg.RPM <= 0 {
+		return nil
+	}
+	return rate.NewLimiter(rate.Limit(float64(cfg.RPM)/60.0), max(cfg.Burst, 1))
+}
+
+// waitLimiter 等待限流器放行，limiter 为 nil 时立即返回
+func waitLimiter(ctx context.Context, limiter *rate.Limiter) error {
+	if limiter == nil {
+		return nil
+	}
+	return limiter.Wait(ctx)
+}
+
+// search 经搜索限流后调用搜索服务
+func (e *Engine) search(ctx context.Context, req *search.Request) (*search.Response, error) {
+	if err := waitLimiter(ctx, e.searchLimiter); err != nil {
+		return nil, err
+	}
+	return e.searcher.Search(ctx, req)
+}
+
+// fetchPage 经抓取限流后抓取网页正文
+func (e *Engine) fetchPage(ctx context.Context, pageURL string) (string, error) {
+	if err := waitLimiter(ctx, e.fetchLimiter); err != nil {
+		return "", err
+	}
+	return fetchAndCleanContent(ctx, pageURL)
+}
+
+// boundedChatModel 限制同时进行的 LLM 调用数，流式调用在流结束后才释放名额
+type boundedChatModel struct {
+	model.ChatModel
+	slots semaphore
+}
+
+func newBoundedChatModel(cm model.ChatModel, n int) *boundedChatModel {
+	return &boundedChatModel{ChatModel: cm, slots: newSemaphore(n)}
+}
+
+// Generate implements model.ChatModel
+func (m *boundedChatModel) Generate(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.Message, error) {
+	if err := m.slots.acquire(ctx); err != nil {
+		return nil, err
+	}
+	defer m.slots.release()
+	return m.ChatModel.Generate(ctx, input, opts...)
+}
+
+// Stream implements model.ChatModel
+func (m *boundedChatModel) Stream(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.StreamReader[*schema.Message], error) {
+	if err := m.slots.acquire(ctx); err != nil {
+		return nil, err
+	}
+	sr, err := m.ChatModel.Stream(ctx, input, opts...)
+	if err != nil {
+		m.slots.release()
+		return nil, err
+	}
+
+	out, writer := schema.Pipe[*schema.Message](1)
+	go func() {
+		defer m.slots.release()
+		defer sr.Close()
+		defer writer.Close()
+		for {
+			chunk, err := sr.Recv()
+			if errors.Is(err, io.EOF) {
+				return
+			}
+			if err != nil {
+				writer.Send(nil, err)
+				return
+			}
+			if closed := writer.Send(chunk, nil); closed {
+				return
+			}
+		}
+	}()
+	return out, nil
+}
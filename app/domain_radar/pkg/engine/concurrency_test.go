@@ -0,0 +1,81 @@
+package engine
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/schema"
+
+	"github.com/iWorld-y/domain_radar/app/domain_radar/pkg/config"
+	"github.com/iWorld-y/domain_radar/app/domain_radar/pkg/search"
+)
+
+type slowChatModel struct {
+	model.ChatModel
+	running, peak atomic.Int32
+}
+
+func (m *slowChatModel) Generate(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.Message, error) {
+	n := m.running.Add(1)
+	defer m.running.Add(-1)
+	for {
+		peak := m.peak.Load()
+		if n <= peak || m.peak.CompareAndSwap(peak, n) {
+			break
+		}
+	}
+	time.Sleep(10 * time.Millisecond)
+	return &schema.Message{Role: schema.Assistant}, nil
+}
+
+func TestBoundedChatModel(t *testing.T) {
+	inner := &slowChatModel{}
+	cm := newBoundedChatModel(inner, 2)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := cm.Generate(context.Background(), nil); err != nil {
+				t.Errorf("Generate() error = %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+	if peak := inner.peak.Load(); peak != 2 {
+		t.Errorf("peak concurrent calls = %d, want 2", peak)
+	}
+}
+
+func TestFetchNodeKeepsOrderAndStopsEarly(t *testing.T) {
+	e := &Engine{cfg: &config.Config{Concurrency: config.ConcurrencyConfig{Fetches: 3}}}
+
+	var results []search.Result
+	for i := 0; i < maxArticlesPerDomain*3; i++ {
+		content := strings.Repeat("x", 600)
+		if i%2 == 1 {
+			content = "" // 正文过短且链接无法抓取，不计入有效文章
+		}
+		results = append(results, search.Result{Title: string(rune('A' + i)), URL: "http://127.0.0.1:0/", Content: content})
+	}
+	s := &domainState{run: &runState{}, domain: "AI", results: results}
+
+	out, err := e.fetchNode(context.Background(), s)
+	if err != nil {
+		t.Fatalf("fetchNode() error = %v", err)
+	}
+	if len(out.articles) != maxArticlesPerDomain {
+		t.Fatalf("articles = %d, want %d", len(out.articles), maxArticlesPerDomain)
+	}
+	for i, a := range out.articles {
+		if want := string(rune('A' + 2*i)); a.Title != want {
+			t.Errorf("articles[%d] = %s, want %s", i, a.Title, want)
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/cloudwego/eino/schema"
+
+	"github.com/iWorld-y/domain_radar/app/domain_radar/pkg/logger"
+	dm "github.com/iWorld-y/domain_radar/app/domain_radar/pkg/model"
+	"github.com/iWorld-y/domain_radar/app/domain_radar/pkg/search"
+)
+
+// searchQuery 某种语言下的检索词
+type searchQuery struct {
+	Language string // 为空时表示不限制语言
+	Query    string
+}
+
+// searchLanguages 返回领域的检索语言，领域未配置时使用全局配置，去除空值与重复项
+func (e *Engine) searchLanguages(d dm.DomainConfig) []string {
+	if len(d.Languages) > 0 {
+		return normalizeLanguages(d.Languages)
+	}
+	return normalizeLanguages(e.cfg.Search.Languages)
+}
+
+// searchQueries 生成领域的检索词。领域配置了检索词时直接使用，检索词的语言属于检索语言时限定该语言；
+// 否则由领域名称生成各检索语言下的检索词：与领域名称同语言时直接使用原文，
+// 其余语言由 LLM 翻译，翻译失败时仅保留已有的检索词
+func (e *Engine) searchQueries(ctx context.Context, d dm.DomainConfig) []searchQuery {
+	domain := d.Name
+	langs := e.searchLanguages(d)
+	if len(d.Queries) > 0 {
+		queries := make([]searchQuery, 0, len(d.Queries))
+		for _, q := range d.Queries {
+			lang := detectLanguage(q)
+			if !slices.Contains(langs, lang) {
+				lang = ""
+			}
+			queries = append(queries, searchQuery{Language: lang, Query: q})
+		}
+		return queries
+	}
+	if len(langs) == 0 {
+		return []searchQuery{{Query: domain}}
+	}
+
+	source := detectLanguage(domain)
+	var queries []searchQuery
+	var missing []string
+	for _, lang := range langs {
+		if lang == source {
+			queries = append(queries, searchQuery{Language: lang, Query: domain})
+		} else {
+			missing = append(missing, lang)
+		}
+	}
+	if len(missing) > 0 {
+		translated, err := e.translateQuery(ctx, domain, missing)
+		if err != nil {
+			logger.Log.Warnf("翻译领域 [%s] 的检索词失败，仅使用原文检索: %v", domain, err)
+		}
+		for _, lang := range missing {
+			if q := strings.TrimSpace(translated[lang]); q != "" {
+				queries = append(queries, searchQuery{Language: lang, Query: q})
+			}
+		}
+	}
+	if len(queries) == 0 {
+		return []searchQuery{{Query: domain}}
+	}
+	return queries
+}
+
+// translateQuery 调用 LLM 将领域名称翻译为各语言的检索词，返回语言到检索词的映射
+func (e *Engine) translateQuery(ctx context.Context, domain string, langs []string) (map[string]string, error) {
+	p := promptsFrom(ctx)
+	messages := []*schema.Message{
+		{Role: schema.System, Content: p.jsonSystem},
+		{Role: schema.User, Content: fmt.Sprintf(p.queryTranslation, domain, strings.Join(langs, ", "))},
+	}
+	var out struct {
+		Queries map[string]string `json:"queries"`
+	}
+	if err := generateJSON(withStage(ctx, stageQueryTranslation), e.chatModel, e.limiter, messages, &out); err != nil {
+		return nil, err
+	}
+	return out.Queries, nil
+}
+
+// mergeResults 轮流从各语言的结果中取出条目并按链接与标题去重，
+// 使抓取数量受限时各语言的来源都能被覆盖
+func mergeResults(lists [][]search.Result) []search.Result {
+	var merged []search.Result
+	seen := make(map[string]bool)
+	for i := 0; ; i++ {
+		more := false
+		for _, list := range lists {
+			if i >= len(list) {
+				continue
+			}
+			more = true
+			r := list[i]
+			urlKey := "url:" + normalizeURL(r.URL)
+			titleKey := "title:" + strings.ToLower(strings.TrimSpace(r.Title))
+			if seen[urlKey] || (titleKey != "title:" && seen[titleKey]) {
+				continue
+			}
+			seen[urlKey] = true
+			seen[titleKey] = true
+			merged = append(merged, r)
+		}
+		if !more {
+			return merged
+		}
+	}
+}
+
+// normalizeURL 归一化链接，忽略协议、www 前缀、末尾斜杠与锚点的差异
+func normalizeURL(raw string) string {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil || u.Host == "" {
+		return strings.TrimSpace(raw)
+	}
+	host := strings.TrimPrefix(strings.ToLower(u.Host), "www.")
+	path := strings.TrimSuffix(u.Path, "/")
+	if u.RawQuery != "" {
+		return host + path + "?" + u.RawQuery
+	}
+	return host + path
+}
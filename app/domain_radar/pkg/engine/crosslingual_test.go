@@ -0,0 +1,81 @@
+package engine
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/schema"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/time/rate"
+
+	"github.com/iWorld-y/domain_radar/app/domain_radar/pkg/config"
+	"github.com/iWorld-y/domain_radar/app/domain_radar/pkg/logger"
+	dm "github.com/iWorld-y/domain_radar/app/domain_radar/pkg/model"
+	"github.com/iWorld-y/domain_radar/app/domain_radar/pkg/search"
+)
+
+type translationModel struct {
+	model.ChatModel
+}
+
+func (translationModel) Generate(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.Message, error) {
+	return &schema.Message{Role: schema.Assistant, Content: `{"queries": {"en": "large language models"}}`}, nil
+}
+
+func TestSearchQueries(t *testing.T) {
+	logger.Log = logrus.New()
+	logger.Log.SetOutput(io.Discard)
+
+	e := &Engine{
+		cfg:       &config.Config{Search: config.SearchConfig{Languages: []string{"zh", "EN", "en"}}},
+		chatModel: translationModel{},
+		limiter:   rate.NewLimiter(rate.Inf, 1),
+	}
+	got := e.searchQueries(context.Background(), dm.DomainConfig{Name: "大模型"})
+	want := []searchQuery{{Language: "zh", Query: "大模型"}, {Language: "en", Query: "large language models"}}
+	if len(got) != len(want) {
+		t.Fatalf("searchQueries() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("searchQueries()[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+
+	e.cfg.Search.Languages = nil
+	if got := e.searchQueries(context.Background(), dm.DomainConfig{Name: "大模型"}); len(got) != 1 || got[0].Query != "大模型" {
+		t.Errorf("searchQueries() without languages = %v, want original domain only", got)
+	}
+
+	// 配置了检索词时直接使用，不再翻译领域名称
+	d := dm.DomainConfig{Name: "大模型", Queries: []string{"开源大模型", "open weights model"}, Languages: []string{"zh"}}
+	got = e.searchQueries(context.Background(), d)
+	want = []searchQuery{{Language: "zh", Query: "开源大模型"}, {Query: "open weights model"}}
+	if len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("searchQueries() with explicit queries = %v, want %v", got, want)
+	}
+}
+
+func TestMergeResults(t *testing.T) {
+	zh := []search.Result{
+		{Title: "大模型发布", URL: "https://www.example.com/a/"},
+		{Title: "算力价格下降", URL: "https://example.com/b"},
+	}
+	en := []search.Result{
+		{Title: "LLM release", URL: "http://example.com/a#top"},
+		{Title: "New open model", URL: "https://news.example.org/c"},
+		{Title: "大模型发布", URL: "https://mirror.example.net/a"},
+	}
+	got := mergeResults([][]search.Result{zh, en})
+	want := []string{"https://www.example.com/a/", "https://example.com/b", "https://news.example.org/c"}
+	if len(got) != len(want) {
+		t.Fatalf("mergeResults() returned %d results, want %d: %v", len(got), len(want), got)
+	}
+	for i, u := range want {
+		if got[i].URL != u {
+			t.Errorf("mergeResults()[%d].URL = %q, want %q", i, got[i].URL, u)
+		}
+	}
+}
@@ -0,0 +1,191 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	dm "github.com/iWorld-y/domain_radar/app/domain_radar/pkg/model"
+	"github.com/iWorld-y/domain_radar/app/domain_radar/pkg/search"
+)
+
+// maxDomainArticles 领域配置中收录文章数的上限，不超过单次搜索返回的结果数
+const maxDomainArticles = 20
+
+// NormalizeDomains 整理领域配置：去除空白与空值、统一站点与语言的写法，并校验各字段；
+// 领域名称不区分大小写，不能重复
+func NormalizeDomains(domains []dm.DomainConfig) ([]dm.DomainConfig, error) {
+	var errs []error
+	seen := make(map[string]bool, len(domains))
+	out := make([]dm.DomainConfig, 0, len(domains))
+	for i, d := range domains {
+		d.Name = strings.TrimSpace(d.Name)
+		d.DisplayName = strings.TrimSpace(d.DisplayName)
+		d.Window = strings.TrimSpace(d.Window)
+		d.Queries = cleanList(d.Queries)
+		d.IncludeSites = normalizeSites(d.IncludeSites)
+		d.ExcludeSites = normalizeSites(d.ExcludeSites)
+		d.Languages = normalizeLanguages(d.Languages)
+
+		label := d.Name
+		if label == "" {
+			label = fmt.Sprintf("#%d", i+1)
+		}
+		key := strings.ToLower(d.Name)
+		switch {
+		case d.Name == "":
+			errs = append(errs, fmt.Errorf("domain %s: name is required", label))
+		case seen[key]:
+			errs = append(errs, fmt.Errorf("domain %s: duplicate name", label))
+		}
+		seen[key] = true
+		if d.MaxArticles < 0 || d.MaxArticles > maxDomainArticles {
+			errs = append(errs, fmt.Errorf("domain %s: max_articles must be between 0 and %d", label, maxDomainArticles))
+		}
+		if d.Weight < 0 {
+			errs = append(errs, fmt.Errorf("domain %s: weight must not be negative", label))
+		}
+		if !ValidWindowPreset(d.Window) {
+			errs = append(errs, fmt.Errorf("domain %s: unknown window preset: %s", label, d.Window))
+		}
+		out = append(out, d)
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// enabledDomains 返回启用的领域，按优先级权重从高到低排列，权重相同时保持原有顺序
+func enabledDomains(domains []dm.DomainConfig) []dm.DomainConfig {
+	var out []dm.DomainConfig
+	for _, d := range domains {
+		if d.Enabled {
+			out = append(out, d)
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].PriorityWeight() > out[j].PriorityWeight()
+	})
+	return out
+}
+
+// domainWindow 返回领域的时间范围：领域配置了时间范围预设时以本次运行的结束时间为终点计算，
+// 否则使用本次运行的时间范围
+func (e *Engine) domainWindow(ctx context.Context, s *runState, d dm.DomainConfig) (time.Time, time.Time, error) {
+	if d.Window == "" {
+		return s.windowStart, s.windowEnd, nil
+	}
+	return e.resolveWindow(ctx, s.opts.UserID, Window{Preset: d.Window, End: s.windowEnd}, time.Now())
+}
+
+// normalizeSites 将站点统一为小写、不带协议、www 前缀与路径的主机名，并去除重复项
+func normalizeSites(sites []string) []string {
+	var out []string
+	seen := make(map[string]bool)
+	for _, site := range sites {
+		site = strings.ToLower(strings.TrimSpace(site))
+		if u, err := url.Parse(site); err == nil && u.Host != "" {
+			site = u.Host
+		}
+		site, _, _ = strings.Cut(site, "/")
+		site = strings.TrimPrefix(site, "www.")
+		if site == "" || seen[site] {
+			continue
+		}
+		seen[site] = true
+		out = append(out, site)
+	}
+	return out
+}
+
+// normalizeLanguages 将语言代码统一为小写并去除空值与重复项
+func normalizeLanguages(langs []string) []string {
+	var out []string
+	seen := make(map[string]bool)
+	for _, lang := range langs {
+		lang = strings.ToLower(strings.TrimSpace(lang))
+		if lang == "" || seen[lang] {
+			continue
+		}
+		seen[lang] = true
+		out = append(out, lang)
+	}
+	return out
+}
+
+// filterSites 按领域配置的站点范围过滤搜索结果，供不支持站点参数的搜索源使用
+func filterSites(results []search.Result, d dm.DomainConfig) []search.Result {
+	if len(d.IncludeSites) == 0 && len(d.ExcludeSites) == 0 {
+		return results
+	}
+	var out []search.Result
+	for _, r := range results {
+		host := resultHost(r.URL)
+		if len(d.IncludeSites) > 0 && !matchSite(host, d.IncludeSites) {
+			continue
+		}
+		if matchSite(host, d.ExcludeSites) {
+			continue
+		}
+		out = append(out, r)
+	}
+	return out
+}
+
+// filterWindow 按发布时间过滤不在时间范围内的搜索结果：搜索源只支持按日期检索，
+// 精确过滤避免 24h 与 since_last 重复收录范围起点当天更早的新闻。发布时间只有日期时按整天比较，
+// 无法解析或未设置时间范围时保留
+func filterWindow(results []search.Result, start, end time.Time) []search.Result {
+	if start.IsZero() || end.IsZero() {
+		return results
+	}
+	startDay, endDay := start.In(time.Local).Format(time.DateOnly), end.In(time.Local).Format(time.DateOnly)
+	var out []search.Result
+	for _, r := range results {
+		published, ok := parsePublishedDate(r.PublishedDate)
+		switch {
+		case !ok:
+		case len(strings.TrimSpace(r.PublishedDate)) == len(time.DateOnly):
+			if day := published.Format(time.DateOnly); day < startDay || day > endDay {
+				continue
+			}
+		case published.Before(start) || published.After(end):
+			continue
+		}
+		out = append(out, r)
+	}
+	return out
+}
+
+// resultHost 返回链接的主机名，忽略 www 前缀
+func resultHost(raw string) string {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil {
+		return ""
+	}
+	return strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+}
+
+// matchSite 判断主机名是否属于站点列表中的某个站点或其子域名
+func matchSite(host string, sites []string) bool {
+	for _, site := range sites {
+		if host == site || strings.HasSuffix(host, "."+site) {
+			return true
+		}
+	}
+	return false
+}
+
+// domainNames 返回领域名称列表，用于日志
+func domainNames(domains []dm.DomainConfig) []string {
+	names := make([]string, 0, len(domains))
+	for _, d := range domains {
+		names = append(names, d.Name)
+	}
+	return names
+}
@@ -0,0 +1,87 @@
+package engine
+
+import (
+	"encoding/json"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+
+	dm "github.com/iWorld-y/domain_radar/app/domain_radar/pkg/model"
+	"github.com/iWorld-y/domain_radar/app/domain_radar/pkg/search"
+)
+
+func TestDomainConfigLegacyStrings(t *testing.T) {
+	// 旧版以字符串列表保存的领域与新版对象可以混用，未写明 enabled 时默认启用
+	var fromJSON []dm.DomainConfig
+	if err := json.Unmarshal([]byte(`["AI", {"name": "Chips", "max_articles": 3}, {"name": "Web3", "enabled": false}]`), &fromJSON); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	var fromYAML []dm.DomainConfig
+	if err := yaml.Unmarshal([]byte("- AI\n- name: Chips\n  max_articles: 3\n- name: Web3\n  enabled: false\n"), &fromYAML); err != nil {
+		t.Fatalf("yaml.Unmarshal() error = %v", err)
+	}
+	for _, got := range [][]dm.DomainConfig{fromJSON, fromYAML} {
+		if len(got) != 3 {
+			t.Fatalf("domains = %+v, want 3", got)
+		}
+		if got[0].Name != "AI" || !got[0].Enabled {
+			t.Errorf("domains[0] = %+v, want enabled AI", got[0])
+		}
+		if got[1].MaxArticles != 3 || !got[1].Enabled {
+			t.Errorf("domains[1] = %+v, want enabled Chips with 3 articles", got[1])
+		}
+		if got[2].Enabled {
+			t.Errorf("domains[2] = %+v, want disabled", got[2])
+		}
+	}
+}
+
+func TestNormalizeDomains(t *testing.T) {
+	got, err := NormalizeDomains([]dm.DomainConfig{{
+		Name:         " AI ",
+		IncludeSites: []string{"https://www.36kr.com/news", "36KR.com", ""},
+		Languages:    []string{"ZH", "zh"},
+	}})
+	if err != nil {
+		t.Fatalf("NormalizeDomains() error = %v", err)
+	}
+	if d := got[0]; d.Name != "AI" || len(d.IncludeSites) != 1 || d.IncludeSites[0] != "36kr.com" || len(d.Languages) != 1 || d.Languages[0] != "zh" {
+		t.Errorf("NormalizeDomains() = %+v", d)
+	}
+
+	invalid := [][]dm.DomainConfig{
+		{{Name: ""}},
+		{{Name: "AI"}, {Name: "ai"}},
+		{{Name: "AI", MaxArticles: maxDomainArticles + 1}},
+		{{Name: "AI", Weight: -1}},
+		{{Name: "AI", Window: "30d"}},
+	}
+	for _, domains := range invalid {
+		if _, err := NormalizeDomains(domains); err == nil {
+			t.Errorf("NormalizeDomains(%+v) error = nil, want error", domains)
+		}
+	}
+}
+
+func TestEnabledDomainsAndFilterSites(t *testing.T) {
+	domains := enabledDomains([]dm.DomainConfig{
+		{Name: "A", Enabled: true},
+		{Name: "B", Enabled: false, Weight: 5},
+		{Name: "C", Enabled: true, Weight: 2},
+		{Name: "D", Enabled: true},
+	})
+	if names := domainNames(domains); len(names) != 3 || names[0] != "C" || names[1] != "A" || names[2] != "D" {
+		t.Errorf("enabledDomains() = %v, want [C A D]", names)
+	}
+
+	results := []search.Result{
+		{URL: "https://www.36kr.com/p/1"},
+		{URL: "https://news.36kr.com/p/2"},
+		{URL: "https://ads.36kr.com/p/3"},
+		{URL: "https://example.com/p/4"},
+	}
+	got := filterSites(results, dm.DomainConfig{IncludeSites: []string{"36kr.com"}, ExcludeSites: []string{"ads.36kr.com"}})
+	if len(got) != 2 || got[0].URL != results[0].URL || got[1].URL != results[1].URL {
+		t.Errorf("filterSites() = %v, want the first two results", got)
+	}
+}
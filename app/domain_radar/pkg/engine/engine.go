@@ -2,16 +2,16 @@ package engine
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
-	"sort"
+	"net/http"
+	"net/url"
 	"strings"
-	"sync"
 	"time"
 
-	"github.com/bytedance/gg/gson"
 	"github.com/cloudwego/eino-ext/components/model/openai"
 	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/compose"
 	"github.com/cloudwego/eino/schema"
 	"github.com/go-shiori/go-readability"
 	"golang.org/x/time/rate"
@@ -28,9 +28,14 @@ import (
 type Engine struct {
 	cfg       *config.Config
 	store     *storage.Storage
+	events    *eventWriter // 后台批量写入运行事件，未配置数据库时为 nil
 	chatModel model.ChatModel
 	searcher  search.Searcher
-	limiter   *rate.Limiter
+	limiter   *rate.Limiter // LLM 请求限流
+
+	searchLimiter *rate.Limiter // 搜索请求限流，为 nil 时不限流
+	fetchLimiter  *rate.Limiter // 网页抓取限流，为 nil 时不限流
+	shareLocks    keyedMutex    // 按共享键串行处理相同的领域，使并发的运行能复用同一份报告
 }
 
 // NewEngine 创建引擎实例
@@ -47,6 +52,25 @@ func NewEngine(cfg *config.Config, store *storage.Storage) (*Engine, error) {
 		return nil, fmt.Errorf("LLM 初始化失败: %w", err)
 	}
 
+	// 记录每次 LLM 调用的用量
+	var recorder UsageRecorder
+	if store != nil {
+		recorder = store
+	}
+	meteredModel := newMeteredChatModel(chatModel, cfg.LLM, recorder)
+
+	// 输入完全一致时复用已缓存的 LLM 输出
+	var cm model.ChatModel = meteredModel
+	if store != nil && cfg.Cache.Enabled {
+		cm = newCachedChatModel(meteredModel, store, time.Duration(cfg.Cache.TTLHours)*time.Hour)
+	}
+	// 限制同时进行的 LLM 调用数，排队时间不计入调用耗时
+	cm = newBoundedChatModel(cm, workers(cfg.Concurrency.LLMCalls, defaultLLMCalls))
+
+	if err := validateStages(cfg.Pipeline.DomainStages); err != nil {
+		return nil, err
+	}
+
 	// 初始化限流器
 	limit := rate.Limit(float64(cfg.Concurrency.RPM) / 60.0)
 	burst := cfg.Concurrency.QPS
@@ -58,309 +82,439 @@ func NewEngine(cfg *config.Config, store *storage.Storage) (*Engine, error) {
 		return nil, fmt.Errorf("搜索客户端初始化失败: %w", err)
 	}
 
+	var events *eventWriter
+	if store != nil {
+		events = newEventWriter(store, eventQueueSize)
+	}
+
 	return &Engine{
 		cfg:       cfg,
 		store:     store,
-		chatModel: chatModel,
+		events:    events,
+		chatModel: cm,
 		searcher:  searcher,
 		limiter:   limiter,
+
+		searchLimiter: newRateLimiter(cfg.Concurrency.Search),
+		fetchLimiter:  newRateLimiter(cfg.Concurrency.Fetch),
 	}, nil
 }
 
 // RunOptions 运行选项
 type RunOptions struct {
 	UserID           int
-	Domains          []string
-	Persona          string
+	Domains          []dm.DomainConfig // 关注的领域，只处理启用的领域，按优先级权重从高到低处理
+	Persona          string            // 自由文本画像，未指定 Personas 时使用
+	Personas         []dm.PersonaLens  // 每个画像各生成一份深度解读，优先于 Persona
+	Lenses           []dm.AnalysisLens // 深度解读中额外生成的自定义栏目
+	Verify           bool              // 是否核验领域报告论断，配置中开启核验时总是核验
+	Share            bool              // 是否复用其他运行生成的领域报告，配置中开启共享时总是复用
+	Budget           Budget            // 本次运行的预算，未设置时使用配置中的默认预算
+	Cache            CachePolicy       // LLM 输出缓存策略，默认优先读取缓存
 	ProgressCallback func(status string, progress int)
+	StreamCallback   func(p PartialOutput) // LLM 边生成边回调阶段性内容，可为空
+	// DomainStatusCallback 每个领域处理结束（成功、失败或跳过）时回调，可为空
+	DomainStatusCallback func(status dm.DomainRunStatus)
+	Research             *ResearchOptions // 非空时对唯一的领域执行深度研究，替代固定的搜索与抓取
+	Language             string           // 报告语言（zh / en），为空时使用配置中的默认语言
+	// RetryRunID 非 0 时只重新处理该运行记录中失败或缺失的领域，结果写入同一运行记录，
+	// 并结合已有的领域报告重新生成深度解读；Domains 为用户当前关注的领域
+	RetryRunID int
+	Window     Window // 搜索新闻的时间范围，重试时沿用原运行记录的范围
+
+	prepared map[string]*preparedReport // 批量运行中预先生成的领域报告，key 为共享键
+	runID    int                        // 批量运行中预先创建的运行记录 ID
+	tracker  *budgetTracker             // 批量运行中预先创建的预算统计，预先生成报告的用量同样计入
 }
 
-// Run 执行一次报告生成任务
-func (e *Engine) Run(ctx context.Context, opts RunOptions) error {
-	logger.Log.Infof("开始为用户 [%d] 生成报告，包含 %d 个领域", opts.UserID, len(opts.Domains))
+// PartialOutput LLM 流式生成中的阶段性内容
+type PartialOutput struct {
+	Domain  string // 所属领域，深度解读时为空
+	Persona string // 所属画像名称，仅深度解读时设置
+	Stage   string // 所属阶段：research / domain_report / deep_analysis
+	Text    string // 目前已生成的可读文本
+}
+
+// lenses 返回本次运行需要生成深度解读的画像视角，未设置画像时为空
+func (o RunOptions) lenses() []dm.PersonaLens {
+	if len(o.Personas) > 0 {
+		return o.Personas
+	}
+	if o.Persona != "" {
+		return []dm.PersonaLens{{Text: o.Persona}}
+	}
+	return nil
+}
+
+// Run 执行一次报告生成任务，返回运行记录 ID，未配置数据库或创建运行记录失败时为 0
+func (e *Engine) Run(ctx context.Context, opts RunOptions) (int, error) {
 	if opts.ProgressCallback != nil {
 		opts.ProgressCallback("starting", 0)
 	}
 
+	domains, err := NormalizeDomains(opts.Domains)
+	if err != nil {
+		return 0, err
+	}
+	opts.Domains = enabledDomains(domains)
+	logger.Log.Infof("开始为用户 [%d] 生成报告，包含 %d 个领域", opts.UserID, len(opts.Domains))
 	if len(opts.Domains) == 0 {
-		return fmt.Errorf("no domains provided")
+		return 0, fmt.Errorf("no enabled domains provided")
+	}
+	if opts.Research != nil && len(opts.Domains) != 1 {
+		return 0, fmt.Errorf("research mode requires exactly one domain, got %d", len(opts.Domains))
+	}
+	if opts.Research != nil && opts.RetryRunID > 0 {
+		return 0, fmt.Errorf("research mode cannot retry an existing run")
 	}
 
-	// 创建本次运行记录
+	// 创建本次运行记录，重试时沿用原运行记录及其时间范围，并载入已生成的领域报告
 	var runID int
-	if e.store != nil {
-		rid, err := e.store.CreateRun()
+	var previous []dm.DomainReport
+	windowStart, windowEnd, err := e.resolveWindow(ctx, opts.UserID, opts.Window, time.Now())
+	if err != nil {
+		return 0, err
+	}
+	if opts.RetryRunID > 0 {
+		run, retry, err := e.retryPlan(ctx, opts.RetryRunID, opts.UserID, domains)
 		if err != nil {
-			logger.Log.Errorf("无法创建运行记录: %v", err)
+			return 0, err
+		}
+		if run.WindowStart != nil && run.WindowEnd != nil {
+			windowStart, windowEnd = *run.WindowStart, *run.WindowEnd
 		} else {
-			runID = rid
+			// 未记录时间范围的历史运行按创建时间前 3 天检索
+			windowStart, windowEnd = run.CreatedAt.Add(-defaultWindow), run.CreatedAt
+		}
+		if previous, err = e.store.GetDomainReports(ctx, opts.RetryRunID); err != nil {
+			return 0, fmt.Errorf("load domain reports: %w", err)
+		}
+		opts.Domains = enabledDomains(retry)
+		logger.Log.Infof("重试运行记录 %d 中的 %d 个领域: %v", opts.RetryRunID, len(opts.Domains), domainNames(opts.Domains))
+		runID = opts.RetryRunID
+		if err := e.store.UpdateRunStatus(ctx, runID, dm.RunStatusRunning); err != nil {
+			logger.Log.Errorf("更新运行记录 %d 状态失败: %v", runID, err)
+		}
+	} else if opts.runID > 0 {
+		// 批量运行已预先创建运行记录并记录开始事件
+		runID = opts.runID
+	} else {
+		runID = e.createRun(ctx, opts, windowStart, windowEnd)
+	}
+	ctx = e.runContext(ctx, runID, opts.UserID)
+	if opts.RetryRunID > 0 {
+		recordRunStarted(ctx, opts, windowStart, windowEnd)
+		recordEvent(ctx, dm.EventRetry, "retry failed or missing domains", map[string]any{"domains": domainNames(opts.Domains)})
+	}
+	ctx = withCachePolicy(ctx, opts.Cache)
+	ctx = withLanguage(ctx, NormalizeLanguage(opts.Language, NormalizeLanguage(e.cfg.ReportLanguage, LanguageZH)))
+	if e.store != nil && e.cfg.Cache.Enabled {
+		if n, err := e.store.DeleteExpiredLLMCache(ctx); err != nil {
+			logger.Log.Errorf("清理过期 LLM 缓存失败: %v", err)
+		} else if n > 0 {
+			logger.Log.Infof("已清理 %d 条过期 LLM 缓存", n)
 		}
 	}
 
-	var domainReports []dm.DomainReport
-	var mu sync.Mutex
-	var wg sync.WaitGroup
-
-	now := time.Now()
-	endDate := now.Format(time.DateOnly)
-	startDate := now.AddDate(0, 0, -3).Format(time.DateOnly)
-
-	totalDomains := len(opts.Domains)
-	completedDomains := 0
-
-	for _, domain := range opts.Domains {
-		wg.Add(1)
-		go func(domain string) {
-			defer wg.Done()
-
-			// 1. 搜索
-			req := &search.Request{
-				Query:             domain,
-				Topic:             "news",
-				MaxResults:        20,
-				StartDate:         startDate,
-				EndDate:           endDate,
-				IncludeRawContent: false,
-			}
-
-			resp, err := e.searcher.Search(ctx, req)
-			if err != nil {
-				logger.Log.Errorf("搜索领域失败 [%s]: %v", domain, err)
-				return
-			}
-			logger.Log.Debugf("搜索领域 [%s] 成功: %s", domain, gson.ToString(resp))
-
-			// 2. 抓取正文
-			var validArticles []dm.Article
-			for _, item := range resp.Results {
-				content := item.Content
-				if len(content) < 500 {
-					fetched, err := fetchAndCleanContent(item.URL)
-					if err == nil && len(fetched) > len(content) {
-						content = fetched
-					}
-				}
-				if len(content) > 5000 {
-					content = content[:5000]
-				}
-				if len(content) > 100 {
-					validArticles = append(validArticles, dm.Article{
-						Title:   item.Title,
-						Link:    item.URL,
-						Source:  domain,
-						PubDate: item.PublishedDate,
-						Content: content,
-					})
-				}
-				if len(validArticles) >= 6 {
-					break
-				}
-			}
-
-			if len(validArticles) < 1 {
-				logger.Log.Warnf("领域 [%s] 未找到足够的有效文章", domain)
-				return
-			}
+	// 预算控制：批量运行沿用预先创建的预算统计
+	tracker := opts.tracker
+	if tracker == nil {
+		tracker = e.runBudget(opts.Budget)
+	}
+	if tracker != nil {
+		ctx = withBudget(ctx, tracker)
+	}
 
-			// 3. 生成领域报告
-			report, err := generateDomainReport(ctx, e.chatModel, domain, validArticles, e.limiter)
-			if err != nil {
-				logger.Log.Errorf("生成领域报告失败 [%s]: %v", domain, err)
-				return
-			}
-			report.Articles = validArticles
+	// 构建流水线：领域流水线按配置插入可选阶段，回调负责追踪、耗时统计与进度上报
+	handler := newPipelineHandler(opts)
+	domainChain, err := e.buildDomainChain(ctx, opts)
+	if err != nil {
+		return runID, e.finishRun(ctx, runID, fmt.Errorf("build domain pipeline: %w", err))
+	}
+	runChain, err := e.buildRunChain(ctx, domainChain)
+	if err != nil {
+		return runID, e.finishRun(ctx, runID, fmt.Errorf("build run pipeline: %w", err))
+	}
 
-			// 保存到数据库
-			if e.store != nil && runID > 0 {
-				if err := e.store.SaveDomainReport(runID, report); err != nil {
-					logger.Log.Errorf("保存领域报告失败 [%s]: %v", domain, err)
-				}
-			}
+	state := &runState{
+		opts:        opts,
+		runID:       runID,
+		tracker:     tracker,
+		windowStart: windowStart,
+		windowEnd:   windowEnd,
+		reports:     previous,
+		retry:       opts.RetryRunID > 0,
+	}
+	if _, err := runChain.Invoke(ctx, state, compose.WithCallbacks(handler)); err != nil {
+		// 取消后各节点的错误形式不一，统一以 ctx 的错误返回
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			err = ctxErr
+		}
+		return runID, e.finishRun(ctx, runID, unwrapNodeError(err))
+	}
 
-			mu.Lock()
-			domainReports = append(domainReports, *report)
-			completedDomains++
-			progress := 10 + int(float64(completedDomains)/float64(totalDomains)*70) // 10% -> 80%
-			if opts.ProgressCallback != nil {
-				opts.ProgressCallback(fmt.Sprintf("processed domain: %s", domain), progress)
-			}
-			mu.Unlock()
-		}(domain)
+	if opts.ProgressCallback != nil {
+		opts.ProgressCallback("completed", 100)
 	}
+	return runID, e.finishRun(ctx, runID, nil)
+}
 
-	wg.Wait()
+// createRun 创建运行记录并记录开始事件，未配置数据库或创建失败时返回 0
+func (e *Engine) createRun(ctx context.Context, opts RunOptions, start, end time.Time) int {
+	if e.store == nil {
+		return 0
+	}
+	runID, err := e.store.CreateRun(ctx, opts.UserID, start, end)
+	if err != nil {
+		logger.Log.Errorf("无法创建运行记录: %v", err)
+		return 0
+	}
+	recordRunStarted(e.runContext(ctx, runID, opts.UserID), opts, start, end)
+	return runID
+}
 
-	if len(domainReports) == 0 {
-		return fmt.Errorf("no domain reports generated")
+// runContext 在 context 中标记所属的运行记录与用户，配置数据库时挂载事件记录器
+func (e *Engine) runContext(ctx context.Context, runID, userID int) context.Context {
+	ctx = withRun(ctx, runID, userID)
+	if e.events != nil {
+		ctx = withEventRecorder(ctx, e.events)
 	}
+	return ctx
+}
 
-	// 排序
-	sort.Slice(domainReports, func(i, j int) bool {
-		return domainReports[i].Score > domainReports[j].Score
+// recordRunStarted 记录运行开始的事件
+func recordRunStarted(ctx context.Context, opts RunOptions, start, end time.Time) {
+	recordEvent(ctx, dm.EventRunStarted, "", map[string]any{
+		"user_id":      opts.UserID,
+		"domains":      domainNames(opts.Domains),
+		"window_start": start.Format(time.DateTime),
+		"window_end":   end.Format(time.DateTime),
 	})
+}
 
-	// 4. 深度解读
-	if opts.ProgressCallback != nil {
-		opts.ProgressCallback("generating deep analysis", 85)
+// runBudget 返回本次运行的预算统计：用户预算优先，其次为部署默认预算，均未设置时为 nil
+func (e *Engine) runBudget(budget Budget) *budgetTracker {
+	if budget.IsZero() {
+		budget = Budget{MaxTokens: e.cfg.Budget.MaxTokens, MaxCost: e.cfg.Budget.MaxCost}
 	}
+	if budget.IsZero() {
+		return nil
+	}
+	return newBudgetTracker(budget)
+}
 
-	if opts.Persona != "" {
-		var sb strings.Builder
-		for _, report := range domainReports {
-			fmt.Fprintf(&sb, "## 领域：%s (评分: %d)\n", report.DomainName, report.Score)
-			fmt.Fprintf(&sb, "### 综述\n%s\n", report.Overview)
-			fmt.Fprintf(&sb, "### 趋势\n%s\n", report.Trends)
-			fmt.Fprintf(&sb, "### 关键事件\n- %s\n\n", strings.Join(report.KeyEvents, "\n- "))
-		}
+// finishRun 等待本次运行的事件写入后按运行结果更新运行记录的状态，并原样返回 err；
+// 已生成的部分结果保留，状态写入不受 ctx 取消的影响
+func (e *Engine) finishRun(ctx context.Context, runID int, err error) error {
+	if e.store == nil || runID <= 0 {
+		return err
+	}
+	status := RunStatus(err)
+	recordEvent(ctx, dm.EventRunFinished, errorMessage(err), map[string]any{"status": status})
+	if e.events != nil {
+		e.events.flush()
+	}
+	if uerr := e.store.UpdateRunStatus(context.WithoutCancel(ctx), runID, status); uerr != nil {
+		logger.Log.Errorf("更新运行记录 %d 状态为 %s 失败: %v", runID, status, uerr)
+	}
+	return err
+}
 
-		analysis, err := deepInterpretReport(ctx, e.chatModel, sb.String(), opts.Persona, e.limiter)
-		if err != nil {
-			logger.Log.Errorf("深度解读失败: %v", err)
-		} else {
-			if e.store != nil && runID > 0 {
-				if err := e.store.SaveDeepAnalysis(runID, opts.UserID, analysis); err != nil {
-					logger.Log.Errorf("保存深度解读失败: %v", err)
-				}
-				if analysis.Title != "" {
-					e.store.UpdateRunTitle(runID, analysis.Title)
-				}
-			}
-		}
+// RunStatus 根据 Run 返回的错误判断运行状态
+func RunStatus(err error) string {
+	switch {
+	case err == nil:
+		return dm.RunStatusCompleted
+	case errors.Is(err, context.Canceled):
+		return dm.RunStatusCancelled
+	case errors.Is(err, ErrBudgetExceeded):
+		return dm.RunStatusBudgetExceeded
+	default:
+		return dm.RunStatusFailed
 	}
+}
 
-	if opts.ProgressCallback != nil {
-		opts.ProgressCallback("completed", 100)
+// ValidateConfig 检查配置能否用于运行引擎，返回发现的全部问题；不访问网络与数据库
+func ValidateConfig(cfg *config.Config) error {
+	var errs []error
+	if cfg.LLM.BaseURL == "" {
+		errs = append(errs, fmt.Errorf("llm.base_url is required"))
 	}
-	return nil
+	if cfg.LLM.APIKey == "" {
+		errs = append(errs, fmt.Errorf("llm.api_key is required"))
+	}
+	if cfg.LLM.Model == "" {
+		errs = append(errs, fmt.Errorf("llm.model is required"))
+	}
+	if cfg.Concurrency.RPM <= 0 || cfg.Concurrency.QPS <= 0 {
+		errs = append(errs, fmt.Errorf("concurrency.rpm and concurrency.qps must be positive"))
+	}
+	c := cfg.Concurrency
+	if c.Domains < 0 || c.Fetches < 0 || c.LLMCalls < 0 || c.Search.RPM < 0 || c.Fetch.RPM < 0 {
+		errs = append(errs, fmt.Errorf("concurrency settings must not be negative"))
+	}
+	if cfg.ReportLanguage != "" && NormalizeLanguage(cfg.ReportLanguage, "") == "" {
+		errs = append(errs, fmt.Errorf("unsupported report_language: %s", cfg.ReportLanguage))
+	}
+	if err := validateStages(cfg.Pipeline.DomainStages); err != nil {
+		errs = append(errs, err)
+	}
+	if _, err := NormalizeDomains(cfg.Domains); err != nil {
+		errs = append(errs, err)
+	}
+	if _, err := factory.NewSearcher(cfg); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
 }
 
-// 辅助函数 (从 main.go 复制并适配)
+// 辅助函数
+
+// fetchTimeout 抓取单篇文章正文的超时时间
+const fetchTimeout = 30 * time.Second
 
-func fetchAndCleanContent(url string) (string, error) {
-	article, err := readability.FromURL(url, 30*time.Second)
+// fetchAndCleanContent 抓取网页并提取正文，ctx 取消时立即中止
+func fetchAndCleanContent(ctx context.Context, pageURL string) (string, error) {
+	parsed, err := url.ParseRequestURI(pageURL)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse URL: %w", err)
+	}
+	ctx, cancel := context.WithTimeout(ctx, fetchTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, pageURL, nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch the page: %w", err)
+	}
+	defer resp.Body.Close()
+	if !strings.Contains(resp.Header.Get("Content-Type"), "text/html") {
+		return "", fmt.Errorf("URL is not a HTML document")
+	}
+	article, err := readability.FromReader(resp.Body, parsed)
 	if err != nil {
 		return "", err
 	}
 	return article.TextContent, nil
 }
 
-func generateDomainReport(ctx context.Context, cm model.ChatModel, domain string, articles []dm.Article, limiter *rate.Limiter) (*dm.DomainReport, error) {
+// generateDomainReport 生成单个领域的总结报告，notes 为深度研究记录的笔记（可为空），
+// onOverview 在流式生成过程中回调目前已生成的领域综述；输出语言与要求不符时追加提醒重新生成一次
+func generateDomainReport(ctx context.Context, cm model.ChatModel, domain string, articles []dm.Article, notes []string, limiter *rate.Limiter, onOverview func(overview string)) (*dm.DomainReport, error) {
+	p := promptsFrom(ctx)
 	var sb strings.Builder
-	sb.WriteString(fmt.Sprintf("以下是关于领域【%s】的一组新闻文章，请阅读并总结：\n\n", domain))
+	sb.WriteString(fmt.Sprintf(p.articlesIntro, domain))
 	for i, art := range articles {
-		sb.WriteString(fmt.Sprintf("文章 %d:\n标题: %s\n内容摘要: %s\n\n", i+1, art.Title, art.Content))
+		sb.WriteString(fmt.Sprintf(p.articleItem, i+1, art.Title, art.Content))
 	}
-
-	prompt := `你是一个资深行业分析师。请根据提供的文章内容，撰写一份该领域的深度总结报告。
-请务必严格按照以下 JSON 格式返回，不要包含任何 markdown 标记：
-{
-	"overview": "领域综述（Markdown格式，200字左右），总结当前领域的核心动态、热点话题。",
-	"key_events": ["关键事件1", "关键事件2", "关键事件3"],
-	"trends": "趋势分析（Markdown格式，100-200字），基于新闻分析未来的技术或市场走向。",
-	"score": 8
-}
-评分说明：score 为 1-10 的整数，代表该领域今日的重要程度和关注价值。`
-
-	maxRetries := 3
-	baseDelay := 2 * time.Second
-	var lastErr error
-
-	for i := 0; i <= maxRetries; i++ {
-		if err := limiter.Wait(ctx); err != nil {
-			return nil, err
+	if len(notes) > 0 {
+		sb.WriteString(p.notesIntro)
+		for _, n := range notes {
+			sb.WriteString("- " + n + "\n")
 		}
+	}
 
+	userContent := sb.String() + "\n\n" + p.domainReport
+	for attempt := 0; ; attempt++ {
 		messages := []*schema.Message{
-			{Role: schema.System, Content: "你是一个 JSON 生成器。请只输出 JSON 字符串。"},
-			{Role: schema.User, Content: sb.String() + "\n\n" + prompt},
+			{Role: schema.System, Content: p.jsonSystem},
+			{Role: schema.User, Content: userContent},
 		}
 
-		resp, err := cm.Generate(ctx, messages)
-		if err != nil {
-			if strings.Contains(err.Error(), "429") || strings.Contains(strings.ToLower(err.Error()), "too many requests") {
-				lastErr = err
-				if i < maxRetries {
-					time.Sleep(baseDelay * time.Duration(1<<i))
-					continue
-				}
+		var report dm.DomainReport
+		err := streamJSON(ctx, cm, limiter, messages, &report, func(buf string) {
+			if onOverview != nil {
+				onOverview(partialJSONString(buf, "overview"))
 			}
+		})
+		if err != nil {
 			return nil, err
 		}
 
-		cleanContent := strings.TrimSpace(resp.Content)
-		cleanContent = strings.TrimPrefix(cleanContent, "```json")
-		cleanContent = strings.TrimPrefix(cleanContent, "```")
-		cleanContent = strings.TrimSuffix(cleanContent, "```")
-
-		var report dm.DomainReport
-		if err := json.Unmarshal([]byte(cleanContent), &report); err != nil {
-			lastErr = err
-			if i < maxRetries {
-				continue
-			}
-			return nil, fmt.Errorf("json unmarshal: %w", err)
+		if attempt == 0 && !matchesLanguage(report.Overview+"\n"+report.Trends, p.language) {
+			logger.Log.Warnf("领域 [%s] 报告语言不是 %s，重新生成", domain, p.language)
+			recordEvent(ctx, dm.EventRetry, "domain report language mismatch", map[string]any{"stage": stageDomainReport, "language": p.language})
+			userContent += p.languageReminder
+			continue
 		}
-
 		report.DomainName = domain
+		normalizeCitations(&report, len(articles))
 		return &report, nil
 	}
-	return nil, lastErr
-}
-
-func deepInterpretReport(ctx context.Context, cm model.ChatModel, content string, userPersona string, limiter *rate.Limiter) (*dm.DeepAnalysisResult, error) {
-	promptTpl := `Role: 资深技术顾问与个人发展战略专家
-Context
-用户画像：%s
-输入数据：这是一份多领域的每日新闻总结报告。
-核心诉求：请跨领域交叉分析，识别宏观趋势，并为用户提供战略建议。
-
-Instructions
-请严格按照 JSON 格式输出：
-{
-    "title": "根据今日所有领域内容生成一个吸引人的简短标题（20字以内）",
-    "macro_trends": "Markdown格式的核心趋势洞察...",
-    "opportunities": "Markdown格式的机遇挖掘...",
-    "risks": "Markdown格式的风险预警...",
-    "action_guides": ["行动建议1", "行动建议2", "行动建议3"]
 }
 
-输入的新闻总结数据：
-%s`
-
-	maxRetries := 3
-	baseDelay := 2 * time.Second
-	var lastErr error
-
-	for i := 0; i <= maxRetries; i++ {
-		if err := limiter.Wait(ctx); err != nil {
-			return nil, err
+// deepInterpretReport 全局深度解读报告，onText 在流式生成过程中回调目前已生成的解读正文；
+// 输出语言与要求不符时追加提醒重新生成一次
+func deepInterpretReport(ctx context.Context, cm model.ChatModel, content string, userPersona string, lenses []dm.AnalysisLens, limiter *rate.Limiter, onText func(text string)) (*dm.DeepAnalysisResult, error) {
+	p := promptsFrom(ctx)
+	userContent := fmt.Sprintf(p.deepAnalysis, userPersona, content)
+	if len(lenses) > 0 {
+		var sb strings.Builder
+		for i, lens := range lenses {
+			fmt.Fprintf(&sb, p.customLensItem, i+1, lens.Name, lens.Instruction)
 		}
-
+		userContent += fmt.Sprintf(p.customLensesText, sb.String())
+	}
+	for attempt := 0; ; attempt++ {
 		messages := []*schema.Message{
-			{Role: schema.System, Content: "你是一个 JSON 生成器。"},
-			{Role: schema.User, Content: fmt.Sprintf(promptTpl, userPersona, content)},
+			{Role: schema.System, Content: p.jsonSystem},
+			{Role: schema.User, Content: userContent},
 		}
 
-		resp, err := cm.Generate(ctx, messages)
-		if err != nil {
-			if strings.Contains(err.Error(), "429") {
-				time.Sleep(baseDelay * time.Duration(1<<i))
-				continue
+		var result dm.DeepAnalysisResult
+		err := streamJSON(ctx, cm, limiter, messages, &result, func(buf string) {
+			if onText == nil {
+				return
 			}
+			var parts []string
+			for _, key := range []string{"macro_trends", "opportunities", "risks"} {
+				if text := partialJSONString(buf, key); text != "" {
+					parts = append(parts, text)
+				}
+			}
+			onText(strings.Join(parts, "\n\n"))
+		})
+		if err != nil {
 			return nil, err
 		}
 
-		cleanContent := strings.TrimSpace(resp.Content)
-		cleanContent = strings.TrimPrefix(cleanContent, "```json")
-		cleanContent = strings.TrimPrefix(cleanContent, "```")
-		cleanContent = strings.TrimSuffix(cleanContent, "```")
-
-		var result dm.DeepAnalysisResult
-		if err := json.Unmarshal([]byte(cleanContent), &result); err != nil {
-			lastErr = err
+		if attempt == 0 && !matchesLanguage(result.MacroTrends+"\n"+result.Opportunities, p.language) {
+			logger.Log.Warnf("深度解读语言不是 %s，重新生成", p.language)
+			recordEvent(ctx, dm.EventRetry, "deep analysis language mismatch", map[string]any{"stage": stageDeepAnalysis, "language": p.language})
+			userContent += p.languageReminder
 			continue
 		}
+		result.Sections = matchSections(result.Sections, lenses)
 		return &result, nil
 	}
-	return nil, fmt.Errorf("failed after retries: %v", lastErr)
+}
+
+// matchSections 按自定义栏目的顺序整理模型返回的栏目内容：优先按名称（忽略大小写与首尾空白）匹配，
+// 名称均不一致时取同一位置且未被其他栏目认领的内容，缺失或为空的栏目不保留
+func matchSections(sections []dm.AnalysisSection, lenses []dm.AnalysisLens) []dm.AnalysisSection {
+	if len(lenses) == 0 {
+		return nil
+	}
+	key := func(name string) string { return strings.ToLower(strings.TrimSpace(name)) }
+	byName := make(map[string]string, len(sections))
+	for _, s := range sections {
+		byName[key(s.Name)] = s.Content
+	}
+	claimed := make(map[string]bool, len(lenses))
+	for _, lens := range lenses {
+		claimed[key(lens.Name)] = true
+	}
+	var result []dm.AnalysisSection
+	for i, lens := range lenses {
+		content, ok := byName[key(lens.Name)]
+		if !ok && i < len(sections) && !claimed[key(sections[i].Name)] {
+			content = sections[i].Content
+		}
+		if content = strings.TrimSpace(content); content != "" {
+			result = append(result, dm.AnalysisSection{Name: lens.Name, Content: content})
+		}
+	}
+	return result
 }
@@ -0,0 +1,128 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/cloudwego/eino/schema"
+
+	"github.com/iWorld-y/domain_radar/app/domain_radar/pkg/logger"
+	dm "github.com/iWorld-y/domain_radar/app/domain_radar/pkg/model"
+)
+
+// entityArticleLen 实体抽取时每篇文章提供给模型的正文长度（字符）
+const entityArticleLen = 800
+
+// entityTypes 受支持的实体类型
+var entityTypes = map[string]bool{
+	dm.EntityTypeCompany:      true,
+	dm.EntityTypePerson:       true,
+	dm.EntityTypeProduct:      true,
+	dm.EntityTypeProject:      true,
+	dm.EntityTypeOrganization: true,
+}
+
+// entityNameSuffixes 归一化时去除的公司后缀，英文后缀需与名称以空格分隔，按顺序匹配第一个
+var entityNameSuffixes = []string{
+	"股份有限公司", "有限责任公司", "有限公司", "公司",
+	" corporation", " incorporated", " co., ltd.", " co., ltd", " co.,ltd", " limited", " corp.", " corp", " inc.", " inc", " ltd.", " ltd", " llc", " plc",
+}
+
+// entitiesNode 抽取文章中的命名实体，失败时不影响报告保存
+func (e *Engine) entitiesNode(ctx context.Context, s *domainState) (*domainState, error) {
+	entities, err := e.extractEntities(withStage(ctx, stageEntities), s.report.Articles)
+	if err != nil {
+		logger.Log.Warnf("抽取领域实体失败 [%s]: %v", s.domain, err)
+		return s, nil
+	}
+	s.report.Entities = entities
+	return s, nil
+}
+
+// extractEntities 调用 LLM 抽取文章中的实体并归一化
+func (e *Engine) extractEntities(ctx context.Context, articles []dm.Article) ([]dm.Entity, error) {
+	if len(articles) == 0 {
+		return nil, nil
+	}
+	p := promptsFrom(ctx)
+	var sb strings.Builder
+	for i, art := range articles {
+		fmt.Fprintf(&sb, p.entityArticleItem, i+1, art.Title, truncateRunes(art.Content, entityArticleLen))
+	}
+	messages := []*schema.Message{
+		{Role: schema.System, Content: p.jsonSystem},
+		{Role: schema.User, Content: sb.String() + p.entityExtraction},
+	}
+	var out struct {
+		Entities []dm.Entity `json:"entities"`
+	}
+	if err := generateJSON(ctx, e.chatModel, e.limiter, messages, &out); err != nil {
+		return nil, err
+	}
+	return normalizeEntities(out.Entities, len(articles)), nil
+}
+
+// normalizeEntities 规范实体名称与类型，合并归一化后相同的实体，
+// 剔除类型不受支持或未引用有效文章的实体
+func normalizeEntities(entities []dm.Entity, articleCount int) []dm.Entity {
+	var result []dm.Entity
+	index := make(map[string]int)
+	for _, ent := range entities {
+		name := strings.Join(strings.Fields(ent.Name), " ")
+		typ := strings.ToLower(strings.TrimSpace(ent.Type))
+		key := entityKey(name)
+		if key == "" || !entityTypes[typ] {
+			continue
+		}
+		var articles []int
+		for _, a := range ent.Articles {
+			if a >= 1 && a <= articleCount {
+				articles = append(articles, a)
+			}
+		}
+		if len(articles) == 0 {
+			continue
+		}
+
+		id := typ + "/" + key
+		i, ok := index[id]
+		if !ok {
+			index[id] = len(result)
+			result = append(result, dm.Entity{Name: name, Type: typ, Key: key})
+			i = len(result) - 1
+		}
+		merged := &result[i]
+		for _, m := range append([]string{name}, ent.Mentions...) {
+			m = strings.TrimSpace(m)
+			if m != "" && !slices.Contains(merged.Mentions, m) {
+				merged.Mentions = append(merged.Mentions, m)
+			}
+		}
+		for _, a := range articles {
+			if !slices.Contains(merged.Articles, a) {
+				merged.Articles = append(merged.Articles, a)
+			}
+		}
+	}
+	return result
+}
+
+// entityKey 计算实体名称的归一化 key：忽略大小写、标点、空白与常见公司后缀
+func entityKey(name string) string {
+	key := strings.ToLower(strings.TrimSpace(name))
+	for _, suffix := range entityNameSuffixes {
+		if trimmed := strings.TrimSpace(strings.TrimSuffix(key, suffix)); trimmed != key && trimmed != "" {
+			key = strings.TrimRight(trimmed, " ,")
+			break
+		}
+	}
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return r
+		}
+		return -1
+	}, key)
+}
@@ -0,0 +1,47 @@
+package engine
+
+import (
+	"testing"
+
+	dm "github.com/iWorld-y/domain_radar/app/domain_radar/pkg/model"
+)
+
+func TestEntityKey(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"NVIDIA Corp.", "nvidia"},
+		{"Apple, Inc.", "apple"},
+		{"阿里巴巴集团控股有限公司", "阿里巴巴集团控股"},
+		{"Open AI", "openai"},
+		{"Microcorp", "microcorp"},
+	}
+	for _, tt := range tests {
+		if got := entityKey(tt.name); got != tt.want {
+			t.Errorf("entityKey(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestNormalizeEntities(t *testing.T) {
+	got := normalizeEntities([]dm.Entity{
+		{Name: "NVIDIA", Type: "Company", Mentions: []string{"英伟达"}, Articles: []int{1, 3}},
+		{Name: "NVIDIA Corp.", Type: "company", Articles: []int{2, 3, 9}},
+		{Name: "Jensen Huang", Type: "person", Articles: []int{9}},
+		{Name: "CUDA", Type: "technology", Articles: []int{1}},
+	}, 3)
+	if len(got) != 1 {
+		t.Fatalf("normalizeEntities() returned %d entities, want 1: %+v", len(got), got)
+	}
+	e := got[0]
+	if e.Name != "NVIDIA" || e.Type != dm.EntityTypeCompany || e.Key != "nvidia" {
+		t.Errorf("entity = %+v, want NVIDIA/company/nvidia", e)
+	}
+	if len(e.Articles) != 3 {
+		t.Errorf("Articles = %v, want [1 3 2]", e.Articles)
+	}
+	if len(e.Mentions) != 3 {
+		t.Errorf("Mentions = %v, want [NVIDIA 英伟达 NVIDIA Corp.]", e.Mentions)
+	}
+}
@@ -0,0 +1,119 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/iWorld-y/domain_radar/app/domain_radar/pkg/logger"
+	dm "github.com/iWorld-y/domain_radar/app/domain_radar/pkg/model"
+)
+
+const (
+	eventQueueSize = 1024 // 等待写入的事件上限，队列满时丢弃新事件
+	eventBatchSize = 64   // 单次批量写入的事件上限
+)
+
+// errEventQueueFull 事件写入跟不上记录速度，队列已满
+var errEventQueueFull = errors.New("run event queue is full")
+
+// EventRecorder 保存运行中流水线步骤的事件
+type EventRecorder interface {
+	SaveRunEvent(ctx context.Context, event *dm.RunEvent) error
+}
+
+// RunEventStore 批量持久化运行事件
+type RunEventStore interface {
+	SaveRunEvents(ctx context.Context, events []*dm.RunEvent) error
+}
+
+type eventRecorderKey struct{}
+
+// withEventRecorder 在 context 中设置本次运行的事件记录器
+func withEventRecorder(ctx context.Context, r EventRecorder) context.Context {
+	return context.WithValue(ctx, eventRecorderKey{}, r)
+}
+
+// recordEvent 记录一条属于当前运行与领域的事件，未关联运行记录时忽略；
+// 写入不受 ctx 取消的影响，失败只记录日志
+func recordEvent(ctx context.Context, typ, message string, data map[string]any) {
+	r, _ := ctx.Value(eventRecorderKey{}).(EventRecorder)
+	info := callInfoFrom(ctx)
+	if r == nil || info.RunID <= 0 {
+		return
+	}
+	event := &dm.RunEvent{RunID: info.RunID, Domain: info.Domain, Type: typ, Message: message, Data: data, CreatedAt: time.Now()}
+	if err := r.SaveRunEvent(context.WithoutCancel(ctx), event); err != nil {
+		logger.Log.Errorf("保存运行事件 %s 失败: %v", typ, err)
+	}
+}
+
+// eventWriter 在后台批量写入运行事件：记录事件只入队不等待数据库，
+// 队列中积压的事件合并为一次写入；运行结束时通过 flush 等待已记录的事件写完
+type eventWriter struct {
+	store RunEventStore
+	queue chan eventItem
+}
+
+// eventItem 队列中的一条事件，或一次 flush 请求（flushed 非空）
+type eventItem struct {
+	event   *dm.RunEvent
+	flushed chan struct{}
+}
+
+// newEventWriter 创建事件写入器并启动后台写入，queueSize 为等待写入的事件上限
+func newEventWriter(store RunEventStore, queueSize int) *eventWriter {
+	w := &eventWriter{store: store, queue: make(chan eventItem, queueSize)}
+	go w.loop()
+	return w
+}
+
+// SaveRunEvent 将事件加入写入队列，队列已满时返回 errEventQueueFull 而不阻塞调用方
+func (w *eventWriter) SaveRunEvent(ctx context.Context, event *dm.RunEvent) error {
+	select {
+	case w.queue <- eventItem{event: event}:
+		return nil
+	default:
+		return errEventQueueFull
+	}
+}
+
+// flush 等待此前入队的事件全部写入
+func (w *eventWriter) flush() {
+	done := make(chan struct{})
+	w.queue <- eventItem{flushed: done}
+	<-done
+}
+
+func (w *eventWriter) loop() {
+	batch := make([]*dm.RunEvent, 0, eventBatchSize)
+	var flushed []chan struct{}
+	for item := range w.queue {
+		batch, flushed = batch[:0], flushed[:0]
+		// 取出队列中已积压的事件，与当前事件合并为一批写入
+	collect:
+		for {
+			if item.flushed != nil {
+				flushed = append(flushed, item.flushed)
+			} else {
+				batch = append(batch, item.event)
+			}
+			if len(batch) == eventBatchSize {
+				break
+			}
+			select {
+			case item = <-w.queue:
+			default:
+				break collect
+			}
+		}
+		if len(batch) > 0 {
+			if err := w.store.SaveRunEvents(context.Background(), batch); err != nil {
+				logger.Log.Errorf("批量保存 %d 条运行事件失败: %v", len(batch), err)
+			}
+		}
+		for _, done := range flushed {
+			close(done)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	dm "github.com/iWorld-y/domain_radar/app/domain_radar/pkg/model"
+)
+
+type eventLog struct {
+	events []*dm.RunEvent
+}
+
+func (l *eventLog) SaveRunEvent(ctx context.Context, event *dm.RunEvent) error {
+	l.events = append(l.events, event)
+	return nil
+}
+
+func TestRecordEvent(t *testing.T) {
+	rec := &eventLog{}
+	ctx := withEventRecorder(context.Background(), rec)
+	// 未关联运行记录的事件不保存
+	recordEvent(ctx, dm.EventSearchIssued, "AI", nil)
+
+	ctx = withRun(ctx, 7, 1)
+	recordEvent(ctx, dm.EventRunStarted, "", nil)
+	canceled, cancel := context.WithCancel(withDomain(ctx, "AI"))
+	cancel()
+	recordEvent(canceled, dm.EventSearchResults, "AI", map[string]any{"count": 3})
+
+	if len(rec.events) != 2 {
+		t.Fatalf("events = %d, want 2", len(rec.events))
+	}
+	if e := rec.events[0]; e.RunID != 7 || e.Domain != "" || e.Type != dm.EventRunStarted {
+		t.Errorf("events[0] = %+v, want run-level run_started of run 7", e)
+	}
+	if e := rec.events[1]; e.RunID != 7 || e.Domain != "AI" || e.Data["count"] != 3 {
+		t.Errorf("events[1] = %+v, want search_results of AI in run 7", e)
+	}
+}
+
+// batchLog 记录每次批量写入的事件，gate 非空时写入前等待放行
+type batchLog struct {
+	mu      sync.Mutex
+	batches [][]*dm.RunEvent
+	gate    chan struct{}
+}
+
+func (l *batchLog) SaveRunEvents(ctx context.Context, events []*dm.RunEvent) error {
+	if l.gate != nil {
+		<-l.gate
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.batches = append(l.batches, append([]*dm.RunEvent(nil), events...))
+	return nil
+}
+
+func TestEventWriterFlush(t *testing.T) {
+	store := &batchLog{gate: make(chan struct{})}
+	w := newEventWriter(store, 100)
+	ctx := withRun(withEventRecorder(context.Background(), w), 7, 1)
+
+	// 数据库写入阻塞时记录事件不等待
+	for i := 0; i < 10; i++ {
+		recordEvent(ctx, dm.EventSearchIssued, "AI", map[string]any{"i": i})
+	}
+	close(store.gate)
+	w.flush()
+
+	var got []*dm.RunEvent
+	for _, b := range store.batches {
+		got = append(got, b...)
+	}
+	if len(got) != 10 {
+		t.Fatalf("saved %d events, want 10", len(got))
+	}
+	for i, e := range got {
+		if e.RunID != 7 || e.Data["i"] != i || e.CreatedAt.IsZero() {
+			t.Errorf("events[%d] = %+v, want event %d of run 7 with time", i, e, i)
+		}
+	}
+	if len(store.batches) >= 10 {
+		t.Errorf("saved in %d batches, want queued events merged", len(store.batches))
+	}
+}
+
+func TestEventWriterQueueFull(t *testing.T) {
+	store := &batchLog{gate: make(chan struct{})}
+	w := newEventWriter(store, 2)
+	defer close(store.gate)
+
+	// 后台写入阻塞在第一条事件上，队列容量为 2，之后的事件被拒绝而不阻塞
+	var full bool
+	for i := 0; i < 5; i++ {
+		if err := w.SaveRunEvent(context.Background(), &dm.RunEvent{RunID: 7}); errors.Is(err, errEventQueueFull) {
+			full = true
+		}
+	}
+	if !full {
+		t.Errorf("SaveRunEvent() never reported a full queue")
+	}
+}
@@ -0,0 +1,194 @@
+package engine
+
+import (
+	"context"
+	"math"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/iWorld-y/domain_radar/app/domain_radar/pkg/logger"
+	dm "github.com/iWorld-y/domain_radar/app/domain_radar/pkg/model"
+	"github.com/iWorld-y/domain_radar/app/domain_radar/pkg/search"
+)
+
+const (
+	// heatHistoryLimit 计算基线与校准时参考的历史报告数
+	heatHistoryLimit = 30
+	// heatMinHistory 按历史分位校准所需的最少历史报告数，不足时直接按原始热度换算
+	heatMinHistory = 5
+	// defaultHeatBaseline 无历史时的默认文章量基线
+	defaultHeatBaseline = 10
+)
+
+// 热度各分项的权重，缺失的分项不参与加权，其权重按比例分摊给其余分项
+const (
+	weightVolume       = 0.25
+	weightDiversity    = 0.15
+	weightRecency      = 0.15
+	weightEngagement   = 0.15
+	weightSignificance = 0.30
+)
+
+// publishedDateLayouts 搜索源常见的发布时间格式
+var publishedDateLayouts = []string{
+	time.RFC1123,
+	time.RFC1123Z,
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	time.DateTime,
+	time.DateOnly,
+}
+
+// heatNode 根据可观测信号计算领域热度，并以该领域的历史热度校准最终评分
+func (e *Engine) heatNode(ctx context.Context, s *domainState) (*domainState, error) {
+	var history []dm.HeatHistory
+	if e.store != nil {
+		h, err := e.store.GetDomainHeatHistory(ctx, s.domain, heatHistoryLimit)
+		if err != nil {
+			logger.Log.Warnf("查询领域 [%s] 历史热度失败: %v", s.domain, err)
+		} else {
+			history = h
+		}
+	}
+
+	start := s.windowStart
+	if start.IsZero() {
+		start = time.Now().AddDate(0, 0, -3)
+	}
+	heat := computeHeat(heatSources(s), s.report.Score, history, start, time.Now())
+	s.report.LLMScore = s.report.Score
+	s.report.Heat = heat
+	s.report.Score = calibrateHeat(heat.Raw, history)
+	logger.Log.Debugf("领域 [%s] 热度 %d（原始 %.3f，LLM 评分 %d）", s.domain, s.report.Score, heat.Raw, s.report.LLMScore)
+	return s, nil
+}
+
+// heatSources 返回计算热度使用的结果列表，深度研究模式下没有搜索结果，使用收录的文章
+func heatSources(s *domainState) []search.Result {
+	if len(s.results) > 0 {
+		return s.results
+	}
+	sources := make([]search.Result, 0, len(s.articles))
+	for _, a := range s.articles {
+		sources = append(sources, search.Result{Title: a.Title, URL: a.Link, PublishedDate: a.PubDate})
+	}
+	return sources
+}
+
+// computeHeat 计算热度各分项，start 与 now 为本次检索的时间窗口
+func computeHeat(results []search.Result, llmScore int, history []dm.HeatHistory, start, now time.Time) *dm.HeatScore {
+	heat := &dm.HeatScore{
+		ResultCount:  len(results),
+		Baseline:     defaultHeatBaseline,
+		Significance: clamp01(float64(llmScore) / 10),
+	}
+
+	// 文章量：相对历史平均值，等于基线时为 0.5
+	if len(history) > 0 {
+		total := 0
+		for _, h := range history {
+			total += h.ResultCount
+		}
+		if avg := float64(total) / float64(len(history)); avg > 0 {
+			heat.Baseline = avg
+		}
+	}
+	heat.Volume = float64(heat.ResultCount) / (float64(heat.ResultCount) + heat.Baseline)
+
+	// 来源多样性：不同站点数占结果数的比例
+	hosts := make(map[string]bool)
+	for _, r := range results {
+		if u, err := url.Parse(r.URL); err == nil && u.Host != "" {
+			hosts[strings.TrimPrefix(strings.ToLower(u.Host), "www.")] = true
+		}
+	}
+	if len(results) > 0 {
+		heat.Diversity = float64(len(hosts)) / float64(len(results))
+	}
+
+	// 时效性：发布时间在检索窗口内越新越高
+	window := now.Sub(start)
+	var freshness float64
+	dated := 0
+	for _, r := range results {
+		published, ok := parsePublishedDate(r.PublishedDate)
+		if !ok || window <= 0 {
+			continue
+		}
+		freshness += clamp01(1 - now.Sub(published).Seconds()/window.Seconds())
+		dated++
+	}
+	if dated > 0 {
+		recency := freshness / float64(dated)
+		heat.Recency = &recency
+	}
+
+	// 社区互动：按数量级换算，1000 次互动及以上为满分
+	var engagement float64
+	engaged := false
+	for _, r := range results {
+		if r.Engagement > 0 {
+			engaged = true
+			engagement += clamp01(math.Log10(1+float64(r.Engagement)) / 3)
+		}
+	}
+	if engaged {
+		engagement /= float64(len(results))
+		heat.Engagement = &engagement
+	}
+
+	sum := weightVolume*heat.Volume + weightDiversity*heat.Diversity + weightSignificance*heat.Significance
+	weights := weightVolume + weightDiversity + weightSignificance
+	if heat.Recency != nil {
+		sum += weightRecency * *heat.Recency
+		weights += weightRecency
+	}
+	if heat.Engagement != nil {
+		sum += weightEngagement * *heat.Engagement
+		weights += weightEngagement
+	}
+	heat.Raw = sum / weights
+	return heat
+}
+
+// calibrateHeat 将原始热度换算为 1-10 的评分：历史充足时按在该领域历史中的分位换算，
+// 使同一领域不同日期的评分可比；历史不足时直接按原始热度换算
+func calibrateHeat(raw float64, history []dm.HeatHistory) int {
+	if len(history) < heatMinHistory {
+		return clampScore(int(math.Round(raw * 10)))
+	}
+	below := 0.0
+	for _, h := range history {
+		switch {
+		case h.Raw < raw:
+			below++
+		case h.Raw == raw:
+			below += 0.5
+		}
+	}
+	percentile := below / float64(len(history))
+	return clampScore(1 + int(math.Round(percentile*9)))
+}
+
+// parsePublishedDate 解析搜索结果的发布时间
+func parsePublishedDate(s string) (time.Time, bool) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return time.Time{}, false
+	}
+	for _, layout := range publishedDateLayouts {
+		if t, err := time.ParseInLocation(layout, s, time.Local); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+func clamp01(v float64) float64 {
+	return math.Max(0, math.Min(1, v))
+}
+
+func clampScore(score int) int {
+	return max(1, min(10, score))
+}
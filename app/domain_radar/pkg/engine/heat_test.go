@@ -0,0 +1,60 @@
+package engine
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	dm "github.com/iWorld-y/domain_radar/app/domain_radar/pkg/model"
+	"github.com/iWorld-y/domain_radar/app/domain_radar/pkg/search"
+)
+
+func TestComputeHeat(t *testing.T) {
+	now := time.Date(2025, 10, 13, 12, 0, 0, 0, time.Local)
+	start := now.Add(-72 * time.Hour)
+	results := []search.Result{
+		{URL: "https://www.a.com/1", PublishedDate: now.Format(time.RFC3339)},
+		{URL: "https://a.com/2", PublishedDate: now.Add(-36 * time.Hour).Format(time.RFC3339)},
+		{URL: "https://b.com/3"},
+		{URL: "https://c.com/4"},
+	}
+	history := []dm.HeatHistory{{ResultCount: 2}, {ResultCount: 6}}
+
+	heat := computeHeat(results, 8, history, start, now)
+	if heat.Baseline != 4 || heat.Volume != 0.5 {
+		t.Errorf("Baseline, Volume = %v, %v, want 4, 0.5", heat.Baseline, heat.Volume)
+	}
+	if heat.Diversity != 0.75 {
+		t.Errorf("Diversity = %v, want 0.75", heat.Diversity)
+	}
+	if heat.Recency == nil || *heat.Recency != 0.75 {
+		t.Errorf("Recency = %v, want 0.75", heat.Recency)
+	}
+	if heat.Engagement != nil {
+		t.Errorf("Engagement = %v, want nil without engagement data", *heat.Engagement)
+	}
+	want := (0.25*0.5 + 0.15*0.75 + 0.15*0.75 + 0.30*0.8) / 0.85
+	if math.Abs(heat.Raw-want) > 1e-9 {
+		t.Errorf("Raw = %v, want %v", heat.Raw, want)
+	}
+}
+
+func TestCalibrateHeat(t *testing.T) {
+	if got := calibrateHeat(0.62, nil); got != 6 {
+		t.Errorf("calibrateHeat() without history = %d, want 6", got)
+	}
+
+	history := make([]dm.HeatHistory, 10)
+	for i := range history {
+		history[i].Raw = float64(i+1) / 20 // 0.05 ~ 0.5
+	}
+	if got := calibrateHeat(0.9, history); got != 10 {
+		t.Errorf("calibrateHeat() above history = %d, want 10", got)
+	}
+	if got := calibrateHeat(0.01, history); got != 1 {
+		t.Errorf("calibrateHeat() below history = %d, want 1", got)
+	}
+	if got := calibrateHeat(0.275, history); got != 6 {
+		t.Errorf("calibrateHeat() at median = %d, want 6", got)
+	}
+}
@@ -0,0 +1,202 @@
+package engine
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/schema"
+	"golang.org/x/time/rate"
+
+	dm "github.com/iWorld-y/domain_radar/app/domain_radar/pkg/model"
+)
+
+// sleepContext 等待 d，ctx 被取消时提前返回
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// generateJSON 调用 LLM 并将返回的 JSON 解析到 out 中，遇到限流或解析失败时重试
+func generateJSON(ctx context.Context, cm model.ChatModel, limiter *rate.Limiter, messages []*schema.Message, out any) error {
+	maxRetries := 3
+	baseDelay := 2 * time.Second
+	var lastErr error
+
+	for i := 0; i <= maxRetries; i++ {
+		if err := limiter.Wait(ctx); err != nil {
+			return err
+		}
+
+		resp, err := cm.Generate(ctx, messages)
+		if err != nil {
+			if isRateLimitError(err) && i < maxRetries {
+				lastErr = err
+				recordRetry(ctx, i+1, err)
+				if err := sleepContext(ctx, baseDelay*time.Duration(1<<i)); err != nil {
+					return err
+				}
+				continue
+			}
+			return err
+		}
+
+		if err := json.Unmarshal([]byte(cleanJSON(resp.Content)), out); err != nil {
+			lastErr = fmt.Errorf("json unmarshal: %w", err)
+			if i < maxRetries {
+				recordRetry(ctx, i+1, lastErr)
+			}
+			ctx = refreshCache(ctx)
+			continue
+		}
+		return nil
+	}
+	return fmt.Errorf("failed after retries: %w", lastErr)
+}
+
+// streamJSON 以流式方式调用 LLM，每收到一段输出即回调当前累计的原始文本，结束后将 JSON 解析到 out 中
+func streamJSON(ctx context.Context, cm model.ChatModel, limiter *rate.Limiter, messages []*schema.Message, out any, onDelta func(buf string)) error {
+	maxRetries := 3
+	baseDelay := 2 * time.Second
+	var lastErr error
+
+	for i := 0; i <= maxRetries; i++ {
+		if err := limiter.Wait(ctx); err != nil {
+			return err
+		}
+
+		content, err := readStream(ctx, cm, messages, onDelta)
+		if err != nil {
+			if isRateLimitError(err) && i < maxRetries {
+				lastErr = err
+				recordRetry(ctx, i+1, err)
+				if err := sleepContext(ctx, baseDelay*time.Duration(1<<i)); err != nil {
+					return err
+				}
+				continue
+			}
+			return err
+		}
+
+		if err := json.Unmarshal([]byte(cleanJSON(content)), out); err != nil {
+			lastErr = fmt.Errorf("json unmarshal: %w", err)
+			if i < maxRetries {
+				recordRetry(ctx, i+1, lastErr)
+			}
+			ctx = refreshCache(ctx)
+			continue
+		}
+		return nil
+	}
+	return fmt.Errorf("failed after retries: %w", lastErr)
+}
+
+// recordRetry 记录 LLM 调用的重试事件，attempt 为即将进行的重试次数
+func recordRetry(ctx context.Context, attempt int, err error) {
+	recordEvent(ctx, dm.EventRetry, err.Error(), map[string]any{"stage": callInfoFrom(ctx).Stage, "attempt": attempt})
+}
+
+// readStream 读取完整的流式输出
+func readStream(ctx context.Context, cm model.ChatModel, messages []*schema.Message, onDelta func(buf string)) (string, error) {
+	sr, err := cm.Stream(ctx, messages)
+	if err != nil {
+		return "", err
+	}
+	defer sr.Close()
+
+	var sb strings.Builder
+	for {
+		chunk, err := sr.Recv()
+		if errors.Is(err, io.EOF) {
+			return sb.String(), nil
+		}
+		if err != nil {
+			return "", err
+		}
+		if chunk.Content == "" {
+			continue
+		}
+		sb.WriteString(chunk.Content)
+		if onDelta != nil {
+			onDelta(sb.String())
+		}
+	}
+}
+
+// isRateLimitError 判断是否为 LLM 限流错误
+func isRateLimitError(err error) bool {
+	return strings.Contains(err.Error(), "429") || strings.Contains(strings.ToLower(err.Error()), "too many requests")
+}
+
+// cleanJSON 去除 LLM 输出中包裹 JSON 的 markdown 代码块标记
+func cleanJSON(content string) string {
+	content = strings.TrimSpace(content)
+	content = strings.TrimPrefix(content, "```json")
+	content = strings.TrimPrefix(content, "```")
+	content = strings.TrimSuffix(content, "```")
+	return strings.TrimSpace(content)
+}
+
+// partialJSONString 从尚未生成完毕的 JSON 文本中提取指定字符串字段目前已输出的内容
+func partialJSONString(buf, key string) string {
+	idx := strings.Index(buf, `"`+key+`"`)
+	if idx < 0 {
+		return ""
+	}
+	rest := strings.TrimLeft(buf[idx+len(key)+2:], " \t\r\n")
+	if !strings.HasPrefix(rest, ":") {
+		return ""
+	}
+	rest = strings.TrimLeft(rest[1:], " \t\r\n")
+	if !strings.HasPrefix(rest, `"`) {
+		return ""
+	}
+	rest = rest[1:]
+
+	var sb strings.Builder
+	for i := 0; i < len(rest); i++ {
+		c := rest[i]
+		switch {
+		case c == '"':
+			return sb.String()
+		case c != '\\':
+			sb.WriteByte(c)
+		case i+1 >= len(rest):
+			// 转义序列尚未输出完整
+			return sb.String()
+		default:
+			i++
+			switch rest[i] {
+			case 'n':
+				sb.WriteByte('\n')
+			case 't':
+				sb.WriteByte('\t')
+			case 'r':
+				sb.WriteByte('\r')
+			case 'u':
+				if i+4 >= len(rest) {
+					return sb.String()
+				}
+				if r, err := strconv.ParseUint(rest[i+1:i+5], 16, 32); err == nil {
+					sb.WriteRune(rune(r))
+				}
+				i += 4
+			default:
+				sb.WriteByte(rest[i])
+			}
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,54 @@
+package engine
+
+import (
+	"testing"
+
+	dm "github.com/iWorld-y/domain_radar/app/domain_radar/pkg/model"
+)
+
+func TestPartialJSONString(t *testing.T) {
+	tests := []struct {
+		name string
+		buf  string
+		want string
+	}{
+		{"key not yet emitted", `{"score": 8, "over`, ""},
+		{"value not started", `{"overview": `, ""},
+		{"partial value", `{"overview": "AI 芯片需求\n持续`, "AI 芯片需求\n持续"},
+		{"complete value", `{"overview": "第一行\n第二行", "trends": "x"}`, "第一行\n第二行"},
+		{"escaped quote", `{"overview": "称为 \"大模型\" 的`, `称为 "大模型" 的`},
+		{"dangling escape", `{"overview": "abc\`, "abc"},
+		{"unicode escape", `{"overview": "中文`, "中文"},
+		{"incomplete unicode escape", `{"overview": "a\u4e2`, "a"},
+		{"non-string value", `{"overview": 12`, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := partialJSONString(tt.buf, "overview"); got != tt.want {
+				t.Errorf("partialJSONString(%q) = %q, want %q", tt.buf, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchSections(t *testing.T) {
+	lenses := []dm.AnalysisLens{{Name: "竞争对手动向"}, {Name: "Hiring signals"}, {Name: "监管动态"}}
+	sections := []dm.AnalysisSection{
+		{Name: "Competitors", Content: "Rival shipped v2"},
+		{Name: " hiring SIGNALS ", Content: "More ML roles"},
+		{Name: "监管动态", Content: "  "},
+	}
+	got := matchSections(sections, lenses)
+	want := []dm.AnalysisSection{
+		{Name: "竞争对手动向", Content: "Rival shipped v2"},
+		{Name: "Hiring signals", Content: "More ML roles"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("matchSections() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("matchSections()[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
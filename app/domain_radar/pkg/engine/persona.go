@@ -0,0 +1,95 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/cloudwego/eino/schema"
+
+	dm "github.com/iWorld-y/domain_radar/app/domain_radar/pkg/model"
+)
+
+// maxInterviewQuestions 画像访谈最多提问数，达到后必须生成画像
+const maxInterviewQuestions = 5
+
+// InterviewPersona 根据已完成的问答决定下一个问题，信息足够或达到提问上限时生成结构化画像与推荐领域；
+// 访谈不保存状态，每次调用都需要传入完整的问答记录
+func (e *Engine) InterviewPersona(ctx context.Context, userID int, language string, turns []dm.InterviewTurn) (*dm.InterviewStep, error) {
+	ctx = withRun(ctx, 0, userID)
+	ctx = withLanguage(ctx, NormalizeLanguage(language, NormalizeLanguage(e.cfg.ReportLanguage, LanguageZH)))
+	p := promptsFrom(ctx)
+
+	var sb strings.Builder
+	for i, t := range turns {
+		fmt.Fprintf(&sb, p.interviewTurn, i+1, t.Question, t.Answer)
+	}
+	if len(turns) == 0 {
+		sb.WriteString("-\n")
+	}
+	// 达到上限后将上限设为已提问数，要求模型结束访谈
+	limit := maxInterviewQuestions
+	if len(turns) >= limit {
+		limit = len(turns)
+	}
+
+	messages := []*schema.Message{
+		{Role: schema.System, Content: p.jsonSystem},
+		{Role: schema.User, Content: fmt.Sprintf(p.personaInterview, sb.String(), limit)},
+	}
+	var out struct {
+		Done             bool        `json:"done"`
+		Question         string      `json:"question"`
+		Persona          *dm.Persona `json:"persona"`
+		SuggestedDomains []string    `json:"suggested_domains"`
+	}
+	if err := generateJSON(withStage(ctx, stagePersonaInterview), e.chatModel, e.limiter, messages, &out); err != nil {
+		return nil, err
+	}
+
+	if !out.Done {
+		question := strings.TrimSpace(out.Question)
+		if question == "" || len(turns) >= maxInterviewQuestions {
+			return nil, fmt.Errorf("persona interview did not conclude after %d questions", len(turns))
+		}
+		return &dm.InterviewStep{Question: question}, nil
+	}
+	if out.Persona == nil {
+		return nil, fmt.Errorf("persona interview finished without a persona")
+	}
+	return &dm.InterviewStep{
+		Done:             true,
+		Persona:          NormalizePersona(out.Persona),
+		SuggestedDomains: cleanList(out.SuggestedDomains),
+	}, nil
+}
+
+// NormalizePersona 清理画像字段：去除空白与重复项，风险偏好不合法时置空
+func NormalizePersona(p *dm.Persona) *dm.Persona {
+	risk := strings.ToLower(strings.TrimSpace(p.RiskAppetite))
+	if risk != dm.RiskAppetiteLow && risk != dm.RiskAppetiteMedium && risk != dm.RiskAppetiteHigh {
+		risk = ""
+	}
+	return &dm.Persona{
+		Role:         strings.TrimSpace(p.Role),
+		Seniority:    strings.TrimSpace(p.Seniority),
+		TechStack:    cleanList(p.TechStack),
+		Goals:        cleanList(p.Goals),
+		RiskAppetite: risk,
+		TimeHorizon:  strings.TrimSpace(p.TimeHorizon),
+		Constraints:  cleanList(p.Constraints),
+	}
+}
+
+// cleanList 去除列表中的空白项与重复项
+func cleanList(items []string) []string {
+	result := make([]string, 0, len(items))
+	for _, item := range items {
+		item = strings.TrimSpace(item)
+		if item != "" && !slices.Contains(result, item) {
+			result = append(result, item)
+		}
+	}
+	return result
+}
@@ -0,0 +1,545 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bytedance/gg/gson"
+	"github.com/cloudwego/eino/compose"
+
+	"github.com/iWorld-y/domain_radar/app/domain_radar/pkg/logger"
+	dm "github.com/iWorld-y/domain_radar/app/domain_radar/pkg/model"
+	"github.com/iWorld-y/domain_radar/app/domain_radar/pkg/search"
+)
+
+// 流水线节点名称，用于回调中的追踪与耗时统计
+const (
+	nodeShare        = "share"
+	nodeGenerate     = "generate"
+	nodeSearch       = "search"
+	nodeFetch        = "fetch"
+	nodeResearch     = "research"
+	nodeSummarize    = "summarize"
+	nodeHeat         = "heat"
+	nodePersist      = "persist"
+	nodeDomains      = "domains"
+	nodeRank         = "rank"
+	nodeDeepAnalysis = "deep_analysis"
+	nodeSaveAnalysis = "save_analysis"
+)
+
+// 共享分支名称
+const (
+	branchGenerate = "generate"
+	branchReuse    = "reuse"
+)
+
+// errNoArticles 领域未找到足够的有效文章
+var errNoArticles = errors.New("no valid articles found")
+
+// domainError 领域流水线中可归类的失败，status 为对应的领域状态
+type domainError struct {
+	status string
+	err    error
+}
+
+func (e *domainError) Error() string { return e.err.Error() }
+
+func (e *domainError) Unwrap() error { return e.err }
+
+// domainStatus 根据领域流水线返回的错误判断领域状态
+func domainStatus(err error) string {
+	var de *domainError
+	switch {
+	case err == nil:
+		return dm.DomainStatusSucceeded
+	case errors.Is(err, errNoArticles):
+		return dm.DomainStatusNoArticles
+	case errors.Is(err, ErrBudgetExceeded), errors.Is(err, context.Canceled):
+		return dm.DomainStatusSkipped
+	case errors.As(err, &de):
+		return de.status
+	default:
+		return dm.DomainStatusFailed
+	}
+}
+
+// runState 单次运行在流水线各节点间传递的状态
+type runState struct {
+	opts        RunOptions
+	runID       int
+	tracker     *budgetTracker
+	windowStart time.Time
+	windowEnd   time.Time // 本次运行时间范围的终点，领域单独配置时间范围时以此为终点
+	retry       bool      // 重试已有运行记录，保存深度解读前替换原有解读
+
+	reports  []dm.DomainReport
+	statuses []dm.DomainRunStatus     // 各领域的处理结果，按完成顺序
+	analyses []*dm.DeepAnalysisResult // 每个画像视角一份深度解读
+}
+
+// domainState 单个领域在领域流水线各节点间传递的状态
+type domainState struct {
+	run         *runState
+	domain      string
+	config      dm.DomainConfig
+	startDate   string // 该领域的检索日期范围，搜索源只支持按日期检索
+	endDate     string
+	windowStart time.Time // 该领域的精确时间范围，用于过滤搜索结果
+	windowEnd   time.Time
+	results     []search.Result
+	articles    []dm.Article
+	notes       []string // 深度研究记录的笔记
+	report      *dm.DomainReport
+	shareKey    string // 共享键，未开启共享时为空
+	shared      bool   // report 复用自其他运行
+	release     func() // 释放共享键的锁，未加锁时为 nil
+}
+
+// setWindow 设置领域的时间范围
+func (s *domainState) setWindow(start, end time.Time) {
+	s.windowStart, s.windowEnd = start, end
+	s.startDate, s.endDate = start.Format(time.DateOnly), end.Format(time.DateOnly)
+}
+
+// domainStage 可通过配置插入领域流水线的可选阶段，位于生成领域报告与保存之间
+type domainStage func(e *Engine, ctx context.Context, s *domainState) (*domainState, error)
+
+// domainStages 可选阶段注册表，key 为配置中使用的阶段名称
+var domainStages = map[string]domainStage{
+	stageVerification: (*Engine).verifyNode,
+	stageEntities:     (*Engine).entitiesNode,
+}
+
+// validateStages 检查配置的可选阶段是否均已注册
+func validateStages(stages []string) error {
+	for _, name := range stages {
+		if _, ok := domainStages[name]; !ok {
+			return fmt.Errorf("unknown pipeline stage: %s", name)
+		}
+	}
+	return nil
+}
+
+// domainStageNames 返回本次运行需要插入的可选阶段，保持配置中的顺序
+func (e *Engine) domainStageNames(opts RunOptions) []string {
+	names := append([]string(nil), e.cfg.Pipeline.DomainStages...)
+	if (opts.Verify || e.cfg.Verification.Enabled) && !slices.Contains(names, stageVerification) {
+		names = append(names, stageVerification)
+	}
+	return names
+}
+
+// buildDomainChain 构建单个领域的流水线：搜索 -> 抓取 -> 生成报告 -> [可选阶段] -> 计算热度 -> 保存，
+// 深度研究模式下由研究节点替代搜索与抓取；开启共享时先查找可复用的报告，找到时跳过生成直接保存
+func (e *Engine) buildDomainChain(ctx context.Context, opts RunOptions) (compose.Runnable[*domainState, *domainState], error) {
+	generate := e.buildGenerateChain(opts)
+	chain := compose.NewChain[*domainState, *domainState]()
+	if e.sharingEnabled(opts) {
+		chain.
+			AppendLambda(compose.InvokableLambda(e.shareNode), compose.WithNodeName(nodeShare)).
+			AppendBranch(compose.NewChainBranch(shareBranch).
+				AddGraph(branchGenerate, generate, compose.WithNodeName(nodeGenerate)).
+				AddPassthrough(branchReuse))
+	} else {
+		chain.AppendGraph(generate, compose.WithNodeName(nodeGenerate))
+	}
+	chain.AppendLambda(compose.InvokableLambda(e.persistNode), compose.WithNodeName(nodePersist))
+	return chain.Compile(ctx, compose.WithGraphName("domain_pipeline"))
+}
+
+// buildGenerateChain 构建生成领域报告的子流水线，不含保存
+func (e *Engine) buildGenerateChain(opts RunOptions) *compose.Chain[*domainState, *domainState] {
+	chain := compose.NewChain[*domainState, *domainState]()
+	if opts.Research != nil {
+		chain.AppendLambda(compose.InvokableLambda(e.researchNode), compose.WithNodeName(nodeResearch))
+	} else {
+		chain.
+			AppendLambda(compose.InvokableLambda(e.searchNode), compose.WithNodeName(nodeSearch)).
+			AppendLambda(compose.InvokableLambda(e.fetchNode), compose.WithNodeName(nodeFetch))
+	}
+	chain.AppendLambda(compose.InvokableLambda(e.summarizeNode), compose.WithNodeName(nodeSummarize))
+	for _, name := range e.domainStageNames(opts) {
+		stage := domainStages[name]
+		node := func(ctx context.Context, s *domainState) (*domainState, error) {
+			return stage(e, ctx, s)
+		}
+		chain.AppendLambda(compose.InvokableLambda(node), compose.WithNodeName(name))
+	}
+	chain.AppendLambda(compose.InvokableLambda(e.heatNode), compose.WithNodeName(nodeHeat))
+	return chain
+}
+
+// buildRunChain 构建整次运行的流水线：并发处理各领域 -> 排序 -> 深度解读 -> 保存解读
+func (e *Engine) buildRunChain(ctx context.Context, domainChain compose.Runnable[*domainState, *domainState]) (compose.Runnable[*runState, *runState], error) {
+	domainsNode := func(ctx context.Context, s *runState) (*runState, error) {
+		return e.runDomains(ctx, s, domainChain)
+	}
+	return compose.NewChain[*runState, *runState]().
+		AppendLambda(compose.InvokableLambda(domainsNode), compose.WithNodeName(nodeDomains)).
+		AppendLambda(compose.InvokableLambda(rankNode), compose.WithNodeName(nodeRank)).
+		AppendLambda(compose.InvokableLambda(e.deepAnalysisNode), compose.WithNodeName(nodeDeepAnalysis)).
+		AppendLambda(compose.InvokableLambda(e.saveAnalysisNode), compose.WithNodeName(nodeSaveAnalysis)).
+		Compile(ctx, compose.WithGraphName("run_pipeline"))
+}
+
+// runDomains 并发执行各领域的流水线，同时处理的领域数受配置限制，单个领域失败不影响其他领域；
+// 领域流水线从 ctx 继承整次运行的回调，无需重复注册
+func (e *Engine) runDomains(ctx context.Context, s *runState, domainChain compose.Runnable[*domainState, *domainState]) (*runState, error) {
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	slots := newSemaphore(workers(e.cfg.Concurrency.Domains, defaultDomainWorkers))
+	for _, d := range s.opts.Domains {
+		if err := slots.acquire(ctx); err != nil {
+			break
+		}
+		wg.Add(1)
+		go func(d dm.DomainConfig) {
+			defer wg.Done()
+			defer slots.release()
+			domain := d.Name
+			ctx := withDomain(ctx, domain)
+			if ctx.Err() != nil {
+				return
+			}
+			if s.tracker != nil && s.tracker.isExceeded() {
+				logger.Log.Warnf("预算已耗尽，跳过领域 [%s]", domain)
+				e.recordDomainStatus(ctx, s, &mu, dm.DomainRunStatus{Domain: domain, Status: dm.DomainStatusSkipped, Error: ErrBudgetExceeded.Error()})
+				return
+			}
+
+			ds := &domainState{run: s, domain: domain, config: d}
+			start, end, err := e.domainWindow(ctx, s, d)
+			if err == nil {
+				ds.setWindow(start, end)
+				var out *domainState
+				out, err = domainChain.Invoke(ctx, ds)
+				if ds.release != nil {
+					ds.release()
+				}
+				if err == nil {
+					ds = out
+				}
+			}
+			status := dm.DomainRunStatus{Domain: domain, Status: domainStatus(err), ArticleCount: len(ds.articles)}
+			if err != nil {
+				status.Error = unwrapNodeError(err).Error()
+			}
+			switch {
+			case errors.Is(err, errNoArticles):
+				logger.Log.Warnf("领域 [%s] 未找到足够的有效文章", domain)
+			case err != nil:
+				logger.Log.Errorf("处理领域失败 [%s]: %v", domain, err)
+			default:
+				mu.Lock()
+				s.reports = append(s.reports, *ds.report)
+				mu.Unlock()
+			}
+			e.recordDomainStatus(ctx, s, &mu, status)
+		}(d)
+	}
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if s.tracker != nil && s.tracker.isExceeded() {
+		generated := 0
+		for _, st := range s.statuses {
+			if st.Status == dm.DomainStatusSucceeded {
+				generated++
+			}
+		}
+		return nil, fmt.Errorf("%w: generated %d of %d domain reports", ErrBudgetExceeded, generated, len(s.opts.Domains))
+	}
+	if len(s.reports) == 0 {
+		var reasons []string
+		for _, st := range s.statuses {
+			reasons = append(reasons, fmt.Sprintf("%s: %s", st.Domain, st.Status))
+		}
+		return nil, fmt.Errorf("no domain reports generated (%s)", strings.Join(reasons, ", "))
+	}
+	return s, nil
+}
+
+// recordDomainStatus 记录并保存领域的处理结果；运行被取消时同样保存，以便区分未完成的领域
+func (e *Engine) recordDomainStatus(ctx context.Context, s *runState, mu *sync.Mutex, status dm.DomainRunStatus) {
+	mu.Lock()
+	s.statuses = append(s.statuses, status)
+	mu.Unlock()
+	if s.opts.DomainStatusCallback != nil {
+		s.opts.DomainStatusCallback(status)
+	}
+	recordEvent(ctx, dm.EventDomainFinished, status.Error, map[string]any{"status": status.Status, "articles": status.ArticleCount})
+	if e.store == nil || s.runID <= 0 {
+		return
+	}
+	if err := e.store.SaveDomainRun(context.WithoutCancel(ctx), s.runID, status); err != nil {
+		logger.Log.Errorf("保存领域 [%s] 处理结果失败: %v", status.Domain, err)
+	}
+}
+
+// searchNode 按各检索语言搜索领域相关新闻，合并去重；部分语言搜索失败不影响其他语言
+func (e *Engine) searchNode(ctx context.Context, s *domainState) (*domainState, error) {
+	var lists [][]search.Result
+	var lastErr error
+	for _, q := range e.searchQueries(ctx, s.config) {
+		recordEvent(ctx, dm.EventSearchIssued, q.Query, map[string]any{"language": q.Language, "start_date": s.startDate, "end_date": s.endDate})
+		resp, err := e.search(ctx, &search.Request{
+			Query:             q.Query,
+			Topic:             "news",
+			MaxResults:        maxDomainArticles,
+			StartDate:         s.startDate,
+			EndDate:           s.endDate,
+			IncludeRawContent: false,
+			Language:          q.Language,
+			IncludeSites:      s.config.IncludeSites,
+			ExcludeSites:      s.config.ExcludeSites,
+		})
+		if err != nil {
+			logger.Log.Warnf("搜索领域 [%s] 失败，检索词 [%s]: %v", s.domain, q.Query, err)
+			recordEvent(ctx, dm.EventSearchFailed, err.Error(), map[string]any{"query": q.Query, "language": q.Language})
+			lastErr = err
+			continue
+		}
+		logger.Log.Debugf("搜索领域 [%s] 成功，检索词 [%s]: %s", s.domain, q.Query, gson.ToString(resp))
+		results := filterWindow(filterSites(resp.Results, s.config), s.windowStart, s.windowEnd)
+		recordEvent(ctx, dm.EventSearchResults, q.Query, map[string]any{"language": q.Language, "count": len(resp.Results), "kept": len(results)})
+		lists = append(lists, results)
+	}
+	if len(lists) == 0 {
+		return nil, &domainError{status: dm.DomainStatusSearchFailed, err: fmt.Errorf("search: %w", lastErr)}
+	}
+	s.results = mergeResults(lists)
+	return s, nil
+}
+
+// fetchNode 并发抓取正文，有效文章数量足够后停止抓取其余结果；文章数上限可由领域配置，
+// 预算紧张时减少文章数。文章保持搜索结果的顺序
+func (e *Engine) fetchNode(ctx context.Context, s *domainState) (*domainState, error) {
+	articleLimit := s.run.tracker.articleLimit(s.config.MaxArticles)
+	fetchCtx, stop := context.WithCancel(ctx)
+	defer stop()
+
+	candidates := make([]*dm.Article, len(s.results))
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	found := 0
+	slots := newSemaphore(workers(e.cfg.Concurrency.Fetches, defaultFetchWorkers))
+	for i, item := range s.results {
+		if err := slots.acquire(fetchCtx); err != nil {
+			break
+		}
+		wg.Add(1)
+		go func(i int, item search.Result) {
+			defer wg.Done()
+			defer slots.release()
+			article := e.fetchArticle(fetchCtx, s.domain, item)
+			if article == nil {
+				return
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			candidates[i] = article
+			if found++; found >= articleLimit {
+				stop()
+			}
+		}(i, item)
+	}
+	wg.Wait()
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, a := range candidates {
+		if a != nil && len(s.articles) < articleLimit {
+			s.articles = append(s.articles, *a)
+		}
+	}
+	if len(s.articles) < 1 {
+		return nil, errNoArticles
+	}
+	return s, nil
+}
+
+// fetchArticle 在摘要过短时抓取网页正文，正文仍过短或抓取被中止时返回 nil
+func (e *Engine) fetchArticle(ctx context.Context, domain string, item search.Result) *dm.Article {
+	content := item.Content
+	var fetchErr error
+	if len(content) < 500 {
+		fetched, err := e.fetchPage(ctx, item.URL)
+		if ctx.Err() != nil {
+			return nil
+		}
+		if fetchErr = err; err == nil && len(fetched) > len(content) {
+			content = fetched
+		}
+	}
+	if len(content) > 5000 {
+		content = content[:5000]
+	}
+	if len(content) <= 100 {
+		reason := fmt.Sprintf("content too short (%d bytes)", len(content))
+		if fetchErr != nil {
+			reason += ": " + fetchErr.Error()
+		}
+		recordEvent(ctx, dm.EventArticleRejected, item.URL, map[string]any{"title": item.Title, "reason": reason})
+		return nil
+	}
+	recordEvent(ctx, dm.EventArticleFetched, item.URL, map[string]any{"title": item.Title, "length": len(content)})
+	return &dm.Article{
+		Title:   item.Title,
+		Link:    item.URL,
+		Source:  domain,
+		PubDate: item.PublishedDate,
+		Content: content,
+	}
+}
+
+// summarizeNode 生成领域报告
+func (e *Engine) summarizeNode(ctx context.Context, s *domainState) (*domainState, error) {
+	onOverview := func(overview string) {
+		if s.run.opts.StreamCallback != nil {
+			s.run.opts.StreamCallback(PartialOutput{Domain: s.domain, Stage: stageDomainReport, Text: overview})
+		}
+	}
+	report, err := generateDomainReport(withStage(ctx, stageDomainReport), e.chatModel, s.domain, s.articles, s.notes, e.limiter, onOverview)
+	if err != nil {
+		return nil, &domainError{status: dm.DomainStatusLLMFailed, err: fmt.Errorf("generate domain report: %w", err)}
+	}
+	report.Articles = s.articles
+	s.report = report
+	return s, nil
+}
+
+// verifyNode 事实核验，预算紧张时跳过；核验失败不影响报告保存
+func (e *Engine) verifyNode(ctx context.Context, s *domainState) (*domainState, error) {
+	if !s.run.tracker.allowVerification() {
+		return s, nil
+	}
+	if err := verifyDomainReport(withStage(ctx, stageVerification), e.chatModel, s.report, e.limiter, e.cfg.Verification.DropUnsupported); err != nil {
+		logger.Log.Warnf("核验领域报告失败 [%s]: %v", s.domain, err)
+	}
+	return s, nil
+}
+
+// persistNode 保存领域报告，已保存过的复用报告只加入本次运行；保存失败时仍保留报告用于深度解读
+func (e *Engine) persistNode(ctx context.Context, s *domainState) (*domainState, error) {
+	if e.store == nil || s.run.runID <= 0 {
+		return s, nil
+	}
+	if s.shared && s.report.ID > 0 {
+		err := e.store.LinkDomainReport(ctx, s.run.runID, s.report.ID)
+		if err != nil {
+			logger.Log.Errorf("加入复用的领域报告失败 [%s]: %v", s.domain, err)
+		}
+		recordEvent(ctx, dm.EventReportReused, errorMessage(err), map[string]any{"report_id": s.report.ID})
+		return s, nil
+	}
+	s.report.ShareKey = s.shareKey
+	err := e.store.SaveDomainReport(ctx, s.run.runID, s.report)
+	if err != nil {
+		logger.Log.Errorf("保存领域报告失败 [%s]: %v", s.domain, err)
+	}
+	recordEvent(ctx, dm.EventReportSaved, errorMessage(err), map[string]any{"report_id": s.report.ID, "articles": len(s.report.Articles)})
+	return s, nil
+}
+
+// rankNode 按评分与领域优先级权重的乘积降序排列领域报告
+func rankNode(ctx context.Context, s *runState) (*runState, error) {
+	weights := make(map[string]float64, len(s.opts.Domains))
+	for _, d := range s.opts.Domains {
+		weights[d.Name] = d.PriorityWeight()
+	}
+	weighted := func(r dm.DomainReport) float64 {
+		w, ok := weights[r.DomainName]
+		if !ok {
+			w = 1
+		}
+		return float64(r.Score) * w
+	}
+	sort.SliceStable(s.reports, func(i, j int) bool {
+		return weighted(s.reports[i]) > weighted(s.reports[j])
+	})
+	return s, nil
+}
+
+// deepAnalysisNode 按每个画像视角分别跨领域深度解读，未设置画像时跳过；单个视角失败不影响其他视角
+func (e *Engine) deepAnalysisNode(ctx context.Context, s *runState) (*runState, error) {
+	lenses := s.opts.lenses()
+	if len(lenses) == 0 {
+		return s, nil
+	}
+	p := promptsFrom(ctx)
+	var sb strings.Builder
+	for _, report := range s.reports {
+		fmt.Fprintf(&sb, p.analysisSection, report.DomainName, report.Score, report.Overview, report.Trends, strings.Join(report.KeyEventContents(), "\n- "))
+	}
+
+	for _, lens := range lenses {
+		onText := func(text string) {
+			if s.opts.StreamCallback != nil {
+				s.opts.StreamCallback(PartialOutput{Persona: lens.Name, Stage: stageDeepAnalysis, Text: text})
+			}
+		}
+		analysis, err := deepInterpretReport(withStage(ctx, stageDeepAnalysis), e.chatModel, sb.String(), lens.Text, s.opts.Lenses, e.limiter, onText)
+		if errors.Is(err, ErrBudgetExceeded) || ctx.Err() != nil {
+			return nil, err
+		}
+		if err != nil {
+			logger.Log.Errorf("深度解读失败 [%s]: %v", lens.Name, err)
+			continue
+		}
+		analysis.PersonaID, analysis.PersonaName = lens.ID, lens.Name
+		s.analyses = append(s.analyses, analysis)
+	}
+	return s, nil
+}
+
+// saveAnalysisNode 保存各画像的深度解读，并以第一份解读的标题作为运行标题
+func (e *Engine) saveAnalysisNode(ctx context.Context, s *runState) (*runState, error) {
+	if len(s.analyses) == 0 || e.store == nil || s.runID <= 0 {
+		return s, nil
+	}
+	if s.retry {
+		if err := e.store.DeleteDeepAnalyses(ctx, s.runID, s.opts.UserID); err != nil {
+			return nil, fmt.Errorf("replace deep analysis: %w", err)
+		}
+	}
+	for _, analysis := range s.analyses {
+		err := e.store.SaveDeepAnalysis(ctx, s.runID, s.opts.UserID, analysis)
+		if err != nil {
+			logger.Log.Errorf("保存深度解读失败 [%s]: %v", analysis.PersonaName, err)
+		}
+		recordEvent(ctx, dm.EventAnalysisSaved, errorMessage(err), map[string]any{"persona": analysis.PersonaName})
+	}
+	if title := s.analyses[0].Title; title != "" {
+		e.store.UpdateRunTitle(ctx, s.runID, title)
+	}
+	return s, nil
+}
+
+// errorMessage 返回错误信息，err 为 nil 时为空
+func errorMessage(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
+
+// unwrapNodeError 去除 compose 为节点错误附加的节点路径信息，返回节点的原始错误
+func unwrapNodeError(err error) error {
+	msg := err.Error()
+	if strings.HasPrefix(msg, "[NodeRunError]") || strings.HasPrefix(msg, "[GraphRunError]") {
+		if inner := errors.Unwrap(err); inner != nil {
+			return inner
+		}
+	}
+	return err
+}
@@ -0,0 +1,155 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/schema"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/time/rate"
+
+	"github.com/iWorld-y/domain_radar/app/domain_radar/pkg/config"
+	"github.com/iWorld-y/domain_radar/app/domain_radar/pkg/logger"
+	dm "github.com/iWorld-y/domain_radar/app/domain_radar/pkg/model"
+	"github.com/iWorld-y/domain_radar/app/domain_radar/pkg/search"
+)
+
+type stubSearcher struct{}
+
+func (stubSearcher) Search(ctx context.Context, req *search.Request) (*search.Response, error) {
+	return &search.Response{Results: []search.Result{
+		{Title: req.Query + " news", URL: "https://example.com/" + req.Query, Content: strings.Repeat("x", 600)},
+	}}, nil
+}
+
+// partialSearcher 对 "Broken" 领域返回错误，对 "Quiet" 领域返回空结果
+type partialSearcher struct{ stubSearcher }
+
+func (p partialSearcher) Search(ctx context.Context, req *search.Request) (*search.Response, error) {
+	switch req.Query {
+	case "Broken":
+		return nil, errors.New("401 invalid api key")
+	case "Quiet":
+		return &search.Response{}, nil
+	}
+	return p.stubSearcher.Search(ctx, req)
+}
+
+type stubReportModel struct {
+	model.ChatModel
+}
+
+func (stubReportModel) Stream(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.StreamReader[*schema.Message], error) {
+	return schema.StreamReaderFromArray([]*schema.Message{
+		{Role: schema.Assistant, Content: `{"overview": "综述", `},
+		{Role: schema.Assistant, Content: `"key_events": [{"event": "事件", "sources": [1]}], "trends": "趋势 [1]", "score": 7}`},
+	}), nil
+}
+
+func TestRunPipeline(t *testing.T) {
+	logger.Log = logrus.New()
+	logger.Log.SetOutput(io.Discard)
+
+	e := &Engine{
+		cfg:       &config.Config{},
+		chatModel: stubReportModel{},
+		searcher:  stubSearcher{},
+		limiter:   rate.NewLimiter(rate.Inf, 1),
+	}
+
+	var mu sync.Mutex
+	var statuses []string
+	var partials []PartialOutput
+	_, err := e.Run(context.Background(), RunOptions{
+		Domains: dm.DomainNames([]string{"AI", "Chips"}),
+		ProgressCallback: func(status string, progress int) {
+			mu.Lock()
+			defer mu.Unlock()
+			statuses = append(statuses, status)
+		},
+		StreamCallback: func(p PartialOutput) {
+			mu.Lock()
+			defer mu.Unlock()
+			partials = append(partials, p)
+		},
+	})
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	processed := 0
+	for _, s := range statuses {
+		if strings.HasPrefix(s, "processed domain: ") {
+			processed++
+		}
+	}
+	if processed != 2 {
+		t.Errorf("processed domain updates = %d, want 2 (statuses: %v)", processed, statuses)
+	}
+	if last := statuses[len(statuses)-1]; last != "completed" {
+		t.Errorf("last status = %q, want completed", last)
+	}
+	if len(partials) == 0 || partials[len(partials)-1].Text != "综述" {
+		t.Errorf("partials = %v, want overview streamed", partials)
+	}
+}
+
+func TestRunDomainStatuses(t *testing.T) {
+	logger.Log = logrus.New()
+	logger.Log.SetOutput(io.Discard)
+
+	e := &Engine{
+		cfg:       &config.Config{},
+		chatModel: stubReportModel{},
+		searcher:  partialSearcher{},
+		limiter:   rate.NewLimiter(rate.Inf, 1),
+	}
+
+	var mu sync.Mutex
+	got := map[string]dm.DomainRunStatus{}
+	_, err := e.Run(context.Background(), RunOptions{
+		Domains: append(dm.DomainNames([]string{"AI", "Broken", "Quiet"}), dm.DomainConfig{Name: "Off"}),
+		DomainStatusCallback: func(status dm.DomainRunStatus) {
+			mu.Lock()
+			defer mu.Unlock()
+			got[status.Domain] = status
+		},
+	})
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	want := map[string]string{
+		"AI":     dm.DomainStatusSucceeded,
+		"Broken": dm.DomainStatusSearchFailed,
+		"Quiet":  dm.DomainStatusNoArticles,
+	}
+	for domain, status := range want {
+		if got[domain].Status != status {
+			t.Errorf("status[%s] = %q, want %q", domain, got[domain].Status, status)
+		}
+	}
+	if _, ok := got["Off"]; ok {
+		t.Errorf("disabled domain was processed: %+v", got["Off"])
+	}
+	if got["AI"].ArticleCount != 1 {
+		t.Errorf("AI article count = %d, want 1", got["AI"].ArticleCount)
+	}
+	if !strings.Contains(got["Broken"].Error, "invalid api key") {
+		t.Errorf("Broken error = %q, want search error details", got["Broken"].Error)
+	}
+}
+
+func TestValidateStages(t *testing.T) {
+	if err := validateStages([]string{stageVerification}); err != nil {
+		t.Errorf("validateStages(verification) error = %v", err)
+	}
+	if err := validateStages([]string{"unknown"}); err == nil {
+		t.Errorf("validateStages(unknown) error = nil, want error")
+	}
+}
@@ -0,0 +1,351 @@
+package engine
+
+import (
+	"context"
+	"unicode"
+)
+
+// 报告语言
+const (
+	LanguageZH = "zh"
+	LanguageEN = "en"
+)
+
+// promptSet 某种报告语言下的全部提示词
+type promptSet struct {
+	language string
+
+	jsonSystem string // 要求只输出 JSON 的系统提示词
+
+	// 领域报告
+	articlesIntro string // 参数：领域
+	articleItem   string // 参数：序号、标题、正文
+	notesIntro    string
+	domainReport  string
+
+	// 深度解读，analysisSection 参数：领域、评分、综述、趋势、关键事件
+	analysisSection string
+	deepAnalysis    string // 参数：用户画像、各领域总结
+	// 用户自定义解读栏目，追加在深度解读提示词之后
+	customLensItem   string // 参数：序号、栏目名称、撰写要求
+	customLensesText string // 参数：栏目列表
+
+	// 事实核验
+	verifyArticlesIntro string
+	verifyArticleItem   string // 参数：序号、标题、正文
+	verifyClaimsIntro   string
+	verifyClaimItem     string // 参数：序号、论断
+	verification        string
+
+	// 深度研究
+	researchSystem string // 参数：领域、开始日期、结束日期、文章上限、最大轮数
+	researchUser   string // 参数：领域
+
+	// 多语言检索词翻译
+	queryTranslation string // 参数：领域、目标语言列表
+
+	// 实体抽取，entityExtraction 拼接在文章列表之后
+	entityArticleItem string // 参数：序号、标题、正文
+	entityExtraction  string
+
+	// 画像访谈
+	interviewTurn    string // 参数：序号、问题、回答
+	personaInterview string // 参数：已完成的问答、最多提问数
+
+	// languageReminder 输出语言不符时追加的提醒
+	languageReminder string
+}
+
+var promptSets = map[string]*promptSet{
+	LanguageZH: {
+		language:   LanguageZH,
+		jsonSystem: "你是一个 JSON 生成器。请只输出 JSON 字符串。",
+
+		articlesIntro: "以下是关于领域【%s】的一组新闻文章，请阅读并总结：\n\n",
+		articleItem:   "文章 %d:\n标题: %s\n内容摘要: %s\n\n",
+		notesIntro:    "以下是研究员在调研过程中记录的笔记，可作为分析线索，但所有论断仍须以文章内容为依据：\n",
+		domainReport: `你是一个资深行业分析师。请根据提供的文章内容，撰写一份该领域的深度总结报告。
+请务必严格按照以下 JSON 格式返回，不要包含任何 markdown 标记：
+{
+	"overview": "领域综述（Markdown格式，200字左右），总结当前领域的核心动态、热点话题。",
+	"key_events": [
+		{"event": "关键事件1", "sources": [1, 3]},
+		{"event": "关键事件2", "sources": [2]}
+	],
+	"trends": "趋势分析（Markdown格式，100-200字），基于新闻分析未来的技术或市场走向。每个论断后用 [文章序号] 标注出处，如：算力需求持续增长 [1][4]。",
+	"score": 8
+}
+引用说明：sources 为支撑该事件的文章序号数组（即上文“文章 N”中的 N），每个关键事件至少引用一篇文章；不得引用不存在的序号，不得编造文章中没有的信息。
+评分说明：score 为 1-10 的整数，代表该领域今日的重要程度和关注价值。
+文章可能来自多种语言，请综合全部来源，所有文本字段统一使用简体中文撰写。`,
+
+		analysisSection: "## 领域：%s (评分: %d)\n### 综述\n%s\n### 趋势\n%s\n### 关键事件\n- %s\n\n",
+		deepAnalysis: `Role: 资深技术顾问与个人发展战略专家
+Context
+用户画像：%s
+输入数据：这是一份多领域的每日新闻总结报告。
+核心诉求：请跨领域交叉分析，识别宏观趋势，并为用户提供战略建议。
+
+Instructions
+请严格按照 JSON 格式输出：
+{
+    "title": "根据今日所有领域内容生成一个吸引人的简短标题（20字以内）",
+    "macro_trends": "Markdown格式的核心趋势洞察...",
+    "opportunities": "Markdown格式的机遇挖掘...",
+    "risks": "Markdown格式的风险预警...",
+    "action_guides": ["行动建议1", "行动建议2", "行动建议3"]
+}
+
+输入的新闻总结数据：
+%s`,
+		customLensItem: "%d. 【%s】%s\n",
+		customLensesText: `
+
+除上述字段外，用户还定义了以下解读栏目：
+%s
+请在 JSON 中增加 "sections" 数组，按上述顺序为每个栏目输出一项，name 必须与栏目名称完全一致，content 为 Markdown 格式的解读内容：
+"sections": [{"name": "栏目名称", "content": "Markdown格式的栏目内容..."}]`,
+
+		verifyArticlesIntro: "以下是原始新闻文章：\n\n",
+		verifyArticleItem:   "文章 %d:\n标题: %s\n正文: %s\n\n",
+		verifyClaimsIntro:   "以下是待核验的论断：\n\n",
+		verifyClaimItem:     "论断 %d: %s\n",
+		verification: `你是一个严谨的事实核查员。请逐条判断上述论断能否由原始新闻文章支撑，尤其注意版本号、金额、日期、数量等具体事实是否与原文一致。
+请务必严格按照以下 JSON 格式返回，不要包含任何 markdown 标记：
+{
+	"verdicts": [
+		{"id": 1, "verdict": "supported", "reason": "文章 2 明确提到……"},
+		{"id": 2, "verdict": "unsupported", "reason": "原文未提及该融资金额"}
+	]
+}
+verdict 取值说明：
+- supported：论断的全部事实均能在原文中找到依据
+- partially_supported：论断的核心事实有依据，但部分细节无法在原文中找到或与原文不符
+- unsupported：原文中找不到依据，或与原文矛盾
+每条论断都必须给出结论，id 与论断序号一一对应。`,
+
+		researchSystem: `你是一名资深行业研究员，正在对领域【%s】做深度调研，时间范围为 %s 至 %s。
+请按以下方式工作：
+1. 使用 search 搜索领域动态，并针对发现的线索（公司、产品、事件、人物）继续追踪搜索；
+2. 使用 fetch_article 阅读最有价值的文章，被阅读的文章会成为最终报告的引用来源，最多收录 %d 篇；
+3. 使用 note 记录关键发现与尚待补充的信息缺口，并在后续步骤中设法补齐；
+4. 信息足够时停止调用工具，用一段话总结研究结论。
+你最多可以进行 %d 轮工具调用。`,
+		researchUser: "请开始调研领域【%s】。",
+
+		queryTranslation: `请将领域名称【%s】翻译为以下语言的新闻检索词：%s。
+要求：使用该语言新闻中最常用的行业说法，而不是逐字直译；每种语言只给出一个检索词。
+请务必严格按照以下 JSON 格式返回，key 为语言代码，不要包含任何 markdown 标记：
+{"queries": {"en": "large language models"}}`,
+
+		entityArticleItem: "文章 %d:\n标题: %s\n正文: %s\n\n",
+		entityExtraction: `请从上述文章中抽取被提及的命名实体，类型仅限：company（公司）、person（人物）、product（产品）、project（项目，含开源项目）、organization（机构、政府部门、标准组织等）。
+要求：
+1. name 使用实体最通用的规范名称，同一实体的不同写法（如 "英伟达" 与 "NVIDIA Corp."）合并为一条，原始写法放入 mentions；
+2. articles 为提及该实体的文章序号数组（即上文“文章 N”中的 N）；
+3. 只抽取文章中明确出现的实体，不得编造。
+请务必严格按照以下 JSON 格式返回，不要包含任何 markdown 标记：
+{
+	"entities": [
+		{"name": "NVIDIA", "type": "company", "mentions": ["英伟达", "NVIDIA Corp."], "articles": [1, 3]}
+	]
+}`,
+
+		interviewTurn: "问题 %d: %s\n回答: %s\n\n",
+		personaInterview: `你是一名职业发展顾问，正在通过简短访谈了解用户，以便为其定制每日行业资讯的深度解读。
+需要了解的信息：角色、资历、技术栈、目标、风险偏好、关注的时间跨度、约束条件（时间、预算、地域等）。
+
+以下是已完成的问答：
+%s
+如果信息仍不足且提问数少于 %d 个，请针对最关键的缺失信息提出下一个问题（简短、具体，一次只问一件事），返回：
+{"done": false, "question": "下一个问题"}
+否则结束访谈，根据回答整理画像并推荐 3-6 个值得关注的领域（适合作为新闻检索词），返回：
+{
+	"done": true,
+	"persona": {
+		"role": "角色",
+		"seniority": "资历",
+		"tech_stack": ["技术1", "技术2"],
+		"goals": ["目标1", "目标2"],
+		"risk_appetite": "low / medium / high 之一",
+		"time_horizon": "关注的时间跨度",
+		"constraints": ["约束1"]
+	},
+	"suggested_domains": ["领域1", "领域2", "领域3"]
+}
+回答中未提及的信息不要编造，留空即可。请使用简体中文，严格按照 JSON 格式返回，不要包含任何 markdown 标记。`,
+
+		languageReminder: "\n\n注意：JSON 中所有文本字段必须使用简体中文撰写，即使原文是其他语言。",
+	},
+	LanguageEN: {
+		language:   LanguageEN,
+		jsonSystem: "You are a JSON generator. Output the JSON string only.",
+
+		articlesIntro: "Below is a set of news articles about the domain \"%s\". Read and summarize them:\n\n",
+		articleItem:   "Article %d:\nTitle: %s\nContent: %s\n\n",
+		notesIntro:    "Below are notes taken by the researcher during the investigation. Use them as leads, but every claim must still be grounded in the articles:\n",
+		domainReport: `You are a senior industry analyst. Based on the articles provided, write an in-depth summary report for this domain in English.
+Return strictly the following JSON format, without any markdown fences:
+{
+	"overview": "Domain overview (Markdown, about 150 words) summarizing the key developments and hot topics in the domain.",
+	"key_events": [
+		{"event": "Key event 1", "sources": [1, 3]},
+		{"event": "Key event 2", "sources": [2]}
+	],
+	"trends": "Trend analysis (Markdown, 80-150 words) on where the technology or market is heading, based on the news. Cite the source after each claim as [article number], e.g.: Demand for compute keeps growing [1][4].",
+	"score": 8
+}
+Citations: sources is the array of article numbers supporting the event (the N in "Article N" above); every key event must cite at least one article. Never cite a number that does not exist and never invent information that is not in the articles.
+Score: an integer from 1 to 10 indicating how important and noteworthy the domain is today.
+Write every text field in English, even when the articles are in another language.`,
+
+		analysisSection: "## Domain: %s (score: %d)\n### Overview\n%s\n### Trends\n%s\n### Key events\n- %s\n\n",
+		deepAnalysis: `Role: Senior technology advisor and personal development strategist
+Context
+User persona: %s
+Input: a daily multi-domain news summary report.
+Goal: analyze across domains, identify macro trends, and give the user strategic advice.
+
+Instructions
+Write in English and output strictly the following JSON format:
+{
+    "title": "A short, catchy title for today's report across all domains (under 12 words)",
+    "macro_trends": "Key trend insights in Markdown...",
+    "opportunities": "Opportunities in Markdown...",
+    "risks": "Risk warnings in Markdown...",
+    "action_guides": ["Action 1", "Action 2", "Action 3"]
+}
+
+News summary input:
+%s`,
+		customLensItem: "%d. [%s] %s\n",
+		customLensesText: `
+
+In addition to the fields above, the user has defined the following analysis sections:
+%s
+Add a "sections" array to the JSON with one item per section in the order above. name must match the section name exactly, and content is the section body in Markdown:
+"sections": [{"name": "Section name", "content": "Section body in Markdown..."}]`,
+
+		verifyArticlesIntro: "Below are the original news articles:\n\n",
+		verifyArticleItem:   "Article %d:\nTitle: %s\nBody: %s\n\n",
+		verifyClaimsIntro:   "Below are the claims to verify:\n\n",
+		verifyClaimItem:     "Claim %d: %s\n",
+		verification: `You are a rigorous fact checker. For each claim above, decide whether it is supported by the original news articles, paying special attention to version numbers, amounts, dates and quantities.
+Return strictly the following JSON format, without any markdown fences:
+{
+	"verdicts": [
+		{"id": 1, "verdict": "supported", "reason": "Article 2 explicitly states ..."},
+		{"id": 2, "verdict": "unsupported", "reason": "The articles do not mention this funding amount"}
+	]
+}
+verdict values:
+- supported: every fact in the claim is backed by the articles
+- partially_supported: the core fact is backed, but some details cannot be found in or contradict the articles
+- unsupported: no support in the articles, or contradicted by them
+Give a verdict for every claim; id must match the claim number. Write reasons in English.`,
+
+		researchSystem: `You are a senior industry researcher doing an in-depth investigation of the domain "%s", covering %s to %s.
+Work as follows:
+1. Use search to find developments in the domain, and follow up on leads you discover (companies, products, events, people) with further searches;
+2. Use fetch_article to read the most valuable articles; articles you read become the citation sources of the final report, up to %d articles;
+3. Use note to record key findings and remaining information gaps, written in English, and try to fill the gaps in later steps;
+4. Stop calling tools once you have enough information, and summarize your conclusions in one paragraph.
+You may use at most %d rounds of tool calls.`,
+		researchUser: "Please start investigating the domain \"%s\".",
+
+		queryTranslation: `Translate the domain name "%s" into news search queries in the following languages: %s.
+Use the industry term most commonly found in news in that language rather than a word-for-word translation, and give exactly one query per language.
+Return strictly the following JSON format, keyed by language code, without any markdown fences:
+{"queries": {"zh": "大语言模型"}}`,
+
+		entityArticleItem: "Article %d:\nTitle: %s\nBody: %s\n\n",
+		entityExtraction: `Extract the named entities mentioned in the articles above. Allowed types only: company, person, product, project (including open-source projects), organization (institutions, government agencies, standards bodies, etc.).
+Requirements:
+1. name is the most widely used canonical name of the entity; merge different spellings of the same entity (e.g. "英伟达" and "NVIDIA Corp.") into one item and put the original spellings in mentions;
+2. articles is the array of article numbers mentioning the entity (the N in "Article N" above);
+3. Only extract entities that explicitly appear in the articles; never invent any.
+Return strictly the following JSON format, without any markdown fences:
+{
+	"entities": [
+		{"name": "NVIDIA", "type": "company", "mentions": ["英伟达", "NVIDIA Corp."], "articles": [1, 3]}
+	]
+}`,
+
+		interviewTurn: "Question %d: %s\nAnswer: %s\n\n",
+		personaInterview: `You are a career advisor running a short interview to understand the user, so that their daily industry news analysis can be tailored to them.
+Information needed: role, seniority, tech stack, goals, risk appetite, time horizon, and constraints (time, budget, location, etc.).
+
+Questions answered so far:
+%s
+If information is still missing and fewer than %d questions have been asked, ask the next question about the most important missing information (short and specific, one thing at a time), returning:
+{"done": false, "question": "Next question"}
+Otherwise end the interview, build the persona from the answers and suggest 3-6 domains worth following (usable as news search queries), returning:
+{
+	"done": true,
+	"persona": {
+		"role": "Role",
+		"seniority": "Seniority",
+		"tech_stack": ["Tech 1", "Tech 2"],
+		"goals": ["Goal 1", "Goal 2"],
+		"risk_appetite": "one of low / medium / high",
+		"time_horizon": "Time horizon",
+		"constraints": ["Constraint 1"]
+	},
+	"suggested_domains": ["Domain 1", "Domain 2", "Domain 3"]
+}
+Do not invent information the user has not given; leave such fields empty. Write in English and return strictly the JSON format, without any markdown fences.`,
+
+		languageReminder: "\n\nNote: every text field in the JSON must be written in English, even when the articles are in another language.",
+	},
+}
+
+// NormalizeLanguage 返回受支持的报告语言，不支持或为空时返回 fallback
+func NormalizeLanguage(lang, fallback string) string {
+	if _, ok := promptSets[lang]; ok {
+		return lang
+	}
+	return fallback
+}
+
+type languageKey struct{}
+
+// withLanguage 在 context 中设置报告语言
+func withLanguage(ctx context.Context, lang string) context.Context {
+	return context.WithValue(ctx, languageKey{}, lang)
+}
+
+// promptsFrom 返回 context 中报告语言对应的提示词，默认中文
+func promptsFrom(ctx context.Context) *promptSet {
+	lang, _ := ctx.Value(languageKey{}).(string)
+	if p, ok := promptSets[lang]; ok {
+		return p
+	}
+	return promptSets[LanguageZH]
+}
+
+// detectLanguage 根据汉字在字母类字符中的占比粗略判断文本语言，无法判断时返回空串
+func detectLanguage(text string) string {
+	var han, letters int
+	for _, r := range text {
+		if !unicode.IsLetter(r) {
+			continue
+		}
+		letters++
+		if unicode.Is(unicode.Han, r) {
+			han++
+		}
+	}
+	if letters == 0 {
+		return ""
+	}
+	if float64(han)/float64(letters) >= 0.3 {
+		return LanguageZH
+	}
+	return LanguageEN
+}
+
+// matchesLanguage 判断文本是否为指定语言，无法判断时视为符合
+func matchesLanguage(text, lang string) bool {
+	detected := detectLanguage(text)
+	return detected == "" || detected == lang
+}
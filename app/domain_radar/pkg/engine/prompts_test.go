@@ -0,0 +1,28 @@
+package engine
+
+import "testing"
+
+func TestDetectLanguage(t *testing.T) {
+	tests := []struct {
+		text string
+		want string
+	}{
+		{"OpenAI 发布了新一代 GPT 模型，推理能力显著提升 [1]。", LanguageZH},
+		{"Nvidia reported record data center revenue, driven by demand for 英伟达 H100 [2].", LanguageEN},
+		{"[1][2] 2025-01-01", ""},
+	}
+	for _, tt := range tests {
+		if got := detectLanguage(tt.text); got != tt.want {
+			t.Errorf("detectLanguage(%q) = %q, want %q", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestNormalizeLanguage(t *testing.T) {
+	if got := NormalizeLanguage("en", LanguageZH); got != LanguageEN {
+		t.Errorf("NormalizeLanguage(en) = %q, want en", got)
+	}
+	if got := NormalizeLanguage("fr", LanguageZH); got != LanguageZH {
+		t.Errorf("NormalizeLanguage(fr) = %q, want zh", got)
+	}
+}
@@ -0,0 +1,278 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/components/tool"
+	"github.com/cloudwego/eino/components/tool/utils"
+	"github.com/cloudwego/eino/compose"
+	"github.com/cloudwego/eino/schema"
+
+	"github.com/iWorld-y/domain_radar/app/domain_radar/pkg/logger"
+	dm "github.com/iWorld-y/domain_radar/app/domain_radar/pkg/model"
+	"github.com/iWorld-y/domain_radar/app/domain_radar/pkg/search"
+)
+
+const (
+	// defaultResearchSteps 未配置时深度研究的最大迭代轮数
+	defaultResearchSteps = 8
+	// maxResearchArticles 深度研究最多收录的文章数，即报告可引用的文章上限
+	maxResearchArticles = 10
+	// researchSearchLimit 单次 search 工具调用返回的结果数
+	researchSearchLimit = 8
+	// researchSnippetLen search 工具返回的摘要长度（字符）
+	researchSnippetLen = 300
+	// researchArticleLen fetch_article 工具返回给模型的正文长度（字符）
+	researchArticleLen = 3000
+	// researchContentLimit 收录文章保存的正文长度（字符）
+	researchContentLimit = 5000
+)
+
+// ResearchOptions 单领域深度研究选项
+type ResearchOptions struct {
+	MaxSteps int // 最大迭代轮数，0 时使用配置默认值，且不超过配置上限
+}
+
+// researchNotebook 深度研究过程中收集的文章与笔记，供工具间共享
+type researchNotebook struct {
+	mu       sync.Mutex
+	domain   string
+	seen     map[string]search.Result // 已搜索到的结果，按 URL 索引
+	articles []dm.Article
+	notes    []string
+}
+
+func newResearchNotebook(domain string) *researchNotebook {
+	return &researchNotebook{domain: domain, seen: make(map[string]search.Result)}
+}
+
+// addArticle 收录文章并返回其序号，已收录时返回原序号；达到上限时返回 0
+func (n *researchNotebook) addArticle(url, content string) int {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	for i, a := range n.articles {
+		if a.Link == url {
+			return i + 1
+		}
+	}
+	if len(n.articles) >= maxResearchArticles {
+		return 0
+	}
+	r := n.seen[url]
+	title := r.Title
+	if title == "" {
+		title = url
+	}
+	n.articles = append(n.articles, dm.Article{
+		Title:   title,
+		Link:    url,
+		Source:  n.domain,
+		PubDate: r.PublishedDate,
+		Content: content,
+	})
+	return len(n.articles)
+}
+
+func (n *researchNotebook) addNote(note string) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.notes = append(n.notes, note)
+}
+
+func (n *researchNotebook) snapshot() ([]dm.Article, []string) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return append([]dm.Article(nil), n.articles...), append([]string(nil), n.notes...)
+}
+
+type researchSearchInput struct {
+	Query string `json:"query" jsonschema:"description=搜索关键词，可以是领域内的具体公司、产品、事件或人物"`
+}
+
+type researchFetchInput struct {
+	URL string `json:"url" jsonschema:"description=要阅读的文章链接，必须来自 search 的结果"`
+}
+
+type researchNoteInput struct {
+	Content string `json:"content" jsonschema:"description=要记录的发现、线索或待补充的信息缺口，注明来源文章序号"`
+}
+
+// researchTools 基于现有搜索与正文抓取能力构建深度研究工具
+func (e *Engine) researchTools(s *domainState, nb *researchNotebook) ([]tool.BaseTool, error) {
+	searchTool, err := utils.InferTool("search", "搜索近期新闻，返回标题、链接与摘要",
+		func(ctx context.Context, in *researchSearchInput) (string, error) {
+			recordEvent(ctx, dm.EventSearchIssued, in.Query, map[string]any{"start_date": s.startDate, "end_date": s.endDate})
+			resp, err := e.search(ctx, &search.Request{
+				Query:        in.Query,
+				Topic:        "news",
+				MaxResults:   researchSearchLimit,
+				StartDate:    s.startDate,
+				EndDate:      s.endDate,
+				IncludeSites: s.config.IncludeSites,
+				ExcludeSites: s.config.ExcludeSites,
+			})
+			if err != nil {
+				recordEvent(ctx, dm.EventSearchFailed, err.Error(), map[string]any{"query": in.Query})
+				return fmt.Sprintf("搜索失败: %v", err), nil
+			}
+			count := len(resp.Results)
+			resp.Results = filterWindow(filterSites(resp.Results, s.config), s.windowStart, s.windowEnd)
+			recordEvent(ctx, dm.EventSearchResults, in.Query, map[string]any{"count": count, "kept": len(resp.Results)})
+			if len(resp.Results) == 0 {
+				return "没有找到相关结果，请换一个关键词", nil
+			}
+			var sb strings.Builder
+			nb.mu.Lock()
+			for i, r := range resp.Results {
+				nb.seen[r.URL] = r
+				fmt.Fprintf(&sb, "%d. %s\n链接: %s\n发布时间: %s\n摘要: %s\n\n", i+1, r.Title, r.URL, r.PublishedDate, truncateRunes(r.Content, researchSnippetLen))
+			}
+			nb.mu.Unlock()
+			return sb.String(), nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	fetchTool, err := utils.InferTool("fetch_article", "阅读文章全文并收录为报告的引用来源，返回文章序号与正文",
+		func(ctx context.Context, in *researchFetchInput) (string, error) {
+			// 只读取 search 返回过的链接，避免模型或文章正文中注入的指令让服务端访问任意地址
+			nb.mu.Lock()
+			r, ok := nb.seen[in.URL]
+			nb.mu.Unlock()
+			if !ok {
+				recordEvent(ctx, dm.EventArticleRejected, in.URL, map[string]any{"reason": "link not from search results"})
+				return "只能阅读 search 结果中的链接，请先通过 search 找到文章", nil
+			}
+			content := r.Content
+			if len(content) < 500 {
+				fetched, err := e.fetchPage(ctx, in.URL)
+				if err == nil && len(fetched) > len(content) {
+					content = fetched
+				}
+			}
+			content = truncateRunes(content, researchContentLimit)
+			if n := utf8.RuneCountInString(content); n <= 100 {
+				recordEvent(ctx, dm.EventArticleRejected, in.URL, map[string]any{"reason": fmt.Sprintf("content too short (%d characters)", n)})
+				return "文章正文过短，无法收录", nil
+			}
+			recordEvent(ctx, dm.EventArticleFetched, in.URL, map[string]any{"length": len(content)})
+			id := nb.addArticle(in.URL, content)
+			if id == 0 {
+				return fmt.Sprintf("已收录 %d 篇文章，达到上限，请停止阅读并整理结论", maxResearchArticles), nil
+			}
+			return fmt.Sprintf("已收录为文章 %d\n正文: %s", id, truncateRunes(content, researchArticleLen)), nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	noteTool, err := utils.InferTool("note", "记录研究发现或尚待补充的信息缺口，最终报告会参考这些笔记",
+		func(ctx context.Context, in *researchNoteInput) (string, error) {
+			nb.addNote(in.Content)
+			if s.run.opts.StreamCallback != nil {
+				_, notes := nb.snapshot()
+				s.run.opts.StreamCallback(PartialOutput{Domain: s.domain, Stage: stageResearch, Text: "- " + strings.Join(notes, "\n- ")})
+			}
+			return "已记录", nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	// 参数解析失败等错误以文本形式返回给模型，由模型修正后重试，不中断研究
+	onError := func(ctx context.Context, err error) string {
+		return fmt.Sprintf("工具调用失败: %v", err)
+	}
+	return []tool.BaseTool{
+		utils.WrapToolWithErrorHandler(searchTool, onError),
+		utils.WrapToolWithErrorHandler(fetchTool, onError),
+		utils.WrapToolWithErrorHandler(noteTool, onError),
+	}, nil
+}
+
+// researchNode 深度研究：模型在有限轮数内调用工具搜索、阅读并记录发现，替代固定的搜索与抓取节点
+func (e *Engine) researchNode(ctx context.Context, s *domainState) (*domainState, error) {
+	ctx = withStage(ctx, stageResearch)
+	nb := newResearchNotebook(s.domain)
+	tools, err := e.researchTools(s, nb)
+	if err != nil {
+		return nil, fmt.Errorf("build research tools: %w", err)
+	}
+	toolsNode, err := compose.NewToolNode(ctx, &compose.ToolsNodeConfig{Tools: tools})
+	if err != nil {
+		return nil, fmt.Errorf("build tools node: %w", err)
+	}
+	var toolInfos []*schema.ToolInfo
+	for _, t := range tools {
+		info, err := t.Info(ctx)
+		if err != nil {
+			return nil, err
+		}
+		toolInfos = append(toolInfos, info)
+	}
+
+	maxSteps := e.researchSteps(s.run.opts.Research)
+	p := promptsFrom(ctx)
+	messages := []*schema.Message{
+		{Role: schema.System, Content: fmt.Sprintf(p.researchSystem, s.domain, s.startDate, s.endDate, maxResearchArticles, maxSteps)},
+		{Role: schema.User, Content: fmt.Sprintf(p.researchUser, s.domain)},
+	}
+
+	for step := 1; step <= maxSteps; step++ {
+		if err := e.limiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+		resp, err := e.chatModel.Generate(ctx, messages, model.WithTools(toolInfos))
+		if err != nil {
+			return nil, &domainError{status: dm.DomainStatusLLMFailed, err: fmt.Errorf("research step %d: %w", step, err)}
+		}
+		messages = append(messages, resp)
+		if len(resp.ToolCalls) == 0 {
+			break
+		}
+
+		results, err := toolsNode.Invoke(ctx, resp)
+		if err != nil {
+			return nil, fmt.Errorf("research step %d tools: %w", step, err)
+		}
+		messages = append(messages, results...)
+
+		articles, notes := nb.snapshot()
+		logger.Log.Infof("领域 [%s] 深度研究第 %d/%d 轮：已收录 %d 篇文章，%d 条笔记", s.domain, step, maxSteps, len(articles), len(notes))
+		if s.run.opts.ProgressCallback != nil {
+			s.run.opts.ProgressCallback(fmt.Sprintf("researching %s: step %d/%d", s.domain, step, maxSteps), 10+step*40/maxSteps)
+		}
+	}
+
+	s.articles, s.notes = nb.snapshot()
+	if len(s.articles) < 1 {
+		return nil, errNoArticles
+	}
+	return s, nil
+}
+
+// researchSteps 返回本次深度研究的最大轮数
+func (e *Engine) researchSteps(opts *ResearchOptions) int {
+	limit := e.cfg.Research.MaxSteps
+	if limit <= 0 {
+		limit = defaultResearchSteps
+	}
+	if opts == nil || opts.MaxSteps <= 0 || opts.MaxSteps > limit {
+		return limit
+	}
+	return opts.MaxSteps
+}
+
+// truncateRunes 按字符截断文本
+func truncateRunes(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	return string([]rune(s)[:n])
+}
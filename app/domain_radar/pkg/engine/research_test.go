@@ -0,0 +1,106 @@
+package engine
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/schema"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/time/rate"
+
+	"github.com/iWorld-y/domain_radar/app/domain_radar/pkg/config"
+	"github.com/iWorld-y/domain_radar/app/domain_radar/pkg/logger"
+	dm "github.com/iWorld-y/domain_radar/app/domain_radar/pkg/model"
+)
+
+// scriptedToolModel 按预设脚本依次返回工具调用，脚本用完后给出最终结论
+type scriptedToolModel struct {
+	model.ChatModel
+	steps [][]schema.ToolCall
+	calls int
+}
+
+func (m *scriptedToolModel) Generate(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.Message, error) {
+	if len(model.GetCommonOptions(nil, opts...).Tools) == 0 {
+		panic("research call without tools")
+	}
+	m.calls++
+	if m.calls > len(m.steps) {
+		return &schema.Message{Role: schema.Assistant, Content: "调研结束"}, nil
+	}
+	return &schema.Message{Role: schema.Assistant, ToolCalls: m.steps[m.calls-1]}, nil
+}
+
+func toolCall(id, name, args string) schema.ToolCall {
+	return schema.ToolCall{ID: id, Function: schema.FunctionCall{Name: name, Arguments: args}}
+}
+
+func TestResearchNode(t *testing.T) {
+	logger.Log = logrus.New()
+	logger.Log.SetOutput(io.Discard)
+
+	// 不在搜索结果中的链接不应被访问
+	var unknownHits atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		unknownHits.Add(1)
+	}))
+	defer srv.Close()
+
+	cm := &scriptedToolModel{steps: [][]schema.ToolCall{
+		{toolCall("1", "search", `{"query": "AI chips"}`)},
+		{
+			toolCall("2", "fetch_article", `{"url": "https://example.com/AI chips"}`),
+			toolCall("3", "note", `{"content": "算力需求持续增长 [1]"}`),
+			toolCall("4", "fetch_article", `{"url": 42}`),
+			toolCall("5", "fetch_article", `{"url": "`+srv.URL+`/internal"}`),
+		},
+	}}
+	e := &Engine{
+		cfg:       &config.Config{},
+		chatModel: cm,
+		searcher:  stubSearcher{},
+		limiter:   rate.NewLimiter(rate.Inf, 1),
+	}
+	s := &domainState{
+		run:    &runState{opts: RunOptions{Domains: dm.DomainNames([]string{"AI"}), Research: &ResearchOptions{MaxSteps: 5}}},
+		domain: "AI",
+	}
+
+	out, err := e.researchNode(context.Background(), s)
+	if err != nil {
+		t.Fatalf("researchNode() error = %v", err)
+	}
+	if cm.calls != 3 {
+		t.Errorf("model calls = %d, want 3", cm.calls)
+	}
+	if len(out.articles) != 1 || out.articles[0].Title != "AI chips news" {
+		t.Errorf("articles = %+v, want the fetched search result", out.articles)
+	}
+	if n := unknownHits.Load(); n != 0 {
+		t.Errorf("requests to a link outside search results = %d, want 0", n)
+	}
+	if len(out.notes) != 1 {
+		t.Errorf("notes = %v, want 1 note", out.notes)
+	}
+}
+
+func TestResearchSteps(t *testing.T) {
+	e := &Engine{cfg: &config.Config{Research: config.ResearchConfig{MaxSteps: 6}}}
+	for _, tt := range []struct {
+		opts *ResearchOptions
+		want int
+	}{
+		{nil, 6},
+		{&ResearchOptions{MaxSteps: 3}, 3},
+		{&ResearchOptions{MaxSteps: 20}, 6},
+	} {
+		if got := e.researchSteps(tt.opts); got != tt.want {
+			t.Errorf("researchSteps(%+v) = %d, want %d", tt.opts, got, tt.want)
+		}
+	}
+}
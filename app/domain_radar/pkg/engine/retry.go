@@ -0,0 +1,67 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/iWorld-y/domain_radar/app/common/ent"
+	dm "github.com/iWorld-y/domain_radar/app/domain_radar/pkg/model"
+)
+
+// ErrRunNotFound 要重试的运行记录不存在或不属于该用户
+var ErrRunNotFound = errors.New("run not found")
+
+// ErrNothingToRetry 运行记录中没有失败或缺失的领域
+var ErrNothingToRetry = errors.New("no failed or missing domains to retry")
+
+// RetryDomains 返回运行记录中需要重试的领域：domains 中启用的领域与记录中处理过的领域里尚未生成报告的部分，
+// 保持 domains 中的顺序；记录中处理过但已不在 domains 中的领域按默认配置重试
+func (e *Engine) RetryDomains(ctx context.Context, runID, userID int, domains []dm.DomainConfig) ([]dm.DomainConfig, error) {
+	_, retry, err := e.retryPlan(ctx, runID, userID, domains)
+	return retry, err
+}
+
+// retryPlan 载入要重试的运行记录并计算需要重试的领域
+func (e *Engine) retryPlan(ctx context.Context, runID, userID int, domains []dm.DomainConfig) (*ent.ReportRun, []dm.DomainConfig, error) {
+	if e.store == nil {
+		return nil, nil, fmt.Errorf("retrying a run requires a database")
+	}
+	run, err := e.store.GetRun(ctx, runID)
+	if ent.IsNotFound(err) {
+		return nil, nil, ErrRunNotFound
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	if run.UserID != userID {
+		return nil, nil, ErrRunNotFound
+	}
+
+	done := make(map[string]bool, len(run.Edges.DomainReports))
+	for _, dr := range run.Edges.DomainReports {
+		done[dr.DomainName] = true
+	}
+	var retry []dm.DomainConfig
+	add := func(d dm.DomainConfig) {
+		if !done[d.Name] {
+			done[d.Name] = true
+			retry = append(retry, d)
+		}
+	}
+	for _, d := range domains {
+		if d.Enabled {
+			add(d)
+		} else {
+			// 已停用的领域不再重试
+			done[d.Name] = true
+		}
+	}
+	for _, dr := range run.Edges.DomainRuns {
+		add(dm.DomainConfig{Name: dr.DomainName, Enabled: true})
+	}
+	if len(retry) == 0 {
+		return nil, nil, ErrNothingToRetry
+	}
+	return run, retry, nil
+}
@@ -0,0 +1,145 @@
+package engine
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/iWorld-y/domain_radar/app/domain_radar/pkg/logger"
+	dm "github.com/iWorld-y/domain_radar/app/domain_radar/pkg/model"
+)
+
+// defaultShareMaxAge 未配置时可复用领域报告的最长生成时间
+const defaultShareMaxAge = 6 * time.Hour
+
+// sharingEnabled 判断本次运行是否复用其他运行生成的领域报告：批量运行总是复用预先生成的报告，
+// 其余运行需要数据库，深度研究与强制刷新缓存时总是重新生成
+func (e *Engine) sharingEnabled(opts RunOptions) bool {
+	if opts.prepared != nil {
+		return true
+	}
+	return (e.cfg.Sharing.Enabled || opts.Share) && e.store != nil && opts.Research == nil && opts.Cache != CacheRefresh
+}
+
+// shareKey 计算领域报告的共享键：归一化后的领域配置中影响报告内容的部分、时间范围的起点与终点日期、
+// 报告语言、可选阶段与领域报告提示词版本均相同时，报告可以共享
+func (e *Engine) shareKey(ctx context.Context, s *domainState) string {
+	d := s.config
+	maxArticles := d.MaxArticles
+	if maxArticles <= 0 {
+		maxArticles = maxArticlesPerDomain
+	}
+	lang, _ := ctx.Value(languageKey{}).(string)
+	key, _ := json.Marshal(struct {
+		Domain       string   `json:"domain"`
+		Queries      []string `json:"queries"`
+		IncludeSites []string `json:"include_sites"`
+		ExcludeSites []string `json:"exclude_sites"`
+		Languages    []string `json:"languages"`
+		MaxArticles  int      `json:"max_articles"`
+		Start        string   `json:"start"`
+		EndDate      string   `json:"end_date"`
+		Language     string   `json:"language"`
+		Stages       []string `json:"stages"`
+		Version      string   `json:"version"`
+	}{
+		Domain:       strings.ToLower(d.Name),
+		Queries:      d.Queries,
+		IncludeSites: d.IncludeSites,
+		ExcludeSites: d.ExcludeSites,
+		Languages:    e.searchLanguages(d),
+		MaxArticles:  maxArticles,
+		Start:        s.windowStart.UTC().Format(time.RFC3339),
+		EndDate:      s.endDate,
+		Language:     lang,
+		Stages:       e.domainStageNames(s.run.opts),
+		Version:      promptVersions[stageDomainReport],
+	})
+	sum := sha256.Sum256(key)
+	return hex.EncodeToString(sum[:])
+}
+
+// shareNode 查找可复用的领域报告，找到时跳过生成直接加入本次运行。批量运行优先使用预先生成的结果；
+// 查询数据库前锁定共享键，同一进程内同时处理相同领域的运行会等待先行者生成报告后复用
+func (e *Engine) shareNode(ctx context.Context, s *domainState) (*domainState, error) {
+	s.shareKey = e.shareKey(ctx, s)
+	if p, ok := s.run.opts.prepared[s.shareKey]; ok {
+		if p.err != nil {
+			return nil, p.err
+		}
+		s.report, s.articles, s.shared = p.report, p.report.Articles, true
+		return s, nil
+	}
+	if e.store == nil {
+		return s, nil
+	}
+	s.release = e.shareLocks.lock(s.shareKey)
+
+	report, err := e.findSharedReport(ctx, s.shareKey)
+	if err != nil {
+		logger.Log.Warnf("查询领域 [%s] 可复用的报告失败，重新生成: %v", s.domain, err)
+		return s, nil
+	}
+	if report != nil {
+		logger.Log.Infof("领域 [%s] 复用已生成的报告 %d", s.domain, report.ID)
+		s.report, s.articles, s.shared = report, report.Articles, true
+	}
+	return s, nil
+}
+
+// findSharedReport 查找共享键相同且未过期的领域报告，没有时返回 nil
+func (e *Engine) findSharedReport(ctx context.Context, key string) (*dm.DomainReport, error) {
+	maxAge := time.Duration(e.cfg.Sharing.MaxAgeHours) * time.Hour
+	if maxAge <= 0 {
+		maxAge = defaultShareMaxAge
+	}
+	return e.store.FindSharedDomainReport(ctx, key, time.Now().Add(-maxAge))
+}
+
+// shareBranch 根据是否找到可复用的报告选择后续分支
+func shareBranch(ctx context.Context, s *domainState) (string, error) {
+	if s.shared {
+		return branchReuse, nil
+	}
+	return branchGenerate, nil
+}
+
+// keyedMutex 按 key 加锁，不再使用的 key 会被清理
+type keyedMutex struct {
+	mu    sync.Mutex
+	locks map[string]*keyedLock
+}
+
+type keyedLock struct {
+	sync.Mutex
+	refs int
+}
+
+// lock 锁定 key 并返回解锁函数
+func (m *keyedMutex) lock(key string) func() {
+	m.mu.Lock()
+	if m.locks == nil {
+		m.locks = make(map[string]*keyedLock)
+	}
+	l, ok := m.locks[key]
+	if !ok {
+		l = &keyedLock{}
+		m.locks[key] = l
+	}
+	l.refs++
+	m.mu.Unlock()
+
+	l.Lock()
+	return func() {
+		l.Unlock()
+		m.mu.Lock()
+		if l.refs--; l.refs == 0 {
+			delete(m.locks, key)
+		}
+		m.mu.Unlock()
+	}
+}
@@ -0,0 +1,62 @@
+package engine
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/iWorld-y/domain_radar/app/domain_radar/pkg/config"
+	dm "github.com/iWorld-y/domain_radar/app/domain_radar/pkg/model"
+)
+
+func TestShareKey(t *testing.T) {
+	e := &Engine{cfg: &config.Config{Search: config.SearchConfig{Languages: []string{"zh", "en"}}}}
+	run := &runState{}
+	key := func(d dm.DomainConfig, start, end string) string {
+		s := &domainState{run: run, config: d}
+		startTime, _ := time.Parse(time.DateTime, start)
+		endTime, _ := time.Parse(time.DateTime, end)
+		s.setWindow(startTime, endTime)
+		return e.shareKey(context.Background(), s)
+	}
+
+	base := key(dm.DomainConfig{Name: "AI", Enabled: true}, "2026-10-01 08:00:00", "2026-10-08 08:00:00")
+	// 展示名称与优先级权重不影响报告内容，领域名称不区分大小写，未设置的语言与文章数按默认值计算，
+	// 时间范围终点只比较日期
+	same := key(dm.DomainConfig{Name: "ai", DisplayName: "人工智能", Weight: 2, Languages: []string{"zh", "en"}, MaxArticles: maxArticlesPerDomain}, "2026-10-01 08:00:00", "2026-10-08 09:30:00")
+	if same != base {
+		t.Errorf("shareKey() = %s, want %s", same, base)
+	}
+	for name, got := range map[string]string{
+		"window":    key(dm.DomainConfig{Name: "AI"}, "2026-10-01 09:00:00", "2026-10-08 08:00:00"),
+		"queries":   key(dm.DomainConfig{Name: "AI", Queries: []string{"AI agents"}}, "2026-10-01 08:00:00", "2026-10-08 08:00:00"),
+		"sites":     key(dm.DomainConfig{Name: "AI", IncludeSites: []string{"36kr.com"}}, "2026-10-01 08:00:00", "2026-10-08 08:00:00"),
+		"languages": key(dm.DomainConfig{Name: "AI", Languages: []string{"en"}}, "2026-10-01 08:00:00", "2026-10-08 08:00:00"),
+	} {
+		if got == base {
+			t.Errorf("shareKey() with different %s = base key, want different", name)
+		}
+	}
+}
+
+func TestKeyedMutex(t *testing.T) {
+	var m keyedMutex
+	release := m.lock("a")
+	locked := make(chan struct{})
+	go func() {
+		m.lock("a")()
+		close(locked)
+	}()
+	// 不同的 key 互不阻塞
+	m.lock("b")()
+	select {
+	case <-locked:
+		t.Fatal("lock() acquired a held key")
+	default:
+	}
+	release()
+	<-locked
+	if len(m.locks) != 0 {
+		t.Errorf("locks = %v, want empty after release", m.locks)
+	}
+}
@@ -0,0 +1,76 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cloudwego/eino/callbacks"
+	"github.com/cloudwego/eino/compose"
+
+	"github.com/iWorld-y/domain_radar/app/domain_radar/pkg/logger"
+)
+
+type nodeStartKey struct{}
+
+// newPipelineHandler 构建流水线回调：记录各节点的耗时与错误，并根据节点完成情况上报进度
+func newPipelineHandler(opts RunOptions) callbacks.Handler {
+	var mu sync.Mutex
+	completedDomains := 0
+	totalDomains := len(opts.Domains)
+
+	progress := func(status string, percent int) {
+		if opts.ProgressCallback != nil {
+			opts.ProgressCallback(status, percent)
+		}
+	}
+
+	return callbacks.NewHandlerBuilder().
+		OnStartFn(func(ctx context.Context, info *callbacks.RunInfo, input callbacks.CallbackInput) context.Context {
+			if info.Component != compose.ComponentOfLambda {
+				return ctx
+			}
+			if info.Name == nodeDeepAnalysis {
+				progress("generating deep analysis", 85)
+			}
+			return context.WithValue(ctx, nodeStartKey{}, time.Now())
+		}).
+		OnEndFn(func(ctx context.Context, info *callbacks.RunInfo, output callbacks.CallbackOutput) context.Context {
+			if info.Component != compose.ComponentOfLambda {
+				return ctx
+			}
+			domain := callInfoFrom(ctx).Domain
+			if domain != "" {
+				logger.Log.Debugf("[pipeline] 领域 [%s] 节点 %s 完成，耗时 %s", domain, info.Name, nodeElapsed(ctx))
+			} else {
+				logger.Log.Infof("[pipeline] 节点 %s 完成，耗时 %s", info.Name, nodeElapsed(ctx))
+			}
+
+			if info.Name == nodePersist {
+				mu.Lock()
+				completedDomains++
+				percent := 10 + int(float64(completedDomains)/float64(totalDomains)*70) // 10% -> 80%
+				progress(fmt.Sprintf("processed domain: %s", domain), percent)
+				mu.Unlock()
+			}
+			return ctx
+		}).
+		OnErrorFn(func(ctx context.Context, info *callbacks.RunInfo, err error) context.Context {
+			if info.Component != compose.ComponentOfLambda {
+				return ctx
+			}
+			logger.Log.Warnf("[pipeline] 领域 [%s] 节点 %s 失败，耗时 %s: %v", callInfoFrom(ctx).Domain, info.Name, nodeElapsed(ctx), err)
+			return ctx
+		}).
+		Build()
+}
+
+// nodeElapsed 返回节点开始执行至今的耗时
+func nodeElapsed(ctx context.Context) time.Duration {
+	start, ok := ctx.Value(nodeStartKey{}).(time.Time)
+	if !ok {
+		return 0
+	}
+	return time.Since(start).Round(time.Millisecond)
+}
@@ -0,0 +1,239 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/schema"
+
+	"github.com/iWorld-y/domain_radar/app/domain_radar/pkg/config"
+	"github.com/iWorld-y/domain_radar/app/domain_radar/pkg/logger"
+	dm "github.com/iWorld-y/domain_radar/app/domain_radar/pkg/model"
+)
+
+// 流水线阶段，用于 LLM 用量统计
+const (
+	stageDomainReport     = "domain_report"
+	stageVerification     = "verification"
+	stageDeepAnalysis     = "deep_analysis"
+	stageResearch         = "research"
+	stageQueryTranslation = "query_translation"
+	stageEntities         = "entities"
+	stagePersonaInterview = "persona_interview"
+)
+
+// UsageRecorder 记录 LLM 调用用量
+type UsageRecorder interface {
+	SaveLLMCall(ctx context.Context, call *dm.LLMCall) error
+}
+
+type callInfoKey struct{}
+
+// callInfo 标识一次 LLM 调用的归属
+type callInfo struct {
+	RunID  int
+	UserID int
+	Domain string
+	Stage  string
+}
+
+func callInfoFrom(ctx context.Context) callInfo {
+	info, _ := ctx.Value(callInfoKey{}).(callInfo)
+	return info
+}
+
+// withRun 在 context 中标记调用所属的运行记录与用户
+func withRun(ctx context.Context, runID, userID int) context.Context {
+	info := callInfoFrom(ctx)
+	info.RunID, info.UserID = runID, userID
+	return context.WithValue(ctx, callInfoKey{}, info)
+}
+
+// withDomain 在 context 中标记调用所属的领域
+func withDomain(ctx context.Context, domain string) context.Context {
+	info := callInfoFrom(ctx)
+	info.Domain = domain
+	return context.WithValue(ctx, callInfoKey{}, info)
+}
+
+// withStage 在 context 中标记调用所属的流水线阶段
+func withStage(ctx context.Context, stage string) context.Context {
+	info := callInfoFrom(ctx)
+	info.Stage = stage
+	return context.WithValue(ctx, callInfoKey{}, info)
+}
+
+// meteredChatModel 记录每次调用的 token 用量、费用与耗时，并在调用前执行预算检查
+type meteredChatModel struct {
+	model.ChatModel
+	defaultModel string
+	cheapModel   string // 预算紧张时切换的低价模型，为空时不切换
+	prices       config.PriceTable
+	recorder     UsageRecorder
+}
+
+func newMeteredChatModel(cm model.ChatModel, cfg config.LLMConfig, recorder UsageRecorder) *meteredChatModel {
+	return &meteredChatModel{
+		ChatModel:    cm,
+		defaultModel: cfg.Model,
+		cheapModel:   cfg.CheapModel,
+		prices:       cfg.Prices,
+		recorder:     recorder,
+	}
+}
+
+// applyBudget 根据本次运行的预算使用情况选择模型，按预估用量占用预算，并拒绝会超出预算的调用
+func (m *meteredChatModel) applyBudget(ctx context.Context, input []*schema.Message, opts []model.Option) ([]model.Option, reservation, error) {
+	opts = m.selectModel(ctx, opts)
+	t := budgetFrom(ctx)
+	if t == nil {
+		return opts, reservation{}, nil
+	}
+	promptTokens := estimatePromptTokens(input)
+	cost := m.prices.Cost(m.modelName(opts), promptTokens, estimatedCompletionTokens)
+	res, err := t.reserve(promptTokens+estimatedCompletionTokens, cost)
+	if err != nil {
+		return nil, reservation{}, err
+	}
+	return opts, res, nil
+}
+
+// Generate implements model.ChatModel
+func (m *meteredChatModel) Generate(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.Message, error) {
+	opts, res, err := m.applyBudget(ctx, input, opts)
+	if err != nil {
+		return nil, err
+	}
+	m.recordStart(ctx, opts)
+	start := time.Now()
+	resp, err := m.ChatModel.Generate(ctx, input, opts...)
+	var usage *schema.TokenUsage
+	if resp != nil && resp.ResponseMeta != nil {
+		usage = resp.ResponseMeta.Usage
+	}
+	m.record(ctx, m.modelName(opts), res, usage, time.Since(start), err)
+	return resp, err
+}
+
+// Stream implements model.ChatModel，在流结束时记录用量
+func (m *meteredChatModel) Stream(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.StreamReader[*schema.Message], error) {
+	opts, res, err := m.applyBudget(ctx, input, opts)
+	if err != nil {
+		return nil, err
+	}
+	m.recordStart(ctx, opts)
+	start := time.Now()
+	name := m.modelName(opts)
+	sr, err := m.ChatModel.Stream(ctx, input, opts...)
+	if err != nil {
+		m.record(ctx, name, res, nil, time.Since(start), err)
+		return nil, err
+	}
+
+	out, writer := schema.Pipe[*schema.Message](1)
+	go func() {
+		defer sr.Close()
+		defer writer.Close()
+
+		var usage *schema.TokenUsage
+		var streamErr error
+		for {
+			chunk, err := sr.Recv()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				streamErr = err
+				writer.Send(nil, err)
+				break
+			}
+			if chunk.ResponseMeta != nil && chunk.ResponseMeta.Usage != nil {
+				usage = chunk.ResponseMeta.Usage
+			}
+			if closed := writer.Send(chunk, nil); closed {
+				break
+			}
+		}
+		m.record(ctx, name, res, usage, time.Since(start), streamErr)
+	}()
+	return out, nil
+}
+
+// selectModel 预算紧张时切换到低价模型
+func (m *meteredChatModel) selectModel(ctx context.Context, opts []model.Option) []model.Option {
+	if m.cheapModel != "" && budgetFrom(ctx).preferCheapModel() {
+		opts = append(opts, model.WithModel(m.cheapModel))
+	}
+	return opts
+}
+
+// modelName 返回本次调用实际使用的模型名称
+func (m *meteredChatModel) modelName(opts []model.Option) string {
+	o := model.GetCommonOptions(&model.Options{Model: &m.defaultModel}, opts...)
+	if o.Model == nil {
+		return m.defaultModel
+	}
+	return *o.Model
+}
+
+// recordStart 记录 LLM 调用开始的事件
+func (m *meteredChatModel) recordStart(ctx context.Context, opts []model.Option) {
+	recordEvent(ctx, dm.EventLLMCallStarted, "", map[string]any{"model": m.modelName(opts), "stage": callInfoFrom(ctx).Stage})
+}
+
+// record 结算调用占用的预算并保存用量记录；未返回用量时，成功的调用按预估用量计入预算，失败的调用只释放占用
+func (m *meteredChatModel) record(ctx context.Context, modelName string, res reservation, usage *schema.TokenUsage, latency time.Duration, callErr error) {
+	if t := budgetFrom(ctx); t != nil {
+		var tokens int
+		var cost float64
+		switch {
+		case usage != nil:
+			tokens = usage.TotalTokens
+			if tokens == 0 {
+				tokens = usage.PromptTokens + usage.CompletionTokens
+			}
+			cost = m.prices.Cost(modelName, usage.PromptTokens, usage.CompletionTokens)
+		case callErr == nil:
+			tokens, cost = res.tokens, res.cost
+		}
+		t.settle(res, tokens, cost)
+	}
+	if m.recorder == nil {
+		return
+	}
+	info := callInfoFrom(ctx)
+	call := &dm.LLMCall{
+		RunID:     info.RunID,
+		UserID:    info.UserID,
+		Domain:    info.Domain,
+		Stage:     info.Stage,
+		Model:     modelName,
+		LatencyMs: latency.Milliseconds(),
+		Success:   callErr == nil,
+	}
+	if usage != nil {
+		call.PromptTokens = usage.PromptTokens
+		call.CompletionTokens = usage.CompletionTokens
+		call.TotalTokens = usage.TotalTokens
+		call.Cost = m.prices.Cost(modelName, usage.PromptTokens, usage.CompletionTokens)
+	}
+	if callErr != nil {
+		call.Error = callErr.Error()
+	}
+	recordEvent(ctx, dm.EventLLMCallFinished, call.Error, map[string]any{
+		"model":             call.Model,
+		"stage":             call.Stage,
+		"prompt_tokens":     call.PromptTokens,
+		"completion_tokens": call.CompletionTokens,
+		"cost":              call.Cost,
+		"latency_ms":        call.LatencyMs,
+		"success":           call.Success,
+	})
+	// 被取消的调用同样计入用量，记录不受 ctx 取消的影响
+	if err := m.recorder.SaveLLMCall(context.WithoutCancel(ctx), call); err != nil {
+		logger.Log.Errorf("保存 LLM 用量记录失败: %v", err)
+	}
+}
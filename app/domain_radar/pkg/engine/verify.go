@@ -0,0 +1,177 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/schema"
+	"golang.org/x/time/rate"
+
+	dm "github.com/iWorld-y/domain_radar/app/domain_radar/pkg/model"
+)
+
+// claim 待核验的单条论断
+type claim struct {
+	claimType string
+	text      string
+	index     int // 关键事件在 KeyEvents 中的下标，趋势论断为在句子列表中的下标
+}
+
+// verdictResponse LLM 核验结果
+type verdictResponse struct {
+	Verdicts []struct {
+		ID      int    `json:"id"`
+		Verdict string `json:"verdict"`
+		Reason  string `json:"reason"`
+	} `json:"verdicts"`
+}
+
+// verifyDomainReport 对照原文核验领域报告中的关键事件与趋势论断，标记或剔除无依据的论断
+func verifyDomainReport(ctx context.Context, cm model.ChatModel, report *dm.DomainReport, limiter *rate.Limiter, dropUnsupported bool) error {
+	sentences := splitClaims(report.Trends)
+	var claims []claim
+	for i, e := range report.KeyEvents {
+		claims = append(claims, claim{claimType: dm.ClaimTypeKeyEvent, text: e.Content, index: i})
+	}
+	for i, s := range sentences {
+		claims = append(claims, claim{claimType: dm.ClaimTypeTrend, text: strings.TrimSpace(s), index: i})
+	}
+	if len(claims) == 0 {
+		return nil
+	}
+
+	p := promptsFrom(ctx)
+	var sb strings.Builder
+	sb.WriteString(p.verifyArticlesIntro)
+	for i, art := range report.Articles {
+		fmt.Fprintf(&sb, p.verifyArticleItem, i+1, art.Title, art.Content)
+	}
+	sb.WriteString(p.verifyClaimsIntro)
+	for i, c := range claims {
+		fmt.Fprintf(&sb, p.verifyClaimItem, i+1, c.text)
+	}
+
+	messages := []*schema.Message{
+		{Role: schema.System, Content: p.jsonSystem},
+		{Role: schema.User, Content: sb.String() + "\n\n" + p.verification},
+	}
+
+	var resp verdictResponse
+	if err := generateJSON(ctx, cm, limiter, messages, &resp); err != nil {
+		return err
+	}
+
+	byID := make(map[int]string, len(resp.Verdicts))
+	reasons := make(map[int]string, len(resp.Verdicts))
+	for _, v := range resp.Verdicts {
+		byID[v.ID] = normalizeVerdict(v.Verdict)
+		reasons[v.ID] = v.Reason
+	}
+
+	droppedEvents := make(map[int]bool)
+	droppedSentences := make(map[int]bool)
+	report.Verdicts = report.Verdicts[:0]
+	for i, c := range claims {
+		verdict, ok := byID[i+1]
+		reason := reasons[i+1]
+		if !ok {
+			// 输出被截断等原因未给出结论的论断标记为未核验，不剔除
+			verdict, reason = dm.VerdictUnverified, "no verdict returned"
+		}
+		dropped := dropUnsupported && verdict == dm.VerdictUnsupported
+		report.Verdicts = append(report.Verdicts, dm.ClaimVerdict{
+			ClaimType: c.claimType,
+			Claim:     c.text,
+			Verdict:   verdict,
+			Reason:    reason,
+			Dropped:   dropped,
+		})
+		switch c.claimType {
+		case dm.ClaimTypeKeyEvent:
+			report.KeyEvents[c.index].Verdict = verdict
+			droppedEvents[c.index] = dropped
+		case dm.ClaimTypeTrend:
+			droppedSentences[c.index] = dropped
+		}
+	}
+
+	if dropUnsupported {
+		events := report.KeyEvents[:0]
+		for i, e := range report.KeyEvents {
+			if !droppedEvents[i] {
+				events = append(events, e)
+			}
+		}
+		report.KeyEvents = events
+
+		var kept strings.Builder
+		for i, s := range sentences {
+			if !droppedSentences[i] {
+				kept.WriteString(s)
+			}
+		}
+		report.Trends = strings.TrimSpace(kept.String())
+	}
+	return nil
+}
+
+// normalizeVerdict 将 LLM 返回的结论归一化为预定义取值，无法识别的结论视为未核验
+func normalizeVerdict(v string) string {
+	v = strings.ToLower(strings.TrimSpace(v))
+	v = strings.ReplaceAll(v, " ", "_")
+	switch v {
+	case dm.VerdictSupported:
+		return dm.VerdictSupported
+	case dm.VerdictPartiallySupported, "partial", "partially":
+		return dm.VerdictPartiallySupported
+	case dm.VerdictUnsupported, "not_supported":
+		return dm.VerdictUnsupported
+	default:
+		return dm.VerdictUnverified
+	}
+}
+
+// splitClaims 将趋势分析按句切分为独立论断，保留句末标点与引用标记
+func splitClaims(text string) []string {
+	var claims []string
+	var cur strings.Builder
+	flush := func() {
+		switch {
+		case strings.TrimSpace(cur.String()) != "":
+			claims = append(claims, cur.String())
+		case len(claims) > 0:
+			// 空白（如段落换行）并入上一句，保证剔除论断后仍保留原有排版
+			claims[len(claims)-1] += cur.String()
+		}
+		cur.Reset()
+	}
+
+	for i := 0; i < len(text); {
+		r, size := utf8.DecodeRuneInString(text[i:])
+		cur.WriteRune(r)
+		i += size
+		if r == '\n' {
+			flush()
+			continue
+		}
+		if !strings.ContainsRune("。！？!?；;", r) && !(r == '.' && (i == len(text) || text[i] == ' ' || text[i] == '\n')) {
+			continue
+		}
+		// 句末紧跟的引用标记归属于当前句
+		for {
+			loc := citationPattern.FindStringIndex(strings.TrimLeft(text[i:], " "))
+			if loc == nil || loc[0] != 0 {
+				break
+			}
+			skip := len(text[i:]) - len(strings.TrimLeft(text[i:], " "))
+			cur.WriteString(text[i : i+skip+loc[1]])
+			i += skip + loc[1]
+		}
+		flush()
+	}
+	flush()
+	return claims
+}
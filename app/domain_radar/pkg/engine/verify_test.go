@@ -0,0 +1,71 @@
+package engine
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/schema"
+	"golang.org/x/time/rate"
+
+	dm "github.com/iWorld-y/domain_radar/app/domain_radar/pkg/model"
+)
+
+// partialJudgeModel 只对第 1、3 条论断给出结论，模拟被截断的核验输出
+type partialJudgeModel struct {
+	model.ChatModel
+}
+
+func (partialJudgeModel) Generate(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.Message, error) {
+	return &schema.Message{Role: schema.Assistant, Content: `{"verdicts": [
+		{"id": 1, "verdict": "unsupported", "reason": "not in articles"},
+		{"id": 3, "verdict": "supported", "reason": "article 1"}
+	]}`}, nil
+}
+
+func TestVerifyDomainReportKeepsClaimsWithoutVerdict(t *testing.T) {
+	report := &dm.DomainReport{
+		KeyEvents: []dm.KeyEvent{{Content: "Funding round"}, {Content: "Chip launch"}},
+		Trends:    "Demand grows [1]. Prices fall [1].",
+		Articles:  []dm.Article{{Title: "t", Content: "c"}},
+	}
+	if err := verifyDomainReport(context.Background(), partialJudgeModel{}, report, rate.NewLimiter(rate.Inf, 1), true); err != nil {
+		t.Fatalf("verifyDomainReport() error = %v", err)
+	}
+
+	if len(report.KeyEvents) != 1 || report.KeyEvents[0].Content != "Chip launch" || report.KeyEvents[0].Verdict != dm.VerdictUnverified {
+		t.Errorf("KeyEvents = %+v, want only the unverified Chip launch", report.KeyEvents)
+	}
+	if report.Trends != "Demand grows [1]. Prices fall [1]." {
+		t.Errorf("Trends = %q, want both sentences kept", report.Trends)
+	}
+	wantVerdicts := []string{dm.VerdictUnsupported, dm.VerdictUnverified, dm.VerdictSupported, dm.VerdictUnverified}
+	wantDropped := []bool{true, false, false, false}
+	if len(report.Verdicts) != len(wantVerdicts) {
+		t.Fatalf("Verdicts = %+v, want %d entries", report.Verdicts, len(wantVerdicts))
+	}
+	for i, v := range report.Verdicts {
+		if v.Verdict != wantVerdicts[i] || v.Dropped != wantDropped[i] {
+			t.Errorf("Verdicts[%d] = %+v, want verdict %s dropped %v", i, v, wantVerdicts[i], wantDropped[i])
+		}
+	}
+	if r := report.Verdicts[1].Reason; r != "no verdict returned" {
+		t.Errorf("Verdicts[1].Reason = %q, want %q", r, "no verdict returned")
+	}
+}
+
+func TestSplitClaims(t *testing.T) {
+	text := "算力需求持续增长 [1]。开源模型加速追赶。[2][3]\nPrices drop. Demand rises [4]."
+
+	got := splitClaims(text)
+	want := []string{
+		"算力需求持续增长 [1]。",
+		"开源模型加速追赶。[2][3]\n",
+		"Prices drop.",
+		" Demand rises [4].",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("splitClaims() = %q, want %q", got, want)
+	}
+}
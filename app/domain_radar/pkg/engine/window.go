@@ -0,0 +1,86 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// 搜索时间范围预设
+const (
+	WindowDefault   = ""           // 最近 3 天
+	WindowLast24h   = "24h"        // 最近 24 小时
+	WindowLast7d    = "7d"         // 最近 7 天
+	WindowSinceLast = "since_last" // 自上次成功运行以来，无历史运行时为最近 3 天
+)
+
+const (
+	defaultWindow  = 3 * 24 * time.Hour
+	maxSinceWindow = 7 * 24 * time.Hour // since_last 的最长范围，避免长时间未运行后一次检索过多
+)
+
+// Window 搜索新闻的时间范围，Start 为零值时按 Preset 计算
+type Window struct {
+	Preset string
+	Start  time.Time
+	End    time.Time // 为零值时为当前时间
+}
+
+// ValidWindowPreset 判断时间范围预设是否受支持
+func ValidWindowPreset(preset string) bool {
+	switch preset {
+	case WindowDefault, WindowLast24h, WindowLast7d, WindowSinceLast:
+		return true
+	}
+	return false
+}
+
+// resolveWindow 计算本次运行的时间范围；固定时长的预设将起点向前取整到小时，
+// 使同一小时内发起的运行时间范围相同，可以共享领域报告
+func (e *Engine) resolveWindow(ctx context.Context, userID int, w Window, now time.Time) (time.Time, time.Time, error) {
+	end := w.End
+	if end.IsZero() {
+		end = now
+	}
+	if !w.Start.IsZero() {
+		if !w.Start.Before(end) {
+			return time.Time{}, time.Time{}, fmt.Errorf("window start %s is not before end %s", w.Start.Format(time.DateOnly), end.Format(time.DateOnly))
+		}
+		return w.Start, end, nil
+	}
+
+	switch w.Preset {
+	case WindowDefault:
+		return end.Add(-defaultWindow).Truncate(time.Hour), end, nil
+	case WindowLast24h:
+		return end.Add(-24 * time.Hour).Truncate(time.Hour), end, nil
+	case WindowLast7d:
+		return end.Add(-7 * 24 * time.Hour).Truncate(time.Hour), end, nil
+	case WindowSinceLast:
+		start := end.Add(-defaultWindow).Truncate(time.Hour)
+		if e.store == nil {
+			return start, end, nil
+		}
+		last, err := e.store.GetLastCompletedRun(ctx, userID)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("find last completed run: %w", err)
+		}
+		if last != nil {
+			start = last.CreatedAt
+			if last.WindowEnd != nil {
+				start = *last.WindowEnd
+			}
+			start = maxTime(start, end.Add(-maxSinceWindow))
+		}
+		return start, end, nil
+	default:
+		return time.Time{}, time.Time{}, fmt.Errorf("unknown window preset: %s", w.Preset)
+	}
+}
+
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
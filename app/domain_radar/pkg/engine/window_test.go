@@ -0,0 +1,69 @@
+package engine
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/iWorld-y/domain_radar/app/domain_radar/pkg/search"
+)
+
+func TestResolveWindow(t *testing.T) {
+	e := &Engine{}
+	now := time.Date(2026, 3, 10, 8, 0, 0, 0, time.UTC)
+	explicit := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		window    Window
+		wantStart time.Time
+		wantErr   bool
+	}{
+		{Window{}, now.Add(-72 * time.Hour), false},
+		{Window{Preset: WindowLast24h}, now.Add(-24 * time.Hour), false},
+		{Window{Preset: WindowLast7d}, now.Add(-7 * 24 * time.Hour), false},
+		{Window{Preset: WindowSinceLast}, now.Add(-72 * time.Hour), false}, // 无数据库时按默认范围
+		{Window{Start: explicit}, explicit, false},
+		{Window{Start: now.Add(time.Hour)}, time.Time{}, true},
+		{Window{Preset: "30d"}, time.Time{}, true},
+	}
+	for _, tt := range tests {
+		start, end, err := e.resolveWindow(context.Background(), 0, tt.window, now)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("resolveWindow(%+v) error = %v, wantErr %v", tt.window, err, tt.wantErr)
+			continue
+		}
+		if err == nil && (!start.Equal(tt.wantStart) || !end.Equal(now)) {
+			t.Errorf("resolveWindow(%+v) = %v ~ %v, want %v ~ %v", tt.window, start, end, tt.wantStart, now)
+		}
+	}
+
+	// 固定时长的预设将起点取整到小时
+	start, _, err := e.resolveWindow(context.Background(), 0, Window{Preset: WindowLast24h}, now.Add(20*time.Minute))
+	if err != nil || !start.Equal(now.Add(-24*time.Hour)) {
+		t.Errorf("resolveWindow(24h) start = %v, %v, want %v", start, err, now.Add(-24*time.Hour))
+	}
+}
+
+func TestFilterWindow(t *testing.T) {
+	start := time.Date(2026, 3, 9, 8, 0, 0, 0, time.Local)
+	end := time.Date(2026, 3, 10, 8, 0, 0, 0, time.Local)
+	results := []search.Result{
+		{URL: "before", PublishedDate: "2026-03-09T07:30:00"},
+		{URL: "inside", PublishedDate: "2026-03-09T09:00:00"},
+		{URL: "rfc1123", PublishedDate: start.Add(time.Hour).Format(time.RFC1123Z)},
+		{URL: "after", PublishedDate: "2026-03-10 09:00:00"},
+		{URL: "date_only", PublishedDate: "2026-03-09"},
+		{URL: "old_date", PublishedDate: "2026-03-08"},
+		{URL: "unknown", PublishedDate: ""},
+	}
+
+	var got []string
+	for _, r := range filterWindow(results, start, end) {
+		got = append(got, r.URL)
+	}
+	want := []string{"inside", "rfc1123", "date_only", "unknown"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("filterWindow() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,78 @@
+package model
+
+import (
+	"encoding/json"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DomainConfig 关注领域的配置。配置文件与数据库中可直接写领域名称字符串，
+// 解析时转换为只设置了名称的已启用领域
+type DomainConfig struct {
+	Name         string   `json:"name" yaml:"name"`                                       // 领域名称，同时作为报告与历史热度中的领域标识
+	DisplayName  string   `json:"display_name,omitempty" yaml:"display_name,omitempty"`   // 展示名称，为空时使用 Name
+	Queries      []string `json:"queries,omitempty" yaml:"queries,omitempty"`             // 检索词，为空时由领域名称生成各检索语言的检索词
+	IncludeSites []string `json:"include_sites,omitempty" yaml:"include_sites,omitempty"` // 只收录这些站点的文章，如 36kr.com
+	ExcludeSites []string `json:"exclude_sites,omitempty" yaml:"exclude_sites,omitempty"` // 不收录这些站点的文章
+	Languages    []string `json:"languages,omitempty" yaml:"languages,omitempty"`         // 检索语言，为空时使用全局配置
+	MaxArticles  int      `json:"max_articles,omitempty" yaml:"max_articles,omitempty"`   // 收录文章数上限，0 时使用默认值
+	Window       string   `json:"window,omitempty" yaml:"window,omitempty"`               // 时间范围预设，为空时使用本次运行的时间范围
+	Weight       float64  `json:"weight,omitempty" yaml:"weight,omitempty"`               // 优先级权重，影响处理顺序与报告排序，0 时视为 1
+	Enabled      bool     `json:"enabled" yaml:"enabled"`                                 // 未写明时默认启用
+}
+
+// DomainNames 将领域名称列表转换为已启用的领域配置
+func DomainNames(names []string) []DomainConfig {
+	domains := make([]DomainConfig, 0, len(names))
+	for _, name := range names {
+		domains = append(domains, DomainConfig{Name: name, Enabled: true})
+	}
+	return domains
+}
+
+// Label 返回领域的展示名称
+func (d DomainConfig) Label() string {
+	if d.DisplayName != "" {
+		return d.DisplayName
+	}
+	return d.Name
+}
+
+// PriorityWeight 返回领域的优先级权重，未设置时为 1
+func (d DomainConfig) PriorityWeight() float64 {
+	if d.Weight <= 0 {
+		return 1
+	}
+	return d.Weight
+}
+
+// UnmarshalJSON 兼容旧版以字符串保存的领域
+func (d *DomainConfig) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err == nil {
+		*d = DomainConfig{Name: name, Enabled: true}
+		return nil
+	}
+	type alias DomainConfig
+	v := alias{Enabled: true}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*d = DomainConfig(v)
+	return nil
+}
+
+// UnmarshalYAML 兼容配置文件中以字符串列出的领域
+func (d *DomainConfig) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		*d = DomainConfig{Name: node.Value, Enabled: true}
+		return nil
+	}
+	type alias DomainConfig
+	v := alias{Enabled: true}
+	if err := node.Decode(&v); err != nil {
+		return err
+	}
+	*d = DomainConfig(v)
+	return nil
+}
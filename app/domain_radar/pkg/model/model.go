@@ -1,5 +1,12 @@
 package model
 
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
 // Article 基础文章信息
 type Article struct {
 	Title   string
@@ -9,21 +16,290 @@ type Article struct {
 	Content string // 临时存储用于 LLM 分析，不一定展示
 }
 
+// 论断核验结论
+const (
+	VerdictSupported          = "supported"           // 有据可查
+	VerdictPartiallySupported = "partially_supported" // 部分有据
+	VerdictUnsupported        = "unsupported"         // 无依据
+	VerdictUnverified         = "unverified"          // 核验模型未给出结论，不剔除
+)
+
+// 论断类型
+const (
+	ClaimTypeKeyEvent = "key_event"
+	ClaimTypeTrend    = "trend"
+)
+
+// KeyEvent 关键事件及其引用来源
+type KeyEvent struct {
+	Content string `json:"event"`             // 事件描述
+	Sources []int  `json:"sources"`           // 引用的文章序号，从 1 开始，对应 DomainReport.Articles 的顺序
+	Verdict string `json:"verdict,omitempty"` // 核验结论，未核验时为空
+}
+
+// UnmarshalJSON 兼容 LLM 直接返回字符串形式的关键事件
+func (k *KeyEvent) UnmarshalJSON(data []byte) error {
+	var content string
+	if err := json.Unmarshal(data, &content); err == nil {
+		*k = KeyEvent{Content: content}
+		return nil
+	}
+	type alias KeyEvent
+	var v alias
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*k = KeyEvent(v)
+	return nil
+}
+
 // DomainReport 领域报告结构体
 type DomainReport struct {
+	ID         int // 已保存的报告 ID，未保存时为 0
 	DomainName string
-	Overview   string    `json:"overview"`   // 领域综述
-	KeyEvents  []string  `json:"key_events"` // 关键事件
-	Trends     string    `json:"trends"`     // 趋势分析
-	Score      int       `json:"score"`      // 领域热度评分
-	Articles   []Article // 引用文章列表
+	Overview   string         `json:"overview"`   // 领域综述
+	KeyEvents  []KeyEvent     `json:"key_events"` // 关键事件
+	Trends     string         `json:"trends"`     // 趋势分析，论断以 [n] 形式标注引用文章
+	Score      int            `json:"score"`      // 领域热度评分：LLM 输出的重要程度，计算热度后替换为校准后的热度
+	LLMScore   int            // LLM 判断的重要程度（1-10）
+	Heat       *HeatScore     // 热度各分项，未计算时为空
+	Articles   []Article      // 引用文章列表
+	Verdicts   []ClaimVerdict // 论断核验结果，未开启核验时为空
+	Entities   []Entity       // 文章中提及的实体，未开启实体抽取时为空
+	ShareKey   string         // 共享键，相同共享键的报告可在多次运行间复用，为空时不共享
+}
+
+// 实体类型
+const (
+	EntityTypeCompany      = "company"
+	EntityTypePerson       = "person"
+	EntityTypeProduct      = "product"
+	EntityTypeProject      = "project"
+	EntityTypeOrganization = "organization"
+)
+
+// Entity 文章中提及的命名实体
+type Entity struct {
+	Name     string   `json:"name"`     // 规范名称
+	Type     string   `json:"type"`     // 实体类型
+	Key      string   `json:"-"`        // 归一化后的名称，用于跨文章、跨运行去重
+	Mentions []string `json:"mentions"` // 文章中出现的原始写法
+	Articles []int    `json:"articles"` // 提及该实体的文章序号，从 1 开始
+}
+
+// HeatScore 领域热度的各项可观测信号，分项取值均为 0-1
+type HeatScore struct {
+	ResultCount  int      // 搜索结果数
+	Baseline     float64  // 该领域历史平均搜索结果数，无历史时为默认基线
+	Volume       float64  // 文章量相对基线的高低
+	Diversity    float64  // 来源多样性
+	Recency      *float64 // 时效性，无发布时间信息时为空
+	Engagement   *float64 // 社区互动，搜索源未提供时为空
+	Significance float64  // LLM 判断的重要程度
+	Raw          float64  // 可用分项的加权和，校准前的热度
+}
+
+// HeatHistory 领域历史报告的热度信号，用于计算基线与校准
+type HeatHistory struct {
+	ResultCount int
+	Raw         float64
+}
+
+// ClaimVerdict 单条论断的核验结果
+type ClaimVerdict struct {
+	ClaimType string // 论断类型：key_event / trend
+	Claim     string // 论断原文
+	Verdict   string // 核验结论
+	Reason    string // 判定理由
+	Dropped   bool   // 是否已从报告中剔除
+}
+
+// KeyEventContents 返回关键事件的纯文本列表
+func (r *DomainReport) KeyEventContents() []string {
+	contents := make([]string, 0, len(r.KeyEvents))
+	for _, e := range r.KeyEvents {
+		contents = append(contents, e.Content)
+	}
+	return contents
+}
+
+// 运行记录状态
+const (
+	RunStatusRunning        = "running"
+	RunStatusCompleted      = "completed"
+	RunStatusFailed         = "failed"
+	RunStatusCancelled      = "cancelled"
+	RunStatusBudgetExceeded = "budget_exceeded"
+)
+
+// 领域在单次运行中的处理状态
+const (
+	DomainStatusSucceeded    = "succeeded"
+	DomainStatusNoArticles   = "no_articles"   // 搜索成功但没有足够的有效文章
+	DomainStatusSearchFailed = "search_failed" // 搜索服务调用失败，如 API Key 失效
+	DomainStatusLLMFailed    = "llm_failed"    // 生成领域报告失败
+	DomainStatusFailed       = "failed"        // 其他阶段失败，如保存报告
+	DomainStatusSkipped      = "skipped"       // 预算耗尽或运行被取消，未完成处理
+)
+
+// DomainRunStatus 领域在单次运行中的处理结果
+type DomainRunStatus struct {
+	Domain       string
+	Status       string
+	Error        string // 未成功时的错误详情
+	ArticleCount int
 }
 
 // DeepAnalysisResult 全局深度解读
 type DeepAnalysisResult struct {
-	Title         string   `json:"title"`        // 报告标题
-	MacroTrends   string   `json:"macro_trends"`
-	Opportunities string   `json:"opportunities"`
-	Risks         string   `json:"risks"`
-	ActionGuides  []string `json:"action_guides"`
+	PersonaID     int               `json:"-"`     // 解读所用的结构化画像 ID，自由文本画像时为 0
+	PersonaName   string            `json:"-"`     // 解读所用的画像名称
+	Title         string            `json:"title"` // 报告标题
+	MacroTrends   string            `json:"macro_trends"`
+	Opportunities string            `json:"opportunities"`
+	Risks         string            `json:"risks"`
+	ActionGuides  []string          `json:"action_guides"`
+	Sections      []AnalysisSection `json:"sections"` // 用户自定义栏目的解读内容，按栏目顺序
+}
+
+// AnalysisLens 用户自定义的深度解读栏目
+type AnalysisLens struct {
+	Name        string // 栏目名称，如“竞争对手动向”
+	Instruction string // 该栏目的撰写要求
+}
+
+// AnalysisSection 某个自定义栏目的解读内容
+type AnalysisSection struct {
+	Name    string `json:"name"`
+	Content string `json:"content"`
+}
+
+// 风险偏好
+const (
+	RiskAppetiteLow    = "low"
+	RiskAppetiteMedium = "medium"
+	RiskAppetiteHigh   = "high"
+)
+
+// Persona 结构化用户画像
+type Persona struct {
+	Role         string   `json:"role"`          // 角色，如 后端工程师、产品经理
+	Seniority    string   `json:"seniority"`     // 资历，如 初级、资深、管理者
+	TechStack    []string `json:"tech_stack"`    // 技术栈
+	Goals        []string `json:"goals"`         // 目标
+	RiskAppetite string   `json:"risk_appetite"` // 风险偏好：low / medium / high
+	TimeHorizon  string   `json:"time_horizon"`  // 关注的时间跨度，如 未来 6 个月
+	Constraints  []string `json:"constraints"`   // 约束条件，如 时间、预算、地域
+}
+
+// personaLabels 渲染画像文本时各字段的标签，按报告语言区分
+var personaLabels = map[string][]string{
+	"zh": {"角色", "资历", "技术栈", "目标", "风险偏好", "关注的时间跨度", "约束条件"},
+	"en": {"Role", "Seniority", "Tech stack", "Goals", "Risk appetite", "Time horizon", "Constraints"},
+}
+
+// Render 按报告语言将画像渲染为深度解读使用的多行文本，空字段不输出
+func (p *Persona) Render(lang string) string {
+	labels, ok := personaLabels[lang]
+	if !ok {
+		labels = personaLabels["zh"]
+	}
+	sep := "、"
+	if lang == "en" {
+		sep = ", "
+	}
+	values := []string{
+		p.Role,
+		p.Seniority,
+		strings.Join(p.TechStack, sep),
+		strings.Join(p.Goals, sep),
+		p.RiskAppetite,
+		p.TimeHorizon,
+		strings.Join(p.Constraints, sep),
+	}
+	var lines []string
+	for i, v := range values {
+		if v = strings.TrimSpace(v); v != "" {
+			lines = append(lines, fmt.Sprintf("%s: %s", labels[i], v))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// PersonaLens 深度解读使用的一个画像视角
+type PersonaLens struct {
+	ID   int    // 结构化画像 ID，自由文本画像时为 0
+	Name string // 画像名称
+	Text string // 填入深度解读提示词的画像文本
+}
+
+// InterviewTurn 画像访谈中的一问一答
+type InterviewTurn struct {
+	Question string
+	Answer   string
+}
+
+// InterviewStep 画像访谈的下一步：继续提问，或给出画像与推荐领域
+type InterviewStep struct {
+	Done             bool
+	Question         string   // 下一个问题，Done 为 true 时为空
+	Persona          *Persona // 访谈结束时生成的画像
+	SuggestedDomains []string // 访谈结束时推荐关注的领域
+}
+
+// LLMCall 单次 LLM 调用的用量记录
+type LLMCall struct {
+	RunID            int
+	UserID           int
+	Domain           string
+	Stage            string // 调用所属的流水线阶段，如 domain_report、verification、deep_analysis
+	Model            string
+	PromptTokens     int
+	CompletionTokens int
+	TotalTokens      int
+	Cost             float64
+	LatencyMs        int64
+	Success          bool
+	Error            string
+}
+
+// LLMCacheEntry 缓存的 LLM 输出
+type LLMCacheEntry struct {
+	Key              string // 由模型、提示词模板版本与消息内容计算的哈希
+	Model            string
+	TemplateVersion  string
+	Content          string
+	PromptTokens     int
+	CompletionTokens int
+	ExpiresAt        time.Time
+}
+
+// 运行事件类型
+const (
+	EventRunStarted      = "run_started"
+	EventRunFinished     = "run_finished"
+	EventSearchIssued    = "search_issued"
+	EventSearchResults   = "search_results"
+	EventSearchFailed    = "search_failed"
+	EventArticleFetched  = "article_fetched"
+	EventArticleRejected = "article_rejected"
+	EventLLMCallStarted  = "llm_call_started"
+	EventLLMCallFinished = "llm_call_finished"
+	EventLLMCacheHit     = "llm_cache_hit"
+	EventRetry           = "retry"          // LLM 调用重试、语言不符时重新生成，或重试已有的运行记录
+	EventReportSaved     = "report_saved"   // 保存领域报告，失败时 Message 为错误
+	EventReportReused    = "report_reused"  // 复用其他运行生成的领域报告
+	EventAnalysisSaved   = "analysis_saved" // 保存深度解读，失败时 Message 为错误
+	EventDomainFinished  = "domain_finished"
+)
+
+// RunEvent 运行中一个流水线步骤的事件，用于审计与排查
+type RunEvent struct {
+	ID        int
+	RunID     int
+	Domain    string         // 所属领域，运行级别的事件为空
+	Type      string         // 事件类型，见 Event 开头的常量
+	Message   string         // 检索词、链接或错误等可读的详情
+	Data      map[string]any // 结果数、token 用量、耗时等结构化详情
+	CreatedAt time.Time
 }
@@ -13,8 +13,11 @@ type Request struct {
 	Topic             string // "news" or "general"
 	MaxResults        int
 	IncludeRawContent bool
-	StartDate         string // Format: YYYY-MM-DD
-	EndDate           string // Format: YYYY-MM-DD
+	StartDate         string   // Format: YYYY-MM-DD
+	EndDate           string   // Format: YYYY-MM-DD
+	Language          string   // 结果语言，如 "zh"、"en"，为空时不限制
+	IncludeSites      []string // 只返回这些站点的结果，搜索源不支持时由调用方过滤
+	ExcludeSites      []string // 排除这些站点的结果，搜索源不支持时由调用方过滤
 }
 
 // Response 通用搜索响应
@@ -30,4 +33,5 @@ type Result struct {
 	RawContent    string
 	Score         float64
 	PublishedDate string
+	Engagement    int // 社区互动量（评论、点赞、转发等），搜索源未提供时为 0
 }
@@ -71,8 +71,10 @@ func (c *Client) Search(ctx context.Context, req *search.Request) (*search.Respo
 		q.Set("categories", "general")
 	}
 
-	// 映射语言 (默认中文/英文? 这里先不设，或设为 auto)
-	// q.Set("language", "auto")
+	// 映射语言，未指定时由 SearXNG 自动判断
+	if req.Language != "" {
+		q.Set("language", req.Language)
+	}
 
 	u.RawQuery = q.Encode()
 
@@ -4,9 +4,25 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 	"unicode/utf8"
 
+	"entgo.io/ent/dialect/sql"
+
 	"github.com/iWorld-y/domain_radar/app/common/ent"
+	"github.com/iWorld-y/domain_radar/app/common/ent/actionguide"
+	"github.com/iWorld-y/domain_radar/app/common/ent/analysislens"
+	"github.com/iWorld-y/domain_radar/app/common/ent/analysissection"
+	"github.com/iWorld-y/domain_radar/app/common/ent/article"
+	"github.com/iWorld-y/domain_radar/app/common/ent/deepanalysisresult"
+	"github.com/iWorld-y/domain_radar/app/common/ent/domainreport"
+	"github.com/iWorld-y/domain_radar/app/common/ent/domainrun"
+	"github.com/iWorld-y/domain_radar/app/common/ent/entity"
+	"github.com/iWorld-y/domain_radar/app/common/ent/keyevent"
+	"github.com/iWorld-y/domain_radar/app/common/ent/llmcache"
+	"github.com/iWorld-y/domain_radar/app/common/ent/persona"
+	"github.com/iWorld-y/domain_radar/app/common/ent/reportrun"
+	"github.com/iWorld-y/domain_radar/app/common/ent/reportrunevent"
 	"github.com/iWorld-y/domain_radar/app/common/ent/user"
 	"github.com/iWorld-y/domain_radar/app/domain_radar/pkg/config"
 	"github.com/iWorld-y/domain_radar/app/domain_radar/pkg/model"
@@ -32,56 +48,386 @@ func NewStorage(cfg config.DBConfig) (*Storage, error) {
 		return nil, fmt.Errorf("failed to initialize schema: %w", err)
 	}
 
-	return &Storage{client: client}, nil
+	s := &Storage{client: client}
+	if err := s.migrateUserDomains(context.Background()); err != nil {
+		client.Close()
+		return nil, fmt.Errorf("failed to migrate user domains: %w", err)
+	}
+	if err := s.migrateDomainReportRuns(context.Background()); err != nil {
+		client.Close()
+		return nil, fmt.Errorf("failed to migrate domain report runs: %w", err)
+	}
+	return s, nil
+}
+
+// migrateDomainReportRuns 将旧版仅通过 run_id 关联运行记录的领域报告加入运行记录的报告列表
+func (s *Storage) migrateDomainReportRuns(ctx context.Context) error {
+	reports, err := s.client.DomainReport.Query().
+		Where(domainreport.RunIDNotNil(), domainreport.Not(domainreport.HasReportRuns())).
+		Select(domainreport.FieldID, domainreport.FieldRunID).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, r := range reports {
+		if err := s.client.DomainReport.UpdateOneID(r.ID).AddReportRunIDs(r.RunID).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// migrateUserDomains 将以字符串列表保存的旧版用户领域改写为领域配置对象
+func (s *Storage) migrateUserDomains(ctx context.Context) error {
+	users, err := s.client.User.Query().
+		Where(func(sel *sql.Selector) {
+			sel.Where(sql.ExprP(fmt.Sprintf("jsonb_typeof(%s->0) = 'string'", sel.C(user.FieldDomains))))
+		}).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, u := range users {
+		// 读取时已将字符串解析为领域配置，原样写回即完成转换
+		if err := s.client.User.UpdateOneID(u.ID).SetDomains(u.Domains).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (s *Storage) Close() error {
 	return s.client.Close()
 }
 
-func (s *Storage) CreateRun() (int, error) {
-	r, err := s.client.ReportRun.Create().Save(context.Background())
+// CreateRun 创建运行记录并记录搜索的时间范围，userID 为 0 时表示不属于任何用户
+func (s *Storage) CreateRun(ctx context.Context, userID int, windowStart, windowEnd time.Time) (int, error) {
+	create := s.client.ReportRun.Create().
+		SetStatus(model.RunStatusRunning).
+		SetWindowStart(windowStart).
+		SetWindowEnd(windowEnd)
+	if userID > 0 {
+		create.SetUserID(userID)
+	}
+	r, err := create.Save(ctx)
 	if err != nil {
 		return 0, err
 	}
 	return r.ID, nil
 }
 
-func (s *Storage) UpdateRunTitle(runID int, title string) error {
+// GetLastCompletedRun 返回用户最近一次成功完成的运行记录，userID 为 0 时查询不属于任何用户的运行，不存在时返回 nil
+func (s *Storage) GetLastCompletedRun(ctx context.Context, userID int) (*ent.ReportRun, error) {
+	owner := reportrun.UserID(userID)
+	if userID <= 0 {
+		owner = reportrun.UserIDIsNil()
+	}
+	run, err := s.client.ReportRun.Query().
+		Where(owner, reportrun.Status(model.RunStatusCompleted)).
+		Order(ent.Desc(reportrun.FieldCreatedAt)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	return run, err
+}
+
+// UpdateRunStatus 更新运行记录的状态
+func (s *Storage) UpdateRunStatus(ctx context.Context, runID int, status string) error {
+	return s.client.ReportRun.UpdateOneID(runID).
+		SetStatus(status).
+		Exec(ctx)
+}
+
+// SaveDomainRun 记录领域在本次运行中的处理结果，重试时替换该领域之前的结果
+func (s *Storage) SaveDomainRun(ctx context.Context, runID int, status model.DomainRunStatus) error {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = tx.DomainRun.Delete().
+		Where(domainrun.RunID(runID), domainrun.DomainName(status.Domain)).
+		Exec(ctx)
+	if err == nil {
+		err = tx.DomainRun.Create().
+			SetRunID(runID).
+			SetDomainName(status.Domain).
+			SetStatus(status.Status).
+			SetError(status.Error).
+			SetArticleCount(status.ArticleCount).
+			Exec(ctx)
+	}
+	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: %v", err, rerr)
+		}
+		return err
+	}
+	return tx.Commit()
+}
+
+// GetDomainReports 返回运行记录中的领域报告及关键事件，包括共享自其他运行的报告，用于重试后重新生成深度解读
+func (s *Storage) GetDomainReports(ctx context.Context, runID int) ([]model.DomainReport, error) {
+	reports, err := s.client.DomainReport.Query().
+		Where(domainreport.HasReportRunsWith(reportrun.ID(runID))).
+		WithKeyEvents(func(q *ent.KeyEventQuery) {
+			q.Order(ent.Asc(keyevent.FieldID))
+		}).
+		Order(ent.Asc(domainreport.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]model.DomainReport, 0, len(reports))
+	for _, r := range reports {
+		result = append(result, toDomainReport(r))
+	}
+	return result, nil
+}
+
+// FindSharedDomainReport 返回 since 之后生成的、共享键为 key 的最新领域报告及其文章与关键事件，不存在时返回 nil
+func (s *Storage) FindSharedDomainReport(ctx context.Context, key string, since time.Time) (*model.DomainReport, error) {
+	r, err := s.client.DomainReport.Query().
+		Where(domainreport.ShareKey(key), domainreport.CreatedAtGTE(since)).
+		WithArticles(func(q *ent.ArticleQuery) {
+			q.Order(ent.Asc(article.FieldRefIndex), ent.Asc(article.FieldID))
+		}).
+		WithKeyEvents(func(q *ent.KeyEventQuery) {
+			q.Order(ent.Asc(keyevent.FieldID))
+			q.WithArticles()
+		}).
+		Order(ent.Desc(domainreport.FieldCreatedAt)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	report := toDomainReport(r)
+	return &report, nil
+}
+
+// LinkDomainReport 将已有的领域报告加入运行记录，已加入时不做处理
+func (s *Storage) LinkDomainReport(ctx context.Context, runID, reportID int) error {
+	linked, err := s.client.DomainReport.Query().
+		Where(domainreport.ID(reportID), domainreport.HasReportRunsWith(reportrun.ID(runID))).
+		Exist(ctx)
+	if err != nil || linked {
+		return err
+	}
+	return s.client.ReportRun.UpdateOneID(runID).
+		AddDomainReportIDs(reportID).
+		Exec(ctx)
+}
+
+// toDomainReport 将已保存的领域报告转换为模型，文章与关键事件的引用来源仅在已载入时转换
+func toDomainReport(r *ent.DomainReport) model.DomainReport {
+	report := model.DomainReport{
+		ID:         r.ID,
+		DomainName: r.DomainName,
+		Overview:   r.Overview,
+		Trends:     r.Trends,
+		Score:      r.Score,
+		LLMScore:   r.LlmScore,
+		ShareKey:   r.ShareKey,
+	}
+	if r.HeatRaw != nil {
+		report.Heat = &model.HeatScore{
+			ResultCount:  r.ResultCount,
+			Baseline:     r.HeatBaseline,
+			Volume:       r.HeatVolume,
+			Diversity:    r.HeatDiversity,
+			Recency:      r.HeatRecency,
+			Engagement:   r.HeatEngagement,
+			Significance: r.HeatSignificance,
+			Raw:          *r.HeatRaw,
+		}
+	}
+	refs := make(map[int]int, len(r.Edges.Articles))
+	for _, a := range r.Edges.Articles {
+		refs[a.ID] = a.RefIndex
+		report.Articles = append(report.Articles, model.Article{
+			Title:   a.Title,
+			Link:    a.Link,
+			Source:  a.Source,
+			PubDate: a.PubDate,
+			Content: a.Content,
+		})
+	}
+	for _, ke := range r.Edges.KeyEvents {
+		event := model.KeyEvent{Content: ke.EventContent, Verdict: ke.Verdict}
+		for _, a := range ke.Edges.Articles {
+			if ref, ok := refs[a.ID]; ok {
+				event.Sources = append(event.Sources, ref)
+			}
+		}
+		report.KeyEvents = append(report.KeyEvents, event)
+	}
+	return report
+}
+
+// DeleteDeepAnalyses 删除用户在运行记录中的深度解读及其行动建议与自定义栏目
+func (s *Storage) DeleteDeepAnalyses(ctx context.Context, runID int, userID int) error {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	ids, err := tx.DeepAnalysisResult.Query().
+		Where(deepanalysisresult.RunID(runID), deepanalysisresult.UserID(userID)).
+		IDs(ctx)
+	if err == nil {
+		_, err = tx.ActionGuide.Delete().Where(actionguide.DeepAnalysisIDIn(ids...)).Exec(ctx)
+	}
+	if err == nil {
+		_, err = tx.AnalysisSection.Delete().Where(analysissection.DeepAnalysisIDIn(ids...)).Exec(ctx)
+	}
+	if err == nil {
+		_, err = tx.DeepAnalysisResult.Delete().Where(deepanalysisresult.IDIn(ids...)).Exec(ctx)
+	}
+	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: %v", err, rerr)
+		}
+		return err
+	}
+	return tx.Commit()
+}
+
+func (s *Storage) UpdateRunTitle(ctx context.Context, runID int, title string) error {
 	return s.client.ReportRun.UpdateOneID(runID).
 		SetTitle(title).
-		Exec(context.Background())
+		Exec(ctx)
 }
 
-func (s *Storage) GetUsersWithPersona() ([]*ent.User, error) {
+// ListUsers 返回全部用户，按 ID 排序
+func (s *Storage) ListUsers(ctx context.Context) ([]*ent.User, error) {
 	return s.client.User.Query().
-		Where(user.PersonaNEQ("")).
-		All(context.Background())
+		Order(ent.Asc(user.FieldID)).
+		All(ctx)
+}
+
+// ListPersonas 返回用户每个未删除画像的最新版本，按名称排序
+func (s *Storage) ListPersonas(ctx context.Context, userID int) ([]*ent.Persona, error) {
+	rows, err := s.client.Persona.Query().
+		Where(persona.UserID(userID), persona.Archived(false)).
+		Order(ent.Asc(persona.FieldName), ent.Desc(persona.FieldVersion)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result []*ent.Persona
+	for _, p := range rows {
+		if n := len(result); n > 0 && result[n-1].Name == p.Name {
+			continue
+		}
+		result = append(result, p)
+	}
+	return result, nil
 }
 
-func (s *Storage) SaveDomainReport(runID int, report *model.DomainReport) error {
-	ctx := context.Background()
+// ListAnalysisLenses 返回用户的全部自定义栏目，按展示顺序排列
+func (s *Storage) ListAnalysisLenses(ctx context.Context, userID int) ([]*ent.AnalysisLens, error) {
+	return s.client.AnalysisLens.Query().
+		Where(analysislens.UserID(userID)).
+		Order(ent.Asc(analysislens.FieldPosition), ent.Asc(analysislens.FieldID)).
+		All(ctx)
+}
+
+// GetUserByName 按用户名查询用户
+func (s *Storage) GetUserByName(ctx context.Context, username string) (*ent.User, error) {
+	return s.client.User.Query().
+		Where(user.Username(username)).
+		Only(ctx)
+}
+
+// ListRuns 返回最近的运行记录及其领域报告，userID 大于 0 时只返回该用户的记录
+func (s *Storage) ListRuns(ctx context.Context, limit int, userID int) ([]*ent.ReportRun, error) {
+	query := s.client.ReportRun.Query().
+		WithDomainReports().
+		Order(ent.Desc(reportrun.FieldCreatedAt)).
+		Limit(limit)
+	if userID > 0 {
+		query.Where(reportrun.UserID(userID))
+	}
+	return query.All(ctx)
+}
+
+// GetRun 返回运行记录及其全部领域报告与深度解读
+func (s *Storage) GetRun(ctx context.Context, runID int) (*ent.ReportRun, error) {
+	return s.client.ReportRun.Query().
+		Where(reportrun.ID(runID)).
+		WithDomainReports(func(q *ent.DomainReportQuery) {
+			q.Order(ent.Desc(domainreport.FieldScore))
+			q.WithKeyEvents(func(q *ent.KeyEventQuery) {
+				q.Order(ent.Asc(keyevent.FieldID))
+			})
+			q.WithArticles(func(q *ent.ArticleQuery) {
+				q.Order(ent.Asc(article.FieldRefIndex), ent.Asc(article.FieldID))
+			})
+		}).
+		WithDeepAnalysisResults(func(q *ent.DeepAnalysisResultQuery) {
+			q.Order(ent.Asc(deepanalysisresult.FieldID))
+			q.WithActionGuides()
+			q.WithSections(func(q *ent.AnalysisSectionQuery) {
+				q.Order(ent.Asc(analysissection.FieldPosition))
+			})
+		}).
+		WithDomainRuns(func(q *ent.DomainRunQuery) {
+			q.Order(ent.Asc(domainrun.FieldID))
+		}).
+		Only(ctx)
+}
+
+func (s *Storage) SaveDomainReport(ctx context.Context, runID int, report *model.DomainReport) error {
+	// 实体为跨运行共享的登记表，在事务外登记，避免并发保存时的唯一约束冲突中断整个事务
+	entityIDs := make([]int, len(report.Entities))
+	for i, e := range report.Entities {
+		id, err := s.ensureEntity(ctx, &e)
+		if err != nil {
+			return fmt.Errorf("ensure entity %s: %w", e.Name, err)
+		}
+		entityIDs[i] = id
+	}
+
 	tx, err := s.client.Tx(ctx)
 	if err != nil {
 		return err
 	}
 
 	// Create DomainReport
-	dr, err := tx.DomainReport.Create().
+	create := tx.DomainReport.Create().
 		SetRunID(runID).
+		AddReportRunIDs(runID).
+		SetShareKey(report.ShareKey).
 		SetDomainName(report.DomainName).
 		SetOverview(report.Overview).
 		SetTrends(report.Trends).
 		SetScore(report.Score).
-		Save(ctx)
+		SetLlmScore(report.LLMScore)
+	if h := report.Heat; h != nil {
+		create.
+			SetResultCount(h.ResultCount).
+			SetHeatBaseline(h.Baseline).
+			SetHeatVolume(h.Volume).
+			SetHeatDiversity(h.Diversity).
+			SetNillableHeatRecency(h.Recency).
+			SetNillableHeatEngagement(h.Engagement).
+			SetHeatSignificance(h.Significance).
+			SetHeatRaw(h.Raw)
+	}
+	dr, err := create.Save(ctx)
 	if err != nil {
 		if rerr := tx.Rollback(); rerr != nil {
 			err = fmt.Errorf("%w: %v", err, rerr)
 		}
 		return err
 	}
+	report.ID = dr.ID
 
 	// Create Articles
+	// 记录文章引用序号（从 1 开始）到文章 ID 的映射，用于关联关键事件的引用来源
+	articleIDs := make(map[int]int, len(report.Articles))
 	if len(report.Articles) > 0 {
 		builders := make([]*ent.ArticleCreate, len(report.Articles))
 		for i, art := range report.Articles {
@@ -106,14 +452,19 @@ func (s *Storage) SaveDomainReport(runID int, report *model.DomainReport) error
 				SetLink(art.Link).
 				SetSource(art.Source).
 				SetPubDate(art.PubDate).
-				SetContent(content)
+				SetContent(content).
+				SetRefIndex(i + 1)
 		}
-		if _, err := tx.Article.CreateBulk(builders...).Save(ctx); err != nil {
+		articles, err := tx.Article.CreateBulk(builders...).Save(ctx)
+		if err != nil {
 			if rerr := tx.Rollback(); rerr != nil {
 				err = fmt.Errorf("%w: %v", err, rerr)
 			}
 			return err
 		}
+		for _, art := range articles {
+			articleIDs[art.RefIndex] = art.ID
+		}
 	}
 
 	// Create KeyEvents
@@ -122,7 +473,13 @@ func (s *Storage) SaveDomainReport(runID int, report *model.DomainReport) error
 		for i, event := range report.KeyEvents {
 			builders[i] = tx.KeyEvent.Create().
 				SetDomainReportID(dr.ID).
-				SetEventContent(event)
+				SetEventContent(event.Content).
+				SetVerdict(event.Verdict)
+			for _, src := range event.Sources {
+				if id, ok := articleIDs[src]; ok {
+					builders[i].AddArticleIDs(id)
+				}
+			}
 		}
 		if _, err := tx.KeyEvent.CreateBulk(builders...).Save(ctx); err != nil {
 			if rerr := tx.Rollback(); rerr != nil {
@@ -132,24 +489,70 @@ func (s *Storage) SaveDomainReport(runID int, report *model.DomainReport) error
 		}
 	}
 
+	// Create ArticleEntities
+	var mentions []*ent.ArticleEntityCreate
+	for i, e := range report.Entities {
+		for _, ref := range e.Articles {
+			articleID, ok := articleIDs[ref]
+			if !ok {
+				continue
+			}
+			mentions = append(mentions, tx.ArticleEntity.Create().
+				SetArticleID(articleID).
+				SetEntityID(entityIDs[i]).
+				SetMention(strings.Join(e.Mentions, "; ")))
+		}
+	}
+	if len(mentions) > 0 {
+		if _, err := tx.ArticleEntity.CreateBulk(mentions...).Save(ctx); err != nil {
+			if rerr := tx.Rollback(); rerr != nil {
+				err = fmt.Errorf("%w: %v", err, rerr)
+			}
+			return err
+		}
+	}
+
+	// Create ClaimVerifications
+	if len(report.Verdicts) > 0 {
+		builders := make([]*ent.ClaimVerificationCreate, len(report.Verdicts))
+		for i, v := range report.Verdicts {
+			builders[i] = tx.ClaimVerification.Create().
+				SetDomainReportID(dr.ID).
+				SetClaimType(v.ClaimType).
+				SetClaim(v.Claim).
+				SetVerdict(v.Verdict).
+				SetReason(v.Reason).
+				SetDropped(v.Dropped)
+		}
+		if _, err := tx.ClaimVerification.CreateBulk(builders...).Save(ctx); err != nil {
+			if rerr := tx.Rollback(); rerr != nil {
+				err = fmt.Errorf("%w: %v", err, rerr)
+			}
+			return err
+		}
+	}
+
 	return tx.Commit()
 }
 
-func (s *Storage) SaveDeepAnalysis(runID int, userID int, result *model.DeepAnalysisResult) error {
-	ctx := context.Background()
+func (s *Storage) SaveDeepAnalysis(ctx context.Context, runID int, userID int, result *model.DeepAnalysisResult) error {
 	tx, err := s.client.Tx(ctx)
 	if err != nil {
 		return err
 	}
 
 	// Create DeepAnalysisResult
-	da, err := tx.DeepAnalysisResult.Create().
+	create := tx.DeepAnalysisResult.Create().
 		SetRunID(runID).
 		SetUserID(userID).
+		SetPersonaName(result.PersonaName).
 		SetMacroTrends(result.MacroTrends).
 		SetOpportunities(result.Opportunities).
-		SetRisks(result.Risks).
-		Save(ctx)
+		SetRisks(result.Risks)
+	if result.PersonaID > 0 {
+		create.SetPersonaID(result.PersonaID)
+	}
+	da, err := create.Save(ctx)
 	if err != nil {
 		if rerr := tx.Rollback(); rerr != nil {
 			err = fmt.Errorf("%w: %v", err, rerr)
@@ -173,9 +576,188 @@ func (s *Storage) SaveDeepAnalysis(runID int, userID int, result *model.DeepAnal
 		}
 	}
 
+	// Create AnalysisSections
+	if len(result.Sections) > 0 {
+		builders := make([]*ent.AnalysisSectionCreate, len(result.Sections))
+		for i, section := range result.Sections {
+			builders[i] = tx.AnalysisSection.Create().
+				SetDeepAnalysisID(da.ID).
+				SetName(section.Name).
+				SetContent(section.Content).
+				SetPosition(i)
+		}
+		if _, err := tx.AnalysisSection.CreateBulk(builders...).Save(ctx); err != nil {
+			if rerr := tx.Rollback(); rerr != nil {
+				err = fmt.Errorf("%w: %v", err, rerr)
+			}
+			return err
+		}
+	}
+
 	return tx.Commit()
 }
 
+// GetDomainHeatHistory 返回领域最近已计算热度的报告信号，按时间倒序
+func (s *Storage) GetDomainHeatHistory(ctx context.Context, domain string, limit int) ([]model.HeatHistory, error) {
+	reports, err := s.client.DomainReport.Query().
+		Where(domainreport.DomainName(domain), domainreport.HeatRawNotNil()).
+		Order(ent.Desc(domainreport.FieldCreatedAt)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	history := make([]model.HeatHistory, 0, len(reports))
+	for _, r := range reports {
+		history = append(history, model.HeatHistory{ResultCount: r.ResultCount, Raw: *r.HeatRaw})
+	}
+	return history, nil
+}
+
+// ensureEntity 返回实体在登记表中的 ID，不存在时创建；并发创建冲突时读取已创建的记录
+func (s *Storage) ensureEntity(ctx context.Context, e *model.Entity) (int, error) {
+	query := func() (int, error) {
+		return s.client.Entity.Query().
+			Where(entity.Key(e.Key), entity.Type(e.Type)).
+			OnlyID(ctx)
+	}
+	id, err := query()
+	if !ent.IsNotFound(err) {
+		return id, err
+	}
+	created, err := s.client.Entity.Create().
+		SetName(e.Name).
+		SetType(e.Type).
+		SetKey(e.Key).
+		Save(ctx)
+	if ent.IsConstraintError(err) {
+		return query()
+	}
+	if err != nil {
+		return 0, err
+	}
+	return created.ID, nil
+}
+
+// SaveLLMCall 保存单次 LLM 调用的用量记录
+func (s *Storage) SaveLLMCall(ctx context.Context, call *model.LLMCall) error {
+	create := s.client.LLMCall.Create().
+		SetDomain(call.Domain).
+		SetStage(call.Stage).
+		SetModel(call.Model).
+		SetPromptTokens(call.PromptTokens).
+		SetCompletionTokens(call.CompletionTokens).
+		SetTotalTokens(call.TotalTokens).
+		SetCost(call.Cost).
+		SetLatencyMs(call.LatencyMs).
+		SetSuccess(call.Success).
+		SetError(call.Error)
+	if call.RunID > 0 {
+		create.SetRunID(call.RunID)
+	}
+	if call.UserID > 0 {
+		create.SetUserID(call.UserID)
+	}
+	return create.Exec(ctx)
+}
+
+// SaveRunEvents 批量保存运行事件，未设置时间的事件使用写入时间
+func (s *Storage) SaveRunEvents(ctx context.Context, events []*model.RunEvent) error {
+	builders := make([]*ent.ReportRunEventCreate, len(events))
+	for i, event := range events {
+		create := s.client.ReportRunEvent.Create().
+			SetDomain(event.Domain).
+			SetType(event.Type).
+			SetMessage(removeNullBytes(event.Message))
+		if event.RunID > 0 {
+			create.SetRunID(event.RunID)
+		}
+		if len(event.Data) > 0 {
+			create.SetData(event.Data)
+		}
+		if !event.CreatedAt.IsZero() {
+			create.SetCreatedAt(event.CreatedAt)
+		}
+		builders[i] = create
+	}
+	return s.client.ReportRunEvent.CreateBulk(builders...).Exec(ctx)
+}
+
+// ListRunEvents 按时间顺序返回运行记录的事件，domain 不为空时只返回该领域的事件
+func (s *Storage) ListRunEvents(ctx context.Context, runID int, domain string) ([]model.RunEvent, error) {
+	query := s.client.ReportRunEvent.Query().
+		Where(reportrunevent.RunID(runID)).
+		Order(ent.Asc(reportrunevent.FieldCreatedAt), ent.Asc(reportrunevent.FieldID))
+	if domain != "" {
+		query.Where(reportrunevent.Domain(domain))
+	}
+	rows, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	events := make([]model.RunEvent, 0, len(rows))
+	for _, r := range rows {
+		events = append(events, model.RunEvent{
+			ID:        r.ID,
+			RunID:     r.RunID,
+			Domain:    r.Domain,
+			Type:      r.Type,
+			Message:   r.Message,
+			Data:      r.Data,
+			CreatedAt: r.CreatedAt,
+		})
+	}
+	return events, nil
+}
+
+// GetLLMCache 查询未过期的 LLM 缓存，未命中时返回 nil
+func (s *Storage) GetLLMCache(ctx context.Context, key string) (*model.LLMCacheEntry, error) {
+	c, err := s.client.LLMCache.Query().
+		Where(llmcache.Key(key), llmcache.ExpiresAtGT(time.Now())).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := c.Update().AddHits(1).Exec(ctx); err != nil {
+		return nil, err
+	}
+	return &model.LLMCacheEntry{
+		Key:              c.Key,
+		Model:            c.Model,
+		TemplateVersion:  c.TemplateVersion,
+		Content:          c.Content,
+		PromptTokens:     c.PromptTokens,
+		CompletionTokens: c.CompletionTokens,
+		ExpiresAt:        c.ExpiresAt,
+	}, nil
+}
+
+// SaveLLMCache 写入 LLM 缓存，key 已存在时覆盖原有内容；并发写入相同的 key 时以最后一次为准
+func (s *Storage) SaveLLMCache(ctx context.Context, entry *model.LLMCacheEntry) error {
+	return s.client.LLMCache.Create().
+		SetKey(entry.Key).
+		SetModel(entry.Model).
+		SetTemplateVersion(entry.TemplateVersion).
+		SetContent(removeNullBytes(entry.Content)).
+		SetPromptTokens(entry.PromptTokens).
+		SetCompletionTokens(entry.CompletionTokens).
+		SetExpiresAt(entry.ExpiresAt).
+		SetCreatedAt(time.Now()).
+		OnConflict(sql.ConflictColumns(llmcache.FieldKey)).
+		UpdateNewValues().
+		Exec(ctx)
+}
+
+// DeleteExpiredLLMCache 清理已过期的 LLM 缓存
+func (s *Storage) DeleteExpiredLLMCache(ctx context.Context) (int, error) {
+	return s.client.LLMCache.Delete().
+		Where(llmcache.ExpiresAtLTE(time.Now())).
+		Exec(ctx)
+}
+
 func removeNullBytes(s string) string {
 	return strings.ReplaceAll(s, "\x00", "")
 }
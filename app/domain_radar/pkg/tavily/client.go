@@ -39,6 +39,8 @@ func (c *Client) Search(ctx context.Context, req *search.Request) (*search.Respo
 		IncludeRawContent: req.IncludeRawContent,
 		StartDate:         req.StartDate,
 		EndDate:           req.EndDate,
+		IncludeDomains:    req.IncludeSites,
+		ExcludeDomains:    req.ExcludeSites,
 	}
 
 	resp, err := c.doSearch(ctx, tavilyReq)